@@ -64,6 +64,7 @@ func init() {
 	Registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	Registry.MustRegister(prometheus.NewGoCollector())
 	metrics.RegisterEtcdMetrics(Registry)
+	metrics.RegisterGrpcMetrics(Registry)
 }
 
 func stopRocksmq() {
@@ -57,6 +57,16 @@ const (
 	InvalidNodeID = int64(-1)
 )
 
+const (
+	// CollectionDisabledKey is the collection property to disable a collection without dropping it,
+	// the search, query, insert and delete requests of a disabled collection are rejected.
+	CollectionDisabledKey = "collection.disabled"
+
+	// CollectionWriteBlockedUntilKey is the collection property to block the inserts and deletes of a collection until
+	// the deadline in unix milliseconds, it's set by creating an index with block_writes to build on a stable snapshot.
+	CollectionWriteBlockedUntilKey = "collection.write_blocked_until"
+)
+
 // Endian is type alias of binary.LittleEndian.
 // Milvus uses little endian by default.
 var Endian = binary.LittleEndian
@@ -661,6 +661,26 @@ func (m *meta) GetNumRowsOfPartition(collectionID UniqueID, partitionID UniqueID
 	return ret
 }
 
+// GetNumRowsOfPartitions returns row count of each provided partition of the collection in one pass over the segments
+func (m *meta) GetNumRowsOfPartitions(collectionID UniqueID, partitionIDs []UniqueID) map[UniqueID]int64 {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make(map[UniqueID]int64, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		ret[partitionID] = 0
+	}
+	segments := m.segments.GetSegments()
+	for _, segment := range segments {
+		if !isSegmentHealthy(segment) || segment.CollectionID != collectionID {
+			continue
+		}
+		if _, ok := ret[segment.PartitionID]; ok {
+			ret[segment.PartitionID] += segment.NumOfRows
+		}
+	}
+	return ret
+}
+
 // GetUnFlushedSegments get all segments which state is not `Flushing` nor `Flushed`
 func (m *meta) GetUnFlushedSegments() []*SegmentInfo {
 	m.RLock()
@@ -384,6 +384,8 @@ func TestMeta_Basic(t *testing.T) {
 		assert.EqualValues(t, (rowCount0 + rowCount1), nums)
 		nums = meta.GetNumRowsOfCollection(collID)
 		assert.EqualValues(t, (rowCount0 + rowCount1), nums)
+		numsOfPartitions := meta.GetNumRowsOfPartitions(collID, []UniqueID{partID0, partID1})
+		assert.Equal(t, map[UniqueID]int64{partID0: rowCount0 + rowCount1, partID1: 0}, numsOfPartitions)
 	})
 
 	t.Run("Test GetSegmentsChanPart", func(t *testing.T) {
@@ -266,6 +266,10 @@ func (m *mockRootCoordService) AlterAlias(ctx context.Context, req *milvuspb.Alt
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func newMockRootCoordService() *mockRootCoordService {
 	return &mockRootCoordService{state: internalpb.StateCode_Healthy}
 }
@@ -702,6 +706,18 @@ func (m *mockRootCoordService) ListPolicy(ctx context.Context, in *internalpb.Li
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordService) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 }
 
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -76,7 +77,8 @@ type Manager interface {
 	DropSegment(ctx context.Context, segmentID UniqueID)
 	// SealAllSegments seals all segments of collection with collectionID and return sealed segments.
 	// If segIDs is not empty, also seals segments in segIDs.
-	SealAllSegments(ctx context.Context, collectionID UniqueID, segIDs []UniqueID) ([]UniqueID, error)
+	// If partitionIDs is not empty, only seals segments of these partitions.
+	SealAllSegments(ctx context.Context, collectionID UniqueID, partitionIDs []UniqueID, segIDs []UniqueID) ([]UniqueID, error)
 	// GetFlushableSegments returns flushable segment ids
 	GetFlushableSegments(ctx context.Context, channel string, ts Timestamp) ([]UniqueID, error)
 	// ExpireAllocations notifies segment status to expire old allocations
@@ -420,8 +422,9 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	}
 }
 
-// SealAllSegments seals all segments of collection with collectionID and return sealed segments
-func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, segIDs []UniqueID) ([]UniqueID, error) {
+// SealAllSegments seals all segments of collection with collectionID and return sealed segments,
+// only the segments of partitionIDs are sealed if partitionIDs is not empty
+func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, partitionIDs []UniqueID, segIDs []UniqueID) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	s.mu.Lock()
@@ -431,6 +434,7 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 	if len(segIDs) != 0 {
 		segCandidates = segIDs
 	}
+	partitionSet := typeutil.NewUniqueSet(partitionIDs...)
 	for _, id := range segCandidates {
 		info := s.meta.GetSegment(id)
 		if info == nil {
@@ -440,6 +444,9 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 		if info.CollectionID != collectionID {
 			continue
 		}
+		if partitionSet.Len() > 0 && !partitionSet.Contain(info.PartitionID) {
+			continue
+		}
 		if info.State == commonpb.SegmentState_Sealed {
 			ret = append(ret, id)
 			continue
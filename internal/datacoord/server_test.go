@@ -243,6 +243,33 @@ func TestFlush(t *testing.T) {
 		assert.EqualValues(t, segID, ids[0])
 	})
 
+	t.Run("flush partition", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: schema, Partitions: []int64{1, 2}})
+		allocations, err := svr.segmentManager.AllocSegment(context.TODO(), 0, 1, "channel-1", 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		segID := allocations[0].SegmentID
+		allocations, err = svr.segmentManager.AllocSegment(context.TODO(), 0, 2, "channel-1", 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		otherSegID := allocations[0].SegmentID
+
+		partitionReq := &datapb.FlushRequest{
+			Base:         req.GetBase(),
+			CollectionID: 0,
+			PartitionIDs: []int64{1},
+		}
+		resp, err := svr.Flush(context.TODO(), partitionReq)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.ElementsMatch(t, []int64{segID}, resp.GetSegmentIDs())
+		assert.EqualValues(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(segID).GetState())
+		assert.EqualValues(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(otherSegID).GetState())
+	})
+
 	t.Run("closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		closeTestServer(t, svr)
@@ -477,6 +504,33 @@ func TestGetPartitionStatistics(t *testing.T) {
 		resp, err := svr.GetPartitionStatistics(context.Background(), req)
 		assert.Nil(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Nil(t, resp.GetPartitionRowCounts())
+	})
+	t.Run("per partition", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		for i, segInfo := range []*datapb.SegmentInfo{
+			{ID: 0, CollectionID: 0, PartitionID: 0, NumOfRows: 100, State: commonpb.SegmentState_Flushed},
+			{ID: 1, CollectionID: 0, PartitionID: 0, NumOfRows: 200, State: commonpb.SegmentState_Growing},
+			{ID: 2, CollectionID: 0, PartitionID: 1, NumOfRows: 300, State: commonpb.SegmentState_Flushed},
+			{ID: 3, CollectionID: 0, PartitionID: 1, NumOfRows: 400, State: commonpb.SegmentState_Dropped},
+			{ID: 4, CollectionID: 1, PartitionID: 2, NumOfRows: 500, State: commonpb.SegmentState_Flushed},
+		} {
+			err := svr.meta.AddSegment(NewSegmentInfo(segInfo))
+			assert.Nil(t, err, i)
+		}
+
+		req := &datapb.GetPartitionStatisticsRequest{
+			CollectionID: 0,
+			PartitionIDs: []int64{0, 1, 2},
+			PerPartition: true,
+		}
+		resp, err := svr.GetPartitionStatistics(context.Background(), req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, map[int64]int64{0: 300, 1: 300, 2: 0}, resp.GetPartitionRowCounts())
+		assert.Equal(t, "600", resp.GetStats()[0].GetValue())
 	})
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -1037,7 +1091,7 @@ func (s *spySegmentManager) DropSegment(ctx context.Context, segmentID UniqueID)
 }
 
 // SealAllSegments seals all segments of collection with collectionID and return sealed segments
-func (s *spySegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, segIDs []UniqueID) ([]UniqueID, error) {
+func (s *spySegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, partitionIDs []UniqueID, segIDs []UniqueID) ([]UniqueID, error) {
 	panic("not implemented") // TODO: Implement
 }
 
@@ -69,7 +69,8 @@ func (s *Server) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 // this api only guarantees all the segments requested is sealed
 // these segments will be flushed only after the Flush policy is fulfilled
 func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
-	log.Info("receive flush request", zap.Int64("dbID", req.GetDbID()), zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("receive flush request", zap.Int64("dbID", req.GetDbID()), zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()))
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "DataCoord-Flush")
 	defer sp.Finish()
 	resp := &datapb.FlushResponse{
@@ -93,7 +94,7 @@ func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.F
 	}
 	timeOfSeal, _ := tsoutil.ParseTS(ts)
 
-	sealedSegmentIDs, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), req.GetSegmentIDs())
+	sealedSegmentIDs, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), req.GetPartitionIDs(), req.GetSegmentIDs())
 	if err != nil {
 		resp.Status.Reason = fmt.Sprintf("failed to flush %d, %s", req.CollectionID, err)
 		return resp, nil
@@ -104,10 +105,12 @@ func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.F
 		sealedSegmentsIDDict[sealedSegmentID] = true
 	}
 
+	partitionSet := typeutil.NewUniqueSet(req.GetPartitionIDs()...)
 	segments := s.meta.GetSegmentsOfCollection(req.GetCollectionID())
 	flushSegmentIDs := make([]UniqueID, 0, len(segments))
 	for _, segment := range segments {
 		if segment != nil &&
+			(partitionSet.Len() == 0 || partitionSet.Contain(segment.GetPartitionID())) &&
 			(segment.GetState() == commonpb.SegmentState_Flushed ||
 				segment.GetState() == commonpb.SegmentState_Flushing) &&
 			!sealedSegmentsIDDict[segment.GetID()] {
@@ -311,10 +314,16 @@ func (s *Server) GetPartitionStatistics(ctx context.Context, req *datapb.GetPart
 	nums := int64(0)
 	if len(req.GetPartitionIDs()) == 0 {
 		nums = s.meta.GetNumRowsOfCollection(req.CollectionID)
-	}
-	for _, partID := range req.GetPartitionIDs() {
-		num := s.meta.GetNumRowsOfPartition(req.CollectionID, partID)
-		nums += num
+	} else if req.GetPerPartition() {
+		resp.PartitionRowCounts = s.meta.GetNumRowsOfPartitions(req.CollectionID, req.GetPartitionIDs())
+		for _, num := range resp.PartitionRowCounts {
+			nums += num
+		}
+	} else {
+		for _, partID := range req.GetPartitionIDs() {
+			num := s.meta.GetNumRowsOfPartition(req.CollectionID, partID)
+			nums += num
+		}
 	}
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(nums, 10)})
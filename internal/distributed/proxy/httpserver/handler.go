@@ -1,6 +1,7 @@
 package httpserver
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -9,16 +10,31 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 )
 
+// Auditor writes the audit entries of the requests, it's implemented by proxy.AuditLogger
+type Auditor interface {
+	Audit(ctx context.Context, method string, clientAddr string, req interface{}, resp interface{}, err error)
+}
+
 // Handlers handles http requests
 type Handlers struct {
 	proxy types.ProxyComponent
+	audit Auditor
 }
 
-// NewHandlers creates a new Handlers
-func NewHandlers(proxy types.ProxyComponent) *Handlers {
+// NewHandlers creates a new Handlers, the ddl and credential requests are audited by audit if it's not nil
+func NewHandlers(proxy types.ProxyComponent, audit Auditor) *Handlers {
 	return &Handlers{
 		proxy: proxy,
+		audit: audit,
+	}
+}
+
+// audited writes the audit entry of the request handled by method, and returns the response as it is
+func (h *Handlers) audited(c *gin.Context, method string, req interface{}, resp interface{}, err error) (interface{}, error) {
+	if h.audit != nil {
+		h.audit.Audit(c, method, c.ClientIP(), req, resp, err)
 	}
+	return resp, err
 }
 
 // RegisterRouters registers routes to given router
@@ -114,7 +130,8 @@ func (h *Handlers) handleCreateCollection(c *gin.Context) (interface{}, error) {
 		ShardsNum:        wrappedReq.ShardsNum,
 		ConsistencyLevel: wrappedReq.ConsistencyLevel,
 	}
-	return h.proxy.CreateCollection(c, req)
+	resp, err := h.proxy.CreateCollection(c, req)
+	return h.audited(c, "CreateCollection", req, resp, err)
 }
 
 func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
@@ -123,7 +140,8 @@ func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropCollection(c, &req)
+	resp, err := h.proxy.DropCollection(c, &req)
+	return h.audited(c, "DropCollection", &req, resp, err)
 }
 
 func (h *Handlers) handleHasCollection(c *gin.Context) (interface{}, error) {
@@ -132,7 +150,8 @@ func (h *Handlers) handleHasCollection(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.HasCollection(c, &req)
+	resp, err := h.proxy.HasCollection(c, &req)
+	return h.audited(c, "HasCollection", &req, resp, err)
 }
 
 func (h *Handlers) handleDescribeCollection(c *gin.Context) (interface{}, error) {
@@ -141,7 +160,8 @@ func (h *Handlers) handleDescribeCollection(c *gin.Context) (interface{}, error)
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DescribeCollection(c, &req)
+	resp, err := h.proxy.DescribeCollection(c, &req)
+	return h.audited(c, "DescribeCollection", &req, resp, err)
 }
 
 func (h *Handlers) handleLoadCollection(c *gin.Context) (interface{}, error) {
@@ -150,7 +170,8 @@ func (h *Handlers) handleLoadCollection(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.LoadCollection(c, &req)
+	resp, err := h.proxy.LoadCollection(c, &req)
+	return h.audited(c, "LoadCollection", &req, resp, err)
 }
 
 func (h *Handlers) handleReleaseCollection(c *gin.Context) (interface{}, error) {
@@ -159,7 +180,8 @@ func (h *Handlers) handleReleaseCollection(c *gin.Context) (interface{}, error)
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.ReleaseCollection(c, &req)
+	resp, err := h.proxy.ReleaseCollection(c, &req)
+	return h.audited(c, "ReleaseCollection", &req, resp, err)
 }
 
 func (h *Handlers) handleGetCollectionStatistics(c *gin.Context) (interface{}, error) {
@@ -168,7 +190,8 @@ func (h *Handlers) handleGetCollectionStatistics(c *gin.Context) (interface{}, e
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.GetCollectionStatistics(c, &req)
+	resp, err := h.proxy.GetCollectionStatistics(c, &req)
+	return h.audited(c, "GetCollectionStatistics", &req, resp, err)
 }
 
 func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
@@ -177,7 +200,8 @@ func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.ShowCollections(c, &req)
+	resp, err := h.proxy.ShowCollections(c, &req)
+	return h.audited(c, "ShowCollections", &req, resp, err)
 }
 
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
@@ -186,7 +210,8 @@ func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreatePartition(c, &req)
+	resp, err := h.proxy.CreatePartition(c, &req)
+	return h.audited(c, "CreatePartition", &req, resp, err)
 }
 
 func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
@@ -195,7 +220,8 @@ func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropPartition(c, &req)
+	resp, err := h.proxy.DropPartition(c, &req)
+	return h.audited(c, "DropPartition", &req, resp, err)
 }
 
 func (h *Handlers) handleHasPartition(c *gin.Context) (interface{}, error) {
@@ -204,7 +230,8 @@ func (h *Handlers) handleHasPartition(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.HasPartition(c, &req)
+	resp, err := h.proxy.HasPartition(c, &req)
+	return h.audited(c, "HasPartition", &req, resp, err)
 }
 
 func (h *Handlers) handleLoadPartitions(c *gin.Context) (interface{}, error) {
@@ -213,7 +240,8 @@ func (h *Handlers) handleLoadPartitions(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.LoadPartitions(c, &req)
+	resp, err := h.proxy.LoadPartitions(c, &req)
+	return h.audited(c, "LoadPartitions", &req, resp, err)
 }
 
 func (h *Handlers) handleReleasePartitions(c *gin.Context) (interface{}, error) {
@@ -222,7 +250,8 @@ func (h *Handlers) handleReleasePartitions(c *gin.Context) (interface{}, error)
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.ReleasePartitions(c, &req)
+	resp, err := h.proxy.ReleasePartitions(c, &req)
+	return h.audited(c, "ReleasePartitions", &req, resp, err)
 }
 
 func (h *Handlers) handleGetPartitionStatistics(c *gin.Context) (interface{}, error) {
@@ -231,7 +260,8 @@ func (h *Handlers) handleGetPartitionStatistics(c *gin.Context) (interface{}, er
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.GetPartitionStatistics(c, &req)
+	resp, err := h.proxy.GetPartitionStatistics(c, &req)
+	return h.audited(c, "GetPartitionStatistics", &req, resp, err)
 }
 
 func (h *Handlers) handleShowPartitions(c *gin.Context) (interface{}, error) {
@@ -240,7 +270,8 @@ func (h *Handlers) handleShowPartitions(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.ShowPartitions(c, &req)
+	resp, err := h.proxy.ShowPartitions(c, &req)
+	return h.audited(c, "ShowPartitions", &req, resp, err)
 }
 
 func (h *Handlers) handleCreateAlias(c *gin.Context) (interface{}, error) {
@@ -249,7 +280,8 @@ func (h *Handlers) handleCreateAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateAlias(c, &req)
+	resp, err := h.proxy.CreateAlias(c, &req)
+	return h.audited(c, "CreateAlias", &req, resp, err)
 }
 
 func (h *Handlers) handleDropAlias(c *gin.Context) (interface{}, error) {
@@ -258,7 +290,8 @@ func (h *Handlers) handleDropAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropAlias(c, &req)
+	resp, err := h.proxy.DropAlias(c, &req)
+	return h.audited(c, "DropAlias", &req, resp, err)
 }
 
 func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
@@ -267,7 +300,8 @@ func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.AlterAlias(c, &req)
+	resp, err := h.proxy.AlterAlias(c, &req)
+	return h.audited(c, "AlterAlias", &req, resp, err)
 }
 
 func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
@@ -276,7 +310,8 @@ func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateIndex(c, &req)
+	resp, err := h.proxy.CreateIndex(c, &req)
+	return h.audited(c, "CreateIndex", &req, resp, err)
 }
 
 func (h *Handlers) handleDescribeIndex(c *gin.Context) (interface{}, error) {
@@ -285,7 +320,8 @@ func (h *Handlers) handleDescribeIndex(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DescribeIndex(c, &req)
+	resp, err := h.proxy.DescribeIndex(c, &req)
+	return h.audited(c, "DescribeIndex", &req, resp, err)
 }
 
 func (h *Handlers) handleGetIndexState(c *gin.Context) (interface{}, error) {
@@ -294,7 +330,8 @@ func (h *Handlers) handleGetIndexState(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.GetIndexState(c, &req)
+	resp, err := h.proxy.GetIndexState(c, &req)
+	return h.audited(c, "GetIndexState", &req, resp, err)
 }
 
 func (h *Handlers) handleGetIndexBuildProgress(c *gin.Context) (interface{}, error) {
@@ -303,7 +340,8 @@ func (h *Handlers) handleGetIndexBuildProgress(c *gin.Context) (interface{}, err
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.GetIndexBuildProgress(c, &req)
+	resp, err := h.proxy.GetIndexBuildProgress(c, &req)
+	return h.audited(c, "GetIndexBuildProgress", &req, resp, err)
 }
 
 func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
@@ -312,7 +350,8 @@ func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropIndex(c, &req)
+	resp, err := h.proxy.DropIndex(c, &req)
+	return h.audited(c, "DropIndex", &req, resp, err)
 }
 
 func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
@@ -388,7 +427,8 @@ func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Flush(c, &req)
+	resp, err := h.proxy.Flush(c, &req)
+	return h.audited(c, "Flush", &req, resp, err)
 }
 
 func (h *Handlers) handleCalcDistance(c *gin.Context) (interface{}, error) {
@@ -521,7 +561,8 @@ func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateCredential(c, &req)
+	resp, err := h.proxy.CreateCredential(c, &req)
+	return h.audited(c, "CreateCredential", &req, resp, err)
 }
 
 func (h *Handlers) handleUpdateCredential(c *gin.Context) (interface{}, error) {
@@ -530,7 +571,8 @@ func (h *Handlers) handleUpdateCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.UpdateCredential(c, &req)
+	resp, err := h.proxy.UpdateCredential(c, &req)
+	return h.audited(c, "UpdateCredential", &req, resp, err)
 }
 
 func (h *Handlers) handleDeleteCredential(c *gin.Context) (interface{}, error) {
@@ -539,7 +581,8 @@ func (h *Handlers) handleDeleteCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DeleteCredential(c, &req)
+	resp, err := h.proxy.DeleteCredential(c, &req)
+	return h.audited(c, "DeleteCredential", &req, resp, err)
 }
 
 func (h *Handlers) handleListCredUsers(c *gin.Context) (interface{}, error) {
@@ -548,5 +591,6 @@ func (h *Handlers) handleListCredUsers(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.ListCredUsers(c, &req)
+	resp, err := h.proxy.ListCredUsers(c, &req)
+	return h.audited(c, "ListCredUsers", &req, resp, err)
 }
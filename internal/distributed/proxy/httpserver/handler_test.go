@@ -73,6 +73,10 @@ func (mockProxyComponent) DropPartition(ctx context.Context, request *milvuspb.D
 	return testStatus, nil
 }
 
+func (mockProxyComponent) DropPartitionData(ctx context.Context, request *milvuspb.DropPartitionDataRequest) (*milvuspb.DropPartitionDataResponse, error) {
+	return &milvuspb.DropPartitionDataResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	return &milvuspb.BoolResponse{Status: testStatus}, nil
 }
@@ -103,6 +107,10 @@ func (mockProxyComponent) AlterAlias(ctx context.Context, request *milvuspb.Alte
 	return testStatus, nil
 }
 
+func (mockProxyComponent) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (mockProxyComponent) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -203,6 +211,18 @@ func (mockProxyComponent) GetReplicas(ctx context.Context, request *milvuspb.Get
 	return &milvuspb.GetReplicasResponse{Status: testStatus}, nil
 }
 
+func (mockProxyComponent) AllocTimestamp(ctx context.Context, request *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
+	return &milvuspb.AllocTimestampResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return &milvuspb.CheckHealthResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) GetVersion(ctx context.Context, request *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
+	return &milvuspb.GetVersionResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{Status: testStatus}, nil
 }
@@ -253,7 +273,7 @@ func (mockProxyComponent) ListCredUsers(ctx context.Context, request *milvuspb.L
 
 func TestHandlers(t *testing.T) {
 	mockProxy := &mockProxyComponent{}
-	h := NewHandlers(mockProxy)
+	h := NewHandlers(mockProxy, nil)
 	testEngine := gin.New()
 	h.RegisterRoutesTo(testEngine)
 
@@ -531,3 +551,50 @@ func TestHandlers(t *testing.T) {
 		})
 	}
 }
+
+type auditCall struct {
+	method     string
+	clientAddr string
+	req        interface{}
+	err        error
+}
+
+type mockAuditor struct {
+	calls []auditCall
+}
+
+func (a *mockAuditor) Audit(ctx context.Context, method string, clientAddr string, req interface{}, resp interface{}, err error) {
+	a.calls = append(a.calls, auditCall{method: method, clientAddr: clientAddr, req: req, err: err})
+}
+
+func TestHandlers_Audit(t *testing.T) {
+	audit := &mockAuditor{}
+	h := NewHandlers(&mockProxyComponent{}, audit)
+	testEngine := gin.New()
+	h.RegisterRoutesTo(testEngine)
+
+	serve := func(httpMethod, path string, body interface{}) int {
+		bodyBytes, err := json.Marshal(body)
+		assert.NoError(t, err)
+		req := httptest.NewRequest(httpMethod, path, bytes.NewReader(bodyBytes))
+		req.RemoteAddr = "10.0.0.1:12345"
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/collection", &gin.H{"collection_name": "coll"}))
+	assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "/credential", &gin.H{"username": "bob"}))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/health", nil))
+	// the requests failed to parse aren't handled, so aren't audited
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "/collection", "bad request"))
+
+	if assert.Len(t, audit.calls, 2) {
+		assert.Equal(t, "CreateCollection", audit.calls[0].method)
+		assert.Equal(t, "10.0.0.1", audit.calls[0].clientAddr)
+		assert.Equal(t, "coll", audit.calls[0].req.(*milvuspb.CreateCollectionRequest).GetCollectionName())
+		assert.NoError(t, audit.calls[0].err)
+		assert.Equal(t, "DeleteCredential", audit.calls[1].method)
+		assert.Equal(t, "bob", audit.calls[1].req.(*milvuspb.DeleteCredentialRequest).GetUsername())
+	}
+}
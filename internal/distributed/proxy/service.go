@@ -59,6 +59,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -96,6 +97,13 @@ type Server struct {
 
 	tracer opentracing.Tracer
 	closer io.Closer
+
+	// auditLogger audits the ddl and credential requests, nil if audit log is disabled
+	auditLogger *proxy.AuditLogger
+
+	// missingCoords are the coords still waited for in the background after a degraded start
+	missingMu     sync.Mutex
+	missingCoords []string
 }
 
 // NewServer create a Proxy server.
@@ -127,7 +135,7 @@ func (s *Server) registerHTTPServer() {
 	}
 	ginHandler := gin.Default()
 	apiv1 := ginHandler.Group(apiPathPrefix)
-	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
+	httpserver.NewHandlers(s.proxy, s.auditLogger).RegisterRoutesTo(apiv1)
 	http.Handle("/", ginHandler)
 }
 
@@ -178,7 +186,9 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			ot.UnaryServerInterceptor(opts...),
+			proxy.ClientIdentityInterceptor,
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.AuditInterceptor(s.auditLogger),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
 			proxy.RateLimitInterceptor(limiter),
@@ -306,8 +316,69 @@ func (s *Server) Run() error {
 	return nil
 }
 
+// waitForCoord waits for the coord to be healthy with exponential backoff, bounded by the dependency wait timeout.
+// If the coord isn't healthy in time, the proxy becomes Abnormal with the reason and the error is returned,
+// unless degraded start is enabled and the coord isn't required, then it's waited for in the background.
+func (s *Server) waitForCoord(coord types.Component, name string, required bool) error {
+	cfg := &proxy.Params.ProxyCfg
+	log.Debug("Proxy wait for coord to be healthy", zap.String("coord", name), zap.Duration("timeout", cfg.DependencyWaitTimeout))
+	s.proxy.SetStateReason(fmt.Sprintf("waiting for %s to be healthy", name))
+
+	ctx, cancel := context.WithTimeout(s.ctx, cfg.DependencyWaitTimeout)
+	defer cancel()
+	err := funcutil.WaitForComponentHealthyWithBackoff(ctx, coord, name, cfg.DependencyWaitInitialBackoff, cfg.DependencyWaitMaxBackoff)
+	if err == nil {
+		log.Debug("Proxy wait for coord to be healthy done", zap.String("coord", name))
+		s.updateMissingCoords()
+		return nil
+	}
+
+	if required || !cfg.DegradedStart {
+		log.Warn("Proxy failed to wait for coord to be healthy", zap.String("coord", name), zap.Error(err))
+		s.proxy.UpdateStateCode(internalpb.StateCode_Abnormal)
+		s.proxy.SetStateReason(fmt.Sprintf("%s is not healthy after %v: %v", name, cfg.DependencyWaitTimeout, err))
+		return fmt.Errorf("%s is not healthy after %v: %w", name, cfg.DependencyWaitTimeout, err)
+	}
+
+	log.Warn("Proxy starts without coord, keep waiting for it in the background", zap.String("coord", name), zap.Error(err))
+	s.missingMu.Lock()
+	s.missingCoords = append(s.missingCoords, name)
+	s.missingMu.Unlock()
+	s.updateMissingCoords()
+
+	go func() {
+		if err := funcutil.WaitForComponentHealthyWithBackoff(s.ctx, coord, name, cfg.DependencyWaitInitialBackoff, cfg.DependencyWaitMaxBackoff); err != nil {
+			log.Warn("Proxy stops waiting for coord", zap.String("coord", name), zap.Error(err))
+			return
+		}
+		log.Info("coord becomes healthy after the degraded start of Proxy", zap.String("coord", name))
+		s.missingMu.Lock()
+		for i, missing := range s.missingCoords {
+			if missing == name {
+				s.missingCoords = append(s.missingCoords[:i], s.missingCoords[i+1:]...)
+				break
+			}
+		}
+		s.missingMu.Unlock()
+		s.updateMissingCoords()
+	}()
+	return nil
+}
+
+// updateMissingCoords sets the state reason of the proxy to the coords still missing, or clears it if none.
+func (s *Server) updateMissingCoords() {
+	s.missingMu.Lock()
+	defer s.missingMu.Unlock()
+	if len(s.missingCoords) == 0 {
+		s.proxy.SetStateReason("")
+		return
+	}
+	s.proxy.SetStateReason(fmt.Sprintf("degraded start, waiting for %v to be healthy", s.missingCoords))
+}
+
 func (s *Server) init() error {
 	Params.InitOnce(typeutil.ProxyRole)
+	compressor.SetGrpcCompressionMinSize(Params.CompressionMinSize)
 	log.Debug("Proxy init service's parameter table done")
 	HTTPParams.InitOnce()
 	log.Debug("Proxy init http server's parameter table done")
@@ -334,6 +405,13 @@ func (s *Server) init() error {
 	s.etcdCli = etcdCli
 	s.proxy.SetEtcdClient(s.etcdCli)
 
+	// the audit logger is shared by the grpc and http servers
+	s.auditLogger, err = proxy.NewAuditLogger()
+	if err != nil {
+		log.Error("Create proxy audit logger failed", zap.Error(err))
+		return err
+	}
+
 	errChan := make(chan error, 1)
 	{
 		s.startInternalRPCServer(Params.InternalPort, errChan)
@@ -375,12 +453,9 @@ func (s *Server) init() error {
 	}
 	log.Debug("init RootCoord client for Proxy done")
 
-	log.Debug("Proxy wait for RootCoord to be healthy")
-	if err := funcutil.WaitForComponentHealthy(s.ctx, s.rootCoordClient, "RootCoord", 1000000, time.Millisecond*200); err != nil {
-		log.Warn("Proxy failed to wait for RootCoord to be healthy", zap.Error(err))
+	if err := s.waitForCoord(s.rootCoordClient, "RootCoord", true); err != nil {
 		return err
 	}
-	log.Debug("Proxy wait for RootCoord to be healthy done")
 
 	log.Debug("set RootCoord client for Proxy")
 	s.proxy.SetRootCoordClient(s.rootCoordClient)
@@ -404,12 +479,9 @@ func (s *Server) init() error {
 	}
 	log.Debug("init DataCoord client for Proxy done")
 
-	log.Debug("Proxy wait for DataCoord to be healthy")
-	if err := funcutil.WaitForComponentHealthy(s.ctx, s.dataCoordClient, "DataCoord", 1000000, time.Millisecond*200); err != nil {
-		log.Warn("Proxy failed to wait for DataCoord to be healthy", zap.Error(err))
+	if err := s.waitForCoord(s.dataCoordClient, "DataCoord", false); err != nil {
 		return err
 	}
-	log.Debug("Proxy wait for DataCoord to be healthy done")
 
 	log.Debug("set DataCoord client for Proxy")
 	s.proxy.SetDataCoordClient(s.dataCoordClient)
@@ -433,12 +505,9 @@ func (s *Server) init() error {
 	}
 	log.Debug("init IndexCoord client for Proxy done")
 
-	log.Debug("Proxy wait for IndexCoord to be healthy")
-	if err := funcutil.WaitForComponentHealthy(s.ctx, s.indexCoordClient, "IndexCoord", 1000000, time.Millisecond*200); err != nil {
-		log.Warn("Proxy failed to wait for IndexCoord to be healthy", zap.Error(err))
+	if err := s.waitForCoord(s.indexCoordClient, "IndexCoord", false); err != nil {
 		return err
 	}
-	log.Debug("Proxy wait for IndexCoord to be healthy done")
 
 	log.Debug("set IndexCoord client for Proxy")
 	s.proxy.SetIndexCoordClient(s.indexCoordClient)
@@ -462,12 +531,9 @@ func (s *Server) init() error {
 	}
 	log.Debug("init QueryCoord client for Proxy done")
 
-	log.Debug("Proxy wait for QueryCoord to be healthy")
-	if err := funcutil.WaitForComponentHealthy(s.ctx, s.queryCoordClient, "QueryCoord", 1000000, time.Millisecond*200); err != nil {
-		log.Warn("Proxy failed to wait for QueryCoord to be healthy", zap.Error(err))
+	if err := s.waitForCoord(s.queryCoordClient, "QueryCoord", false); err != nil {
 		return err
 	}
-	log.Debug("Proxy wait for QueryCoord to be healthy done")
 
 	log.Debug("set QueryCoord client for Proxy")
 	s.proxy.SetQueryCoordClient(s.queryCoordClient)
@@ -606,6 +672,11 @@ func (s *Server) DropPartition(ctx context.Context, request *milvuspb.DropPartit
 	return s.proxy.DropPartition(ctx, request)
 }
 
+// DropPartitionData notifies Proxy to delete all entities of a partition
+func (s *Server) DropPartitionData(ctx context.Context, request *milvuspb.DropPartitionDataRequest) (*milvuspb.DropPartitionDataResponse, error) {
+	return s.proxy.DropPartitionData(ctx, request)
+}
+
 // HasPartition notifies Proxy to check a partition's existence
 func (s *Server) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	return s.proxy.HasPartition(ctx, request)
@@ -728,6 +799,11 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+// AlterCollection sets the properties of the specified collection.
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollection(ctx, request)
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
@@ -763,6 +839,18 @@ func (s *Server) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReque
 	return s.proxy.GetReplicas(ctx, req)
 }
 
+func (s *Server) AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
+	return s.proxy.AllocTimestamp(ctx, req)
+}
+
+func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return s.proxy.CheckHealth(ctx, req)
+}
+
+func (s *Server) GetVersion(ctx context.Context, req *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
+	return s.proxy.GetVersion(ctx, req)
+}
+
 // Check is required by gRPC healthy checking
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
 	ret := &grpc_health_v1.HealthCheckResponse{
@@ -853,6 +941,18 @@ func (s *Server) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantReque
 	return s.proxy.SelectGrant(ctx, req)
 }
 
+func (s *Server) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, req)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, req)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, req)
+}
+
 func (s *Server) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	return s.proxy.RefreshPolicyInfoCache(ctx, req)
 }
@@ -23,8 +23,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -47,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -166,6 +169,10 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -262,6 +269,18 @@ func (m *MockRootCoord) ListPolicy(ctx context.Context, in *internalpb.ListPolic
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockIndexCoord struct {
 	MockBase
@@ -633,6 +652,10 @@ func (m *MockProxy) DropPartition(ctx context.Context, request *milvuspb.DropPar
 	return nil, nil
 }
 
+func (m *MockProxy) DropPartitionData(ctx context.Context, request *milvuspb.DropPartitionDataRequest) (*milvuspb.DropPartitionDataResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	return nil, nil
 }
@@ -737,6 +760,10 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRates(ctx context.Context, request *proxypb.SetRatesRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -769,6 +796,9 @@ func (m *MockProxy) UpdateStateCode(stateCode internalpb.StateCode) {
 
 }
 
+func (m *MockProxy) SetStateReason(reason string) {
+}
+
 func (m *MockProxy) SetEtcdClient(etcdClient *clientv3.Client) {
 }
 
@@ -804,6 +834,18 @@ func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRe
 	return nil, nil
 }
 
+func (m *MockProxy) AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetVersion(ctx context.Context, req *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -856,6 +898,18 @@ func (m *MockProxy) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantReq
 	return nil, nil
 }
 
+func (m *MockProxy) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -1060,6 +1114,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("DropPartitionData", func(t *testing.T) {
+		_, err := server.DropPartitionData(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("HasPartition", func(t *testing.T) {
 		_, err := server.HasPartition(ctx, nil)
 		assert.Nil(t, err)
@@ -1160,6 +1219,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("AllocTimestamp", func(t *testing.T) {
+		_, err := server.AllocTimestamp(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CheckHealth", func(t *testing.T) {
+		_, err := server.CheckHealth(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetVersion", func(t *testing.T) {
+		_, err := server.GetVersion(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("RegisterLink", func(t *testing.T) {
 		_, err := server.RegisterLink(ctx, nil)
 		assert.Nil(t, err)
@@ -1190,6 +1264,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("AlterCollection", func(t *testing.T) {
+		_, err := server.AlterCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
@@ -1270,6 +1349,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("CreateDatabase", func(t *testing.T) {
+		_, err := server.CreateDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabase", func(t *testing.T) {
+		_, err := server.DropDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabases", func(t *testing.T) {
+		_, err := server.ListDatabases(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("RefreshPrivilegeInfoCache", func(t *testing.T) {
 		_, err := server.RefreshPolicyInfoCache(ctx, nil)
 		assert.Nil(t, err)
@@ -1466,6 +1560,27 @@ func Test_NewServer_TLS_TwoWay(t *testing.T) {
 	err := runAndWaitForServerReady(server)
 	assert.Nil(t, err)
 	assert.NotNil(t, server.grpcExternalServer)
+
+	t.Run("client without cert is rejected", func(t *testing.T) {
+		ca, err := os.ReadFile(Params.CaPemPath)
+		require.NoError(t, err)
+		certPool := x509.NewCertPool()
+		require.True(t, certPool.AppendCertsFromPEM(ca))
+		creds := credentials.NewTLS(&tls.Config{
+			ServerName: "localhost",
+			RootCAs:    certPool,
+			MinVersion: tls.VersionTLS13,
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, "localhost:"+strconv.Itoa(Params.Port), grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = milvuspb.NewMilvusServiceClient(conn).CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.Error(t, err)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -1517,3 +1632,104 @@ func Test_NewServer_TLS_FileNotExisted(t *testing.T) {
 	assert.NotNil(t, err)
 	server.Stop()
 }
+
+type stateRecordingProxy struct {
+	MockProxy
+	mu     sync.Mutex
+	state  internalpb.StateCode
+	reason string
+}
+
+func (p *stateRecordingProxy) UpdateStateCode(stateCode internalpb.StateCode) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.state = stateCode
+}
+
+func (p *stateRecordingProxy) SetStateReason(reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reason = reason
+}
+
+func (p *stateRecordingProxy) getReason() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.reason
+}
+
+func Test_Server_waitForCoord(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	proxy.Params.InitOnce()
+	cfg := &proxy.Params.ProxyCfg
+	oldTimeout, oldDegraded := cfg.DependencyWaitTimeout, cfg.DegradedStart
+	cfg.DependencyWaitInitialBackoff = time.Millisecond
+	cfg.DependencyWaitMaxBackoff = 5 * time.Millisecond
+	defer func() {
+		cfg.DependencyWaitTimeout, cfg.DegradedStart = oldTimeout, oldDegraded
+		cfg.DependencyWaitInitialBackoff, cfg.DependencyWaitMaxBackoff = 200*time.Millisecond, 3*time.Second
+	}()
+
+	abnormal := &internalpb.ComponentStates{
+		State:  &internalpb.ComponentInfo{StateCode: internalpb.StateCode_Initializing},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	healthy := &internalpb.ComponentStates{
+		State:  &internalpb.ComponentInfo{StateCode: internalpb.StateCode_Healthy},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	// availableAfter returns a coord which becomes healthy after n attempts
+	availableAfter := func(n int) *MockDataCoord {
+		coord := &MockDataCoord{}
+		coord.On("GetComponentStates", mock.Anything).Return(abnormal, nil).Times(n)
+		coord.On("GetComponentStates", mock.Anything).Return(healthy, nil)
+		return coord
+	}
+
+	t.Run("healthy after attempts", func(t *testing.T) {
+		cfg.DependencyWaitTimeout = 10 * time.Second
+		p := &stateRecordingProxy{}
+		s := &Server{ctx: ctx, proxy: p}
+		coord := availableAfter(3)
+		err := s.waitForCoord(coord, "DataCoord", false)
+		assert.NoError(t, err)
+		coord.AssertNumberOfCalls(t, "GetComponentStates", 4)
+		assert.Empty(t, p.getReason())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		cfg.DependencyWaitTimeout = 50 * time.Millisecond
+		cfg.DegradedStart = true
+		p := &stateRecordingProxy{}
+		s := &Server{ctx: ctx, proxy: p}
+		err := s.waitForCoord(availableAfter(math.MaxInt32), "RootCoord", true)
+		assert.Error(t, err)
+		assert.Equal(t, internalpb.StateCode_Abnormal, p.state)
+		assert.Contains(t, p.getReason(), "RootCoord is not healthy")
+
+		cfg.DegradedStart = false
+		p = &stateRecordingProxy{}
+		s = &Server{ctx: ctx, proxy: p}
+		err = s.waitForCoord(availableAfter(math.MaxInt32), "DataCoord", false)
+		assert.Error(t, err)
+		assert.Equal(t, internalpb.StateCode_Abnormal, p.state)
+		assert.Contains(t, p.getReason(), "DataCoord is not healthy")
+	})
+
+	t.Run("degraded start", func(t *testing.T) {
+		cfg.DependencyWaitTimeout = 10 * time.Millisecond
+		cfg.DegradedStart = true
+		p := &stateRecordingProxy{}
+		s := &Server{ctx: ctx, proxy: p}
+		err := s.waitForCoord(availableAfter(50), "QueryCoord", false)
+		assert.NoError(t, err)
+		assert.NotEqual(t, internalpb.StateCode_Abnormal, p.state)
+		assert.Contains(t, p.getReason(), "degraded start")
+
+		assert.Eventually(t, func() bool {
+			return p.getReason() == ""
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -68,6 +68,12 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 	return client, nil
 }
 
+// SetCompression sets the registered grpc compressor of the requests to QueryNode, the results are compressed
+// with the same compressor. An empty name disables compression.
+func (c *Client) SetCompression(name string) {
+	c.grpcClient.SetCompression(name)
+}
+
 // Init initializes QueryNode's grpc client.
 func (c *Client) Init() error {
 	return nil
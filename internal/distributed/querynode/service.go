@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	qn "github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -89,6 +90,7 @@ func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error)
 // init initializes QueryNode's grpc service.
 func (s *Server) init() error {
 	Params.InitOnce(typeutil.QueryNodeRole)
+	compressor.SetGrpcCompressionMinSize(Params.CompressionMinSize)
 
 	if !funcutil.CheckPortAvailable(Params.Port) {
 		Params.Port = funcutil.GetAvailablePort()
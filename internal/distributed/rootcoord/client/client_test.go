@@ -140,6 +140,10 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.AlterAlias(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AlterCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.Import(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -208,6 +212,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListPolicy(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CreateDatabase(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropDatabase(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDatabases(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.ShowConfigurations(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -338,6 +354,10 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.AlterAlias(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AlterCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.Import(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -410,6 +430,18 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListPolicy(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CreateDatabase(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropDatabase(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDatabases(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 
 	// clean up
 	err = client.Stop()
@@ -728,6 +728,7 @@ func (i *IndexCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeInd
 			CollectionID: index.CollectionID,
 			FieldID:      index.FieldID,
 			IndexName:    index.IndexName,
+			IndexID:      index.IndexID,
 			TypeParams:   index.TypeParams,
 			IndexParams:  index.IndexParams,
 		})
@@ -37,7 +37,20 @@ func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain
 	}
 }
 
+// errDatabaseNotSupported is returned for the databases other than the default one, the tables don't record the
+// database of the collections and aliases.
+func errDatabaseNotSupported(dbName string) error {
+	return fmt.Errorf("database %s isn't supported by the mysql meta store", dbName)
+}
+
+func isDefaultDatabase(dbName string) bool {
+	return dbName == "" || dbName == util.DefaultDBName
+}
+
 func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Collection, ts typeutil.Timestamp) error {
+	if !isDefaultDatabase(collection.DBName) {
+		return errDatabaseNotSupported(collection.DBName)
+	}
 	tenantID := contextutil.TenantID(ctx)
 
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
@@ -212,7 +225,11 @@ func (tc *Catalog) populateCollection(ctx context.Context, collectionID typeutil
 	return mCollection, nil
 }
 
-func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (tc *Catalog) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+	if !isDefaultDatabase(dbName) {
+		return nil, common.NewKeyNotExistError(fmt.Sprintf("%s, at timestamp = %d", collectionName, ts))
+	}
+
 	tenantID := contextutil.TenantID(ctx)
 
 	// Since collection name will not change for different ts
@@ -231,7 +248,7 @@ func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 // [collection3, t3, is_deleted=false]
 // t1, t2, t3 are the largest timestamp that less than or equal to @param ts
 // the final result will only return collection2 and collection3 since collection1 is deleted
-func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// 1. find each collection_id with latest ts <= @param ts
@@ -240,7 +257,7 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 	if len(cidTsPairs) == 0 {
-		return map[string]*model.Collection{}, nil
+		return []*model.Collection{}, nil
 	}
 
 	// 2. populate each collection
@@ -266,12 +283,7 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, err
 	}
 
-	r := map[string]*model.Collection{}
-	for _, c := range collections {
-		r[c.Name] = c
-	}
-
-	return r, nil
+	return collections, nil
 }
 
 func (tc *Catalog) CollectionExists(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool {
@@ -487,6 +499,9 @@ func (tc *Catalog) AlterPartition(ctx context.Context, oldPart *model.Partition,
 }
 
 func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
+	if !isDefaultDatabase(alias.DBName) {
+		return errDatabaseNotSupported(alias.DBName)
+	}
 	tenantID := contextutil.TenantID(ctx)
 
 	collAlias := &dbmodel.CollectionAlias{
@@ -504,7 +519,10 @@ func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 	return nil
 }
 
-func (tc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
+func (tc *Catalog) DropAlias(ctx context.Context, dbName string, alias string, ts typeutil.Timestamp) error {
+	if !isDefaultDatabase(dbName) {
+		return errDatabaseNotSupported(dbName)
+	}
 	tenantID := contextutil.TenantID(ctx)
 
 	collectionID, err := tc.metaDomain.CollAliasDb(ctx).GetCollectionIDByAlias(tenantID, alias, ts)
@@ -571,6 +589,19 @@ func (tc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*m
 	return r, nil
 }
 
+func (tc *Catalog) CreateDatabase(ctx context.Context, db *model.Database) error {
+	return errDatabaseNotSupported(db.Name)
+}
+
+func (tc *Catalog) DropDatabase(ctx context.Context, dbName string) error {
+	return errDatabaseNotSupported(dbName)
+}
+
+// ListDatabases returns no database, only the default one is supported by the mysql meta store.
+func (tc *Catalog) ListDatabases(ctx context.Context) ([]*model.Database, error) {
+	return []*model.Database{}, nil
+}
+
 func (tc *Catalog) GetCredential(ctx context.Context, username string) (*model.Credential, error) {
 	tenantID := contextutil.TenantID(ctx)
 
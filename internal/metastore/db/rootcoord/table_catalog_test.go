@@ -450,7 +450,7 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 	indexDbMock.On("Get", tenantID, collID1).Return(indexes, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, "", collName1, ts)
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, coll.TenantID, res.TenantID)
@@ -472,7 +472,7 @@ func TestTableCatalog_GetCollectionByName_SelectCollIDError(t *testing.T) {
 	collDbMock.On("GetCollectionIDByName", tenantID, collName1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, "", collName1, ts)
 	require.Nil(t, res)
 	require.Error(t, gotErr)
 }
@@ -536,17 +536,17 @@ func TestTableCatalog_ListCollections(t *testing.T) {
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, 1, len(res))
-	require.Equal(t, coll.TenantID, res[coll.CollectionName].TenantID)
-	require.Equal(t, coll.CollectionID, res[coll.CollectionName].CollectionID)
-	require.Equal(t, coll.CollectionName, res[coll.CollectionName].Name)
-	require.Equal(t, coll.AutoID, res[coll.CollectionName].AutoID)
-	require.Equal(t, coll.Ts, res[coll.CollectionName].CreateTime)
-	require.Empty(t, res[coll.CollectionName].StartPositions)
+	require.Equal(t, coll.TenantID, res[0].TenantID)
+	require.Equal(t, coll.CollectionID, res[0].CollectionID)
+	require.Equal(t, coll.CollectionName, res[0].Name)
+	require.Equal(t, coll.AutoID, res[0].AutoID)
+	require.Equal(t, coll.Ts, res[0].CreateTime)
+	require.Empty(t, res[0].StartPositions)
 	// partitions/fields/channels
-	require.NotEmpty(t, res[coll.CollectionName].Partitions)
-	require.NotEmpty(t, res[coll.CollectionName].Fields)
-	require.NotEmpty(t, res[coll.CollectionName].VirtualChannelNames)
-	require.NotEmpty(t, res[coll.CollectionName].PhysicalChannelNames)
+	require.NotEmpty(t, res[0].Partitions)
+	require.NotEmpty(t, res[0].Fields)
+	require.NotEmpty(t, res[0].VirtualChannelNames)
+	require.NotEmpty(t, res[0].PhysicalChannelNames)
 }
 
 func TestTableCatalog_CollectionExists(t *testing.T) {
@@ -968,7 +968,7 @@ func TestTableCatalog_DropAlias_TsNot0(t *testing.T) {
 	aliasDbMock.On("Insert", mock.Anything).Return(nil).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, "", collAlias1, ts)
 	require.NoError(t, gotErr)
 }
 
@@ -978,7 +978,7 @@ func TestTableCatalog_DropAlias_TsNot0_SelectCollectionIDByAliasError(t *testing
 	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, "", collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
@@ -989,7 +989,7 @@ func TestTableCatalog_DropAlias_TsNot0_InsertIndexError(t *testing.T) {
 	aliasDbMock.On("Insert", mock.Anything).Return(errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, "", collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
@@ -2011,3 +2011,24 @@ func TestTableCatalog_ListUserRole_Error(t *testing.T) {
 	_, err := mockCatalog.ListUserRole(ctx, tenantID)
 	require.Error(t, err)
 }
+
+func TestTableCatalog_Database(t *testing.T) {
+	err := mockCatalog.CreateDatabase(ctx, &model.Database{Name: "db"})
+	require.Error(t, err)
+
+	err = mockCatalog.DropDatabase(ctx, "db")
+	require.Error(t, err)
+
+	dbs, err := mockCatalog.ListDatabases(ctx)
+	require.NoError(t, err)
+	require.Empty(t, dbs)
+
+	_, err = mockCatalog.GetCollectionByName(ctx, "db", collName1, ts)
+	require.Error(t, err)
+
+	err = mockCatalog.CreateAlias(ctx, &model.Alias{DBName: "db", Name: collAlias1, CollectionID: collID1}, ts)
+	require.Error(t, err)
+
+	err = mockCatalog.DropAlias(ctx, "db", collAlias1, ts)
+	require.Error(t, err)
+}
@@ -33,6 +33,8 @@ const (
 // prefix/partitions/collection_id/partition_id		-> PartitionInfo
 // prefix/aliases/alias_name						-> AliasInfo
 // prefix/fields/collection_id/field_id				-> FieldSchema
+// prefix/database/db-info/db_name					-> DatabaseInfo
+// prefix/database/aliases/db_name/alias_name		-> AliasInfo
 type Catalog struct {
 	Txn      kv.TxnKV
 	Snapshot kv.SnapShotKV
@@ -58,8 +60,28 @@ func buildFieldKey(collectionID typeutil.UniqueID, fieldID int64) string {
 	return fmt.Sprintf("%s/%d", buildFieldPrefix(collectionID), fieldID)
 }
 
-func buildAliasKey(aliasName string) string {
-	return fmt.Sprintf("%s/%s", AliasMetaPrefix, aliasName)
+// isDefaultDatabase returns whether dbName is the default database, the collections and aliases created before
+// databases are supported have an empty database name.
+func isDefaultDatabase(dbName string) bool {
+	return dbName == "" || dbName == util.DefaultDBName
+}
+
+// buildAliasKey returns the key of the alias, the keys of the aliases in the default database are kept as they are
+// for compatibility.
+func buildAliasKey(dbName string, aliasName string) string {
+	if isDefaultDatabase(dbName) {
+		return fmt.Sprintf("%s/%s", AliasMetaPrefix, aliasName)
+	}
+	return fmt.Sprintf("%s/%s/%s", DatabaseAliasMetaPrefix, dbName, aliasName)
+}
+
+// sameDatabase returns whether the database names refer to the same database.
+func sameDatabase(dbName1 string, dbName2 string) bool {
+	return dbName1 == dbName2 || isDefaultDatabase(dbName1) && isDefaultDatabase(dbName2)
+}
+
+func buildDatabaseKey(dbName string) string {
+	return fmt.Sprintf("%s/%s", DatabaseInfoPrefix, dbName)
 }
 
 func buildKvs(keys, values []string) (map[string]string, error) {
@@ -237,15 +259,18 @@ func (kc *Catalog) CreatePartition(ctx context.Context, partition *model.Partiti
 }
 
 func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
-	oldKBefore210 := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias.Name)
-	k := buildAliasKey(alias.Name)
+	k := buildAliasKey(alias.DBName, alias.Name)
 	aliasInfo := model.MarshalAliasModel(alias)
 	v, err := proto.Marshal(aliasInfo)
 	if err != nil {
 		return err
 	}
 	kvs := map[string]string{k: string(v)}
-	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(kvs, []string{oldKBefore210}, ts)
+	var removals []string
+	if isDefaultDatabase(alias.DBName) {
+		removals = append(removals, fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias.Name))
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(kvs, removals, ts)
 }
 
 func (kc *Catalog) CreateCredential(ctx context.Context, credential *model.Credential) error {
@@ -369,9 +394,12 @@ func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Col
 
 	var delMetakeysSnap []string
 	for _, alias := range collectionInfo.Aliases {
-		delMetakeysSnap = append(delMetakeysSnap,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		delMetakeysSnap = append(delMetakeysSnap, buildAliasKey(collectionInfo.DBName, alias))
+		if isDefaultDatabase(collectionInfo.DBName) {
+			delMetakeysSnap = append(delMetakeysSnap,
+				fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
+			)
+		}
 	}
 	delMetakeysSnap = append(delMetakeysSnap, buildPartitionPrefix(collectionInfo.CollectionID))
 	delMetakeysSnap = append(delMetakeysSnap, buildFieldPrefix(collectionInfo.CollectionID))
@@ -402,6 +430,7 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
 	key := buildCollectionKey(oldColl.CollectionID)
 	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
 	if err != nil {
@@ -493,13 +522,15 @@ func (kc *Catalog) DropCredential(ctx context.Context, username string) error {
 	return nil
 }
 
-func (kc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
-	oldKBefore210 := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
-	k := buildAliasKey(alias)
-	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k, oldKBefore210}, ts)
+func (kc *Catalog) DropAlias(ctx context.Context, dbName string, alias string, ts typeutil.Timestamp) error {
+	removals := []string{buildAliasKey(dbName, alias)}
+	if isDefaultDatabase(dbName) {
+		removals = append(removals, fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias))
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, removals, ts)
 }
 
-func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (kc *Catalog) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Warn("get collection meta fail", zap.String("collectionName", collectionName), zap.Error(err))
@@ -513,16 +544,16 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 			log.Warn("get collection meta unmarshal fail", zap.String("collectionName", collectionName), zap.Error(err))
 			continue
 		}
-		if colMeta.Schema.Name == collectionName {
+		if colMeta.Schema.Name == collectionName && sameDatabase(colMeta.GetDbName(), dbName) {
 			// compatibility handled by kc.GetCollectionByID.
 			return kc.GetCollectionByID(ctx, colMeta.GetID(), ts)
 		}
 	}
 
-	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
+	return nil, common.NewKeyNotExistError(fmt.Sprintf("%s, at timestamp = %d", collectionName, ts))
 }
 
-func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) ([]*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Error("get collections meta fail",
@@ -532,7 +563,7 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		return nil, nil
 	}
 
-	colls := make(map[string]*model.Collection)
+	colls := make([]*model.Collection, 0, len(vals))
 	for _, val := range vals {
 		collMeta := pb.CollectionInfo{}
 		err := proto.Unmarshal([]byte(val), &collMeta)
@@ -544,7 +575,7 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 		if err != nil {
 			return nil, err
 		}
-		colls[collMeta.Schema.Name] = collection
+		colls = append(colls, collection)
 	}
 
 	return colls, nil
@@ -577,6 +608,11 @@ func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestam
 	if err != nil {
 		return nil, err
 	}
+	_, dbValues, err := kc.Snapshot.LoadWithPrefix(DatabaseAliasMetaPrefix, ts)
+	if err != nil {
+		return nil, err
+	}
+	values = append(values, dbValues...)
 	// aliases after 210 stored by AliasInfo.
 	aliases := make([]*model.Alias, 0, len(values))
 	for _, value := range values {
@@ -586,6 +622,7 @@ func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestam
 			return nil, err
 		}
 		aliases = append(aliases, &model.Alias{
+			DBName:       info.GetDbName(),
 			Name:         info.GetAliasName(),
 			CollectionID: info.GetCollectionId(),
 			CreatedTime:  info.GetCreatedTime(),
@@ -607,6 +644,47 @@ func (kc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*m
 	return aliases, nil
 }
 
+func (kc *Catalog) CreateDatabase(ctx context.Context, db *model.Database) error {
+	k := buildDatabaseKey(db.Name)
+	v, err := proto.Marshal(model.MarshalDatabaseModel(db))
+	if err != nil {
+		log.Error("create database marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	if err := kc.Txn.Save(k, string(v)); err != nil {
+		log.Error("create database persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) DropDatabase(ctx context.Context, dbName string) error {
+	k := buildDatabaseKey(dbName)
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Error("drop database update meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListDatabases lists the databases created, the default database isn't included since it's never persisted.
+func (kc *Catalog) ListDatabases(ctx context.Context) ([]*model.Database, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(DatabaseInfoPrefix)
+	if err != nil {
+		log.Error("list databases fail", zap.String("prefix", DatabaseInfoPrefix), zap.Error(err))
+		return nil, err
+	}
+	dbs := make([]*model.Database, 0, len(values))
+	for _, value := range values {
+		info := &pb.DatabaseInfo{}
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, model.UnmarshalDatabaseModel(info))
+	}
+	return dbs, nil
+}
+
 func (kc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	keys, _, err := kc.Txn.LoadWithPrefix(CredentialPrefix)
 	if err != nil {
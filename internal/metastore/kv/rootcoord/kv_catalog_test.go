@@ -11,6 +11,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/golang/protobuf/proto"
@@ -528,14 +529,27 @@ func TestCatalog_DropAliasV2(t *testing.T) {
 
 	kc := Catalog{Snapshot: snapshot}
 
-	err := kc.DropAlias(ctx, "alias", 0)
+	err := kc.DropAlias(ctx, "", "alias", 0)
 	assert.Error(t, err)
 
+	var removed []string
 	snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		removed = removals
 		return nil
 	}
-	err = kc.DropAlias(ctx, "alias", 0)
+	err = kc.DropAlias(ctx, "", "alias", 0)
 	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{AliasMetaPrefix + "/alias", CollectionAliasMetaPrefix + "/alias"}, removed)
+
+	err = kc.DropAlias(ctx, "db", "alias", 0)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{DatabaseAliasMetaPrefix + "/db/alias"}, removed)
+}
+
+func Test_buildAliasKey(t *testing.T) {
+	assert.Equal(t, AliasMetaPrefix+"/alias", buildAliasKey("", "alias"))
+	assert.Equal(t, AliasMetaPrefix+"/alias", buildAliasKey(util.DefaultDBName, "alias"))
+	assert.Equal(t, DatabaseAliasMetaPrefix+"/db/alias", buildAliasKey("db", "alias"))
 }
 
 func TestCatalog_listAliasesBefore210(t *testing.T) {
@@ -624,8 +638,15 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 		value, err := proto.Marshal(coll)
 		assert.NoError(t, err)
 
+		dbAlias := &pb.AliasInfo{CollectionId: 101, DbName: "db"}
+		dbValue, err := proto.Marshal(dbAlias)
+		assert.NoError(t, err)
+
 		snapshot := kv.NewMockSnapshotKV()
 		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			if key == DatabaseAliasMetaPrefix {
+				return []string{"key1"}, []string{string(dbValue)}, nil
+			}
 			return []string{"key"}, []string{string(value)}, nil
 		}
 
@@ -633,8 +654,11 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 
 		got, err := kc.listAliasesAfter210(ctx, 0)
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(got))
+		assert.Equal(t, 2, len(got))
 		assert.Equal(t, int64(100), got[0].CollectionID)
+		assert.Equal(t, "", got[0].DBName)
+		assert.Equal(t, int64(101), got[1].CollectionID)
+		assert.Equal(t, "db", got[1].DBName)
 	})
 }
 
@@ -690,6 +714,9 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 			if key == AliasMetaPrefix {
 				return []string{"key1"}, []string{string(value2)}, nil
 			}
+			if key == DatabaseAliasMetaPrefix {
+				return nil, nil, nil
+			}
 			return []string{"key"}, []string{string(value)}, nil
 		}
 
@@ -1084,3 +1111,136 @@ func TestCatalog_DropCollection(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestCatalog_GetCollectionByNameWithDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	newCollection := func(id int64, dbName string) *pb.CollectionInfo {
+		return &pb.CollectionInfo{
+			ID:           id,
+			DbName:       dbName,
+			Schema:       &schemapb.CollectionSchema{Name: "coll", Fields: []*schemapb.FieldSchema{{FieldID: 100}}},
+			PartitionIDs: []int64{1},
+			// the partitions of the deprecated fields are unmarshalled as well
+			PartitionNames:             []string{"_default"},
+			PartitionCreatedTimestamps: []uint64{0},
+		}
+	}
+	colls := map[string]*pb.CollectionInfo{
+		buildCollectionKey(100): newCollection(100, ""),
+		buildCollectionKey(101): newCollection(101, "db"),
+	}
+
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+		var keys, values []string
+		for k, coll := range colls {
+			value, err := proto.Marshal(coll)
+			assert.NoError(t, err)
+			keys = append(keys, k)
+			values = append(values, string(value))
+		}
+		return keys, values, nil
+	}
+	snapshot.LoadFunc = func(key string, ts typeutil.Timestamp) (string, error) {
+		coll, ok := colls[key]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		value, err := proto.Marshal(coll)
+		return string(value), err
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	got, err := kc.GetCollectionByName(ctx, "", "coll", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), got.CollectionID)
+
+	got, err = kc.GetCollectionByName(ctx, util.DefaultDBName, "coll", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), got.CollectionID)
+
+	got, err = kc.GetCollectionByName(ctx, "db", "coll", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), got.CollectionID)
+	assert.Equal(t, "db", got.DBName)
+
+	_, err = kc.GetCollectionByName(ctx, "db2", "coll", 0)
+	assert.Error(t, err)
+}
+
+func TestCatalog_Database(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create database", func(t *testing.T) {
+		txn := kv.NewMockTxnKV()
+		saved := make(map[string]string)
+		txn.SaveF = func(key, value string) error {
+			saved[key] = value
+			return nil
+		}
+		kc := Catalog{Txn: txn}
+
+		err := kc.CreateDatabase(ctx, &model.Database{Name: "db", CreatedTime: 100})
+		assert.NoError(t, err)
+		value, ok := saved[buildDatabaseKey("db")]
+		assert.True(t, ok)
+		info := &pb.DatabaseInfo{}
+		assert.NoError(t, proto.Unmarshal([]byte(value), info))
+		assert.Equal(t, "db", info.GetName())
+		assert.Equal(t, uint64(100), info.GetCreatedTime())
+
+		txn.SaveF = func(key, value string) error {
+			return errors.New("mock")
+		}
+		err = kc.CreateDatabase(ctx, &model.Database{Name: "db"})
+		assert.Error(t, err)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		txn := kv.NewMockTxnKV()
+		var removed string
+		txn.RemoveF = func(key string) error {
+			removed = key
+			return nil
+		}
+		kc := Catalog{Txn: txn}
+
+		err := kc.DropDatabase(ctx, "db")
+		assert.NoError(t, err)
+		assert.Equal(t, buildDatabaseKey("db"), removed)
+
+		txn.RemoveF = func(key string) error {
+			return errors.New("mock")
+		}
+		err = kc.DropDatabase(ctx, "db")
+		assert.Error(t, err)
+	})
+
+	t.Run("list databases", func(t *testing.T) {
+		value, err := proto.Marshal(&pb.DatabaseInfo{Name: "db", CreatedTime: 100})
+		assert.NoError(t, err)
+		txn := &MockedTxnKV{loadWithPrefixFn: func(key string) ([]string, []string, error) {
+			assert.Equal(t, DatabaseInfoPrefix, key)
+			return []string{buildDatabaseKey("db")}, []string{string(value)}, nil
+		}}
+		kc := Catalog{Txn: txn}
+
+		dbs, err := kc.ListDatabases(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Database{{Name: "db", CreatedTime: 100}}, dbs)
+
+		txn.loadWithPrefixFn = func(key string) ([]string, []string, error) {
+			return []string{"key"}, []string{"not in pb format"}, nil
+		}
+		_, err = kc.ListDatabases(ctx)
+		assert.Error(t, err)
+
+		txn.loadWithPrefixFn = func(key string) ([]string, []string, error) {
+			return nil, nil, errors.New("mock")
+		}
+		_, err = kc.ListDatabases(ctx)
+		assert.Error(t, err)
+	})
+}
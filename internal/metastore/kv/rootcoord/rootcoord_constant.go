@@ -14,6 +14,13 @@ const (
 	// CollectionAliasMetaPrefix prefix for collection alias meta
 	CollectionAliasMetaPrefix = ComponentPrefix + "/collection-alias"
 
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+	// DatabaseInfoPrefix prefix for the info of the databases except the default one
+	DatabaseInfoPrefix = DatabaseMetaPrefix + "/db-info"
+	// DatabaseAliasMetaPrefix prefix for the aliases of the databases except the default one
+	DatabaseAliasMetaPrefix = DatabaseMetaPrefix + "/aliases"
+
 	// CommonCredentialPrefix subpath for common credential
 	/* #nosec G101 */
 	CommonCredentialPrefix = "/credential"
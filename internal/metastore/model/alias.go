@@ -3,6 +3,7 @@ package model
 import pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 
 type Alias struct {
+	DBName       string
 	Name         string
 	CollectionID int64
 	CreatedTime  uint64
@@ -15,6 +16,7 @@ func (a Alias) Available() bool {
 
 func (a Alias) Clone() *Alias {
 	return &Alias{
+		DBName:       a.DBName,
 		Name:         a.Name,
 		CollectionID: a.CollectionID,
 		CreatedTime:  a.CreatedTime,
@@ -23,12 +25,14 @@ func (a Alias) Clone() *Alias {
 }
 
 func (a Alias) Equal(other Alias) bool {
-	return a.Name == other.Name &&
+	return a.DBName == other.DBName &&
+		a.Name == other.Name &&
 		a.CollectionID == other.CollectionID
 }
 
 func MarshalAliasModel(alias *Alias) *pb.AliasInfo {
 	return &pb.AliasInfo{
+		DbName:       alias.DBName,
 		AliasName:    alias.Name,
 		CollectionId: alias.CollectionID,
 		CreatedTime:  alias.CreatedTime,
@@ -38,6 +42,7 @@ func MarshalAliasModel(alias *Alias) *pb.AliasInfo {
 
 func UnmarshalAliasModel(info *pb.AliasInfo) *Alias {
 	return &Alias{
+		DBName:       info.GetDbName(),
 		Name:         info.GetAliasName(),
 		CollectionID: info.GetCollectionId(),
 		CreatedTime:  info.GetCreatedTime(),
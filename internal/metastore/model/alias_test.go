@@ -80,6 +80,7 @@ func TestAlias_Clone(t *testing.T) {
 
 func TestAlias_Codec(t *testing.T) {
 	alias := &Alias{
+		DBName:       "db",
 		Name:         "alias",
 		CollectionID: 101,
 		CreatedTime:  10000,
@@ -88,4 +89,5 @@ func TestAlias_Codec(t *testing.T) {
 	aliasPb := MarshalAliasModel(alias)
 	aliasFromPb := UnmarshalAliasModel(aliasPb)
 	assert.True(t, aliasFromPb.Equal(*alias))
+	assert.Equal(t, "db", aliasFromPb.DBName)
 }
@@ -9,6 +9,7 @@ import (
 
 type Collection struct {
 	TenantID             string
+	DBName               string
 	CollectionID         int64
 	Partitions           []*Partition
 	Name                 string
@@ -24,6 +25,7 @@ type Collection struct {
 	Aliases              []string          // TODO: deprecate this.
 	Extra                map[string]string // deprecated.
 	State                pb.CollectionState
+	Properties           []*commonpb.KeyValuePair
 }
 
 func (c Collection) Available() bool {
@@ -33,6 +35,7 @@ func (c Collection) Available() bool {
 func (c Collection) Clone() *Collection {
 	return &Collection{
 		TenantID:             c.TenantID,
+		DBName:               c.DBName,
 		CollectionID:         c.CollectionID,
 		Name:                 c.Name,
 		Description:          c.Description,
@@ -48,11 +51,13 @@ func (c Collection) Clone() *Collection {
 		Aliases:              common.CloneStringList(c.Aliases),
 		Extra:                common.CloneStr2Str(c.Extra),
 		State:                c.State,
+		Properties:           common.CloneKeyValuePairs(c.Properties),
 	}
 }
 
 func (c Collection) Equal(other Collection) bool {
 	return c.TenantID == other.TenantID &&
+		c.DBName == other.DBName &&
 		CheckPartitionsEqual(c.Partitions, other.Partitions) &&
 		c.Name == other.Name &&
 		c.Description == other.Description &&
@@ -86,6 +91,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 	}
 
 	return &Collection{
+		DBName:               coll.DbName,
 		CollectionID:         coll.ID,
 		Name:                 coll.Schema.Name,
 		Description:          coll.Schema.Description,
@@ -99,6 +105,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		CreateTime:           coll.CreateTime,
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
+		Properties:           coll.Properties,
 	}
 }
 
@@ -125,6 +132,7 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 	}
 
 	return &pb.CollectionInfo{
+		DbName:               coll.DBName,
 		ID:                   coll.CollectionID,
 		Schema:               collSchema,
 		CreateTime:           coll.CreateTime,
@@ -134,5 +142,6 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
+		Properties:           coll.Properties,
 	}
 }
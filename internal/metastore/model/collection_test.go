@@ -13,6 +13,7 @@ import (
 var (
 	colID      int64 = 1
 	colName          = "c"
+	dbName           = "db"
 	fieldID    int64 = 101
 	fieldName        = "field110"
 	partID     int64 = 20
@@ -24,6 +25,12 @@ var (
 			Value: "field110-v1",
 		},
 	}
+	colProperties = []*commonpb.KeyValuePair{
+		{
+			Key:   "k1",
+			Value: "v1",
+		},
+	}
 	startPositions = []*commonpb.KeyDataPair{
 		{
 			Key:  "k1",
@@ -33,6 +40,7 @@ var (
 
 	colModel = &Collection{
 		TenantID:             tenantID,
+		DBName:               dbName,
 		CollectionID:         colID,
 		Name:                 colName,
 		AutoID:               false,
@@ -44,6 +52,7 @@ var (
 		CreateTime:           1,
 		StartPositions:       startPositions,
 		ConsistencyLevel:     commonpb.ConsistencyLevel_Strong,
+		Properties:           colProperties,
 		Partitions: []*Partition{
 			{
 				PartitionID:               partID,
@@ -54,7 +63,8 @@ var (
 	}
 
 	deprecatedColPb = &pb.CollectionInfo{
-		ID: colID,
+		DbName: dbName,
+		ID:     colID,
 		Schema: &schemapb.CollectionSchema{
 			Name:        colName,
 			Description: "none",
@@ -76,6 +86,7 @@ var (
 		ShardsNum:            1,
 		StartPositions:       startPositions,
 		ConsistencyLevel:     commonpb.ConsistencyLevel_Strong,
+		Properties:           colProperties,
 	}
 )
 
@@ -89,4 +100,9 @@ func TestUnmarshalCollectionModel(t *testing.T) {
 
 func TestMarshalCollectionModel(t *testing.T) {
 	assert.Nil(t, MarshalCollectionModel(nil))
+
+	ret := MarshalCollectionModel(colModel)
+	assert.Equal(t, dbName, ret.GetDbName())
+	assert.Equal(t, colProperties, ret.GetProperties())
+	assert.Equal(t, colProperties, colModel.Clone().Properties)
 }
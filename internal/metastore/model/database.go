@@ -0,0 +1,35 @@
+package model
+
+import pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+
+type Database struct {
+	Name        string
+	CreatedTime uint64
+}
+
+func (d Database) Clone() *Database {
+	return &Database{
+		Name:        d.Name,
+		CreatedTime: d.CreatedTime,
+	}
+}
+
+func MarshalDatabaseModel(db *Database) *pb.DatabaseInfo {
+	if db == nil {
+		return nil
+	}
+	return &pb.DatabaseInfo{
+		Name:        db.Name,
+		CreatedTime: db.CreatedTime,
+	}
+}
+
+func UnmarshalDatabaseModel(info *pb.DatabaseInfo) *Database {
+	if info == nil {
+		return nil
+	}
+	return &Database{
+		Name:        info.GetName(),
+		CreatedTime: info.GetCreatedTime(),
+	}
+}
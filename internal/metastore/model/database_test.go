@@ -0,0 +1,19 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabase_Codec(t *testing.T) {
+	db := &Database{
+		Name:        "db",
+		CreatedTime: 10000,
+	}
+	assert.Equal(t, db, UnmarshalDatabaseModel(MarshalDatabaseModel(db)))
+	assert.Equal(t, db, db.Clone())
+
+	assert.Nil(t, MarshalDatabaseModel(nil))
+	assert.Nil(t, UnmarshalDatabaseModel(nil))
+}
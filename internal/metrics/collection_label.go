@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+	"sync"
+)
+
+const (
+	// maxCollectionLabelValues is the max number of distinct collections labeled in per-collection metrics.
+	maxCollectionLabelValues = 256
+
+	// OtherCollectionLabel is the collection label of the collections beyond maxCollectionLabelValues.
+	OtherCollectionLabel = "other"
+)
+
+// collectionLabelGuard bounds the cardinality of the collection label, collections beyond the limit
+// share OtherCollectionLabel so that a large number of collections can't blow up the time series.
+type collectionLabelGuard struct {
+	mu     sync.Mutex
+	max    int
+	labels map[int64]string
+}
+
+func newCollectionLabelGuard(max int) *collectionLabelGuard {
+	return &collectionLabelGuard{
+		max:    max,
+		labels: make(map[int64]string),
+	}
+}
+
+func (g *collectionLabelGuard) label(collectionID int64) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if label, ok := g.labels[collectionID]; ok {
+		return label
+	}
+	if len(g.labels) >= g.max {
+		return OtherCollectionLabel
+	}
+	label := strconv.FormatInt(collectionID, 10)
+	g.labels[collectionID] = label
+	return label
+}
+
+// remove releases the label of the collection, it returns false if the collection isn't labeled.
+func (g *collectionLabelGuard) remove(collectionID int64) (string, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	label, ok := g.labels[collectionID]
+	if ok {
+		delete(g.labels, collectionID)
+	}
+	return label, ok
+}
+
+var proxyCollectionLabels = newCollectionLabelGuard(maxCollectionLabelValues)
+
+// ProxyCollectionLabel returns the collection label used in per-collection proxy metrics.
+func ProxyCollectionLabel(collectionID int64) string {
+	return proxyCollectionLabels.label(collectionID)
+}
+
+// CleanupProxyCollectionMetrics removes the per-collection proxy metrics of the collection,
+// it should be called once the collection is dropped.
+func CleanupProxyCollectionMetrics(nodeID int64, collectionID int64) {
+	label, ok := proxyCollectionLabels.remove(collectionID)
+	if !ok {
+		return
+	}
+	nodeIDStr := strconv.FormatInt(nodeID, 10)
+	ProxySearchNQ.DeleteLabelValues(nodeIDStr, label)
+	ProxySearchTopK.DeleteLabelValues(nodeIDStr, label)
+	for _, queryType := range []string{SearchLabel, QueryLabel} {
+		ProxySearchResultBytes.DeleteLabelValues(nodeIDStr, queryType, label)
+		ProxyReduceResultDuration.DeleteLabelValues(nodeIDStr, queryType, label)
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionLabelGuard(t *testing.T) {
+	g := newCollectionLabelGuard(2)
+	assert.Equal(t, "1", g.label(1))
+	assert.Equal(t, "2", g.label(2))
+	assert.Equal(t, OtherCollectionLabel, g.label(3))
+	assert.Equal(t, "1", g.label(1))
+
+	label, ok := g.remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, "1", label)
+	_, ok = g.remove(1)
+	assert.False(t, ok)
+	_, ok = g.remove(3)
+	assert.False(t, ok)
+
+	assert.Equal(t, "3", g.label(3))
+}
+
+func TestProxyCollectionMetrics(t *testing.T) {
+	r := prometheus.NewRegistry()
+	RegisterProxy(r)
+
+	const nodeID, collectionID = int64(1), int64(100)
+	nodeIDStr := "1"
+	collection := ProxyCollectionLabel(collectionID)
+	ProxySearchNQ.WithLabelValues(nodeIDStr, collection).Observe(10)
+	ProxySearchTopK.WithLabelValues(nodeIDStr, collection).Observe(100)
+	ProxySearchResultBytes.WithLabelValues(nodeIDStr, SearchLabel, collection).Observe(4096)
+	ProxyReduceResultDuration.WithLabelValues(nodeIDStr, SearchLabel, collection).Add(5)
+
+	values := gatherCollectionMetrics(t, r, collection)
+	assert.Equal(t, map[string]float64{
+		"milvus_proxy_search_nq":                 10,
+		"milvus_proxy_search_topk":               100,
+		"milvus_proxy_sq_result_bytes":           4096,
+		"milvus_proxy_sq_reduce_result_duration": 5,
+	}, values)
+
+	CleanupProxyCollectionMetrics(nodeID, collectionID)
+	assert.Empty(t, gatherCollectionMetrics(t, r, collection))
+}
+
+// gatherCollectionMetrics returns the sum of histograms and value of counters of the collection by metric name.
+func gatherCollectionMetrics(t *testing.T, r *prometheus.Registry, collection string) map[string]float64 {
+	families, err := r.Gather()
+	require.NoError(t, err)
+	ret := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == collectionIDLabelName && label.GetValue() == collection {
+					ret[family.GetName()] = metric.GetHistogram().GetSampleSum() + metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	compressorLabelName = "compressor"
+	bytesTypeLabelName  = "bytes_type"
+
+	// UncompressedBytesLabel is the bytes of the messages before compression.
+	UncompressedBytesLabel = "uncompressed"
+	// CompressedBytesLabel is the bytes of the messages sent on the wire.
+	CompressedBytesLabel = "compressed"
+)
+
+var (
+	// GrpcCompressionBytes counts the bytes of grpc messages passed through the compressors, comparing the
+	// uncompressed and compressed bytes shows the bandwidth saved.
+	GrpcCompressionBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "grpc",
+			Name:      "compression_bytes",
+			Help:      "bytes of grpc messages passed through the compressors",
+		}, []string{compressorLabelName, bytesTypeLabelName})
+)
+
+// RegisterGrpcMetrics registers grpc metrics
+func RegisterGrpcMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(GrpcCompressionBytes)
+}
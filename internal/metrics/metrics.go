@@ -50,6 +50,9 @@ const (
 	CacheHitLabel  = "hit"
 	CacheMissLabel = "miss"
 
+	ServerBusyLabel     = "server_busy"
+	ResultTooLargeLabel = "result_too_large"
+
 	UnissuedIndexTaskLabel   = "unissued"
 	InProgressIndexTaskLabel = "in-progress"
 	FinishedIndexTaskLabel   = "finished"
@@ -75,12 +78,21 @@ const (
 	rolenameLabelName        = "role_name"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
+	reasonLabelName          = "reason"
+	priorityLabelName        = "priority"
 )
 
 var (
 	// buckets involves durations in milliseconds,
 	// [1 2 4 8 16 32 64 128 256 512 1024 2048 4096 8192 16384 32768 65536 1.31072e+05]
 	buckets = prometheus.ExponentialBuckets(1, 2, 18)
+
+	// countBuckets involves counts like nq and topk,
+	// [1 2 4 8 16 32 64 128 256 512 1024 2048 4096 8192 16384]
+	countBuckets = prometheus.ExponentialBuckets(1, 2, 15)
+
+	// sizeBuckets involves sizes in bytes, from 1KB to 4GB.
+	sizeBuckets = prometheus.ExponentialBuckets(1024, 4, 12)
 )
 
 //ServeHTTP serves prometheus http service
@@ -29,14 +29,14 @@ import (
 )
 
 var (
-	// ProxySearchVectors record the number of vectors search successfully.
+	// ProxySearchVectors record the number of vectors searched, the vectors of the rejected searches are labeled as abandon.
 	ProxySearchVectors = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
 			Subsystem: typeutil.ProxyRole,
 			Name:      "search_vectors_count",
-			Help:      "counter of vectors successfully searched",
-		}, []string{nodeIDLabelName})
+			Help:      "counter of vectors searched",
+		}, []string{nodeIDLabelName, statusLabelName})
 
 	// ProxyInsertVectors record the number of vectors insert successfully.
 	ProxyInsertVectors = prometheus.NewCounterVec(
@@ -96,6 +96,25 @@ var (
 			Help:      "number of MsgStream objects per physical channel",
 		}, []string{nodeIDLabelName, channelNameLabelName})
 
+	// ProxyDmlChannelProduceLatency records the latency of producing dml messages to each physical channel.
+	ProxyDmlChannelProduceLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dml_channel_produce_latency",
+			Help:      "latency of producing dml messages to each physical channel",
+			Buckets:   buckets, // unit: ms
+		}, []string{nodeIDLabelName, channelNameLabelName})
+
+	// ProxyDmlChannelOutstandingBytes records the bytes of dml messages being produced to each physical channel.
+	ProxyDmlChannelOutstandingBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dml_channel_outstanding_bytes",
+			Help:      "bytes of dml messages being produced to each physical channel",
+		}, []string{nodeIDLabelName, channelNameLabelName})
+
 	// ProxyMutationLatency record the latency that insert successfully.
 	ProxyMutationLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -122,9 +141,55 @@ var (
 			Namespace: milvusNamespace,
 			Subsystem: typeutil.ProxyRole,
 			Name:      "cache_hit_count",
-			Help:      "count of cache hits",
+			Help:      "count of cache hits and misses",
 		}, []string{nodeIDLabelName, cacheNameLabelName, cacheStateLabelName})
 
+	// ProxyWarmedUpCollections record the number of the loaded collections warmed up in the caches at startup.
+	ProxyWarmedUpCollections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "warmed_up_collection_num",
+			Help:      "number of loaded collections whose meta and shard leaders are cached at startup",
+		}, []string{nodeIDLabelName})
+
+	// ProxyInFlightResultBytes record the size of the shard results held by the running search and query tasks.
+	ProxyInFlightResultBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "in_flight_result_bytes",
+			Help:      "size of the shard results held by the running search and query tasks",
+		}, []string{nodeIDLabelName})
+
+	// ProxyResultMemoryRejectCount record the number of the search and query tasks rejected for the result memory.
+	ProxyResultMemoryRejectCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "result_memory_reject_count",
+			Help:      "count of the search and query tasks rejected because the in-flight results are too large",
+		}, []string{nodeIDLabelName, queryTypeLabelName, reasonLabelName})
+
+	// ProxyDQLQueueLatency record the time that the search and query tasks wait in the queue by request priority.
+	ProxyDQLQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dql_queue_latency",
+			Help:      "latency of the search and query tasks waiting in the queue",
+			Buckets:   buckets,
+		}, []string{nodeIDLabelName, queryTypeLabelName, priorityLabelName})
+
+	// ProxySlowDQLCount record the number of the search and query requests slower than the threshold by request priority.
+	ProxySlowDQLCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slow_dql_count",
+			Help:      "count of the search and query requests slower than the threshold",
+		}, []string{nodeIDLabelName, queryTypeLabelName, priorityLabelName})
+
 	// ProxyUpdateCacheLatency record the time that proxy update cache when cache miss.
 	ProxyUpdateCacheLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -164,6 +229,33 @@ var (
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName})
 
+	// ProxyTSOClockSkew record the skew between the local clock of proxy and the physical time of TSO.
+	ProxyTSOClockSkew = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "tso_clock_skew",
+			Help:      "local clock minus the physical time of the allocated timestamps, in milliseconds",
+		}, []string{nodeIDLabelName})
+
+	// ProxyCachedIDs record the number of the ids cached in proxy for the rows of the inserts.
+	ProxyCachedIDs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "cached_ids",
+			Help:      "number of ids cached for the rows of inserts",
+		}, []string{nodeIDLabelName})
+
+	// ProxyBlockingIDAllocCount record the number of the id allocations waiting for rootcoord as the cached ids run out.
+	ProxyBlockingIDAllocCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "blocking_id_alloc_count",
+			Help:      "count of id allocations blocked on rootcoord as the cached ids run out",
+		}, []string{nodeIDLabelName})
+
 	// ProxyDDLFunctionCall records the number of times the function of the DDL operation was executed, like `CreateCollection`.
 	ProxyDDLFunctionCall = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -221,6 +313,24 @@ var (
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName, functionLabelName})
 
+	// ProxyAllocTimestampCount records the number of AllocTimestamp requests, which is not counted as DDL or DQL.
+	ProxyAllocTimestampCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "alloc_timestamp_req_count",
+			Help:      "count of AllocTimestamp requests",
+		}, []string{nodeIDLabelName, statusLabelName})
+
+	// ProxySessionReregisterCount records the number of re-registrations of the session after its lease is lost.
+	ProxySessionReregisterCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "session_reregister_count",
+			Help:      "count of session re-registrations after the lease is lost",
+		}, []string{nodeIDLabelName, statusLabelName})
+
 	// ProxyReceiveBytes record the received bytes of messages in Proxy
 	ProxyReceiveBytes = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -239,6 +349,45 @@ var (
 			Help:      "count of bytes sent back to sdk",
 		}, []string{nodeIDLabelName})
 
+	// ProxySearchNQ record the nq of each search request, labeled by collection.
+	ProxySearchNQ = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_nq",
+			Help:      "number of queries per search request",
+			Buckets:   countBuckets,
+		}, []string{nodeIDLabelName, collectionIDLabelName})
+
+	// ProxySearchTopK record the topk of each search request, labeled by collection.
+	ProxySearchTopK = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_topk",
+			Help:      "topk per search request",
+			Buckets:   countBuckets,
+		}, []string{nodeIDLabelName, collectionIDLabelName})
+
+	// ProxySearchResultBytes record the size of search or query result sent back to client, labeled by collection.
+	ProxySearchResultBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "sq_result_bytes",
+			Help:      "size of search or query result in bytes",
+			Buckets:   sizeBuckets,
+		}, []string{nodeIDLabelName, queryTypeLabelName, collectionIDLabelName})
+
+	// ProxyReduceResultDuration record the total time that proxy spends on reducing results, labeled by collection.
+	ProxyReduceResultDuration = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "sq_reduce_result_duration",
+			Help:      "total time in milliseconds that proxy spends on reducing results",
+		}, []string{nodeIDLabelName, queryTypeLabelName, collectionIDLabelName})
+
 	// ProxyLimiterRate records rates of rateLimiter in Proxy.
 	ProxyLimiterRate = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -260,16 +409,26 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyDecodeResultLatency)
 
 	registry.MustRegister(ProxyMsgStreamObjectsForPChan)
+	registry.MustRegister(ProxyDmlChannelProduceLatency)
+	registry.MustRegister(ProxyDmlChannelOutstandingBytes)
 
 	registry.MustRegister(ProxyMutationLatency)
 	registry.MustRegister(ProxySendMutationReqLatency)
 
 	registry.MustRegister(ProxyCacheHitCounter)
 	registry.MustRegister(ProxyUpdateCacheLatency)
+	registry.MustRegister(ProxyWarmedUpCollections)
+	registry.MustRegister(ProxyInFlightResultBytes)
+	registry.MustRegister(ProxyResultMemoryRejectCount)
+	registry.MustRegister(ProxyDQLQueueLatency)
+	registry.MustRegister(ProxySlowDQLCount)
 
 	registry.MustRegister(ProxySyncTimeTick)
 	registry.MustRegister(ProxyApplyPrimaryKeyLatency)
 	registry.MustRegister(ProxyApplyTimestampLatency)
+	registry.MustRegister(ProxyTSOClockSkew)
+	registry.MustRegister(ProxyCachedIDs)
+	registry.MustRegister(ProxyBlockingIDAllocCount)
 
 	registry.MustRegister(ProxyDDLFunctionCall)
 	registry.MustRegister(ProxyDQLFunctionCall)
@@ -277,10 +436,17 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyDDLReqLatency)
 	registry.MustRegister(ProxyDMLReqLatency)
 	registry.MustRegister(ProxyDQLReqLatency)
+	registry.MustRegister(ProxyAllocTimestampCount)
+	registry.MustRegister(ProxySessionReregisterCount)
 	registry.MustRegister(ProxyReceiveBytes)
 	registry.MustRegister(ProxyReadReqSendBytes)
 
 	registry.MustRegister(ProxyLimiterRate)
+
+	registry.MustRegister(ProxySearchNQ)
+	registry.MustRegister(ProxySearchTopK)
+	registry.MustRegister(ProxySearchResultBytes)
+	registry.MustRegister(ProxyReduceResultDuration)
 }
 
 // SetRateGaugeByRateType sets ProxyLimiterRate metrics.
@@ -73,6 +73,14 @@ const (
 	ErrorCode_SegmentNotFound               ErrorCode = 47
 	ErrorCode_ForceDeny                     ErrorCode = 48
 	ErrorCode_RateLimit                     ErrorCode = 49
+	ErrorCode_CollectionNotLoaded           ErrorCode = 50
+	ErrorCode_IndexNameDuplicated           ErrorCode = 51
+	ErrorCode_PartitionNotExists            ErrorCode = 52
+	ErrorCode_CollectionDisabled            ErrorCode = 53
+	ErrorCode_CoordUnavailable              ErrorCode = 54
+	ErrorCode_SchemaMismatch                ErrorCode = 55
+	ErrorCode_CollectionWriteBlocked        ErrorCode = 56
+	ErrorCode_DatabaseNotExist              ErrorCode = 57
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -127,6 +135,14 @@ var ErrorCode_name = map[int32]string{
 	47:   "SegmentNotFound",
 	48:   "ForceDeny",
 	49:   "RateLimit",
+	50:   "CollectionNotLoaded",
+	51:   "IndexNameDuplicated",
+	52:   "PartitionNotExists",
+	53:   "CollectionDisabled",
+	54:   "CoordUnavailable",
+	55:   "SchemaMismatch",
+	56:   "CollectionWriteBlocked",
+	57:   "DatabaseNotExist",
 	1000: "DDRequestRace",
 }
 
@@ -180,6 +196,14 @@ var ErrorCode_value = map[string]int32{
 	"SegmentNotFound":               47,
 	"ForceDeny":                     48,
 	"RateLimit":                     49,
+	"CollectionNotLoaded":           50,
+	"IndexNameDuplicated":           51,
+	"PartitionNotExists":            52,
+	"CollectionDisabled":            53,
+	"CoordUnavailable":              54,
+	"SchemaMismatch":                55,
+	"CollectionWriteBlocked":        56,
+	"DatabaseNotExist":              57,
 	"DDRequestRace":                 1000,
 }
 
@@ -315,6 +339,7 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_AlterCollection    MsgType = 111
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -392,6 +417,10 @@ const (
 	MsgType_SelectGrant            MsgType = 1607
 	MsgType_RefreshPolicyInfoCache MsgType = 1608
 	MsgType_ListPolicy             MsgType = 1609
+	// Database
+	MsgType_CreateDatabase MsgType = 1700
+	MsgType_DropDatabase   MsgType = 1701
+	MsgType_ListDatabases  MsgType = 1702
 )
 
 var MsgType_name = map[int32]string{
@@ -407,6 +436,7 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "AlterCollection",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -475,6 +505,9 @@ var MsgType_name = map[int32]string{
 	1607: "SelectGrant",
 	1608: "RefreshPolicyInfoCache",
 	1609: "ListPolicy",
+	1700: "CreateDatabase",
+	1701: "DropDatabase",
+	1702: "ListDatabases",
 }
 
 var MsgType_value = map[string]int32{
@@ -490,6 +523,7 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"AlterCollection":          111,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -558,6 +592,9 @@ var MsgType_value = map[string]int32{
 	"SelectGrant":              1607,
 	"RefreshPolicyInfoCache":   1608,
 	"ListPolicy":               1609,
+	"CreateDatabase":           1700,
+	"DropDatabase":             1701,
+	"ListDatabases":            1702,
 }
 
 func (x MsgType) String() string {
@@ -754,6 +791,9 @@ const (
 	ObjectPrivilege_PrivilegeSelectOwnership    ObjectPrivilege = 22
 	ObjectPrivilege_PrivilegeManageOwnership    ObjectPrivilege = 23
 	ObjectPrivilege_PrivilegeSelectUser         ObjectPrivilege = 24
+	ObjectPrivilege_PrivilegeCreateDatabase     ObjectPrivilege = 25
+	ObjectPrivilege_PrivilegeDropDatabase       ObjectPrivilege = 26
+	ObjectPrivilege_PrivilegeListDatabases      ObjectPrivilege = 27
 )
 
 var ObjectPrivilege_name = map[int32]string{
@@ -782,6 +822,9 @@ var ObjectPrivilege_name = map[int32]string{
 	22: "PrivilegeSelectOwnership",
 	23: "PrivilegeManageOwnership",
 	24: "PrivilegeSelectUser",
+	25: "PrivilegeCreateDatabase",
+	26: "PrivilegeDropDatabase",
+	27: "PrivilegeListDatabases",
 }
 
 var ObjectPrivilege_value = map[string]int32{
@@ -810,6 +853,9 @@ var ObjectPrivilege_value = map[string]int32{
 	"PrivilegeSelectOwnership":    22,
 	"PrivilegeManageOwnership":    23,
 	"PrivilegeSelectUser":         24,
+	"PrivilegeCreateDatabase":     25,
+	"PrivilegeDropDatabase":       26,
+	"PrivilegeListDatabases":      27,
 }
 
 func (x ObjectPrivilege) String() string {
@@ -1394,164 +1440,172 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 2539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x59, 0x73, 0x24, 0x47,
-	0xf1, 0x57, 0xcf, 0x8c, 0x8e, 0xa9, 0x19, 0x49, 0xa5, 0x92, 0x56, 0x3b, 0xde, 0xc3, 0x2b, 0xeb,
-	0x6f, 0xff, 0x59, 0x06, 0x5b, 0x6b, 0xaf, 0x23, 0x80, 0x20, 0xc2, 0x04, 0xd2, 0x8c, 0xa4, 0x55,
-	0x58, 0x17, 0x2d, 0xad, 0x4d, 0x10, 0x01, 0x1b, 0x35, 0xdd, 0xa9, 0x51, 0xed, 0x76, 0x77, 0x35,
-	0x5d, 0x35, 0x5a, 0x0d, 0x4f, 0xc6, 0x80, 0x9f, 0xc1, 0x7c, 0x01, 0x3e, 0x00, 0xf7, 0xfd, 0xc8,
-	0x8d, 0xcd, 0xf5, 0xcc, 0x0d, 0x8f, 0xf0, 0x48, 0x04, 0x87, 0xf1, 0x49, 0x64, 0x55, 0x5f, 0xa3,
-	0x5d, 0xc3, 0x03, 0x6f, 0x5d, 0xbf, 0xcc, 0xca, 0xab, 0xb2, 0x32, 0xb3, 0x9a, 0x34, 0x3d, 0x19,
-	0x86, 0x32, 0x5a, 0x89, 0x13, 0xa9, 0x25, 0x9b, 0x0f, 0x45, 0x70, 0x32, 0x50, 0x76, 0xb5, 0x62,
-	0x49, 0x17, 0x96, 0xfa, 0x52, 0xf6, 0x03, 0xb8, 0x66, 0xc0, 0xde, 0xe0, 0xe8, 0x9a, 0x0f, 0xca,
-	0x4b, 0x44, 0xac, 0x65, 0x62, 0x19, 0x97, 0x6f, 0x91, 0x89, 0x03, 0xcd, 0xf5, 0x40, 0xb1, 0xa7,
-	0x08, 0x81, 0x24, 0x91, 0xc9, 0x2d, 0x4f, 0xfa, 0xd0, 0x72, 0x96, 0x9c, 0xab, 0x33, 0xd7, 0x1f,
-	0x5c, 0xb9, 0x8f, 0xd4, 0x95, 0x75, 0x64, 0xeb, 0x48, 0x1f, 0xdc, 0x3a, 0x64, 0x9f, 0x6c, 0x91,
-	0x4c, 0x24, 0xc0, 0x95, 0x8c, 0x5a, 0x95, 0x25, 0xe7, 0x6a, 0xdd, 0x4d, 0x57, 0xcb, 0xef, 0x26,
-	0xcd, 0xa7, 0x61, 0xf8, 0x0c, 0x0f, 0x06, 0xb0, 0xcf, 0x45, 0xc2, 0x28, 0xa9, 0xde, 0x81, 0xa1,
-	0x91, 0x5f, 0x77, 0xf1, 0x93, 0x2d, 0x90, 0xf1, 0x13, 0x24, 0xa7, 0x1b, 0xed, 0x62, 0xf9, 0x49,
-	0xd2, 0x78, 0x1a, 0x86, 0x5d, 0xae, 0xf9, 0xdb, 0x6c, 0x63, 0xa4, 0xe6, 0x73, 0xcd, 0xcd, 0xae,
-	0xa6, 0x6b, 0xbe, 0x97, 0x2f, 0x91, 0xda, 0x5a, 0x20, 0x7b, 0x85, 0x48, 0xc7, 0x10, 0x53, 0x91,
-	0x27, 0x84, 0xee, 0x07, 0xdc, 0x83, 0x63, 0x19, 0xf8, 0x90, 0x18, 0x93, 0x50, 0xae, 0xe6, 0xfd,
-	0x4c, 0xae, 0xe6, 0x7d, 0xf6, 0x5e, 0x52, 0xd3, 0xc3, 0xd8, 0x5a, 0x33, 0x73, 0xfd, 0xe1, 0xfb,
-	0x46, 0xa0, 0x24, 0xe6, 0x70, 0x18, 0x83, 0x6b, 0x76, 0x60, 0x08, 0x8c, 0x22, 0xd5, 0xaa, 0x2e,
-	0x55, 0xaf, 0x36, 0xdd, 0x74, 0xb5, 0xfc, 0x91, 0x11, 0xbd, 0x9b, 0x89, 0x1c, 0xc4, 0x6c, 0x8b,
-	0x34, 0xe3, 0x02, 0x53, 0x2d, 0x67, 0xa9, 0x7a, 0xb5, 0x71, 0xfd, 0x91, 0xff, 0xa6, 0xcd, 0x18,
-	0xed, 0x8e, 0x6c, 0x5d, 0x7e, 0x8c, 0x4c, 0xae, 0xfa, 0x7e, 0x02, 0x4a, 0xb1, 0x19, 0x52, 0x11,
-	0x71, 0xea, 0x4c, 0x45, 0xc4, 0x18, 0xa3, 0x58, 0x26, 0xda, 0xf8, 0x52, 0x75, 0xcd, 0xf7, 0xf2,
-	0x8b, 0x0e, 0x99, 0xdc, 0x51, 0xfd, 0x35, 0xae, 0x80, 0xbd, 0x87, 0x4c, 0x85, 0xaa, 0x7f, 0xcb,
-	0xf8, 0x6b, 0x4f, 0xfc, 0xd2, 0x7d, 0x2d, 0xd8, 0x51, 0x7d, 0xe3, 0xe7, 0x64, 0x68, 0x3f, 0x30,
-	0xc0, 0xa1, 0xea, 0x6f, 0x75, 0x53, 0xc9, 0x76, 0xc1, 0x2e, 0x91, 0xba, 0x16, 0x21, 0x28, 0xcd,
-	0xc3, 0xb8, 0x55, 0x5d, 0x72, 0xae, 0xd6, 0xdc, 0x02, 0x60, 0x17, 0xc8, 0x94, 0x92, 0x83, 0xc4,
-	0x83, 0xad, 0x6e, 0xab, 0x66, 0xb6, 0xe5, 0xeb, 0xe5, 0xa7, 0x48, 0x7d, 0x47, 0xf5, 0x6f, 0x00,
-	0xf7, 0x21, 0x61, 0x8f, 0x93, 0x5a, 0x8f, 0x2b, 0x6b, 0x51, 0xe3, 0xed, 0x2d, 0x42, 0x0f, 0x5c,
-	0xc3, 0xb9, 0xfc, 0x51, 0xd2, 0xec, 0xee, 0x6c, 0xff, 0x0f, 0x12, 0xd0, 0x74, 0x75, 0xcc, 0x13,
-	0x7f, 0x97, 0x87, 0x59, 0x22, 0x16, 0xc0, 0xf2, 0x6b, 0x0e, 0x69, 0xee, 0x27, 0xe2, 0x44, 0x04,
-	0xd0, 0x87, 0xf5, 0x53, 0xcd, 0x3e, 0x40, 0x1a, 0xb2, 0x77, 0x1b, 0x3c, 0x5d, 0x8e, 0xdd, 0x95,
-	0xfb, 0xea, 0xd9, 0x33, 0x7c, 0x26, 0x7c, 0x44, 0xe6, 0xdf, 0x6c, 0x8f, 0xd0, 0x54, 0x42, 0x9c,
-	0x09, 0xfe, 0x8f, 0x29, 0x67, 0xc5, 0xe4, 0x46, 0xb8, 0xb3, 0x72, 0x14, 0x60, 0x6d, 0x32, 0x97,
-	0x0a, 0x8c, 0x78, 0x08, 0xb7, 0x44, 0xe4, 0xc3, 0xa9, 0x39, 0x84, 0xf1, 0x8c, 0x17, 0x5d, 0xd9,
-	0x42, 0x98, 0x3d, 0x4a, 0xd8, 0x3d, 0xbc, 0xca, 0x1c, 0xca, 0xb8, 0x4b, 0xcf, 0x30, 0xab, 0xf6,
-	0x5f, 0xa7, 0x48, 0x3d, 0xbf, 0xf3, 0xac, 0x41, 0x26, 0x0f, 0x06, 0x9e, 0x07, 0x4a, 0xd1, 0x31,
-	0x36, 0x4f, 0x66, 0x6f, 0x46, 0x70, 0x1a, 0x83, 0xa7, 0xc1, 0x37, 0x3c, 0xd4, 0x61, 0x73, 0x64,
-	0xba, 0x23, 0xa3, 0x08, 0x3c, 0xbd, 0xc1, 0x45, 0x00, 0x3e, 0xad, 0xb0, 0x05, 0x42, 0xf7, 0x21,
-	0x09, 0x85, 0x52, 0x42, 0x46, 0x5d, 0x88, 0x04, 0xf8, 0xb4, 0xca, 0xce, 0x93, 0xf9, 0x8e, 0x0c,
-	0x02, 0xf0, 0xb4, 0x90, 0xd1, 0xae, 0xd4, 0xeb, 0xa7, 0x42, 0x69, 0x45, 0x6b, 0x28, 0x76, 0x2b,
-	0x08, 0xa0, 0xcf, 0x83, 0xd5, 0xa4, 0x3f, 0x08, 0x21, 0xd2, 0x74, 0x1c, 0x65, 0xa4, 0x60, 0x57,
-	0x84, 0x10, 0xa1, 0x24, 0x3a, 0x59, 0x42, 0x8d, 0xb5, 0x18, 0x5b, 0x3a, 0xc5, 0x1e, 0x20, 0xe7,
-	0x52, 0xb4, 0xa4, 0x80, 0x87, 0x40, 0xeb, 0x6c, 0x96, 0x34, 0x52, 0xd2, 0xe1, 0xde, 0xfe, 0xd3,
-	0x94, 0x94, 0x24, 0xb8, 0xf2, 0xae, 0x0b, 0x9e, 0x4c, 0x7c, 0xda, 0x28, 0x99, 0xf0, 0x0c, 0x78,
-	0x5a, 0x26, 0x5b, 0x5d, 0xda, 0x44, 0x83, 0x53, 0xf0, 0x00, 0x78, 0xe2, 0x1d, 0xbb, 0xa0, 0x06,
-	0x81, 0xa6, 0xd3, 0x8c, 0x92, 0xe6, 0x86, 0x08, 0x60, 0x57, 0xea, 0x0d, 0x39, 0x88, 0x7c, 0x3a,
-	0xc3, 0x66, 0x08, 0xd9, 0x01, 0xcd, 0xd3, 0x08, 0xcc, 0xa2, 0xda, 0x0e, 0xf7, 0x8e, 0x21, 0x05,
-	0x28, 0x5b, 0x24, 0xac, 0xc3, 0xa3, 0x48, 0xea, 0x4e, 0x02, 0x5c, 0xc3, 0x86, 0xb9, 0xcd, 0x74,
-	0x0e, 0xcd, 0x19, 0xc1, 0x45, 0x00, 0x94, 0x15, 0xdc, 0x5d, 0x08, 0x20, 0xe7, 0x9e, 0x2f, 0xb8,
-	0x53, 0x1c, 0xb9, 0x17, 0xd0, 0xf8, 0xb5, 0x81, 0x08, 0x7c, 0x13, 0x12, 0x7b, 0x2c, 0xe7, 0xd0,
-	0xc6, 0xd4, 0xf8, 0xdd, 0xed, 0xad, 0x83, 0x43, 0xba, 0xc8, 0xce, 0x91, 0xb9, 0x14, 0xd9, 0x01,
-	0x9d, 0x08, 0xcf, 0x04, 0xef, 0x3c, 0x9a, 0xba, 0x37, 0xd0, 0x7b, 0x47, 0x3b, 0x10, 0xca, 0x64,
-	0x48, 0x5b, 0x78, 0xa0, 0x46, 0x52, 0x76, 0x44, 0xf4, 0x01, 0xd4, 0xb0, 0x1e, 0xc6, 0x7a, 0x58,
-	0x84, 0x97, 0x5e, 0x60, 0x17, 0xc9, 0xf9, 0x9b, 0xb1, 0xcf, 0x35, 0x6c, 0x85, 0x58, 0x6a, 0x0e,
-	0xb9, 0xba, 0x83, 0xee, 0x0e, 0x12, 0xa0, 0x17, 0xd9, 0x05, 0xb2, 0x38, 0x7a, 0x16, 0x79, 0xb0,
-	0x2e, 0xe1, 0x46, 0xeb, 0x6d, 0x27, 0x01, 0x1f, 0x22, 0x2d, 0x78, 0x90, 0x6d, 0xbc, 0x5c, 0x48,
-	0xbd, 0x97, 0xf8, 0x20, 0x12, 0xad, 0xe7, 0xf7, 0x12, 0xaf, 0xb0, 0x16, 0x59, 0xd8, 0x04, 0x7d,
-	0x2f, 0x65, 0x09, 0x29, 0xdb, 0x42, 0x19, 0xd2, 0x4d, 0x05, 0x89, 0xca, 0x28, 0x0f, 0x31, 0x46,
-	0x66, 0x36, 0x41, 0x23, 0x98, 0x61, 0xcb, 0x18, 0x27, 0x6b, 0x9e, 0x2b, 0x03, 0xc8, 0xe0, 0xff,
-	0xc3, 0x18, 0x74, 0x13, 0x19, 0x97, 0xc1, 0x87, 0xd1, 0xcd, 0xbd, 0x18, 0x12, 0xae, 0x01, 0x65,
-	0x94, 0x69, 0x8f, 0xa0, 0x9c, 0x03, 0xc0, 0x08, 0x94, 0xe1, 0xff, 0x2f, 0xe0, 0xb2, 0xd6, 0x77,
-	0x60, 0x0e, 0xa7, 0xdc, 0x60, 0xeb, 0x64, 0x46, 0xba, 0x8a, 0x5e, 0xa7, 0x4a, 0xf2, 0xfb, 0x9f,
-	0x11, 0xdf, 0x89, 0xa9, 0x62, 0xf7, 0x6d, 0x26, 0x3c, 0xd2, 0x19, 0xde, 0x66, 0x0f, 0x91, 0xcb,
-	0x2e, 0x1c, 0x25, 0xa0, 0x8e, 0xf7, 0x65, 0x20, 0xbc, 0xe1, 0x56, 0x74, 0x24, 0xf3, 0x94, 0x44,
-	0x96, 0x77, 0xa1, 0x25, 0x18, 0x16, 0x4b, 0xcf, 0xe0, 0x47, 0x31, 0x26, 0xbb, 0x52, 0x1f, 0x60,
-	0x39, 0xdc, 0x36, 0x05, 0x96, 0x3e, 0x86, 0x5a, 0x76, 0xa5, 0x0b, 0x71, 0x20, 0x3c, 0xbe, 0x7a,
-	0xc2, 0x45, 0xc0, 0x7b, 0x01, 0xd0, 0x15, 0x0c, 0xca, 0x01, 0xf4, 0xf1, 0xca, 0xe6, 0xe7, 0x7b,
-	0x8d, 0x4d, 0x93, 0xfa, 0x86, 0x4c, 0x3c, 0xe8, 0x42, 0x34, 0xa4, 0x8f, 0xe3, 0xd2, 0xe5, 0x1a,
-	0xb6, 0x45, 0x28, 0x34, 0x7d, 0x82, 0x31, 0x32, 0xdd, 0xed, 0xba, 0xf0, 0xb1, 0x01, 0x28, 0xed,
-	0x72, 0x0f, 0xe8, 0x9f, 0x27, 0xdb, 0x1e, 0x21, 0x26, 0xe5, 0x70, 0x38, 0x01, 0x34, 0xa0, 0x58,
-	0xed, 0xca, 0x08, 0xe8, 0x18, 0x6b, 0x92, 0xa9, 0x9b, 0x91, 0x50, 0x6a, 0x00, 0x3e, 0x75, 0xf0,
-	0xba, 0x6d, 0x45, 0xfb, 0x89, 0xec, 0x63, 0x1f, 0xa4, 0x15, 0xa4, 0x6e, 0x88, 0x48, 0xa8, 0x63,
-	0x53, 0x68, 0x08, 0x99, 0x48, 0xef, 0x5d, 0x8d, 0xd5, 0xc9, 0xb8, 0x0b, 0x3a, 0x19, 0xd2, 0xf1,
-	0xf6, 0xf3, 0x0e, 0x69, 0xa6, 0xc6, 0x5a, 0x3d, 0x0b, 0x84, 0x96, 0xd7, 0x85, 0xa6, 0x3c, 0xf3,
-	0x1d, 0xac, 0x7f, 0x9b, 0x89, 0xbc, 0x2b, 0xa2, 0x3e, 0xad, 0xa0, 0xe0, 0x03, 0xe0, 0x81, 0x51,
-	0xd2, 0x20, 0x93, 0x1b, 0xc1, 0xc0, 0x68, 0xac, 0x19, 0xfd, 0xb8, 0x40, 0xb6, 0x71, 0x24, 0x61,
-	0xa6, 0xc4, 0xe0, 0xd3, 0x09, 0xf4, 0xde, 0xde, 0x0f, 0xa4, 0x4d, 0xb6, 0xdf, 0x4f, 0x66, 0xcf,
-	0x8c, 0x13, 0x6c, 0x8a, 0xd4, 0x52, 0xd5, 0x94, 0x34, 0xd7, 0x44, 0xc4, 0x93, 0xa1, 0x2d, 0x42,
-	0xd4, 0xc7, 0xcb, 0xb9, 0x11, 0x48, 0xae, 0x53, 0x00, 0xda, 0xaf, 0x34, 0x4d, 0x3f, 0x37, 0x1b,
-	0xa7, 0x49, 0xfd, 0x66, 0xe4, 0xc3, 0x91, 0x88, 0xc0, 0xa7, 0x63, 0xa6, 0x38, 0xd8, 0x6b, 0x55,
-	0xdc, 0x52, 0x1f, 0x83, 0x89, 0xc6, 0x94, 0x30, 0xc0, 0x1b, 0x7e, 0x83, 0xab, 0x12, 0x74, 0x84,
-	0x07, 0xdc, 0x35, 0xd3, 0x62, 0xaf, 0xbc, 0xbd, 0x6f, 0x0e, 0xf8, 0x58, 0xde, 0x2d, 0x30, 0x45,
-	0x8f, 0x51, 0xd3, 0x26, 0xe8, 0x83, 0xa1, 0xd2, 0x10, 0x76, 0x64, 0x74, 0x24, 0xfa, 0x8a, 0x0a,
-	0xd4, 0xb4, 0x2d, 0xb9, 0x5f, 0xda, 0x7e, 0x1b, 0x53, 0xcc, 0x85, 0x00, 0xb8, 0x2a, 0x4b, 0xbd,
-	0x63, 0xca, 0xa3, 0x31, 0x75, 0x35, 0x10, 0x5c, 0xd1, 0x00, 0x5d, 0x41, 0x2b, 0xed, 0x32, 0xc4,
-	0xf3, 0x5d, 0x0d, 0x34, 0x24, 0x76, 0x1d, 0xb1, 0x05, 0x32, 0x6b, 0xf9, 0xf7, 0x79, 0xa2, 0x85,
-	0x11, 0xf2, 0x92, 0x63, 0x32, 0x29, 0x91, 0x71, 0x81, 0xbd, 0x8c, 0xdd, 0xa8, 0x79, 0x83, 0xab,
-	0x02, 0xfa, 0xa9, 0xc3, 0x16, 0xc9, 0x5c, 0xe6, 0x5a, 0x81, 0xff, 0xcc, 0x61, 0xf3, 0x64, 0x06,
-	0x5d, 0xcb, 0x31, 0x45, 0x7f, 0x6e, 0x40, 0x74, 0xa2, 0x04, 0xfe, 0xc2, 0x48, 0x48, 0xbd, 0x28,
-	0xe1, 0xbf, 0x34, 0xca, 0x50, 0x42, 0x9a, 0x44, 0x8a, 0xbe, 0xea, 0xa0, 0xa5, 0x99, 0xb2, 0x14,
-	0xa6, 0xaf, 0x19, 0x46, 0x94, 0x9a, 0x33, 0xbe, 0x6e, 0x18, 0x53, 0x99, 0x39, 0xfa, 0x86, 0x41,
-	0x6f, 0xf0, 0xc8, 0x97, 0x47, 0x47, 0x39, 0xfa, 0xa6, 0xc3, 0x5a, 0x64, 0x1e, 0xb7, 0xaf, 0xf1,
-	0x80, 0x47, 0x5e, 0xc1, 0xff, 0x96, 0xc3, 0xce, 0x11, 0x7a, 0x46, 0x9d, 0xa2, 0xcf, 0x55, 0x18,
-	0xcd, 0xe2, 0x6b, 0xee, 0x11, 0xfd, 0x42, 0xc5, 0xc4, 0x2a, 0x65, 0xb4, 0xd8, 0x17, 0x2b, 0x6c,
-	0xc6, 0x06, 0xdd, 0xae, 0xbf, 0x54, 0x61, 0x0d, 0x32, 0xb1, 0x15, 0x29, 0x48, 0x34, 0xfd, 0x0c,
-	0xe6, 0xf7, 0x84, 0x2d, 0xb5, 0xf4, 0xb3, 0x78, 0xa3, 0xc6, 0x4d, 0x7e, 0xd3, 0x17, 0xb1, 0x8d,
-	0x33, 0x17, 0x14, 0x44, 0x7e, 0xe9, 0xee, 0x28, 0xfa, 0x39, 0xb3, 0xc3, 0xf6, 0x49, 0xfa, 0xb7,
-	0xaa, 0x09, 0x4d, 0xb9, 0x69, 0xfe, 0xbd, 0x8a, 0x26, 0x6c, 0x82, 0x2e, 0x6e, 0x36, 0xfd, 0x47,
-	0x95, 0x5d, 0x20, 0xe7, 0x32, 0xcc, 0xb4, 0xb0, 0xfc, 0x4e, 0xff, 0xb3, 0xca, 0x2e, 0x91, 0xf3,
-	0x58, 0xcf, 0xf3, 0xbc, 0xc1, 0x4d, 0x42, 0x69, 0xe1, 0x29, 0xfa, 0x4a, 0x95, 0x5d, 0x24, 0x8b,
-	0x9b, 0xa0, 0xf3, 0xf3, 0x28, 0x11, 0xff, 0x55, 0x65, 0xd3, 0x64, 0x0a, 0x6f, 0xbd, 0x80, 0x13,
-	0xa0, 0xaf, 0x56, 0xf1, 0x50, 0xb3, 0x65, 0x6a, 0xce, 0x6b, 0x55, 0x0c, 0xf5, 0xb3, 0x5c, 0x7b,
-	0xc7, 0xdd, 0xb0, 0x73, 0xcc, 0xa3, 0x08, 0x02, 0x45, 0x5f, 0xaf, 0x62, 0x40, 0x5d, 0x08, 0xe5,
-	0x09, 0x94, 0xe0, 0x37, 0x8c, 0xd3, 0x86, 0xf9, 0x83, 0x03, 0x48, 0x86, 0x39, 0xe1, 0xcd, 0x2a,
-	0x1e, 0x8d, 0xe5, 0x1f, 0xa5, 0xbc, 0x55, 0x65, 0x97, 0x49, 0xcb, 0x16, 0x8b, 0xec, 0x60, 0x90,
-	0xd8, 0x07, 0xac, 0xc3, 0xf4, 0xb9, 0x5a, 0x2e, 0xb1, 0x0b, 0x81, 0xe6, 0xf9, 0xbe, 0x4f, 0xd4,
-	0xd0, 0x2e, 0xbc, 0x5c, 0x45, 0xf9, 0x55, 0xf4, 0xf9, 0x1a, 0x9e, 0xe8, 0x26, 0xe8, 0xb4, 0x02,
-	0x2b, 0xfa, 0x49, 0x9c, 0x9a, 0x66, 0x6e, 0x46, 0x6a, 0xd0, 0xcb, 0x0d, 0xa5, 0x9f, 0xca, 0x36,
-	0x77, 0x85, 0xd2, 0x89, 0xe8, 0x0d, 0x4c, 0xa6, 0x7f, 0xba, 0x86, 0x4e, 0x1d, 0x0c, 0x23, 0x6f,
-	0x04, 0x7e, 0xc1, 0xc8, 0x4c, 0x6d, 0x33, 0x46, 0xfd, 0xaa, 0xc6, 0x66, 0x09, 0xb1, 0xb7, 0xda,
-	0x00, 0xbf, 0xce, 0xe4, 0xe1, 0x98, 0x74, 0x02, 0x89, 0xe9, 0x21, 0xf4, 0x37, 0xb9, 0x89, 0xa5,
-	0xda, 0x49, 0x7f, 0x5b, 0xc3, 0xa0, 0x1f, 0x8a, 0x10, 0x0e, 0x85, 0x77, 0x87, 0x7e, 0xa5, 0x8e,
-	0xf6, 0x99, 0x98, 0xec, 0x4a, 0x1f, 0x6c, 0x8e, 0x7c, 0xb5, 0x8e, 0x29, 0x87, 0x99, 0x6c, 0x53,
-	0xee, 0x6b, 0x66, 0x9d, 0xb6, 0x82, 0xad, 0x2e, 0xfd, 0x3a, 0x8e, 0x6b, 0x24, 0x5d, 0x1f, 0x1e,
-	0xec, 0xd1, 0x6f, 0xd4, 0x51, 0xd5, 0x6a, 0x10, 0x48, 0x8f, 0xeb, 0xfc, 0x3e, 0x7d, 0xb3, 0x8e,
-	0x17, 0xb2, 0xa4, 0x3d, 0x3d, 0xf7, 0x6f, 0xd5, 0x8d, 0xa3, 0x16, 0x37, 0xe9, 0xda, 0xc5, 0xb2,
-	0xfa, 0x6d, 0x23, 0x15, 0x9f, 0x96, 0x68, 0xc9, 0xa1, 0xa6, 0xdf, 0x31, 0x7c, 0x67, 0x27, 0x10,
-	0xfa, 0xbb, 0x46, 0x9a, 0xa1, 0x25, 0xec, 0xf7, 0x0d, 0x7b, 0xc3, 0x46, 0x47, 0x0e, 0xfa, 0x07,
-	0x03, 0x9f, 0x1d, 0x53, 0xe8, 0x1f, 0x1b, 0x68, 0x58, 0x79, 0xd2, 0xc0, 0x79, 0x5b, 0xd1, 0x3f,
-	0x35, 0xd0, 0x82, 0x62, 0xa6, 0xa0, 0xdf, 0x6d, 0x62, 0xb0, 0xb2, 0x69, 0x82, 0x7e, 0xaf, 0x89,
-	0x6e, 0x9e, 0x99, 0x23, 0xe8, 0xf7, 0x9b, 0xe6, 0x38, 0xf2, 0x09, 0x82, 0xfe, 0xa0, 0x04, 0x20,
-	0x17, 0xfd, 0x61, 0xd3, 0xd4, 0xb0, 0x91, 0xa9, 0x81, 0xfe, 0xa8, 0x89, 0xb6, 0x9d, 0x9d, 0x17,
-	0xe8, 0x8f, 0x9b, 0xf6, 0xb8, 0xf3, 0x49, 0x81, 0xfe, 0xa4, 0x89, 0x77, 0xe8, 0xfe, 0x33, 0x02,
-	0x7d, 0xc9, 0xe8, 0x2a, 0xa6, 0x03, 0xfa, 0x72, 0xb3, 0xbd, 0x4c, 0x26, 0xbb, 0x2a, 0x30, 0x9d,
-	0x67, 0x92, 0x54, 0xbb, 0x2a, 0xa0, 0x63, 0x58, 0xa8, 0xd7, 0xa4, 0x0c, 0xd6, 0x4f, 0xe3, 0xe4,
-	0x99, 0x27, 0xa8, 0xd3, 0x5e, 0x23, 0xb3, 0x1d, 0x19, 0xc6, 0x3c, 0xbf, 0xb0, 0xa6, 0xd9, 0xd8,
-	0x2e, 0x05, 0xbe, 0x4d, 0x95, 0x31, 0xac, 0xf6, 0xeb, 0xa7, 0xe0, 0x0d, 0x4c, 0x4f, 0x74, 0x70,
-	0x89, 0x9b, 0x30, 0xc8, 0x3e, 0xad, 0xb4, 0x3f, 0x44, 0x68, 0x47, 0x46, 0x4a, 0x28, 0x0d, 0x91,
-	0x37, 0xdc, 0x86, 0x13, 0x08, 0x4c, 0xe7, 0xd5, 0x89, 0x8c, 0xfa, 0x74, 0xcc, 0x3c, 0x49, 0xc0,
-	0x3c, 0x2d, 0x6c, 0x7f, 0x5e, 0xc3, 0xb1, 0xc3, 0xbc, 0x3b, 0x66, 0x08, 0x59, 0x3f, 0x81, 0x48,
-	0x0f, 0x78, 0x10, 0x0c, 0x69, 0x15, 0xd7, 0x9d, 0x81, 0xd2, 0x32, 0x14, 0x1f, 0xc7, 0x36, 0xdd,
-	0xfe, 0xb2, 0x43, 0x1a, 0xb6, 0x19, 0xe7, 0xa6, 0xd9, 0xe5, 0x3e, 0x44, 0xbe, 0x30, 0xc2, 0x71,
-	0x6c, 0x36, 0x50, 0x3a, 0x41, 0x38, 0x05, 0xd3, 0x81, 0xe6, 0x89, 0xce, 0xde, 0x37, 0x16, 0xea,
-	0xca, 0xbb, 0x51, 0x20, 0xb9, 0x6f, 0x26, 0x82, 0x7c, 0xeb, 0x3e, 0x4f, 0x94, 0x19, 0x0b, 0xf0,
-	0x55, 0x91, 0xca, 0x4f, 0x8c, 0x3f, 0x3e, 0x1d, 0x2f, 0xc0, 0xc2, 0xe7, 0x09, 0x6c, 0xbf, 0x16,
-	0x34, 0xc9, 0x9e, 0x65, 0x3a, 0x69, 0x5f, 0x27, 0xa4, 0x78, 0x51, 0x1a, 0x7f, 0x8a, 0x36, 0x3a,
-	0x86, 0x51, 0xd9, 0x0c, 0x64, 0x8f, 0x07, 0xd4, 0xc1, 0x29, 0xc2, 0x24, 0x45, 0xa5, 0xfd, 0xc2,
-	0x38, 0x99, 0x3d, 0xf3, 0x7e, 0x44, 0xdb, 0xf2, 0xc5, 0x6a, 0x80, 0x27, 0x77, 0x99, 0x3c, 0x90,
-	0x23, 0xf7, 0x8c, 0x0d, 0x0e, 0xce, 0x9c, 0x39, 0xf9, 0xcc, 0xfc, 0x50, 0x61, 0x57, 0xc8, 0xc5,
-	0x82, 0x78, 0xef, 0xd4, 0x80, 0xa5, 0xbb, 0x95, 0x33, 0x9c, 0x1d, 0x1f, 0x6a, 0x18, 0xd1, 0x9c,
-	0x8a, 0xd5, 0xc0, 0xbe, 0xf6, 0x8a, 0xc7, 0xae, 0x6d, 0x8b, 0x74, 0x02, 0x1f, 0x60, 0x85, 0x8d,
-	0x79, 0x5a, 0xd1, 0x49, 0x8c, 0x61, 0x4e, 0x48, 0x5b, 0xd6, 0xd4, 0x08, 0x98, 0xb6, 0xae, 0x3a,
-	0x0e, 0xe8, 0x39, 0x88, 0x35, 0xab, 0x28, 0x17, 0x04, 0x9f, 0x05, 0x67, 0x42, 0x60, 0xeb, 0x52,
-	0x63, 0x84, 0x62, 0xb0, 0x2e, 0x68, 0x2e, 0x02, 0xda, 0xc4, 0x83, 0x1a, 0x89, 0x8b, 0xdd, 0x31,
-	0x3d, 0xa2, 0x3c, 0xed, 0x82, 0x33, 0x38, 0x11, 0x15, 0x13, 0xbb, 0xe9, 0x9f, 0xb3, 0x23, 0x98,
-	0xa9, 0x8f, 0x94, 0x8e, 0xa8, 0x2b, 0x35, 0x7a, 0x3a, 0x37, 0xea, 0xa8, 0x49, 0x10, 0xca, 0x46,
-	0xa2, 0x6b, 0xed, 0xde, 0xbb, 0x1b, 0x41, 0xa2, 0x8e, 0x45, 0x4c, 0xe7, 0x47, 0x82, 0x66, 0x4b,
-	0x94, 0xc9, 0x8b, 0x85, 0x91, 0x50, 0xa0, 0xe9, 0xc5, 0xa6, 0x73, 0xa3, 0x07, 0x66, 0x8a, 0x44,
-	0x41, 0x5d, 0x1c, 0xa1, 0xee, 0xf0, 0x88, 0xf7, 0x4b, 0x0a, 0xcf, 0x8f, 0x28, 0x2c, 0x55, 0xa7,
-	0xd6, 0xfb, 0x24, 0x99, 0xcb, 0xff, 0x76, 0xdc, 0x82, 0x53, 0x7d, 0x4b, 0xf6, 0x6e, 0xb3, 0x2b,
-	0x2b, 0xf6, 0x2f, 0xe5, 0x4a, 0xf6, 0x97, 0x72, 0x65, 0x07, 0x94, 0x42, 0x91, 0xb1, 0xc9, 0x8f,
-	0xd6, 0x5f, 0x26, 0xcd, 0x6f, 0x9c, 0x87, 0xee, 0xff, 0x73, 0xac, 0xf4, 0x5b, 0xc6, 0x9d, 0x8d,
-	0x4b, 0xab, 0xbd, 0xde, 0xed, 0xb5, 0x67, 0xc9, 0x8c, 0x90, 0xd9, 0xbe, 0x7e, 0x12, 0x7b, 0x6b,
-	0x8d, 0x8e, 0xd9, 0xb7, 0x8f, 0x32, 0xf6, 0x9d, 0x0f, 0x3f, 0xd9, 0x17, 0xfa, 0x78, 0xd0, 0x43,
-	0x69, 0xd7, 0x2c, 0xdb, 0x63, 0x42, 0xa6, 0x5f, 0xd7, 0x44, 0xa4, 0xb1, 0x62, 0x07, 0xf6, 0xff,
-	0xe9, 0x35, 0xab, 0x31, 0xee, 0x7d, 0xde, 0x71, 0x7a, 0x13, 0x06, 0x7a, 0xf2, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x8e, 0xbe, 0x21, 0x8f, 0x85, 0x15, 0x00, 0x00,
+	// 2667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xc9, 0x73, 0x64, 0x47,
+	0xd1, 0x9f, 0x56, 0xb7, 0x96, 0xae, 0x6e, 0x49, 0xa9, 0x92, 0x46, 0xa3, 0xd9, 0x3c, 0xb2, 0x3e,
+	0xfb, 0x63, 0x10, 0xb6, 0xc6, 0x9e, 0x01, 0xdb, 0x10, 0x61, 0x02, 0x49, 0x2d, 0x69, 0x14, 0xd6,
+	0x46, 0x4b, 0xb2, 0x09, 0x22, 0x60, 0xa2, 0xfa, 0xbd, 0x54, 0xab, 0x66, 0xde, 0x7b, 0xd5, 0xbc,
+	0xaa, 0xd6, 0xa8, 0x39, 0x19, 0xb3, 0x9c, 0xc1, 0xfc, 0x03, 0x1c, 0x80, 0x13, 0x60, 0x76, 0x38,
+	0xb2, 0x63, 0xb3, 0x9d, 0xc1, 0xac, 0x47, 0xb8, 0xb3, 0x7a, 0x25, 0xb2, 0xea, 0x6d, 0x2d, 0x8d,
+	0xe1, 0xc0, 0xad, 0xeb, 0x97, 0x7b, 0x56, 0x56, 0x66, 0xbe, 0x66, 0x75, 0x4f, 0x85, 0xa1, 0x8a,
+	0x16, 0x3a, 0xb1, 0x32, 0x8a, 0x4f, 0x86, 0x32, 0x38, 0xea, 0x6a, 0x77, 0x5a, 0x70, 0xa4, 0x0b,
+	0xb3, 0x6d, 0xa5, 0xda, 0x01, 0x5e, 0xb3, 0x60, 0xab, 0x7b, 0x70, 0xcd, 0x47, 0xed, 0xc5, 0xb2,
+	0x63, 0x54, 0xec, 0x18, 0xe7, 0x6e, 0xb1, 0xa1, 0x5d, 0x23, 0x4c, 0x57, 0xf3, 0x27, 0x19, 0xc3,
+	0x38, 0x56, 0xf1, 0x2d, 0x4f, 0xf9, 0x38, 0x53, 0x9a, 0x2d, 0x5d, 0x1d, 0xbb, 0x7e, 0xdf, 0xc2,
+	0x3d, 0xb4, 0x2e, 0xac, 0x10, 0xdb, 0xb2, 0xf2, 0xb1, 0x59, 0xc5, 0xf4, 0x27, 0x9f, 0x66, 0x43,
+	0x31, 0x0a, 0xad, 0xa2, 0x99, 0x81, 0xd9, 0xd2, 0xd5, 0x6a, 0x33, 0x39, 0xcd, 0x3d, 0xc6, 0xea,
+	0x4f, 0x61, 0xef, 0x69, 0x11, 0x74, 0x71, 0x47, 0xc8, 0x98, 0x03, 0x2b, 0xdf, 0xc1, 0x9e, 0xd5,
+	0x5f, 0x6d, 0xd2, 0x4f, 0x3e, 0xc5, 0x06, 0x8f, 0x88, 0x9c, 0x08, 0xba, 0xc3, 0xdc, 0x0d, 0x56,
+	0x7b, 0x0a, 0x7b, 0x0d, 0x61, 0xc4, 0x5b, 0x88, 0x71, 0x56, 0xf1, 0x85, 0x11, 0x56, 0xaa, 0xde,
+	0xb4, 0xbf, 0xe7, 0x2e, 0xb1, 0xca, 0x52, 0xa0, 0x5a, 0xb9, 0xca, 0x92, 0x25, 0x26, 0x2a, 0x8f,
+	0x18, 0xec, 0x04, 0xc2, 0xc3, 0x43, 0x15, 0xf8, 0x18, 0x5b, 0x97, 0x48, 0xaf, 0x11, 0xed, 0x54,
+	0xaf, 0x11, 0x6d, 0xfe, 0x04, 0xab, 0x98, 0x5e, 0xc7, 0x79, 0x33, 0x76, 0xfd, 0x81, 0x7b, 0x66,
+	0xa0, 0xa0, 0x66, 0xaf, 0xd7, 0xc1, 0xa6, 0x95, 0xa0, 0x14, 0x58, 0x43, 0x7a, 0xa6, 0x3c, 0x5b,
+	0xbe, 0x5a, 0x6f, 0x26, 0xa7, 0xb9, 0x0f, 0xf5, 0xd9, 0x5d, 0x8b, 0x55, 0xb7, 0xc3, 0xd7, 0x59,
+	0xbd, 0x93, 0x63, 0x7a, 0xa6, 0x34, 0x5b, 0xbe, 0x5a, 0xbb, 0xfe, 0xe0, 0x7f, 0xb3, 0x66, 0x9d,
+	0x6e, 0xf6, 0x89, 0xce, 0x3d, 0xcc, 0x86, 0x17, 0x7d, 0x3f, 0x46, 0xad, 0xf9, 0x18, 0x1b, 0x90,
+	0x9d, 0x24, 0x98, 0x01, 0xd9, 0xa1, 0x1c, 0x75, 0x54, 0x6c, 0x6c, 0x2c, 0xe5, 0xa6, 0xfd, 0x3d,
+	0xf7, 0x7c, 0x89, 0x0d, 0x6f, 0xea, 0xf6, 0x92, 0xd0, 0xc8, 0x1f, 0x67, 0x23, 0xa1, 0x6e, 0xdf,
+	0xb2, 0xf1, 0xba, 0x1b, 0xbf, 0x74, 0x4f, 0x0f, 0x36, 0x75, 0xdb, 0xc6, 0x39, 0x1c, 0xba, 0x1f,
+	0x94, 0xe0, 0x50, 0xb7, 0xd7, 0x1b, 0x89, 0x66, 0x77, 0xe0, 0x97, 0x58, 0xd5, 0xc8, 0x10, 0xb5,
+	0x11, 0x61, 0x67, 0xa6, 0x3c, 0x5b, 0xba, 0x5a, 0x69, 0xe6, 0x00, 0xbf, 0xc0, 0x46, 0xb4, 0xea,
+	0xc6, 0x1e, 0xae, 0x37, 0x66, 0x2a, 0x56, 0x2c, 0x3b, 0xcf, 0x3d, 0xc9, 0xaa, 0x9b, 0xba, 0x7d,
+	0x13, 0x85, 0x8f, 0x31, 0x7f, 0x84, 0x55, 0x5a, 0x42, 0x3b, 0x8f, 0x6a, 0x6f, 0xed, 0x11, 0x45,
+	0xd0, 0xb4, 0x9c, 0x73, 0x1f, 0x66, 0xf5, 0xc6, 0xe6, 0xc6, 0xff, 0xa0, 0x81, 0x5c, 0xd7, 0x87,
+	0x22, 0xf6, 0xb7, 0x44, 0x98, 0x16, 0x62, 0x0e, 0xcc, 0xbd, 0x5a, 0x62, 0xf5, 0x9d, 0x58, 0x1e,
+	0xc9, 0x00, 0xdb, 0xb8, 0x72, 0x6c, 0xf8, 0xfb, 0x58, 0x4d, 0xb5, 0x6e, 0xa3, 0x67, 0x8a, 0xb9,
+	0xbb, 0x72, 0x4f, 0x3b, 0xdb, 0x96, 0xcf, 0xa6, 0x8f, 0xa9, 0xec, 0x37, 0xdf, 0x66, 0x90, 0x68,
+	0xe8, 0xa4, 0x8a, 0xff, 0x63, 0xc9, 0x39, 0x35, 0x99, 0x13, 0xcd, 0x71, 0xd5, 0x0f, 0xf0, 0x79,
+	0x36, 0x91, 0x28, 0x8c, 0x44, 0x88, 0xb7, 0x64, 0xe4, 0xe3, 0xb1, 0xbd, 0x84, 0xc1, 0x94, 0x97,
+	0x42, 0x59, 0x27, 0x98, 0x3f, 0xc4, 0xf8, 0x29, 0x5e, 0x6d, 0x2f, 0x65, 0xb0, 0x09, 0x27, 0x98,
+	0xf5, 0xfc, 0x0b, 0x8c, 0x55, 0xb3, 0x37, 0xcf, 0x6b, 0x6c, 0x78, 0xb7, 0xeb, 0x79, 0xa8, 0x35,
+	0x9c, 0xe1, 0x93, 0x6c, 0x7c, 0x3f, 0xc2, 0xe3, 0x0e, 0x7a, 0x06, 0x7d, 0xcb, 0x03, 0x25, 0x3e,
+	0xc1, 0x46, 0x97, 0x55, 0x14, 0xa1, 0x67, 0x56, 0x85, 0x0c, 0xd0, 0x87, 0x01, 0x3e, 0xc5, 0x60,
+	0x07, 0xe3, 0x50, 0x6a, 0x2d, 0x55, 0xd4, 0xc0, 0x48, 0xa2, 0x0f, 0x65, 0x7e, 0x8e, 0x4d, 0x2e,
+	0xab, 0x20, 0x40, 0xcf, 0x48, 0x15, 0x6d, 0x29, 0xb3, 0x72, 0x2c, 0xb5, 0xd1, 0x50, 0x21, 0xb5,
+	0xeb, 0x41, 0x80, 0x6d, 0x11, 0x2c, 0xc6, 0xed, 0x6e, 0x88, 0x91, 0x81, 0x41, 0xd2, 0x91, 0x80,
+	0x0d, 0x19, 0x62, 0x44, 0x9a, 0x60, 0xb8, 0x80, 0x5a, 0x6f, 0x29, 0xb7, 0x30, 0xc2, 0xcf, 0xb3,
+	0xb3, 0x09, 0x5a, 0x30, 0x20, 0x42, 0x84, 0x2a, 0x1f, 0x67, 0xb5, 0x84, 0xb4, 0xb7, 0xbd, 0xf3,
+	0x14, 0xb0, 0x82, 0x86, 0xa6, 0xba, 0xdb, 0x44, 0x4f, 0xc5, 0x3e, 0xd4, 0x0a, 0x2e, 0x3c, 0x8d,
+	0x9e, 0x51, 0xf1, 0x7a, 0x03, 0xea, 0xe4, 0x70, 0x02, 0xee, 0xa2, 0x88, 0xbd, 0xc3, 0x26, 0xea,
+	0x6e, 0x60, 0x60, 0x94, 0x03, 0xab, 0xaf, 0xca, 0x00, 0xb7, 0x94, 0x59, 0x55, 0xdd, 0xc8, 0x87,
+	0x31, 0x3e, 0xc6, 0xd8, 0x26, 0x1a, 0x91, 0x64, 0x60, 0x9c, 0xcc, 0x2e, 0x0b, 0xef, 0x10, 0x13,
+	0x00, 0xf8, 0x34, 0xe3, 0xcb, 0x22, 0x8a, 0x94, 0x59, 0x8e, 0x51, 0x18, 0x5c, 0xb5, 0xaf, 0x19,
+	0x26, 0xc8, 0x9d, 0x3e, 0x5c, 0x06, 0x08, 0x3c, 0xe7, 0x6e, 0x60, 0x80, 0x19, 0xf7, 0x64, 0xce,
+	0x9d, 0xe0, 0xc4, 0x3d, 0x45, 0xce, 0x2f, 0x75, 0x65, 0xe0, 0xdb, 0x94, 0xb8, 0x6b, 0x39, 0x4b,
+	0x3e, 0x26, 0xce, 0x6f, 0x6d, 0xac, 0xef, 0xee, 0xc1, 0x34, 0x3f, 0xcb, 0x26, 0x12, 0x64, 0x13,
+	0x4d, 0x2c, 0x3d, 0x9b, 0xbc, 0x73, 0xe4, 0xea, 0x76, 0xd7, 0x6c, 0x1f, 0x6c, 0x62, 0xa8, 0xe2,
+	0x1e, 0xcc, 0xd0, 0x85, 0x5a, 0x4d, 0xe9, 0x15, 0xc1, 0x79, 0xb2, 0xb0, 0x12, 0x76, 0x4c, 0x2f,
+	0x4f, 0x2f, 0x5c, 0xe0, 0x17, 0xd9, 0xb9, 0xfd, 0x8e, 0x2f, 0x0c, 0xae, 0x87, 0xd4, 0x6a, 0xf6,
+	0x84, 0xbe, 0x43, 0xe1, 0x76, 0x63, 0x84, 0x8b, 0xfc, 0x02, 0x9b, 0xee, 0xbf, 0x8b, 0x2c, 0x59,
+	0x97, 0x48, 0xd0, 0x45, 0xbb, 0x1c, 0xa3, 0x8f, 0x91, 0x91, 0x22, 0x48, 0x05, 0x2f, 0xe7, 0x5a,
+	0x4f, 0x13, 0xef, 0x23, 0xa2, 0x8b, 0xfc, 0x34, 0xf1, 0x0a, 0x9f, 0x61, 0x53, 0x6b, 0x68, 0x4e,
+	0x53, 0x66, 0x89, 0xb2, 0x21, 0xb5, 0x25, 0xed, 0x6b, 0x8c, 0x75, 0x4a, 0xb9, 0x9f, 0x73, 0x36,
+	0xb6, 0x86, 0x86, 0xc0, 0x14, 0x9b, 0xa3, 0x3c, 0x39, 0xf7, 0x9a, 0x2a, 0xc0, 0x14, 0xfe, 0x3f,
+	0xca, 0x41, 0x23, 0x56, 0x9d, 0x22, 0xf8, 0x00, 0x85, 0xb9, 0xdd, 0xc1, 0x58, 0x18, 0x24, 0x1d,
+	0x45, 0xda, 0x83, 0xa4, 0x67, 0x17, 0x29, 0x03, 0x45, 0xf8, 0xff, 0x73, 0xb8, 0x68, 0xf5, 0x6d,
+	0x54, 0xc3, 0x09, 0x37, 0xba, 0x3e, 0x99, 0x92, 0xae, 0x52, 0xd4, 0x89, 0x91, 0xec, 0xfd, 0xa7,
+	0xc4, 0xb7, 0x53, 0xa9, 0x38, 0xb9, 0xb5, 0x58, 0x44, 0x26, 0xc5, 0xe7, 0xf9, 0xfd, 0xec, 0x72,
+	0x13, 0x0f, 0x62, 0xd4, 0x87, 0x3b, 0x2a, 0x90, 0x5e, 0x6f, 0x3d, 0x3a, 0x50, 0x59, 0x49, 0x12,
+	0xcb, 0x3b, 0xc8, 0x13, 0x4a, 0x8b, 0xa3, 0xa7, 0xf0, 0x43, 0x94, 0x93, 0x2d, 0x65, 0x76, 0xa9,
+	0x1d, 0x6e, 0xd8, 0x06, 0x0b, 0x0f, 0x93, 0x95, 0x2d, 0xd5, 0xc4, 0x4e, 0x20, 0x3d, 0xb1, 0x78,
+	0x24, 0x64, 0x20, 0x5a, 0x01, 0xc2, 0x02, 0x25, 0x65, 0x17, 0xdb, 0xf4, 0x64, 0xb3, 0xfb, 0xbd,
+	0xc6, 0x47, 0x59, 0x75, 0x55, 0xc5, 0x1e, 0x36, 0x30, 0xea, 0xc1, 0x23, 0x74, 0x6c, 0x0a, 0x83,
+	0x1b, 0x32, 0x94, 0x06, 0x1e, 0x3d, 0xd5, 0x06, 0x36, 0x94, 0xf0, 0xd1, 0x87, 0xeb, 0xf6, 0xb9,
+	0xd9, 0xba, 0x13, 0x21, 0x36, 0xba, 0xd6, 0x94, 0x41, 0x1f, 0x6e, 0x90, 0xf1, 0x1d, 0x11, 0x1b,
+	0xd9, 0xdf, 0x37, 0xde, 0x69, 0x5f, 0x49, 0xa6, 0xa9, 0x21, 0x35, 0xf9, 0xe4, 0xc3, 0xbb, 0xec,
+	0x2b, 0x51, 0x2a, 0xf6, 0xf7, 0x23, 0x91, 0xb9, 0xfa, 0x18, 0x85, 0xb5, 0xeb, 0x1d, 0x62, 0x28,
+	0x36, 0xa5, 0x0e, 0x85, 0xf1, 0x0e, 0xe1, 0xf1, 0xfe, 0x2a, 0x7d, 0x26, 0x96, 0x06, 0x97, 0x02,
+	0xe5, 0xdd, 0x41, 0x1f, 0x9e, 0x20, 0x2d, 0xb4, 0x8f, 0xd0, 0xbc, 0xc8, 0x5e, 0xc2, 0xbb, 0x39,
+	0x67, 0xa3, 0x8d, 0x46, 0x13, 0x3f, 0xd2, 0x45, 0x6d, 0x9a, 0xc2, 0x43, 0xf8, 0xf3, 0xf0, 0xbc,
+	0xc7, 0x98, 0x75, 0x9c, 0x56, 0x2b, 0x24, 0x3b, 0xf9, 0x69, 0x4b, 0x45, 0x08, 0x67, 0x78, 0x9d,
+	0x8d, 0xec, 0x47, 0x52, 0xeb, 0x2e, 0xfa, 0x50, 0xa2, 0x66, 0xb1, 0x1e, 0xed, 0xc4, 0xaa, 0x4d,
+	0x53, 0x1c, 0x06, 0x88, 0xba, 0x2a, 0x23, 0xa9, 0x0f, 0x6d, 0x9b, 0x64, 0x6c, 0x28, 0xe9, 0x1a,
+	0x15, 0x5e, 0x65, 0x83, 0x4d, 0x34, 0x71, 0x0f, 0x06, 0xe7, 0x9f, 0x2b, 0xb1, 0x7a, 0x92, 0x6a,
+	0x67, 0x67, 0x8a, 0x41, 0xf1, 0x9c, 0x5b, 0xca, 0xbc, 0x2d, 0x51, 0xf7, 0x5e, 0x8b, 0xd5, 0x5d,
+	0x19, 0xb5, 0x61, 0x80, 0x14, 0xef, 0xa2, 0x08, 0xac, 0x91, 0x1a, 0x1b, 0x5e, 0x0d, 0xba, 0xd6,
+	0x62, 0xc5, 0xda, 0xa7, 0x03, 0xb1, 0x0d, 0x12, 0x89, 0xea, 0xbc, 0x83, 0x3e, 0x0c, 0xd1, 0xdd,
+	0xb9, 0xd7, 0x4d, 0xb4, 0xe1, 0xf9, 0xf7, 0xb2, 0xf1, 0x13, 0xcb, 0x10, 0x1f, 0x61, 0x95, 0xc4,
+	0x34, 0xb0, 0xfa, 0x92, 0x8c, 0x44, 0xdc, 0x73, 0x2d, 0x14, 0x7c, 0x6a, 0x2d, 0xab, 0x81, 0x12,
+	0x26, 0x01, 0x70, 0xfe, 0xa5, 0x51, 0xbb, 0x8d, 0x58, 0xc1, 0x51, 0x56, 0xdd, 0x8f, 0x7c, 0x3c,
+	0x90, 0x11, 0xfa, 0x70, 0xc6, 0x5e, 0x9a, 0x6b, 0x0a, 0x79, 0x8f, 0xf1, 0x29, 0x99, 0xe4, 0x4c,
+	0x01, 0x43, 0xea, 0x4f, 0x37, 0x85, 0x2e, 0x40, 0x07, 0x54, 0x09, 0x0d, 0xbb, 0xeb, 0xb6, 0x8a,
+	0xe2, 0x6d, 0x5b, 0x9e, 0x87, 0xea, 0x6e, 0x8e, 0x69, 0x38, 0x24, 0x4b, 0x6b, 0x68, 0x76, 0x7b,
+	0xda, 0x60, 0xb8, 0xac, 0xa2, 0x03, 0xd9, 0xd6, 0x20, 0xc9, 0x12, 0x55, 0x62, 0x41, 0xfc, 0x36,
+	0x3d, 0x90, 0x26, 0x06, 0x28, 0x74, 0x51, 0xeb, 0x1d, 0xdb, 0xdc, 0xad, 0xab, 0x8b, 0x81, 0x14,
+	0x1a, 0x02, 0x0a, 0x85, 0xbc, 0x74, 0xc7, 0x90, 0xee, 0x77, 0x31, 0x30, 0x18, 0xbb, 0x73, 0x44,
+	0x5e, 0xd8, 0x73, 0x41, 0x89, 0xe2, 0x53, 0x6c, 0xdc, 0x29, 0xc9, 0x4a, 0x1b, 0x5e, 0x2c, 0xd9,
+	0xf2, 0x8a, 0x55, 0x27, 0xc7, 0x5e, 0xa2, 0x01, 0x5b, 0xbf, 0x29, 0x74, 0x0e, 0xfd, 0xac, 0xc4,
+	0xa7, 0xd9, 0x44, 0x1a, 0x6f, 0x8e, 0xff, 0xbc, 0xc4, 0x27, 0xd9, 0x18, 0xc5, 0x9b, 0x61, 0x1a,
+	0x7e, 0x61, 0x41, 0x8a, 0xac, 0x00, 0xfe, 0xd2, 0x6a, 0x48, 0x42, 0x2b, 0xe0, 0xbf, 0xb2, 0xc6,
+	0x48, 0x43, 0x52, 0x59, 0x1a, 0x5e, 0x29, 0x91, 0xa7, 0xa9, 0xb1, 0x04, 0x86, 0x57, 0x2d, 0x23,
+	0x69, 0xcd, 0x18, 0x5f, 0xb3, 0x8c, 0x89, 0xce, 0x0c, 0x7d, 0xdd, 0xa2, 0x37, 0x45, 0xe4, 0xab,
+	0x83, 0x83, 0x0c, 0x7d, 0xa3, 0xc4, 0x67, 0xd8, 0x24, 0x89, 0x2f, 0x89, 0x40, 0x44, 0x5e, 0xce,
+	0xff, 0x66, 0x89, 0x9f, 0x65, 0x70, 0xc2, 0x9c, 0x86, 0x67, 0x07, 0x38, 0xa4, 0x49, 0xb7, 0x8f,
+	0x0b, 0xbe, 0x34, 0x60, 0x73, 0x95, 0x30, 0x3a, 0xec, 0xcb, 0x03, 0x7c, 0xcc, 0xdd, 0x84, 0x3b,
+	0x7f, 0x65, 0x80, 0xd7, 0xd8, 0xd0, 0x7a, 0xa4, 0x31, 0x36, 0xf0, 0x69, 0x2a, 0xfa, 0x21, 0x37,
+	0x3d, 0xe0, 0x33, 0xf4, 0xcc, 0x06, 0x6d, 0xd1, 0xc3, 0xf3, 0xb4, 0x99, 0xf0, 0x26, 0x6a, 0x8c,
+	0xfc, 0xc2, 0x83, 0xd2, 0xf0, 0x59, 0x2b, 0xe1, 0x46, 0x3f, 0xfc, 0xb5, 0x6c, 0x53, 0x53, 0xdc,
+	0x03, 0xfe, 0x56, 0x26, 0x17, 0xd6, 0xd0, 0xe4, 0xcf, 0x1d, 0xfe, 0x5e, 0xe6, 0x17, 0xd8, 0xd9,
+	0x14, 0xb3, 0x53, 0x39, 0x7b, 0xe8, 0xff, 0x28, 0xf3, 0x4b, 0xec, 0x1c, 0x8d, 0xa8, 0xac, 0x0e,
+	0x48, 0x48, 0x6a, 0x23, 0x3d, 0x0d, 0xff, 0x2c, 0xf3, 0x8b, 0x6c, 0x7a, 0x0d, 0x4d, 0x76, 0x1f,
+	0x05, 0xe2, 0xbf, 0xca, 0x7c, 0x94, 0x8d, 0x50, 0x2b, 0x90, 0x78, 0x84, 0xf0, 0x4a, 0x99, 0x2e,
+	0x35, 0x3d, 0x26, 0xee, 0xbc, 0x5a, 0xa6, 0x54, 0x3f, 0x43, 0x9d, 0xad, 0x11, 0x2e, 0x1f, 0x8a,
+	0x28, 0xc2, 0x40, 0xc3, 0x6b, 0x65, 0x4a, 0x68, 0x13, 0x43, 0x75, 0x84, 0x05, 0xf8, 0x75, 0x1b,
+	0xb4, 0x65, 0x7e, 0x7f, 0x17, 0xe3, 0x5e, 0x46, 0x78, 0xa3, 0x4c, 0x57, 0xe3, 0xf8, 0xfb, 0x29,
+	0x6f, 0x96, 0xf9, 0x65, 0x36, 0xe3, 0x3a, 0x48, 0x7a, 0x31, 0x44, 0x6c, 0x23, 0x8d, 0x16, 0x78,
+	0xb6, 0x92, 0x69, 0x6c, 0x60, 0x60, 0x44, 0x26, 0xf7, 0xb1, 0x0a, 0xf9, 0xb5, 0x86, 0xc5, 0x89,
+	0xa2, 0xe1, 0xb9, 0x0a, 0xdd, 0xe8, 0x1a, 0x9a, 0x64, 0xa8, 0x68, 0xf8, 0x38, 0x2d, 0x82, 0x63,
+	0xfb, 0x91, 0xee, 0xb6, 0x32, 0x47, 0xe1, 0x13, 0xa9, 0x70, 0x43, 0x6a, 0x13, 0xcb, 0x56, 0xd7,
+	0x56, 0xfa, 0x27, 0x2b, 0x14, 0xd4, 0x6e, 0x2f, 0xf2, 0xfa, 0xe0, 0x4f, 0x59, 0x9d, 0x89, 0x6f,
+	0xd6, 0xa9, 0x5f, 0x57, 0xf8, 0x38, 0x63, 0xee, 0xa9, 0x5b, 0xe0, 0x37, 0xa9, 0x3e, 0xda, 0xfc,
+	0x8e, 0x30, 0xb6, 0x63, 0x11, 0x5e, 0xce, 0x5c, 0x2c, 0x34, 0x54, 0xf8, 0x6d, 0x85, 0x92, 0xbe,
+	0x27, 0x43, 0xdc, 0x93, 0xde, 0x1d, 0xf8, 0x6a, 0x95, 0xfc, 0xb3, 0x39, 0xd9, 0x52, 0x3e, 0xba,
+	0x1a, 0xf9, 0x5a, 0x95, 0x4a, 0x8e, 0x2a, 0xd9, 0x95, 0xdc, 0xd7, 0xed, 0x39, 0x99, 0x0f, 0xeb,
+	0x0d, 0xf8, 0x06, 0x6d, 0xa0, 0x2c, 0x39, 0xef, 0xed, 0x6e, 0xc3, 0x37, 0xab, 0x64, 0x6a, 0x31,
+	0x08, 0x14, 0x4d, 0xb7, 0xf4, 0x3d, 0x7d, 0xab, 0x4a, 0x0f, 0xb2, 0x60, 0x3d, 0xb9, 0xf7, 0x6f,
+	0x57, 0x6d, 0xa0, 0x0e, 0xb7, 0xe5, 0xda, 0xa0, 0x5e, 0xfb, 0x1d, 0xab, 0x95, 0xa6, 0x13, 0x79,
+	0xb2, 0x67, 0xe0, 0xbb, 0x96, 0xef, 0xe4, 0x52, 0x05, 0xbf, 0xab, 0x25, 0x15, 0x5a, 0xc0, 0x7e,
+	0x5f, 0x73, 0x2f, 0xac, 0x7f, 0x8b, 0x82, 0x3f, 0x58, 0xf8, 0xe4, 0xe6, 0x05, 0x7f, 0xac, 0xf1,
+	0x69, 0xb7, 0x25, 0xa4, 0xcb, 0x13, 0x7d, 0x42, 0x68, 0xf8, 0x53, 0x8d, 0x3c, 0xc8, 0xd7, 0x24,
+	0xf8, 0x5e, 0x9d, 0x92, 0x95, 0x2e, 0x48, 0xf0, 0xfd, 0x3a, 0x85, 0x79, 0x62, 0x35, 0x82, 0x1f,
+	0xd4, 0xed, 0x75, 0x64, 0x4b, 0x11, 0xfc, 0xb0, 0x00, 0x10, 0x17, 0xfc, 0xa8, 0x6e, 0x7b, 0x58,
+	0xdf, 0x22, 0x04, 0x3f, 0xae, 0x93, 0x6f, 0x27, 0x57, 0x20, 0xf8, 0x49, 0xdd, 0x5d, 0x77, 0xb6,
+	0xfc, 0xc0, 0x4f, 0xeb, 0xf4, 0x86, 0xee, 0xbd, 0xf6, 0xc0, 0x8b, 0xd6, 0x56, 0xbe, 0xf0, 0xc0,
+	0x4b, 0xd6, 0x96, 0x8b, 0x21, 0x9d, 0xf4, 0xf0, 0xf9, 0x51, 0x7a, 0xe7, 0x14, 0x47, 0x06, 0x7d,
+	0x61, 0x94, 0xb2, 0x48, 0x82, 0x29, 0xa4, 0xe1, 0x8b, 0xa3, 0xf3, 0x73, 0x6c, 0xb8, 0xa1, 0x03,
+	0x3b, 0xca, 0x86, 0x59, 0xb9, 0xa1, 0x03, 0x38, 0x43, 0x9d, 0x7f, 0x49, 0xa9, 0x60, 0xe5, 0xb8,
+	0x13, 0x3f, 0xfd, 0x28, 0x94, 0xe6, 0x97, 0xd8, 0xf8, 0xb2, 0x0a, 0x3b, 0x22, 0x7b, 0xec, 0x76,
+	0x7a, 0xb9, 0xb1, 0x87, 0xbe, 0x05, 0xe0, 0x0c, 0x8d, 0x8f, 0x95, 0x63, 0xf4, 0xba, 0x76, 0xc8,
+	0x96, 0xe8, 0x48, 0x42, 0x01, 0xd2, 0xf6, 0x33, 0x30, 0xff, 0x01, 0xda, 0x66, 0x22, 0x2d, 0xb5,
+	0xc1, 0xc8, 0xeb, 0x6d, 0xe0, 0x11, 0x06, 0x76, 0x94, 0x9b, 0x58, 0x45, 0x6d, 0x38, 0x63, 0xbf,
+	0xd0, 0xd0, 0x7e, 0x69, 0xb9, 0x81, 0xbf, 0x44, 0x5b, 0x18, 0x49, 0x92, 0x37, 0x2b, 0x47, 0x18,
+	0x99, 0xae, 0x08, 0x82, 0x1e, 0x94, 0xe9, 0xbc, 0xdc, 0xd5, 0x46, 0x85, 0xf2, 0xa3, 0x34, 0xf7,
+	0xe7, 0x5f, 0x28, 0xb1, 0x9a, 0x9b, 0xee, 0x99, 0x6b, 0xee, 0xb8, 0x83, 0x91, 0x2f, 0xad, 0x72,
+	0xfa, 0x8a, 0xb0, 0x50, 0xb2, 0x92, 0x94, 0x72, 0xa6, 0x5d, 0x23, 0x62, 0x93, 0x7e, 0xee, 0x39,
+	0xa8, 0xa1, 0xee, 0x46, 0x81, 0x5b, 0xe7, 0xca, 0xb9, 0xe8, 0x8e, 0x88, 0x35, 0xd9, 0xb3, 0x1f,
+	0x59, 0x89, 0xfe, 0xd8, 0xc6, 0xe3, 0xc3, 0x60, 0x0e, 0xe6, 0x31, 0x0f, 0xd1, 0x3c, 0x77, 0xa0,
+	0x7d, 0x28, 0xe9, 0x2b, 0x61, 0xf3, 0xd7, 0x19, 0xcb, 0x3f, 0xb0, 0x6d, 0x3c, 0xf9, 0x48, 0x3d,
+	0x43, 0x59, 0x59, 0x0b, 0x54, 0x4b, 0x04, 0x50, 0xa2, 0xb5, 0xc4, 0x16, 0xd4, 0xc0, 0xfc, 0xcb,
+	0x83, 0x6c, 0xfc, 0xc4, 0xe7, 0x34, 0xf9, 0x96, 0x1d, 0x16, 0x03, 0xba, 0xb9, 0xcb, 0xec, 0x7c,
+	0x86, 0x9c, 0xda, 0x43, 0x4a, 0xb4, 0x82, 0x67, 0xe4, 0x13, 0x0b, 0xc9, 0x00, 0xbf, 0xc2, 0x2e,
+	0xe6, 0xc4, 0xd3, 0x6b, 0x08, 0xb5, 0xfd, 0x99, 0x8c, 0xe1, 0xe4, 0x3e, 0x52, 0xa1, 0x8c, 0x66,
+	0x54, 0xea, 0x24, 0xee, 0xe3, 0x37, 0x83, 0x92, 0x91, 0x0a, 0x43, 0xb4, 0x20, 0xe7, 0x3e, 0x66,
+	0x65, 0x05, 0xc3, 0x94, 0xc3, 0x8c, 0x90, 0x8c, 0xbb, 0x91, 0x3e, 0x30, 0x19, 0x7b, 0x55, 0x5a,
+	0x78, 0x33, 0x70, 0x0d, 0x8b, 0xad, 0x86, 0xd1, 0x57, 0xd2, 0x89, 0x14, 0xb8, 0x9e, 0x56, 0xeb,
+	0xa3, 0x58, 0xac, 0x81, 0x46, 0xc8, 0x00, 0xea, 0x76, 0x35, 0x2f, 0xe6, 0xc5, 0x49, 0x8c, 0xf6,
+	0x19, 0x4f, 0x26, 0xe8, 0x18, 0xad, 0x58, 0x19, 0xe8, 0x66, 0xef, 0x78, 0x1f, 0x66, 0x7b, 0x2b,
+	0x40, 0x9f, 0xb9, 0xc2, 0x92, 0x00, 0x13, 0xfd, 0x81, 0xda, 0x02, 0x01, 0xde, 0x97, 0x5d, 0xe7,
+	0xf7, 0xf6, 0xdd, 0x08, 0x63, 0x7d, 0x28, 0x3b, 0x30, 0xd9, 0x97, 0x34, 0xd7, 0xde, 0x6c, 0x5d,
+	0x4c, 0xf5, 0xa5, 0x82, 0x5c, 0xcf, 0x85, 0xce, 0xf6, 0x5f, 0x98, 0x6d, 0x30, 0x39, 0x75, 0xba,
+	0x8f, 0xba, 0x29, 0x22, 0xd1, 0x2e, 0x18, 0x3c, 0xd7, 0x67, 0xb0, 0xd0, 0xd9, 0x66, 0xfa, 0x6a,
+	0xe8, 0x44, 0xd7, 0x39, 0x4f, 0x9f, 0x7f, 0x7d, 0xde, 0x64, 0xa4, 0x0b, 0x7d, 0x8e, 0xf6, 0x77,
+	0xa1, 0x8b, 0xef, 0x51, 0x6c, 0x22, 0xfb, 0x43, 0xe9, 0x16, 0x1e, 0x9b, 0x5b, 0xaa, 0x75, 0x9b,
+	0x5f, 0x59, 0x70, 0x7f, 0x04, 0x2f, 0xa4, 0x7f, 0x04, 0x2f, 0x6c, 0xa2, 0xd6, 0xe4, 0x66, 0xc7,
+	0xd6, 0xdc, 0xcc, 0x5f, 0x86, 0xed, 0x3f, 0x65, 0xf7, 0xdf, 0xfb, 0xff, 0xc7, 0xc2, 0x3f, 0x5f,
+	0xcd, 0xf1, 0x4e, 0xe1, 0xb4, 0xdd, 0xba, 0xbd, 0xf4, 0x0c, 0x1b, 0x93, 0x2a, 0x95, 0x6b, 0xc7,
+	0x1d, 0x6f, 0xa9, 0xb6, 0x6c, 0xe5, 0x76, 0x48, 0xc7, 0x4e, 0xe9, 0x83, 0x37, 0xda, 0xd2, 0x1c,
+	0x76, 0x5b, 0xa4, 0xed, 0x9a, 0x63, 0x7b, 0x58, 0xaa, 0xe4, 0xd7, 0x35, 0x19, 0x19, 0x9a, 0x20,
+	0x81, 0xfb, 0x8b, 0xfa, 0x9a, 0xb3, 0xd8, 0x69, 0x7d, 0xae, 0x54, 0x6a, 0x0d, 0x59, 0xe8, 0xc6,
+	0xbf, 0x07, 0x00, 0x3d, 0xcb, 0xe8, 0xe9, 0xe8, 0x16, 0x00, 0x00,
 }
@@ -136,6 +136,7 @@ type FlushRequest struct {
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	SegmentIDs           []int64           `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
 	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -194,6 +195,13 @@ func (m *FlushRequest) GetCollectionID() int64 {
 	return 0
 }
 
+func (m *FlushRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
 type FlushResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DbID                 int64            `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -1013,13 +1021,15 @@ func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
 }
 
 type GetPartitionStatisticsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID         int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs []int64           `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// return the row count of each partition in partition_row_counts besides the total
+	PerPartition         bool     `protobuf:"varint,5,opt,name=per_partition,json=perPartition,proto3" json:"per_partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
@@ -1075,12 +1085,21 @@ func (m *GetPartitionStatisticsRequest) GetPartitionIDs() []int64 {
 	return nil
 }
 
+func (m *GetPartitionStatisticsRequest) GetPerPartition() bool {
+	if m != nil {
+		return m.PerPartition
+	}
+	return false
+}
+
 type GetPartitionStatisticsResponse struct {
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	Status               *commonpb.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Stats  []*commonpb.KeyValuePair `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Status *commonpb.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// partition id -> row count, set only if per_partition is true
+	PartitionRowCounts   map[int64]int64 `protobuf:"bytes,3,rep,name=partition_row_counts,json=partitionRowCounts,proto3" json:"partition_row_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
@@ -1122,6 +1141,13 @@ func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
+func (m *GetPartitionStatisticsResponse) GetPartitionRowCounts() map[int64]int64 {
+	if m != nil {
+		return m.PartitionRowCounts
+	}
+	return nil
+}
+
 type GetSegmentInfoChannelRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -4299,6 +4325,7 @@ func init() {
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
 	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
 	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.data.GetPartitionStatisticsResponse.PartitionRowCountsEntry")
 	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
 	proto.RegisterType((*AcquireSegmentLockRequest)(nil), "milvus.proto.data.AcquireSegmentLockRequest")
 	proto.RegisterType((*ReleaseSegmentLockRequest)(nil), "milvus.proto.data.ReleaseSegmentLockRequest")
@@ -4354,249 +4381,253 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 3863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0xdd, 0x6f, 0x1c, 0xd7,
-	0x57, 0x99, 0xfd, 0xf2, 0xee, 0xd9, 0x0f, 0xaf, 0x6f, 0x52, 0x7b, 0xb3, 0xf9, 0x9e, 0x34, 0xa9,
-	0x93, 0x5f, 0x9a, 0xb4, 0x0e, 0xd5, 0xaf, 0x22, 0xbf, 0xb6, 0x8a, 0xe3, 0xd8, 0x59, 0xb0, 0x53,
-	0x67, 0xec, 0x34, 0x12, 0x45, 0x5a, 0x8d, 0x77, 0xae, 0xd7, 0x53, 0xef, 0xcc, 0x6c, 0x66, 0x66,
-	0xe3, 0xb8, 0x3c, 0xb4, 0xa2, 0x12, 0x52, 0x11, 0xa2, 0x08, 0x84, 0x04, 0x0f, 0x48, 0x88, 0xa7,
-	0x82, 0x84, 0x84, 0x54, 0xf1, 0x00, 0xa8, 0xef, 0x15, 0x3c, 0x20, 0x1e, 0xf9, 0x07, 0x80, 0x27,
-	0xfe, 0x04, 0x84, 0xee, 0xc7, 0xdc, 0xf9, 0xde, 0x1d, 0xef, 0x26, 0x0d, 0xe2, 0xcd, 0xf7, 0xcc,
-	0x39, 0xf7, 0x9e, 0x7b, 0xee, 0xf9, 0xbe, 0x77, 0x0d, 0x4d, 0x4d, 0x75, 0xd5, 0x6e, 0xcf, 0xb2,
-	0x6c, 0xed, 0xf6, 0xd0, 0xb6, 0x5c, 0x0b, 0x2d, 0x18, 0xfa, 0xe0, 0xc5, 0xc8, 0x61, 0xa3, 0xdb,
-	0xe4, 0x73, 0xbb, 0xd6, 0xb3, 0x0c, 0xc3, 0x32, 0x19, 0xa8, 0xdd, 0xd0, 0x4d, 0x17, 0xdb, 0xa6,
-	0x3a, 0xe0, 0xe3, 0x5a, 0x90, 0xa0, 0x5d, 0x73, 0x7a, 0x07, 0xd8, 0x50, 0xd9, 0x48, 0x9e, 0x83,
-	0xe2, 0x43, 0x63, 0xe8, 0x1e, 0xcb, 0x7f, 0x26, 0x41, 0x6d, 0x7d, 0x30, 0x72, 0x0e, 0x14, 0xfc,
-	0x7c, 0x84, 0x1d, 0x17, 0xbd, 0x07, 0x85, 0x3d, 0xd5, 0xc1, 0x2d, 0xe9, 0xb2, 0xb4, 0x5c, 0x5d,
-	0x39, 0x7f, 0x3b, 0xb4, 0x2a, 0x5f, 0x6f, 0xcb, 0xe9, 0xaf, 0xaa, 0x0e, 0x56, 0x28, 0x26, 0x42,
-	0x50, 0xd0, 0xf6, 0x3a, 0x6b, 0xad, 0xdc, 0x65, 0x69, 0x39, 0xaf, 0xd0, 0xbf, 0xd1, 0x45, 0x00,
-	0x07, 0xf7, 0x0d, 0x6c, 0xba, 0x9d, 0x35, 0xa7, 0x95, 0xbf, 0x9c, 0x5f, 0xce, 0x2b, 0x01, 0x08,
-	0x92, 0xa1, 0xd6, 0xb3, 0x06, 0x03, 0xdc, 0x73, 0x75, 0xcb, 0xec, 0xac, 0xb5, 0x0a, 0x94, 0x36,
-	0x04, 0x93, 0xff, 0x43, 0x82, 0x3a, 0x67, 0xcd, 0x19, 0x5a, 0xa6, 0x83, 0xd1, 0x5d, 0x28, 0x39,
-	0xae, 0xea, 0x8e, 0x1c, 0xce, 0xdd, 0xb9, 0x44, 0xee, 0x76, 0x28, 0x8a, 0xc2, 0x51, 0x13, 0xd9,
-	0x8b, 0x2e, 0x9f, 0x8f, 0x2f, 0x1f, 0xd9, 0x42, 0x21, 0xb6, 0x85, 0x65, 0x98, 0xdf, 0x27, 0xdc,
-	0xed, 0xf8, 0x48, 0x45, 0x8a, 0x14, 0x05, 0x93, 0x99, 0x5c, 0xdd, 0xc0, 0x9f, 0xee, 0xef, 0x60,
-	0x75, 0xd0, 0x2a, 0xd1, 0xb5, 0x02, 0x10, 0xf9, 0xdf, 0x24, 0x68, 0x0a, 0x74, 0xef, 0x1c, 0xce,
-	0x40, 0xb1, 0x67, 0x8d, 0x4c, 0x97, 0x6e, 0xb5, 0xae, 0xb0, 0x01, 0xba, 0x02, 0xb5, 0xde, 0x81,
-	0x6a, 0x9a, 0x78, 0xd0, 0x35, 0x55, 0x03, 0xd3, 0x4d, 0x55, 0x94, 0x2a, 0x87, 0x3d, 0x56, 0x0d,
-	0x9c, 0x69, 0x6f, 0x97, 0xa1, 0x3a, 0x54, 0x6d, 0x57, 0x0f, 0x49, 0x3f, 0x08, 0x42, 0x6d, 0x28,
-	0xeb, 0x4e, 0xc7, 0x18, 0x5a, 0xb6, 0xdb, 0x2a, 0x5e, 0x96, 0x96, 0xcb, 0x8a, 0x18, 0x93, 0x15,
-	0x74, 0xfa, 0xd7, 0xae, 0xea, 0x1c, 0x76, 0xd6, 0xf8, 0x8e, 0x42, 0x30, 0xf9, 0x2f, 0x25, 0x58,
-	0xbc, 0xef, 0x38, 0x7a, 0xdf, 0x8c, 0xed, 0x6c, 0x11, 0x4a, 0xa6, 0xa5, 0xe1, 0xce, 0x1a, 0xdd,
-	0x5a, 0x5e, 0xe1, 0x23, 0x74, 0x0e, 0x2a, 0x43, 0x8c, 0xed, 0xae, 0x6d, 0x0d, 0xbc, 0x8d, 0x95,
-	0x09, 0x40, 0xb1, 0x06, 0x18, 0x3d, 0x81, 0x05, 0x27, 0x32, 0x11, 0xd3, 0xab, 0xea, 0xca, 0xd5,
-	0xdb, 0x31, 0xcb, 0xb8, 0x1d, 0x5d, 0x54, 0x89, 0x53, 0xcb, 0x5f, 0xe7, 0xe0, 0xb4, 0xc0, 0x63,
-	0xbc, 0x92, 0xbf, 0x89, 0xe4, 0x1d, 0xdc, 0x17, 0xec, 0xb1, 0x41, 0x16, 0xc9, 0x8b, 0x23, 0xcb,
-	0x07, 0x8f, 0x2c, 0x83, 0xaa, 0x47, 0xcf, 0xa3, 0x18, 0x3f, 0x8f, 0x4b, 0x50, 0xc5, 0x2f, 0x87,
-	0xba, 0x8d, 0xbb, 0x44, 0x71, 0xa8, 0xc8, 0x0b, 0x0a, 0x30, 0xd0, 0xae, 0x6e, 0x04, 0x6d, 0x63,
-	0x2e, 0xb3, 0x6d, 0xc8, 0x7f, 0x25, 0xc1, 0x52, 0xec, 0x94, 0xb8, 0xb1, 0x29, 0xd0, 0xa4, 0x3b,
-	0xf7, 0x25, 0x43, 0xcc, 0x8e, 0x08, 0xfc, 0xfa, 0x38, 0x81, 0xfb, 0xe8, 0x4a, 0x8c, 0x3e, 0xc0,
-	0x64, 0x2e, 0x3b, 0x93, 0x87, 0xb0, 0xb4, 0x81, 0x5d, 0xbe, 0x00, 0xf9, 0x86, 0x9d, 0xe9, 0x9d,
-	0x55, 0xd8, 0xaa, 0x73, 0x51, 0xab, 0x96, 0xff, 0x2e, 0x27, 0x6c, 0x91, 0x2e, 0xd5, 0x31, 0xf7,
-	0x2d, 0x74, 0x1e, 0x2a, 0x02, 0x85, 0x6b, 0x85, 0x0f, 0x40, 0xbf, 0x84, 0x22, 0xe1, 0x94, 0xa9,
-	0x44, 0x63, 0xe5, 0x4a, 0xf2, 0x9e, 0x02, 0x73, 0x2a, 0x0c, 0x1f, 0x75, 0xa0, 0xe1, 0xb8, 0xaa,
-	0xed, 0x76, 0x87, 0x96, 0x43, 0xcf, 0x99, 0x2a, 0x4e, 0x75, 0x45, 0x0e, 0xcf, 0x20, 0xdc, 0xfa,
-	0x96, 0xd3, 0xdf, 0xe6, 0x98, 0x4a, 0x9d, 0x52, 0x7a, 0x43, 0xf4, 0x10, 0x6a, 0xd8, 0xd4, 0xfc,
-	0x89, 0x0a, 0x99, 0x27, 0xaa, 0x62, 0x53, 0x13, 0xd3, 0xf8, 0xe7, 0x53, 0xcc, 0x7e, 0x3e, 0x7f,
-	0x20, 0x41, 0x2b, 0x7e, 0x40, 0xb3, 0xb8, 0xec, 0x7b, 0x8c, 0x08, 0xb3, 0x03, 0x1a, 0x6b, 0xe1,
-	0xe2, 0x90, 0x14, 0x4e, 0x22, 0xff, 0xa9, 0x04, 0x6f, 0xf9, 0xec, 0xd0, 0x4f, 0xaf, 0x4b, 0x5b,
-	0xd0, 0x4d, 0x68, 0xea, 0x66, 0x6f, 0x30, 0xd2, 0xf0, 0x53, 0xf3, 0x11, 0x56, 0x07, 0xee, 0xc1,
-	0x31, 0x3d, 0xc3, 0xb2, 0x12, 0x83, 0xcb, 0xdf, 0x48, 0xb0, 0x18, 0xe5, 0x6b, 0x16, 0x21, 0xfd,
-	0x1a, 0x14, 0x75, 0x73, 0xdf, 0xf2, 0x64, 0x74, 0x71, 0x8c, 0x51, 0x92, 0xb5, 0x18, 0xb2, 0x6c,
-	0xc0, 0xb9, 0x0d, 0xec, 0x76, 0x4c, 0x07, 0xdb, 0xee, 0xaa, 0x6e, 0x0e, 0xac, 0xfe, 0xb6, 0xea,
-	0x1e, 0xcc, 0x60, 0x50, 0x21, 0xdb, 0xc8, 0x45, 0x6c, 0x43, 0xfe, 0x5e, 0x82, 0xf3, 0xc9, 0xeb,
-	0xf1, 0xad, 0xb7, 0xa1, 0xbc, 0xaf, 0xe3, 0x81, 0x46, 0xe4, 0x2b, 0x51, 0xf9, 0x8a, 0x31, 0x31,
-	0xac, 0x21, 0x41, 0xe6, 0x3b, 0xbc, 0x92, 0xa2, 0xcd, 0x3b, 0xae, 0xad, 0x9b, 0xfd, 0x4d, 0xdd,
-	0x71, 0x15, 0x86, 0x1f, 0x90, 0x67, 0x3e, 0xbb, 0x1a, 0xff, 0xbe, 0x04, 0x17, 0x37, 0xb0, 0xfb,
-	0x40, 0xf8, 0x65, 0xf2, 0x5d, 0x77, 0x5c, 0xbd, 0xe7, 0xbc, 0xda, 0xdc, 0x28, 0x43, 0x80, 0x96,
-	0xbf, 0x93, 0xe0, 0x52, 0x2a, 0x33, 0x5c, 0x74, 0xdc, 0xef, 0x78, 0x5e, 0x39, 0xd9, 0xef, 0xfc,
-	0x26, 0x3e, 0xfe, 0x4c, 0x1d, 0x8c, 0xf0, 0xb6, 0xaa, 0xdb, 0xcc, 0xef, 0x4c, 0xe9, 0x85, 0xff,
-	0x56, 0x82, 0x0b, 0x1b, 0xd8, 0xdd, 0xf6, 0x62, 0xd2, 0x1b, 0x94, 0x0e, 0xc1, 0x09, 0xc4, 0x46,
-	0x2f, 0x39, 0x0b, 0xc1, 0xe4, 0x3f, 0x64, 0xc7, 0x99, 0xc8, 0xef, 0x1b, 0x11, 0xe0, 0x45, 0x6a,
-	0x09, 0x01, 0x93, 0x7c, 0xc0, 0x52, 0x07, 0x2e, 0x3e, 0xf9, 0x2f, 0x24, 0x38, 0x7b, 0xbf, 0xf7,
-	0x7c, 0xa4, 0xdb, 0x98, 0x23, 0x6d, 0x5a, 0xbd, 0xc3, 0xe9, 0x85, 0xeb, 0xa7, 0x59, 0xb9, 0x50,
-	0x9a, 0x35, 0x29, 0x35, 0x5f, 0x84, 0x92, 0xcb, 0xf2, 0x3a, 0x96, 0xa9, 0xf0, 0x11, 0xe5, 0x4f,
-	0xc1, 0x03, 0xac, 0x3a, 0xff, 0x37, 0xf9, 0xfb, 0xae, 0x00, 0xb5, 0xcf, 0x78, 0x3a, 0x46, 0xa3,
-	0x76, 0x54, 0x93, 0xa4, 0xe4, 0xc4, 0x2b, 0x90, 0xc1, 0x25, 0x25, 0x75, 0x1b, 0x50, 0x77, 0x30,
-	0x3e, 0x9c, 0x26, 0x46, 0xd7, 0x08, 0xa1, 0x88, 0xad, 0x9b, 0xb0, 0x30, 0x32, 0x69, 0x69, 0x80,
-	0x35, 0x2e, 0x40, 0xa6, 0xb9, 0x93, 0x7d, 0x77, 0x9c, 0x10, 0x3d, 0xe2, 0xd5, 0x47, 0x60, 0xae,
-	0x62, 0xa6, 0xb9, 0xa2, 0x64, 0xa8, 0x03, 0x4d, 0xcd, 0xb6, 0x86, 0x43, 0xac, 0x75, 0x1d, 0x6f,
-	0xaa, 0x52, 0xb6, 0xa9, 0x38, 0x9d, 0x98, 0xea, 0x3d, 0x38, 0x1d, 0xe5, 0xb4, 0xa3, 0x91, 0x84,
-	0x94, 0x9c, 0x61, 0xd2, 0x27, 0x74, 0x0b, 0x16, 0xe2, 0xf8, 0x65, 0x8a, 0x1f, 0xff, 0x80, 0xde,
-	0x05, 0x14, 0x61, 0x95, 0xa0, 0x57, 0x18, 0x7a, 0x98, 0x99, 0x8e, 0xe6, 0xc8, 0xdf, 0x4a, 0xb0,
-	0xf8, 0x4c, 0x75, 0x7b, 0x07, 0x6b, 0x06, 0xb7, 0xb5, 0x19, 0x7c, 0xd5, 0x47, 0x50, 0x79, 0xc1,
-	0xf5, 0xc2, 0x0b, 0x48, 0x97, 0x12, 0xe4, 0x13, 0xd4, 0x40, 0xc5, 0xa7, 0x90, 0x7f, 0x92, 0xe0,
-	0xcc, 0x7a, 0xa0, 0x2e, 0x7c, 0x03, 0x5e, 0x73, 0x52, 0x41, 0x7b, 0x1d, 0x1a, 0x86, 0x6a, 0x1f,
-	0xc6, 0xea, 0xd9, 0x08, 0x54, 0x7e, 0x09, 0xc0, 0x47, 0x5b, 0x4e, 0x7f, 0x0a, 0xfe, 0x3f, 0x84,
-	0x39, 0xbe, 0x2a, 0x77, 0x9f, 0x93, 0xf4, 0xcc, 0x43, 0x97, 0xff, 0x59, 0x82, 0x86, 0x1f, 0x12,
-	0xa9, 0x91, 0x37, 0x20, 0x27, 0x4c, 0x3b, 0xd7, 0x59, 0x43, 0x1f, 0x41, 0x89, 0x35, 0x3a, 0xf8,
-	0xdc, 0xd7, 0xc2, 0x73, 0xf3, 0x26, 0x48, 0x20, 0xae, 0x52, 0x80, 0xc2, 0x89, 0x88, 0x8c, 0x44,
-	0x14, 0x11, 0xce, 0xc7, 0x87, 0xa0, 0x0e, 0xcc, 0x87, 0x53, 0x76, 0xcf, 0x84, 0x2f, 0xa7, 0x05,
-	0x8f, 0x35, 0xd5, 0x55, 0x69, 0xec, 0x68, 0x84, 0x32, 0x76, 0x47, 0xfe, 0xef, 0x22, 0x54, 0x03,
-	0xbb, 0x8c, 0xed, 0x24, 0x7a, 0xa4, 0xb9, 0xc9, 0x75, 0x63, 0x3e, 0x5e, 0x37, 0x5e, 0x83, 0x86,
-	0x4e, 0x93, 0xaf, 0x2e, 0x57, 0x45, 0xea, 0x35, 0x2b, 0x4a, 0x9d, 0x41, 0xb9, 0x5d, 0xa0, 0x8b,
-	0x50, 0x35, 0x47, 0x46, 0xd7, 0xda, 0xef, 0xda, 0xd6, 0x91, 0xc3, 0x0b, 0xd0, 0x8a, 0x39, 0x32,
-	0x3e, 0xdd, 0x57, 0xac, 0x23, 0xc7, 0xaf, 0x71, 0x4a, 0x27, 0xac, 0x71, 0x2e, 0x42, 0xd5, 0x50,
-	0x5f, 0x92, 0x59, 0xbb, 0xe6, 0xc8, 0xa0, 0xb5, 0x69, 0x5e, 0xa9, 0x18, 0xea, 0x4b, 0xc5, 0x3a,
-	0x7a, 0x3c, 0x32, 0xd0, 0x32, 0x34, 0x07, 0xaa, 0xe3, 0x76, 0x83, 0xc5, 0x6d, 0x99, 0x16, 0xb7,
-	0x0d, 0x02, 0x7f, 0xe8, 0x17, 0xb8, 0xf1, 0x6a, 0xa9, 0x32, 0x43, 0xb5, 0xa4, 0x19, 0x03, 0x7f,
-	0x22, 0xc8, 0x5e, 0x2d, 0x69, 0xc6, 0x40, 0x4c, 0xf3, 0x21, 0xcc, 0xed, 0xd1, 0x94, 0xd6, 0x69,
-	0x55, 0x53, 0x1d, 0xe6, 0x3a, 0xc9, 0x66, 0x59, 0xe6, 0xab, 0x78, 0xe8, 0xe8, 0x57, 0x50, 0xa1,
-	0x99, 0x04, 0xa5, 0xad, 0x65, 0xa2, 0xf5, 0x09, 0x08, 0xb5, 0x86, 0x07, 0xae, 0x4a, 0xa9, 0xeb,
-	0xd9, 0xa8, 0x05, 0x01, 0x71, 0xd2, 0x3d, 0x1b, 0xab, 0x2e, 0xd6, 0x56, 0x8f, 0x1f, 0x58, 0xc6,
-	0x50, 0xa5, 0xca, 0xd4, 0x6a, 0xd0, 0xb2, 0x25, 0xe9, 0x13, 0x71, 0x0c, 0x3d, 0x31, 0x5a, 0xb7,
-	0x2d, 0xa3, 0x35, 0xcf, 0x1c, 0x43, 0x18, 0x8a, 0x2e, 0x00, 0x78, 0xee, 0x59, 0x75, 0x5b, 0x4d,
-	0x7a, 0x8a, 0x15, 0x0e, 0xb9, 0xef, 0xca, 0x5f, 0xc1, 0x19, 0x5f, 0x43, 0x02, 0xa7, 0x11, 0x3f,
-	0x58, 0x69, 0xda, 0x83, 0x1d, 0x5f, 0x8c, 0xfc, 0x6b, 0x01, 0x16, 0x77, 0xd4, 0x17, 0xf8, 0xf5,
-	0xd7, 0x3d, 0x99, 0xfc, 0xf1, 0x26, 0x2c, 0xd0, 0x52, 0x67, 0x25, 0xc0, 0xcf, 0x98, 0x84, 0x20,
-	0x78, 0x9c, 0x71, 0x42, 0xf4, 0x09, 0xc9, 0x64, 0x70, 0xef, 0x70, 0xdb, 0xd2, 0xfd, 0x64, 0xe0,
-	0x42, 0xc2, 0x3c, 0x0f, 0x04, 0x96, 0x12, 0xa4, 0x40, 0xdb, 0x71, 0xd7, 0xc6, 0xd2, 0x80, 0x77,
-	0xc6, 0x56, 0xdf, 0xbe, 0xf4, 0xa3, 0x1e, 0x0e, 0xb5, 0x60, 0x8e, 0xc7, 0x70, 0x6a, 0xf7, 0x65,
-	0xc5, 0x1b, 0xa2, 0x6d, 0x38, 0xcd, 0x76, 0xb0, 0xc3, 0x95, 0x9a, 0x6d, 0xbe, 0x9c, 0x69, 0xf3,
-	0x49, 0xa4, 0x61, 0x9b, 0xa8, 0x9c, 0xd4, 0x26, 0x5a, 0x30, 0xc7, 0xf5, 0x94, 0xfa, 0x82, 0xb2,
-	0xe2, 0x0d, 0xc9, 0x31, 0xb3, 0xbe, 0xa6, 0x6e, 0xf6, 0x5b, 0x55, 0xfa, 0xcd, 0x07, 0x90, 0x9a,
-	0x11, 0x7c, 0x79, 0x4e, 0xe8, 0x13, 0x7d, 0x0c, 0x65, 0xa1, 0xe1, 0xb9, 0xcc, 0x1a, 0x2e, 0x68,
-	0xa2, 0x3e, 0x3a, 0x1f, 0xf1, 0xd1, 0xf2, 0xbf, 0x48, 0x50, 0x5b, 0x23, 0x5b, 0xda, 0xb4, 0xfa,
-	0x34, 0xa2, 0x5c, 0x83, 0x86, 0x8d, 0x7b, 0x96, 0xad, 0x75, 0xb1, 0xe9, 0xda, 0x3a, 0x66, 0xed,
-	0x85, 0x82, 0x52, 0x67, 0xd0, 0x87, 0x0c, 0x48, 0xd0, 0x88, 0xdb, 0x75, 0x5c, 0xd5, 0x18, 0x76,
-	0xf7, 0x89, 0x79, 0xe7, 0x18, 0x9a, 0x80, 0x52, 0xeb, 0xbe, 0x02, 0x35, 0x1f, 0xcd, 0xb5, 0xe8,
-	0xfa, 0x05, 0xa5, 0x2a, 0x60, 0xbb, 0x16, 0x7a, 0x1b, 0x1a, 0x54, 0xa6, 0xdd, 0x81, 0xd5, 0xef,
-	0x92, 0x52, 0x9c, 0x07, 0x9b, 0x9a, 0xc6, 0xd9, 0x22, 0x67, 0x15, 0xc6, 0x72, 0xf4, 0x2f, 0x31,
-	0x0f, 0x37, 0x02, 0x6b, 0x47, 0xff, 0x12, 0x93, 0x58, 0x5f, 0x27, 0xb1, 0xf3, 0xb1, 0xa5, 0xe1,
-	0xdd, 0x29, 0x33, 0x8d, 0x0c, 0x3d, 0xdb, 0xf3, 0x50, 0x11, 0x3b, 0xe0, 0x5b, 0xf2, 0x01, 0x68,
-	0x1d, 0x1a, 0x5e, 0x4e, 0xdc, 0x65, 0xa5, 0x62, 0x21, 0x35, 0xf3, 0x0b, 0x44, 0x3f, 0x47, 0xa9,
-	0x7b, 0x64, 0x74, 0x28, 0xaf, 0x43, 0x2d, 0xf8, 0x99, 0xac, 0xba, 0x13, 0x55, 0x14, 0x01, 0x20,
-	0xda, 0xf8, 0x78, 0x64, 0x90, 0x33, 0xe5, 0x8e, 0xc5, 0x1b, 0xca, 0xdf, 0x48, 0x50, 0xe7, 0x21,
-	0x7b, 0x47, 0xdc, 0x6e, 0xd0, 0xad, 0x49, 0x74, 0x6b, 0xf4, 0x6f, 0xf4, 0xeb, 0xe1, 0x86, 0xe4,
-	0xdb, 0x89, 0x4e, 0x80, 0x4e, 0x42, 0xb3, 0xe3, 0x50, 0xbc, 0xce, 0xd2, 0x9c, 0xf8, 0x9a, 0x28,
-	0x1a, 0x3f, 0x1a, 0xaa, 0x68, 0x2d, 0x98, 0x53, 0x35, 0xcd, 0xc6, 0x8e, 0xc3, 0xf9, 0xf0, 0x86,
-	0xe4, 0xcb, 0x0b, 0x6c, 0x3b, 0x9e, 0xca, 0xe7, 0x15, 0x6f, 0x88, 0x7e, 0x05, 0x65, 0x91, 0x4e,
-	0xe7, 0x93, 0x52, 0xa8, 0x20, 0x9f, 0xbc, 0x94, 0x16, 0x14, 0xf2, 0xdf, 0xe7, 0xa0, 0xc1, 0x05,
-	0xb6, 0xca, 0x63, 0xea, 0x78, 0xe3, 0x5b, 0x85, 0xda, 0xbe, 0x6f, 0xfb, 0xe3, 0x9a, 0x66, 0x41,
-	0x17, 0x11, 0xa2, 0x99, 0x64, 0x80, 0xe1, 0xa8, 0x5e, 0x98, 0x29, 0xaa, 0x17, 0x4f, 0xea, 0xc1,
-	0xe2, 0x79, 0x5e, 0x29, 0x21, 0xcf, 0x93, 0x7f, 0x1b, 0xaa, 0x81, 0x09, 0xa8, 0x87, 0x66, 0xdd,
-	0x36, 0x2e, 0x31, 0x6f, 0x88, 0xee, 0xfa, 0xb9, 0x0d, 0x13, 0xd5, 0xd9, 0x04, 0x5e, 0x22, 0x69,
-	0x8d, 0xfc, 0xd7, 0x12, 0x94, 0xf8, 0xcc, 0x97, 0xa0, 0xca, 0x9d, 0x0e, 0xcd, 0xfb, 0xd8, 0xec,
-	0xc0, 0x41, 0x24, 0xf1, 0x7b, 0x75, 0x5e, 0xe7, 0x2c, 0x94, 0x23, 0xfe, 0x66, 0x8e, 0x87, 0x05,
-	0xef, 0x53, 0xc0, 0xc9, 0x90, 0x4f, 0xd4, 0xbf, 0xfc, 0x24, 0xd1, 0x6b, 0x05, 0x05, 0xf7, 0xac,
-	0x17, 0xd8, 0x3e, 0x9e, 0xbd, 0x1f, 0x7b, 0x2f, 0xa0, 0xd0, 0x19, 0xeb, 0x43, 0x41, 0x80, 0xee,
-	0xf9, 0xe2, 0xce, 0x27, 0x35, 0xa3, 0x82, 0x1e, 0x86, 0xab, 0xa3, 0x2f, 0xf6, 0x3f, 0x62, 0x9d,
-	0xe5, 0xf0, 0x56, 0xa6, 0xcd, 0x6b, 0x5e, 0x49, 0xd9, 0x21, 0xff, 0x89, 0x04, 0x67, 0x37, 0xb0,
-	0xbb, 0x1e, 0xee, 0x35, 0xbc, 0x69, 0xae, 0x0c, 0x68, 0x27, 0x31, 0x35, 0xcb, 0xa9, 0xb7, 0xa1,
-	0x2c, 0xba, 0x26, 0xec, 0x7e, 0x40, 0x8c, 0xe5, 0xdf, 0x93, 0xa0, 0xc5, 0x57, 0xa1, 0x6b, 0x92,
-	0x94, 0x7a, 0x80, 0x5d, 0xac, 0xfd, 0xdc, 0x75, 0xf3, 0x8f, 0x12, 0x34, 0x83, 0x1e, 0x9f, 0x3a,
-	0xed, 0x0f, 0xa0, 0x48, 0xdb, 0x13, 0x9c, 0x83, 0x89, 0xca, 0xca, 0xb0, 0x89, 0xcb, 0xa0, 0x69,
-	0xde, 0xae, 0x08, 0x4e, 0x7c, 0xe8, 0x87, 0x9d, 0xfc, 0xc9, 0xc3, 0x0e, 0x0f, 0xc3, 0xd6, 0x88,
-	0xcc, 0xcb, 0xfa, 0x7a, 0x3e, 0x40, 0xfe, 0x0d, 0x58, 0xf4, 0xcb, 0x11, 0x46, 0x37, 0xad, 0x26,
-	0xc9, 0x3f, 0xe4, 0xa0, 0x15, 0x98, 0xec, 0xe7, 0x8e, 0x21, 0x29, 0x99, 0x6f, 0xfe, 0x15, 0x65,
-	0xbe, 0x85, 0xd9, 0xe3, 0x46, 0x31, 0x29, 0x6e, 0xfc, 0x53, 0x0e, 0x1a, 0xbe, 0xd4, 0xb6, 0x07,
-	0xaa, 0x89, 0x16, 0xa1, 0x34, 0x1c, 0xa8, 0x7e, 0x63, 0x95, 0x8f, 0xd0, 0x8e, 0xc8, 0x99, 0xc2,
-	0x72, 0xfa, 0x45, 0x92, 0x3e, 0xa4, 0x1c, 0x84, 0x12, 0x99, 0x82, 0x94, 0x96, 0xac, 0x38, 0xa1,
-	0x0d, 0x02, 0x9e, 0xa7, 0x31, 0xc5, 0xd3, 0x0d, 0x8c, 0x6e, 0x01, 0xe2, 0xda, 0xd2, 0xd5, 0xcd,
-	0xae, 0x83, 0x7b, 0x96, 0xa9, 0x31, 0x3d, 0x2a, 0x2a, 0x4d, 0xfe, 0xa5, 0x63, 0xee, 0x30, 0x38,
-	0xfa, 0x00, 0x0a, 0xee, 0xf1, 0x90, 0x45, 0x84, 0x46, 0xa2, 0xa7, 0xf5, 0xf9, 0xda, 0x3d, 0x1e,
-	0x62, 0x85, 0xa2, 0x7b, 0xcf, 0x38, 0x5c, 0x5b, 0x7d, 0xc1, 0xc3, 0x6b, 0x41, 0x09, 0x40, 0x88,
-	0x65, 0x78, 0x32, 0x9c, 0x63, 0x61, 0x88, 0x0f, 0xe5, 0x7f, 0xc8, 0x41, 0xd3, 0x9f, 0x52, 0xc1,
-	0xce, 0x68, 0xe0, 0xa6, 0xca, 0x6f, 0x7c, 0x61, 0x39, 0x29, 0x07, 0xf9, 0x04, 0xaa, 0xfc, 0x3c,
-	0x4f, 0xa0, 0x0f, 0xc0, 0x48, 0x36, 0xc7, 0x28, 0x68, 0xf1, 0x15, 0x29, 0x68, 0xe9, 0x84, 0x0a,
-	0x2a, 0x7f, 0x2f, 0xc1, 0x5b, 0x31, 0xe3, 0x1f, 0x2b, 0xc0, 0xf1, 0xe9, 0x2f, 0x77, 0x0a, 0xd1,
-	0x29, 0xb9, 0x1f, 0xba, 0x07, 0x25, 0x9b, 0xce, 0xce, 0xdb, 0xfc, 0x57, 0xc7, 0x2a, 0x07, 0x63,
-	0x44, 0xe1, 0x24, 0xf2, 0x1f, 0x4b, 0xb0, 0x14, 0x67, 0x75, 0x86, 0xe0, 0xb2, 0x0a, 0x73, 0x6c,
-	0x6a, 0xcf, 0x86, 0x96, 0xc7, 0xdb, 0x90, 0x2f, 0x1c, 0xc5, 0x23, 0x94, 0x77, 0x60, 0xd1, 0x8b,
-	0x41, 0xbe, 0x80, 0xb7, 0xb0, 0xab, 0x8e, 0x49, 0xfe, 0x2e, 0x41, 0x95, 0xe5, 0x16, 0x2c, 0xa9,
-	0x62, 0x65, 0x13, 0xec, 0x89, 0x6e, 0x03, 0x49, 0xf4, 0xce, 0x50, 0x27, 0x1e, 0xed, 0xab, 0x67,
-	0xb9, 0x73, 0x91, 0x45, 0x55, 0x46, 0x2a, 0x30, 0xb6, 0xb5, 0x8a, 0x12, 0x82, 0x25, 0xf5, 0x59,
-	0xf3, 0x53, 0xf6, 0x59, 0x37, 0xe1, 0xad, 0x08, 0xab, 0x33, 0x1c, 0x09, 0xd9, 0xf9, 0xe2, 0x4e,
-	0xf8, 0xb1, 0xc3, 0xf4, 0x59, 0xcd, 0x05, 0xd1, 0x91, 0xef, 0xea, 0x5a, 0xd4, 0xd6, 0x35, 0xf4,
-	0x31, 0x54, 0x4c, 0x7c, 0xd4, 0x0d, 0x06, 0xd5, 0x0c, 0x8d, 0xd7, 0xb2, 0x89, 0x8f, 0xe8, 0x5f,
-	0xf2, 0x63, 0x58, 0x8a, 0xb1, 0x3a, 0xcb, 0xde, 0xff, 0x51, 0x82, 0xb3, 0x6b, 0xb6, 0x35, 0xfc,
-	0x4c, 0xb7, 0xdd, 0x91, 0x3a, 0x08, 0xdf, 0x5f, 0xbe, 0x9e, 0xf2, 0xfc, 0x51, 0x20, 0xbd, 0x62,
-	0x0a, 0x70, 0x2b, 0xc1, 0x04, 0xe2, 0x4c, 0xf1, 0x4d, 0x07, 0x92, 0xb1, 0xff, 0xcc, 0x27, 0x31,
-	0xcf, 0xf1, 0x26, 0x04, 0xfe, 0x2c, 0xd9, 0x67, 0x62, 0x37, 0x2f, 0x3f, 0x6d, 0x37, 0x2f, 0xc5,
-	0x0b, 0x17, 0x5e, 0x91, 0x17, 0x3e, 0x71, 0x79, 0xf9, 0x08, 0xc2, 0x9d, 0x56, 0x1a, 0xfe, 0xa6,
-	0x6a, 0xd1, 0xae, 0x02, 0xf8, 0x5d, 0x47, 0xfe, 0x56, 0x2d, 0xcb, 0x34, 0x01, 0x2a, 0x72, 0x5a,
-	0x22, 0xe2, 0xd1, 0xdb, 0x82, 0x50, 0x1f, 0xec, 0x09, 0xb4, 0x93, 0xb4, 0x74, 0x16, 0xcd, 0xff,
-	0x21, 0x07, 0xd0, 0x11, 0xcf, 0x1b, 0xa7, 0x73, 0xe6, 0x57, 0xa1, 0xee, 0x2b, 0x8c, 0x6f, 0xef,
-	0x41, 0x2d, 0xd2, 0x88, 0x49, 0x88, 0x82, 0x85, 0xe0, 0xc4, 0x8a, 0x18, 0x8d, 0xce, 0x13, 0xb0,
-	0x1a, 0xa6, 0x14, 0x51, 0xff, 0x79, 0x0e, 0x2a, 0xb6, 0x75, 0xd4, 0x25, 0x66, 0xa6, 0x79, 0xef,
-	0x37, 0x6d, 0xeb, 0x88, 0x18, 0x9f, 0x86, 0x96, 0x60, 0xce, 0x55, 0x9d, 0x43, 0x32, 0x7f, 0x29,
-	0x70, 0x85, 0xae, 0xa1, 0x33, 0x50, 0xdc, 0xd7, 0x07, 0x98, 0xdd, 0xd8, 0x56, 0x14, 0x36, 0x40,
-	0xbf, 0xf4, 0x1e, 0x1a, 0x95, 0x33, 0x3f, 0x93, 0x60, 0x6f, 0x8d, 0x7e, 0x92, 0x60, 0xde, 0x97,
-	0x1a, 0x75, 0x40, 0xc4, 0xa7, 0x51, 0x7f, 0xf6, 0xc0, 0xd2, 0x98, 0xab, 0x68, 0xa4, 0xb8, 0x74,
-	0x46, 0xc8, 0xbc, 0x96, 0x4f, 0x32, 0xae, 0xde, 0x22, 0xfb, 0x22, 0x9b, 0xd6, 0x35, 0xef, 0xe6,
-	0xae, 0x64, 0x5b, 0x47, 0x1d, 0x4d, 0x48, 0x83, 0x3d, 0xce, 0x64, 0xd5, 0x05, 0x91, 0xc6, 0x03,
-	0xfa, 0x3e, 0xf3, 0x2a, 0xd4, 0xb1, 0x6d, 0x5b, 0x76, 0xd7, 0xc0, 0x8e, 0xa3, 0xf6, 0x31, 0x4f,
-	0x80, 0x6b, 0x14, 0xb8, 0xc5, 0x60, 0xf2, 0x8f, 0x79, 0x68, 0xf8, 0x5b, 0xf1, 0xee, 0xeb, 0x74,
-	0xcd, 0xbb, 0xaf, 0xd3, 0xc9, 0xd1, 0x81, 0xcd, 0x5c, 0xa1, 0x38, 0xdc, 0xd5, 0x5c, 0x4b, 0x52,
-	0x2a, 0x1c, 0xda, 0xd1, 0x48, 0x5c, 0x25, 0x46, 0x66, 0x5a, 0x1a, 0xf6, 0x0f, 0x17, 0x3c, 0x10,
-	0x3f, 0xdb, 0x90, 0x8e, 0x14, 0x32, 0xe8, 0x48, 0x31, 0x83, 0x8e, 0x94, 0x12, 0x74, 0x64, 0x11,
-	0x4a, 0x7b, 0xa3, 0xde, 0x21, 0x76, 0x79, 0xba, 0xca, 0x47, 0x61, 0xdd, 0x29, 0x47, 0x74, 0x47,
-	0xa8, 0x48, 0x25, 0xa8, 0x22, 0xe7, 0xa0, 0xc2, 0x2e, 0x8e, 0xba, 0xae, 0x43, 0x3b, 0xe8, 0x79,
-	0xa5, 0xcc, 0x00, 0xbb, 0x0e, 0xfa, 0xd0, 0xcb, 0xc7, 0xaa, 0x49, 0xc6, 0x4e, 0xbd, 0x4e, 0x44,
-	0x4b, 0xbc, 0x6c, 0xec, 0x1a, 0x34, 0xe8, 0x33, 0xf8, 0xe7, 0x23, 0x6c, 0x1f, 0xab, 0x7b, 0x03,
-	0xdc, 0xaa, 0x51, 0x76, 0xea, 0x04, 0xfa, 0xc4, 0x03, 0x12, 0x81, 0x50, 0x34, 0xdd, 0xd4, 0xf0,
-	0x4b, 0xac, 0xb5, 0xea, 0x14, 0x89, 0x8a, 0xba, 0xc3, 0x40, 0xf2, 0x17, 0x80, 0xfc, 0x35, 0x66,
-	0x4b, 0xca, 0x22, 0x87, 0x98, 0x8b, 0x1e, 0xa2, 0xfc, 0x37, 0x12, 0x2c, 0x04, 0x17, 0x9b, 0x36,
-	0x3c, 0x7e, 0x0c, 0x55, 0x76, 0xd3, 0xd0, 0x25, 0xe6, 0xc9, 0x6b, 0xfe, 0x0b, 0x63, 0xa5, 0xa7,
-	0x80, 0xff, 0x08, 0x9b, 0x28, 0xc1, 0x91, 0x65, 0x1f, 0xea, 0x66, 0xbf, 0x4b, 0x38, 0xf3, 0x8c,
-	0xa2, 0xc6, 0x81, 0x8f, 0x09, 0x4c, 0xfe, 0x56, 0x82, 0x8b, 0x4f, 0x87, 0x9a, 0xea, 0xe2, 0x40,
-	0x9e, 0x30, 0xeb, 0xbb, 0xae, 0x0f, 0xbc, 0x87, 0x55, 0xb9, 0x6c, 0xdd, 0x72, 0x86, 0x2d, 0x6f,
-	0xc1, 0x59, 0x05, 0x3b, 0xd8, 0xd4, 0x42, 0x1f, 0xa7, 0xae, 0xf4, 0x87, 0xd0, 0x4e, 0x9a, 0x6e,
-	0x96, 0xb3, 0x67, 0x09, 0x5b, 0xd7, 0x26, 0xd3, 0xba, 0xdc, 0xff, 0x90, 0x3c, 0x81, 0xae, 0xe3,
-	0xca, 0xff, 0x25, 0xc1, 0xc2, 0x7d, 0xcd, 0x5b, 0xef, 0xb5, 0xe5, 0x85, 0xd1, 0xbc, 0x29, 0x1f,
-	0xcf, 0x9b, 0x5e, 0x95, 0x23, 0xe1, 0x2e, 0xd5, 0x1c, 0x19, 0x5e, 0xa8, 0xb0, 0xe9, 0xbd, 0xbd,
-	0xbc, 0x2f, 0x2e, 0x73, 0x15, 0xbc, 0x8f, 0x6d, 0x6c, 0xf6, 0xf0, 0xa6, 0xd5, 0x3b, 0x0c, 0xbc,
-	0xce, 0x92, 0x82, 0xaf, 0xb3, 0xa6, 0x7d, 0xed, 0x75, 0xf3, 0xcf, 0x25, 0x58, 0x88, 0x75, 0x8d,
-	0x50, 0x03, 0xe0, 0xa9, 0xd9, 0xe3, 0xed, 0xb4, 0xe6, 0x29, 0x54, 0x83, 0xb2, 0xd7, 0x5c, 0x6b,
-	0x4a, 0xa8, 0x0a, 0x73, 0xbb, 0x16, 0xc5, 0x6e, 0xe6, 0x50, 0x13, 0x6a, 0x8c, 0x70, 0xd4, 0xeb,
-	0x61, 0xc7, 0x69, 0xe6, 0x05, 0x64, 0x5d, 0xd5, 0x07, 0x23, 0x1b, 0x37, 0x0b, 0xa8, 0x0e, 0x95,
-	0x5d, 0x8b, 0xbf, 0x6d, 0x6b, 0x16, 0x11, 0x82, 0x86, 0xf7, 0xd0, 0x8d, 0x13, 0x95, 0x02, 0x30,
-	0x8f, 0x6c, 0xee, 0xe6, 0xb3, 0x60, 0x4f, 0x64, 0xf7, 0x78, 0x88, 0xd1, 0x12, 0x9c, 0x7e, 0x6a,
-	0x6a, 0x78, 0x5f, 0x37, 0xb1, 0xe6, 0x7f, 0x6a, 0x9e, 0x42, 0xa7, 0x61, 0x7e, 0x0b, 0xdb, 0x7d,
-	0x1c, 0x00, 0xe6, 0xd0, 0x02, 0xd4, 0xb7, 0xf4, 0x97, 0x01, 0x50, 0x5e, 0x2e, 0x94, 0xa5, 0xa6,
-	0xb4, 0xf2, 0x3f, 0x4b, 0x50, 0x21, 0xf5, 0xcc, 0x03, 0xcb, 0xb2, 0x35, 0x34, 0x04, 0x44, 0x9f,
-	0x82, 0x1a, 0x43, 0xcb, 0x14, 0x0f, 0xac, 0xd1, 0x7b, 0x29, 0x39, 0x53, 0x1c, 0x95, 0xeb, 0x61,
-	0xfb, 0x7a, 0x0a, 0x45, 0x04, 0x5d, 0x3e, 0x85, 0x0c, 0xba, 0xe2, 0xae, 0x6e, 0xe0, 0x5d, 0xbd,
-	0x77, 0xe8, 0xbd, 0x11, 0x19, 0xb3, 0x62, 0x04, 0xd5, 0x5b, 0x31, 0x52, 0x3d, 0xf3, 0x01, 0x7b,
-	0xaf, 0xeb, 0x19, 0xa2, 0x7c, 0x0a, 0x3d, 0x87, 0x33, 0x1b, 0x38, 0xe0, 0x78, 0xbc, 0x05, 0x57,
-	0xd2, 0x17, 0x8c, 0x21, 0x9f, 0x70, 0xc9, 0x4d, 0x28, 0xd2, 0x96, 0x2c, 0x4a, 0xf2, 0x4d, 0xc1,
-	0xdf, 0x43, 0xb5, 0x2f, 0xa7, 0x23, 0x88, 0xd9, 0xbe, 0x80, 0xf9, 0xc8, 0xaf, 0x28, 0xd0, 0x8d,
-	0x04, 0xb2, 0xe4, 0xdf, 0xc3, 0xb4, 0x6f, 0x66, 0x41, 0x15, 0x6b, 0xf5, 0xa1, 0x11, 0x7e, 0x46,
-	0x8a, 0x92, 0x9a, 0x02, 0x89, 0x0f, 0xe0, 0xdb, 0x37, 0x32, 0x60, 0x8a, 0x85, 0x0c, 0x68, 0x46,
-	0x5f, 0xf5, 0xa3, 0x9b, 0x63, 0x27, 0x08, 0xab, 0xdb, 0x2f, 0x32, 0xe1, 0x8a, 0xe5, 0x8e, 0xa9,
-	0x12, 0xc4, 0x1e, 0x8a, 0xa3, 0xdb, 0xc9, 0xd3, 0xa4, 0xbd, 0x60, 0x6f, 0xdf, 0xc9, 0x8c, 0x2f,
-	0x96, 0xfe, 0x5d, 0x76, 0x15, 0x94, 0xf4, 0xd8, 0x1a, 0xbd, 0x9f, 0x3c, 0xdd, 0x98, 0x57, 0xe2,
-	0xed, 0x95, 0x93, 0x90, 0x08, 0x26, 0xbe, 0xa2, 0x77, 0x38, 0x09, 0xcf, 0x95, 0xa3, 0x76, 0xe7,
-	0xcd, 0x97, 0xfe, 0x12, 0xbb, 0xfd, 0xfe, 0x09, 0x28, 0x04, 0x03, 0x56, 0xf4, 0x67, 0x13, 0x9e,
-	0x19, 0xde, 0x99, 0xa8, 0x35, 0xd3, 0xd9, 0xe0, 0xe7, 0x30, 0x1f, 0x79, 0x8d, 0x93, 0x68, 0x35,
-	0xc9, 0x2f, 0x76, 0xda, 0xe3, 0xe2, 0x35, 0x33, 0xc9, 0xc8, 0x95, 0x18, 0x4a, 0xd1, 0xfe, 0x84,
-	0x6b, 0xb3, 0xf6, 0xcd, 0x2c, 0xa8, 0x62, 0x23, 0x0e, 0x75, 0x97, 0x91, 0x6b, 0x25, 0x74, 0x2b,
-	0x79, 0x8e, 0xe4, 0x2b, 0xb1, 0xf6, 0xbb, 0x19, 0xb1, 0xc5, 0xa2, 0xbf, 0x03, 0x68, 0xe7, 0x80,
-	0xd4, 0x30, 0xe6, 0xbe, 0xde, 0x1f, 0xd9, 0x2a, 0x7b, 0x72, 0x93, 0xe6, 0xa3, 0xe3, 0xa8, 0x29,
-	0xba, 0x32, 0x96, 0x42, 0x2c, 0xde, 0x05, 0xd8, 0xc0, 0xee, 0x16, 0x76, 0x6d, 0xa2, 0xa0, 0xd7,
-	0x13, 0xcf, 0xdb, 0x47, 0xf0, 0x96, 0x7a, 0x67, 0x22, 0x5e, 0x20, 0x24, 0x34, 0xb7, 0x54, 0x93,
-	0x94, 0xef, 0xfe, 0x33, 0xb4, 0x5b, 0x89, 0xe4, 0x51, 0xb4, 0x14, 0x81, 0xa6, 0x62, 0x8b, 0x25,
-	0x8f, 0x44, 0x98, 0x0d, 0x74, 0x53, 0xa3, 0xee, 0xc7, 0xe7, 0x39, 0xf9, 0x42, 0x2a, 0xea, 0x7e,
-	0xc6, 0xe0, 0x8b, 0x85, 0xbf, 0x96, 0xe8, 0x6f, 0x72, 0x22, 0x08, 0xcf, 0x74, 0xf7, 0x60, 0x7b,
-	0xa0, 0x9a, 0x4e, 0x16, 0x16, 0x28, 0xe2, 0x09, 0x58, 0xe0, 0xf8, 0x82, 0x05, 0x0d, 0xea, 0xa1,
-	0x1e, 0x29, 0x4a, 0x7a, 0xf3, 0x95, 0xd4, 0xf0, 0x6d, 0x2f, 0x4f, 0x46, 0x14, 0xab, 0x1c, 0x40,
-	0xdd, 0x53, 0x69, 0x26, 0xdc, 0x1b, 0x69, 0x9c, 0xfa, 0x38, 0x29, 0x16, 0x99, 0x8c, 0x1a, 0xb4,
-	0xc8, 0x78, 0x0b, 0x08, 0x65, 0x6b, 0x1d, 0x8e, 0xb3, 0xc8, 0xf4, 0xbe, 0x12, 0x73, 0x39, 0x91,
-	0x76, 0x6b, 0xb2, 0x3f, 0x4b, 0xec, 0x1e, 0x27, 0xba, 0x9c, 0x94, 0xee, 0xad, 0x7c, 0x0a, 0x3d,
-	0x83, 0x12, 0xff, 0x31, 0xee, 0xdb, 0xe3, 0x0b, 0x42, 0x3e, 0xfb, 0xb5, 0x09, 0x58, 0x62, 0xe2,
-	0x43, 0x58, 0x4a, 0x29, 0x07, 0x13, 0x43, 0xe1, 0xf8, 0xd2, 0x71, 0x92, 0x93, 0x56, 0x01, 0xc5,
-	0x7f, 0xf1, 0x92, 0x78, 0x4c, 0xa9, 0x3f, 0x8c, 0xc9, 0xb0, 0x44, 0xfc, 0x47, 0x2b, 0x89, 0x4b,
-	0xa4, 0xfe, 0xb6, 0x65, 0xd2, 0x12, 0x4f, 0x00, 0xfc, 0xa2, 0x2f, 0xf1, 0x3c, 0x62, 0x35, 0xe1,
-	0x84, 0x29, 0x57, 0xfe, 0xbd, 0x0c, 0x65, 0xef, 0x85, 0xd5, 0x1b, 0xc8, 0xff, 0xdf, 0x40, 0x42,
-	0xfe, 0x39, 0xcc, 0x47, 0x7e, 0xaa, 0x91, 0x68, 0x3c, 0xc9, 0x3f, 0xe7, 0x98, 0x74, 0x42, 0xcf,
-	0xf8, 0x3f, 0x12, 0x10, 0xb1, 0xf9, 0x9d, 0xb4, 0xa4, 0x3e, 0x1a, 0x96, 0x27, 0x4c, 0xfc, 0xff,
-	0x3b, 0x08, 0x3f, 0x06, 0x08, 0x84, 0xdf, 0xf1, 0xf7, 0xe4, 0x24, 0xa2, 0x4c, 0x92, 0x96, 0x91,
-	0x18, 0x61, 0x6f, 0x64, 0xb9, 0xd3, 0x4c, 0xf7, 0x91, 0xe9, 0x71, 0x75, 0xeb, 0x84, 0x3e, 0x72,
-	0x02, 0xf7, 0x0e, 0xf1, 0x24, 0xd1, 0x76, 0x52, 0x8a, 0x27, 0x49, 0x69, 0x62, 0x25, 0xc6, 0x94,
-	0xf4, 0x1e, 0xd5, 0x6b, 0xf1, 0x2d, 0xab, 0x77, 0x7f, 0xeb, 0xfd, 0xbe, 0xee, 0x1e, 0x8c, 0xf6,
-	0xc8, 0x97, 0x3b, 0x0c, 0xf5, 0x5d, 0xdd, 0xe2, 0x7f, 0xdd, 0xf1, 0x74, 0xef, 0x0e, 0xa5, 0xbe,
-	0x43, 0xd6, 0x18, 0xee, 0xed, 0x95, 0xe8, 0xe8, 0xee, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x48,
-	0x17, 0x7f, 0x8d, 0x97, 0x44, 0x00, 0x00,
+	// 3934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4b, 0x6f, 0x1c, 0x5b,
+	0x5a, 0xa9, 0x7e, 0xb9, 0xfb, 0xeb, 0x87, 0xdb, 0x27, 0xb9, 0x76, 0xa7, 0xf3, 0xae, 0xdc, 0xe4,
+	0x3a, 0x99, 0xc4, 0xc9, 0x75, 0xb8, 0x22, 0x9a, 0xcc, 0xdc, 0x51, 0x1c, 0xc7, 0x4e, 0x83, 0x9d,
+	0x71, 0xca, 0xce, 0x8d, 0xc4, 0x20, 0xb5, 0xca, 0x5d, 0xc7, 0xed, 0x1a, 0x77, 0x55, 0x75, 0xaa,
+	0xaa, 0xe3, 0x78, 0x66, 0x31, 0x57, 0x20, 0x21, 0x81, 0x90, 0x06, 0x81, 0x58, 0xb0, 0x40, 0x42,
+	0xac, 0x80, 0x15, 0xd2, 0x88, 0x05, 0x8f, 0xd9, 0x8f, 0x60, 0x81, 0xb8, 0x3b, 0xfe, 0x00, 0xb0,
+	0xe2, 0x27, 0x20, 0x74, 0x1e, 0x75, 0xea, 0xdd, 0x5d, 0xee, 0x76, 0x6e, 0x10, 0x3b, 0x9f, 0xaf,
+	0xbe, 0xef, 0x3b, 0x8f, 0xef, 0xfd, 0x9d, 0xd3, 0x86, 0xa6, 0xa6, 0xba, 0x6a, 0xb7, 0x67, 0x59,
+	0xb6, 0xb6, 0x32, 0xb4, 0x2d, 0xd7, 0x42, 0x0b, 0x86, 0x3e, 0x78, 0x37, 0x72, 0xd8, 0x68, 0x85,
+	0x7c, 0x6e, 0xd7, 0x7a, 0x96, 0x61, 0x58, 0x26, 0x03, 0xb5, 0x1b, 0xba, 0xe9, 0x62, 0xdb, 0x54,
+	0x07, 0x7c, 0x5c, 0x0b, 0x12, 0xb4, 0x6b, 0x4e, 0xef, 0x10, 0x1b, 0x2a, 0x1b, 0xc9, 0x73, 0x50,
+	0x7c, 0x6e, 0x0c, 0xdd, 0x13, 0xf9, 0x9f, 0x24, 0xa8, 0x6d, 0x0c, 0x46, 0xce, 0xa1, 0x82, 0xdf,
+	0x8e, 0xb0, 0xe3, 0xa2, 0x87, 0x50, 0xd8, 0x57, 0x1d, 0xdc, 0x92, 0xae, 0x4b, 0xcb, 0xd5, 0xd5,
+	0xcb, 0x2b, 0xa1, 0x59, 0xf9, 0x7c, 0xdb, 0x4e, 0x7f, 0x4d, 0x75, 0xb0, 0x42, 0x31, 0x11, 0x82,
+	0x82, 0xb6, 0xdf, 0x59, 0x6f, 0xe5, 0xae, 0x4b, 0xcb, 0x79, 0x85, 0xfe, 0x8d, 0xae, 0x02, 0x38,
+	0xb8, 0x6f, 0x60, 0xd3, 0xed, 0xac, 0x3b, 0xad, 0xfc, 0xf5, 0xfc, 0x72, 0x5e, 0x09, 0x40, 0x90,
+	0x0c, 0xb5, 0x9e, 0x35, 0x18, 0xe0, 0x9e, 0xab, 0x5b, 0x66, 0x67, 0xbd, 0x55, 0xa0, 0xb4, 0x21,
+	0x18, 0xc1, 0x19, 0xaa, 0xb6, 0xab, 0xb3, 0xa1, 0xd3, 0x2a, 0x52, 0x2e, 0x21, 0x98, 0xfc, 0x1f,
+	0x12, 0xd4, 0xf9, 0xf2, 0x9d, 0xa1, 0x65, 0x3a, 0x18, 0x3d, 0x82, 0x92, 0xe3, 0xaa, 0xee, 0xc8,
+	0xe1, 0x3b, 0xb8, 0x94, 0xb8, 0x83, 0x5d, 0x8a, 0xa2, 0x70, 0xd4, 0xc4, 0x2d, 0x44, 0x97, 0x98,
+	0x4f, 0x58, 0x62, 0x78, 0x9b, 0x85, 0xd8, 0x36, 0x97, 0x61, 0xfe, 0x80, 0xac, 0x6e, 0xd7, 0x47,
+	0x62, 0xbb, 0x88, 0x82, 0x09, 0x27, 0x57, 0x37, 0xf0, 0x0f, 0x0f, 0x76, 0xb1, 0x3a, 0x68, 0x95,
+	0xe8, 0x5c, 0x01, 0x88, 0xfc, 0x6f, 0x12, 0x34, 0x05, 0xba, 0x27, 0xab, 0x0b, 0x50, 0xec, 0x59,
+	0x23, 0xd3, 0xa5, 0x5b, 0xad, 0x2b, 0x6c, 0x80, 0x6e, 0x40, 0xad, 0x77, 0xa8, 0x9a, 0x26, 0x1e,
+	0x74, 0x4d, 0xd5, 0xc0, 0x74, 0x53, 0x15, 0xa5, 0xca, 0x61, 0x2f, 0x55, 0x03, 0x67, 0xda, 0xdb,
+	0x75, 0xa8, 0x06, 0x8e, 0x9a, 0x4b, 0x28, 0x08, 0x42, 0x6d, 0x28, 0xeb, 0x4e, 0xc7, 0x18, 0x5a,
+	0xb6, 0xdb, 0x2a, 0x5e, 0x97, 0x96, 0xcb, 0x8a, 0x18, 0x93, 0x19, 0x74, 0xfa, 0xd7, 0x9e, 0xea,
+	0x1c, 0x75, 0xd6, 0xf9, 0x8e, 0x42, 0x30, 0xf9, 0x2f, 0x24, 0x58, 0x7c, 0xea, 0x38, 0x7a, 0xdf,
+	0x8c, 0xed, 0x6c, 0x11, 0x4a, 0xa6, 0xa5, 0xe1, 0xce, 0x3a, 0xdd, 0x5a, 0x5e, 0xe1, 0x23, 0x74,
+	0x09, 0x2a, 0x43, 0x8c, 0xed, 0xae, 0x6d, 0x0d, 0xbc, 0x8d, 0x95, 0x09, 0x40, 0xb1, 0x06, 0x18,
+	0xbd, 0x82, 0x05, 0x27, 0xc2, 0x88, 0xe9, 0x5e, 0x75, 0xf5, 0xe6, 0x4a, 0xcc, 0x7a, 0x56, 0xa2,
+	0x93, 0x2a, 0x71, 0x6a, 0xf9, 0xeb, 0x1c, 0x9c, 0x17, 0x78, 0x6c, 0xad, 0xe4, 0x6f, 0x72, 0xf2,
+	0x0e, 0xee, 0x8b, 0xe5, 0xb1, 0x41, 0x96, 0x93, 0x17, 0x22, 0xcb, 0x07, 0x45, 0x96, 0xc5, 0x1c,
+	0x22, 0xf2, 0x28, 0xc6, 0xe5, 0x71, 0x0d, 0xaa, 0xf8, 0xfd, 0x50, 0xb7, 0x71, 0x97, 0x28, 0x0e,
+	0x3d, 0xf2, 0x82, 0x02, 0x0c, 0xb4, 0xa7, 0x1b, 0x41, 0xdb, 0x98, 0xcb, 0x6c, 0x1b, 0xf2, 0x5f,
+	0x4a, 0xb0, 0x14, 0x93, 0x12, 0x37, 0x36, 0x05, 0x9a, 0x74, 0xe7, 0xfe, 0xc9, 0x10, 0xb3, 0x23,
+	0x07, 0x7e, 0x7b, 0xdc, 0x81, 0xfb, 0xe8, 0x4a, 0x8c, 0x3e, 0xb0, 0xc8, 0x5c, 0xf6, 0x45, 0x1e,
+	0xc1, 0xd2, 0x26, 0x76, 0xf9, 0x04, 0xe4, 0x1b, 0x76, 0xa6, 0x77, 0x68, 0x61, 0xab, 0xce, 0x45,
+	0xad, 0x5a, 0xfe, 0xdb, 0x1c, 0x34, 0x83, 0x53, 0x75, 0xcc, 0x03, 0x0b, 0x5d, 0x86, 0x8a, 0x40,
+	0xe1, 0x5a, 0xe1, 0x03, 0xd0, 0xaf, 0x43, 0x91, 0xac, 0x94, 0xa9, 0x44, 0x63, 0xf5, 0x46, 0xf2,
+	0x9e, 0x02, 0x3c, 0x15, 0x86, 0x8f, 0x3a, 0xd0, 0x70, 0x5c, 0xd5, 0x76, 0xbb, 0x43, 0xcb, 0xa1,
+	0x72, 0xa6, 0x8a, 0x53, 0x5d, 0x95, 0xc3, 0x1c, 0x84, 0xeb, 0xdf, 0x76, 0xfa, 0x3b, 0x1c, 0x53,
+	0xa9, 0x53, 0x4a, 0x6f, 0x88, 0x9e, 0x43, 0x0d, 0x9b, 0x9a, 0xcf, 0xa8, 0x90, 0x99, 0x51, 0x15,
+	0x9b, 0x9a, 0x60, 0xe3, 0xcb, 0xa7, 0x98, 0x5d, 0x3e, 0x7f, 0x28, 0x41, 0x2b, 0x2e, 0xa0, 0x59,
+	0x5c, 0xf6, 0x13, 0x46, 0x84, 0x99, 0x80, 0xc6, 0x5a, 0xb8, 0x10, 0x92, 0xc2, 0x49, 0xe4, 0x3f,
+	0x95, 0xe0, 0x13, 0x7f, 0x39, 0xf4, 0xd3, 0x87, 0xd2, 0x16, 0x74, 0x17, 0x9a, 0xba, 0xd9, 0x1b,
+	0x8c, 0x34, 0xfc, 0xda, 0x7c, 0x81, 0xd5, 0x81, 0x7b, 0x78, 0x42, 0x65, 0x58, 0x56, 0x62, 0x70,
+	0xf9, 0x77, 0x25, 0x58, 0x8c, 0xae, 0x6b, 0x96, 0x43, 0xfa, 0x35, 0x28, 0xea, 0xe6, 0x81, 0xe5,
+	0x9d, 0xd1, 0xd5, 0x31, 0x46, 0x49, 0xe6, 0x62, 0xc8, 0xb2, 0x01, 0x97, 0x36, 0xb1, 0xdb, 0x31,
+	0x1d, 0x6c, 0xbb, 0x6b, 0xba, 0x39, 0xb0, 0xfa, 0x3b, 0xaa, 0x7b, 0x38, 0x83, 0x41, 0x85, 0x6c,
+	0x23, 0x17, 0xb1, 0x0d, 0xf9, 0xaf, 0x24, 0xb8, 0x9c, 0x3c, 0x1f, 0xdf, 0x7a, 0x1b, 0xca, 0x07,
+	0x3a, 0x1e, 0x68, 0x9d, 0x75, 0xe6, 0x5d, 0xf2, 0x8a, 0x18, 0x13, 0xc3, 0x1a, 0x12, 0x64, 0xbe,
+	0xc3, 0x1b, 0x29, 0xda, 0xbc, 0xeb, 0xda, 0xba, 0xd9, 0xdf, 0xd2, 0x1d, 0x57, 0x61, 0xf8, 0x81,
+	0xf3, 0xcc, 0x67, 0x57, 0xe3, 0x3f, 0x90, 0xe0, 0xea, 0x26, 0x76, 0x9f, 0x09, 0xbf, 0x4c, 0xbe,
+	0xeb, 0x8e, 0xab, 0xf7, 0x9c, 0xb3, 0xcd, 0x9f, 0x32, 0x04, 0x68, 0xf9, 0xe7, 0x12, 0x5c, 0x4b,
+	0x5d, 0x0c, 0x3f, 0x3a, 0xee, 0x77, 0x3c, 0xaf, 0x9c, 0xec, 0x77, 0x7e, 0x13, 0x9f, 0x7c, 0xa5,
+	0x0e, 0x46, 0x78, 0x47, 0xd5, 0x6d, 0xe6, 0x77, 0xa6, 0xf4, 0xc2, 0xdf, 0x48, 0x70, 0x65, 0x13,
+	0xbb, 0x3b, 0x5e, 0x4c, 0xfa, 0x88, 0xa7, 0x13, 0xcb, 0x1e, 0x0b, 0xf1, 0xec, 0x11, 0xdd, 0x84,
+	0xfa, 0x10, 0xdb, 0x5d, 0x01, 0xe3, 0x59, 0x4c, 0x6d, 0x88, 0x6d, 0xb1, 0x07, 0xf9, 0x9b, 0x1c,
+	0x95, 0x79, 0xe2, 0xa6, 0x3e, 0xc6, 0x29, 0xa3, 0x9f, 0xc2, 0x05, 0xb1, 0xe2, 0xae, 0x6d, 0x1d,
+	0x77, 0x69, 0x0e, 0xe1, 0x65, 0x3a, 0x9d, 0x04, 0x1b, 0x1f, 0xbf, 0xfc, 0x15, 0xf1, 0x4d, 0xb1,
+	0x8e, 0x9f, 0x51, 0x5e, 0xcf, 0x4d, 0xd7, 0x3e, 0x51, 0xd0, 0x30, 0xf6, 0xa1, 0xfd, 0x1c, 0x96,
+	0x52, 0xd0, 0x51, 0x13, 0xf2, 0x47, 0xf8, 0x84, 0xc7, 0x3e, 0xf2, 0x27, 0x49, 0x76, 0xde, 0x91,
+	0x2d, 0x73, 0xe1, 0xb1, 0xc1, 0x77, 0x73, 0x8f, 0x25, 0xf9, 0x2a, 0x35, 0xf9, 0x80, 0xef, 0x79,
+	0xc6, 0x72, 0x24, 0xae, 0x27, 0xf2, 0x9f, 0x4b, 0x70, 0xf1, 0x69, 0xef, 0xed, 0x48, 0xb7, 0x31,
+	0x47, 0xda, 0xb2, 0x7a, 0x47, 0xd3, 0x6b, 0x91, 0x9f, 0x4f, 0xe6, 0x42, 0xf9, 0xe4, 0xa4, 0x3a,
+	0x65, 0x11, 0x4a, 0x2e, 0x4b, 0x60, 0x59, 0x4a, 0xc6, 0x47, 0x74, 0x7d, 0x0a, 0x1e, 0x60, 0xd5,
+	0xf9, 0xbf, 0xb9, 0xbe, 0x9f, 0x17, 0xa0, 0xf6, 0x15, 0xcf, 0x3b, 0x69, 0x7a, 0x12, 0x35, 0x19,
+	0x29, 0x39, 0xc3, 0x0c, 0xa4, 0xaa, 0x49, 0xd9, 0xeb, 0x26, 0xd4, 0x1d, 0x8c, 0x8f, 0xa6, 0x49,
+	0x46, 0x6a, 0x84, 0xd0, 0x1b, 0xa1, 0x2d, 0x58, 0x18, 0x99, 0xb4, 0x06, 0xc2, 0x1a, 0x3f, 0x40,
+	0x66, 0xa2, 0x93, 0x83, 0x54, 0x9c, 0x10, 0xbd, 0x80, 0xf9, 0x08, 0xa8, 0x55, 0xcc, 0xc4, 0x2b,
+	0x4a, 0x86, 0x3a, 0xd0, 0xd4, 0x6c, 0x6b, 0x38, 0xc4, 0x5a, 0xd7, 0xf1, 0x58, 0x95, 0xb2, 0xb1,
+	0xe2, 0x74, 0x82, 0xd5, 0x43, 0x38, 0x1f, 0x5d, 0x69, 0x47, 0x23, 0x99, 0x37, 0x91, 0x61, 0xd2,
+	0x27, 0x74, 0x0f, 0x16, 0xe2, 0xf8, 0x65, 0x8a, 0x1f, 0xff, 0x80, 0xee, 0x03, 0x8a, 0x2c, 0x95,
+	0xa0, 0x57, 0x18, 0x7a, 0x78, 0x31, 0x1d, 0xcd, 0x91, 0x7f, 0x5f, 0x82, 0xc5, 0x37, 0xaa, 0xdb,
+	0x3b, 0x5c, 0x37, 0xb8, 0xad, 0xcd, 0xe0, 0x94, 0xbf, 0x0f, 0x95, 0x77, 0x5c, 0x2f, 0xbc, 0xc8,
+	0x7b, 0x2d, 0xe1, 0x7c, 0x82, 0x1a, 0xa8, 0xf8, 0x14, 0xf2, 0xaf, 0x24, 0xb8, 0xb0, 0x11, 0x28,
+	0x80, 0x3f, 0x42, 0x78, 0x98, 0x54, 0xb9, 0xdf, 0x86, 0x86, 0xa1, 0xda, 0x47, 0xb1, 0xc2, 0x3d,
+	0x02, 0x95, 0xdf, 0x03, 0xf0, 0xd1, 0xb6, 0xd3, 0x9f, 0x62, 0xfd, 0x8f, 0x61, 0x8e, 0xcf, 0xca,
+	0x43, 0xc0, 0x24, 0x3d, 0xf3, 0xd0, 0xe5, 0x7f, 0x96, 0xa0, 0xe1, 0xc7, 0x7e, 0x6a, 0xe4, 0x0d,
+	0xc8, 0x09, 0xd3, 0xce, 0x75, 0xd6, 0xd1, 0xf7, 0xa1, 0xc4, 0xba, 0x3e, 0x9c, 0xf7, 0xad, 0x30,
+	0x6f, 0xf6, 0x6d, 0xc5, 0x67, 0xb2, 0x4b, 0x01, 0x0a, 0x27, 0x22, 0x67, 0x24, 0x22, 0x80, 0x70,
+	0x3e, 0x3e, 0x04, 0x75, 0x60, 0x3e, 0x5c, 0x9b, 0x78, 0x26, 0x7c, 0x3d, 0x2d, 0x00, 0xae, 0xab,
+	0xae, 0x4a, 0xe3, 0x5f, 0x23, 0x54, 0x9a, 0x38, 0xf2, 0x7f, 0x17, 0xa1, 0x1a, 0xd8, 0x65, 0x6c,
+	0x27, 0x51, 0x91, 0xe6, 0x26, 0x17, 0xc8, 0xf9, 0x78, 0x81, 0x7c, 0x0b, 0x1a, 0x3a, 0xcd, 0x32,
+	0xbb, 0x5c, 0x15, 0xa9, 0xd7, 0xac, 0x28, 0x75, 0x06, 0xe5, 0x76, 0x81, 0xae, 0x42, 0xd5, 0x1c,
+	0x19, 0x5d, 0xeb, 0x80, 0x44, 0x57, 0x87, 0x57, 0xda, 0x15, 0x73, 0x64, 0xfc, 0xf0, 0x40, 0xb1,
+	0x8e, 0x1d, 0xbf, 0x98, 0x2b, 0x9d, 0xb2, 0x98, 0xbb, 0x0a, 0x55, 0x43, 0x7d, 0x4f, 0x63, 0xb6,
+	0x39, 0x32, 0x68, 0x11, 0x9e, 0x57, 0x2a, 0x86, 0xfa, 0x5e, 0xb1, 0x8e, 0x5f, 0x8e, 0x0c, 0xb4,
+	0x0c, 0xcd, 0x81, 0xea, 0xb8, 0xdd, 0x60, 0x15, 0x5f, 0xa6, 0x55, 0x7c, 0x83, 0xc0, 0x9f, 0xfb,
+	0x95, 0x7c, 0xbc, 0x2c, 0xac, 0xcc, 0x50, 0x16, 0x6a, 0xc6, 0xc0, 0x67, 0x04, 0xd9, 0xcb, 0x42,
+	0xcd, 0x18, 0x08, 0x36, 0x8f, 0x61, 0x6e, 0x9f, 0xe6, 0xee, 0x4e, 0xab, 0x9a, 0xea, 0x30, 0x37,
+	0x48, 0xda, 0xce, 0x52, 0x7c, 0xc5, 0x43, 0x47, 0xdf, 0x83, 0x0a, 0xcd, 0x86, 0x28, 0x6d, 0x2d,
+	0x13, 0xad, 0x4f, 0x40, 0xa8, 0x35, 0x3c, 0x70, 0x55, 0x4a, 0x5d, 0xcf, 0x46, 0x2d, 0x08, 0x88,
+	0x93, 0xee, 0xd9, 0x58, 0x75, 0xb1, 0xb6, 0x76, 0xf2, 0xcc, 0x32, 0x86, 0x2a, 0x55, 0xa6, 0x56,
+	0x83, 0xe6, 0x81, 0x49, 0x9f, 0x88, 0x63, 0xe8, 0x89, 0xd1, 0x86, 0x6d, 0x19, 0xad, 0x79, 0xe6,
+	0x18, 0xc2, 0x50, 0x74, 0x05, 0xc0, 0x73, 0xcf, 0xaa, 0xdb, 0x6a, 0x52, 0x29, 0x56, 0x38, 0xe4,
+	0xa9, 0x2b, 0xff, 0x0c, 0x2e, 0xf8, 0x1a, 0x12, 0x90, 0x46, 0x5c, 0xb0, 0xd2, 0xb4, 0x82, 0x1d,
+	0x5f, 0x75, 0xfd, 0x6b, 0x01, 0x16, 0x77, 0xd5, 0x77, 0xf8, 0xc3, 0x17, 0x78, 0x99, 0xfc, 0xf1,
+	0x16, 0x2c, 0xd0, 0x9a, 0x6e, 0x35, 0xb0, 0x9e, 0x56, 0x21, 0x93, 0x38, 0xe3, 0x84, 0xe8, 0x07,
+	0x24, 0x93, 0xc1, 0xbd, 0xa3, 0x1d, 0x4b, 0xf7, 0x93, 0x81, 0x2b, 0x09, 0x7c, 0x9e, 0x09, 0x2c,
+	0x25, 0x48, 0x81, 0x76, 0xe2, 0xae, 0x8d, 0xa5, 0x01, 0x9f, 0x8d, 0x6d, 0x33, 0xf8, 0xa7, 0x1f,
+	0xf5, 0x70, 0xa8, 0x05, 0x73, 0x3c, 0x86, 0x53, 0xbb, 0x2f, 0x2b, 0xde, 0x10, 0xed, 0xc0, 0x79,
+	0xb6, 0x83, 0x5d, 0xae, 0xd4, 0x6c, 0xf3, 0xe5, 0x4c, 0x9b, 0x4f, 0x22, 0x0d, 0xdb, 0x44, 0xe5,
+	0xb4, 0x36, 0xd1, 0x82, 0x39, 0xae, 0xa7, 0xd4, 0x17, 0x94, 0x15, 0x6f, 0x48, 0xc4, 0xcc, 0x1a,
+	0xb8, 0xba, 0xd9, 0x6f, 0x55, 0xe9, 0x37, 0x1f, 0x40, 0x8a, 0x63, 0xf0, 0xcf, 0x73, 0x42, 0x43,
+	0xec, 0x4b, 0x28, 0x0b, 0x0d, 0xcf, 0x65, 0xd6, 0x70, 0x41, 0x13, 0xf5, 0xd1, 0xf9, 0x88, 0x8f,
+	0x96, 0xff, 0x45, 0x82, 0xda, 0x3a, 0xd9, 0xd2, 0x96, 0xd5, 0xa7, 0x11, 0xe5, 0x16, 0x34, 0x6c,
+	0xdc, 0xb3, 0x6c, 0xad, 0x8b, 0x4d, 0xd7, 0xd6, 0x31, 0xeb, 0xa3, 0x14, 0x94, 0x3a, 0x83, 0x3e,
+	0x67, 0x40, 0x82, 0x46, 0xdc, 0xae, 0xe3, 0xaa, 0xc6, 0xb0, 0x7b, 0x40, 0xcc, 0x3b, 0xc7, 0xd0,
+	0x04, 0x94, 0x5a, 0xf7, 0x0d, 0xa8, 0xf9, 0x68, 0xae, 0x45, 0xe7, 0x2f, 0x28, 0x55, 0x01, 0xdb,
+	0xb3, 0xd0, 0xa7, 0xd0, 0xa0, 0x67, 0xda, 0x1d, 0x58, 0xfd, 0x2e, 0xe9, 0x39, 0xf0, 0x60, 0x53,
+	0xd3, 0xf8, 0xb2, 0x88, 0xac, 0xc2, 0x58, 0x8e, 0xfe, 0x13, 0xcc, 0xc3, 0x8d, 0xc0, 0xda, 0xd5,
+	0x7f, 0x82, 0x49, 0xac, 0xaf, 0x93, 0xd8, 0xf9, 0xd2, 0xd2, 0xf0, 0xde, 0x94, 0x99, 0x46, 0x86,
+	0xe6, 0xf4, 0x65, 0xa8, 0x88, 0x1d, 0xf0, 0x2d, 0xf9, 0x00, 0xb4, 0x01, 0x0d, 0x2f, 0x27, 0xee,
+	0xb2, 0x72, 0xb7, 0x90, 0x9a, 0xf9, 0x05, 0xa2, 0x9f, 0xa3, 0xd4, 0x3d, 0x32, 0x3a, 0x94, 0x37,
+	0xa0, 0x16, 0xfc, 0x4c, 0x66, 0xdd, 0x8d, 0x2a, 0x8a, 0x00, 0x10, 0x6d, 0x7c, 0x39, 0x32, 0x88,
+	0x4c, 0xb9, 0x63, 0xf1, 0x86, 0xa4, 0x59, 0x56, 0xe7, 0x21, 0x7b, 0x57, 0x5c, 0xe3, 0xd0, 0xad,
+	0x49, 0x74, 0x6b, 0xf4, 0x6f, 0xf4, 0xdd, 0x70, 0xe7, 0xf5, 0xd3, 0x44, 0x27, 0x40, 0x99, 0xd0,
+	0xec, 0x38, 0x14, 0xaf, 0xb3, 0x74, 0x61, 0xbe, 0x26, 0x8a, 0xc6, 0x45, 0x43, 0x15, 0xad, 0x05,
+	0x73, 0xaa, 0xa6, 0xd9, 0xd8, 0x71, 0xf8, 0x3a, 0xbc, 0x21, 0xf9, 0xf2, 0x0e, 0xdb, 0x8e, 0xa7,
+	0xf2, 0x79, 0xc5, 0x1b, 0xa2, 0xef, 0x41, 0x59, 0xa4, 0xd3, 0xf9, 0xa4, 0x14, 0x2a, 0xb8, 0x4e,
+	0xb6, 0x59, 0x45, 0x50, 0xc8, 0x7f, 0x97, 0x83, 0x06, 0x3f, 0xb0, 0x35, 0x1e, 0x53, 0xc7, 0x1b,
+	0xdf, 0x1a, 0xd4, 0x0e, 0x7c, 0xdb, 0x1f, 0xd7, 0x1d, 0x0c, 0xba, 0x88, 0x10, 0xcd, 0x24, 0x03,
+	0x0c, 0x47, 0xf5, 0xc2, 0x4c, 0x51, 0xbd, 0x78, 0x5a, 0x0f, 0x16, 0xcf, 0xf3, 0x4a, 0x09, 0x79,
+	0x9e, 0xfc, 0xdb, 0x50, 0x0d, 0x30, 0xa0, 0x1e, 0x9a, 0xb5, 0x15, 0xf9, 0x89, 0x79, 0x43, 0xf4,
+	0xc8, 0xcf, 0x6d, 0xd8, 0x51, 0x5d, 0x4c, 0x58, 0x4b, 0x24, 0xad, 0x91, 0xff, 0x5a, 0x82, 0x12,
+	0xe7, 0x4c, 0x2e, 0x66, 0x98, 0x7f, 0xa1, 0x79, 0x1f, 0xe3, 0x0e, 0x1c, 0x44, 0x12, 0xbf, 0xb3,
+	0xf3, 0x3a, 0x17, 0xa1, 0x1c, 0xf1, 0x37, 0x73, 0x3c, 0x2c, 0x78, 0x9f, 0x02, 0x4e, 0x66, 0x6e,
+	0xc0, 0xfd, 0xcb, 0xaf, 0x24, 0x7a, 0x7f, 0xa2, 0xe0, 0x9e, 0xf5, 0x0e, 0xdb, 0x27, 0xb3, 0x37,
+	0x9e, 0x9f, 0x04, 0x14, 0x3a, 0x63, 0x7d, 0x28, 0x08, 0xd0, 0x13, 0xff, 0xb8, 0xf3, 0x49, 0x0d,
+	0xb5, 0xa0, 0x87, 0xe1, 0xea, 0xe8, 0x1f, 0xfb, 0x1f, 0xb1, 0x16, 0x7a, 0x78, 0x2b, 0xd3, 0xe6,
+	0x35, 0x67, 0x52, 0x76, 0xc8, 0x7f, 0x22, 0xc1, 0xc5, 0x4d, 0xec, 0x6e, 0x84, 0x7b, 0x0d, 0x1f,
+	0x7b, 0x55, 0x06, 0xb4, 0x93, 0x16, 0x35, 0x8b, 0xd4, 0xdb, 0x50, 0x16, 0x5d, 0x13, 0x76, 0x11,
+	0x22, 0xc6, 0xf2, 0xef, 0x49, 0xd0, 0xe2, 0xb3, 0xd0, 0x39, 0x49, 0x4a, 0x3d, 0xc0, 0x2e, 0xd6,
+	0xbe, 0xed, 0xba, 0xf9, 0x97, 0x12, 0x34, 0x83, 0x1e, 0x9f, 0x7c, 0x45, 0x5f, 0x40, 0x91, 0xb6,
+	0x27, 0xf8, 0x0a, 0x26, 0x2a, 0x2b, 0xc3, 0x26, 0x2e, 0x83, 0xa6, 0x79, 0x7b, 0x22, 0x38, 0xf1,
+	0xa1, 0x1f, 0x76, 0xf2, 0xa7, 0x0f, 0x3b, 0x3c, 0x0c, 0x5b, 0x23, 0xc2, 0x97, 0xf5, 0xf5, 0x7c,
+	0x80, 0xfc, 0x1b, 0xb0, 0xe8, 0x97, 0x23, 0x8c, 0x6e, 0x5a, 0x4d, 0x92, 0x7f, 0x91, 0x83, 0x56,
+	0x80, 0xd9, 0xb7, 0x1d, 0x43, 0x52, 0x32, 0xdf, 0xfc, 0x19, 0x65, 0xbe, 0x85, 0xd9, 0xe3, 0x46,
+	0x31, 0x29, 0x6e, 0xfc, 0x63, 0x0e, 0x1a, 0xfe, 0xa9, 0xed, 0x0c, 0x54, 0x93, 0xf4, 0x61, 0x87,
+	0x03, 0xd5, 0x6f, 0xac, 0xf2, 0x11, 0xda, 0x15, 0x39, 0x53, 0xf8, 0x9c, 0xbe, 0x93, 0xa4, 0x0f,
+	0x29, 0x82, 0x50, 0x22, 0x2c, 0x48, 0x69, 0xc9, 0x8a, 0x13, 0xda, 0x20, 0xe0, 0x79, 0x1a, 0x53,
+	0x3c, 0xd2, 0x1b, 0xb8, 0x07, 0x88, 0x6b, 0x4b, 0x57, 0x37, 0xbb, 0x0e, 0xee, 0x59, 0xa6, 0xc6,
+	0xf4, 0xa8, 0xa8, 0x34, 0xf9, 0x97, 0x8e, 0xb9, 0xcb, 0xe0, 0xe8, 0x0b, 0x28, 0xb8, 0x27, 0x43,
+	0x16, 0x11, 0x1a, 0xab, 0x37, 0xc6, 0xae, 0x6b, 0xef, 0x64, 0x88, 0x15, 0x8a, 0xee, 0xbd, 0x57,
+	0x71, 0x6d, 0xf5, 0x1d, 0x0f, 0xaf, 0x05, 0x25, 0x00, 0x21, 0x96, 0xe1, 0x9d, 0xe1, 0x1c, 0x0b,
+	0x43, 0x7c, 0x28, 0xff, 0x7d, 0x0e, 0x9a, 0x3e, 0x4b, 0x05, 0x3b, 0xa3, 0x81, 0x9b, 0x7a, 0x7e,
+	0xe3, 0x0b, 0xcb, 0x49, 0x39, 0xc8, 0x0f, 0xa0, 0xca, 0xe5, 0x79, 0x0a, 0x7d, 0x00, 0x46, 0xb2,
+	0x35, 0x46, 0x41, 0x8b, 0x67, 0xa4, 0xa0, 0xa5, 0x53, 0x2a, 0x28, 0xb9, 0x2a, 0xfd, 0x24, 0x66,
+	0xfc, 0x63, 0x0f, 0x70, 0x7c, 0xfa, 0xcb, 0x9d, 0x42, 0x94, 0x25, 0xf7, 0x43, 0x4f, 0xa0, 0x64,
+	0x53, 0xee, 0xbc, 0xcd, 0x7f, 0x73, 0xac, 0x72, 0xb0, 0x85, 0x28, 0x9c, 0x44, 0xfe, 0x63, 0x09,
+	0x96, 0xe2, 0x4b, 0x9d, 0x21, 0xb8, 0xac, 0xc1, 0x1c, 0x63, 0xed, 0xd9, 0xd0, 0xf2, 0x78, 0x1b,
+	0xf2, 0x0f, 0x47, 0xf1, 0x08, 0xe5, 0x5d, 0x58, 0xf4, 0x62, 0x90, 0x7f, 0xc0, 0xdb, 0xd8, 0x55,
+	0xc7, 0x24, 0x7f, 0xd7, 0xa0, 0xca, 0x72, 0x0b, 0x96, 0x54, 0xb1, 0xb2, 0x09, 0xf6, 0x45, 0xb7,
+	0x81, 0x24, 0x7a, 0x17, 0xa8, 0x13, 0x8f, 0xf6, 0xd5, 0xb3, 0xdc, 0xb9, 0xc8, 0x50, 0x0b, 0x54,
+	0x60, 0x6c, 0x6b, 0x15, 0x25, 0x04, 0x4b, 0xea, 0xb3, 0xe6, 0xa7, 0xec, 0xb3, 0x6e, 0xc1, 0x27,
+	0x91, 0xa5, 0xce, 0x20, 0x12, 0xb2, 0xf3, 0xc5, 0xdd, 0xf0, 0xab, 0x8e, 0xe9, 0xb3, 0x9a, 0x2b,
+	0xa2, 0x23, 0xdf, 0xd5, 0xb5, 0xa8, 0xad, 0x6b, 0xe8, 0x4b, 0xa8, 0x98, 0xf8, 0xb8, 0x1b, 0x0c,
+	0xaa, 0x19, 0x1a, 0xaf, 0x65, 0x13, 0x1f, 0xd3, 0xbf, 0xe4, 0x97, 0xb0, 0x14, 0x5b, 0xea, 0x2c,
+	0x7b, 0xff, 0x07, 0x09, 0x2e, 0xae, 0xdb, 0xd6, 0xf0, 0x2b, 0xdd, 0x76, 0x47, 0xea, 0x20, 0x7c,
+	0x7f, 0xf9, 0x61, 0xca, 0xf3, 0x17, 0x81, 0xf4, 0x8a, 0x29, 0xc0, 0xbd, 0x04, 0x13, 0x88, 0x2f,
+	0x8a, 0x6f, 0x3a, 0x90, 0x8c, 0xfd, 0x67, 0x1e, 0x2e, 0xa6, 0xe2, 0x4d, 0x08, 0xfc, 0x59, 0xb2,
+	0xcf, 0xc4, 0x6e, 0x5e, 0x7e, 0xda, 0x6e, 0x5e, 0x8a, 0x17, 0x2e, 0x9c, 0x91, 0x17, 0x3e, 0x75,
+	0x79, 0xf9, 0x02, 0xc2, 0x9d, 0xd6, 0x56, 0x29, 0x73, 0x03, 0x2b, 0x4c, 0x88, 0xd6, 0x00, 0xfc,
+	0xae, 0x63, 0x6b, 0x2e, 0x33, 0x9b, 0x00, 0x15, 0x91, 0x96, 0x88, 0x78, 0xad, 0x72, 0x24, 0x04,
+	0xca, 0xaf, 0xa0, 0x9d, 0xa4, 0xa5, 0xb3, 0x68, 0xfe, 0x2f, 0x72, 0x00, 0x1d, 0xf1, 0x8e, 0x73,
+	0x3a, 0x67, 0x7e, 0x13, 0xea, 0xbe, 0xc2, 0xf8, 0xf6, 0x1e, 0xd4, 0x22, 0x8d, 0x98, 0x84, 0xff,
+	0xd0, 0x41, 0xd7, 0xe2, 0x45, 0x8c, 0x46, 0xf9, 0x04, 0xac, 0x86, 0x29, 0x45, 0xd4, 0x7f, 0x5e,
+	0x82, 0x0a, 0xb9, 0x72, 0x21, 0x66, 0xa6, 0x79, 0x0f, 0x55, 0x6d, 0xeb, 0x98, 0x18, 0x9f, 0x86,
+	0x96, 0x60, 0x8e, 0xdc, 0x99, 0x13, 0xfe, 0xa5, 0xc0, 0x15, 0xba, 0x46, 0x1e, 0x2f, 0x1c, 0xe8,
+	0x03, 0xcc, 0x6e, 0x6c, 0x2b, 0x0a, 0x1b, 0x90, 0xbb, 0x1f, 0xf6, 0xa2, 0xaa, 0x9c, 0xf9, 0xa9,
+	0x07, 0xc5, 0x27, 0x25, 0xf6, 0xbc, 0x7f, 0x6a, 0xd4, 0x01, 0x11, 0x9f, 0x46, 0xfd, 0xd9, 0x33,
+	0x4b, 0x63, 0xae, 0xa2, 0x91, 0xe2, 0xd2, 0x19, 0x21, 0x25, 0x52, 0x7c, 0x92, 0x71, 0xf5, 0x16,
+	0xd9, 0x17, 0xd9, 0xb4, 0xae, 0x79, 0x37, 0x77, 0x25, 0xdb, 0x3a, 0xee, 0x68, 0xe2, 0x34, 0xd8,
+	0x2b, 0x54, 0x56, 0x5d, 0x94, 0x6d, 0xfe, 0x92, 0x83, 0x9c, 0x27, 0xb6, 0x6d, 0xcb, 0xee, 0x1a,
+	0xd8, 0x71, 0xd4, 0x3e, 0xe6, 0x09, 0x70, 0x8d, 0x02, 0xb7, 0x19, 0x4c, 0xfe, 0x65, 0x1e, 0x1a,
+	0xfe, 0x56, 0xbc, 0xfb, 0x3a, 0x5d, 0xf3, 0xee, 0xeb, 0x74, 0x22, 0x3a, 0xb0, 0x99, 0x2b, 0x14,
+	0xc2, 0x5d, 0xcb, 0xb5, 0x24, 0xa5, 0xc2, 0xa1, 0x1d, 0x8d, 0xc4, 0x55, 0x62, 0x64, 0xa6, 0xa5,
+	0x61, 0x5f, 0xb8, 0xe0, 0x81, 0xb8, 0x6c, 0x43, 0x3a, 0x52, 0xc8, 0xa0, 0x23, 0xc5, 0x0c, 0x3a,
+	0x52, 0x4a, 0xd0, 0x91, 0x45, 0x28, 0xed, 0x8f, 0x7a, 0x47, 0xd8, 0xe5, 0xe9, 0x2a, 0x1f, 0x85,
+	0x75, 0xa7, 0x1c, 0xd1, 0x1d, 0xa1, 0x22, 0x95, 0xa0, 0x8a, 0x5c, 0x82, 0x0a, 0xbb, 0x38, 0xea,
+	0xba, 0x0e, 0xed, 0xa0, 0xe7, 0x95, 0x32, 0x03, 0xec, 0x39, 0xe8, 0xb1, 0x97, 0x8f, 0x55, 0x93,
+	0x8c, 0x9d, 0x7a, 0x9d, 0x88, 0x96, 0x78, 0xd9, 0xd8, 0x2d, 0x68, 0x90, 0xcf, 0xdd, 0xb7, 0x23,
+	0x6c, 0x9f, 0xa8, 0xfb, 0x03, 0xdc, 0xaa, 0xd1, 0xe5, 0xd4, 0x09, 0xf4, 0x95, 0x07, 0x24, 0x07,
+	0x42, 0xd1, 0x74, 0x53, 0xc3, 0xef, 0xb1, 0xd6, 0xaa, 0x53, 0x24, 0x7a, 0xd4, 0x1d, 0x06, 0x92,
+	0x7f, 0x0c, 0xc8, 0x9f, 0x63, 0xb6, 0xa4, 0x2c, 0x22, 0xc4, 0x5c, 0x54, 0x88, 0xf2, 0xdf, 0x48,
+	0xb0, 0x10, 0x9c, 0x6c, 0xda, 0xf0, 0xf8, 0x25, 0x54, 0xd9, 0x4d, 0x43, 0x97, 0x98, 0x27, 0xaf,
+	0xf9, 0xaf, 0x8c, 0x3d, 0x3d, 0x05, 0xfc, 0xd7, 0xe6, 0x44, 0x09, 0x8e, 0x2d, 0xfb, 0x48, 0x37,
+	0xfb, 0x5d, 0xb2, 0x32, 0xcf, 0x28, 0x6a, 0x1c, 0x48, 0xba, 0xb7, 0xf4, 0x8d, 0xc4, 0xd5, 0xd7,
+	0x43, 0x4d, 0x75, 0x71, 0x20, 0x4f, 0x98, 0xf5, 0x01, 0xdb, 0x17, 0xde, 0xe3, 0xb0, 0x5c, 0xb6,
+	0x6e, 0x39, 0xc3, 0x96, 0xb7, 0xc9, 0x03, 0x23, 0x07, 0x9b, 0x5a, 0xe8, 0xe3, 0xd4, 0x95, 0xfe,
+	0x10, 0xda, 0x49, 0xec, 0x66, 0x91, 0x3d, 0x4b, 0xd8, 0xba, 0x36, 0x76, 0x58, 0x17, 0x26, 0xcf,
+	0xf3, 0x04, 0x3a, 0x8f, 0x2b, 0xff, 0x97, 0x04, 0x0b, 0x4f, 0x35, 0x6f, 0xbe, 0x0f, 0x96, 0x17,
+	0x46, 0xf3, 0xa6, 0x7c, 0x3c, 0x6f, 0x3a, 0x2b, 0x47, 0xc2, 0x5d, 0x2a, 0x69, 0xe1, 0xf2, 0x50,
+	0x61, 0xd3, 0x7b, 0x7b, 0xf9, 0x40, 0x5c, 0xe6, 0x2a, 0xf8, 0x00, 0xdb, 0xd8, 0xec, 0x61, 0xf2,
+	0x1c, 0x2c, 0xf0, 0x3a, 0x4b, 0x0a, 0xbe, 0xce, 0x9a, 0xf6, 0xb5, 0xd7, 0xdd, 0x3f, 0x93, 0x60,
+	0x21, 0xd6, 0x35, 0x42, 0x0d, 0x80, 0xd7, 0x66, 0x8f, 0xb7, 0xd3, 0x9a, 0xe7, 0x50, 0x0d, 0xca,
+	0x5e, 0x73, 0xad, 0x29, 0xa1, 0x2a, 0xcc, 0xed, 0x59, 0x14, 0xbb, 0x99, 0x43, 0x4d, 0xa8, 0x31,
+	0xc2, 0x51, 0xaf, 0x87, 0x1d, 0xa7, 0x99, 0x17, 0x90, 0x0d, 0x55, 0x1f, 0x8c, 0x6c, 0xdc, 0x2c,
+	0xa0, 0x3a, 0x54, 0xf6, 0x2c, 0xfe, 0xb6, 0xad, 0x59, 0x44, 0x08, 0x1a, 0x7c, 0xe0, 0x11, 0x95,
+	0x02, 0x30, 0x8f, 0x6c, 0xee, 0xee, 0x1b, 0x68, 0x84, 0x1b, 0x05, 0x68, 0x09, 0xce, 0xbf, 0x36,
+	0x35, 0x7c, 0xa0, 0x9b, 0x58, 0xf3, 0x3f, 0x35, 0xcf, 0xa1, 0xf3, 0x30, 0xbf, 0x8d, 0xed, 0x3e,
+	0x0e, 0x00, 0x73, 0x68, 0x01, 0xea, 0xdb, 0xfa, 0xfb, 0x00, 0x28, 0x2f, 0x17, 0xca, 0x52, 0x53,
+	0x5a, 0xfd, 0x9f, 0x25, 0xa8, 0x90, 0x7a, 0xe6, 0x99, 0x65, 0xd9, 0x1a, 0x1a, 0x02, 0xa2, 0x6f,
+	0x5e, 0x8d, 0xa1, 0x65, 0x8a, 0x97, 0xe4, 0xe8, 0x61, 0x4a, 0xce, 0x14, 0x47, 0xe5, 0x7a, 0xd8,
+	0xbe, 0x9d, 0x42, 0x11, 0x41, 0x97, 0xcf, 0x21, 0x83, 0xce, 0x48, 0x3a, 0x2b, 0x7b, 0x7a, 0xef,
+	0xc8, 0x7b, 0x23, 0x32, 0x66, 0xc6, 0x08, 0xaa, 0x37, 0x63, 0xa4, 0x7a, 0xe6, 0x03, 0xf6, 0x30,
+	0xd9, 0x33, 0x44, 0xf9, 0x1c, 0x7a, 0x0b, 0x17, 0x36, 0x71, 0xc0, 0xf1, 0x78, 0x13, 0xae, 0xa6,
+	0x4f, 0x18, 0x43, 0x3e, 0xe5, 0x94, 0x5b, 0x50, 0xa4, 0x2d, 0x59, 0x94, 0xe4, 0x9b, 0x82, 0x3f,
+	0x0e, 0x6b, 0x5f, 0x4f, 0x47, 0x10, 0xdc, 0x7e, 0x0c, 0xf3, 0x91, 0x9f, 0x8b, 0xa0, 0x3b, 0x09,
+	0x64, 0xc9, 0x3f, 0xfc, 0x69, 0xdf, 0xcd, 0x82, 0x2a, 0xe6, 0xea, 0x43, 0x23, 0xfc, 0x8c, 0x14,
+	0x2d, 0x27, 0x3f, 0x7f, 0x8d, 0xbf, 0xf4, 0x6f, 0xdf, 0xc9, 0x80, 0x29, 0x26, 0x32, 0xa0, 0x19,
+	0xfd, 0xf9, 0x02, 0xba, 0x3b, 0x96, 0x41, 0x58, 0xdd, 0xbe, 0x93, 0x09, 0x57, 0x4c, 0x77, 0x02,
+	0x17, 0x92, 0x5e, 0xc4, 0xa3, 0x95, 0x64, 0x36, 0x69, 0x4f, 0xf5, 0xdb, 0x0f, 0x32, 0xe3, 0x8b,
+	0xa9, 0x7f, 0x87, 0x5d, 0x05, 0x25, 0xbd, 0x2a, 0x47, 0x9f, 0x27, 0xb3, 0x1b, 0xf3, 0x1c, 0xbe,
+	0xbd, 0x7a, 0x1a, 0x12, 0xb1, 0x88, 0x9f, 0xd1, 0x3b, 0x9c, 0x84, 0x37, 0xcb, 0xe8, 0x61, 0x32,
+	0xbf, 0xf4, 0x27, 0xe7, 0xed, 0xcf, 0x4f, 0xfd, 0x20, 0x5a, 0x3e, 0x87, 0xac, 0xe8, 0xef, 0x43,
+	0x3c, 0x33, 0x7c, 0x30, 0x51, 0x6b, 0xa6, 0xb3, 0xc1, 0x1f, 0xc1, 0x7c, 0xe4, 0x35, 0x4e, 0xa2,
+	0xd5, 0x24, 0xbf, 0xd8, 0x69, 0x8f, 0x8b, 0xd7, 0xcc, 0x24, 0x23, 0x57, 0x62, 0x28, 0x45, 0xfb,
+	0x13, 0xae, 0xcd, 0xda, 0x77, 0xb3, 0xa0, 0x8a, 0x8d, 0x38, 0xd4, 0x5d, 0x46, 0xae, 0x95, 0xd0,
+	0xbd, 0x64, 0x1e, 0xc9, 0x57, 0x62, 0xed, 0xfb, 0x19, 0xb1, 0xc5, 0xa4, 0x3f, 0x05, 0xb4, 0x7b,
+	0x48, 0x6a, 0x18, 0xf3, 0x40, 0xef, 0x8f, 0x6c, 0x95, 0x3d, 0xb9, 0x49, 0xf3, 0xd1, 0x71, 0xd4,
+	0x14, 0x5d, 0x19, 0x4b, 0x21, 0x26, 0xef, 0x02, 0x6c, 0x62, 0x77, 0x1b, 0xbb, 0x36, 0x51, 0xd0,
+	0xdb, 0x89, 0xf2, 0xf6, 0x11, 0xbc, 0xa9, 0x3e, 0x9b, 0x88, 0x17, 0x08, 0x09, 0xcd, 0x6d, 0xd5,
+	0x24, 0xe5, 0xbb, 0xff, 0x0c, 0xed, 0x5e, 0x22, 0x79, 0x14, 0x2d, 0xe5, 0x40, 0x53, 0xb1, 0xc5,
+	0x94, 0xc7, 0x22, 0xcc, 0x06, 0xba, 0xa9, 0x68, 0x25, 0x91, 0x4d, 0x1c, 0x31, 0xc5, 0xfd, 0x8c,
+	0xc1, 0x17, 0x13, 0x7f, 0x2d, 0xc1, 0xa5, 0x38, 0xc2, 0x1b, 0xdd, 0x3d, 0x24, 0xf7, 0x2c, 0x4e,
+	0x96, 0x25, 0x50, 0xc4, 0x53, 0x2c, 0x81, 0xe3, 0x8b, 0x25, 0x68, 0x50, 0x0f, 0xf5, 0x48, 0x51,
+	0xd2, 0x9b, 0xaf, 0xa4, 0x86, 0x6f, 0x7b, 0x79, 0x32, 0xa2, 0x98, 0xe5, 0x10, 0xea, 0x9e, 0x4a,
+	0xb3, 0xc3, 0xbd, 0x93, 0xb6, 0x52, 0x1f, 0x27, 0xc5, 0x22, 0x93, 0x51, 0x83, 0x16, 0x19, 0x6f,
+	0x01, 0xa1, 0x6c, 0xad, 0xc3, 0x71, 0x16, 0x99, 0xde, 0x57, 0x62, 0x2e, 0x27, 0xd2, 0x6e, 0x4d,
+	0xf6, 0x67, 0x89, 0xdd, 0xe3, 0xf6, 0xdd, 0x2c, 0xa8, 0x62, 0xae, 0x37, 0x50, 0xe2, 0xbf, 0x3a,
+	0xfe, 0x74, 0x7c, 0x41, 0xc8, 0xb9, 0xdf, 0x9a, 0x80, 0x25, 0x18, 0x1f, 0xc1, 0x52, 0x4a, 0x39,
+	0x98, 0x18, 0x0a, 0xc7, 0x97, 0x8e, 0x93, 0x9c, 0xb4, 0x0a, 0x28, 0xfe, 0x8b, 0x97, 0x44, 0x31,
+	0xa5, 0xfe, 0x30, 0x26, 0xc3, 0x14, 0xf1, 0x1f, 0xad, 0x24, 0x4e, 0x91, 0xfa, 0xdb, 0x96, 0x49,
+	0x53, 0xbc, 0x02, 0xf0, 0x8b, 0xbe, 0x44, 0x79, 0xc4, 0x6a, 0xc2, 0x09, 0x2c, 0x57, 0xff, 0xbd,
+	0x0c, 0x65, 0xef, 0x85, 0xd5, 0x47, 0xc8, 0xff, 0x3f, 0x42, 0x42, 0xfe, 0x23, 0x98, 0x8f, 0xfc,
+	0x54, 0x23, 0xd1, 0x78, 0x92, 0x7f, 0xce, 0x31, 0x49, 0x42, 0x6f, 0xf8, 0x7f, 0x4c, 0x10, 0xb1,
+	0xf9, 0xb3, 0xb4, 0xa4, 0x3e, 0x1a, 0x96, 0x27, 0x30, 0xfe, 0xff, 0x1d, 0x84, 0x5f, 0x02, 0x04,
+	0xc2, 0xef, 0xf8, 0x7b, 0x72, 0x12, 0x51, 0x26, 0x9d, 0x96, 0x91, 0x18, 0x61, 0xef, 0x64, 0xb9,
+	0xd3, 0x4c, 0xf7, 0x91, 0xe9, 0x71, 0x75, 0xfb, 0x94, 0x3e, 0x72, 0xc2, 0xea, 0x1d, 0x40, 0xf1,
+	0x76, 0x52, 0x8a, 0x27, 0x49, 0x69, 0x62, 0xb5, 0xef, 0x67, 0xc4, 0x16, 0x7b, 0x38, 0x7b, 0xdf,
+	0xb2, 0xf6, 0xe8, 0xb7, 0x3e, 0xef, 0xeb, 0xee, 0xe1, 0x68, 0x9f, 0x7c, 0x79, 0xc0, 0x50, 0xef,
+	0xeb, 0x16, 0xff, 0xeb, 0x81, 0xa7, 0x7b, 0x0f, 0x28, 0xf5, 0x03, 0x32, 0xc7, 0x70, 0x7f, 0xbf,
+	0x44, 0x47, 0x8f, 0xfe, 0x77, 0x00, 0xe2, 0x01, 0xdc, 0xf3, 0xa4, 0x45, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
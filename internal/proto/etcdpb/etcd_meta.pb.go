@@ -251,9 +251,12 @@ type CollectionInfo struct {
 	StartPositions             []*commonpb.KeyDataPair   `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	State                      CollectionState           `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.etcd.CollectionState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
-	XXX_unrecognized           []byte                    `json:"-"`
-	XXX_sizecache              int32                     `json:"-"`
+	Properties                 []*commonpb.KeyValuePair  `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
+	// empty for the collections created before databases are supported, which belong to the default database
+	DbName               string   `protobuf:"bytes,15,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -372,6 +375,20 @@ func (m *CollectionInfo) GetState() CollectionState {
 	return CollectionState_CollectionCreated
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type PartitionInfo struct {
 	PartitionID               int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string         `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
@@ -444,13 +461,15 @@ func (m *PartitionInfo) GetState() PartitionState {
 }
 
 type AliasInfo struct {
-	AliasName            string     `protobuf:"bytes,1,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
-	CollectionId         int64      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	CreatedTime          uint64     `protobuf:"varint,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	State                AliasState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.etcd.AliasState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	AliasName    string     `protobuf:"bytes,1,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
+	CollectionId int64      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CreatedTime  uint64     `protobuf:"varint,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	State        AliasState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.etcd.AliasState" json:"state,omitempty"`
+	// empty for the aliases created before databases are supported, which belong to the default database
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AliasInfo) Reset()         { *m = AliasInfo{} }
@@ -506,6 +525,60 @@ func (m *AliasInfo) GetState() AliasState {
 	return AliasState_AliasCreated
 }
 
+func (m *AliasInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DatabaseInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTime          uint64   `protobuf:"varint,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreatedTime() uint64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -524,7 +597,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{6}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -618,7 +691,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -698,7 +771,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{7}
+	return fileDescriptor_975d306d62b73e88, []int{8}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -763,6 +836,7 @@ func init() {
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
 	proto.RegisterType((*PartitionInfo)(nil), "milvus.proto.etcd.PartitionInfo")
 	proto.RegisterType((*AliasInfo)(nil), "milvus.proto.etcd.AliasInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
@@ -771,68 +845,72 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0x23, 0x45,
-	0x14, 0x9d, 0x76, 0xfb, 0xd5, 0xd7, 0x8f, 0xd8, 0xc5, 0x4c, 0xd4, 0x13, 0x66, 0xa0, 0xc7, 0x30,
-	0xd0, 0x1a, 0x69, 0x12, 0x91, 0xf0, 0xda, 0x80, 0x80, 0xb4, 0x46, 0xb2, 0x80, 0x91, 0xd5, 0x89,
-	0xb2, 0x60, 0xd3, 0x2a, 0x77, 0x57, 0xec, 0x42, 0xfd, 0x52, 0x57, 0x39, 0x90, 0x3f, 0xe0, 0x4f,
-	0xf8, 0x04, 0xf8, 0x01, 0xbe, 0x86, 0x35, 0x7b, 0x54, 0x55, 0xfd, 0xb6, 0xc3, 0x92, 0x9d, 0xef,
-	0xe9, 0xba, 0xb7, 0xee, 0xb9, 0xf7, 0xd4, 0x31, 0x1c, 0x11, 0xee, 0x07, 0x5e, 0x44, 0x38, 0x3e,
-	0x4d, 0xb3, 0x84, 0x27, 0x68, 0x1e, 0xd1, 0xf0, 0x6e, 0xc7, 0x54, 0x74, 0x2a, 0xbe, 0x9e, 0x8c,
-	0xfd, 0x24, 0x8a, 0x92, 0x58, 0x41, 0x27, 0x63, 0xe6, 0x6f, 0x49, 0x94, 0x1f, 0x5f, 0xfc, 0xa5,
-	0x81, 0xb1, 0x8c, 0x03, 0xf2, 0xeb, 0x32, 0xbe, 0x4d, 0xd0, 0x73, 0x00, 0x2a, 0x02, 0x2f, 0xc6,
-	0x11, 0x31, 0x35, 0x4b, 0xb3, 0x0d, 0xd7, 0x90, 0xc8, 0x5b, 0x1c, 0x11, 0x64, 0xc2, 0x40, 0x06,
-	0x4b, 0xc7, 0xec, 0x58, 0x9a, 0xad, 0xbb, 0x45, 0x88, 0x1c, 0x18, 0xab, 0xc4, 0x14, 0x67, 0x38,
-	0x62, 0xa6, 0x6e, 0xe9, 0xf6, 0xe8, 0xfc, 0xc5, 0x69, 0xa3, 0x99, 0xbc, 0x8d, 0xef, 0xc9, 0xfd,
-	0x0d, 0x0e, 0x77, 0x64, 0x85, 0x69, 0xe6, 0x8e, 0x64, 0xda, 0x4a, 0x66, 0x89, 0xfa, 0x01, 0x09,
-	0x09, 0x27, 0x81, 0xd9, 0xb5, 0x34, 0x7b, 0xe8, 0x16, 0x21, 0x7a, 0x1f, 0x46, 0x7e, 0x46, 0x30,
-	0x27, 0x1e, 0xa7, 0x11, 0x31, 0x7b, 0x96, 0x66, 0x77, 0x5d, 0x50, 0xd0, 0x35, 0x8d, 0xc8, 0xc2,
-	0x81, 0xe9, 0x1b, 0x4a, 0xc2, 0xa0, 0xe2, 0x62, 0xc2, 0xe0, 0x96, 0x86, 0x24, 0x58, 0x3a, 0x92,
-	0x88, 0xee, 0x16, 0xe1, 0xc3, 0x34, 0x16, 0x7f, 0xf6, 0x60, 0x7a, 0x99, 0x84, 0x21, 0xf1, 0x39,
-	0x4d, 0x62, 0x59, 0x66, 0x0a, 0x9d, 0xb2, 0x42, 0x67, 0xe9, 0xa0, 0xaf, 0xa0, 0xaf, 0x06, 0x28,
-	0x73, 0x47, 0xe7, 0x2f, 0x9b, 0x1c, 0xf3, 0xe1, 0x56, 0x45, 0xae, 0x24, 0xe0, 0xe6, 0x49, 0x6d,
-	0x22, 0x7a, 0x9b, 0x08, 0x5a, 0xc0, 0x38, 0xc5, 0x19, 0xa7, 0xb2, 0x01, 0x87, 0x99, 0x5d, 0x4b,
-	0xb7, 0x75, 0xb7, 0x81, 0xa1, 0x8f, 0x60, 0x5a, 0xc6, 0x62, 0x31, 0xcc, 0xec, 0x59, 0xba, 0x6d,
-	0xb8, 0x2d, 0x14, 0xbd, 0x81, 0xc9, 0xad, 0x18, 0x8a, 0x27, 0xf9, 0x11, 0x66, 0xf6, 0x0f, 0xad,
-	0x45, 0x68, 0xe4, 0xb4, 0x39, 0x3c, 0x77, 0x7c, 0x5b, 0xc6, 0x84, 0xa1, 0x73, 0x78, 0x72, 0x47,
-	0x33, 0xbe, 0xc3, 0xa1, 0xe7, 0x6f, 0x71, 0x1c, 0x93, 0x50, 0x0a, 0x84, 0x99, 0x03, 0x79, 0xed,
-	0x3b, 0xf9, 0xc7, 0x4b, 0xf5, 0x4d, 0xdd, 0xfd, 0x29, 0x1c, 0xa7, 0xdb, 0x7b, 0x46, 0xfd, 0xbd,
-	0xa4, 0xa1, 0x4c, 0x7a, 0x5c, 0x7c, 0x6d, 0x64, 0x7d, 0x03, 0xcf, 0x4a, 0x0e, 0x9e, 0x9a, 0x4a,
-	0x20, 0x27, 0xc5, 0x38, 0x8e, 0x52, 0x66, 0x1a, 0x96, 0x6e, 0x77, 0xdd, 0x93, 0xf2, 0xcc, 0xa5,
-	0x3a, 0x72, 0x5d, 0x9e, 0x10, 0x12, 0x66, 0x5b, 0x9c, 0x05, 0xcc, 0x8b, 0x77, 0x91, 0x09, 0x96,
-	0x66, 0xf7, 0x5c, 0x43, 0x21, 0x6f, 0x77, 0x11, 0x5a, 0xc2, 0x11, 0xe3, 0x38, 0xe3, 0x5e, 0x9a,
-	0x30, 0x59, 0x81, 0x99, 0x23, 0x39, 0x14, 0xeb, 0x21, 0xad, 0x3a, 0x98, 0x63, 0x29, 0xd5, 0xa9,
-	0x4c, 0x5c, 0x15, 0x79, 0xc8, 0x85, 0xb9, 0x9f, 0xc4, 0x8c, 0x32, 0x4e, 0x62, 0xff, 0xde, 0x0b,
-	0xc9, 0x1d, 0x09, 0xcd, 0xb1, 0xa5, 0xd9, 0xd3, 0xb6, 0x28, 0xf2, 0x62, 0x97, 0xd5, 0xe9, 0x1f,
-	0xc4, 0x61, 0x77, 0xe6, 0xb7, 0x10, 0xf4, 0x25, 0xf4, 0x18, 0xc7, 0x9c, 0x98, 0x13, 0x59, 0x67,
-	0x71, 0x60, 0x53, 0x35, 0x69, 0x89, 0x93, 0xae, 0x4a, 0x58, 0xfc, 0xa3, 0xc1, 0x64, 0x55, 0x8a,
-	0x44, 0x28, 0xd7, 0x82, 0x51, 0x4d, 0x35, 0xb9, 0x84, 0xeb, 0x10, 0xfa, 0x10, 0x26, 0x0d, 0xc5,
-	0x48, 0x49, 0x1b, 0x6e, 0x13, 0x44, 0x5f, 0xc3, 0xbb, 0xff, 0xb1, 0x93, 0x5c, 0xc2, 0x4f, 0x1f,
-	0x5c, 0x09, 0xfa, 0x00, 0x26, 0x7e, 0xd9, 0xb3, 0x47, 0xd5, 0xdb, 0xd6, 0xdd, 0x71, 0x05, 0x2e,
-	0x03, 0xf4, 0x45, 0x41, 0xbc, 0x27, 0x89, 0x1f, 0x92, 0x68, 0xc9, 0xae, 0xc1, 0xfb, 0x77, 0x0d,
-	0x8c, 0x6f, 0x43, 0x8a, 0x59, 0x61, 0x60, 0x58, 0x04, 0x0d, 0x03, 0x93, 0x88, 0xa4, 0xb2, 0xd7,
-	0x4a, 0xe7, 0x40, 0x2b, 0x2f, 0x60, 0x5c, 0x67, 0x99, 0x13, 0xcc, 0x9f, 0xad, 0xe4, 0x85, 0x2e,
-	0x8a, 0x6e, 0xbb, 0xb2, 0xdb, 0xe7, 0x07, 0xba, 0x95, 0x3d, 0x35, 0x3a, 0xfd, 0xad, 0x03, 0xb3,
-	0x2b, 0xb2, 0x89, 0x48, 0xcc, 0x2b, 0x97, 0x5a, 0x40, 0xfd, 0xf2, 0x62, 0x4b, 0x0d, 0xac, 0xbd,
-	0xc8, 0xce, 0xfe, 0x22, 0x9f, 0x81, 0xc1, 0xf2, 0xca, 0x8e, 0xec, 0x57, 0x77, 0x2b, 0x40, 0x39,
-	0xa1, 0x78, 0xce, 0x4e, 0x3e, 0xfa, 0x22, 0xac, 0x3b, 0x61, 0xaf, 0x69, 0xe8, 0x26, 0x0c, 0xd6,
-	0x3b, 0x2a, 0x73, 0xfa, 0xea, 0x4b, 0x1e, 0x8a, 0xf1, 0x90, 0x18, 0xaf, 0x43, 0xa2, 0x5c, 0xc5,
-	0x1c, 0x48, 0xa7, 0x1e, 0x29, 0x4c, 0x12, 0x6b, 0x9b, 0xdc, 0x70, 0xcf, 0xad, 0xff, 0xd6, 0xea,
-	0x3e, 0xfb, 0x23, 0xe1, 0xf8, 0x7f, 0xf7, 0xd9, 0xf7, 0x00, 0xca, 0x09, 0x15, 0x2e, 0x5b, 0x43,
-	0xd0, 0xcb, 0x9a, 0xc7, 0x7a, 0x1c, 0x6f, 0x0a, 0x8f, 0xad, 0x1e, 0xc7, 0x35, 0xde, 0xb0, 0x3d,
-	0xbb, 0xee, 0xef, 0xdb, 0xf5, 0xe2, 0x0f, 0xc1, 0x36, 0x23, 0x01, 0x89, 0x39, 0xc5, 0xa1, 0x5c,
-	0xfb, 0x09, 0x0c, 0x77, 0x8c, 0x64, 0x35, 0x95, 0x96, 0x31, 0x7a, 0x0d, 0x88, 0xc4, 0x7e, 0x76,
-	0x9f, 0x0a, 0x05, 0xa6, 0x98, 0xb1, 0x5f, 0x92, 0x2c, 0xc8, 0x9f, 0xe6, 0xbc, 0xfc, 0xb2, 0xca,
-	0x3f, 0xa0, 0x63, 0xe8, 0x73, 0x12, 0xe3, 0x98, 0x4b, 0x92, 0x86, 0x9b, 0x47, 0xe8, 0x29, 0x0c,
-	0x29, 0xf3, 0xd8, 0x2e, 0x25, 0x59, 0xf1, 0x6f, 0x4a, 0xd9, 0x95, 0x08, 0xd1, 0xc7, 0x70, 0xc4,
-	0xb6, 0xf8, 0xfc, 0xb3, 0xcf, 0xab, 0xf2, 0x3d, 0x99, 0x3b, 0x55, 0x70, 0x51, 0xfb, 0x55, 0x02,
-	0x47, 0x2d, 0xbb, 0x41, 0x4f, 0x60, 0x5e, 0x41, 0xf9, 0x5b, 0x9f, 0x3d, 0x42, 0xc7, 0x80, 0x5a,
-	0x30, 0x8d, 0x37, 0x33, 0xad, 0x89, 0x3b, 0x59, 0x92, 0xa6, 0x02, 0xef, 0x34, 0xcb, 0x48, 0x9c,
-	0x04, 0x33, 0xfd, 0xd5, 0xcf, 0x30, 0x6d, 0x3e, 0x73, 0xf4, 0x18, 0x66, 0xab, 0x96, 0xb5, 0xcc,
-	0x1e, 0x89, 0xf4, 0x26, 0xaa, 0x6e, 0xab, 0xc3, 0xb5, 0xcb, 0xea, 0x35, 0xaa, 0xbb, 0x6e, 0x00,
-	0xaa, 0x47, 0x8a, 0x66, 0x30, 0x96, 0x51, 0x75, 0xc7, 0x1c, 0x26, 0x15, 0xa2, 0xea, 0x17, 0x50,
-	0xad, 0x76, 0x91, 0x57, 0xd6, 0xfd, 0xee, 0xe2, 0xa7, 0x4f, 0x36, 0x94, 0x6f, 0x77, 0x6b, 0xe1,
-	0xfb, 0x67, 0x4a, 0xb5, 0xaf, 0x69, 0x92, 0xff, 0x3a, 0xa3, 0x31, 0x17, 0x8b, 0x0e, 0xcf, 0xa4,
-	0x90, 0xcf, 0x84, 0x59, 0xa4, 0xeb, 0x75, 0x5f, 0x46, 0x17, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0x11, 0x13, 0x31, 0xd0, 0x09, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xde, 0xf1, 0xf8, 0x11, 0x97, 0x1f, 0x71, 0x9a, 0xdd, 0x30, 0x1b, 0x76, 0x61, 0xd6, 0x10,
+	0xb0, 0x56, 0xda, 0x44, 0x24, 0xbc, 0x2e, 0x20, 0x96, 0x0c, 0x2b, 0x59, 0xc0, 0xca, 0x9a, 0x44,
+	0x7b, 0xe0, 0x32, 0x6a, 0xcf, 0x54, 0xe2, 0x46, 0xf3, 0xd2, 0x74, 0x3b, 0x90, 0x7f, 0xc0, 0x0f,
+	0xe0, 0xbf, 0x70, 0xe5, 0xc2, 0xaf, 0xe1, 0xcc, 0x1d, 0x75, 0xf7, 0x3c, 0x6d, 0x07, 0x71, 0xe2,
+	0xe6, 0xfa, 0xba, 0xab, 0xa6, 0xbe, 0xaa, 0xaf, 0xab, 0x0c, 0xfb, 0x28, 0xfc, 0xc0, 0x8b, 0x50,
+	0xd0, 0x93, 0x34, 0x4b, 0x44, 0x42, 0x0e, 0x22, 0x16, 0xde, 0xae, 0xb9, 0xb6, 0x4e, 0xe4, 0xe9,
+	0xd1, 0xd0, 0x4f, 0xa2, 0x28, 0x89, 0x35, 0x74, 0x34, 0xe4, 0xfe, 0x0a, 0xa3, 0xfc, 0xfa, 0xf4,
+	0x4f, 0x03, 0xfa, 0xf3, 0x38, 0xc0, 0x5f, 0xe6, 0xf1, 0x75, 0x42, 0x9e, 0x02, 0x30, 0x69, 0x78,
+	0x31, 0x8d, 0xd0, 0x32, 0x6c, 0x63, 0xd6, 0x77, 0xfb, 0x0a, 0x79, 0x4d, 0x23, 0x24, 0x16, 0xf4,
+	0x94, 0x31, 0x77, 0xac, 0x96, 0x6d, 0xcc, 0x4c, 0xb7, 0x30, 0x89, 0x03, 0x43, 0xed, 0x98, 0xd2,
+	0x8c, 0x46, 0xdc, 0x32, 0x6d, 0x73, 0x36, 0x38, 0x7b, 0x76, 0xd2, 0x48, 0x26, 0x4f, 0xe3, 0x3b,
+	0xbc, 0x7b, 0x43, 0xc3, 0x35, 0x2e, 0x28, 0xcb, 0xdc, 0x81, 0x72, 0x5b, 0x28, 0x2f, 0x19, 0x3f,
+	0xc0, 0x10, 0x05, 0x06, 0x56, 0xdb, 0x36, 0x66, 0x7b, 0x6e, 0x61, 0x92, 0xf7, 0x60, 0xe0, 0x67,
+	0x48, 0x05, 0x7a, 0x82, 0x45, 0x68, 0x75, 0x6c, 0x63, 0xd6, 0x76, 0x41, 0x43, 0x57, 0x2c, 0xc2,
+	0xa9, 0x03, 0xe3, 0x57, 0x0c, 0xc3, 0xa0, 0xe2, 0x62, 0x41, 0xef, 0x9a, 0x85, 0x18, 0xcc, 0x1d,
+	0x45, 0xc4, 0x74, 0x0b, 0xf3, 0x7e, 0x1a, 0xd3, 0xdf, 0xba, 0x30, 0xbe, 0x48, 0xc2, 0x10, 0x7d,
+	0xc1, 0x92, 0x58, 0x85, 0x19, 0x43, 0xab, 0x8c, 0xd0, 0x9a, 0x3b, 0xe4, 0x4b, 0xe8, 0xea, 0x02,
+	0x2a, 0xdf, 0xc1, 0xd9, 0x71, 0x93, 0x63, 0x5e, 0xdc, 0x2a, 0xc8, 0xa5, 0x02, 0xdc, 0xdc, 0x69,
+	0x93, 0x88, 0xb9, 0x49, 0x84, 0x4c, 0x61, 0x98, 0xd2, 0x4c, 0x30, 0x95, 0x80, 0xc3, 0xad, 0xb6,
+	0x6d, 0xce, 0x4c, 0xb7, 0x81, 0x91, 0x0f, 0x61, 0x5c, 0xda, 0xb2, 0x31, 0xdc, 0xea, 0xd8, 0xe6,
+	0xac, 0xef, 0x6e, 0xa0, 0xe4, 0x15, 0x8c, 0xae, 0x65, 0x51, 0x3c, 0xc5, 0x0f, 0xb9, 0xd5, 0xdd,
+	0xd5, 0x16, 0xa9, 0x91, 0x93, 0x66, 0xf1, 0xdc, 0xe1, 0x75, 0x69, 0x23, 0x27, 0x67, 0xf0, 0xe8,
+	0x96, 0x65, 0x62, 0x4d, 0x43, 0xcf, 0x5f, 0xd1, 0x38, 0xc6, 0x50, 0x09, 0x84, 0x5b, 0x3d, 0xf5,
+	0xd9, 0xb7, 0xf2, 0xc3, 0x0b, 0x7d, 0xa6, 0xbf, 0xfd, 0x09, 0x1c, 0xa6, 0xab, 0x3b, 0xce, 0xfc,
+	0x2d, 0xa7, 0x3d, 0xe5, 0xf4, 0xb0, 0x38, 0x6d, 0x78, 0x7d, 0x0d, 0x4f, 0x4a, 0x0e, 0x9e, 0xae,
+	0x4a, 0xa0, 0x2a, 0xc5, 0x05, 0x8d, 0x52, 0x6e, 0xf5, 0x6d, 0x73, 0xd6, 0x76, 0x8f, 0xca, 0x3b,
+	0x17, 0xfa, 0xca, 0x55, 0x79, 0x43, 0x4a, 0x98, 0xaf, 0x68, 0x16, 0x70, 0x2f, 0x5e, 0x47, 0x16,
+	0xd8, 0xc6, 0xac, 0xe3, 0xf6, 0x35, 0xf2, 0x7a, 0x1d, 0x91, 0x39, 0xec, 0x73, 0x41, 0x33, 0xe1,
+	0xa5, 0x09, 0x57, 0x11, 0xb8, 0x35, 0x50, 0x45, 0xb1, 0xef, 0xd3, 0xaa, 0x43, 0x05, 0x55, 0x52,
+	0x1d, 0x2b, 0xc7, 0x45, 0xe1, 0x47, 0x5c, 0x38, 0xf0, 0x93, 0x98, 0x33, 0x2e, 0x30, 0xf6, 0xef,
+	0xbc, 0x10, 0x6f, 0x31, 0xb4, 0x86, 0xb6, 0x31, 0x1b, 0x9f, 0x1d, 0xef, 0x0c, 0x76, 0x51, 0xdd,
+	0xfe, 0x5e, 0x5e, 0x76, 0x27, 0xfe, 0x06, 0x42, 0xbe, 0x80, 0x0e, 0x17, 0x54, 0xa0, 0x35, 0x52,
+	0x71, 0xa6, 0x3b, 0x3a, 0x55, 0x93, 0x96, 0xbc, 0xe9, 0x6a, 0x07, 0xf2, 0x12, 0x20, 0xcd, 0x92,
+	0x14, 0x33, 0xc1, 0x90, 0x5b, 0xe3, 0xff, 0xfa, 0xfe, 0x6a, 0x4e, 0xe4, 0x6d, 0xe8, 0x05, 0x4b,
+	0xfd, 0xf4, 0xf7, 0xd5, 0xd3, 0xef, 0x06, 0x4b, 0xd9, 0x96, 0xe9, 0xdf, 0x06, 0x8c, 0x16, 0xa5,
+	0x00, 0xe5, 0xab, 0xb0, 0x61, 0x50, 0x53, 0x64, 0xfe, 0x3c, 0xea, 0x10, 0xf9, 0x00, 0x46, 0x0d,
+	0x35, 0xaa, 0xe7, 0xd2, 0x77, 0x9b, 0x20, 0xf9, 0x0a, 0xde, 0xf9, 0x97, 0x7e, 0xe7, 0xcf, 0xe3,
+	0xf1, 0xbd, 0xed, 0x26, 0xef, 0xc3, 0xc8, 0x2f, 0xeb, 0xe1, 0x31, 0x3d, 0x37, 0x4c, 0x77, 0x58,
+	0x81, 0xf3, 0x80, 0x7c, 0x5e, 0x14, 0xb5, 0xa3, 0x8a, 0xba, 0x4b, 0xfe, 0x25, 0xbb, 0x7a, 0x4d,
+	0xa7, 0x7f, 0x18, 0xd0, 0x7f, 0x19, 0x32, 0xca, 0x8b, 0xe1, 0x48, 0xa5, 0xd1, 0x18, 0x8e, 0x0a,
+	0x51, 0x54, 0xb6, 0x52, 0x69, 0xed, 0x48, 0xe5, 0x19, 0x0c, 0xeb, 0x2c, 0x73, 0x82, 0x03, 0xbf,
+	0xe2, 0x45, 0xce, 0x8b, 0x6c, 0xdb, 0x2a, 0xdb, 0xa7, 0x3b, 0xb2, 0x55, 0x39, 0x35, 0xba, 0x5f,
+	0x6b, 0x5d, 0xa7, 0xd1, 0xba, 0x6f, 0x61, 0x28, 0x05, 0xbc, 0xa4, 0x1c, 0x15, 0x09, 0x02, 0xed,
+	0x5a, 0xfa, 0xea, 0xf7, 0x56, 0x52, 0xad, 0xad, 0xa4, 0xa6, 0xbf, 0xb6, 0x60, 0x72, 0x89, 0x37,
+	0x11, 0xc6, 0xa2, 0x9a, 0xb0, 0x53, 0xa8, 0x93, 0x2b, 0x54, 0xd0, 0xc0, 0x36, 0x85, 0xd2, 0xda,
+	0x16, 0xca, 0x13, 0xe8, 0xf3, 0x3c, 0xb2, 0xa3, 0xea, 0x61, 0xba, 0x15, 0xa0, 0xa7, 0xb8, 0x1c,
+	0x45, 0x4e, 0xde, 0xda, 0xc2, 0xac, 0x4f, 0xf1, 0x4e, 0x73, 0x19, 0x59, 0xd0, 0x5b, 0xae, 0x99,
+	0xf2, 0xe9, 0xea, 0x93, 0xdc, 0x94, 0x4c, 0x31, 0xa6, 0xcb, 0x10, 0xf5, 0x44, 0xb4, 0x7a, 0x6a,
+	0xcb, 0x0c, 0x34, 0xa6, 0x88, 0x6d, 0x0e, 0xe8, 0xbd, 0xad, 0x4d, 0xf3, 0x97, 0x51, 0xdf, 0x11,
+	0x3f, 0xa0, 0xa0, 0xff, 0xfb, 0x8e, 0x78, 0x17, 0xa0, 0xac, 0x50, 0xb1, 0x21, 0x6a, 0x08, 0x39,
+	0xae, 0xed, 0x07, 0x4f, 0xd0, 0x9b, 0x62, 0x3f, 0x54, 0x8f, 0xef, 0x8a, 0xde, 0xf0, 0xad, 0x55,
+	0xd3, 0xdd, 0x5e, 0x35, 0xd3, 0xdf, 0x25, 0xdb, 0x0c, 0x03, 0x8c, 0x05, 0xa3, 0xa1, 0x6a, 0xfb,
+	0x11, 0xec, 0xad, 0x39, 0x66, 0x35, 0x19, 0x95, 0x36, 0x79, 0x01, 0x04, 0x63, 0x3f, 0xbb, 0x4b,
+	0xa5, 0x98, 0x52, 0xca, 0xf9, 0xcf, 0x49, 0x16, 0xe4, 0x4f, 0xff, 0xa0, 0x3c, 0x59, 0xe4, 0x07,
+	0xe4, 0x10, 0xba, 0x02, 0x63, 0x1a, 0x0b, 0x45, 0xb2, 0xef, 0xe6, 0x16, 0x79, 0x0c, 0x7b, 0x8c,
+	0x7b, 0x7c, 0x9d, 0x62, 0x56, 0xfc, 0x13, 0x60, 0xfc, 0x52, 0x9a, 0xe4, 0x23, 0xd8, 0xe7, 0x2b,
+	0x7a, 0xf6, 0xe9, 0x67, 0x55, 0x78, 0xad, 0xf8, 0xb1, 0x86, 0x8b, 0xd8, 0xcf, 0x13, 0xd8, 0xdf,
+	0x18, 0x95, 0xe4, 0x11, 0x1c, 0x54, 0x50, 0x3e, 0x4b, 0x26, 0x0f, 0xc8, 0x21, 0x90, 0x0d, 0x98,
+	0xc5, 0x37, 0x13, 0xa3, 0x89, 0x3b, 0x59, 0x92, 0xa6, 0x12, 0x6f, 0x35, 0xc3, 0x28, 0x1c, 0x83,
+	0x89, 0xf9, 0xfc, 0x27, 0x18, 0x37, 0xc7, 0x08, 0x79, 0x08, 0x93, 0xc5, 0xc6, 0xe8, 0x9a, 0x3c,
+	0x90, 0xee, 0x4d, 0x54, 0x7f, 0xad, 0x0e, 0xd7, 0x3e, 0x56, 0x8f, 0x51, 0x7d, 0xeb, 0x0d, 0x40,
+	0x35, 0x04, 0xc8, 0x04, 0x86, 0xca, 0xaa, 0xbe, 0x71, 0x00, 0xa3, 0x0a, 0xd1, 0xf1, 0x0b, 0xa8,
+	0x16, 0xbb, 0xf0, 0x2b, 0xe3, 0x7e, 0x73, 0xfe, 0xe3, 0xc7, 0x37, 0x4c, 0xac, 0xd6, 0x4b, 0xb9,
+	0x2c, 0x4e, 0xb5, 0x6a, 0x5f, 0xb0, 0x24, 0xff, 0x75, 0xca, 0x62, 0x21, 0x1b, 0x1d, 0x9e, 0x2a,
+	0x21, 0x9f, 0xca, 0x61, 0x94, 0x2e, 0x97, 0x5d, 0x65, 0x9d, 0xff, 0x33, 0x00, 0x6a, 0xbf, 0x46,
+	0x00, 0x8c, 0x0a, 0x00, 0x00,
 }
@@ -1651,12 +1651,14 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SlicedBlob     []byte `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount int64  `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset   int64  `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	// the tsafe of each dml channel searched by the shard leader, the data before it is visible to the search
+	ChannelServiceableTs map[string]uint64 `protobuf:"bytes,13,rep,name=channel_serviceable_ts,json=channelServiceableTs,proto3" json:"channel_serviceable_ts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
@@ -1768,6 +1770,13 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetChannelServiceableTs() map[string]uint64 {
+	if m != nil {
+		return m.ChannelServiceableTs
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
@@ -2816,6 +2825,7 @@ func init() {
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
+	proto.RegisterMapType((map[string]uint64)(nil), "milvus.proto.internal.SearchResults.ChannelServiceableTsEntry")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
@@ -2836,152 +2846,156 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0xdf, 0x9e, 0x9e, 0xf1, 0xcc, 0xbc, 0x19, 0x8f, 0xdb, 0x15, 0x67, 0x77, 0xe2, 0x24, 0x1b,
-	0xa7, 0x59, 0xc0, 0x24, 0x6c, 0x12, 0xbc, 0xbb, 0xc9, 0x0a, 0x10, 0x21, 0xf6, 0x64, 0x83, 0x15,
-	0x3b, 0x38, 0xed, 0x10, 0x09, 0x2e, 0xad, 0x9a, 0xe9, 0xf2, 0x4c, 0x93, 0xee, 0xae, 0x4e, 0x55,
-	0xb5, 0x9d, 0xc9, 0x89, 0x03, 0x27, 0x56, 0x70, 0xe3, 0x82, 0x04, 0x67, 0x84, 0x84, 0xc4, 0x6d,
-	0x6f, 0x20, 0x71, 0xe2, 0xc4, 0x89, 0x0b, 0xff, 0x0a, 0xe2, 0x80, 0xea, 0xa3, 0x7b, 0x3e, 0x3c,
-	0x76, 0x6c, 0x47, 0xbb, 0x1b, 0xa4, 0xbd, 0x75, 0xbd, 0xf7, 0xea, 0xf5, 0xab, 0xf7, 0x7e, 0xef,
-	0xd5, 0x7b, 0xdd, 0xd0, 0x0a, 0x13, 0x41, 0x58, 0x82, 0xa3, 0x1b, 0x29, 0xa3, 0x82, 0xa2, 0xf3,
-	0x71, 0x18, 0xed, 0x67, 0x5c, 0xaf, 0x6e, 0xe4, 0xcc, 0xe5, 0x66, 0x8f, 0xc6, 0x31, 0x4d, 0x34,
-	0x79, 0xb9, 0xc9, 0x7b, 0x03, 0x12, 0x63, 0xbd, 0x72, 0xff, 0x6a, 0xc1, 0xfc, 0x06, 0x8d, 0x53,
-	0x9a, 0x90, 0x44, 0x6c, 0x26, 0x7b, 0x14, 0xbd, 0x0d, 0x73, 0x09, 0x0d, 0xc8, 0x66, 0xa7, 0x6d,
-	0xad, 0x58, 0xab, 0xb6, 0x67, 0x56, 0x08, 0x41, 0x99, 0xd1, 0x88, 0xb4, 0x4b, 0x2b, 0xd6, 0x6a,
-	0xdd, 0x53, 0xcf, 0xe8, 0x2e, 0x00, 0x17, 0x58, 0x10, 0xbf, 0x47, 0x03, 0xd2, 0xb6, 0x57, 0xac,
-	0xd5, 0xd6, 0xda, 0xca, 0x8d, 0x99, 0x56, 0xdc, 0xd8, 0x95, 0x82, 0x1b, 0x34, 0x20, 0x5e, 0x9d,
-	0xe7, 0x8f, 0xe8, 0x87, 0x00, 0xe4, 0x85, 0x60, 0xd8, 0x0f, 0x93, 0x3d, 0xda, 0x2e, 0xaf, 0xd8,
-	0xab, 0x8d, 0xb5, 0xab, 0x93, 0x0a, 0x8c, 0xf1, 0x0f, 0xc9, 0xf0, 0x29, 0x8e, 0x32, 0xb2, 0x83,
-	0x43, 0xe6, 0xd5, 0xd5, 0x26, 0x69, 0xae, 0xfb, 0x6f, 0x0b, 0x16, 0x8a, 0x03, 0xa8, 0x77, 0x70,
-	0xf4, 0x5d, 0xa8, 0xa8, 0x57, 0xa8, 0x13, 0x34, 0xd6, 0xde, 0x3b, 0xc2, 0xa2, 0x89, 0x73, 0x7b,
-	0x7a, 0x0b, 0xfa, 0x09, 0x9c, 0xe3, 0x59, 0xb7, 0x97, 0xb3, 0x7c, 0x45, 0xe5, 0xed, 0x92, 0x32,
-	0xed, 0x64, 0x9a, 0xd0, 0xb8, 0x02, 0x63, 0xd2, 0x07, 0x30, 0x27, 0x35, 0x65, 0x5c, 0x79, 0xa9,
-	0xb1, 0x76, 0x71, 0xe6, 0x21, 0x77, 0x95, 0x88, 0x67, 0x44, 0xdd, 0x8b, 0x70, 0xe1, 0x01, 0x11,
-	0x53, 0xa7, 0xf3, 0xc8, 0xf3, 0x8c, 0x70, 0x61, 0x98, 0x4f, 0xc2, 0x98, 0x3c, 0x09, 0x7b, 0xcf,
-	0x36, 0x06, 0x38, 0x49, 0x48, 0x94, 0x33, 0x2f, 0xc3, 0xc5, 0x07, 0x44, 0x6d, 0x08, 0xb9, 0x08,
-	0x7b, 0x7c, 0x8a, 0x7d, 0x1e, 0xce, 0x3d, 0x20, 0xa2, 0x13, 0x4c, 0x91, 0x9f, 0x42, 0xed, 0x91,
-	0x0c, 0xb6, 0x84, 0xc1, 0x6d, 0xa8, 0xe2, 0x20, 0x60, 0x84, 0x73, 0xe3, 0xc5, 0x4b, 0x33, 0x2d,
-	0xbe, 0xa7, 0x65, 0xbc, 0x5c, 0x78, 0x16, 0x4c, 0xdc, 0x9f, 0x03, 0x6c, 0x26, 0xa1, 0xd8, 0xc1,
-	0x0c, 0xc7, 0xfc, 0x48, 0x80, 0x75, 0xa0, 0xc9, 0x05, 0x66, 0xc2, 0x4f, 0x95, 0x9c, 0x71, 0xf9,
-	0x09, 0xd0, 0xd0, 0x50, 0xdb, 0xb4, 0x76, 0xf7, 0xa7, 0x00, 0xbb, 0x82, 0x85, 0x49, 0x7f, 0x2b,
-	0xe4, 0x42, 0xbe, 0x6b, 0x5f, 0xca, 0xc9, 0x43, 0xd8, 0xab, 0x75, 0xcf, 0xac, 0xc6, 0xc2, 0x51,
-	0x3a, 0x79, 0x38, 0xee, 0x42, 0x23, 0x77, 0xf7, 0x36, 0xef, 0xa3, 0x5b, 0x50, 0xee, 0x62, 0x4e,
-	0x8e, 0x75, 0xcf, 0x36, 0xef, 0xaf, 0x63, 0x4e, 0x3c, 0x25, 0xe9, 0xfe, 0xb9, 0x04, 0x4b, 0x13,
-	0x61, 0x31, 0x8e, 0x3f, 0xbd, 0x2a, 0xe9, 0xe6, 0xa0, 0xbb, 0xd9, 0x51, 0xe6, 0xdb, 0x9e, 0x7a,
-	0x46, 0x2e, 0x34, 0x7b, 0x34, 0x8a, 0x48, 0x4f, 0x84, 0x34, 0xd9, 0xec, 0x28, 0xa4, 0xd9, 0xde,
-	0x04, 0x4d, 0xca, 0xa4, 0x98, 0x89, 0x50, 0x2f, 0xb9, 0x4a, 0x39, 0xdb, 0x9b, 0xa0, 0xa1, 0x6f,
-	0x81, 0x23, 0x18, 0xde, 0x27, 0x91, 0x2f, 0xc2, 0x98, 0x70, 0x81, 0xe3, 0xb4, 0x5d, 0x59, 0xb1,
-	0x56, 0xcb, 0xde, 0x82, 0xa6, 0x3f, 0xc9, 0xc9, 0xe8, 0x26, 0x9c, 0xeb, 0x67, 0x98, 0xe1, 0x44,
-	0x10, 0x32, 0x26, 0x3d, 0xa7, 0xa4, 0x51, 0xc1, 0x1a, 0x6d, 0xb8, 0x0e, 0x8b, 0x52, 0x8c, 0x66,
-	0x62, 0x4c, 0xbc, 0xaa, 0xc4, 0x1d, 0xc3, 0x28, 0x84, 0xdd, 0xcf, 0x2c, 0x38, 0x3f, 0xe5, 0x2f,
-	0x9e, 0xd2, 0x84, 0x93, 0x33, 0x38, 0xec, 0x2c, 0x11, 0x47, 0x77, 0x74, 0x21, 0x91, 0x49, 0x7b,
-	0x42, 0x2c, 0x6a, 0x79, 0xf7, 0x57, 0x36, 0xbc, 0xb3, 0xc1, 0x88, 0x2a, 0x73, 0xb9, 0xf7, 0xcf,
-	0x1e, 0xec, 0x77, 0xa0, 0x1a, 0x74, 0xfd, 0x04, 0xc7, 0x79, 0x5a, 0xcd, 0x05, 0xdd, 0x47, 0x38,
-	0x26, 0xe8, 0x1b, 0xd0, 0x1a, 0x45, 0x57, 0x52, 0x54, 0xcc, 0xeb, 0xde, 0x14, 0x15, 0xbd, 0x07,
-	0xf3, 0x45, 0x84, 0x95, 0x58, 0x59, 0x89, 0x4d, 0x12, 0x0b, 0x4c, 0x55, 0x8e, 0xc1, 0xd4, 0xdc,
-	0x0c, 0x4c, 0xad, 0x40, 0x63, 0x0c, 0x3f, 0x2a, 0x9a, 0xb6, 0x37, 0x4e, 0x92, 0x69, 0xa8, 0x6f,
-	0x9d, 0x76, 0x6d, 0xc5, 0x5a, 0x6d, 0x7a, 0x66, 0x85, 0x6e, 0xc1, 0xb9, 0xfd, 0x90, 0x89, 0x0c,
-	0x47, 0xa6, 0x12, 0x49, 0x3b, 0x78, 0xbb, 0xae, 0x72, 0x75, 0x16, 0x0b, 0xad, 0xc1, 0x52, 0x3a,
-	0x18, 0xf2, 0xb0, 0x37, 0xb5, 0x05, 0xd4, 0x96, 0x99, 0x3c, 0xf7, 0xef, 0x16, 0x9c, 0xef, 0x30,
-	0x9a, 0xbe, 0x11, 0xa1, 0xc8, 0x9d, 0x5c, 0x3e, 0xc6, 0xc9, 0x95, 0xc3, 0x4e, 0x76, 0x7f, 0x5d,
-	0x82, 0xb7, 0x35, 0xa2, 0x76, 0x72, 0xc7, 0x7e, 0x0e, 0xa7, 0xf8, 0x26, 0x2c, 0x8c, 0xde, 0xaa,
-	0x05, 0x66, 0x1f, 0xe3, 0xeb, 0xd0, 0x2a, 0x02, 0xac, 0xe5, 0xbe, 0x58, 0x48, 0xb9, 0x9f, 0x96,
-	0x60, 0x49, 0x06, 0xf5, 0x2b, 0x6f, 0x48, 0x6f, 0xfc, 0xc1, 0x02, 0xa4, 0xd1, 0x71, 0x2f, 0x0a,
-	0x31, 0xff, 0x32, 0x7d, 0xb1, 0x04, 0x15, 0x2c, 0x6d, 0x30, 0x2e, 0xd0, 0x0b, 0x97, 0x83, 0x23,
-	0xa3, 0xf5, 0x79, 0x59, 0x57, 0xbc, 0xd4, 0x1e, 0x7f, 0xe9, 0xef, 0x2d, 0x58, 0xbc, 0x17, 0x09,
-	0xc2, 0xde, 0x50, 0xa7, 0xfc, 0xad, 0x94, 0x47, 0x6d, 0x33, 0x09, 0xc8, 0x8b, 0x2f, 0xd3, 0xc0,
-	0xcb, 0x00, 0x7b, 0x21, 0x89, 0x82, 0x71, 0xf4, 0xd6, 0x15, 0xe5, 0xb5, 0x90, 0xdb, 0x86, 0xaa,
-	0x52, 0x52, 0xa0, 0x36, 0x5f, 0xca, 0x6e, 0x4f, 0x77, 0xfe, 0xa6, 0xdb, 0xab, 0x9d, 0xb8, 0xdb,
-	0x53, 0xdb, 0x4c, 0xb7, 0xf7, 0xcf, 0x32, 0xcc, 0x6f, 0x26, 0x9c, 0x30, 0x71, 0x76, 0xe7, 0x5d,
-	0x82, 0x3a, 0x1f, 0x60, 0xa6, 0x0e, 0x6a, 0xdc, 0x37, 0x22, 0x8c, 0xbb, 0xd6, 0x7e, 0x95, 0x6b,
-	0xcb, 0x27, 0x2c, 0x0e, 0x95, 0xe3, 0x8a, 0xc3, 0xdc, 0x31, 0x2e, 0xae, 0xbe, 0xba, 0x38, 0xd4,
-	0x0e, 0xdf, 0xbe, 0xf2, 0x80, 0xa4, 0x1f, 0xcb, 0xf1, 0xa4, 0xd3, 0xae, 0x2b, 0xfe, 0x88, 0x80,
-	0xde, 0x05, 0x28, 0x3a, 0x31, 0x7d, 0x8f, 0x96, 0xbd, 0x31, 0x8a, 0xbc, 0xbb, 0x19, 0x3d, 0x90,
-	0xbd, 0x62, 0x43, 0xf5, 0x8a, 0x66, 0x85, 0x3e, 0x84, 0x1a, 0xa3, 0x07, 0x7e, 0x80, 0x05, 0x6e,
-	0x37, 0x55, 0xf0, 0x2e, 0xcc, 0x74, 0xf6, 0x7a, 0x44, 0xbb, 0x5e, 0x95, 0xd1, 0x83, 0x0e, 0x16,
-	0x18, 0xdd, 0x85, 0x86, 0x42, 0x00, 0xd7, 0x1b, 0xe7, 0xd5, 0xc6, 0x77, 0x27, 0x37, 0x9a, 0x01,
-	0xf5, 0x13, 0x29, 0x27, 0x37, 0x79, 0x1a, 0x9a, 0x5c, 0x29, 0xb8, 0x00, 0xb5, 0x24, 0x8b, 0x7d,
-	0x46, 0x0f, 0x78, 0xbb, 0xa5, 0xfa, 0xc6, 0x6a, 0x92, 0xc5, 0x1e, 0x3d, 0xe0, 0x68, 0x1d, 0xaa,
-	0xfb, 0x84, 0xf1, 0x90, 0x26, 0xed, 0x05, 0x35, 0x8a, 0xae, 0x1e, 0x31, 0xae, 0x69, 0xc4, 0x48,
-	0x75, 0x4f, 0xb5, 0xbc, 0x97, 0x6f, 0x74, 0xff, 0x55, 0x86, 0xf9, 0x5d, 0x82, 0x59, 0x6f, 0x70,
-	0x76, 0x40, 0x2d, 0x41, 0x85, 0x91, 0xe7, 0x45, 0x73, 0xae, 0x17, 0x45, 0x7c, 0xed, 0x63, 0xe2,
-	0x5b, 0x3e, 0x41, 0xc7, 0x5e, 0x99, 0xd1, 0xb1, 0x3b, 0x60, 0x07, 0x3c, 0x52, 0xd0, 0xa9, 0x7b,
-	0xf2, 0x51, 0xf6, 0xd9, 0x69, 0x84, 0x7b, 0x64, 0x40, 0xa3, 0x80, 0x30, 0xbf, 0xcf, 0x68, 0xa6,
-	0xfb, 0xec, 0xa6, 0xe7, 0x8c, 0x31, 0x1e, 0x48, 0x3a, 0xba, 0x03, 0xb5, 0x80, 0x47, 0xbe, 0x18,
-	0xa6, 0x44, 0xe1, 0xa7, 0x75, 0xc4, 0x31, 0x3b, 0x3c, 0x7a, 0x32, 0x4c, 0x89, 0x57, 0x0d, 0xf4,
-	0x03, 0xba, 0x05, 0x4b, 0x9c, 0xb0, 0x10, 0x47, 0xe1, 0x4b, 0x12, 0xf8, 0xe4, 0x45, 0xca, 0xfc,
-	0x34, 0xc2, 0x89, 0x02, 0x59, 0xd3, 0x43, 0x23, 0xde, 0xfd, 0x17, 0x29, 0xdb, 0x89, 0x70, 0x82,
-	0x56, 0xc1, 0xa1, 0x99, 0x48, 0x33, 0xe1, 0x1b, 0x18, 0x84, 0x81, 0xc2, 0x9c, 0xed, 0xb5, 0x34,
-	0x5d, 0x45, 0x9d, 0x6f, 0x06, 0x33, 0xa7, 0x90, 0xc6, 0xa9, 0xa6, 0x90, 0xe6, 0xe9, 0xa6, 0x90,
-	0xf9, 0xd9, 0x53, 0x08, 0x6a, 0x41, 0x29, 0x79, 0xae, 0xb0, 0x66, 0x7b, 0xa5, 0xe4, 0xb9, 0x0c,
-	0xa4, 0xa0, 0xe9, 0x33, 0x85, 0x31, 0xdb, 0x53, 0xcf, 0x32, 0x89, 0x62, 0x22, 0x58, 0xd8, 0x93,
-	0x6e, 0x69, 0x3b, 0x2a, 0x0e, 0x63, 0x14, 0xf7, 0xbf, 0xf6, 0x08, 0x56, 0x3c, 0x8b, 0x04, 0xff,
-	0xa2, 0x26, 0x98, 0x02, 0x8b, 0xf6, 0x38, 0x16, 0xaf, 0x40, 0x43, 0x1b, 0xa7, 0x63, 0x5e, 0x9e,
-	0xb6, 0x57, 0x0a, 0xc8, 0x2c, 0x7b, 0x9e, 0x11, 0x16, 0x12, 0x6e, 0xca, 0x3e, 0x24, 0x59, 0xfc,
-	0x58, 0x53, 0xd0, 0x39, 0xa8, 0x08, 0x9a, 0xfa, 0xcf, 0xf2, 0x72, 0x25, 0x68, 0xfa, 0x10, 0x7d,
-	0x1f, 0x96, 0x39, 0xc1, 0x11, 0x09, 0xfc, 0xa2, 0xbc, 0x70, 0x9f, 0xab, 0x63, 0x93, 0xa0, 0x5d,
-	0x55, 0x61, 0x6e, 0x6b, 0x89, 0xdd, 0x42, 0x60, 0xd7, 0xf0, 0x65, 0x14, 0x7b, 0xba, 0x6d, 0x9f,
-	0xd8, 0x56, 0x53, 0x9d, 0x3d, 0x1a, 0xb1, 0x8a, 0x0d, 0x1f, 0x43, 0xbb, 0x1f, 0xd1, 0x2e, 0x8e,
-	0xfc, 0x43, 0x6f, 0x55, 0x23, 0x84, 0xed, 0xbd, 0xad, 0xf9, 0xbb, 0x53, 0xaf, 0x94, 0xc7, 0xe3,
-	0x51, 0xd8, 0x23, 0x81, 0xdf, 0x8d, 0x68, 0xb7, 0x0d, 0x0a, 0xae, 0xa0, 0x49, 0xb2, 0x5e, 0x49,
-	0x98, 0x1a, 0x01, 0xe9, 0x86, 0x1e, 0xcd, 0x12, 0xa1, 0xc0, 0x67, 0x7b, 0x2d, 0x4d, 0x7f, 0x94,
-	0xc5, 0x1b, 0x92, 0x8a, 0xbe, 0x06, 0xf3, 0x46, 0x92, 0xee, 0xed, 0x71, 0x22, 0x14, 0xea, 0x6c,
-	0xaf, 0xa9, 0x89, 0x3f, 0x56, 0x34, 0xf7, 0x2f, 0x36, 0x2c, 0x78, 0xd2, 0xbb, 0x64, 0x9f, 0xfc,
-	0x3f, 0xd5, 0x95, 0xa3, 0xf2, 0x7b, 0xee, 0x54, 0xf9, 0x5d, 0x3d, 0x71, 0x7e, 0xd7, 0x4e, 0x95,
-	0xdf, 0xf5, 0xd3, 0xe5, 0x37, 0x1c, 0x91, 0xdf, 0x4b, 0x50, 0x89, 0xc2, 0x38, 0xcc, 0x03, 0xac,
-	0x17, 0xee, 0x1f, 0x27, 0x42, 0xf6, 0x06, 0xe4, 0xec, 0x35, 0xb0, 0xc3, 0x40, 0x37, 0x90, 0x8d,
-	0xb5, 0xf6, 0xcc, 0x1b, 0x73, 0xb3, 0xc3, 0x3d, 0x29, 0x34, 0x7d, 0xcb, 0x56, 0x4e, 0x7d, 0xcb,
-	0xfe, 0x00, 0x2e, 0x1e, 0xce, 0x64, 0x66, 0xdc, 0x11, 0xb4, 0xe7, 0x54, 0x44, 0x2f, 0x4c, 0xa7,
-	0x72, 0xee, 0xaf, 0x00, 0x7d, 0x07, 0x96, 0xc6, 0x72, 0x79, 0xb4, 0xb1, 0xaa, 0x27, 0xfb, 0x11,
-	0x6f, 0xb4, 0xe5, 0xb8, 0x6c, 0xae, 0x1d, 0x97, 0xcd, 0xee, 0x3f, 0x6c, 0x98, 0xef, 0x90, 0x88,
-	0x08, 0xf2, 0x55, 0x13, 0x78, 0x64, 0x13, 0xf8, 0x6d, 0x40, 0x61, 0x22, 0x6e, 0x7f, 0xe8, 0xa7,
-	0x2c, 0x8c, 0x31, 0x1b, 0xfa, 0xcf, 0xc8, 0x30, 0x2f, 0x93, 0x8e, 0xe2, 0xec, 0x68, 0xc6, 0x43,
-	0x32, 0xe4, 0xaf, 0x6c, 0x0a, 0xc7, 0xbb, 0x30, 0x9d, 0x36, 0x45, 0x17, 0xf6, 0x3d, 0x68, 0x4e,
-	0xbc, 0xa2, 0xf9, 0x0a, 0xc0, 0x36, 0xd2, 0xd1, 0x7b, 0xdd, 0xff, 0x58, 0x50, 0xdf, 0xa2, 0x38,
-	0x50, 0xf3, 0xd0, 0x19, 0xc3, 0x58, 0xb4, 0xba, 0xa5, 0xe9, 0x56, 0xf7, 0x12, 0x8c, 0x46, 0x1a,
-	0x13, 0xc8, 0xb1, 0x19, 0x67, 0x6c, 0x56, 0x29, 0x4f, 0xce, 0x2a, 0x57, 0xa0, 0x11, 0x4a, 0x83,
-	0xfc, 0x14, 0x8b, 0x81, 0xae, 0x94, 0x75, 0x0f, 0x14, 0x69, 0x47, 0x52, 0xe4, 0x30, 0x93, 0x0b,
-	0xa8, 0x61, 0x66, 0xee, 0xc4, 0xc3, 0x8c, 0x51, 0xa2, 0x86, 0x99, 0x5f, 0x5a, 0x00, 0xea, 0xe0,
-	0xb2, 0x1e, 0x1c, 0x56, 0x6a, 0x9d, 0x45, 0xa9, 0x2c, 0xe1, 0x2a, 0x52, 0x24, 0xc2, 0x62, 0x94,
-	0x54, 0xdc, 0x38, 0x07, 0xc9, 0xa8, 0x69, 0x96, 0x49, 0x28, 0xee, 0xfe, 0xc6, 0x02, 0x50, 0x55,
-	0x41, 0x9b, 0x31, 0x0d, 0x3f, 0xeb, 0xf8, 0x31, 0xaf, 0x34, 0xe9, 0xba, 0xf5, 0xdc, 0x75, 0xc7,
-	0x7c, 0x47, 0x1d, 0xeb, 0xcb, 0xf3, 0xc3, 0x1b, 0xef, 0xaa, 0x67, 0xf7, 0xb7, 0x16, 0x34, 0x8d,
-	0x75, 0xda, 0xa4, 0x89, 0x28, 0x5b, 0xd3, 0x51, 0x56, 0xcd, 0x4d, 0x4c, 0xd9, 0xd0, 0xe7, 0xe1,
-	0x4b, 0x62, 0x0c, 0x02, 0x4d, 0xda, 0x0d, 0x5f, 0x92, 0x09, 0xf0, 0xda, 0x93, 0xe0, 0xbd, 0x0e,
-	0x8b, 0x8c, 0xf4, 0x48, 0x22, 0xa2, 0xa1, 0x1f, 0xd3, 0x20, 0xdc, 0x0b, 0x49, 0xa0, 0xd0, 0x50,
-	0xf3, 0x9c, 0x9c, 0xb1, 0x6d, 0xe8, 0xee, 0x2f, 0x2c, 0x68, 0x6c, 0xf3, 0xfe, 0x0e, 0xe5, 0x2a,
-	0xc9, 0xd0, 0x55, 0x68, 0x9a, 0xc2, 0xa6, 0x33, 0xdc, 0x52, 0x08, 0x6b, 0xf4, 0x46, 0xdf, 0x22,
-	0x65, 0x69, 0x8f, 0x79, 0xdf, 0xb8, 0xa9, 0xe9, 0xe9, 0x05, 0x5a, 0x86, 0x5a, 0xcc, 0xfb, 0xaa,
-	0x17, 0x37, 0xb0, 0x2c, 0xd6, 0xf2, 0xac, 0xa3, 0x2b, 0xac, 0xac, 0xae, 0xb0, 0x11, 0xc1, 0xfd,
-	0xcc, 0x02, 0x64, 0xbe, 0x75, 0xbe, 0xd6, 0xaf, 0x09, 0x15, 0xe5, 0xf1, 0xef, 0xa9, 0x25, 0x85,
-	0xf1, 0x09, 0xda, 0x54, 0x51, 0xb0, 0x0f, 0x15, 0x85, 0xeb, 0xb0, 0x18, 0x90, 0x3d, 0x9c, 0x45,
-	0xe3, 0xb7, 0xae, 0x36, 0xd9, 0x31, 0x8c, 0x89, 0x6f, 0xfb, 0xad, 0x0d, 0x46, 0x02, 0x92, 0x88,
-	0x10, 0x47, 0xea, 0x97, 0xd3, 0x32, 0xd4, 0x32, 0x2e, 0x91, 0x50, 0xf8, 0xae, 0x58, 0xa3, 0xf7,
-	0x01, 0x91, 0xa4, 0xc7, 0x86, 0xa9, 0x04, 0x71, 0x8a, 0x39, 0x3f, 0xa0, 0x2c, 0x30, 0x85, 0x7a,
-	0xb1, 0xe0, 0xec, 0x18, 0x86, 0x1c, 0x5a, 0x05, 0x49, 0x70, 0x22, 0xf2, 0x7a, 0xad, 0x57, 0x32,
-	0xf4, 0x21, 0xf7, 0x79, 0x96, 0x12, 0x66, 0xc2, 0x5a, 0x0d, 0xf9, 0xae, 0x5c, 0xca, 0x52, 0xce,
-	0x07, 0x78, 0xed, 0xa3, 0xdb, 0x23, 0xf5, 0xba, 0x44, 0xb7, 0x34, 0x39, 0xd7, 0xed, 0xde, 0x87,
-	0xc5, 0xad, 0x90, 0x8b, 0x1d, 0x1a, 0x85, 0xbd, 0xe1, 0x99, 0x6f, 0x1c, 0xf7, 0x53, 0x0b, 0xd0,
-	0xb8, 0x1e, 0xf3, 0x67, 0x63, 0xd4, 0x31, 0x58, 0x27, 0xef, 0x18, 0xae, 0x42, 0x33, 0x55, 0x6a,
-	0xd4, 0x7f, 0xd4, 0x3c, 0x7a, 0x0d, 0x4d, 0x93, 0xbe, 0xe5, 0xe8, 0x32, 0x80, 0x74, 0xa6, 0xcf,
-	0x68, 0x44, 0x74, 0xf0, 0xea, 0x5e, 0x5d, 0x52, 0x3c, 0x49, 0x70, 0xfb, 0x70, 0x61, 0x77, 0x40,
-	0x0f, 0x36, 0x68, 0xb2, 0x17, 0xf6, 0x33, 0x86, 0x25, 0xa0, 0x5f, 0xe3, 0x8b, 0x59, 0x1b, 0xaa,
-	0x29, 0x16, 0x32, 0xad, 0x4d, 0x8c, 0xf2, 0xa5, 0xfb, 0x3b, 0x0b, 0x96, 0x67, 0xbd, 0xe9, 0x75,
-	0x8e, 0xff, 0x00, 0xe6, 0x7b, 0x5a, 0x9d, 0xd6, 0x76, 0xf2, 0x5f, 0x87, 0x93, 0xfb, 0xdc, 0xfb,
-	0x50, 0xf6, 0xb0, 0x20, 0xe8, 0x26, 0x94, 0x98, 0x50, 0x16, 0xb4, 0xd6, 0xae, 0x1c, 0x51, 0xac,
-	0xa4, 0xa0, 0x9a, 0x86, 0x4b, 0x4c, 0xa0, 0x26, 0x58, 0x4c, 0x9d, 0xd4, 0xf2, 0x2c, 0x76, 0xed,
-	0x63, 0xa8, 0x17, 0x7f, 0xbb, 0x91, 0x03, 0xcd, 0xcd, 0x24, 0x14, 0xaa, 0x51, 0x0e, 0x93, 0xbe,
-	0xf3, 0x16, 0x6a, 0x40, 0xf5, 0x47, 0x04, 0x47, 0x62, 0x30, 0x74, 0x2c, 0xd4, 0x84, 0xda, 0xbd,
-	0x6e, 0x42, 0x59, 0x8c, 0x23, 0xa7, 0x74, 0x6d, 0x0d, 0x16, 0x0f, 0x7d, 0x9c, 0x90, 0x22, 0x1e,
-	0x3d, 0x90, 0xde, 0x0d, 0x9c, 0xb7, 0xd0, 0x02, 0x34, 0x36, 0x68, 0x94, 0xc5, 0x89, 0x26, 0x58,
-	0xd7, 0xfe, 0x64, 0x41, 0x2d, 0x37, 0x06, 0x2d, 0xc2, 0x7c, 0xa7, 0xb3, 0x35, 0xfa, 0xd3, 0xe1,
-	0xbc, 0x25, 0x0d, 0xe8, 0x74, 0xb6, 0x8a, 0xef, 0xe4, 0xfa, 0x9d, 0x9d, 0xce, 0x96, 0xaa, 0xb6,
-	0x4e, 0xc9, 0xac, 0x3e, 0x89, 0x32, 0x3e, 0x70, 0xec, 0x42, 0x41, 0x9c, 0x62, 0xad, 0xa0, 0x8c,
-	0xe6, 0xa1, 0xde, 0xd9, 0xde, 0xd2, 0x76, 0x39, 0x15, 0xb3, 0xd4, 0x0d, 0x97, 0x33, 0x27, 0xed,
-	0xe9, 0x6c, 0x6f, 0xad, 0x67, 0xd1, 0x33, 0x79, 0x71, 0x3b, 0x55, 0xc5, 0x7f, 0xbc, 0xa5, 0xa7,
-	0x34, 0xa7, 0xa6, 0xd4, 0x3f, 0xde, 0x92, 0x73, 0xe3, 0xd0, 0xa9, 0xaf, 0xdf, 0xf9, 0xd9, 0x47,
-	0xfd, 0x50, 0x0c, 0xb2, 0xae, 0x0c, 0xc7, 0x4d, 0xed, 0xd9, 0xf7, 0x43, 0x6a, 0x9e, 0x6e, 0xe6,
-	0xde, 0xbd, 0xa9, 0x9c, 0x5d, 0x2c, 0xd3, 0x6e, 0x77, 0x4e, 0x51, 0x3e, 0xf8, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x31, 0x5e, 0x70, 0xe6, 0xf4, 0x20, 0x00, 0x00,
+	// 2415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x4f, 0x4f, 0xcf, 0x78, 0x66, 0xde, 0x8c, 0xc7, 0xe3, 0x8a, 0x93, 0x1d, 0x3b, 0xd9, 0x8d,
+	0xd3, 0xdf, 0xfd, 0x82, 0x49, 0xd8, 0x24, 0x78, 0x77, 0x93, 0x68, 0x41, 0x1b, 0x62, 0x4f, 0xd6,
+	0x58, 0xb1, 0x83, 0xd3, 0x0e, 0x91, 0xe0, 0xd2, 0xaa, 0x99, 0x2e, 0xcf, 0x34, 0xee, 0xee, 0xea,
+	0x54, 0x55, 0xdb, 0x99, 0x9c, 0x38, 0x70, 0x62, 0x05, 0x37, 0x2e, 0x48, 0x70, 0x46, 0x48, 0x48,
+	0xdc, 0x38, 0x20, 0x81, 0xc4, 0x89, 0x13, 0x27, 0x2e, 0xfc, 0x2b, 0x9c, 0x50, 0xfd, 0xe8, 0x9e,
+	0x1f, 0x1e, 0x3b, 0xb6, 0xa3, 0xdd, 0x0d, 0xd2, 0xde, 0xba, 0xde, 0x7b, 0xf5, 0xea, 0xd5, 0x7b,
+	0x9f, 0x7a, 0xfd, 0x5e, 0x15, 0x34, 0x82, 0x58, 0x10, 0x16, 0xe3, 0xf0, 0x56, 0xc2, 0xa8, 0xa0,
+	0xe8, 0x52, 0x14, 0x84, 0x07, 0x29, 0xd7, 0xa3, 0x5b, 0x19, 0x73, 0xa9, 0xde, 0xa5, 0x51, 0x44,
+	0x63, 0x4d, 0x5e, 0xaa, 0xf3, 0x6e, 0x9f, 0x44, 0x58, 0x8f, 0x9c, 0xbf, 0x5a, 0x30, 0xbb, 0x4e,
+	0xa3, 0x84, 0xc6, 0x24, 0x16, 0x9b, 0xf1, 0x1e, 0x45, 0x97, 0x61, 0x26, 0xa6, 0x3e, 0xd9, 0x6c,
+	0xb7, 0xac, 0x65, 0x6b, 0xc5, 0x76, 0xcd, 0x08, 0x21, 0x28, 0x32, 0x1a, 0x92, 0x56, 0x61, 0xd9,
+	0x5a, 0xa9, 0xba, 0xea, 0x1b, 0x3d, 0x00, 0xe0, 0x02, 0x0b, 0xe2, 0x75, 0xa9, 0x4f, 0x5a, 0xf6,
+	0xb2, 0xb5, 0xd2, 0x58, 0x5d, 0xbe, 0x35, 0xd5, 0x8a, 0x5b, 0xbb, 0x52, 0x70, 0x9d, 0xfa, 0xc4,
+	0xad, 0xf2, 0xec, 0x13, 0x7d, 0x1f, 0x80, 0xbc, 0x14, 0x0c, 0x7b, 0x41, 0xbc, 0x47, 0x5b, 0xc5,
+	0x65, 0x7b, 0xa5, 0xb6, 0x7a, 0x7d, 0x5c, 0x81, 0x31, 0xfe, 0x31, 0x19, 0x3c, 0xc7, 0x61, 0x4a,
+	0x76, 0x70, 0xc0, 0xdc, 0xaa, 0x9a, 0x24, 0xcd, 0x75, 0xfe, 0x6d, 0xc1, 0x5c, 0xbe, 0x01, 0xb5,
+	0x06, 0x47, 0x9f, 0x40, 0x49, 0x2d, 0xa1, 0x76, 0x50, 0x5b, 0x7d, 0xff, 0x18, 0x8b, 0xc6, 0xf6,
+	0xed, 0xea, 0x29, 0xe8, 0x47, 0x70, 0x91, 0xa7, 0x9d, 0x6e, 0xc6, 0xf2, 0x14, 0x95, 0xb7, 0x0a,
+	0xcb, 0xf6, 0xa9, 0x35, 0xa1, 0x51, 0x05, 0xc6, 0xa4, 0x0f, 0x61, 0x46, 0x6a, 0x4a, 0xb9, 0xf2,
+	0x52, 0x6d, 0xf5, 0xca, 0xd4, 0x4d, 0xee, 0x2a, 0x11, 0xd7, 0x88, 0x3a, 0x57, 0x60, 0x71, 0x83,
+	0x88, 0x89, 0xdd, 0xb9, 0xe4, 0x45, 0x4a, 0xb8, 0x30, 0xcc, 0x67, 0x41, 0x44, 0x9e, 0x05, 0xdd,
+	0xfd, 0xf5, 0x3e, 0x8e, 0x63, 0x12, 0x66, 0xcc, 0x77, 0xe1, 0xca, 0x06, 0x51, 0x13, 0x02, 0x2e,
+	0x82, 0x2e, 0x9f, 0x60, 0x5f, 0x82, 0x8b, 0x1b, 0x44, 0xb4, 0xfd, 0x09, 0xf2, 0x73, 0xa8, 0x3c,
+	0x91, 0xc1, 0x96, 0x30, 0xb8, 0x0b, 0x65, 0xec, 0xfb, 0x8c, 0x70, 0x6e, 0xbc, 0x78, 0x75, 0xaa,
+	0xc5, 0x0f, 0xb5, 0x8c, 0x9b, 0x09, 0x4f, 0x83, 0x89, 0xf3, 0x53, 0x80, 0xcd, 0x38, 0x10, 0x3b,
+	0x98, 0xe1, 0x88, 0x1f, 0x0b, 0xb0, 0x36, 0xd4, 0xb9, 0xc0, 0x4c, 0x78, 0x89, 0x92, 0x6b, 0x15,
+	0x4e, 0x8b, 0x86, 0x9a, 0x9a, 0xa6, 0xb5, 0x3b, 0x3f, 0x06, 0xd8, 0x15, 0x2c, 0x88, 0x7b, 0x5b,
+	0x01, 0x17, 0x72, 0xad, 0x03, 0x29, 0x27, 0x37, 0x61, 0xaf, 0x54, 0x5d, 0x33, 0x1a, 0x09, 0x47,
+	0xe1, 0xf4, 0xe1, 0x78, 0x00, 0xb5, 0xcc, 0xdd, 0xdb, 0xbc, 0x87, 0xee, 0x40, 0xb1, 0x83, 0x39,
+	0x39, 0xd1, 0x3d, 0xdb, 0xbc, 0xb7, 0x86, 0x39, 0x71, 0x95, 0xa4, 0xf3, 0xc7, 0x02, 0x2c, 0x8c,
+	0x85, 0xc5, 0x38, 0xfe, 0xec, 0xaa, 0xa4, 0x9b, 0xfd, 0xce, 0x66, 0x5b, 0x99, 0x6f, 0xbb, 0xea,
+	0x1b, 0x39, 0x50, 0xef, 0xd2, 0x30, 0x24, 0x5d, 0x11, 0xd0, 0x78, 0xb3, 0xad, 0x90, 0x66, 0xbb,
+	0x63, 0x34, 0x29, 0x93, 0x60, 0x26, 0x02, 0x3d, 0xe4, 0xea, 0xc8, 0xd9, 0xee, 0x18, 0x0d, 0x7d,
+	0x0b, 0x9a, 0x82, 0xe1, 0x03, 0x12, 0x7a, 0x22, 0x88, 0x08, 0x17, 0x38, 0x4a, 0x5a, 0xa5, 0x65,
+	0x6b, 0xa5, 0xe8, 0xce, 0x69, 0xfa, 0xb3, 0x8c, 0x8c, 0x6e, 0xc3, 0xc5, 0x5e, 0x8a, 0x19, 0x8e,
+	0x05, 0x21, 0x23, 0xd2, 0x33, 0x4a, 0x1a, 0xe5, 0xac, 0xe1, 0x84, 0x9b, 0x30, 0x2f, 0xc5, 0x68,
+	0x2a, 0x46, 0xc4, 0xcb, 0x4a, 0xbc, 0x69, 0x18, 0xb9, 0xb0, 0xf3, 0x67, 0x0b, 0x2e, 0x4d, 0xf8,
+	0x8b, 0x27, 0x34, 0xe6, 0xe4, 0x1c, 0x0e, 0x3b, 0x4f, 0xc4, 0xd1, 0x3d, 0x9d, 0x48, 0xe4, 0xa1,
+	0x3d, 0x25, 0x16, 0xb5, 0xbc, 0xf3, 0x0b, 0x1b, 0xde, 0x59, 0x67, 0x44, 0xa5, 0xb9, 0xcc, 0xfb,
+	0xe7, 0x0f, 0xf6, 0x3b, 0x50, 0xf6, 0x3b, 0x5e, 0x8c, 0xa3, 0xec, 0x58, 0xcd, 0xf8, 0x9d, 0x27,
+	0x38, 0x22, 0xe8, 0x1b, 0xd0, 0x18, 0x46, 0x57, 0x52, 0x54, 0xcc, 0xab, 0xee, 0x04, 0x15, 0xbd,
+	0x0f, 0xb3, 0x79, 0x84, 0x95, 0x58, 0x51, 0x89, 0x8d, 0x13, 0x73, 0x4c, 0x95, 0x4e, 0xc0, 0xd4,
+	0xcc, 0x14, 0x4c, 0x2d, 0x43, 0x6d, 0x04, 0x3f, 0x2a, 0x9a, 0xb6, 0x3b, 0x4a, 0x92, 0xc7, 0x50,
+	0xff, 0x75, 0x5a, 0x95, 0x65, 0x6b, 0xa5, 0xee, 0x9a, 0x11, 0xba, 0x03, 0x17, 0x0f, 0x02, 0x26,
+	0x52, 0x1c, 0x9a, 0x4c, 0x24, 0xed, 0xe0, 0xad, 0xaa, 0x3a, 0xab, 0xd3, 0x58, 0x68, 0x15, 0x16,
+	0x92, 0xfe, 0x80, 0x07, 0xdd, 0x89, 0x29, 0xa0, 0xa6, 0x4c, 0xe5, 0x39, 0x7f, 0xb7, 0xe0, 0x52,
+	0x9b, 0xd1, 0xe4, 0xad, 0x08, 0x45, 0xe6, 0xe4, 0xe2, 0x09, 0x4e, 0x2e, 0x1d, 0x75, 0xb2, 0xf3,
+	0xcb, 0x02, 0x5c, 0xd6, 0x88, 0xda, 0xc9, 0x1c, 0xfb, 0x05, 0xec, 0xe2, 0x9b, 0x30, 0x37, 0x5c,
+	0xd5, 0x8b, 0x8f, 0xdf, 0xc6, 0xff, 0x43, 0x23, 0x0f, 0xb0, 0x96, 0xfb, 0x72, 0x21, 0xe5, 0x7c,
+	0x5e, 0x80, 0x05, 0x19, 0xd4, 0xaf, 0xbd, 0x21, 0xbd, 0xf1, 0x3b, 0x0b, 0x90, 0x46, 0xc7, 0xc3,
+	0x30, 0xc0, 0xfc, 0xab, 0xf4, 0xc5, 0x02, 0x94, 0xb0, 0xb4, 0xc1, 0xb8, 0x40, 0x0f, 0x1c, 0x0e,
+	0x4d, 0x19, 0xad, 0x2f, 0xca, 0xba, 0x7c, 0x51, 0x7b, 0x74, 0xd1, 0xdf, 0x5a, 0x30, 0xff, 0x30,
+	0x14, 0x84, 0xbd, 0xa5, 0x4e, 0xf9, 0x5b, 0x21, 0x8b, 0xda, 0x66, 0xec, 0x93, 0x97, 0x5f, 0xa5,
+	0x81, 0xef, 0x02, 0xec, 0x05, 0x24, 0xf4, 0x47, 0xd1, 0x5b, 0x55, 0x94, 0x37, 0x42, 0x6e, 0x0b,
+	0xca, 0x4a, 0x49, 0x8e, 0xda, 0x6c, 0x28, 0xab, 0x3d, 0x5d, 0xf9, 0x9b, 0x6a, 0xaf, 0x72, 0xea,
+	0x6a, 0x4f, 0x4d, 0x33, 0xd5, 0xde, 0x3f, 0x8b, 0x30, 0xbb, 0x19, 0x73, 0xc2, 0xc4, 0xf9, 0x9d,
+	0x77, 0x15, 0xaa, 0xbc, 0x8f, 0x99, 0xff, 0x64, 0xe8, 0xbe, 0x21, 0x61, 0xd4, 0xb5, 0xf6, 0xeb,
+	0x5c, 0x5b, 0x3c, 0x65, 0x72, 0x28, 0x9d, 0x94, 0x1c, 0x66, 0x4e, 0x70, 0x71, 0xf9, 0xf5, 0xc9,
+	0xa1, 0x72, 0xf4, 0xef, 0x2b, 0x37, 0x48, 0x7a, 0x91, 0x6c, 0x4f, 0xda, 0xad, 0xaa, 0xe2, 0x0f,
+	0x09, 0xe8, 0x3d, 0x80, 0xbc, 0x12, 0xd3, 0xff, 0xd1, 0xa2, 0x3b, 0x42, 0x91, 0xff, 0x6e, 0x46,
+	0x0f, 0x65, 0xad, 0x58, 0x53, 0xb5, 0xa2, 0x19, 0xa1, 0x8f, 0xa0, 0xc2, 0xe8, 0xa1, 0xe7, 0x63,
+	0x81, 0x5b, 0x75, 0x15, 0xbc, 0xc5, 0xa9, 0xce, 0x5e, 0x0b, 0x69, 0xc7, 0x2d, 0x33, 0x7a, 0xd8,
+	0xc6, 0x02, 0xa3, 0x07, 0x50, 0x53, 0x08, 0xe0, 0x7a, 0xe2, 0xac, 0x9a, 0xf8, 0xde, 0xf8, 0x44,
+	0xd3, 0xa0, 0x7e, 0x26, 0xe5, 0xe4, 0x24, 0x57, 0x43, 0x93, 0x2b, 0x05, 0x8b, 0x50, 0x89, 0xd3,
+	0xc8, 0x63, 0xf4, 0x90, 0xb7, 0x1a, 0xaa, 0x6e, 0x2c, 0xc7, 0x69, 0xe4, 0xd2, 0x43, 0x8e, 0xd6,
+	0xa0, 0x7c, 0x40, 0x18, 0x0f, 0x68, 0xdc, 0x9a, 0x53, 0xad, 0xe8, 0xca, 0x31, 0xed, 0x9a, 0x46,
+	0x8c, 0x54, 0xf7, 0x5c, 0xcb, 0xbb, 0xd9, 0x44, 0xe7, 0x5f, 0x45, 0x98, 0xdd, 0x25, 0x98, 0x75,
+	0xfb, 0xe7, 0x07, 0xd4, 0x02, 0x94, 0x18, 0x79, 0x91, 0x17, 0xe7, 0x7a, 0x90, 0xc7, 0xd7, 0x3e,
+	0x21, 0xbe, 0xc5, 0x53, 0x54, 0xec, 0xa5, 0x29, 0x15, 0x7b, 0x13, 0x6c, 0x9f, 0x87, 0x0a, 0x3a,
+	0x55, 0x57, 0x7e, 0xca, 0x3a, 0x3b, 0x09, 0x71, 0x97, 0xf4, 0x69, 0xe8, 0x13, 0xe6, 0xf5, 0x18,
+	0x4d, 0x75, 0x9d, 0x5d, 0x77, 0x9b, 0x23, 0x8c, 0x0d, 0x49, 0x47, 0xf7, 0xa0, 0xe2, 0xf3, 0xd0,
+	0x13, 0x83, 0x84, 0x28, 0xfc, 0x34, 0x8e, 0xd9, 0x66, 0x9b, 0x87, 0xcf, 0x06, 0x09, 0x71, 0xcb,
+	0xbe, 0xfe, 0x40, 0x77, 0x60, 0x81, 0x13, 0x16, 0xe0, 0x30, 0x78, 0x45, 0x7c, 0x8f, 0xbc, 0x4c,
+	0x98, 0x97, 0x84, 0x38, 0x56, 0x20, 0xab, 0xbb, 0x68, 0xc8, 0x7b, 0xf4, 0x32, 0x61, 0x3b, 0x21,
+	0x8e, 0xd1, 0x0a, 0x34, 0x69, 0x2a, 0x92, 0x54, 0x78, 0x06, 0x06, 0x81, 0xaf, 0x30, 0x67, 0xbb,
+	0x0d, 0x4d, 0x57, 0x51, 0xe7, 0x9b, 0xfe, 0xd4, 0x2e, 0xa4, 0x76, 0xa6, 0x2e, 0xa4, 0x7e, 0xb6,
+	0x2e, 0x64, 0x76, 0x7a, 0x17, 0x82, 0x1a, 0x50, 0x88, 0x5f, 0x28, 0xac, 0xd9, 0x6e, 0x21, 0x7e,
+	0x21, 0x03, 0x29, 0x68, 0xb2, 0xaf, 0x30, 0x66, 0xbb, 0xea, 0x5b, 0x1e, 0xa2, 0x88, 0x08, 0x16,
+	0x74, 0xa5, 0x5b, 0x5a, 0x4d, 0x15, 0x87, 0x11, 0x8a, 0xf3, 0x97, 0xd2, 0x10, 0x56, 0x3c, 0x0d,
+	0x05, 0xff, 0xb2, 0x3a, 0x98, 0x1c, 0x8b, 0xf6, 0x28, 0x16, 0xaf, 0x41, 0x4d, 0x1b, 0xa7, 0x63,
+	0x5e, 0x9c, 0xb4, 0x57, 0x0a, 0xc8, 0x53, 0xf6, 0x22, 0x25, 0x2c, 0x20, 0xdc, 0xa4, 0x7d, 0x88,
+	0xd3, 0xe8, 0xa9, 0xa6, 0xa0, 0x8b, 0x50, 0x12, 0x34, 0xf1, 0xf6, 0xb3, 0x74, 0x25, 0x68, 0xf2,
+	0x18, 0x7d, 0x0f, 0x96, 0x38, 0xc1, 0x21, 0xf1, 0xbd, 0x3c, 0xbd, 0x70, 0x8f, 0xab, 0x6d, 0x13,
+	0xbf, 0x55, 0x56, 0x61, 0x6e, 0x69, 0x89, 0xdd, 0x5c, 0x60, 0xd7, 0xf0, 0x65, 0x14, 0xbb, 0xba,
+	0x6c, 0x1f, 0x9b, 0x56, 0x51, 0x95, 0x3d, 0x1a, 0xb2, 0xf2, 0x09, 0xf7, 0xa1, 0xd5, 0x0b, 0x69,
+	0x07, 0x87, 0xde, 0x91, 0x55, 0x55, 0x0b, 0x61, 0xbb, 0x97, 0x35, 0x7f, 0x77, 0x62, 0x49, 0xb9,
+	0x3d, 0x1e, 0x06, 0x5d, 0xe2, 0x7b, 0x9d, 0x90, 0x76, 0x5a, 0xa0, 0xe0, 0x0a, 0x9a, 0x24, 0xf3,
+	0x95, 0x84, 0xa9, 0x11, 0x90, 0x6e, 0xe8, 0xd2, 0x34, 0x16, 0x0a, 0x7c, 0xb6, 0xdb, 0xd0, 0xf4,
+	0x27, 0x69, 0xb4, 0x2e, 0xa9, 0xe8, 0xff, 0x60, 0xd6, 0x48, 0xd2, 0xbd, 0x3d, 0x4e, 0x84, 0x42,
+	0x9d, 0xed, 0xd6, 0x35, 0xf1, 0x87, 0x8a, 0x86, 0x04, 0x5c, 0x36, 0xf6, 0x7b, 0x9c, 0xb0, 0x83,
+	0xa0, 0x4b, 0x70, 0x27, 0x24, 0x9e, 0xe0, 0x26, 0x01, 0x7e, 0x7a, 0xdc, 0x9d, 0xd9, 0x28, 0x64,
+	0x6e, 0x99, 0xa6, 0x66, 0x77, 0xa8, 0xe1, 0x19, 0x7f, 0x14, 0x0b, 0x36, 0x70, 0x17, 0xba, 0x53,
+	0x58, 0x4b, 0x1b, 0xb0, 0x78, 0xec, 0x14, 0x99, 0x32, 0xf6, 0xc9, 0x40, 0xc1, 0xaf, 0xea, 0xca,
+	0x4f, 0x09, 0x15, 0x75, 0x3b, 0xa2, 0xe0, 0x55, 0x74, 0xf5, 0xe0, 0x93, 0xc2, 0x7d, 0xcb, 0xf9,
+	0x93, 0x0d, 0x73, 0xae, 0x04, 0x07, 0x39, 0x20, 0xff, 0x4b, 0x69, 0xf1, 0xb8, 0xf4, 0x34, 0x73,
+	0xa6, 0xf4, 0x54, 0x3e, 0x75, 0x7a, 0xaa, 0x9c, 0x29, 0x3d, 0x55, 0xcf, 0x96, 0x9e, 0xe0, 0x98,
+	0xf4, 0xb4, 0x00, 0xa5, 0x30, 0x88, 0x82, 0x0c, 0x9f, 0x7a, 0xe0, 0xfc, 0x7e, 0x2c, 0x64, 0x6f,
+	0x41, 0xca, 0xb9, 0x01, 0x76, 0xe0, 0xeb, 0xfa, 0xb7, 0xb6, 0xda, 0x9a, 0xfa, 0xc3, 0xdf, 0x6c,
+	0x73, 0x57, 0x0a, 0x4d, 0x16, 0x09, 0xa5, 0x33, 0x17, 0x09, 0x9f, 0xc2, 0x95, 0xa3, 0x89, 0x88,
+	0x19, 0x77, 0xf8, 0xad, 0x19, 0x15, 0xd1, 0xc5, 0xc9, 0x4c, 0x94, 0xf9, 0xcb, 0x47, 0xdf, 0x81,
+	0x85, 0x91, 0x54, 0x34, 0x9c, 0x58, 0xd6, 0x17, 0x13, 0x43, 0xde, 0x70, 0xca, 0x49, 0xc9, 0xa8,
+	0x72, 0x52, 0x32, 0x72, 0xfe, 0x61, 0xc3, 0x6c, 0x9b, 0x84, 0x44, 0x90, 0xaf, 0x6b, 0xd8, 0x63,
+	0x6b, 0xd8, 0x6f, 0x03, 0x0a, 0x62, 0x71, 0xf7, 0x23, 0x2f, 0x61, 0x41, 0x84, 0xd9, 0xc0, 0xdb,
+	0x27, 0x83, 0x2c, 0xcb, 0x37, 0x15, 0x67, 0x47, 0x33, 0x1e, 0x93, 0x01, 0x7f, 0x6d, 0x4d, 0x3b,
+	0x5a, 0x44, 0xea, 0x63, 0x93, 0x17, 0x91, 0xdf, 0x85, 0xfa, 0xd8, 0x12, 0xf5, 0xd7, 0x00, 0xb6,
+	0x96, 0x0c, 0xd7, 0x75, 0xfe, 0x63, 0x41, 0x75, 0x8b, 0x62, 0x5f, 0xb5, 0x73, 0xe7, 0x0c, 0x63,
+	0x5e, 0xa9, 0x17, 0x26, 0x2b, 0xf5, 0xab, 0x30, 0xec, 0xc8, 0x4c, 0x20, 0x87, 0x84, 0xd1, 0x56,
+	0xab, 0x38, 0xde, 0x6a, 0x5d, 0x83, 0x5a, 0x20, 0x0d, 0xf2, 0x12, 0x2c, 0xfa, 0x3a, 0x53, 0x56,
+	0x5d, 0x50, 0xa4, 0x1d, 0x49, 0x91, 0xbd, 0x58, 0x26, 0xa0, 0x7a, 0xb1, 0x99, 0x53, 0xf7, 0x62,
+	0x46, 0x89, 0xea, 0xc5, 0x7e, 0x6e, 0xc9, 0x6b, 0x7e, 0x9f, 0xbc, 0x94, 0xf9, 0xe0, 0xa8, 0x52,
+	0xeb, 0x3c, 0x4a, 0x65, 0x0a, 0x57, 0x91, 0x22, 0x21, 0x16, 0xc3, 0x43, 0xc5, 0x8d, 0x73, 0x90,
+	0x8c, 0x9a, 0x66, 0x99, 0x03, 0xc5, 0x9d, 0x5f, 0x59, 0x00, 0x2a, 0x2b, 0x68, 0x33, 0x26, 0xe1,
+	0x67, 0x9d, 0xdc, 0xa5, 0x16, 0xc6, 0x5d, 0xb7, 0x96, 0xb9, 0xee, 0x84, 0x6b, 0xe0, 0x91, 0xb6,
+	0x22, 0xdb, 0xbc, 0xf1, 0xae, 0xfa, 0x76, 0x7e, 0x6d, 0x41, 0xdd, 0x58, 0xa7, 0x4d, 0x1a, 0x8b,
+	0xb2, 0x35, 0x19, 0x65, 0x55, 0x9b, 0x45, 0x94, 0x0d, 0x3c, 0x1e, 0xbc, 0x22, 0xc6, 0x20, 0xd0,
+	0xa4, 0xdd, 0xe0, 0x15, 0x19, 0x03, 0xaf, 0x3d, 0x0e, 0xde, 0x9b, 0x30, 0xcf, 0x48, 0x97, 0xc4,
+	0x22, 0x1c, 0x78, 0x11, 0xf5, 0x83, 0xbd, 0x80, 0xf8, 0x0a, 0x0d, 0x15, 0xb7, 0x99, 0x31, 0xb6,
+	0x0d, 0xdd, 0xf9, 0x99, 0x05, 0xb5, 0x6d, 0xde, 0xdb, 0xa1, 0x5c, 0x1d, 0x32, 0x74, 0x1d, 0xea,
+	0x59, 0x91, 0xa2, 0x4e, 0xb8, 0x2e, 0x0d, 0x6a, 0xdd, 0xe1, 0x55, 0xaa, 0x4c, 0xed, 0x11, 0xef,
+	0x19, 0x37, 0xd5, 0x5d, 0x3d, 0x40, 0x4b, 0x50, 0x89, 0x78, 0x4f, 0xb5, 0x12, 0x06, 0x96, 0xf9,
+	0x58, 0xee, 0x75, 0xf8, 0x0b, 0x2b, 0xaa, 0x5f, 0x58, 0x55, 0x8c, 0x5e, 0xf0, 0x23, 0x53, 0xa2,
+	0xbc, 0xd1, 0xcb, 0x8a, 0x8a, 0xf2, 0xe8, 0x75, 0x70, 0x41, 0x61, 0x7c, 0x8c, 0x36, 0x91, 0x14,
+	0xec, 0x23, 0x49, 0xe1, 0x26, 0xcc, 0xfb, 0x64, 0x0f, 0xa7, 0xa1, 0xf0, 0x26, 0x4d, 0x6e, 0x1a,
+	0xc6, 0xd8, 0xd3, 0x44, 0x63, 0x9d, 0x11, 0x9f, 0xc4, 0x22, 0xc0, 0xa1, 0x7a, 0x31, 0x5b, 0x82,
+	0x4a, 0xca, 0x09, 0x1b, 0xf1, 0x5d, 0x3e, 0x46, 0x1f, 0x00, 0x22, 0x71, 0x97, 0x0d, 0x12, 0x09,
+	0xe2, 0x04, 0x73, 0x7e, 0x48, 0x99, 0x6f, 0x12, 0xf5, 0x7c, 0xce, 0xd9, 0x31, 0x0c, 0xd9, 0x73,
+	0x0b, 0x12, 0xe3, 0x58, 0x64, 0xf9, 0x5a, 0x8f, 0x64, 0xe8, 0x03, 0xee, 0xf1, 0x34, 0x21, 0xcc,
+	0x84, 0xb5, 0x1c, 0xf0, 0x5d, 0x39, 0x94, 0xa9, 0x9c, 0xf7, 0xf1, 0xea, 0xc7, 0x77, 0x87, 0xea,
+	0x75, 0x8a, 0x6e, 0x68, 0x72, 0xa6, 0xdb, 0x79, 0x04, 0xf3, 0xf2, 0x69, 0x6c, 0x87, 0x86, 0x41,
+	0x77, 0x70, 0xee, 0x3f, 0x8e, 0xf3, 0xb9, 0x05, 0x68, 0x54, 0x8f, 0x79, 0x98, 0x19, 0x56, 0x0c,
+	0xd6, 0xe9, 0x2b, 0x86, 0xeb, 0x50, 0x4f, 0x94, 0x1a, 0xf5, 0x0c, 0x9c, 0x45, 0xaf, 0xa6, 0x69,
+	0xd2, 0xb7, 0x5c, 0xde, 0x4f, 0x49, 0x67, 0x7a, 0x8c, 0x86, 0x44, 0x07, 0xaf, 0xea, 0x56, 0x25,
+	0xc5, 0x95, 0x04, 0xa7, 0x07, 0x8b, 0xbb, 0x7d, 0x7a, 0xb8, 0x4e, 0xe3, 0xbd, 0xa0, 0x97, 0x32,
+	0x2c, 0x01, 0xfd, 0x06, 0x17, 0x7e, 0x2d, 0x28, 0x27, 0x58, 0xc8, 0x63, 0x6d, 0x62, 0x94, 0x0d,
+	0x9d, 0xdf, 0x58, 0xb0, 0x34, 0x6d, 0xa5, 0x37, 0xd9, 0xfe, 0x06, 0xcc, 0x76, 0xb5, 0x3a, 0xad,
+	0xed, 0xf4, 0x2f, 0x9f, 0xe3, 0xf3, 0x9c, 0x47, 0x50, 0x74, 0xb1, 0x20, 0xe8, 0x36, 0x14, 0x98,
+	0x50, 0x16, 0x34, 0x56, 0xaf, 0x1d, 0x93, 0xac, 0xa4, 0xa0, 0x6a, 0xe6, 0x0b, 0x4c, 0xa0, 0x3a,
+	0x58, 0x4c, 0xed, 0xd4, 0x72, 0x2d, 0x76, 0xe3, 0x3e, 0x54, 0xf3, 0xc7, 0x7a, 0xd4, 0x84, 0xba,
+	0x7c, 0xbb, 0x55, 0x85, 0x72, 0x10, 0xf7, 0x9a, 0x17, 0x50, 0x0d, 0xca, 0x3f, 0x20, 0x38, 0x14,
+	0xfd, 0x41, 0xd3, 0x42, 0x75, 0xa8, 0x3c, 0xec, 0xc4, 0x94, 0x45, 0x38, 0x6c, 0x16, 0x6e, 0xac,
+	0xc2, 0xfc, 0x91, 0xbb, 0x15, 0x29, 0xe2, 0xd2, 0x43, 0xe9, 0x5d, 0xbf, 0x79, 0x01, 0xcd, 0x41,
+	0x6d, 0x9d, 0x86, 0x69, 0x14, 0x6b, 0x82, 0x75, 0xe3, 0x0f, 0x16, 0x54, 0x32, 0x63, 0xd0, 0x3c,
+	0xcc, 0xb6, 0xdb, 0x5b, 0xc3, 0x87, 0x9a, 0xe6, 0x05, 0x69, 0x40, 0xbb, 0xbd, 0x95, 0x5f, 0xf3,
+	0xeb, 0x35, 0xdb, 0xed, 0x2d, 0x95, 0x6d, 0x9b, 0x05, 0x33, 0xfa, 0x2c, 0x4c, 0x79, 0xbf, 0x69,
+	0xe7, 0x0a, 0xa2, 0x04, 0x6b, 0x05, 0x45, 0x34, 0x0b, 0xd5, 0xf6, 0xf6, 0x96, 0xb6, 0xab, 0x59,
+	0x32, 0x43, 0x5d, 0x70, 0x35, 0x67, 0xa4, 0x3d, 0xed, 0xed, 0xad, 0xb5, 0x34, 0xdc, 0x97, 0x3f,
+	0xee, 0x66, 0x59, 0xf1, 0x9f, 0x6e, 0xe9, 0xce, 0xab, 0x59, 0x51, 0xea, 0x9f, 0x6e, 0xc9, 0xb6,
+	0x77, 0xd0, 0xac, 0xae, 0xdd, 0xfb, 0xc9, 0xc7, 0xbd, 0x40, 0xf4, 0xd3, 0x8e, 0x0c, 0xc7, 0x6d,
+	0xed, 0xd9, 0x0f, 0x02, 0x6a, 0xbe, 0x6e, 0x67, 0xde, 0xbd, 0xad, 0x9c, 0x9d, 0x0f, 0x93, 0x4e,
+	0x67, 0x46, 0x51, 0x3e, 0xfc, 0xef, 0x00, 0xed, 0x8b, 0xea, 0xdd, 0xb3, 0x21, 0x00, 0x00,
 }
@@ -302,10 +302,16 @@ type CreateCollectionRequest struct {
 	// https://github.com/milvus-io/milvus/issues/6690
 	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The number of partitions the rows are hashed into by the partition key field, only valid when the
+	// partition key is declared in the schema. (Optional)
+	NumPartitions int64 `protobuf:"varint,7,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
+	// Succeed without creating if the collection exists with the same schema, the field order aside,
+	// fail with SchemaMismatch if it exists with a different schema. (Optional)
+	IfNotExists          bool     `protobuf:"varint,8,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
@@ -375,6 +381,20 @@ func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLeve
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *CreateCollectionRequest) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
+func (m *CreateCollectionRequest) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
 //*
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
@@ -383,7 +403,9 @@ type DropCollectionRequest struct {
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The unique collection name in milvus.(Required)
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// Succeed if the collection does not exist, which dropping a collection always does for compatibility. (Optional)
+	IfExists             bool     `protobuf:"varint,4,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -435,6 +457,91 @@ func (m *DropCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *DropCollectionRequest) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
+//*
+// Alter the properties of a collection in milvus
+type AlterCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// Not useful for now
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// The properties to set, the existing properties with other keys are kept
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{5}
+}
+
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
+}
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
+}
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
+}
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
+
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 //*
 // Check collection exist in milvus or not.
 type HasCollectionRequest struct {
@@ -455,7 +562,7 @@ func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
 func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasCollectionRequest) ProtoMessage()    {}
 func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{5}
+	return fileDescriptor_02345ba45cc0e303, []int{6}
 }
 
 func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -516,7 +623,7 @@ func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
 func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
 func (*BoolResponse) ProtoMessage()    {}
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
 func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
@@ -563,7 +670,7 @@ func (m *StringResponse) Reset()         { *m = StringResponse{} }
 func (m *StringResponse) String() string { return proto.CompactTextString(m) }
 func (*StringResponse) ProtoMessage()    {}
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
 
 func (m *StringResponse) XXX_Unmarshal(b []byte) error {
@@ -620,7 +727,7 @@ func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionReq
 func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionRequest) ProtoMessage()    {}
 func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+	return fileDescriptor_02345ba45cc0e303, []int{9}
 }
 
 func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -702,17 +809,19 @@ type DescribeCollectionResponse struct {
 	// The consistency level that the collection used, modification is not supported now.
 	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	// The collection name
-	CollectionName       string   `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CollectionName string `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The properties of the collection set by AlterCollection
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
 func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionResponse) ProtoMessage()    {}
 func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+	return fileDescriptor_02345ba45cc0e303, []int{10}
 }
 
 func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
@@ -817,6 +926,13 @@ func (m *DescribeCollectionResponse) GetCollectionName() string {
 	return ""
 }
 
+func (m *DescribeCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 //*
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
@@ -837,7 +953,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+	return fileDescriptor_02345ba45cc0e303, []int{11}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -904,7 +1020,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -959,7 +1075,11 @@ type GetStatisticsRequest struct {
 	// The partition names you want get statistics, empty for all partitions
 	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	// Not useful for now, reserved for future
-	GuaranteeTimestamp   uint64   `protobuf:"varint,5,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	GuaranteeTimestamp uint64 `protobuf:"varint,5,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// The segments you want get statistics, empty for all segments. They must belong to the collection and the partitions
+	SegmentIDs []int64 `protobuf:"varint,6,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// Return the statistics of each partition in partition_stats, it can't be used with segmentIDs
+	WithPartitionStats   bool     `protobuf:"varint,7,opt,name=with_partition_stats,json=withPartitionStats,proto3" json:"with_partition_stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -969,7 +1089,7 @@ func (m *GetStatisticsRequest) Reset()         { *m = GetStatisticsRequest{} }
 func (m *GetStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatisticsRequest) ProtoMessage()    {}
 func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
 func (m *GetStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1025,24 +1145,42 @@ func (m *GetStatisticsRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *GetStatisticsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetStatisticsRequest) GetWithPartitionStats() bool {
+	if m != nil {
+		return m.WithPartitionStats
+	}
+	return false
+}
+
 //*
 // Will return statistics in stats field like [{key:"row_count",value:"1"}]
+// The row_count is the sum of in_memory_row_count, which is counted by the query nodes for the loaded partitions
+// including their growing segments, and flushed_row_count, which is counted by data coord for the unloaded partitions.
 // WARNING: This API is experimental and not useful for now.
 type GetStatisticsResponse struct {
 	// Contain error_code and reason
 	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	// Collection statistics data
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Stats []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Statistics data of each partition, only returned if with_partition_stats is set
+	PartitionStats       []*PartitionStatistics `protobuf:"bytes,3,rep,name=partition_stats,json=partitionStats,proto3" json:"partition_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *GetStatisticsResponse) Reset()         { *m = GetStatisticsResponse{} }
 func (m *GetStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatisticsResponse) ProtoMessage()    {}
 func (*GetStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
 func (m *GetStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1077,6 +1215,68 @@ func (m *GetStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *GetStatisticsResponse) GetPartitionStats() []*PartitionStatistics {
+	if m != nil {
+		return m.PartitionStats
+	}
+	return nil
+}
+
+type PartitionStatistics struct {
+	PartitionName        string                   `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionID          int64                    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PartitionStatistics) Reset()         { *m = PartitionStatistics{} }
+func (m *PartitionStatistics) String() string { return proto.CompactTextString(m) }
+func (*PartitionStatistics) ProtoMessage()    {}
+func (*PartitionStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{15}
+}
+
+func (m *PartitionStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionStatistics.Unmarshal(m, b)
+}
+func (m *PartitionStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionStatistics.Marshal(b, m, deterministic)
+}
+func (m *PartitionStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStatistics.Merge(m, src)
+}
+func (m *PartitionStatistics) XXX_Size() int {
+	return xxx_messageInfo_PartitionStatistics.Size(m)
+}
+func (m *PartitionStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStatistics proto.InternalMessageInfo
+
+func (m *PartitionStatistics) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *PartitionStatistics) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionStatistics) GetStats() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 //*
 // Get collection statistics like row_count.
 type GetCollectionStatisticsRequest struct {
@@ -1095,7 +1295,7 @@ func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionSta
 func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
 func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
 func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1353,7 @@ func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionSt
 func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
 func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
 func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1210,7 +1410,7 @@ func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{}
 func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsRequest) ProtoMessage()    {}
 func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
 func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1292,7 +1492,7 @@ func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse
 func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsResponse) ProtoMessage()    {}
 func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
 func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1382,7 +1582,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1451,7 +1651,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1501,145 +1701,289 @@ func (m *DropPartitionRequest) GetPartitionName() string {
 }
 
 //
-// Check if partition exist in collection or not.
-type HasPartitionRequest struct {
+// Delete all entities of a partition while keeping the partition.
+type DropPartitionDataRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to check
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The partition name you want to wipe
+	PartitionName string `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// Must be true, to avoid wiping a partition by accident
+	Confirm bool `protobuf:"varint,5,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	// Must be true to wipe the default partition
+	Force                bool     `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+func (m *DropPartitionDataRequest) Reset()         { *m = DropPartitionDataRequest{} }
+func (m *DropPartitionDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionDataRequest) ProtoMessage()    {}
+func (*DropPartitionDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *DropPartitionDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionDataRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *DropPartitionDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionDataRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *DropPartitionDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionDataRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *DropPartitionDataRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionDataRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *DropPartitionDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropPartitionDataRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropPartitionDataRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *DropPartitionDataRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
+func (m *DropPartitionDataRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetPartitionName() string {
+func (m *DropPartitionDataRequest) GetPartitionName() string {
 	if m != nil {
 		return m.PartitionName
 	}
 	return ""
 }
 
-//
-// Load specific partitions data of one collection into query nodes
-// Then you can get these data as result when you do vector search on this collection.
-type LoadPartitionsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to load
-	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// The replicas number you would load, 1 by default
-	ReplicaNumber        int32    `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+func (m *DropPartitionDataRequest) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
+}
+
+func (m *DropPartitionDataRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DropPartitionDataResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The number of deleted entities
+	DeleteCnt int64 `protobuf:"varint,2,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
+	// The deleted entities are invisible to the search and query requests guaranteed after this timestamp
+	Timestamp            uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+func (m *DropPartitionDataResponse) Reset()         { *m = DropPartitionDataResponse{} }
+func (m *DropPartitionDataResponse) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionDataResponse) ProtoMessage()    {}
+func (*DropPartitionDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *DropPartitionDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionDataResponse.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *DropPartitionDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionDataResponse.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *DropPartitionDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionDataResponse.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *DropPartitionDataResponse) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionDataResponse.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *DropPartitionDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropPartitionDataResponse proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *DropPartitionDataResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+func (m *DropPartitionDataResponse) GetDeleteCnt() int64 {
 	if m != nil {
-		return m.DbName
+		return m.DeleteCnt
 	}
-	return ""
+	return 0
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+func (m *DropPartitionDataResponse) GetTimestamp() uint64 {
 	if m != nil {
-		return m.CollectionName
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
+//
+// Check if partition exist in collection or not.
+type HasPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to check
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
+}
+
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+}
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+}
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
+}
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HasPartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+//
+// Load specific partitions data of one collection into query nodes
+// Then you can get these data as result when you do vector search on this collection.
+type LoadPartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition names you want to load
+	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// The replicas number you would load, 1 by default
+	ReplicaNumber        int32    `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
+}
+
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+}
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+}
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+}
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
 
 func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
 	if m != nil {
@@ -1669,7 +2013,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +2082,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1799,7 +2143,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1848,7 +2192,9 @@ type ShowPartitionsRequest struct {
 	// When type is InMemory, will return these patitions's inMemory_percentages.(Optional)
 	PartitionNames []string `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	// Decide return Loaded partitions or All partitions(Optional)
-	Type                 ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	Type ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// Return the row counts of the partitions in num_rows(Optional), it costs a call to datacoord
+	WithRowCounts        bool     `protobuf:"varint,7,opt,name=with_row_counts,json=withRowCounts,proto3" json:"with_row_counts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1858,7 +2204,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1921,6 +2267,13 @@ func (m *ShowPartitionsRequest) GetType() ShowType {
 	return ShowType_All
 }
 
+func (m *ShowPartitionsRequest) GetWithRowCounts() bool {
+	if m != nil {
+		return m.WithRowCounts
+	}
+	return false
+}
+
 //
 // List all partitions for particular collection response.
 // The returned datas are all rows, we can format to columns by therir index.
@@ -1936,7 +2289,9 @@ type ShowPartitionsResponse struct {
 	// All utc timestamps calculated by created_timestamps
 	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
 	// Load percentage on querynode
-	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	InMemoryPercentages []int64 `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	// Row counts of the partitions, set only if with_row_counts is true
+	NumRows              []int64  `protobuf:"varint,7,rep,packed,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1946,7 +2301,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -2009,6 +2364,13 @@ func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
+func (m *ShowPartitionsResponse) GetNumRows() []int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return nil
+}
+
 type DescribeSegmentRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -2022,7 +2384,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +2441,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2510,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2202,7 +2564,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2251,7 +2613,9 @@ type CreateIndexRequest struct {
 	// Support keys: index_type,metric_type, params. Different index_type may has different params.
 	ExtraParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
 	// Version before 2.0.2 doesn't contain index_name, we use default index name.
-	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexName string `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// Block the inserts and deletes of the collection until the index is built, or the block times out.
+	BlockWrites          bool     `protobuf:"varint,7,opt,name=block_writes,json=blockWrites,proto3" json:"block_writes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2261,7 +2625,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2324,6 +2688,13 @@ func (m *CreateIndexRequest) GetIndexName() string {
 	return ""
 }
 
+func (m *CreateIndexRequest) GetBlockWrites() bool {
+	if m != nil {
+		return m.BlockWrites
+	}
+	return false
+}
+
 //
 // Get created index information.
 // Current release of Milvus only supports showing latest built index.
@@ -2337,7 +2708,9 @@ type DescribeIndexRequest struct {
 	// The vector field name in this particular collection
 	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
 	// No need to set up for now @2021.06.30
-	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexName string `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// Skip getting the state and build progress of indexes, only the index params are returned
+	SkipIndexProgress    bool     `protobuf:"varint,6,opt,name=skip_index_progress,json=skipIndexProgress,proto3" json:"skip_index_progress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2347,7 +2720,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2403,6 +2776,13 @@ func (m *DescribeIndexRequest) GetIndexName() string {
 	return ""
 }
 
+func (m *DescribeIndexRequest) GetSkipIndexProgress() bool {
+	if m != nil {
+		return m.SkipIndexProgress
+	}
+	return false
+}
+
 //
 // Index informations
 type IndexDescription struct {
@@ -2413,7 +2793,15 @@ type IndexDescription struct {
 	// Will return index_type, metric_type, params(like nlist).
 	Params []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
 	// The vector field name
-	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// The state of the index
+	State commonpb.IndexState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	// The number of rows indexed
+	IndexedRows int64 `protobuf:"varint,6,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	// The total number of rows
+	TotalRows int64 `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// The reason why the index failed to build
+	IndexStateFailReason string   `protobuf:"bytes,8,opt,name=index_state_fail_reason,json=indexStateFailReason,proto3" json:"index_state_fail_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2423,7 +2811,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -2472,6 +2860,34 @@ func (m *IndexDescription) GetFieldName() string {
 	return ""
 }
 
+func (m *IndexDescription) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexDescription) GetIndexedRows() int64 {
+	if m != nil {
+		return m.IndexedRows
+	}
+	return 0
+}
+
+func (m *IndexDescription) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *IndexDescription) GetIndexStateFailReason() string {
+	if m != nil {
+		return m.IndexStateFailReason
+	}
+	return ""
+}
+
 //
 // Describe index response
 type DescribeIndexResponse struct {
@@ -2488,7 +2904,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -2545,7 +2961,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2614,7 +3030,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2671,7 +3087,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2740,7 +3156,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2797,7 +3213,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2854,23 +3270,26 @@ func (m *DropIndexRequest) GetIndexName() string {
 }
 
 type InsertRequest struct {
-	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Base           *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData     []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys       []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows        uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// retries of the insert with the same dedup_token get the result of the first successful attempt,
+	// only the retries sent to the same proxy within a while are deduplicated
+	DedupToken           string   `protobuf:"bytes,8,opt,name=dedup_token,json=dedupToken,proto3" json:"dedup_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2940,6 +3359,13 @@ func (m *InsertRequest) GetNumRows() uint32 {
 	return 0
 }
 
+func (m *InsertRequest) GetDedupToken() string {
+	if m != nil {
+		return m.DedupToken
+	}
+	return ""
+}
+
 type MutationResult struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
@@ -2959,7 +3385,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -3044,22 +3470,24 @@ func (m *MutationResult) GetTimestamp() uint64 {
 }
 
 type DeleteRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	HashKeys             []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr           string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	HashKeys       []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	// The primary keys to delete, used instead of expr to avoid building large expressions
+	PrimaryKeys          *schemapb.IDs `protobuf:"bytes,7,opt,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -3122,30 +3550,42 @@ func (m *DeleteRequest) GetHashKeys() []uint32 {
 	return nil
 }
 
-type SearchRequest struct {
-	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+func (m *DeleteRequest) GetPrimaryKeys() *schemapb.IDs {
+	if m != nil {
+		return m.PrimaryKeys
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	Nq                   int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup   []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields       []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams       []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp    uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
+	// values of the placeholders in dsl, e.g. {"ids": [1, 2, 3]} for `id in {ids}`
+	ExprTemplateValues map[string]*schemapb.TemplateValue `protobuf:"bytes,13,rep,name=expr_template_values,json=exprTemplateValues,proto3" json:"expr_template_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ignore the guarantee_timestamp and use the consistency level of the collection,
+	// or the default consistency level of proxy if the collection is of Customized level
+	UseDefaultConsistency bool     `protobuf:"varint,14,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -3250,6 +3690,20 @@ func (m *SearchRequest) GetNq() int64 {
 	return 0
 }
 
+func (m *SearchRequest) GetExprTemplateValues() map[string]*schemapb.TemplateValue {
+	if m != nil {
+		return m.ExprTemplateValues
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
 type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
@@ -3263,7 +3717,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -3306,19 +3760,27 @@ func (m *Hits) GetScores() []float32 {
 }
 
 type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	CollectionName       string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Status         *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results        *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	CollectionName string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// JSON breakdown of where the time of the search went, set only if asked by the return_execution_info search param
+	ExecutionInfo string `protobuf:"bytes,4,opt,name=execution_info,json=executionInfo,proto3" json:"execution_info,omitempty"`
+	// the timestamps the search is served at, set only if asked by the consistency_check search param
+	ConsistencyInfo *SearchConsistencyInfo `protobuf:"bytes,5,opt,name=consistency_info,json=consistencyInfo,proto3" json:"consistency_info,omitempty"`
+	// the continuation token of the next batch, set only if asked by the search_iterator search param
+	SearchIteratorToken string `protobuf:"bytes,6,opt,name=search_iterator_token,json=searchIteratorToken,proto3" json:"search_iterator_token,omitempty"`
+	// whether the vector field has no index and is searched by brute force, see proxy.searchWithoutIndex
+	BruteForce           bool     `protobuf:"varint,7,opt,name=brute_force,json=bruteForce,proto3" json:"brute_force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -3360,10 +3822,144 @@ func (m *SearchResults) GetCollectionName() string {
 	return ""
 }
 
+func (m *SearchResults) GetExecutionInfo() string {
+	if m != nil {
+		return m.ExecutionInfo
+	}
+	return ""
+}
+
+func (m *SearchResults) GetConsistencyInfo() *SearchConsistencyInfo {
+	if m != nil {
+		return m.ConsistencyInfo
+	}
+	return nil
+}
+
+func (m *SearchResults) GetSearchIteratorToken() string {
+	if m != nil {
+		return m.SearchIteratorToken
+	}
+	return ""
+}
+
+func (m *SearchResults) GetBruteForce() bool {
+	if m != nil {
+		return m.BruteForce
+	}
+	return false
+}
+
+// SearchConsistencyInfo tells whether a write should be visible to a search, the write is visible if its timestamp
+// isn't after the guarantee timestamp, and the serviceable timestamp of the shard of the write has reached it.
+type SearchConsistencyInfo struct {
+	GuaranteeTimestamp   uint64                  `protobuf:"varint,1,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Shards               []*ShardConsistencyInfo `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SearchConsistencyInfo) Reset()         { *m = SearchConsistencyInfo{} }
+func (m *SearchConsistencyInfo) String() string { return proto.CompactTextString(m) }
+func (*SearchConsistencyInfo) ProtoMessage()    {}
+func (*SearchConsistencyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{50}
+}
+
+func (m *SearchConsistencyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchConsistencyInfo.Unmarshal(m, b)
+}
+func (m *SearchConsistencyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchConsistencyInfo.Marshal(b, m, deterministic)
+}
+func (m *SearchConsistencyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchConsistencyInfo.Merge(m, src)
+}
+func (m *SearchConsistencyInfo) XXX_Size() int {
+	return xxx_messageInfo_SearchConsistencyInfo.Size(m)
+}
+func (m *SearchConsistencyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchConsistencyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchConsistencyInfo proto.InternalMessageInfo
+
+func (m *SearchConsistencyInfo) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *SearchConsistencyInfo) GetShards() []*ShardConsistencyInfo {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type ShardConsistencyInfo struct {
+	ChannelName string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeID      int64  `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// the tsafe of the dml channel, the max timestamp of the data consumed by the shard leader
+	ServiceableTimestamp uint64   `protobuf:"varint,3,opt,name=serviceable_timestamp,json=serviceableTimestamp,proto3" json:"serviceable_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardConsistencyInfo) Reset()         { *m = ShardConsistencyInfo{} }
+func (m *ShardConsistencyInfo) String() string { return proto.CompactTextString(m) }
+func (*ShardConsistencyInfo) ProtoMessage()    {}
+func (*ShardConsistencyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{51}
+}
+
+func (m *ShardConsistencyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardConsistencyInfo.Unmarshal(m, b)
+}
+func (m *ShardConsistencyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardConsistencyInfo.Marshal(b, m, deterministic)
+}
+func (m *ShardConsistencyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardConsistencyInfo.Merge(m, src)
+}
+func (m *ShardConsistencyInfo) XXX_Size() int {
+	return xxx_messageInfo_ShardConsistencyInfo.Size(m)
+}
+func (m *ShardConsistencyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardConsistencyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardConsistencyInfo proto.InternalMessageInfo
+
+func (m *ShardConsistencyInfo) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardConsistencyInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ShardConsistencyInfo) GetServiceableTimestamp() uint64 {
+	if m != nil {
+		return m.ServiceableTimestamp
+	}
+	return 0
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -3373,7 +3969,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -3415,6 +4011,13 @@ func (m *FlushRequest) GetCollectionNames() []string {
 	return nil
 }
 
+func (m *FlushRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
 type FlushResponse struct {
 	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -3430,7 +4033,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3487,25 +4090,30 @@ func (m *FlushResponse) GetCollSealTimes() map[string]int64 {
 }
 
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string                   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string                 `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	QueryParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Base               *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName             string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName     string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr               string                   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields       []string                 `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames     []string                 `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp    uint64                   `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	QueryParams        []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	// values of the placeholders in expr, e.g. {"ids": [1, 2, 3]} for `id in {ids}`
+	ExprTemplateValues map[string]*schemapb.TemplateValue `protobuf:"bytes,10,rep,name=expr_template_values,json=exprTemplateValues,proto3" json:"expr_template_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ignore the guarantee_timestamp and use the consistency level of the collection,
+	// or the default consistency level of proxy if the collection is of Customized level
+	UseDefaultConsistency bool     `protobuf:"varint,11,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3589,20 +4197,36 @@ func (m *QueryRequest) GetQueryParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *QueryRequest) GetExprTemplateValues() map[string]*schemapb.TemplateValue {
+	if m != nil {
+		return m.ExprTemplateValues
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
 type QueryResults struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Status         *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData     []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	CollectionName string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the cursor of the next page, set only if asked by the query_iterator query param and there may be more rows
+	QueryIteratorCursor  string   `protobuf:"bytes,4,opt,name=query_iterator_cursor,json=queryIteratorCursor,proto3" json:"query_iterator_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3644,6 +4268,13 @@ func (m *QueryResults) GetCollectionName() string {
 	return ""
 }
 
+func (m *QueryResults) GetQueryIteratorCursor() string {
+	if m != nil {
+		return m.QueryIteratorCursor
+	}
+	return ""
+}
+
 type VectorIDs struct {
 	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
@@ -3658,7 +4289,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3721,7 +4352,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3801,7 +4432,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3867,7 +4498,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3955,7 +4586,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4024,7 +4655,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4078,7 +4709,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4134,7 +4765,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4238,7 +4869,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4292,7 +4923,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4338,7 +4969,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -4377,7 +5008,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -4415,7 +5046,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -4448,7 +5079,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -4495,7 +5126,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -4543,7 +5174,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4593,6 +5224,7 @@ type LoadBalanceRequest struct {
 	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
 	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
 	CollectionName       string            `protobuf:"bytes,5,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	DryRun               bool              `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -4602,7 +5234,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4658,6 +5290,13 @@ func (m *LoadBalanceRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *LoadBalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type ManualCompactionRequest struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
@@ -4670,7 +5309,7 @@ func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest
 func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionRequest) ProtoMessage()    {}
 func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
@@ -4717,7 +5356,7 @@ func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionRespon
 func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionResponse) ProtoMessage()    {}
 func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
@@ -4763,7 +5402,7 @@ func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateReq
 func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateRequest) ProtoMessage()    {}
 func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4807,7 +5446,7 @@ func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateRe
 func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateResponse) ProtoMessage()    {}
 func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -4881,7 +5520,7 @@ func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansReq
 func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansRequest) ProtoMessage()    {}
 func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
@@ -4922,7 +5561,7 @@ func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansRe
 func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansResponse) ProtoMessage()    {}
 func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
@@ -4976,7 +5615,7 @@ func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
 func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
 func (*CompactionMergeInfo) ProtoMessage()    {}
 func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
@@ -5022,7 +5661,7 @@ func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
 func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushStateRequest) ProtoMessage()    {}
 func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5062,7 +5701,7 @@ func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
 func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushStateResponse) ProtoMessage()    {}
 func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5104,6 +5743,7 @@ type ImportRequest struct {
 	RowBased             bool                     `protobuf:"varint,4,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
 	Files                []string                 `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
 	Options              []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	DbName               string                   `protobuf:"bytes,7,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -5113,7 +5753,7 @@ func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
 func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()    {}
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{76}
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
 func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
@@ -5176,6 +5816,13 @@ func (m *ImportRequest) GetOptions() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *ImportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type ImportResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Tasks                []int64          `protobuf:"varint,2,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
@@ -5188,7 +5835,7 @@ func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
 func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()    {}
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{77}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
@@ -5234,7 +5881,7 @@ func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
 func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetImportStateRequest) ProtoMessage()    {}
 func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{78}
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
 func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5280,7 +5927,7 @@ func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{}
 func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetImportStateResponse) ProtoMessage()    {}
 func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{79}
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
 func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5358,6 +6005,9 @@ func (m *GetImportStateResponse) GetDataIndexed() bool {
 }
 
 type ListImportTasksRequest struct {
+	CollectionName       string   `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbName               string   `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5367,7 +6017,7 @@ func (m *ListImportTasksRequest) Reset()         { *m = ListImportTasksRequest{}
 func (m *ListImportTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListImportTasksRequest) ProtoMessage()    {}
 func (*ListImportTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{80}
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
 func (m *ListImportTasksRequest) XXX_Unmarshal(b []byte) error {
@@ -5388,6 +6038,27 @@ func (m *ListImportTasksRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListImportTasksRequest proto.InternalMessageInfo
 
+func (m *ListImportTasksRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ListImportTasksRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ListImportTasksRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type ListImportTasksResponse struct {
 	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Tasks                []*GetImportStateResponse `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -5400,7 +6071,7 @@ func (m *ListImportTasksResponse) Reset()         { *m = ListImportTasksResponse
 func (m *ListImportTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*ListImportTasksResponse) ProtoMessage()    {}
 func (*ListImportTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{81}
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
 func (m *ListImportTasksResponse) XXX_Unmarshal(b []byte) error {
@@ -5439,6 +6110,7 @@ type GetReplicasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	WithShardNodes       bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
+	CollectionName       string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -5448,7 +6120,7 @@ func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
 func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasRequest) ProtoMessage()    {}
 func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{82}
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
 func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
@@ -5490,6 +6162,13 @@ func (m *GetReplicasRequest) GetWithShardNodes() bool {
 	return false
 }
 
+func (m *GetReplicasRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
 type GetReplicasResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
@@ -5502,7 +6181,7 @@ func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
 func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasResponse) ProtoMessage()    {}
 func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{83}
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
 func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
@@ -5552,7 +6231,7 @@ func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
 func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaInfo) ProtoMessage()    {}
 func (*ReplicaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{84}
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
 func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
@@ -5624,7 +6303,7 @@ func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
 func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
 func (*ShardReplica) ProtoMessage()    {}
 func (*ShardReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{85}
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
 func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
@@ -5673,6 +6352,285 @@ func (m *ShardReplica) GetNodeIds() []int64 {
 	return nil
 }
 
+type AllocTimestampRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AllocTimestampRequest) Reset()         { *m = AllocTimestampRequest{} }
+func (m *AllocTimestampRequest) String() string { return proto.CompactTextString(m) }
+func (*AllocTimestampRequest) ProtoMessage()    {}
+func (*AllocTimestampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *AllocTimestampRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocTimestampRequest.Unmarshal(m, b)
+}
+func (m *AllocTimestampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocTimestampRequest.Marshal(b, m, deterministic)
+}
+func (m *AllocTimestampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocTimestampRequest.Merge(m, src)
+}
+func (m *AllocTimestampRequest) XXX_Size() int {
+	return xxx_messageInfo_AllocTimestampRequest.Size(m)
+}
+func (m *AllocTimestampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocTimestampRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocTimestampRequest proto.InternalMessageInfo
+
+func (m *AllocTimestampRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type AllocTimestampResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// a timestamp allocated from the TSO, it can be used as the travel_timestamp or
+	// guarantee_timestamp of the later search and query requests
+	Timestamp            uint64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllocTimestampResponse) Reset()         { *m = AllocTimestampResponse{} }
+func (m *AllocTimestampResponse) String() string { return proto.CompactTextString(m) }
+func (*AllocTimestampResponse) ProtoMessage()    {}
+func (*AllocTimestampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *AllocTimestampResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocTimestampResponse.Unmarshal(m, b)
+}
+func (m *AllocTimestampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocTimestampResponse.Marshal(b, m, deterministic)
+}
+func (m *AllocTimestampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocTimestampResponse.Merge(m, src)
+}
+func (m *AllocTimestampResponse) XXX_Size() int {
+	return xxx_messageInfo_AllocTimestampResponse.Size(m)
+}
+func (m *AllocTimestampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocTimestampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocTimestampResponse proto.InternalMessageInfo
+
+func (m *AllocTimestampResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AllocTimestampResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type CheckHealthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckHealthRequest) Reset()         { *m = CheckHealthRequest{} }
+func (m *CheckHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckHealthRequest) ProtoMessage()    {}
+func (*CheckHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *CheckHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckHealthRequest.Unmarshal(m, b)
+}
+func (m *CheckHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckHealthRequest.Merge(m, src)
+}
+func (m *CheckHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckHealthRequest.Size(m)
+}
+func (m *CheckHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckHealthRequest proto.InternalMessageInfo
+
+type CheckHealthResponse struct {
+	Status    *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsHealthy bool             `protobuf:"varint,2,opt,name=isHealthy,proto3" json:"isHealthy,omitempty"`
+	// the reasons why the proxy is not healthy, empty if it's healthy
+	Reasons              []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckHealthResponse) Reset()         { *m = CheckHealthResponse{} }
+func (m *CheckHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckHealthResponse) ProtoMessage()    {}
+func (*CheckHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *CheckHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckHealthResponse.Unmarshal(m, b)
+}
+func (m *CheckHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckHealthResponse.Merge(m, src)
+}
+func (m *CheckHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckHealthResponse.Size(m)
+}
+func (m *CheckHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckHealthResponse proto.InternalMessageInfo
+
+func (m *CheckHealthResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CheckHealthResponse) GetIsHealthy() bool {
+	if m != nil {
+		return m.IsHealthy
+	}
+	return false
+}
+
+func (m *CheckHealthResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type GetVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionRequest) Reset()         { *m = GetVersionRequest{} }
+func (m *GetVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionRequest) ProtoMessage()    {}
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *GetVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionRequest.Unmarshal(m, b)
+}
+func (m *GetVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionRequest.Merge(m, src)
+}
+func (m *GetVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionRequest.Size(m)
+}
+func (m *GetVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionRequest proto.InternalMessageInfo
+
+type GetVersionResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the semantic version of milvus
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit string `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	BuildTime string `protobuf:"bytes,4,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`
+	// the features supported by the cluster, e.g. auth, search_iterator and search_without_index
+	Features             []string `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionResponse) Reset()         { *m = GetVersionResponse{} }
+func (m *GetVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVersionResponse) ProtoMessage()    {}
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *GetVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionResponse.Unmarshal(m, b)
+}
+func (m *GetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionResponse.Merge(m, src)
+}
+func (m *GetVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVersionResponse.Size(m)
+}
+func (m *GetVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionResponse proto.InternalMessageInfo
+
+func (m *GetVersionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetVersionResponse) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
+	}
+	return ""
+}
+
+func (m *GetVersionResponse) GetBuildTime() string {
+	if m != nil {
+		return m.BuildTime
+	}
+	return ""
+}
+
+func (m *GetVersionResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 type CreateCredentialRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -5693,7 +6651,7 @@ func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest
 func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCredentialRequest) ProtoMessage()    {}
 func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{86}
+	return fileDescriptor_02345ba45cc0e303, []int{98}
 }
 
 func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5771,7 +6729,7 @@ func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest
 func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateCredentialRequest) ProtoMessage()    {}
 func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{87}
+	return fileDescriptor_02345ba45cc0e303, []int{99}
 }
 
 func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5848,7 +6806,7 @@ func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest
 func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteCredentialRequest) ProtoMessage()    {}
 func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{88}
+	return fileDescriptor_02345ba45cc0e303, []int{100}
 }
 
 func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5897,7 +6855,7 @@ func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
 func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersResponse) ProtoMessage()    {}
 func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{89}
+	return fileDescriptor_02345ba45cc0e303, []int{101}
 }
 
 func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -5944,7 +6902,7 @@ func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
 func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersRequest) ProtoMessage()    {}
 func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{90}
+	return fileDescriptor_02345ba45cc0e303, []int{102}
 }
 
 func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -5984,7 +6942,7 @@ func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
 func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
 func (*RoleEntity) ProtoMessage()    {}
 func (*RoleEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{91}
+	return fileDescriptor_02345ba45cc0e303, []int{103}
 }
 
 func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
@@ -6023,7 +6981,7 @@ func (m *UserEntity) Reset()         { *m = UserEntity{} }
 func (m *UserEntity) String() string { return proto.CompactTextString(m) }
 func (*UserEntity) ProtoMessage()    {}
 func (*UserEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{92}
+	return fileDescriptor_02345ba45cc0e303, []int{104}
 }
 
 func (m *UserEntity) XXX_Unmarshal(b []byte) error {
@@ -6065,7 +7023,7 @@ func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
 func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleRequest) ProtoMessage()    {}
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{93}
+	return fileDescriptor_02345ba45cc0e303, []int{105}
 }
 
 func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6114,7 +7072,7 @@ func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
 func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*DropRoleRequest) ProtoMessage()    {}
 func (*DropRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{94}
+	return fileDescriptor_02345ba45cc0e303, []int{106}
 }
 
 func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6167,7 +7125,7 @@ func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{}
 func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateUserRoleRequest) ProtoMessage()    {}
 func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{95}
+	return fileDescriptor_02345ba45cc0e303, []int{107}
 }
 
 func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6232,7 +7190,7 @@ func (m *SelectRoleRequest) Reset()         { *m = SelectRoleRequest{} }
 func (m *SelectRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleRequest) ProtoMessage()    {}
 func (*SelectRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{96}
+	return fileDescriptor_02345ba45cc0e303, []int{108}
 }
 
 func (m *SelectRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6286,7 +7244,7 @@ func (m *RoleResult) Reset()         { *m = RoleResult{} }
 func (m *RoleResult) String() string { return proto.CompactTextString(m) }
 func (*RoleResult) ProtoMessage()    {}
 func (*RoleResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{97}
+	return fileDescriptor_02345ba45cc0e303, []int{109}
 }
 
 func (m *RoleResult) XXX_Unmarshal(b []byte) error {
@@ -6335,7 +7293,7 @@ func (m *SelectRoleResponse) Reset()         { *m = SelectRoleResponse{} }
 func (m *SelectRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleResponse) ProtoMessage()    {}
 func (*SelectRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{98}
+	return fileDescriptor_02345ba45cc0e303, []int{110}
 }
 
 func (m *SelectRoleResponse) XXX_Unmarshal(b []byte) error {
@@ -6386,7 +7344,7 @@ func (m *SelectUserRequest) Reset()         { *m = SelectUserRequest{} }
 func (m *SelectUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectUserRequest) ProtoMessage()    {}
 func (*SelectUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{99}
+	return fileDescriptor_02345ba45cc0e303, []int{111}
 }
 
 func (m *SelectUserRequest) XXX_Unmarshal(b []byte) error {
@@ -6440,7 +7398,7 @@ func (m *UserResult) Reset()         { *m = UserResult{} }
 func (m *UserResult) String() string { return proto.CompactTextString(m) }
 func (*UserResult) ProtoMessage()    {}
 func (*UserResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{100}
+	return fileDescriptor_02345ba45cc0e303, []int{112}
 }
 
 func (m *UserResult) XXX_Unmarshal(b []byte) error {
@@ -6489,7 +7447,7 @@ func (m *SelectUserResponse) Reset()         { *m = SelectUserResponse{} }
 func (m *SelectUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectUserResponse) ProtoMessage()    {}
 func (*SelectUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{101}
+	return fileDescriptor_02345ba45cc0e303, []int{113}
 }
 
 func (m *SelectUserResponse) XXX_Unmarshal(b []byte) error {
@@ -6535,7 +7493,7 @@ func (m *ObjectEntity) Reset()         { *m = ObjectEntity{} }
 func (m *ObjectEntity) String() string { return proto.CompactTextString(m) }
 func (*ObjectEntity) ProtoMessage()    {}
 func (*ObjectEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{102}
+	return fileDescriptor_02345ba45cc0e303, []int{114}
 }
 
 func (m *ObjectEntity) XXX_Unmarshal(b []byte) error {
@@ -6574,7 +7532,7 @@ func (m *PrivilegeEntity) Reset()         { *m = PrivilegeEntity{} }
 func (m *PrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*PrivilegeEntity) ProtoMessage()    {}
 func (*PrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{103}
+	return fileDescriptor_02345ba45cc0e303, []int{115}
 }
 
 func (m *PrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6614,7 +7572,7 @@ func (m *GrantorEntity) Reset()         { *m = GrantorEntity{} }
 func (m *GrantorEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantorEntity) ProtoMessage()    {}
 func (*GrantorEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{104}
+	return fileDescriptor_02345ba45cc0e303, []int{116}
 }
 
 func (m *GrantorEntity) XXX_Unmarshal(b []byte) error {
@@ -6660,7 +7618,7 @@ func (m *GrantPrivilegeEntity) Reset()         { *m = GrantPrivilegeEntity{} }
 func (m *GrantPrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantPrivilegeEntity) ProtoMessage()    {}
 func (*GrantPrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{105}
+	return fileDescriptor_02345ba45cc0e303, []int{117}
 }
 
 func (m *GrantPrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6706,7 +7664,7 @@ func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
 func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantEntity) ProtoMessage()    {}
 func (*GrantEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{106}
+	return fileDescriptor_02345ba45cc0e303, []int{118}
 }
 
 func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
@@ -6769,7 +7727,7 @@ func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
 func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectGrantRequest) ProtoMessage()    {}
 func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{107}
+	return fileDescriptor_02345ba45cc0e303, []int{119}
 }
 
 func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
@@ -6818,97 +7776,286 @@ func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
 func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectGrantResponse) ProtoMessage()    {}
 func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{108}
+	return fileDescriptor_02345ba45cc0e303, []int{120}
 }
 
 func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
 }
-func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type OperatePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant
+	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// operation type
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{121}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{122}
+}
+
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
+
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{123}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
 }
-func (m *SelectGrantResponse) XXX_Size() int {
-	return xxx_messageInfo_SelectGrantResponse.Size(m)
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
 }
-func (m *SelectGrantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
 
-func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+func (m *DropDatabaseRequest) GetDbName() string {
 	if m != nil {
-		return m.Entities
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-type OperatePrivilegeRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// grant
-	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
-	// operation type
-	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
-func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
-func (*OperatePrivilegeRequest) ProtoMessage()    {}
-func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{109}
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{124}
 }
 
-func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
 }
-func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
 }
-func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
 }
-func (m *OperatePrivilegeRequest) XXX_Size() int {
-	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
 }
-func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
 
-func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+type ListDatabasesResponse struct {
+	Status  *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// the utc timestamps the databases are created at, the default database has a zero timestamp
+	CreatedUtcTimestamps []uint64 `protobuf:"varint,3,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{125}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Entity
+		return m.Status
 	}
 	return nil
 }
 
-func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.Type
+		return m.DbNames
 	}
-	return OperatePrivilegeType_Grant
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetCreatedUtcTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamps
+	}
+	return nil
 }
 
 type MilvusExt struct {
@@ -6922,7 +8069,7 @@ func (m *MilvusExt) Reset()         { *m = MilvusExt{} }
 func (m *MilvusExt) String() string { return proto.CompactTextString(m) }
 func (*MilvusExt) ProtoMessage()    {}
 func (*MilvusExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{110}
+	return fileDescriptor_02345ba45cc0e303, []int{126}
 }
 
 func (m *MilvusExt) XXX_Unmarshal(b []byte) error {
@@ -6968,6 +8115,7 @@ func init() {
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.milvus.AlterCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
 	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
 	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
@@ -6977,12 +8125,15 @@ func init() {
 	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
 	proto.RegisterType((*GetStatisticsRequest)(nil), "milvus.proto.milvus.GetStatisticsRequest")
 	proto.RegisterType((*GetStatisticsResponse)(nil), "milvus.proto.milvus.GetStatisticsResponse")
+	proto.RegisterType((*PartitionStatistics)(nil), "milvus.proto.milvus.PartitionStatistics")
 	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
+	proto.RegisterType((*DropPartitionDataRequest)(nil), "milvus.proto.milvus.DropPartitionDataRequest")
+	proto.RegisterType((*DropPartitionDataResponse)(nil), "milvus.proto.milvus.DropPartitionDataResponse")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
 	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
 	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
@@ -7007,14 +8158,18 @@ func init() {
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterMapType((map[string]*schemapb.TemplateValue)(nil), "milvus.proto.milvus.SearchRequest.ExprTemplateValuesEntry")
 	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
+	proto.RegisterType((*SearchConsistencyInfo)(nil), "milvus.proto.milvus.SearchConsistencyInfo")
+	proto.RegisterType((*ShardConsistencyInfo)(nil), "milvus.proto.milvus.ShardConsistencyInfo")
 	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
 	proto.RegisterMapType((map[string]int64)(nil), "milvus.proto.milvus.FlushResponse.CollSealTimesEntry")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.FlushCollSegIDsEntry")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
+	proto.RegisterMapType((map[string]*schemapb.TemplateValue)(nil), "milvus.proto.milvus.QueryRequest.ExprTemplateValuesEntry")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
 	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
@@ -7052,6 +8207,12 @@ func init() {
 	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.milvus.GetReplicasResponse")
 	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.milvus.ReplicaInfo")
 	proto.RegisterType((*ShardReplica)(nil), "milvus.proto.milvus.ShardReplica")
+	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.milvus.AllocTimestampRequest")
+	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.milvus.AllocTimestampResponse")
+	proto.RegisterType((*CheckHealthRequest)(nil), "milvus.proto.milvus.CheckHealthRequest")
+	proto.RegisterType((*CheckHealthResponse)(nil), "milvus.proto.milvus.CheckHealthResponse")
+	proto.RegisterType((*GetVersionRequest)(nil), "milvus.proto.milvus.GetVersionRequest")
+	proto.RegisterType((*GetVersionResponse)(nil), "milvus.proto.milvus.GetVersionResponse")
 	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
 	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
 	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
@@ -7076,6 +8237,10 @@ func init() {
 	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
 	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
 	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
 	proto.RegisterType((*MilvusExt)(nil), "milvus.proto.milvus.MilvusExt")
 	proto.RegisterExtension(E_MilvusExtObj)
 }
@@ -7083,337 +8248,398 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 5279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
-	0x76, 0xec, 0xf9, 0xcf, 0x9b, 0x0f, 0x87, 0xc5, 0xdf, 0x78, 0x24, 0x59, 0x54, 0xdb, 0xb2, 0x69,
-	0x69, 0x4d, 0xd9, 0x94, 0x3f, 0x6b, 0xd9, 0x6b, 0x5b, 0x12, 0x6d, 0x89, 0xb0, 0x3e, 0x74, 0xd3,
-	0x76, 0xb0, 0x71, 0x8c, 0x46, 0x73, 0xba, 0x38, 0x6c, 0xab, 0xa7, 0x7b, 0xdc, 0xdd, 0x43, 0x8a,
-	0xce, 0x65, 0x81, 0xcd, 0x2e, 0x36, 0xc8, 0x66, 0x8d, 0x7c, 0x17, 0x39, 0xe4, 0x83, 0x60, 0x73,
-	0x08, 0xb2, 0x09, 0xe2, 0x24, 0x40, 0x80, 0xcd, 0x21, 0x77, 0x23, 0xbf, 0x3d, 0x04, 0xc9, 0x22,
-	0x39, 0x2e, 0x02, 0xe4, 0x10, 0x20, 0x87, 0xdc, 0x92, 0x20, 0x41, 0x7d, 0xba, 0xa7, 0xba, 0xa7,
-	0x7a, 0x38, 0xe4, 0x58, 0x26, 0x65, 0x9e, 0xa6, 0x5f, 0xfd, 0x5e, 0xbd, 0x7a, 0xbf, 0xaa, 0xf7,
-	0xaa, 0x08, 0xd5, 0xae, 0x65, 0xef, 0xf6, 0xfd, 0x95, 0x9e, 0xe7, 0x06, 0x2e, 0x9a, 0x15, 0xbf,
-	0x56, 0xd8, 0x47, 0xab, 0xda, 0x76, 0xbb, 0x5d, 0xd7, 0x61, 0xc0, 0x56, 0xd5, 0x6f, 0xef, 0xe0,
-	0xae, 0xc1, 0xbf, 0x96, 0x3a, 0xae, 0xdb, 0xb1, 0xf1, 0x25, 0xfa, 0xb5, 0xd5, 0xdf, 0xbe, 0x64,
-	0x62, 0xbf, 0xed, 0x59, 0xbd, 0xc0, 0xf5, 0x58, 0x0d, 0xf5, 0x77, 0x14, 0x40, 0xd7, 0x3d, 0x6c,
-	0x04, 0xf8, 0xaa, 0x6d, 0x19, 0xbe, 0x86, 0x3f, 0xea, 0x63, 0x3f, 0x40, 0xcf, 0x40, 0x6e, 0xcb,
-	0xf0, 0x71, 0x53, 0x59, 0x52, 0x96, 0x2b, 0xab, 0xa7, 0x57, 0x62, 0x03, 0xf3, 0x01, 0x6f, 0xfb,
-	0x9d, 0x6b, 0x86, 0x8f, 0x35, 0x5a, 0x13, 0x2d, 0x42, 0xd1, 0xdc, 0xd2, 0x1d, 0xa3, 0x8b, 0x9b,
-	0x99, 0x25, 0x65, 0xb9, 0xac, 0x15, 0xcc, 0xad, 0x3b, 0x46, 0x17, 0xa3, 0x27, 0x61, 0xba, 0xed,
-	0xda, 0x36, 0x6e, 0x07, 0x96, 0xeb, 0xb0, 0x0a, 0x59, 0x5a, 0xa1, 0x3e, 0x00, 0xd3, 0x8a, 0x73,
-	0x90, 0x37, 0x08, 0x0e, 0xcd, 0x1c, 0x2d, 0x66, 0x1f, 0xaa, 0x0f, 0x8d, 0x35, 0xcf, 0xed, 0x3d,
-	0x28, 0xec, 0xa2, 0x41, 0xb3, 0xe2, 0xa0, 0xbf, 0xad, 0xc0, 0xcc, 0x55, 0x3b, 0xc0, 0xde, 0x09,
-	0x25, 0xca, 0x0f, 0x33, 0xb0, 0xc8, 0x56, 0xed, 0x7a, 0x54, 0xfd, 0x38, 0xb1, 0x5c, 0x80, 0x02,
-	0xe3, 0x3b, 0x8a, 0x66, 0x55, 0xe3, 0x5f, 0xe8, 0x0c, 0x80, 0xbf, 0x63, 0x78, 0xa6, 0xaf, 0x3b,
-	0xfd, 0x6e, 0x33, 0xbf, 0xa4, 0x2c, 0xe7, 0xb5, 0x32, 0x83, 0xdc, 0xe9, 0x77, 0x91, 0x06, 0x33,
-	0x6d, 0xd7, 0xf1, 0x2d, 0x3f, 0xc0, 0x4e, 0x7b, 0x5f, 0xb7, 0xf1, 0x2e, 0xb6, 0x9b, 0x85, 0x25,
-	0x65, 0xb9, 0xbe, 0x7a, 0x5e, 0x8a, 0xf7, 0xf5, 0x41, 0xed, 0x5b, 0xa4, 0xb2, 0xd6, 0x68, 0x27,
-	0x20, 0x57, 0xd0, 0x67, 0xaf, 0x4e, 0x97, 0x94, 0x86, 0xd2, 0xfc, 0xbf, 0xf0, 0x4f, 0x51, 0x7f,
-	0x57, 0x81, 0x79, 0xc2, 0x44, 0x27, 0x82, 0x58, 0x21, 0x86, 0x19, 0x11, 0xc3, 0x3f, 0x54, 0x60,
-	0xee, 0xa6, 0xe1, 0x9f, 0x8c, 0xd5, 0x3c, 0x03, 0x10, 0x58, 0x5d, 0xac, 0xfb, 0x81, 0xd1, 0xed,
-	0xd1, 0x15, 0xcd, 0x69, 0x65, 0x02, 0xd9, 0x24, 0x00, 0xf5, 0xeb, 0x50, 0xbd, 0xe6, 0xba, 0xb6,
-	0x86, 0xfd, 0x9e, 0xeb, 0xf8, 0x18, 0x5d, 0x86, 0x82, 0x1f, 0x18, 0x41, 0xdf, 0xe7, 0x48, 0x9e,
-	0x92, 0x22, 0xb9, 0x49, 0xab, 0x68, 0xbc, 0x2a, 0xe1, 0xeb, 0x5d, 0xc3, 0xee, 0x33, 0x1c, 0x4b,
-	0x1a, 0xfb, 0x50, 0xdf, 0x87, 0xfa, 0x66, 0xe0, 0x59, 0x4e, 0xe7, 0x73, 0xec, 0xbc, 0x1c, 0x76,
-	0xfe, 0x6f, 0x0a, 0x3c, 0xb2, 0x46, 0xf5, 0xdf, 0xd6, 0x09, 0x11, 0x1b, 0x15, 0xaa, 0x03, 0xc8,
-	0xfa, 0x1a, 0x25, 0x75, 0x56, 0x8b, 0xc1, 0x12, 0x8b, 0x91, 0x4f, 0x2c, 0x46, 0xc8, 0x4c, 0x59,
-	0x91, 0x99, 0xbe, 0x91, 0x87, 0x96, 0x6c, 0xa2, 0x93, 0x90, 0xf4, 0x6b, 0x91, 0x84, 0x67, 0x68,
-	0xa3, 0x84, 0x7c, 0x72, 0xab, 0x33, 0x18, 0x6d, 0x93, 0x02, 0x22, 0x45, 0x90, 0x9c, 0x69, 0x56,
-	0x32, 0xd3, 0x55, 0x98, 0xdf, 0xb5, 0xbc, 0xa0, 0x6f, 0xd8, 0x7a, 0x7b, 0xc7, 0x70, 0x1c, 0x6c,
-	0x53, 0xda, 0x11, 0xd5, 0x97, 0x5d, 0x2e, 0x6b, 0xb3, 0xbc, 0xf0, 0x3a, 0x2b, 0x23, 0x04, 0xf4,
-	0xd1, 0x73, 0xb0, 0xd0, 0xdb, 0xd9, 0xf7, 0xad, 0xf6, 0x50, 0xa3, 0x3c, 0x6d, 0x34, 0x17, 0x96,
-	0xc6, 0x5a, 0x5d, 0x84, 0x99, 0x36, 0xd5, 0x9e, 0xa6, 0x4e, 0x28, 0xc9, 0x48, 0x5b, 0xa0, 0xa4,
-	0x6d, 0xf0, 0x82, 0x77, 0x42, 0x38, 0x41, 0x2b, 0xac, 0xdc, 0x0f, 0xda, 0x42, 0x83, 0x22, 0x6d,
-	0x30, 0xcb, 0x0b, 0xdf, 0x0d, 0xda, 0x83, 0x36, 0x71, 0xbd, 0x57, 0x4a, 0xea, 0xbd, 0x26, 0x14,
-	0xa9, 0x1e, 0xc7, 0x7e, 0xb3, 0x4c, 0xd1, 0x0c, 0x3f, 0xd1, 0x3a, 0x4c, 0xfb, 0x81, 0xe1, 0x05,
-	0x7a, 0xcf, 0xf5, 0x2d, 0x42, 0x17, 0xbf, 0x09, 0x4b, 0xd9, 0xe5, 0xca, 0xea, 0x92, 0x74, 0x91,
-	0xde, 0xc2, 0xfb, 0x6b, 0x46, 0x60, 0x6c, 0x18, 0x96, 0xa7, 0xd5, 0x69, 0xc3, 0x8d, 0xb0, 0x9d,
-	0x5c, 0xb9, 0x56, 0x26, 0x52, 0xae, 0x32, 0xce, 0xae, 0xca, 0x38, 0x5b, 0xfd, 0x2b, 0x05, 0xe6,
-	0x6f, 0xb9, 0x86, 0x79, 0x32, 0xe4, 0xec, 0x3c, 0xd4, 0x3d, 0xdc, 0xb3, 0xad, 0xb6, 0x41, 0xd6,
-	0x63, 0x0b, 0x7b, 0x54, 0xd2, 0xf2, 0x5a, 0x8d, 0x43, 0xef, 0x50, 0xe0, 0x95, 0xe2, 0x67, 0xaf,
-	0xe6, 0x1a, 0xf9, 0x66, 0x56, 0xfd, 0xbe, 0x02, 0x4d, 0x0d, 0xdb, 0xd8, 0xf0, 0x4f, 0x86, 0xa2,
-	0x60, 0x98, 0x15, 0x9a, 0x59, 0xf5, 0x3f, 0x14, 0x98, 0xbb, 0x81, 0x03, 0x22, 0x9c, 0x96, 0x1f,
-	0x58, 0xed, 0x63, 0xf5, 0x4d, 0x9e, 0x84, 0xe9, 0x9e, 0xe1, 0x05, 0x56, 0x54, 0x2f, 0x14, 0xd5,
-	0x7a, 0x04, 0x66, 0xf2, 0x76, 0x09, 0x66, 0x3b, 0x7d, 0xc3, 0x33, 0x9c, 0x00, 0x63, 0x41, 0x80,
-	0x98, 0x32, 0x43, 0x51, 0x51, 0x24, 0x3f, 0x6c, 0xbe, 0xd0, 0xcc, 0xaa, 0xdf, 0x52, 0x60, 0x3e,
-	0x31, 0xdf, 0x49, 0xb4, 0xd8, 0x8b, 0x90, 0x27, 0xbf, 0xfc, 0x66, 0x86, 0x0a, 0xd5, 0xb9, 0x34,
-	0xa1, 0x7a, 0x8f, 0x18, 0x0c, 0x2a, 0x55, 0xac, 0x3e, 0x71, 0x08, 0x1f, 0xbd, 0x81, 0x03, 0x41,
-	0xbf, 0x9d, 0x84, 0x15, 0x18, 0xd0, 0xe9, 0x13, 0x05, 0xce, 0xa6, 0xe2, 0x77, 0x2c, 0x14, 0xfb,
-	0x2f, 0x05, 0x16, 0x36, 0x77, 0xdc, 0xbd, 0x01, 0x4a, 0x0f, 0x82, 0x52, 0x71, 0xeb, 0x98, 0x4d,
-	0x58, 0x47, 0xf4, 0x2c, 0xe4, 0x82, 0xfd, 0x1e, 0xa6, 0xe2, 0x5e, 0x5f, 0x3d, 0xb3, 0x22, 0xd9,
-	0x3f, 0xad, 0x10, 0x24, 0xdf, 0xd9, 0xef, 0x61, 0x8d, 0x56, 0x45, 0x4f, 0x41, 0x23, 0x41, 0xfb,
-	0xd0, 0x96, 0x4c, 0xc7, 0x89, 0xef, 0x87, 0xb6, 0x37, 0x27, 0xda, 0xde, 0xff, 0xcc, 0xc0, 0xe2,
-	0xd0, 0xb4, 0x27, 0x59, 0x00, 0x19, 0x3e, 0x19, 0x29, 0x3e, 0x44, 0xcd, 0x09, 0x55, 0x2d, 0x93,
-	0x6c, 0x6a, 0xb2, 0xcb, 0x59, 0xad, 0x26, 0x98, 0x59, 0xd3, 0x47, 0x4f, 0x03, 0x1a, 0xb2, 0x7e,
-	0x4c, 0x72, 0x73, 0xda, 0x4c, 0xd2, 0xfc, 0x51, 0x13, 0x2b, 0xb5, 0x7f, 0x8c, 0x2c, 0x39, 0x6d,
-	0x4e, 0x62, 0x00, 0x7d, 0xf4, 0x2c, 0xcc, 0x59, 0xce, 0x6d, 0xdc, 0x75, 0xbd, 0x7d, 0xbd, 0x87,
-	0xbd, 0x36, 0x76, 0x02, 0xa3, 0x83, 0xfd, 0x66, 0x81, 0x62, 0x34, 0x1b, 0x96, 0x6d, 0x0c, 0x8a,
-	0xd0, 0x0b, 0xb0, 0xf8, 0x51, 0x1f, 0x7b, 0xfb, 0xba, 0x8f, 0xbd, 0x5d, 0xab, 0x8d, 0x75, 0x63,
-	0xd7, 0xb0, 0x6c, 0x63, 0xcb, 0xc6, 0xcd, 0xe2, 0x52, 0x76, 0xb9, 0xa4, 0xcd, 0xd3, 0xe2, 0x4d,
-	0x56, 0x7a, 0x35, 0x2c, 0x54, 0xff, 0x5c, 0x81, 0x05, 0xb6, 0x19, 0xda, 0x08, 0xd5, 0xce, 0x31,
-	0x1b, 0x9b, 0xb8, 0x56, 0xe4, 0x5b, 0xb7, 0x5a, 0x4c, 0x29, 0xaa, 0x9f, 0x2a, 0x30, 0x47, 0xf6,
-	0x24, 0x0f, 0x13, 0xce, 0x7f, 0xaa, 0xc0, 0xec, 0x4d, 0xc3, 0x7f, 0x98, 0x50, 0xfe, 0x17, 0xee,
-	0x88, 0x44, 0x38, 0x3f, 0x1c, 0x16, 0x73, 0xd8, 0x63, 0xc9, 0x4b, 0x3c, 0x16, 0xf5, 0x2f, 0x07,
-	0x8e, 0xca, 0xc3, 0x35, 0x41, 0xf5, 0x47, 0x0a, 0x9c, 0xb9, 0x81, 0x83, 0x08, 0xeb, 0x93, 0xe1,
-	0xd1, 0x8c, 0xc9, 0x54, 0xdf, 0x63, 0xde, 0x80, 0x14, 0xf9, 0x63, 0x31, 0xb6, 0xbf, 0x94, 0x81,
-	0x79, 0x62, 0x75, 0x4e, 0x06, 0x13, 0x8c, 0xb3, 0xad, 0x95, 0x30, 0x4a, 0x5e, 0x2a, 0x09, 0xa1,
-	0x09, 0x2f, 0x8c, 0x6d, 0xc2, 0xd5, 0x3f, 0xcb, 0x30, 0xd7, 0x43, 0xa4, 0xc6, 0x24, 0xcb, 0x22,
-	0xc1, 0x35, 0x23, 0xc5, 0x55, 0x85, 0x6a, 0x04, 0x59, 0x5f, 0x0b, 0xcd, 0x6f, 0x0c, 0x76, 0x52,
-	0xad, 0xaf, 0xfa, 0x5d, 0x05, 0x16, 0xc2, 0x43, 0x83, 0x4d, 0xdc, 0xe9, 0x62, 0x27, 0x38, 0x3a,
-	0x0f, 0x25, 0x39, 0x20, 0x23, 0xe1, 0x80, 0xd3, 0x50, 0xf6, 0xd9, 0x38, 0xd1, 0x79, 0xc0, 0x00,
-	0xa0, 0xfe, 0xb5, 0x02, 0x8b, 0x43, 0xe8, 0x4c, 0xb2, 0x88, 0x4d, 0x28, 0x5a, 0x8e, 0x89, 0xef,
-	0x47, 0xd8, 0x84, 0x9f, 0xa4, 0x64, 0xab, 0x6f, 0xd9, 0x66, 0x84, 0x46, 0xf8, 0x89, 0xce, 0x41,
-	0x15, 0x3b, 0xc4, 0xc7, 0xd0, 0x69, 0x5d, 0xca, 0xc8, 0x25, 0xad, 0xc2, 0x60, 0xeb, 0x04, 0x44,
-	0x1a, 0x6f, 0x5b, 0x98, 0x36, 0xce, 0xb3, 0xc6, 0xfc, 0x53, 0xfd, 0x65, 0x05, 0x66, 0x09, 0x17,
-	0x72, 0xec, 0xfd, 0x07, 0x4b, 0xcd, 0x25, 0xa8, 0x08, 0x6c, 0xc6, 0x27, 0x22, 0x82, 0xd4, 0x7b,
-	0x30, 0x17, 0x47, 0x67, 0x12, 0x6a, 0x3e, 0x0a, 0x10, 0xad, 0x15, 0x93, 0x86, 0xac, 0x26, 0x40,
-	0xd4, 0xdf, 0xc8, 0x84, 0x61, 0x05, 0x4a, 0xa6, 0x63, 0x3e, 0xcd, 0xa4, 0x4b, 0x22, 0xea, 0xf3,
-	0x32, 0x85, 0xd0, 0xe2, 0x35, 0xa8, 0xe2, 0xfb, 0x81, 0x67, 0xe8, 0x3d, 0xc3, 0x33, 0xba, 0x4c,
-	0xac, 0xc6, 0x52, 0xbd, 0x15, 0xda, 0x6c, 0x83, 0xb6, 0x22, 0x83, 0x50, 0x16, 0x61, 0x83, 0x14,
-	0xd8, 0x20, 0x14, 0x32, 0xd8, 0xa7, 0x55, 0x9a, 0x59, 0xf5, 0xc7, 0xc4, 0xeb, 0xe3, 0x6c, 0x7d,
-	0xd2, 0x29, 0x13, 0x9f, 0x53, 0x5e, 0x3a, 0xa7, 0x6a, 0x33, 0xab, 0xfe, 0x81, 0x02, 0x0d, 0x3a,
-	0x97, 0x35, 0x1e, 0x5c, 0xb2, 0x5c, 0x27, 0xd1, 0x58, 0x49, 0x34, 0x1e, 0x21, 0x8d, 0x2f, 0x41,
-	0x81, 0xaf, 0x44, 0x76, 0xdc, 0x95, 0xe0, 0x0d, 0x0e, 0x98, 0x8f, 0xfa, 0xfb, 0x0a, 0xcc, 0x27,
-	0x68, 0x3f, 0x89, 0x08, 0xbc, 0x03, 0x88, 0xcd, 0xd0, 0x1c, 0x4c, 0x3b, 0xb4, 0xdc, 0xe7, 0xa5,
-	0x66, 0x2a, 0x49, 0x24, 0x6d, 0xc6, 0x4a, 0x40, 0x7c, 0xf5, 0x27, 0x0a, 0x9c, 0xbe, 0x81, 0x03,
-	0x5a, 0xf5, 0x1a, 0x51, 0x43, 0x1b, 0x9e, 0xdb, 0xf1, 0xb0, 0xef, 0x7f, 0x09, 0x18, 0xe5, 0x37,
-	0x99, 0xcf, 0x27, 0x9b, 0xdb, 0x24, 0x0b, 0x71, 0x0e, 0xaa, 0x74, 0x30, 0x6c, 0xea, 0x9e, 0xbb,
-	0xe7, 0x73, 0x86, 0xaa, 0x70, 0x98, 0xe6, 0xee, 0x51, 0xce, 0x08, 0xdc, 0xc0, 0xb0, 0x59, 0x05,
-	0x6e, 0x6c, 0x28, 0x84, 0x14, 0x53, 0xa9, 0x0c, 0x11, 0x23, 0x9d, 0xe3, 0x2f, 0x01, 0xb1, 0x7f,
-	0xc0, 0x4e, 0xce, 0xc4, 0x39, 0x4d, 0x42, 0xe4, 0xe7, 0x99, 0x6b, 0xca, 0x66, 0x55, 0x5f, 0x3d,
-	0x2b, 0x6d, 0x23, 0x0c, 0xc6, 0x6a, 0xa3, 0xb3, 0x50, 0xd9, 0x36, 0x2c, 0x5b, 0xf7, 0xb0, 0xe1,
-	0xbb, 0x0e, 0x9f, 0x31, 0x10, 0x90, 0x46, 0x21, 0xea, 0xdf, 0x2a, 0x2c, 0xbe, 0xfb, 0x65, 0x50,
-	0x86, 0xb5, 0x66, 0x56, 0xfd, 0x61, 0x06, 0x6a, 0xeb, 0x8e, 0x8f, 0xbd, 0xe0, 0xe4, 0xef, 0x63,
-	0xd0, 0x6b, 0x50, 0xa1, 0x33, 0xf4, 0x75, 0xd3, 0x08, 0x0c, 0x6e, 0xfa, 0x1e, 0x95, 0x46, 0x76,
-	0xde, 0x24, 0xf5, 0xd6, 0x8c, 0xc0, 0xd0, 0x18, 0x99, 0x7c, 0xf2, 0x1b, 0x9d, 0x82, 0xf2, 0x8e,
-	0xe1, 0xef, 0xe8, 0xf7, 0xf0, 0x3e, 0x73, 0x2e, 0x6b, 0x5a, 0x89, 0x00, 0xde, 0xc2, 0xfb, 0x3e,
-	0x7a, 0x04, 0x4a, 0x4e, 0xbf, 0xcb, 0x44, 0xae, 0xb8, 0xa4, 0x2c, 0xd7, 0xb4, 0xa2, 0xd3, 0xef,
-	0x12, 0x81, 0x63, 0xe4, 0x2a, 0x35, 0xb3, 0xea, 0xdf, 0x64, 0xa0, 0x7e, 0xbb, 0x4f, 0xb6, 0x4f,
-	0x34, 0x40, 0xd5, 0xb7, 0x83, 0xa3, 0xb1, 0xe7, 0x05, 0xc8, 0x32, 0x47, 0x84, 0xb4, 0x68, 0x4a,
-	0x67, 0xb0, 0xbe, 0xe6, 0x6b, 0xa4, 0x12, 0x0d, 0xce, 0xf4, 0xdb, 0x6d, 0xee, 0xd3, 0x65, 0x29,
-	0xd6, 0x65, 0x02, 0x61, 0x1e, 0xdd, 0x29, 0x28, 0x63, 0xcf, 0x8b, 0x3c, 0x3e, 0x3a, 0x27, 0xec,
-	0x79, 0xac, 0x50, 0x85, 0xaa, 0xd1, 0xbe, 0xe7, 0xb8, 0x7b, 0x36, 0x36, 0x3b, 0xd8, 0xa4, 0x8c,
-	0x50, 0xd2, 0x62, 0x30, 0xc6, 0x2a, 0x84, 0x03, 0xf4, 0xb6, 0x13, 0x50, 0x5f, 0x20, 0x4b, 0x58,
-	0x85, 0x40, 0xae, 0x3b, 0x01, 0x29, 0x36, 0xb1, 0x8d, 0x03, 0x4c, 0x8b, 0x8b, 0xac, 0x98, 0x41,
-	0x78, 0x71, 0xbf, 0x17, 0xb5, 0x2e, 0xb1, 0x62, 0x06, 0x21, 0xc5, 0xa7, 0xa1, 0x3c, 0x38, 0x40,
-	0x2f, 0x0f, 0xce, 0x3b, 0x29, 0x40, 0xfd, 0xa9, 0x02, 0xb5, 0x35, 0xda, 0xd5, 0x43, 0xc0, 0x7d,
-	0x08, 0x72, 0xf8, 0x7e, 0xcf, 0xe3, 0xc2, 0x44, 0x7f, 0x8f, 0x64, 0x28, 0xc6, 0x35, 0xe5, 0x66,
-	0x56, 0xfd, 0x76, 0x0e, 0x6a, 0x9b, 0xd8, 0xf0, 0xda, 0x3b, 0x0f, 0xc5, 0x61, 0x4e, 0x03, 0xb2,
-	0xa6, 0x6f, 0xf3, 0x79, 0x92, 0x9f, 0xe8, 0x22, 0xcc, 0xf4, 0x6c, 0xa3, 0x8d, 0x77, 0x5c, 0xdb,
-	0xc4, 0x9e, 0xde, 0xf1, 0xdc, 0x3e, 0x0b, 0x40, 0x56, 0xb5, 0x86, 0x50, 0x70, 0x83, 0xc0, 0xd1,
-	0x8b, 0x50, 0x32, 0x7d, 0x5b, 0xa7, 0xbb, 0xe0, 0x22, 0xd5, 0xbe, 0xf2, 0xf9, 0xad, 0xf9, 0x36,
-	0xdd, 0x04, 0x17, 0x4d, 0xf6, 0x03, 0x3d, 0x06, 0x35, 0xb7, 0x1f, 0xf4, 0xfa, 0x81, 0xce, 0x44,
-	0xb6, 0x59, 0xa2, 0xe8, 0x55, 0x19, 0x90, 0x4a, 0xb4, 0x8f, 0xde, 0x84, 0x9a, 0x4f, 0x49, 0x19,
-	0x3a, 0xc0, 0xe5, 0x71, 0xdd, 0xae, 0x2a, 0x6b, 0xc7, 0x3d, 0xe0, 0xa7, 0xa0, 0x11, 0x78, 0xc6,
-	0x2e, 0xb6, 0x85, 0x00, 0x0f, 0x50, 0xfe, 0x9c, 0x66, 0xf0, 0x41, 0x74, 0x34, 0x25, 0x1c, 0x54,
-	0x49, 0x0b, 0x07, 0xa1, 0x3a, 0x64, 0x9c, 0x8f, 0x68, 0xa4, 0x31, 0xab, 0x65, 0x9c, 0x8f, 0x18,
-	0x23, 0xd4, 0x9b, 0x59, 0xf5, 0x2d, 0xc8, 0xdd, 0xb4, 0x02, 0x4a, 0x61, 0x22, 0xfe, 0x0a, 0xdd,
-	0x87, 0x50, 0x21, 0x7f, 0x04, 0x4a, 0x9e, 0xbb, 0xc7, 0xf4, 0x1a, 0xf1, 0xc9, 0xaa, 0x5a, 0xd1,
-	0x73, 0xf7, 0xa8, 0xd2, 0xa2, 0xc9, 0x2a, 0xae, 0x87, 0x99, 0x87, 0x99, 0xd1, 0xf8, 0x97, 0xfa,
-	0x27, 0xca, 0x80, 0xab, 0x88, 0x26, 0xf2, 0x8f, 0xa6, 0x8a, 0x5e, 0x83, 0xa2, 0xc7, 0xda, 0x8f,
-	0x0c, 0x95, 0x8b, 0x23, 0x51, 0xbd, 0x1a, 0xb6, 0x1a, 0x9b, 0x01, 0xc9, 0x0e, 0xb3, 0xfa, 0xa6,
-	0xdd, 0xf7, 0x1f, 0x84, 0x14, 0xc8, 0xc2, 0x0e, 0x59, 0x79, 0x18, 0x84, 0xae, 0xc6, 0xf4, 0x52,
-	0x56, 0xfd, 0xef, 0x1c, 0xd4, 0x38, 0x3e, 0x93, 0xb8, 0x1a, 0xa9, 0x38, 0x6d, 0x42, 0x85, 0x8c,
-	0xad, 0xfb, 0xb8, 0x13, 0x9e, 0xae, 0x54, 0x56, 0x57, 0xa5, 0xae, 0x76, 0x0c, 0x0d, 0x9a, 0x96,
-	0xb0, 0x49, 0x1b, 0xbd, 0xe1, 0x04, 0xde, 0xbe, 0x06, 0xed, 0x08, 0x80, 0xda, 0x30, 0xb3, 0x4d,
-	0x2a, 0xeb, 0x62, 0xd7, 0x39, 0xda, 0xf5, 0x8b, 0x63, 0x74, 0x4d, 0xbf, 0x92, 0xfd, 0x4f, 0x6f,
-	0xc7, 0xa1, 0xe8, 0x03, 0xb6, 0xa4, 0xba, 0x8f, 0x0d, 0x2e, 0x1f, 0xdc, 0xd8, 0x3e, 0x3f, 0x36,
-	0xf6, 0x06, 0x13, 0x20, 0x36, 0x40, 0xad, 0x2d, 0xc2, 0x5a, 0x1f, 0xc0, 0x74, 0x02, 0x05, 0x22,
-	0x11, 0xf7, 0xf0, 0x3e, 0xdf, 0x78, 0x91, 0x9f, 0xe8, 0x39, 0x31, 0x29, 0x26, 0xcd, 0xcc, 0xdf,
-	0x72, 0x9d, 0xce, 0x55, 0xcf, 0x33, 0xf6, 0x79, 0xd2, 0xcc, 0x95, 0xcc, 0x57, 0x95, 0xd6, 0x16,
-	0xcc, 0xc9, 0xa6, 0xf9, 0xb9, 0x8e, 0xf1, 0x3a, 0xa0, 0xe1, 0x79, 0x4a, 0x46, 0x88, 0xa5, 0xf6,
-	0x64, 0x85, 0x1e, 0xd4, 0x4f, 0xb2, 0x50, 0x7d, 0xbb, 0x8f, 0xbd, 0xfd, 0xe3, 0xb4, 0x09, 0xa1,
-	0x4d, 0xcb, 0x09, 0x36, 0x6d, 0x48, 0x0d, 0xe7, 0x25, 0x6a, 0x58, 0x62, 0x4c, 0x0a, 0x52, 0x63,
-	0x22, 0xd3, 0xb3, 0xc5, 0x43, 0xe9, 0xd9, 0x52, 0xaa, 0x9e, 0x5d, 0x83, 0x2a, 0x8b, 0xc0, 0x1d,
-	0xd6, 0x14, 0x54, 0x68, 0x33, 0x66, 0x09, 0x98, 0x3e, 0x68, 0x34, 0xb3, 0xea, 0x1f, 0x2b, 0xd1,
-	0x8a, 0x4c, 0xa4, 0x4f, 0x63, 0x4e, 0x6a, 0xe6, 0xd0, 0x4e, 0xea, 0xd8, 0xfa, 0xf4, 0x53, 0x05,
-	0xca, 0xef, 0xe1, 0x76, 0xe0, 0x7a, 0x44, 0x66, 0x25, 0xcd, 0x94, 0x31, 0x76, 0x0e, 0x99, 0xe4,
-	0xce, 0xe1, 0x32, 0x94, 0x2c, 0x53, 0x37, 0x08, 0xc3, 0xd3, 0x71, 0x47, 0xf9, 0xa7, 0x45, 0xcb,
-	0xa4, 0x92, 0x31, 0x7e, 0x1c, 0xe5, 0xfb, 0x0a, 0x54, 0x19, 0xce, 0x3e, 0x6b, 0xf9, 0xb2, 0x30,
-	0x9c, 0x22, 0x93, 0x42, 0xfe, 0x11, 0x4d, 0xf4, 0xe6, 0xd4, 0x60, 0xd8, 0xab, 0x00, 0x84, 0xc8,
-	0xbc, 0x39, 0x13, 0xe2, 0x25, 0x29, 0xb6, 0xac, 0x39, 0x25, 0xf8, 0xcd, 0x29, 0xad, 0x4c, 0x5a,
-	0xd1, 0x2e, 0xae, 0x15, 0x21, 0x4f, 0x5b, 0xab, 0xff, 0xa3, 0xc0, 0xec, 0x75, 0xc3, 0x6e, 0xaf,
-	0x59, 0x7e, 0x60, 0x38, 0xed, 0x09, 0x3c, 0xd2, 0x2b, 0x50, 0x74, 0x7b, 0xba, 0x8d, 0xb7, 0x03,
-	0x8e, 0xd2, 0xb9, 0x11, 0x33, 0x62, 0x64, 0xd0, 0x0a, 0x6e, 0xef, 0x16, 0xde, 0x0e, 0xd0, 0x2b,
-	0x50, 0x72, 0x7b, 0xba, 0x67, 0x75, 0x76, 0x02, 0x4e, 0xfd, 0x31, 0x1a, 0x17, 0xdd, 0x9e, 0x46,
-	0x5a, 0x08, 0x87, 0x51, 0xb9, 0x43, 0x1e, 0x46, 0xa9, 0x3f, 0x1e, 0x9a, 0xfe, 0x04, 0x32, 0x70,
-	0x05, 0x4a, 0x96, 0x13, 0xe8, 0xa6, 0xe5, 0x87, 0x24, 0x38, 0x23, 0xe7, 0x21, 0x27, 0xa0, 0x33,
-	0xa0, 0x6b, 0xea, 0x04, 0x64, 0x6c, 0xf4, 0x3a, 0xc0, 0xb6, 0xed, 0x1a, 0xbc, 0x35, 0xa3, 0xc1,
-	0x59, 0xb9, 0xf8, 0x90, 0x6a, 0x61, 0xfb, 0x32, 0x6d, 0x44, 0x7a, 0x18, 0x2c, 0xe9, 0xdf, 0x2b,
-	0x30, 0xbf, 0x81, 0x3d, 0x96, 0x13, 0x16, 0xf0, 0x93, 0xe4, 0x75, 0x67, 0xdb, 0x8d, 0x1f, 0xe6,
-	0x2b, 0x89, 0xc3, 0xfc, 0xcf, 0xe7, 0x00, 0x3b, 0xb6, 0x9f, 0x64, 0x21, 0xa5, 0x70, 0x3f, 0x19,
-	0x06, 0xce, 0xd8, 0xc6, 0xbc, 0x9e, 0xb2, 0x4c, 0x1c, 0x5f, 0xf1, 0x7c, 0x42, 0xfd, 0x35, 0x96,
-	0x37, 0x23, 0x9d, 0xd4, 0xd1, 0x19, 0x76, 0x01, 0xb8, 0xe1, 0x48, 0x98, 0x91, 0x27, 0x20, 0xa1,
-	0x3b, 0x52, 0x14, 0xd1, 0x6f, 0x29, 0xb0, 0x94, 0x8e, 0xd5, 0x24, 0xbe, 0xd5, 0xeb, 0x90, 0xb7,
-	0x9c, 0x6d, 0x37, 0x3c, 0xa7, 0xbc, 0x20, 0x95, 0x05, 0xf9, 0xb8, 0xac, 0xa1, 0xfa, 0x0f, 0x19,
-	0x68, 0xbc, 0xcd, 0xf2, 0x30, 0xbe, 0xf0, 0xe5, 0xef, 0xe2, 0xae, 0xee, 0x5b, 0x1f, 0xe3, 0x70,
-	0xf9, 0xbb, 0xb8, 0xbb, 0x69, 0x7d, 0x8c, 0x63, 0x9c, 0x91, 0x8f, 0x73, 0xc6, 0xe8, 0x83, 0x79,
-	0xf1, 0x1c, 0xba, 0x18, 0x3f, 0x87, 0x5e, 0x80, 0x82, 0xe3, 0x9a, 0x78, 0x7d, 0x8d, 0xef, 0xc1,
-	0xf9, 0xd7, 0x80, 0xd5, 0xca, 0x87, 0x63, 0x35, 0x32, 0x14, 0xed, 0xc2, 0x64, 0x29, 0x9d, 0x04,
-	0x47, 0xf6, 0xa9, 0x7e, 0x4f, 0x81, 0xd6, 0x0d, 0x1c, 0x24, 0xa9, 0x7a, 0x7c, 0xfc, 0xf7, 0x89,
-	0x02, 0xa7, 0xa4, 0x08, 0x4d, 0xc2, 0x7a, 0x2f, 0xc7, 0x59, 0x4f, 0x7e, 0x44, 0x3e, 0x34, 0x24,
-	0xe7, 0xba, 0x67, 0xa1, 0xba, 0xd6, 0xef, 0x76, 0x23, 0xdf, 0xee, 0x1c, 0x54, 0x3d, 0xf6, 0x93,
-	0xed, 0x8b, 0x99, 0x65, 0xae, 0x70, 0x18, 0xd9, 0xfd, 0xaa, 0x17, 0xa1, 0xc6, 0x9b, 0x70, 0xac,
-	0x5b, 0x50, 0xf2, 0xf8, 0x6f, 0x5e, 0x3f, 0xfa, 0x56, 0xe7, 0x61, 0x56, 0xc3, 0x1d, 0xc2, 0xf4,
-	0xde, 0x2d, 0xcb, 0xb9, 0xc7, 0x87, 0x51, 0xbf, 0xa9, 0xc0, 0x5c, 0x1c, 0xce, 0xfb, 0x7a, 0x01,
-	0x8a, 0x86, 0x69, 0x7a, 0xd8, 0xf7, 0x47, 0x2e, 0xcb, 0x55, 0x56, 0x47, 0x0b, 0x2b, 0x0b, 0x94,
-	0xcb, 0x8c, 0x4d, 0x39, 0x55, 0x87, 0x99, 0x1b, 0x38, 0xb8, 0x8d, 0x03, 0x6f, 0xa2, 0xf4, 0x88,
-	0x26, 0xd9, 0x98, 0xd2, 0xc6, 0x9c, 0x2d, 0xc2, 0x4f, 0xf5, 0xbb, 0x0a, 0x20, 0x71, 0x84, 0x49,
-	0x96, 0x59, 0xa4, 0x72, 0x26, 0x4e, 0x65, 0x96, 0xa0, 0xd6, 0xed, 0xb9, 0x0e, 0x76, 0x02, 0xd1,
-	0x11, 0xab, 0x45, 0x50, 0xca, 0x7e, 0x3f, 0x55, 0x00, 0xdd, 0x72, 0x0d, 0xf3, 0x9a, 0x61, 0x4f,
-	0xe6, 0x38, 0x9c, 0x01, 0xf0, 0xbd, 0xb6, 0xce, 0xe5, 0x38, 0xc3, 0xf5, 0x92, 0xd7, 0xbe, 0xc3,
-	0x44, 0xf9, 0x2c, 0x54, 0x4c, 0x3f, 0xe0, 0xc5, 0x61, 0xb4, 0x1e, 0x4c, 0x3f, 0x60, 0xe5, 0x34,
-	0x4f, 0x9c, 0xec, 0xd8, 0xb0, 0xa9, 0x0b, 0xc1, 0xce, 0x1c, 0xad, 0xd6, 0x60, 0x05, 0x9b, 0x11,
-	0x5c, 0x22, 0x5c, 0xf9, 0xf4, 0x9c, 0xcd, 0x99, 0x66, 0x5e, 0xdd, 0x86, 0xc5, 0xdb, 0x86, 0xd3,
-	0x37, 0xec, 0xeb, 0x6e, 0xb7, 0x67, 0xc4, 0x72, 0x8c, 0x93, 0x1a, 0x53, 0x91, 0x68, 0xcc, 0x47,
-	0x59, 0xea, 0x23, 0x73, 0xfa, 0xe9, 0xe4, 0x72, 0x9a, 0x00, 0x61, 0xe3, 0x14, 0x9b, 0x8a, 0xea,
-	0x43, 0x73, 0x78, 0x9c, 0x49, 0x96, 0x98, 0x62, 0x17, 0x76, 0x25, 0xea, 0xf3, 0x01, 0x4c, 0x7d,
-	0x0d, 0x1e, 0xa1, 0xf9, 0xa8, 0x21, 0x28, 0x16, 0x56, 0x49, 0x76, 0xa0, 0x48, 0x3a, 0xf8, 0xa3,
-	0x0c, 0x55, 0x8a, 0x43, 0x3d, 0x4c, 0x82, 0xf8, 0x95, 0x78, 0x10, 0xe3, 0xf1, 0x94, 0x34, 0xf8,
-	0xf8, 0x88, 0x5c, 0x7d, 0x2f, 0xc3, 0x34, 0xbe, 0x8f, 0xdb, 0xfd, 0xc0, 0x72, 0x3a, 0x1b, 0xb6,
-	0xe1, 0xdc, 0x71, 0xb9, 0x91, 0x4a, 0x82, 0xd1, 0xe3, 0x50, 0x23, 0xcb, 0xe0, 0xf6, 0x03, 0x5e,
-	0x8f, 0x59, 0xab, 0x38, 0x90, 0xf4, 0x47, 0xe6, 0x6b, 0xe3, 0x00, 0x9b, 0xbc, 0x1e, 0x33, 0x5d,
-	0x49, 0x30, 0xa1, 0xd6, 0xb6, 0x61, 0xd9, 0x51, 0x35, 0x76, 0xa2, 0x1c, 0x83, 0x0d, 0x91, 0x9b,
-	0x80, 0xfd, 0xc3, 0x90, 0xfb, 0x9f, 0x94, 0x04, 0xb9, 0x79, 0x0f, 0xc7, 0x45, 0xee, 0x9b, 0x00,
-	0x5d, 0xec, 0x75, 0xf0, 0x3a, 0x35, 0x19, 0xec, 0xa8, 0x67, 0x59, 0x6a, 0x32, 0x06, 0x1d, 0xdc,
-	0x0e, 0x1b, 0x68, 0x42, 0x5b, 0xf5, 0x06, 0xcc, 0x4a, 0xaa, 0x10, 0x6d, 0xe8, 0xbb, 0x7d, 0xaf,
-	0x8d, 0xc3, 0x63, 0xc3, 0xf0, 0x93, 0x58, 0xcf, 0xc0, 0xf0, 0x3a, 0x38, 0xe0, 0x8c, 0xcd, 0xbf,
-	0xd4, 0x17, 0x68, 0x90, 0x90, 0x9e, 0x84, 0xc4, 0xb8, 0x39, 0x9e, 0x0b, 0xa1, 0x0c, 0xe5, 0x42,
-	0x6c, 0xd3, 0x40, 0x9c, 0xd8, 0x6e, 0xc2, 0x3c, 0x16, 0x7a, 0xba, 0x84, 0x4d, 0x7e, 0x75, 0x2a,
-	0xfc, 0x54, 0xff, 0x57, 0x81, 0xda, 0x7a, 0xb7, 0xe7, 0x0e, 0x42, 0x4f, 0x63, 0x6f, 0x61, 0x87,
-	0x4f, 0xec, 0x33, 0xb2, 0x13, 0xfb, 0xc7, 0xa0, 0x16, 0xbf, 0x64, 0xc3, 0x4e, 0x04, 0xab, 0x6d,
-	0xf1, 0x72, 0xcd, 0x29, 0x28, 0x7b, 0xee, 0x9e, 0x4e, 0x14, 0xb0, 0xc9, 0x33, 0x66, 0x4a, 0x9e,
-	0xbb, 0x47, 0xd4, 0xb2, 0x89, 0xe6, 0x20, 0xbf, 0x6d, 0xd9, 0x51, 0xb2, 0x17, 0xfb, 0x40, 0x2f,
-	0x93, 0x0d, 0x1e, 0x8b, 0x9f, 0x17, 0xc6, 0xdd, 0x67, 0x85, 0x2d, 0x98, 0x9e, 0x43, 0x4d, 0x45,
-	0x7d, 0x1f, 0xea, 0xe1, 0xf4, 0x27, 0xbc, 0x3c, 0x16, 0x18, 0xfe, 0xbd, 0x30, 0xab, 0x85, 0x7d,
-	0xa8, 0x17, 0x59, 0x34, 0x95, 0xf6, 0x1f, 0x5b, 0x7d, 0x04, 0x39, 0x52, 0x83, 0x0b, 0x15, 0xfd,
-	0xad, 0xfe, 0x5d, 0x06, 0x16, 0x92, 0xb5, 0x27, 0x41, 0xe9, 0x85, 0xb8, 0x20, 0xc9, 0xef, 0x02,
-	0x89, 0xa3, 0x71, 0x21, 0xe2, 0x4b, 0xd1, 0x76, 0xfb, 0x4e, 0xc0, 0xb5, 0x15, 0x59, 0x8a, 0xeb,
-	0xe4, 0x1b, 0x2d, 0x42, 0xd1, 0x32, 0x75, 0x9b, 0x6c, 0x0a, 0x99, 0x49, 0x2b, 0x58, 0xe6, 0x2d,
-	0xb2, 0x61, 0x7c, 0x31, 0x74, 0xd4, 0xc6, 0x4e, 0x85, 0x61, 0xf5, 0x51, 0x1d, 0x32, 0x96, 0xc9,
-	0xd5, 0x53, 0xc6, 0x32, 0x09, 0x57, 0xd1, 0xd3, 0x04, 0x7a, 0x38, 0xc4, 0xf3, 0xb8, 0x09, 0x3b,
-	0xd4, 0x08, 0xf4, 0xed, 0x10, 0x48, 0x7c, 0x39, 0x5a, 0x8d, 0x07, 0xec, 0xa9, 0xbf, 0x5d, 0xd2,
-	0x2a, 0x04, 0xb6, 0xce, 0x40, 0x6a, 0x13, 0x16, 0x08, 0x6a, 0x6c, 0x8a, 0xef, 0x90, 0x05, 0x09,
-	0x3d, 0xb4, 0x5f, 0x51, 0x60, 0x71, 0xa8, 0x68, 0x12, 0x5a, 0x5f, 0x15, 0x97, 0xbf, 0xb2, 0x7a,
-	0x51, 0xaa, 0x73, 0xe4, 0x8b, 0x1b, 0xf2, 0xca, 0xaf, 0x33, 0x77, 0x4a, 0x63, 0xa9, 0xba, 0x0f,
-	0x38, 0xf1, 0x6b, 0x19, 0x1a, 0x7b, 0x56, 0xb0, 0xa3, 0xd3, 0xdb, 0x65, 0xd4, 0x97, 0x61, 0x09,
-	0x0e, 0x25, 0xad, 0x4e, 0xe0, 0x9b, 0x04, 0x4c, 0xfc, 0x19, 0x5f, 0xfd, 0x8e, 0x02, 0xb3, 0x31,
-	0xb4, 0x26, 0x21, 0xd3, 0x2b, 0xc4, 0xcd, 0x63, 0x1d, 0x71, 0x4a, 0x2d, 0x49, 0x29, 0xc5, 0x47,
-	0xa3, 0x5a, 0x39, 0x6a, 0xa1, 0xfe, 0x44, 0x81, 0x8a, 0x50, 0x42, 0xf6, 0x8f, 0xbc, 0x6c, 0xb0,
-	0x7f, 0x8c, 0x00, 0x63, 0x91, 0xe1, 0x31, 0x18, 0xe8, 0x2a, 0xe1, 0xea, 0x83, 0x90, 0x7b, 0x69,
-	0xfa, 0xe8, 0x26, 0xd4, 0x19, 0x99, 0x22, 0xd4, 0xa5, 0xc7, 0x3a, 0x51, 0x56, 0xa9, 0xe1, 0x99,
-	0x1c, 0x4b, 0xad, 0xe6, 0x0b, 0x5f, 0x2c, 0xb6, 0xed, 0x9a, 0x98, 0x8e, 0x94, 0x1f, 0xda, 0xcd,
-	0x55, 0xc5, 0xa6, 0xc4, 0x23, 0xb6, 0xb1, 0x61, 0x62, 0x2f, 0x9a, 0x5b, 0xf4, 0x4d, 0x5c, 0x50,
-	0xf6, 0x5b, 0x27, 0x3b, 0x04, 0xae, 0x75, 0x81, 0x81, 0xc8, 0xe6, 0x01, 0x3d, 0x01, 0xd3, 0x66,
-	0x37, 0x76, 0xb5, 0x31, 0xf4, 0x99, 0xcd, 0xae, 0x70, 0xa7, 0x31, 0x86, 0x50, 0x2e, 0x8e, 0xd0,
-	0xb7, 0x06, 0x97, 0xc5, 0x3d, 0x6c, 0x62, 0x27, 0xb0, 0x0c, 0xfb, 0xe8, 0x3c, 0xd9, 0x82, 0x52,
-	0xdf, 0xc7, 0x9e, 0x60, 0x24, 0xa2, 0x6f, 0x52, 0xd6, 0x33, 0x7c, 0x7f, 0xcf, 0xf5, 0x4c, 0x8e,
-	0x65, 0xf4, 0x3d, 0x22, 0x91, 0x95, 0x5d, 0x30, 0x96, 0x27, 0xb2, 0xbe, 0x00, 0x8b, 0x5d, 0xd7,
-	0xb4, 0xb6, 0x2d, 0x59, 0xfe, 0x2b, 0x69, 0x36, 0x1f, 0x16, 0xc7, 0xda, 0x85, 0x57, 0x73, 0x66,
-	0xc5, 0xab, 0x39, 0x3f, 0xc8, 0xc0, 0xe2, 0xbb, 0x3d, 0xf3, 0x0b, 0xa0, 0xc3, 0x12, 0x54, 0x5c,
-	0xdb, 0xdc, 0x88, 0x93, 0x42, 0x04, 0x91, 0x1a, 0x0e, 0xde, 0x8b, 0x6a, 0xb0, 0x70, 0x81, 0x08,
-	0x1a, 0x99, 0xf8, 0x7b, 0x24, 0x7a, 0x15, 0x46, 0xd1, 0xab, 0xfc, 0xd9, 0xab, 0x85, 0x52, 0xa6,
-	0x31, 0xd7, 0xcc, 0xa8, 0x3f, 0x0f, 0x8b, 0x2c, 0x85, 0xe0, 0x01, 0x53, 0x29, 0x5c, 0xa3, 0x79,
-	0x71, 0x8d, 0x3e, 0x84, 0x79, 0xa2, 0xcd, 0xc9, 0xd0, 0xef, 0xfa, 0xd8, 0x9b, 0x50, 0x49, 0x9d,
-	0x86, 0x72, 0x38, 0x5a, 0x98, 0xb2, 0x3d, 0x00, 0xa8, 0x3f, 0x07, 0x73, 0x89, 0xb1, 0x8e, 0x38,
-	0xcb, 0x70, 0x26, 0x0b, 0xe2, 0x4c, 0x96, 0x00, 0x34, 0xd7, 0xc6, 0x6f, 0x38, 0x81, 0x15, 0xec,
-	0x13, 0x2f, 0x41, 0x70, 0xbf, 0xe8, 0x6f, 0x52, 0x83, 0x8c, 0x3b, 0xa2, 0xc6, 0xaf, 0x2a, 0x30,
-	0xc3, 0x24, 0x97, 0x74, 0x75, 0xf4, 0x55, 0x78, 0x11, 0x0a, 0x98, 0x8e, 0xc2, 0x4f, 0x1d, 0xce,
-	0xca, 0x55, 0x75, 0x84, 0xae, 0xc6, 0xab, 0x4b, 0xc5, 0x28, 0x80, 0xe9, 0x35, 0xcf, 0xed, 0x4d,
-	0x86, 0x11, 0xf5, 0x4c, 0x6c, 0x2c, 0xfa, 0x9a, 0x25, 0x02, 0xb8, 0x93, 0xc6, 0x18, 0xff, 0xa8,
-	0xc0, 0xc2, 0xdd, 0x1e, 0xf6, 0x8c, 0x00, 0x13, 0xa2, 0x4d, 0x36, 0xfa, 0x28, 0xd9, 0x8d, 0x61,
-	0x96, 0x8d, 0x63, 0x86, 0x5e, 0x89, 0xdd, 0x27, 0x94, 0xef, 0x47, 0x12, 0x58, 0x0e, 0xee, 0x25,
-	0x84, 0xf3, 0x5a, 0x14, 0xe7, 0xf5, 0x23, 0x05, 0x66, 0x36, 0x31, 0xb1, 0x63, 0x93, 0x4d, 0xe9,
-	0x32, 0xe4, 0x08, 0x96, 0xe3, 0x2e, 0x30, 0xad, 0x8c, 0x2e, 0xc0, 0x8c, 0xe5, 0xb4, 0xed, 0xbe,
-	0x89, 0x75, 0x32, 0x7f, 0x9d, 0xb8, 0x71, 0xdc, 0x79, 0x98, 0xe6, 0x05, 0x64, 0x1a, 0xc4, 0x44,
-	0x4b, 0x79, 0xfc, 0x3e, 0xe3, 0xf1, 0x28, 0x71, 0x8b, 0xa1, 0xa0, 0x1c, 0x06, 0x85, 0xe7, 0x21,
-	0x4f, 0x86, 0x0e, 0x9d, 0x08, 0x79, 0xab, 0x81, 0x98, 0x68, 0xac, 0xb6, 0xfa, 0x0b, 0x0a, 0x20,
-	0x91, 0x6c, 0x93, 0x68, 0x89, 0x97, 0xc4, 0x84, 0x8d, 0xec, 0x48, 0xd4, 0xd9, 0x4c, 0xa3, 0x54,
-	0x0d, 0xf5, 0xd3, 0x68, 0xf5, 0xe8, 0x72, 0x4f, 0xb2, 0x7a, 0x64, 0x5e, 0x23, 0x57, 0x4f, 0x20,
-	0x02, 0xad, 0x2c, 0xae, 0x1e, 0xe5, 0x58, 0xc9, 0xea, 0x11, 0x9c, 0xe9, 0xea, 0x71, 0xfd, 0xde,
-	0x6c, 0x66, 0xc8, 0xa2, 0x31, 0x64, 0xc3, 0x45, 0xa3, 0x23, 0x2b, 0x87, 0x19, 0xf9, 0x79, 0xc8,
-	0x93, 0x11, 0x0f, 0xa6, 0x57, 0xb8, 0x68, 0xb4, 0xb6, 0xb0, 0x68, 0x1c, 0x81, 0x07, 0xbf, 0x68,
-	0x83, 0x99, 0x0e, 0x16, 0x4d, 0x85, 0xea, 0xdd, 0xad, 0x0f, 0x71, 0x3b, 0x18, 0xa1, 0x79, 0xcf,
-	0xc3, 0xf4, 0x86, 0x67, 0xed, 0x5a, 0x36, 0xee, 0x8c, 0x52, 0xe1, 0xdf, 0x51, 0xa0, 0x76, 0xc3,
-	0x33, 0x9c, 0xc0, 0x0d, 0xd5, 0xf8, 0x91, 0xe8, 0x79, 0x0d, 0xca, 0xbd, 0x70, 0x34, 0xce, 0x03,
-	0x8f, 0xcb, 0x23, 0x33, 0x71, 0x9c, 0xb4, 0x41, 0x33, 0xf5, 0x3d, 0x98, 0xa3, 0x98, 0x24, 0xd1,
-	0x7e, 0x15, 0x4a, 0x54, 0x99, 0x5b, 0xfc, 0xa0, 0xa3, 0xb2, 0xaa, 0xca, 0xb7, 0x34, 0xe2, 0x34,
-	0xb4, 0xa8, 0x8d, 0xfa, 0xaf, 0x0a, 0x54, 0x68, 0xd9, 0x60, 0x82, 0x87, 0x97, 0xf2, 0x97, 0xa0,
-	0xe0, 0x52, 0x92, 0x8f, 0x0c, 0xe0, 0x8a, 0xab, 0xa2, 0xf1, 0x06, 0xc4, 0x43, 0x66, 0xbf, 0x44,
-	0x8d, 0x0c, 0x0c, 0xc4, 0x75, 0x72, 0xb1, 0xc3, 0x70, 0xa7, 0x6a, 0x79, 0xbc, 0xf9, 0x85, 0x4d,
-	0xe8, 0x5e, 0x8d, 0xf1, 0x24, 0xad, 0x70, 0x74, 0x11, 0xfe, 0x6a, 0xc2, 0xc6, 0x2e, 0xa5, 0x63,
-	0x21, 0x37, 0xb2, 0x31, 0xcd, 0x4a, 0xf6, 0x6a, 0x31, 0xb4, 0x26, 0xdc, 0xab, 0x45, 0x2c, 0x30,
-	0x6a, 0xaf, 0x26, 0x22, 0x37, 0x60, 0x80, 0x7f, 0x56, 0x60, 0x91, 0xdb, 0xb4, 0x88, 0xb7, 0x8e,
-	0x81, 0x4c, 0xe8, 0x6b, 0xdc, 0xf6, 0x66, 0xa9, 0xed, 0x7d, 0x6a, 0x94, 0xed, 0x8d, 0xf0, 0x3c,
-	0xc0, 0xf8, 0x9e, 0x87, 0xf2, 0x6d, 0xda, 0xf0, 0x8d, 0xfb, 0x01, 0x6a, 0x42, 0x71, 0x17, 0x7b,
-	0xbe, 0xe5, 0x3a, 0x5c, 0xc4, 0xc3, 0xcf, 0x0b, 0xe7, 0xa0, 0x14, 0xde, 0x30, 0x44, 0x45, 0xc8,
-	0x5e, 0xb5, 0xed, 0xc6, 0x14, 0xaa, 0x42, 0x69, 0x9d, 0x5f, 0xa3, 0x6b, 0x28, 0x17, 0x5e, 0x87,
-	0x59, 0x89, 0xdd, 0x47, 0x33, 0x50, 0xbb, 0x6a, 0x52, 0xef, 0xf2, 0x1d, 0x97, 0x00, 0x1b, 0x53,
-	0x68, 0x01, 0x90, 0x86, 0xbb, 0xee, 0x2e, 0xad, 0xf8, 0xa6, 0xe7, 0x76, 0x29, 0x5c, 0xb9, 0xf0,
-	0x34, 0xcc, 0xc9, 0xb0, 0x47, 0x65, 0xc8, 0x53, 0x6a, 0x34, 0xa6, 0x10, 0x40, 0x41, 0xc3, 0xbb,
-	0xee, 0x3d, 0xdc, 0x50, 0x56, 0xff, 0xe2, 0x02, 0xd4, 0x18, 0xee, 0xfc, 0x3e, 0x3c, 0xd2, 0xa1,
-	0x91, 0x7c, 0x12, 0x0c, 0x7d, 0x45, 0x7e, 0x62, 0x2a, 0x7f, 0x39, 0xac, 0x35, 0x8a, 0x99, 0xd4,
-	0x29, 0xf4, 0x3e, 0xd4, 0xe3, 0x8f, 0x68, 0x21, 0x79, 0xf8, 0x58, 0xfa, 0xd2, 0xd6, 0x41, 0x9d,
-	0xeb, 0x50, 0x8b, 0xbd, 0x7f, 0x85, 0xe4, 0x0b, 0x2c, 0x7b, 0x23, 0xab, 0x25, 0xd7, 0x26, 0xe2,
-	0x1b, 0x55, 0x0c, 0xfb, 0xf8, 0x83, 0x34, 0x29, 0xd8, 0x4b, 0x5f, 0xad, 0x39, 0x08, 0x7b, 0x03,
-	0x66, 0x86, 0xde, 0x8b, 0x41, 0x4f, 0xa7, 0x1c, 0x88, 0xc8, 0xdf, 0x95, 0x39, 0x68, 0x88, 0x3d,
-	0x40, 0xc3, 0x6f, 0x3a, 0xa1, 0x15, 0xf9, 0x0a, 0xa4, 0xbd, 0x72, 0xd5, 0xba, 0x34, 0x76, 0xfd,
-	0x88, 0x70, 0xdf, 0x56, 0x60, 0x31, 0xe5, 0x69, 0x11, 0x74, 0x39, 0xed, 0x74, 0x6c, 0xc4, 0x43,
-	0x29, 0xad, 0xe7, 0x0e, 0xd7, 0x28, 0x42, 0xc4, 0x81, 0xe9, 0xc4, 0xcb, 0x1a, 0xe8, 0x62, 0xea,
-	0x75, 0xe0, 0xe1, 0x67, 0x47, 0x5a, 0x5f, 0x19, 0xaf, 0x72, 0x34, 0xde, 0x07, 0x30, 0x9d, 0x78,
-	0x56, 0x22, 0x65, 0x3c, 0xf9, 0xe3, 0x13, 0x07, 0x2d, 0xe8, 0xd7, 0xa1, 0x16, 0x7b, 0xff, 0x21,
-	0x85, 0xe3, 0x65, 0x6f, 0x44, 0x1c, 0xd4, 0xf5, 0x07, 0x50, 0x15, 0x9f, 0x69, 0x40, 0xcb, 0x69,
-	0xb2, 0x34, 0xd4, 0xf1, 0x61, 0x44, 0x69, 0x70, 0xbd, 0x7a, 0x84, 0x28, 0x0d, 0xdd, 0x48, 0x1f,
-	0x5f, 0x94, 0x84, 0xfe, 0x47, 0x8a, 0xd2, 0xa1, 0x87, 0xf8, 0xa6, 0x42, 0x8f, 0xe7, 0x25, 0xd7,
-	0xf7, 0xd1, 0x6a, 0x1a, 0x6f, 0xa6, 0x3f, 0x54, 0xd0, 0xba, 0x7c, 0xa8, 0x36, 0x11, 0x15, 0xef,
-	0x41, 0x3d, 0x7e, 0x49, 0x3d, 0x85, 0x8a, 0xd2, 0x7b, 0xfd, 0xad, 0x8b, 0x63, 0xd5, 0x8d, 0x06,
-	0x7b, 0x17, 0x2a, 0xc2, 0x2b, 0x9f, 0xe8, 0xc9, 0x11, 0x7c, 0x2c, 0x3e, 0x79, 0x79, 0x10, 0x25,
-	0xdf, 0x86, 0x72, 0xf4, 0x38, 0x27, 0x3a, 0x9f, 0xca, 0xbf, 0x87, 0xe9, 0x72, 0x13, 0x60, 0xf0,
-	0xf2, 0x26, 0x7a, 0x42, 0xda, 0xe7, 0xd0, 0xd3, 0x9c, 0x07, 0x75, 0x1a, 0x4d, 0x9f, 0xdd, 0xe2,
-	0x19, 0x35, 0x7d, 0xf1, 0x22, 0xda, 0x41, 0xdd, 0xee, 0x40, 0x2d, 0x76, 0xa1, 0x34, 0x4d, 0x84,
-	0x25, 0x17, 0x7e, 0x5b, 0x17, 0xc6, 0xa9, 0x1a, 0xad, 0xdf, 0x0e, 0xd4, 0x62, 0x97, 0xf9, 0x52,
-	0x46, 0x92, 0x5d, 0x62, 0x4c, 0x19, 0x49, 0x7a, 0x37, 0x50, 0x9d, 0x42, 0xdf, 0x10, 0xee, 0x0d,
-	0xc6, 0x2e, 0x69, 0xa2, 0x67, 0x47, 0xf6, 0x23, 0xbb, 0xac, 0xda, 0x5a, 0x3d, 0x4c, 0x93, 0x08,
-	0x05, 0xce, 0x55, 0x8c, 0xa4, 0xe9, 0x5c, 0x75, 0x98, 0x95, 0xda, 0x84, 0x02, 0xbb, 0x95, 0x87,
-	0xd4, 0x94, 0xab, 0xb9, 0xc2, 0x95, 0xbd, 0xd6, 0x63, 0xd2, 0x3a, 0xf1, 0x7b, 0x6a, 0xac, 0x53,
-	0x76, 0x52, 0x9a, 0xd2, 0x69, 0xec, 0x26, 0xd6, 0xb8, 0x9d, 0x6a, 0x50, 0x60, 0x57, 0x43, 0x52,
-	0x3a, 0x8d, 0xdd, 0x7b, 0x6a, 0x8d, 0xae, 0xc3, 0xf6, 0xbb, 0x53, 0x68, 0x03, 0xf2, 0x34, 0xfc,
-	0x8c, 0xce, 0x8d, 0xba, 0x6e, 0x30, 0xaa, 0xc7, 0xd8, 0x8d, 0x04, 0x75, 0x0a, 0xdd, 0x85, 0x3c,
-	0x0d, 0xe0, 0xa5, 0xf4, 0x28, 0xe6, 0xe1, 0xb7, 0x46, 0x56, 0x09, 0x51, 0x34, 0xa1, 0x2a, 0x66,
-	0xcb, 0xa6, 0x98, 0x2c, 0x49, 0x3e, 0x71, 0x6b, 0x9c, 0x9a, 0xe1, 0x28, 0x4c, 0x8c, 0x06, 0xa1,
-	0xf8, 0x74, 0x31, 0x1a, 0x0a, 0xf3, 0xa7, 0x8b, 0xd1, 0x70, 0x64, 0x5f, 0x9d, 0x42, 0xbf, 0xa8,
-	0x40, 0x33, 0x2d, 0x85, 0x13, 0xa5, 0x7a, 0x40, 0xa3, 0xf2, 0x50, 0x5b, 0xcf, 0x1f, 0xb2, 0x55,
-	0x84, 0xcb, 0xc7, 0x34, 0xee, 0x37, 0x94, 0xb4, 0x79, 0x29, 0xad, 0xbf, 0x94, 0x44, 0xc4, 0xd6,
-	0x33, 0xe3, 0x37, 0x88, 0xc6, 0xde, 0x82, 0x8a, 0x10, 0x73, 0x4c, 0xd1, 0xbc, 0xc3, 0xc1, 0xd2,
-	0x94, 0x55, 0x95, 0x84, 0x2f, 0x19, 0x7b, 0xd3, 0x4c, 0xbf, 0x14, 0x66, 0x14, 0x13, 0x07, 0x53,
-	0xd8, 0x3b, 0x96, 0x28, 0xa8, 0x4e, 0x21, 0x0c, 0x55, 0x31, 0xed, 0x2f, 0x85, 0x1b, 0x25, 0x19,
-	0x83, 0xad, 0xa7, 0xc6, 0xa8, 0x19, 0x0d, 0xa3, 0x03, 0x0c, 0xd2, 0xee, 0x52, 0x6c, 0xdd, 0x50,
-	0xe6, 0x5f, 0xeb, 0xc9, 0x03, 0xeb, 0x89, 0x66, 0x5f, 0x48, 0xa4, 0x4b, 0xa1, 0xfe, 0x70, 0xaa,
-	0xdd, 0x18, 0x7b, 0x91, 0xe1, 0xd4, 0xac, 0x94, 0xbd, 0x48, 0x6a, 0x16, 0x58, 0xeb, 0xd2, 0xd8,
-	0xf5, 0xa3, 0xf9, 0x7c, 0x04, 0x8d, 0x64, 0x2a, 0x5b, 0xca, 0x1e, 0x37, 0x25, 0xb3, 0xae, 0xf5,
-	0xf4, 0x98, 0xb5, 0x45, 0x7b, 0x78, 0x6a, 0x18, 0xa7, 0x9f, 0xb1, 0x82, 0x1d, 0x9a, 0x21, 0x35,
-	0xce, 0xac, 0xc5, 0x64, 0xac, 0x71, 0x66, 0x1d, 0x4b, 0xbd, 0xe2, 0xc6, 0x8b, 0x66, 0x1b, 0xa4,
-	0x19, 0x2f, 0x31, 0xe9, 0x27, 0xc5, 0xce, 0xc4, 0x33, 0x63, 0x98, 0xfb, 0x19, 0xcf, 0x62, 0x40,
-	0x17, 0xc6, 0x4a, 0x75, 0x18, 0xe5, 0x7e, 0xca, 0xd3, 0x22, 0xd8, 0xd6, 0x2d, 0x91, 0xa4, 0x91,
-	0xb2, 0x95, 0x92, 0x67, 0x79, 0xa4, 0x6c, 0xdd, 0x52, 0xf2, 0x3e, 0xa8, 0x60, 0x35, 0x92, 0x11,
-	0xef, 0xd1, 0x67, 0x21, 0xc9, 0x50, 0xe7, 0xc1, 0xc7, 0x15, 0x8d, 0x64, 0x28, 0x39, 0x65, 0x80,
-	0x94, 0x88, 0xf3, 0x18, 0x03, 0x24, 0xa3, 0xb0, 0x29, 0x03, 0xa4, 0x04, 0x6b, 0xc7, 0xf0, 0x5d,
-	0x63, 0xd1, 0xcf, 0x14, 0x53, 0x28, 0x8b, 0x90, 0xa6, 0x98, 0x42, 0x69, 0xe0, 0x96, 0x79, 0xf4,
-	0x83, 0x20, 0x66, 0x8a, 0x96, 0x1b, 0x8a, 0x72, 0x1e, 0x84, 0xfe, 0x5d, 0x28, 0x85, 0x51, 0x48,
-	0xf4, 0x78, 0xaa, 0x8b, 0x78, 0x88, 0x0e, 0x3f, 0x80, 0xe9, 0xc4, 0x09, 0x5e, 0x0a, 0x8b, 0xca,
-	0xa3, 0x90, 0x07, 0xaf, 0x27, 0x0c, 0xe2, 0x55, 0x29, 0x44, 0x18, 0x8a, 0x03, 0xa6, 0xa8, 0xfa,
-	0xe1, 0xc0, 0x97, 0x38, 0x00, 0x41, 0x6c, 0xe4, 0x00, 0x42, 0xa8, 0x6a, 0xe4, 0x00, 0x62, 0x90,
-	0x86, 0x71, 0x64, 0xf2, 0x80, 0x32, 0x85, 0x23, 0x53, 0x4e, 0x8b, 0x0f, 0x22, 0xd1, 0x16, 0x54,
-	0x84, 0x23, 0x6f, 0x34, 0x0a, 0x35, 0xf1, 0xac, 0x3e, 0xc5, 0x55, 0x90, 0x9c, 0x9e, 0xab, 0x53,
-	0xab, 0x7d, 0xa8, 0x6e, 0x78, 0xee, 0xfd, 0xf0, 0x11, 0xd1, 0x2f, 0xc8, 0xd0, 0x5f, 0x69, 0x43,
-	0x9d, 0x55, 0xd0, 0xf1, 0xfd, 0x40, 0x77, 0xb7, 0x3e, 0x44, 0xa7, 0x57, 0xd8, 0xbf, 0xe6, 0x58,
-	0x09, 0xff, 0x35, 0xc7, 0xca, 0x9b, 0x96, 0x8d, 0xef, 0xf2, 0x2c, 0xc8, 0x7f, 0x2f, 0x8e, 0xb8,
-	0xb9, 0x17, 0x1d, 0x59, 0x6b, 0xfc, 0xbf, 0x83, 0xbc, 0x71, 0x3f, 0xb8, 0xbb, 0xf5, 0xe1, 0x35,
-	0xe3, 0xb3, 0x57, 0x8b, 0x90, 0x5f, 0x5d, 0x79, 0x76, 0xe5, 0x19, 0xa8, 0x5b, 0x51, 0xf5, 0x8e,
-	0xd7, 0x6b, 0x5f, 0xab, 0xb0, 0x46, 0x1b, 0xa4, 0x9f, 0x0d, 0xe5, 0x67, 0x2f, 0x77, 0xac, 0x60,
-	0xa7, 0xbf, 0x45, 0x96, 0xe0, 0x12, 0xab, 0xf6, 0xb4, 0xe5, 0xf2, 0x5f, 0x97, 0x2c, 0x27, 0xc0,
-	0x9e, 0x63, 0xd8, 0xec, 0xbf, 0x86, 0x70, 0x68, 0x6f, 0xeb, 0xf7, 0x14, 0x65, 0xab, 0x40, 0x41,
-	0x97, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x23, 0x78, 0x7c, 0x97, 0x64, 0x00, 0x00,
+	// 6244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3d, 0x4b, 0x6c, 0x24, 0xc7,
+	0x75, 0xdb, 0x33, 0x9c, 0xdf, 0x9b, 0x19, 0x72, 0xd8, 0xfc, 0x8d, 0x66, 0xb5, 0x12, 0xb7, 0xa5,
+	0xd5, 0x72, 0xb9, 0x12, 0x57, 0xe2, 0x6a, 0xb5, 0xd2, 0x4a, 0x96, 0xc4, 0x5d, 0xee, 0x87, 0xd0,
+	0x7e, 0xa8, 0xe6, 0x4a, 0x82, 0xe3, 0x08, 0x8d, 0xe6, 0x74, 0x71, 0xd8, 0x62, 0x4f, 0xf7, 0xa8,
+	0xbb, 0x87, 0x5c, 0x2a, 0x40, 0x10, 0xc7, 0x89, 0xe1, 0x20, 0x1f, 0x23, 0x1f, 0xd8, 0xc8, 0xc1,
+	0xf9, 0xc1, 0x97, 0x20, 0x97, 0x18, 0x06, 0x92, 0xc0, 0x49, 0xe0, 0x43, 0x6e, 0x42, 0x9c, 0xc4,
+	0x07, 0x23, 0x0e, 0x9c, 0x4b, 0x0e, 0x09, 0x90, 0x5b, 0x80, 0x04, 0xc8, 0x21, 0x09, 0x62, 0xd4,
+	0xa7, 0xbb, 0xab, 0x7b, 0xaa, 0xe7, 0xc3, 0xd1, 0x6a, 0xb9, 0x3c, 0x4d, 0xbf, 0x7a, 0x55, 0xf5,
+	0xde, 0xab, 0x57, 0xaf, 0x5e, 0xd5, 0x7b, 0x55, 0x84, 0x4a, 0xdb, 0xb4, 0xf6, 0xbb, 0xde, 0x4a,
+	0xc7, 0x75, 0x7c, 0x47, 0x9e, 0xe1, 0xbf, 0x56, 0xe8, 0x47, 0xa3, 0xd2, 0x74, 0xda, 0x6d, 0xc7,
+	0xa6, 0xc0, 0x46, 0xc5, 0x6b, 0xee, 0xa2, 0xb6, 0xce, 0xbe, 0x16, 0x5b, 0x8e, 0xd3, 0xb2, 0xd0,
+	0x05, 0xf2, 0xb5, 0xdd, 0xdd, 0xb9, 0x60, 0x20, 0xaf, 0xe9, 0x9a, 0x1d, 0xdf, 0x71, 0x29, 0x86,
+	0xf2, 0x7b, 0x12, 0xc8, 0xd7, 0x5c, 0xa4, 0xfb, 0x68, 0xcd, 0x32, 0x75, 0x4f, 0x45, 0x1f, 0x77,
+	0x91, 0xe7, 0xcb, 0x2f, 0xc2, 0xc4, 0xb6, 0xee, 0xa1, 0xba, 0xb4, 0x28, 0x2d, 0x95, 0x57, 0x9f,
+	0x5c, 0x89, 0x75, 0xcc, 0x3a, 0xbc, 0xe3, 0xb5, 0xae, 0xea, 0x1e, 0x52, 0x09, 0xa6, 0xbc, 0x00,
+	0x05, 0x63, 0x5b, 0xb3, 0xf5, 0x36, 0xaa, 0x67, 0x16, 0xa5, 0xa5, 0x92, 0x9a, 0x37, 0xb6, 0xef,
+	0xea, 0x6d, 0x24, 0x9f, 0x85, 0xa9, 0xa6, 0x63, 0x59, 0xa8, 0xe9, 0x9b, 0x8e, 0x4d, 0x11, 0xb2,
+	0x04, 0x61, 0x32, 0x02, 0x13, 0xc4, 0x59, 0xc8, 0xe9, 0x98, 0x86, 0xfa, 0x04, 0x29, 0xa6, 0x1f,
+	0x8a, 0x07, 0xb5, 0x75, 0xd7, 0xe9, 0x3c, 0x2c, 0xea, 0xc2, 0x4e, 0xb3, 0x7c, 0xa7, 0xdf, 0x92,
+	0x60, 0x7a, 0xcd, 0xf2, 0x91, 0x7b, 0x4c, 0x85, 0xf2, 0xdf, 0x19, 0x58, 0xa0, 0xa3, 0x76, 0x2d,
+	0x44, 0x7f, 0x94, 0x54, 0xce, 0x43, 0x9e, 0xea, 0x1d, 0x21, 0xb3, 0xa2, 0xb2, 0x2f, 0xf9, 0x14,
+	0x80, 0xb7, 0xab, 0xbb, 0x86, 0xa7, 0xd9, 0xdd, 0x76, 0x3d, 0xb7, 0x28, 0x2d, 0xe5, 0xd4, 0x12,
+	0x85, 0xdc, 0xed, 0xb6, 0x65, 0x15, 0xa6, 0x9b, 0x8e, 0xed, 0x99, 0x9e, 0x8f, 0xec, 0xe6, 0xa1,
+	0x66, 0xa1, 0x7d, 0x64, 0xd5, 0xf3, 0x8b, 0xd2, 0xd2, 0xe4, 0xea, 0x19, 0x21, 0xdd, 0xd7, 0x22,
+	0xec, 0xdb, 0x18, 0x59, 0xad, 0x35, 0x13, 0x10, 0xf9, 0x0c, 0x4c, 0xda, 0xdd, 0xb6, 0xd6, 0xd1,
+	0x5d, 0xdf, 0xc4, 0xf4, 0x79, 0xf5, 0xc2, 0xa2, 0xb4, 0x94, 0x55, 0xab, 0x76, 0xb7, 0xbd, 0x19,
+	0x02, 0x65, 0x05, 0xaa, 0xe6, 0x8e, 0x66, 0x3b, 0xbe, 0x86, 0x1e, 0x98, 0x9e, 0xef, 0xd5, 0x8b,
+	0x8b, 0xd2, 0x52, 0x51, 0x2d, 0x9b, 0x3b, 0x77, 0x1d, 0xff, 0x3a, 0x01, 0x5d, 0x91, 0x3f, 0x7d,
+	0x73, 0xaa, 0x28, 0xd5, 0xa4, 0xfa, 0xff, 0x07, 0x7f, 0x92, 0xf2, 0x57, 0x12, 0xcc, 0x61, 0x7d,
+	0x3c, 0x1e, 0x72, 0x3f, 0x09, 0x25, 0x73, 0x27, 0xe0, 0x60, 0x82, 0x70, 0x50, 0x34, 0x77, 0xe2,
+	0xe4, 0x67, 0x78, 0xf2, 0xff, 0x53, 0x82, 0x79, 0xa2, 0xd8, 0xc7, 0x83, 0x7e, 0x05, 0x2a, 0x11,
+	0x64, 0x63, 0x9d, 0xb0, 0x90, 0x55, 0x63, 0x30, 0x79, 0x0d, 0xa0, 0xe3, 0x3a, 0x1d, 0xe4, 0xfa,
+	0x26, 0xf2, 0xea, 0xb9, 0xc5, 0xec, 0x52, 0x79, 0xf5, 0xb4, 0x90, 0xba, 0x77, 0xd0, 0xe1, 0xfb,
+	0xba, 0xd5, 0x45, 0x9b, 0xba, 0xe9, 0xaa, 0x5c, 0x25, 0xe5, 0x8f, 0x25, 0x98, 0xbd, 0xa5, 0x7b,
+	0xc7, 0x83, 0xe7, 0x53, 0x00, 0xbe, 0xd9, 0x46, 0x9a, 0xe7, 0xeb, 0xed, 0x0e, 0xe1, 0x78, 0x42,
+	0x2d, 0x61, 0xc8, 0x16, 0x06, 0x28, 0x5f, 0x84, 0xca, 0x55, 0xc7, 0xb1, 0x54, 0xe4, 0x75, 0x1c,
+	0xdb, 0x43, 0xf2, 0x45, 0xc8, 0x7b, 0xbe, 0xee, 0x77, 0x3d, 0x46, 0xe4, 0x49, 0x21, 0x91, 0x5b,
+	0x04, 0x45, 0x65, 0xa8, 0xd8, 0x6a, 0xec, 0x63, 0x49, 0x10, 0x1a, 0x8b, 0x2a, 0xfd, 0x50, 0xbe,
+	0x04, 0x93, 0x5b, 0xbe, 0x6b, 0xda, 0xad, 0xcf, 0xb0, 0xf1, 0x52, 0xd0, 0xf8, 0xbf, 0x49, 0xf0,
+	0xc4, 0x3a, 0x59, 0x5d, 0xb6, 0xd1, 0xe3, 0xa3, 0x5c, 0xf1, 0xc1, 0xc8, 0x25, 0x06, 0x23, 0x98,
+	0x42, 0x59, 0x7e, 0x0a, 0xfd, 0x4d, 0x0e, 0x1a, 0x22, 0x46, 0xc7, 0x11, 0xe9, 0x17, 0x42, 0xfb,
+	0x99, 0x21, 0x95, 0x12, 0xd6, 0x8f, 0x96, 0xad, 0x44, 0xbd, 0x6d, 0x11, 0x40, 0x68, 0x66, 0x93,
+	0x9c, 0x66, 0x05, 0x9c, 0xae, 0xc2, 0xdc, 0xbe, 0xe9, 0xfa, 0x5d, 0xdd, 0xd2, 0x9a, 0xbb, 0xba,
+	0x6d, 0x23, 0x8b, 0xc8, 0x0e, 0x9b, 0x8d, 0xec, 0x52, 0x49, 0x9d, 0x61, 0x85, 0xd7, 0x68, 0x19,
+	0x16, 0xa0, 0x27, 0xbf, 0x0c, 0xf3, 0x9d, 0xdd, 0x43, 0xcf, 0x6c, 0xf6, 0x54, 0xca, 0x91, 0x4a,
+	0xb3, 0x41, 0x69, 0xac, 0xd6, 0x79, 0x98, 0x6e, 0x92, 0xb5, 0xc9, 0xd0, 0xb0, 0x24, 0xa9, 0x68,
+	0xf3, 0x44, 0xb4, 0x35, 0x56, 0x70, 0x3f, 0x80, 0x63, 0xb2, 0x02, 0xe4, 0xae, 0xdf, 0xe4, 0x2a,
+	0x14, 0x48, 0x85, 0x19, 0x56, 0xf8, 0x9e, 0xdf, 0x8c, 0xea, 0xc4, 0x57, 0x95, 0x62, 0x72, 0x55,
+	0xa9, 0x43, 0x81, 0xac, 0x92, 0xc8, 0xab, 0x97, 0x08, 0x99, 0xc1, 0xa7, 0xbc, 0x01, 0x53, 0x9e,
+	0xaf, 0xbb, 0xbe, 0xd6, 0x71, 0x3c, 0xb6, 0x38, 0x00, 0xb1, 0x27, 0x8b, 0x69, 0xf6, 0x64, 0x5d,
+	0xf7, 0x75, 0x62, 0x4e, 0x26, 0x49, 0xc5, 0xcd, 0xa0, 0x9e, 0x78, 0xe9, 0x2a, 0x8f, 0xb7, 0x74,
+	0x09, 0x34, 0xbb, 0x22, 0xd4, 0xec, 0xb8, 0x49, 0xac, 0x1e, 0xc5, 0x24, 0xfe, 0xa5, 0x04, 0x73,
+	0xb7, 0x1d, 0xdd, 0x38, 0x1e, 0x53, 0xf5, 0x0c, 0x4c, 0xba, 0xa8, 0x63, 0x99, 0x4d, 0x1d, 0x0f,
+	0xe9, 0x36, 0x72, 0xc9, 0x64, 0xcd, 0xa9, 0x55, 0x06, 0xbd, 0x4b, 0x80, 0x57, 0x0a, 0x9f, 0xbe,
+	0x39, 0x51, 0xcb, 0xd5, 0xb3, 0xca, 0x37, 0x25, 0xa8, 0xab, 0xc8, 0x42, 0xba, 0x77, 0x3c, 0x6c,
+	0x0d, 0xa5, 0x2c, 0x5f, 0xcf, 0x2a, 0xdf, 0xcf, 0xc0, 0xec, 0x4d, 0xe4, 0xe3, 0xf9, 0x6d, 0x7a,
+	0xbe, 0xd9, 0x7c, 0xa4, 0xce, 0xe3, 0x59, 0x98, 0x0a, 0xfd, 0xa0, 0xd8, 0x6c, 0x9f, 0x0c, 0xc1,
+	0x74, 0xca, 0x5e, 0x80, 0x99, 0x56, 0x57, 0x77, 0x75, 0xdb, 0x47, 0x88, 0x9b, 0x83, 0xd4, 0x1e,
+	0xca, 0x61, 0x51, 0x34, 0x05, 0x9f, 0x02, 0xf0, 0x50, 0xab, 0x8d, 0x6c, 0x7f, 0x63, 0xdd, 0xab,
+	0xe7, 0x17, 0xb3, 0x4b, 0x59, 0x95, 0x83, 0xc8, 0x2f, 0xc2, 0xec, 0x81, 0xe9, 0xef, 0x46, 0x6e,
+	0x18, 0xb6, 0xb0, 0x3e, 0xf5, 0xc5, 0x8a, 0xaa, 0x8c, 0xcb, 0x42, 0x67, 0x0c, 0xcb, 0xca, 0xa3,
+	0x12, 0x84, 0x7a, 0x56, 0xf9, 0xb1, 0x04, 0x73, 0x09, 0x09, 0x8e, 0x63, 0x5a, 0x2f, 0x43, 0x8e,
+	0x76, 0x9d, 0x19, 0x76, 0x9a, 0x50, 0x7c, 0xf9, 0x5d, 0x5e, 0x78, 0xb4, 0x89, 0x2c, 0x69, 0x62,
+	0x69, 0x45, 0xb0, 0x0d, 0x5b, 0x89, 0xb1, 0xc3, 0x08, 0x9f, 0xec, 0xf0, 0x40, 0x0f, 0xab, 0xed,
+	0x8c, 0x00, 0x0f, 0xab, 0x7f, 0x7c, 0x9c, 0x08, 0x83, 0x25, 0xb5, 0x1a, 0x1b, 0x26, 0x79, 0x11,
+	0xca, 0x21, 0x60, 0x63, 0x9d, 0x28, 0x45, 0x56, 0xe5, 0x41, 0x11, 0xb3, 0xd9, 0xd1, 0x98, 0xc5,
+	0x1b, 0x9e, 0xa7, 0x6e, 0x22, 0x9f, 0x5b, 0x61, 0x8e, 0x83, 0x02, 0x47, 0x4a, 0xf1, 0x75, 0x09,
+	0x9e, 0x4e, 0xa5, 0xef, 0x51, 0xa8, 0x87, 0xf2, 0x5f, 0x12, 0xcc, 0x6f, 0xed, 0x3a, 0x07, 0x11,
+	0x49, 0x0f, 0x43, 0x52, 0x71, 0xff, 0x24, 0x9b, 0xf0, 0x4f, 0xe4, 0x97, 0x60, 0xc2, 0x3f, 0xec,
+	0x20, 0x62, 0x2d, 0x27, 0x57, 0x4f, 0x09, 0x15, 0x13, 0x13, 0x79, 0xff, 0xb0, 0x83, 0x54, 0x82,
+	0x2a, 0x9f, 0x83, 0x5a, 0x42, 0xf6, 0xc1, 0x6a, 0x3e, 0x15, 0x17, 0x7e, 0xb8, 0x81, 0x98, 0xe0,
+	0xbd, 0x9f, 0xff, 0xc8, 0xc0, 0x42, 0x0f, 0xdb, 0xe3, 0x0c, 0x80, 0x88, 0x9e, 0x8c, 0x90, 0x1e,
+	0x3c, 0x4d, 0x38, 0x54, 0xd3, 0xa0, 0x6a, 0x9e, 0x55, 0xab, 0x11, 0x74, 0xc3, 0xf0, 0xe4, 0x17,
+	0x40, 0xee, 0xf1, 0x3f, 0xa8, 0xe1, 0x9b, 0x50, 0xa7, 0x93, 0x0e, 0x08, 0x71, 0x72, 0x84, 0x1e,
+	0x08, 0x15, 0xcb, 0x84, 0x3a, 0x2b, 0x70, 0x41, 0x3c, 0xf9, 0x25, 0x98, 0x35, 0xed, 0x3b, 0xa8,
+	0xed, 0xb8, 0x87, 0x5a, 0x07, 0xb9, 0x4d, 0x64, 0xfb, 0x7a, 0x0b, 0x05, 0xa6, 0x70, 0x26, 0x28,
+	0xdb, 0x8c, 0x8a, 0xe4, 0x57, 0x60, 0xe1, 0xe3, 0x2e, 0x72, 0x0f, 0x35, 0x0f, 0xb9, 0xfb, 0x66,
+	0x13, 0x69, 0xfa, 0xbe, 0x6e, 0x5a, 0xfa, 0xb6, 0x85, 0xea, 0x85, 0xc5, 0xec, 0x52, 0x51, 0x9d,
+	0x23, 0xc5, 0x5b, 0xb4, 0x74, 0x2d, 0x28, 0x54, 0xbe, 0x2b, 0xc1, 0x3c, 0xdd, 0xec, 0x87, 0xb6,
+	0xe3, 0x11, 0xaf, 0xd5, 0x09, 0x63, 0x35, 0x21, 0x30, 0x56, 0xca, 0x77, 0x24, 0x98, 0xc5, 0x1b,
+	0xe5, 0xc7, 0x89, 0xe6, 0x7f, 0x95, 0xa0, 0x1e, 0xa3, 0x19, 0xbb, 0x7f, 0xc7, 0x9f, 0x6e, 0xec,
+	0xf1, 0x36, 0x1d, 0x7b, 0xc7, 0x74, 0xe9, 0x19, 0x4b, 0x51, 0x0d, 0x3e, 0xf1, 0x5e, 0x6d, 0xc7,
+	0x71, 0x9b, 0x88, 0xf8, 0xdf, 0x45, 0x95, 0x7e, 0x28, 0xbf, 0x8e, 0xf7, 0x6a, 0xbd, 0x7c, 0x8e,
+	0x33, 0x8d, 0x4f, 0x01, 0x18, 0xc8, 0x42, 0x3e, 0xd2, 0x9a, 0xb6, 0xcf, 0x96, 0xa6, 0x12, 0x85,
+	0x5c, 0xb3, 0x7d, 0xf9, 0x49, 0x28, 0x45, 0x6e, 0x05, 0x67, 0xc6, 0x08, 0x40, 0xf9, 0x53, 0x09,
+	0x66, 0x6e, 0xe9, 0xde, 0xe3, 0xa4, 0x2a, 0x3f, 0x61, 0xfe, 0x73, 0x48, 0xf3, 0xe3, 0xe1, 0xe8,
+	0xf5, 0x3a, 0xda, 0x39, 0x81, 0xa3, 0xad, 0xfc, 0x79, 0xe4, 0x5f, 0x3f, 0x5e, 0x0c, 0x2a, 0xdf,
+	0x93, 0xe0, 0xd4, 0x4d, 0xe4, 0x8b, 0xbc, 0xb1, 0xe3, 0xaf, 0x54, 0xbf, 0x41, 0xbd, 0x30, 0x21,
+	0xf1, 0x8f, 0xc4, 0xc9, 0xf9, 0x6e, 0x06, 0xe6, 0xf0, 0x6a, 0x7f, 0x3c, 0x94, 0x60, 0x98, 0x03,
+	0x1d, 0x81, 0xa2, 0xe4, 0x84, 0x33, 0x21, 0x70, 0x9d, 0xf2, 0xc3, 0xbb, 0x4e, 0xcf, 0xc1, 0x14,
+	0xd9, 0xd4, 0xb8, 0xce, 0x81, 0xd6, 0x74, 0xba, 0x76, 0xb8, 0x9f, 0xa9, 0x62, 0xb0, 0x8a, 0x9d,
+	0x22, 0x0c, 0x54, 0x7e, 0x90, 0x81, 0xf9, 0xa4, 0xd4, 0xc6, 0x19, 0x3e, 0x01, 0x4f, 0x19, 0x21,
+	0x4f, 0x0a, 0x54, 0xb8, 0xdd, 0x40, 0xe0, 0x1e, 0xc5, 0x60, 0xc7, 0xd6, 0x3b, 0x7a, 0x02, 0x8a,
+	0xf8, 0xdc, 0xde, 0x75, 0x0e, 0x3c, 0xe2, 0x0e, 0x65, 0xd5, 0x82, 0xdd, 0x6d, 0xab, 0xce, 0x81,
+	0xa7, 0xfc, 0x9a, 0x04, 0xf3, 0xc1, 0x89, 0xdb, 0x16, 0xdd, 0x63, 0x1e, 0x5d, 0x0d, 0x93, 0x4a,
+	0x94, 0x11, 0x28, 0xd1, 0x93, 0x50, 0x0a, 0xf7, 0xb2, 0xec, 0x30, 0x2d, 0x02, 0x28, 0xdf, 0x97,
+	0x60, 0xa1, 0x87, 0x9c, 0x71, 0xc6, 0xb7, 0x0e, 0x05, 0xd3, 0x36, 0xd0, 0x83, 0x90, 0x9a, 0xe0,
+	0x13, 0x97, 0x6c, 0x77, 0x4d, 0xcb, 0x08, 0xc9, 0x08, 0x3e, 0xe5, 0xd3, 0x50, 0x41, 0x36, 0x76,
+	0x0f, 0x35, 0x82, 0xcb, 0x0e, 0xff, 0xcb, 0x14, 0xb6, 0x81, 0x41, 0xb8, 0xf2, 0x8e, 0x89, 0x48,
+	0xe5, 0x1c, 0xad, 0xcc, 0x3e, 0xf1, 0xfa, 0x3f, 0x83, 0x15, 0x94, 0x51, 0xef, 0x3d, 0x5c, 0x69,
+	0x26, 0xb6, 0xad, 0xd9, 0x9e, 0x6d, 0xab, 0xb2, 0x07, 0xb3, 0x71, 0x72, 0xc6, 0x91, 0x66, 0xfc,
+	0x68, 0x22, 0x93, 0x3c, 0x9a, 0x50, 0xfe, 0x22, 0x13, 0x44, 0x3c, 0x89, 0x98, 0x1e, 0x71, 0x28,
+	0x80, 0x0c, 0x09, 0xbf, 0x24, 0x94, 0x08, 0x84, 0x14, 0xaf, 0x43, 0x05, 0x3d, 0xf0, 0x5d, 0x1d,
+	0x9f, 0xa2, 0xe8, 0xed, 0x11, 0x62, 0x1f, 0x65, 0x52, 0x6d, 0x93, 0xd4, 0xc2, 0x9d, 0x10, 0x15,
+	0xa1, 0x9d, 0xe4, 0x69, 0x27, 0x04, 0x42, 0x3a, 0x39, 0x0d, 0x95, 0x6d, 0xcb, 0x69, 0xee, 0x69,
+	0x07, 0xae, 0xe9, 0xa3, 0xc0, 0xa2, 0x95, 0x09, 0xec, 0x03, 0x02, 0xa2, 0xbb, 0xf0, 0x72, 0x3d,
+	0xab, 0x7c, 0x39, 0x03, 0xb3, 0x81, 0xe6, 0x1f, 0x77, 0xe1, 0xc5, 0xd9, 0xce, 0x25, 0xd9, 0x5e,
+	0x81, 0x19, 0x6f, 0xcf, 0xec, 0xd0, 0xd9, 0xa3, 0x75, 0x5c, 0xa7, 0xe5, 0x22, 0xcf, 0x63, 0x6e,
+	0xf2, 0x34, 0x2e, 0x22, 0x0c, 0x6e, 0xb2, 0x02, 0x2a, 0x83, 0x4a, 0x3d, 0xab, 0xfc, 0x28, 0x03,
+	0x35, 0x52, 0xb4, 0xce, 0x42, 0xe9, 0xa6, 0x63, 0x27, 0x3a, 0x93, 0x92, 0x9d, 0xa5, 0x4f, 0xf0,
+	0xd7, 0x20, 0xcf, 0x06, 0x77, 0xe8, 0x13, 0x1b, 0x56, 0x61, 0x10, 0xff, 0x97, 0xe8, 0x9a, 0x4f,
+	0x59, 0x9f, 0x5c, 0x7d, 0x5a, 0xd8, 0x30, 0x61, 0x04, 0xcf, 0x1f, 0x44, 0x57, 0x7c, 0xa2, 0x0e,
+	0x84, 0x36, 0x64, 0x50, 0x53, 0x9c, 0xa7, 0xb3, 0x95, 0xc1, 0xb0, 0x39, 0xc6, 0x1d, 0xfb, 0x8e,
+	0xaf, 0x5b, 0x81, 0xad, 0x26, 0xe6, 0x91, 0x40, 0x48, 0xf1, 0x25, 0x58, 0xa0, 0xb2, 0x20, 0x0d,
+	0x6a, 0x3b, 0xba, 0x69, 0x69, 0x2e, 0xd2, 0x3d, 0xc7, 0x26, 0x47, 0xf5, 0x25, 0x75, 0xd6, 0x0c,
+	0x7b, 0xbd, 0xa1, 0x9b, 0x96, 0x4a, 0xca, 0x94, 0x3f, 0xc2, 0x81, 0xd5, 0xb8, 0x6e, 0x8d, 0x63,
+	0x05, 0xee, 0x83, 0x4c, 0xa9, 0x30, 0xa2, 0x61, 0x0a, 0xfc, 0x9f, 0x33, 0xc2, 0xc5, 0x3e, 0x39,
+	0xa8, 0xea, 0xb4, 0x99, 0x80, 0x78, 0xca, 0x3f, 0x49, 0xf0, 0xe4, 0x4d, 0xe4, 0x13, 0xd4, 0xab,
+	0xd8, 0x12, 0x07, 0xfa, 0xf1, 0xd8, 0x4e, 0x84, 0x48, 0xb1, 0xbf, 0x41, 0x3d, 0x67, 0x11, 0x6f,
+	0xe3, 0x0c, 0x44, 0x52, 0xa1, 0x32, 0x83, 0x14, 0x2a, 0x9b, 0x50, 0x28, 0xe5, 0x87, 0x12, 0xcc,
+	0x06, 0x84, 0x51, 0x5d, 0x7d, 0xfc, 0x85, 0xfd, 0x6d, 0x7a, 0xc8, 0xcd, 0xf3, 0x34, 0x8e, 0x90,
+	0xc3, 0xc9, 0x9e, 0x19, 0x69, 0xb2, 0x3f, 0x0d, 0x65, 0x7e, 0x7a, 0x52, 0x8e, 0x61, 0x27, 0x9a,
+	0x94, 0x3f, 0x90, 0x68, 0xf6, 0xcd, 0xe3, 0x6d, 0xec, 0xa9, 0xd8, 0xab, 0xf5, 0x2c, 0xf6, 0xcc,
+	0xab, 0x1b, 0xb6, 0x87, 0x5c, 0xff, 0x31, 0x38, 0xd5, 0x79, 0x0b, 0xca, 0x84, 0x43, 0x4f, 0x33,
+	0x74, 0x5f, 0x67, 0xab, 0xff, 0x53, 0xc2, 0xc8, 0xf0, 0x0d, 0x8c, 0x47, 0x0e, 0x71, 0xa8, 0x98,
+	0x3c, 0xfc, 0x1b, 0x67, 0x87, 0xec, 0xea, 0xde, 0xae, 0xb6, 0x87, 0x0e, 0xa9, 0xeb, 0x5d, 0x55,
+	0x8b, 0x18, 0xf0, 0x0e, 0x3a, 0x4c, 0xfa, 0xdb, 0xd2, 0x52, 0x35, 0xf4, 0xb7, 0xb1, 0x5a, 0x18,
+	0xc8, 0xe8, 0x76, 0x34, 0xdf, 0xd9, 0x43, 0x81, 0xd5, 0x06, 0x02, 0xba, 0x8f, 0x21, 0x54, 0x9e,
+	0xc5, 0x7a, 0x56, 0xf9, 0xdb, 0x0c, 0x4c, 0xde, 0xe9, 0xfa, 0x3a, 0x8b, 0x80, 0x77, 0x2d, 0xff,
+	0x68, 0xfa, 0xbb, 0x0c, 0x59, 0xea, 0xac, 0xe1, 0x1a, 0x75, 0x21, 0x8b, 0x1b, 0xeb, 0x9e, 0x8a,
+	0x91, 0xf0, 0x58, 0x7b, 0xdd, 0x66, 0x93, 0xf9, 0xbd, 0x59, 0xc2, 0x56, 0x09, 0x43, 0xa8, 0xd7,
+	0x7b, 0x12, 0x4a, 0xc8, 0x75, 0x43, 0xaf, 0x98, 0x30, 0x8d, 0x5c, 0x97, 0x16, 0x2a, 0x50, 0xd1,
+	0x9b, 0x7b, 0xb6, 0x73, 0x60, 0x21, 0xa3, 0x85, 0x0c, 0x76, 0x5a, 0x16, 0x83, 0x51, 0x5d, 0xc2,
+	0x2a, 0x42, 0x4e, 0xb2, 0xe8, 0xfa, 0x57, 0xa2, 0x10, 0x7c, 0x92, 0x15, 0x3f, 0xe8, 0x2a, 0x24,
+	0x0f, 0xba, 0x4e, 0x01, 0x74, 0x3b, 0x61, 0xed, 0x22, 0x2d, 0xa6, 0x90, 0x9e, 0x73, 0xb0, 0x52,
+	0xf2, 0x1c, 0xec, 0x0f, 0x33, 0x50, 0x5d, 0x27, 0x4d, 0x3d, 0x06, 0xea, 0x29, 0xc3, 0x04, 0x7a,
+	0xd0, 0x71, 0xd9, 0x6c, 0x23, 0xbf, 0xfb, 0x6b, 0xdc, 0xeb, 0x50, 0xe9, 0xb8, 0x66, 0x5b, 0x77,
+	0x0f, 0x69, 0x79, 0x61, 0xc0, 0x68, 0x97, 0x19, 0x36, 0xae, 0x4c, 0x55, 0xae, 0x84, 0x43, 0xbf,
+	0x79, 0xa8, 0x6e, 0x21, 0xdd, 0x6d, 0xee, 0x3e, 0x16, 0x07, 0x6e, 0x35, 0xc8, 0x1a, 0x9e, 0xc5,
+	0x84, 0x84, 0x7f, 0xe2, 0xf4, 0x88, 0x8e, 0xa5, 0x37, 0xd1, 0xae, 0x63, 0x19, 0xc8, 0xd5, 0x5a,
+	0xae, 0xd3, 0xa5, 0xe9, 0x11, 0x15, 0xb5, 0xc6, 0x15, 0xdc, 0xc4, 0x70, 0xf9, 0x32, 0x14, 0x0d,
+	0xcf, 0xd2, 0xc8, 0x49, 0x45, 0x81, 0xd8, 0x76, 0x31, 0x7f, 0xeb, 0x9e, 0x45, 0x0e, 0x2a, 0x0a,
+	0x06, 0xfd, 0x21, 0x3f, 0x03, 0x55, 0xa7, 0xeb, 0x77, 0xba, 0xbe, 0x46, 0x0d, 0x42, 0xbd, 0x48,
+	0xc8, 0xab, 0x50, 0x20, 0xb1, 0x17, 0x9e, 0x7c, 0x03, 0xaa, 0x1e, 0x11, 0x65, 0xb0, 0xc3, 0x28,
+	0x0d, 0xeb, 0x84, 0x56, 0x68, 0x3d, 0xb6, 0xc5, 0x38, 0x07, 0x35, 0xdf, 0xd5, 0xf7, 0x91, 0xc5,
+	0xc5, 0x8e, 0x81, 0x28, 0xf7, 0x14, 0x85, 0x47, 0x81, 0xe3, 0x94, 0x48, 0x73, 0x39, 0x35, 0xd2,
+	0x3c, 0x09, 0x19, 0xfb, 0x63, 0x92, 0x07, 0x91, 0x55, 0x33, 0xf6, 0xc7, 0xb2, 0x05, 0xb3, 0x58,
+	0xd5, 0x34, 0x1f, 0xb5, 0x3b, 0x16, 0x76, 0x30, 0x49, 0xfa, 0x51, 0x90, 0x05, 0x71, 0x45, 0x7c,
+	0x8e, 0xc3, 0xeb, 0xcb, 0xca, 0xf5, 0x07, 0x1d, 0xf7, 0x3e, 0xab, 0x4d, 0x38, 0xf2, 0xae, 0xdb,
+	0xbe, 0x7b, 0xa8, 0xca, 0xa8, 0xa7, 0x00, 0xc7, 0x6c, 0xba, 0x1e, 0xd2, 0x0c, 0xb4, 0xa3, 0x77,
+	0x2d, 0x5f, 0xe3, 0x52, 0x36, 0xea, 0x93, 0xc4, 0x76, 0xcc, 0x75, 0x3d, 0xb4, 0x4e, 0x4b, 0xb9,
+	0x0c, 0x8f, 0x86, 0x09, 0x0b, 0x29, 0xdd, 0x60, 0x8d, 0xd8, 0x43, 0x87, 0x6c, 0x93, 0x80, 0x7f,
+	0xca, 0xaf, 0xf2, 0x09, 0x55, 0xe5, 0x55, 0x45, 0x38, 0x23, 0x62, 0x4d, 0xb1, 0xa4, 0xab, 0x2b,
+	0x99, 0x57, 0x25, 0x3a, 0x33, 0x26, 0xeb, 0x59, 0xe5, 0x1d, 0x98, 0xb8, 0x65, 0xfa, 0x44, 0xe5,
+	0xb0, 0x31, 0x95, 0xc8, 0xce, 0x17, 0xff, 0xc4, 0xb6, 0x1e, 0x9f, 0x59, 0x91, 0x65, 0x04, 0xbb,
+	0xc0, 0x15, 0xb5, 0xe0, 0x3a, 0x07, 0x64, 0x8d, 0x20, 0x99, 0x9b, 0x8e, 0x8b, 0xe8, 0x06, 0x24,
+	0xa3, 0xb2, 0x2f, 0xe5, 0xcb, 0xd9, 0x68, 0x9a, 0x61, 0xbb, 0xee, 0x1d, 0xcd, 0xb0, 0xbf, 0x05,
+	0x05, 0x97, 0xd6, 0xef, 0x9b, 0xd9, 0xc4, 0xf7, 0x44, 0x96, 0xb1, 0xa0, 0xd6, 0x48, 0x56, 0x0b,
+	0x3d, 0x40, 0xcd, 0x2e, 0xc1, 0x33, 0xed, 0x1d, 0x27, 0xb0, 0x5a, 0x21, 0x74, 0xc3, 0xde, 0x71,
+	0xe4, 0xf7, 0x80, 0xcf, 0xbb, 0xa1, 0x88, 0x39, 0x42, 0xd9, 0x72, 0x1f, 0xd5, 0xe1, 0x86, 0x16,
+	0xb7, 0x82, 0x43, 0x93, 0x31, 0x00, 0x4e, 0x63, 0x62, 0x33, 0xc9, 0xf4, 0x91, 0xab, 0xfb, 0x8e,
+	0xcb, 0x16, 0x4f, 0xba, 0xdf, 0x9e, 0xa1, 0x85, 0x1b, 0xac, 0x8c, 0xac, 0xa2, 0x78, 0x99, 0xdd,
+	0x76, 0xbb, 0x78, 0x8b, 0x44, 0x22, 0x34, 0x74, 0xe3, 0x0d, 0x04, 0x74, 0x03, 0x43, 0x94, 0x5f,
+	0x95, 0x60, 0x4e, 0xd8, 0x7f, 0xda, 0x2c, 0x92, 0x52, 0x67, 0xd1, 0x1a, 0xe4, 0x69, 0x82, 0x14,
+	0xdb, 0x02, 0x9d, 0x4b, 0x39, 0xef, 0xd4, 0x5d, 0x23, 0xc9, 0x2b, 0xab, 0xa8, 0x7c, 0x55, 0x82,
+	0x59, 0x11, 0x02, 0xf6, 0xf0, 0xf9, 0xe4, 0x30, 0xa6, 0xd9, 0xe5, 0x66, 0x94, 0x13, 0x86, 0xb5,
+	0xcc, 0x76, 0x0c, 0x14, 0xee, 0x7f, 0xd9, 0x97, 0x7c, 0x11, 0x8b, 0x8d, 0x84, 0x3b, 0xc9, 0x51,
+	0x56, 0x32, 0x44, 0x34, 0xcb, 0x15, 0x86, 0xbc, 0x28, 0x7f, 0x26, 0x41, 0xe5, 0x86, 0xd5, 0xf5,
+	0x1e, 0xc6, 0x02, 0x20, 0x8a, 0x46, 0x67, 0xc5, 0xd1, 0xe8, 0x61, 0x97, 0x00, 0x3a, 0x41, 0xa7,
+	0x16, 0xb3, 0xca, 0xff, 0x4c, 0x40, 0x95, 0x11, 0x3e, 0x8e, 0xb3, 0x9f, 0x4a, 0xfc, 0x16, 0x94,
+	0x31, 0x91, 0x9a, 0x87, 0x5a, 0xc1, 0xe9, 0x6f, 0x79, 0x75, 0x55, 0x38, 0xd2, 0x31, 0x32, 0x48,
+	0x62, 0xe1, 0x16, 0xa9, 0x44, 0x2d, 0x21, 0x34, 0x43, 0x80, 0xdc, 0x84, 0xe9, 0x1d, 0x8c, 0xac,
+	0xf1, 0x4d, 0x4f, 0x90, 0xa6, 0x2f, 0x0f, 0xd1, 0x34, 0xf9, 0x4a, 0xb6, 0x3f, 0xb5, 0x13, 0x87,
+	0xca, 0x1f, 0xd2, 0x59, 0xae, 0x79, 0x48, 0x67, 0x6b, 0x08, 0x73, 0x77, 0x2f, 0x0d, 0x4d, 0xbd,
+	0x4e, 0x17, 0x19, 0xda, 0x41, 0xb5, 0xc9, 0xc3, 0x1a, 0x1f, 0xc2, 0x54, 0x82, 0x04, 0x81, 0x15,
+	0x7e, 0x39, 0x6e, 0x85, 0xc5, 0x8e, 0xf6, 0x6d, 0xc7, 0x6e, 0xad, 0xb9, 0xae, 0x7e, 0xc8, 0x59,
+	0xe0, 0xc6, 0x36, 0xcc, 0x8a, 0xd8, 0xfc, 0x4c, 0xfb, 0x78, 0x1b, 0xe4, 0x5e, 0x3e, 0x05, 0x3d,
+	0xc4, 0x92, 0x73, 0xb3, 0x5c, 0x0b, 0xca, 0xef, 0xe7, 0xa0, 0xf2, 0x2e, 0x4e, 0x30, 0x78, 0x94,
+	0x7e, 0x53, 0xe0, 0x34, 0x4e, 0x70, 0x4e, 0x63, 0x8f, 0xab, 0x92, 0x13, 0xb8, 0x2a, 0x82, 0xd9,
+	0x96, 0x17, 0x3a, 0x5c, 0x22, 0x5f, 0xa4, 0x30, 0x92, 0x2f, 0x52, 0x4c, 0xb5, 0xa2, 0xeb, 0x50,
+	0xa1, 0x19, 0x1c, 0xa3, 0xba, 0x4b, 0x65, 0x52, 0x8d, 0x79, 0x4b, 0x7b, 0x29, 0x1e, 0x0c, 0x4d,
+	0x45, 0x7d, 0x4d, 0xa8, 0xf1, 0xfc, 0xc0, 0x7d, 0x56, 0x0e, 0x4c, 0xf9, 0x38, 0x39, 0x30, 0xb5,
+	0x7a, 0x56, 0xf9, 0x17, 0x29, 0xd4, 0xd0, 0xb1, 0x5c, 0x8e, 0xd8, 0xb6, 0x39, 0x33, 0xf2, 0xb6,
+	0x79, 0x68, 0x65, 0x5e, 0x05, 0x9a, 0xb1, 0x13, 0xad, 0xf9, 0xcd, 0xae, 0xeb, 0x39, 0x81, 0x76,
+	0xcf, 0x90, 0xc2, 0x60, 0xcd, 0xbf, 0x46, 0x8a, 0x70, 0x5a, 0x4c, 0xe9, 0x7d, 0xd4, 0xf4, 0x1d,
+	0x17, 0xdb, 0x3d, 0x41, 0x57, 0xd2, 0x10, 0xe7, 0x1f, 0x99, 0xe4, 0xf9, 0xc7, 0x45, 0x28, 0x9a,
+	0x86, 0xa6, 0x63, 0xa3, 0x51, 0xcf, 0x0e, 0xd8, 0x56, 0x15, 0x4c, 0x83, 0x58, 0x97, 0xe1, 0x63,
+	0xea, 0xdf, 0x94, 0xa0, 0x42, 0x69, 0xf6, 0x68, 0xcd, 0xd7, 0xb9, 0xee, 0x24, 0x91, 0x25, 0x63,
+	0x1f, 0x21, 0xa3, 0xb7, 0x4e, 0x44, 0xdd, 0xae, 0x01, 0xe0, 0x81, 0x61, 0xd5, 0xa9, 0xc6, 0x2c,
+	0x0a, 0xa9, 0xa5, 0xd5, 0xc9, 0x20, 0xdd, 0x3a, 0xa1, 0x96, 0x70, 0x2d, 0xd2, 0xc4, 0xd5, 0x02,
+	0xe4, 0x48, 0x6d, 0xe5, 0x7f, 0x25, 0x98, 0xb9, 0xa6, 0x5b, 0xcd, 0x75, 0xd3, 0xf3, 0x75, 0xbb,
+	0x39, 0xc6, 0xb6, 0xf9, 0x0a, 0x14, 0x9c, 0x8e, 0x66, 0xa1, 0x1d, 0x9f, 0x91, 0x74, 0xba, 0x0f,
+	0x47, 0x54, 0x0c, 0x6a, 0xde, 0xe9, 0xdc, 0x46, 0x3b, 0xbe, 0xfc, 0x06, 0x14, 0x9d, 0x8e, 0xe6,
+	0x9a, 0xad, 0x5d, 0xbf, 0x9e, 0x1d, 0xb6, 0x72, 0xc1, 0xe9, 0xa8, 0xb8, 0x06, 0x17, 0x02, 0x98,
+	0x18, 0x31, 0x04, 0xa0, 0xfc, 0xb0, 0x87, 0xfd, 0x31, 0xe6, 0xcd, 0x15, 0x28, 0x9a, 0xb6, 0xaf,
+	0x19, 0xa6, 0x17, 0x88, 0xe0, 0x94, 0x58, 0x87, 0x6c, 0x9f, 0x70, 0x40, 0xc6, 0xd4, 0xf6, 0x71,
+	0xdf, 0xf2, 0xdb, 0x00, 0x3b, 0x96, 0xa3, 0xb3, 0xda, 0x54, 0x06, 0x4f, 0x8b, 0xa7, 0x1c, 0x46,
+	0x0b, 0xea, 0x97, 0x48, 0x25, 0xdc, 0x42, 0x34, 0xa4, 0x7f, 0x2f, 0xc1, 0xdc, 0x26, 0x72, 0xa9,
+	0x21, 0xf2, 0x59, 0x48, 0x90, 0xf8, 0x99, 0xb1, 0xa8, 0xac, 0x94, 0x88, 0xca, 0x7e, 0x36, 0x91,
+	0xc8, 0xd8, 0xa9, 0x18, 0x4d, 0x2f, 0x08, 0x4f, 0xc5, 0x2e, 0xc7, 0x03, 0x2a, 0xe2, 0x61, 0x62,
+	0xf4, 0xf2, 0xa7, 0xac, 0xca, 0x6f, 0xd3, 0xdc, 0x55, 0x21, 0x53, 0x47, 0x57, 0xd8, 0x79, 0x60,
+	0x8b, 0x6f, 0x62, 0x29, 0x7e, 0x0e, 0x12, 0xb6, 0x43, 0x6c, 0xbc, 0x94, 0xdf, 0x95, 0x60, 0x31,
+	0x9d, 0xaa, 0x71, 0xfc, 0xd3, 0xb7, 0x21, 0x87, 0xb7, 0x55, 0xc1, 0x56, 0x43, 0xbc, 0xaf, 0x12,
+	0xf7, 0x4b, 0x2b, 0x2a, 0xff, 0x90, 0x81, 0xda, 0xbb, 0x34, 0x17, 0xf2, 0x73, 0x1f, 0xfe, 0x36,
+	0x6a, 0x6b, 0x9e, 0xf9, 0x09, 0x0a, 0x86, 0xbf, 0x8d, 0xda, 0x5b, 0xe6, 0x27, 0x28, 0xa6, 0x19,
+	0xb9, 0xb8, 0x66, 0x0c, 0x88, 0xb0, 0x72, 0xd1, 0xbf, 0x42, 0x3c, 0xfa, 0x17, 0x6d, 0x8b, 0x8a,
+	0xb1, 0x6d, 0x51, 0xa8, 0x6a, 0xa5, 0xd1, 0x54, 0x0d, 0x77, 0x45, 0x9a, 0x30, 0xa8, 0x37, 0x91,
+	0x55, 0x83, 0x4f, 0x9c, 0x5a, 0xd4, 0xb8, 0x89, 0xfc, 0xa4, 0x54, 0x1f, 0x9d, 0xfe, 0x7d, 0x5d,
+	0x82, 0x93, 0x42, 0x82, 0xc6, 0x51, 0xbd, 0xd7, 0xe3, 0xaa, 0x77, 0x26, 0xdd, 0x97, 0x12, 0x68,
+	0xdd, 0x4b, 0x50, 0x59, 0xef, 0xb6, 0xdb, 0xa1, 0x7f, 0x7c, 0x1a, 0x2a, 0x2e, 0xfd, 0x49, 0xcf,
+	0xdf, 0xd8, 0xbe, 0x96, 0xc1, 0xf0, 0x29, 0x9b, 0x72, 0x1e, 0xaa, 0xac, 0x0a, 0xa3, 0xba, 0x01,
+	0x45, 0x97, 0xfd, 0x66, 0xf8, 0xe1, 0xb7, 0x32, 0x07, 0x33, 0x2a, 0x6a, 0x61, 0xa5, 0x77, 0x6f,
+	0x9b, 0xf6, 0x1e, 0xeb, 0x46, 0xf9, 0x8a, 0x04, 0xb3, 0x71, 0x38, 0x6b, 0xeb, 0x15, 0x28, 0xe8,
+	0x86, 0x41, 0xc2, 0xd2, 0xfd, 0x86, 0x65, 0x8d, 0xe2, 0xa8, 0x01, 0x32, 0x27, 0xb9, 0xcc, 0xd0,
+	0x92, 0x53, 0x34, 0x98, 0xbe, 0x89, 0xfc, 0x3b, 0xc8, 0x77, 0xc7, 0x4a, 0x95, 0xab, 0xe3, 0xf3,
+	0x1e, 0x52, 0x99, 0xa9, 0x45, 0xf0, 0x89, 0x93, 0x78, 0x64, 0xbe, 0x87, 0x71, 0x86, 0x99, 0x97,
+	0x72, 0x26, 0x2e, 0x65, 0x9a, 0x24, 0xde, 0xee, 0x38, 0x36, 0xb2, 0x7d, 0xde, 0x79, 0xab, 0x86,
+	0x50, 0xa2, 0x7e, 0xff, 0x27, 0x81, 0x8c, 0xf3, 0x37, 0xaf, 0xea, 0xd6, 0x78, 0x8e, 0x03, 0x0e,
+	0x47, 0xb8, 0x4d, 0x2d, 0x76, 0xbc, 0x51, 0xf2, 0xdc, 0xe6, 0x5d, 0x3a, 0x95, 0x71, 0x2c, 0xc5,
+	0xf3, 0x59, 0x71, 0x90, 0x91, 0x05, 0x86, 0xe7, 0xd3, 0x72, 0x72, 0x5b, 0xce, 0x43, 0xba, 0x85,
+	0x0c, 0x8d, 0xcb, 0x5a, 0x99, 0x20, 0x68, 0x35, 0x5a, 0xb0, 0x15, 0xc2, 0x05, 0x93, 0x2b, 0x27,
+	0x74, 0x17, 0xf1, 0x46, 0xcd, 0x3d, 0xd4, 0xdc, 0xae, 0xcd, 0x32, 0x1a, 0xf2, 0x86, 0x7b, 0xa8,
+	0x76, 0x59, 0xe4, 0x66, 0xba, 0x9e, 0x53, 0x76, 0x60, 0xe1, 0x8e, 0x6e, 0xe3, 0x0b, 0x7f, 0x4e,
+	0xbb, 0xa3, 0xc7, 0xee, 0x4f, 0x25, 0x4d, 0xa9, 0x24, 0x30, 0xa5, 0x4f, 0xd1, 0x7b, 0x09, 0x74,
+	0x47, 0x45, 0xb8, 0x9e, 0x50, 0x39, 0x08, 0xed, 0xa7, 0x50, 0x97, 0x14, 0x0f, 0xea, 0xbd, 0xfd,
+	0x8c, 0x33, 0xf6, 0x84, 0xba, 0xa0, 0x29, 0xde, 0xd0, 0x47, 0x30, 0xe5, 0x2d, 0x78, 0x82, 0x5c,
+	0x16, 0x09, 0x40, 0xb1, 0xa8, 0x71, 0xb2, 0x01, 0x49, 0xd0, 0xc0, 0x9f, 0x64, 0xa0, 0x21, 0x6a,
+	0x61, 0x1c, 0xc2, 0xaf, 0xc4, 0x63, 0xb4, 0xcf, 0xa6, 0xdc, 0x12, 0x8c, 0xf7, 0xc8, 0xec, 0xfa,
+	0x12, 0x4c, 0xb1, 0x63, 0x4c, 0xbb, 0xb5, 0x69, 0xe9, 0xf6, 0x5d, 0x87, 0xad, 0x5e, 0x49, 0xb0,
+	0xfc, 0x2c, 0x54, 0xf1, 0x30, 0x38, 0x5d, 0x9f, 0xe1, 0xd1, 0x65, 0x2c, 0x0e, 0xc4, 0xed, 0x61,
+	0x7e, 0x2d, 0xe4, 0x23, 0x83, 0xe1, 0xd1, 0x35, 0x2d, 0x09, 0xc6, 0xd2, 0xc2, 0xf1, 0xe0, 0x10,
+	0x8d, 0xc6, 0xc3, 0x62, 0xb0, 0x1e, 0x71, 0x63, 0xb0, 0x37, 0x8a, 0xb8, 0xff, 0x51, 0x82, 0x86,
+	0xa8, 0x85, 0x47, 0x25, 0xee, 0x5b, 0x00, 0x6d, 0xe4, 0xb6, 0xd0, 0x06, 0x59, 0x4b, 0xfa, 0xdd,
+	0xfa, 0x8a, 0x1a, 0xb8, 0x13, 0x54, 0x50, 0xb9, 0xba, 0xca, 0x4d, 0x98, 0x11, 0xa0, 0x60, 0x33,
+	0xe9, 0x39, 0x5d, 0xb7, 0x89, 0x82, 0x63, 0xfa, 0xe0, 0x13, 0x2f, 0xab, 0xbe, 0xee, 0xb6, 0x50,
+	0x90, 0x43, 0xcf, 0xbe, 0x94, 0x57, 0x48, 0x0e, 0x04, 0x39, 0x66, 0x8a, 0x69, 0x73, 0x3c, 0xdb,
+	0x4d, 0xea, 0xc9, 0x76, 0xdb, 0x81, 0xb9, 0x44, 0xbd, 0x31, 0x33, 0x15, 0xc9, 0xd1, 0x1d, 0x32,
+	0xd8, 0xcd, 0xf2, 0xe0, 0x53, 0xf9, 0x06, 0x8e, 0xac, 0xb7, 0x3b, 0x4e, 0x14, 0x59, 0x1f, 0x7a,
+	0x6f, 0xdb, 0x1b, 0x6f, 0xcc, 0x88, 0xe2, 0x8d, 0xcf, 0x40, 0x35, 0x7e, 0x07, 0x99, 0x9e, 0xcb,
+	0x56, 0x9a, 0xfc, 0xdd, 0xe3, 0x93, 0x50, 0xc2, 0x91, 0x0e, 0x6c, 0x99, 0x8d, 0xe0, 0x41, 0x04,
+	0xd7, 0x39, 0xc0, 0xf6, 0xda, 0x20, 0x97, 0x21, 0x4c, 0x2b, 0xcc, 0x08, 0xa6, 0x1f, 0xf2, 0xeb,
+	0x78, 0xe7, 0x47, 0xd3, 0x83, 0xf2, 0xc3, 0x6e, 0xc0, 0x82, 0x1a, 0xfc, 0x89, 0x58, 0x81, 0x77,
+	0x83, 0xa8, 0x01, 0x94, 0xeb, 0x12, 0xbe, 0x74, 0x1f, 0xc8, 0x65, 0xcc, 0x4b, 0xf7, 0xbe, 0xee,
+	0xed, 0x05, 0x09, 0x8d, 0xf4, 0x43, 0x39, 0x4f, 0xb3, 0x48, 0x48, 0xfb, 0x31, 0xb5, 0x90, 0x61,
+	0x02, 0x63, 0xb0, 0xd9, 0x46, 0x7e, 0x2b, 0x7f, 0x97, 0x81, 0xf9, 0x24, 0xf6, 0x38, 0x24, 0xbd,
+	0x12, 0x9f, 0x61, 0xe2, 0x3b, 0xd4, 0x7c, 0x6f, 0x6c, 0x76, 0xb1, 0x31, 0x22, 0x19, 0xd4, 0xcc,
+	0x8c, 0x15, 0x5d, 0x96, 0x3c, 0x8d, 0x05, 0x6a, 0x1a, 0x9a, 0x85, 0xb7, 0x91, 0x74, 0x11, 0xcc,
+	0x9b, 0xc6, 0x6d, 0xbc, 0xc5, 0xbc, 0x1c, 0xb8, 0x76, 0x43, 0x67, 0x41, 0x52, 0x7c, 0x1c, 0x40,
+	0x34, 0x0d, 0x66, 0xb7, 0x32, 0xa6, 0x81, 0xd5, 0x8d, 0x9c, 0x3f, 0x90, 0xd3, 0x19, 0x76, 0xfb,
+	0x8a, 0x24, 0x71, 0x63, 0xe8, 0xbb, 0x01, 0x10, 0x7b, 0x7f, 0x04, 0x8d, 0x25, 0x2a, 0x05, 0xef,
+	0x83, 0x60, 0xd8, 0x06, 0x05, 0x29, 0x3f, 0x0f, 0xf3, 0x98, 0x34, 0xca, 0xe2, 0x7d, 0x3c, 0x20,
+	0x23, 0xeb, 0xfe, 0x30, 0xdb, 0x16, 0x4e, 0xc7, 0xb2, 0xbc, 0x8e, 0x29, 0xbf, 0x29, 0xc1, 0x42,
+	0x0f, 0x01, 0xe3, 0x8c, 0xe8, 0x1a, 0xaf, 0x64, 0xe5, 0xd5, 0xf3, 0x42, 0x93, 0x27, 0x56, 0xa1,
+	0x40, 0x23, 0xff, 0x9a, 0xba, 0x79, 0x2a, 0xbd, 0x4e, 0xf2, 0x90, 0x33, 0x8b, 0x97, 0xa0, 0x46,
+	0x12, 0xf2, 0x49, 0x84, 0x8a, 0xf8, 0x58, 0x34, 0x7d, 0xac, 0xa8, 0x4e, 0x62, 0x38, 0x89, 0x56,
+	0x61, 0x3f, 0x4b, 0x78, 0xd0, 0x36, 0x21, 0xdc, 0x96, 0x7c, 0x4d, 0x82, 0x99, 0x18, 0xfd, 0xe3,
+	0xc8, 0xf3, 0x0d, 0xec, 0xa7, 0xd2, 0x86, 0x98, 0x48, 0x17, 0x85, 0x22, 0x65, 0xbd, 0x91, 0xd5,
+	0x23, 0xac, 0x81, 0x93, 0x0d, 0xcb, 0x5c, 0x09, 0xde, 0x00, 0xb3, 0xb2, 0x68, 0x03, 0x1c, 0x02,
+	0x86, 0x92, 0xd7, 0x33, 0x10, 0xd9, 0x54, 0xee, 0xfe, 0x24, 0x77, 0x41, 0xc0, 0xf0, 0xe4, 0x5b,
+	0x30, 0x49, 0xe5, 0x19, 0x92, 0x2e, 0x3c, 0x97, 0xe2, 0x43, 0x86, 0x8c, 0x4a, 0xb5, 0xea, 0x71,
+	0x5f, 0x34, 0xc5, 0xc8, 0x31, 0x10, 0xe9, 0x29, 0xd7, 0xb3, 0x1d, 0xad, 0xf0, 0x55, 0xb1, 0x4b,
+	0x6f, 0x21, 0xdd, 0x40, 0x6e, 0xc8, 0x5b, 0xf8, 0x8d, 0x7d, 0x68, 0xfa, 0x5b, 0xc3, 0x5b, 0x1c,
+	0xb6, 0x3a, 0x00, 0x05, 0xe1, 0xdd, 0x0f, 0xbe, 0x98, 0x61, 0xb4, 0x63, 0x2f, 0x54, 0x04, 0x4e,
+	0xbf, 0xd1, 0xe6, 0x9e, 0xa6, 0x88, 0x11, 0x34, 0x11, 0x27, 0x68, 0x03, 0xe6, 0xd6, 0x2c, 0xcb,
+	0x89, 0x2e, 0x31, 0x1c, 0x59, 0x73, 0x95, 0x3d, 0x98, 0x4f, 0x36, 0x35, 0x8e, 0x12, 0xc5, 0x52,
+	0x86, 0x32, 0xc9, 0x94, 0xa1, 0x59, 0x90, 0xaf, 0xed, 0xa2, 0xe6, 0xde, 0x2d, 0xa4, 0x5b, 0x7e,
+	0x10, 0x11, 0x55, 0x7e, 0x11, 0x1f, 0x0c, 0xf2, 0xe0, 0x31, 0x09, 0x30, 0x3d, 0xda, 0xd0, 0x21,
+	0x5b, 0xf6, 0x23, 0x00, 0xdd, 0xf1, 0xe9, 0x9e, 0x63, 0x53, 0x6d, 0x2a, 0xa9, 0xc1, 0xa7, 0x32,
+	0x43, 0xb6, 0x94, 0xef, 0x23, 0xd7, 0x8b, 0x36, 0x17, 0x81, 0x7d, 0x08, 0xa1, 0x63, 0x7a, 0x23,
+	0xfb, 0xb4, 0x9d, 0x60, 0xb3, 0xc9, 0x3e, 0xf1, 0xa6, 0xac, 0x65, 0xe2, 0x68, 0x47, 0xbb, 0x6d,
+	0xfa, 0x4c, 0x17, 0x4a, 0x2d, 0x13, 0x7b, 0x98, 0x6d, 0x93, 0x24, 0x69, 0x91, 0x7b, 0x14, 0x24,
+	0xe8, 0x13, 0x64, 0x13, 0x12, 0x08, 0x1e, 0x33, 0xac, 0x8b, 0x3b, 0x48, 0xf7, 0xbb, 0x6e, 0xe8,
+	0x2a, 0x84, 0xdf, 0xca, 0x2f, 0x47, 0x2f, 0x6f, 0xb9, 0xc8, 0x40, 0xb6, 0x6f, 0xea, 0xd6, 0xd1,
+	0x8d, 0x5c, 0x03, 0x8a, 0x5d, 0x0f, 0xb9, 0x9c, 0xd3, 0x13, 0x7e, 0xe3, 0xb2, 0x8e, 0xee, 0x79,
+	0x07, 0x8e, 0x6b, 0x30, 0x0e, 0xc2, 0xef, 0x3e, 0xb7, 0x72, 0xe8, 0x7b, 0x42, 0xe2, 0x5b, 0x39,
+	0xaf, 0xc0, 0x42, 0xdb, 0x31, 0xcc, 0x1d, 0x53, 0x74, 0x99, 0x07, 0x57, 0x9b, 0x0b, 0x8a, 0x63,
+	0xf5, 0x82, 0x7b, 0xe0, 0x33, 0xfc, 0x3d, 0xf0, 0x6f, 0x67, 0x60, 0xe1, 0xbd, 0x8e, 0xf1, 0x39,
+	0xc8, 0x61, 0x11, 0xca, 0x8e, 0x65, 0x6c, 0xc6, 0x45, 0xc1, 0x83, 0x30, 0x86, 0x8d, 0x0e, 0x42,
+	0x0c, 0x3a, 0x9e, 0x3c, 0xa8, 0xef, 0x2d, 0xa6, 0x23, 0xc9, 0x2b, 0xdf, 0x4f, 0x5e, 0xa5, 0x4f,
+	0xdf, 0xcc, 0x17, 0x33, 0xb5, 0xd9, 0x7a, 0x46, 0xf9, 0x39, 0x7c, 0x55, 0xc8, 0x42, 0x0f, 0x5d,
+	0x4a, 0xc1, 0x18, 0xcd, 0xf1, 0x63, 0xf4, 0x11, 0xcc, 0x61, 0xf7, 0x00, 0x77, 0xfd, 0x9e, 0x87,
+	0x5c, 0x6f, 0x6c, 0x33, 0x10, 0xf4, 0x16, 0xdc, 0x3f, 0x8b, 0x00, 0xca, 0xcf, 0xc2, 0x6c, 0xa2,
+	0xaf, 0x23, 0x72, 0x19, 0x70, 0x32, 0xcf, 0x73, 0xb2, 0x08, 0xa0, 0x3a, 0x16, 0xba, 0x6e, 0xfb,
+	0xa6, 0x7f, 0x88, 0x9d, 0x5b, 0xce, 0xa5, 0x22, 0xbf, 0x31, 0x06, 0xee, 0xb7, 0x0f, 0xc6, 0x6f,
+	0x49, 0x30, 0x4d, 0x67, 0x2e, 0x6e, 0xea, 0xe8, 0xa3, 0x70, 0x19, 0xf2, 0x88, 0xf4, 0x52, 0xcf,
+	0x88, 0xe2, 0x1c, 0xec, 0x23, 0x22, 0x57, 0x65, 0xe8, 0xc2, 0x69, 0xe4, 0xc3, 0x14, 0xce, 0xaf,
+	0x1e, 0x8f, 0x22, 0xe2, 0x50, 0x5b, 0x88, 0xdf, 0x3b, 0x15, 0x31, 0xe0, 0x6e, 0x9a, 0x62, 0xfc,
+	0x48, 0x82, 0xf9, 0x7b, 0x1d, 0xe4, 0xea, 0x3e, 0xc2, 0x42, 0x1b, 0xaf, 0xf7, 0x7e, 0x73, 0x37,
+	0x46, 0x59, 0x36, 0x4e, 0x99, 0xfc, 0x46, 0xec, 0xf1, 0x0a, 0xf1, 0xfe, 0x3a, 0x41, 0x65, 0x74,
+	0x19, 0x33, 0xe0, 0x6b, 0x81, 0xe7, 0xeb, 0x7b, 0x12, 0x4c, 0x6f, 0x21, 0xec, 0xef, 0x8c, 0xc7,
+	0xd2, 0x45, 0x98, 0xc0, 0x54, 0x0e, 0x3b, 0xc0, 0x04, 0x59, 0x5e, 0x86, 0x69, 0xd3, 0x6e, 0x5a,
+	0x5d, 0x03, 0x69, 0x98, 0x7f, 0x9a, 0x5a, 0x46, 0xbd, 0xd1, 0x29, 0x56, 0x80, 0xd9, 0xc0, 0xae,
+	0x9c, 0x50, 0xc7, 0x1f, 0x50, 0x1d, 0x0f, 0xd3, 0xa8, 0x29, 0x09, 0xd2, 0x28, 0x24, 0x5c, 0x82,
+	0x1c, 0xee, 0x3a, 0x70, 0x36, 0xc5, 0xb5, 0xa2, 0x69, 0xa2, 0x52, 0x6c, 0xe5, 0x97, 0x24, 0x90,
+	0x79, 0xb1, 0x8d, 0x63, 0x25, 0x5e, 0xe3, 0x13, 0xfe, 0xb2, 0x7d, 0x49, 0xa7, 0x9c, 0x86, 0xa9,
+	0x7e, 0xca, 0x77, 0xc2, 0xd1, 0x23, 0xc3, 0x3d, 0xce, 0xe8, 0x61, 0xbe, 0xfa, 0x8e, 0x1e, 0x27,
+	0x04, 0x82, 0xcc, 0x8f, 0x1e, 0xd1, 0x58, 0xc1, 0xe8, 0x61, 0x9a, 0xc9, 0xe8, 0x31, 0xfb, 0x5e,
+	0xaf, 0x67, 0xf0, 0xa0, 0x51, 0x62, 0x83, 0x41, 0x23, 0x3d, 0x4b, 0xa3, 0xf4, 0x7c, 0x09, 0x72,
+	0xb8, 0xc7, 0xc1, 0xf2, 0x0a, 0x06, 0x8d, 0x60, 0x73, 0x83, 0xc6, 0x08, 0x78, 0xf8, 0x83, 0x16,
+	0x71, 0x1a, 0x0d, 0x9a, 0x02, 0x95, 0x7b, 0xdb, 0x1f, 0xa1, 0xa6, 0xdf, 0xc7, 0xf2, 0x9e, 0x81,
+	0xa9, 0x4d, 0xd7, 0xdc, 0x37, 0x2d, 0xd4, 0xea, 0x67, 0xc2, 0xbf, 0x26, 0x41, 0xf5, 0xa6, 0xab,
+	0xdb, 0xbe, 0x13, 0x98, 0xf1, 0x23, 0xc9, 0xf3, 0x2a, 0x94, 0x3a, 0x41, 0x6f, 0x4c, 0x07, 0x9e,
+	0x15, 0x87, 0x20, 0xe3, 0x34, 0xa9, 0x51, 0x35, 0xe5, 0x7d, 0x98, 0x25, 0x94, 0x24, 0xc9, 0x7e,
+	0x13, 0x8a, 0xc4, 0x98, 0x9b, 0xec, 0xe0, 0xae, 0x27, 0xd7, 0x85, 0x7d, 0xc4, 0xd8, 0x50, 0xc3,
+	0x3a, 0xca, 0x3f, 0x4b, 0x50, 0x26, 0x65, 0x11, 0x83, 0xa3, 0xcf, 0xf2, 0xd7, 0x20, 0xef, 0x10,
+	0x91, 0xf7, 0xcd, 0x54, 0xe0, 0x47, 0x45, 0x65, 0x15, 0xf0, 0x4e, 0x8a, 0xfe, 0xe2, 0x2d, 0x32,
+	0x50, 0x10, 0xb3, 0xc9, 0x85, 0x16, 0xa5, 0x9d, 0x98, 0xe5, 0xe1, 0xf8, 0x0b, 0xaa, 0x28, 0xbf,
+	0x13, 0xea, 0x24, 0x41, 0x38, 0xfa, 0x14, 0x7e, 0x35, 0xb1, 0xc6, 0x2e, 0xa6, 0x53, 0x21, 0x5e,
+	0x64, 0x63, 0x96, 0x15, 0xef, 0xe9, 0x63, 0x64, 0x8d, 0xb9, 0xa7, 0x0f, 0x55, 0xa0, 0xdf, 0x9e,
+	0x9e, 0x27, 0x2e, 0x52, 0x80, 0x1f, 0x4b, 0xb0, 0xc0, 0xd6, 0xb4, 0x50, 0xb7, 0x1e, 0x81, 0x98,
+	0xe4, 0x2f, 0xb0, 0xb5, 0x37, 0x4b, 0xd6, 0xde, 0x73, 0xfd, 0xd6, 0xde, 0x90, 0xce, 0x01, 0x8b,
+	0xef, 0x3e, 0xcc, 0x51, 0xf7, 0x6a, 0x5d, 0xf7, 0x75, 0x4c, 0xde, 0x67, 0x9f, 0x67, 0x18, 0xf4,
+	0xfb, 0x44, 0xdc, 0x85, 0x9a, 0xc1, 0x2e, 0xd4, 0xc3, 0xef, 0xb5, 0xc1, 0xf7, 0xca, 0xfc, 0xdd,
+	0xa0, 0xd7, 0xf1, 0xfd, 0xdd, 0x93, 0x7c, 0xeb, 0xdf, 0x92, 0x60, 0x2e, 0xd1, 0xfc, 0x38, 0x3a,
+	0xfb, 0x04, 0x14, 0x19, 0x67, 0x81, 0xe7, 0x5e, 0xa0, 0xac, 0xf5, 0x7b, 0xdf, 0x21, 0x9b, 0xfe,
+	0xbe, 0x83, 0x72, 0x06, 0x4a, 0x77, 0x48, 0xb7, 0xd7, 0x1f, 0xf8, 0xfc, 0x36, 0x5c, 0x8a, 0x6d,
+	0xc3, 0x97, 0x4f, 0x43, 0x31, 0x78, 0x42, 0x43, 0x2e, 0x40, 0x76, 0xcd, 0xb2, 0x6a, 0x27, 0xe4,
+	0x0a, 0x14, 0x37, 0xd8, 0xfb, 0x0f, 0x35, 0x69, 0xf9, 0x6d, 0x98, 0x11, 0xf8, 0x78, 0xf2, 0x34,
+	0x54, 0xd7, 0x0c, 0xb2, 0x93, 0xb8, 0xef, 0x60, 0x60, 0xed, 0x84, 0x3c, 0x0f, 0xb2, 0x8a, 0xda,
+	0xce, 0x3e, 0x41, 0xbc, 0xe1, 0x3a, 0x6d, 0x02, 0x97, 0x96, 0x5f, 0x80, 0x59, 0x91, 0xa6, 0xca,
+	0x25, 0xc8, 0x11, 0xcd, 0xaf, 0x9d, 0x90, 0x01, 0xf2, 0x2a, 0xda, 0x77, 0xf6, 0x50, 0x4d, 0x5a,
+	0xfd, 0xc9, 0x8b, 0x50, 0xa5, 0xb4, 0xb3, 0x87, 0xb6, 0x64, 0x0d, 0x6a, 0xc9, 0xb7, 0xb4, 0xe5,
+	0xe7, 0xc5, 0xd1, 0x1e, 0xf1, 0x93, 0xdb, 0x8d, 0x7e, 0x83, 0xa0, 0x9c, 0x90, 0xbf, 0x04, 0x93,
+	0xf1, 0x27, 0xa3, 0x65, 0x71, 0x4e, 0x8c, 0xf0, 0x5d, 0xe9, 0x41, 0x8d, 0x6b, 0x50, 0x8d, 0x3d,
+	0x6d, 0x2c, 0x8b, 0x27, 0xb3, 0xe8, 0xf9, 0xe3, 0x86, 0x78, 0xe5, 0xe0, 0x9f, 0x1f, 0xa6, 0xd4,
+	0xc7, 0x1f, 0x0a, 0x4d, 0xa1, 0x5e, 0xf8, 0x9a, 0xe8, 0x20, 0xea, 0x75, 0x98, 0xee, 0x79, 0xc7,
+	0x53, 0x7e, 0x21, 0xe5, 0x90, 0x54, 0xfc, 0xde, 0xe7, 0xa0, 0x2e, 0x0e, 0x40, 0xee, 0x7d, 0xae,
+	0x57, 0x5e, 0x11, 0x8f, 0x40, 0xda, 0x03, 0xc6, 0x8d, 0x0b, 0x43, 0xe3, 0x87, 0x82, 0xfb, 0xaa,
+	0x04, 0x0b, 0x29, 0x6f, 0x16, 0xca, 0x17, 0xd3, 0x8e, 0xd6, 0xfb, 0xbc, 0xc0, 0xd8, 0x78, 0x79,
+	0xb4, 0x4a, 0x21, 0x21, 0x36, 0x4c, 0x25, 0x9e, 0xec, 0x93, 0xcf, 0xa7, 0xbe, 0x77, 0xd3, 0xfb,
+	0x9e, 0x61, 0xe3, 0xf9, 0xe1, 0x90, 0xc3, 0xfe, 0x3e, 0x84, 0xa9, 0xc4, 0x1b, 0xe3, 0x29, 0xfd,
+	0x89, 0x5f, 0x22, 0x1f, 0x34, 0xa0, 0x38, 0x9b, 0x3f, 0xfe, 0x1c, 0x5e, 0x4a, 0xf3, 0xe2, 0x47,
+	0xf3, 0x06, 0x35, 0xff, 0x45, 0xa8, 0xc6, 0xde, 0x46, 0x4b, 0x99, 0x50, 0xa2, 0xb7, 0xed, 0x06,
+	0x35, 0xed, 0xc3, 0x74, 0xcf, 0xb3, 0x6b, 0x29, 0xda, 0x9e, 0xf6, 0x0c, 0x5d, 0x63, 0x65, 0x58,
+	0x74, 0x6e, 0x38, 0x2a, 0xfc, 0xe3, 0x6a, 0xf2, 0x52, 0x9a, 0x81, 0xe8, 0x61, 0x67, 0x14, 0xfb,
+	0xb0, 0x19, 0xbd, 0xad, 0x9f, 0x6e, 0x1f, 0x7a, 0xde, 0x91, 0x1a, 0xde, 0x3e, 0x70, 0xed, 0xf7,
+	0xb5, 0x0f, 0x23, 0x77, 0xf1, 0x15, 0x89, 0x84, 0x45, 0x45, 0xef, 0xb2, 0xae, 0xa6, 0x4d, 0xb8,
+	0xf4, 0xe7, 0xc5, 0x1a, 0x17, 0x47, 0xaa, 0x13, 0x4a, 0x71, 0x0f, 0x26, 0xe3, 0x4f, 0x46, 0xa5,
+	0x48, 0x51, 0xf8, 0x1a, 0x57, 0xe3, 0xfc, 0x50, 0xb8, 0x61, 0x67, 0xef, 0x41, 0x99, 0xfb, 0x9f,
+	0x1f, 0xf2, 0xd9, 0x3e, 0xb3, 0x87, 0xff, 0x07, 0x18, 0x83, 0x24, 0xf9, 0x2e, 0x94, 0xc2, 0x7f,
+	0xd5, 0x21, 0x9f, 0x49, 0xd5, 0xd3, 0x51, 0x9a, 0xdc, 0x02, 0x88, 0xfe, 0x0f, 0x87, 0xfc, 0x5c,
+	0xba, 0x15, 0x19, 0xa5, 0xd1, 0x90, 0x7d, 0x7a, 0x29, 0xbc, 0x1f, 0xfb, 0xfc, 0xc3, 0x07, 0x83,
+	0x9a, 0xdd, 0x85, 0x6a, 0xb0, 0x1e, 0xd0, 0x86, 0xcf, 0xf5, 0x5d, 0x33, 0x62, 0x4d, 0x2f, 0x0f,
+	0x83, 0x1a, 0x8e, 0xdf, 0x2e, 0x54, 0x63, 0x8f, 0x47, 0xa4, 0xf4, 0x24, 0x7a, 0x34, 0xa3, 0xb1,
+	0x3c, 0x0c, 0x6a, 0xd8, 0xd3, 0x2f, 0x70, 0xef, 0x54, 0xc4, 0x1e, 0x05, 0x91, 0x5f, 0xea, 0xdb,
+	0x8e, 0xe8, 0x71, 0x94, 0xc6, 0xea, 0x28, 0x55, 0x42, 0x12, 0x98, 0x56, 0x51, 0x91, 0xa6, 0x6b,
+	0xd5, 0x28, 0x23, 0xb5, 0x05, 0x79, 0xfa, 0x0a, 0x84, 0xac, 0xa4, 0x3c, 0x05, 0xc3, 0x3d, 0x11,
+	0xd1, 0x78, 0x46, 0x88, 0x13, 0x7f, 0xf6, 0x80, 0x36, 0x4a, 0x8f, 0xfa, 0x53, 0x1a, 0x8d, 0x5d,
+	0xec, 0x1f, 0xb6, 0x51, 0x15, 0xf2, 0xf4, 0x06, 0x68, 0x4a, 0xa3, 0xb1, 0x9b, 0xcd, 0x8d, 0xfe,
+	0x38, 0xf4, 0xc0, 0xe6, 0x84, 0xbc, 0x09, 0x39, 0x92, 0x0f, 0x24, 0x9f, 0xee, 0x77, 0xb9, 0xae,
+	0x5f, 0x8b, 0xb1, 0xfb, 0x77, 0xca, 0x09, 0xf9, 0x1e, 0xe4, 0x48, 0xe2, 0x44, 0x4a, 0x8b, 0xfc,
+	0xe5, 0xa5, 0x46, 0x5f, 0x94, 0x80, 0x44, 0x03, 0x2a, 0xfc, 0xbd, 0x86, 0x94, 0x25, 0x4b, 0x70,
+	0xf3, 0xa3, 0x31, 0x0c, 0x66, 0xd0, 0x0b, 0x9d, 0x46, 0x51, 0x6e, 0x54, 0xfa, 0x34, 0xea, 0xc9,
+	0xbb, 0x6a, 0x2c, 0x0f, 0x83, 0x1a, 0x0a, 0xe8, 0x57, 0x24, 0xa8, 0xa7, 0x25, 0xdb, 0xcb, 0xa9,
+	0x6e, 0x5d, 0xbf, 0x1b, 0x03, 0x8d, 0x4b, 0x23, 0xd6, 0x0a, 0x69, 0xf9, 0x84, 0x24, 0x38, 0xf4,
+	0xa4, 0xd7, 0x5f, 0x48, 0x6b, 0x2f, 0x25, 0x65, 0xbc, 0xf1, 0xe2, 0xf0, 0x15, 0xc2, 0xbe, 0xb7,
+	0xa1, 0xcc, 0x25, 0x57, 0xa4, 0x58, 0xde, 0xde, 0xf4, 0x91, 0xc6, 0xd2, 0x60, 0x44, 0x7e, 0x25,
+	0x8d, 0x87, 0xdf, 0x53, 0x56, 0x52, 0x61, 0xb8, 0xbf, 0x71, 0x7e, 0x28, 0x5c, 0x9e, 0x21, 0x2e,
+	0xce, 0x9e, 0xb6, 0x94, 0xf4, 0x04, 0xe8, 0x1b, 0x4b, 0x83, 0x11, 0xc3, 0x3e, 0x34, 0x80, 0x28,
+	0x62, 0x9e, 0xb2, 0x06, 0xf6, 0x04, 0xda, 0x1b, 0x67, 0x07, 0xe2, 0x85, 0x1d, 0x6c, 0x42, 0x8e,
+	0x64, 0xb1, 0xa7, 0x4c, 0x5f, 0x3e, 0x29, 0xbe, 0xa1, 0xf4, 0x43, 0x09, 0x5b, 0x44, 0x50, 0xe1,
+	0x53, 0xda, 0x53, 0xe6, 0xaf, 0x20, 0x1b, 0xbe, 0x71, 0x6e, 0x08, 0xcc, 0x84, 0x64, 0x58, 0x4a,
+	0x79, 0xba, 0x64, 0xe2, 0x59, 0xed, 0x8d, 0xb3, 0x03, 0xf1, 0x78, 0x47, 0x89, 0x4b, 0x12, 0x4f,
+	0x19, 0xde, 0xde, 0x34, 0xf2, 0x21, 0xb6, 0xa4, 0xbd, 0xd9, 0xc5, 0x29, 0x5b, 0xd2, 0xd4, 0x44,
+	0xe6, 0xc6, 0x85, 0xa1, 0xf1, 0x43, 0x7e, 0x3e, 0x86, 0x5a, 0x32, 0x1b, 0x3b, 0xe5, 0xa8, 0x23,
+	0x25, 0x39, 0xbc, 0xf1, 0xc2, 0x90, 0xd8, 0xbc, 0x07, 0x71, 0xb2, 0x97, 0xa6, 0x0f, 0xf0, 0x3f,
+	0x80, 0xb0, 0x74, 0xdb, 0x1b, 0x86, 0x6b, 0x3e, 0x9f, 0xb8, 0x71, 0x61, 0x68, 0xfc, 0x90, 0x04,
+	0xbc, 0xdc, 0x93, 0x8c, 0xb5, 0xb4, 0xe5, 0x9e, 0xcf, 0x5b, 0x6d, 0x3c, 0xd3, 0x17, 0x87, 0x37,
+	0x33, 0xf1, 0x4c, 0x38, 0x79, 0x79, 0xa8, 0x74, 0xb9, 0x7e, 0x66, 0x46, 0x9c, 0x5a, 0x47, 0x77,
+	0xf0, 0x89, 0x44, 0xbf, 0x94, 0x2d, 0xaf, 0x38, 0x1f, 0xb1, 0xf1, 0xfc, 0x70, 0xc8, 0xdc, 0xc4,
+	0xaa, 0x25, 0x93, 0x5c, 0xfa, 0x1f, 0x89, 0x25, 0xb3, 0x1b, 0x06, 0x9f, 0x5a, 0xd5, 0x92, 0xd9,
+	0x23, 0x29, 0x1d, 0xa4, 0x24, 0x99, 0x0c, 0xd1, 0x41, 0x32, 0xf1, 0x22, 0xa5, 0x83, 0x94, 0xfc,
+	0x8c, 0x21, 0xbc, 0xfd, 0x58, 0xc2, 0x43, 0x8a, 0xf3, 0x20, 0x4a, 0x8a, 0x68, 0x2c, 0x0f, 0x83,
+	0xca, 0xa9, 0x2f, 0x44, 0x79, 0x0b, 0x29, 0x56, 0xae, 0x27, 0xb1, 0x61, 0x10, 0xf9, 0xf7, 0xa0,
+	0x18, 0x24, 0x1e, 0xc8, 0xcf, 0xa6, 0x3a, 0xd5, 0x23, 0x34, 0xf8, 0x21, 0x4c, 0x25, 0x0e, 0x72,
+	0x53, 0x54, 0x54, 0x9c, 0x78, 0x30, 0x78, 0x3c, 0x21, 0x0a, 0x51, 0xa7, 0x08, 0xa1, 0x27, 0xf4,
+	0xdf, 0x38, 0x3b, 0x10, 0x8f, 0x5f, 0x4b, 0xa2, 0x70, 0x6a, 0xdf, 0x0e, 0xb8, 0xe8, 0x74, 0xe3,
+	0xec, 0x40, 0x3c, 0x7e, 0x4e, 0x25, 0xcf, 0xa9, 0x53, 0x34, 0x32, 0x25, 0x40, 0x34, 0x48, 0x44,
+	0xdb, 0x50, 0xe6, 0xa2, 0x5c, 0x72, 0x3f, 0xd2, 0xf8, 0xf0, 0x5c, 0x63, 0x69, 0x30, 0x22, 0x7f,
+	0xd8, 0x13, 0x0f, 0xf2, 0xa4, 0x58, 0x3d, 0x61, 0x24, 0x68, 0x10, 0x03, 0x1f, 0x40, 0x85, 0x8f,
+	0xe4, 0xa4, 0x78, 0x0d, 0x82, 0x60, 0xcf, 0x90, 0x73, 0x35, 0xa8, 0xd5, 0x6f, 0xae, 0x26, 0x03,
+	0x3a, 0x8d, 0xe5, 0x61, 0x50, 0x03, 0xf9, 0xac, 0x76, 0xa1, 0xb2, 0xe9, 0x3a, 0x0f, 0x82, 0x7f,
+	0xe2, 0xf1, 0x39, 0x39, 0x42, 0x57, 0x9a, 0x30, 0x49, 0x11, 0x34, 0xf4, 0xc0, 0xd7, 0x9c, 0xed,
+	0x8f, 0xe4, 0x27, 0x57, 0xe8, 0xbf, 0x7e, 0x5d, 0x09, 0xfe, 0xf5, 0xeb, 0xca, 0x0d, 0xd3, 0x42,
+	0xf7, 0xd8, 0x45, 0x87, 0x7f, 0x2f, 0xf4, 0xb9, 0xb5, 0x1f, 0x46, 0x76, 0x54, 0xf6, 0xdf, 0x67,
+	0xaf, 0x3f, 0xf0, 0xef, 0x6d, 0x7f, 0x74, 0x55, 0xff, 0xf4, 0xcd, 0x02, 0xe4, 0x56, 0x57, 0x5e,
+	0x5a, 0x79, 0x11, 0x26, 0xcd, 0x10, 0xbd, 0xe5, 0x76, 0x9a, 0x57, 0xcb, 0xb4, 0xd2, 0x26, 0x6e,
+	0x67, 0x53, 0xfa, 0x99, 0x8b, 0x2d, 0xd3, 0xdf, 0xed, 0x6e, 0xe3, 0x81, 0xb8, 0x40, 0xd1, 0x5e,
+	0x30, 0x1d, 0xf6, 0xeb, 0x82, 0x69, 0xfb, 0xc8, 0xb5, 0x75, 0x8b, 0xfe, 0x57, 0x5a, 0x06, 0xed,
+	0x6c, 0xff, 0x81, 0x24, 0x6d, 0xe7, 0x09, 0xe8, 0xe2, 0x4f, 0x07, 0x00, 0x78, 0xfc, 0x2f, 0x89,
+	0xf7, 0x76, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7436,8 +8662,10 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropPartitionData(ctx context.Context, in *DropPartitionDataRequest, opts ...grpc.CallOption) (*DropPartitionDataResponse, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -7461,6 +8689,9 @@ type MilvusServiceClient interface {
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
 	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
+	AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error)
+	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
@@ -7487,6 +8718,9 @@ type MilvusServiceClient interface {
 	SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error)
 	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -7569,6 +8803,15 @@ func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowColle
 	return out, nil
 }
 
+func (c *milvusServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
@@ -7587,6 +8830,15 @@ func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartiti
 	return out, nil
 }
 
+func (c *milvusServiceClient) DropPartitionData(ctx context.Context, in *DropPartitionDataRequest, opts ...grpc.CallOption) (*DropPartitionDataResponse, error) {
+	out := new(DropPartitionDataResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartitionData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
 	out := new(BoolResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
@@ -7794,6 +9046,33 @@ func (c *milvusServiceClient) GetReplicas(ctx context.Context, in *GetReplicasRe
 	return out, nil
 }
 
+func (c *milvusServiceClient) AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error) {
+	out := new(AllocTimestampResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AllocTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error) {
+	out := new(CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
 	out := new(DummyResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
@@ -7983,6 +9262,33 @@ func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRe
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -7993,8 +9299,10 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
+	DropPartitionData(context.Context, *DropPartitionDataRequest) (*DropPartitionDataResponse, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
 	LoadPartitions(context.Context, *LoadPartitionsRequest) (*commonpb.Status, error)
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
@@ -8018,6 +9326,9 @@ type MilvusServiceServer interface {
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
 	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
+	AllocTimestamp(context.Context, *AllocTimestampRequest) (*AllocTimestampResponse, error)
+	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
@@ -8044,6 +9355,9 @@ type MilvusServiceServer interface {
 	SelectUser(context.Context, *SelectUserRequest) (*SelectUserResponse, error)
 	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -8074,12 +9388,18 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AlterCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
 func (*UnimplementedMilvusServiceServer) DropPartition(ctx context.Context, req *DropPartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropPartition not implemented")
 }
+func (*UnimplementedMilvusServiceServer) DropPartitionData(ctx context.Context, req *DropPartitionDataRequest) (*DropPartitionDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropPartitionData not implemented")
+}
 func (*UnimplementedMilvusServiceServer) HasPartition(ctx context.Context, req *HasPartitionRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HasPartition not implemented")
 }
@@ -8149,6 +9469,15 @@ func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context
 func (*UnimplementedMilvusServiceServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AllocTimestamp(ctx context.Context, req *AllocTimestampRequest) (*AllocTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocTimestamp not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CheckHealth(ctx context.Context, req *CheckHealthRequest) (*CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetVersion(ctx context.Context, req *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
 }
@@ -8212,6 +9541,15 @@ func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -8361,6 +9699,24 @@ func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -8397,6 +9753,24 @@ func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_DropPartitionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropPartitionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropPartitionData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartitionData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropPartitionData(ctx, req.(*DropPartitionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HasPartitionRequest)
 	if err := dec(in); err != nil {
@@ -8811,6 +10185,60 @@ func _MilvusService_GetReplicas_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_AllocTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AllocTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AllocTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AllocTimestamp(ctx, req.(*AllocTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CheckHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CheckHealth(ctx, req.(*CheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DummyRequest)
 	if err := dec(in); err != nil {
@@ -9189,6 +10617,60 @@ func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -9225,6 +10707,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _MilvusService_AlterCollection_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -9233,6 +10719,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropPartition",
 			Handler:    _MilvusService_DropPartition_Handler,
 		},
+		{
+			MethodName: "DropPartitionData",
+			Handler:    _MilvusService_DropPartitionData_Handler,
+		},
 		{
 			MethodName: "HasPartition",
 			Handler:    _MilvusService_HasPartition_Handler,
@@ -9325,6 +10815,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplicas",
 			Handler:    _MilvusService_GetReplicas_Handler,
 		},
+		{
+			MethodName: "AllocTimestamp",
+			Handler:    _MilvusService_AllocTimestamp_Handler,
+		},
+		{
+			MethodName: "CheckHealth",
+			Handler:    _MilvusService_CheckHealth_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _MilvusService_GetVersion_Handler,
+		},
 		{
 			MethodName: "Dummy",
 			Handler:    _MilvusService_Dummy_Handler,
@@ -9409,6 +10911,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SelectGrant",
 			Handler:    _MilvusService_SelectGrant_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
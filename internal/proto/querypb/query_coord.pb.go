@@ -2177,6 +2177,7 @@ type LoadBalanceRequest struct {
 	DstNodeIDs           []int64           `protobuf:"varint,4,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
 	SealedSegmentIDs     []int64           `protobuf:"varint,5,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
 	CollectionID         int64             `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DryRun               bool              `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -2249,6 +2250,13 @@ func (m *LoadBalanceRequest) GetCollectionID() int64 {
 	return 0
 }
 
+func (m *LoadBalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type DmChannelWatchInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	DmChannel            string   `protobuf:"bytes,2,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
@@ -3582,225 +3590,226 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 3485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x49, 0x8f, 0x1c, 0x57,
-	0x79, 0xaa, 0x97, 0x99, 0xee, 0xaf, 0x97, 0xa9, 0x79, 0xb3, 0xb8, 0xd3, 0xf1, 0x96, 0x72, 0xec,
-	0x0c, 0xe3, 0x64, 0xec, 0x8c, 0x43, 0xe4, 0x40, 0x22, 0xc5, 0x9e, 0x89, 0x27, 0x83, 0xed, 0xc9,
-	0x50, 0x6d, 0x1b, 0x64, 0x45, 0x74, 0xaa, 0xbb, 0xde, 0xf4, 0x94, 0x5c, 0x4b, 0xbb, 0xaa, 0x7a,
-	0xec, 0x09, 0x57, 0x2e, 0x6c, 0x07, 0x38, 0x70, 0x02, 0x4e, 0x20, 0x81, 0x94, 0x08, 0x21, 0x71,
-	0xe0, 0x80, 0x10, 0x12, 0x07, 0x38, 0x21, 0x7e, 0x00, 0x12, 0x07, 0x0e, 0x1c, 0x80, 0x23, 0x07,
-	0x6e, 0xe8, 0x6d, 0xb5, 0xd7, 0x74, 0x7b, 0x26, 0xce, 0x82, 0xb8, 0x75, 0x7d, 0x6f, 0xf9, 0xbe,
-	0xf7, 0xed, 0xdf, 0xf7, 0x5e, 0xc3, 0xdc, 0xc3, 0x11, 0x76, 0x0f, 0xba, 0x7d, 0xc7, 0x71, 0xf5,
-	0xd5, 0xa1, 0xeb, 0xf8, 0x0e, 0x42, 0x96, 0x61, 0xee, 0x8f, 0x3c, 0xf6, 0xb5, 0x4a, 0xc7, 0xdb,
-	0xf5, 0xbe, 0x63, 0x59, 0x8e, 0xcd, 0x60, 0xed, 0x7a, 0x74, 0x46, 0xbb, 0x69, 0xd8, 0x3e, 0x76,
-	0x6d, 0xcd, 0x14, 0xa3, 0x5e, 0x7f, 0x0f, 0x5b, 0x1a, 0xff, 0x92, 0x75, 0xcd, 0xd7, 0xa2, 0xfb,
-	0x2b, 0xdf, 0x90, 0x60, 0xa9, 0xb3, 0xe7, 0x3c, 0x5a, 0x77, 0x4c, 0x13, 0xf7, 0x7d, 0xc3, 0xb1,
-	0x3d, 0x15, 0x3f, 0x1c, 0x61, 0xcf, 0x47, 0x97, 0xa1, 0xd4, 0xd3, 0x3c, 0xdc, 0x92, 0xce, 0x4a,
-	0xcb, 0xb5, 0xb5, 0x93, 0xab, 0x31, 0x4a, 0x38, 0x09, 0xb7, 0xbd, 0xc1, 0x75, 0xcd, 0xc3, 0x2a,
-	0x9d, 0x89, 0x10, 0x94, 0xf4, 0xde, 0xd6, 0x46, 0xab, 0x70, 0x56, 0x5a, 0x2e, 0xaa, 0xf4, 0x37,
-	0x7a, 0x1e, 0x1a, 0xfd, 0x60, 0xef, 0xad, 0x0d, 0xaf, 0x55, 0x3c, 0x5b, 0x5c, 0x2e, 0xaa, 0x71,
-	0xa0, 0xf2, 0x57, 0x09, 0x4e, 0xa4, 0xc8, 0xf0, 0x86, 0x8e, 0xed, 0x61, 0x74, 0x05, 0xa6, 0x3d,
-	0x5f, 0xf3, 0x47, 0x1e, 0xa7, 0xe4, 0xd9, 0x4c, 0x4a, 0x3a, 0x74, 0x8a, 0xca, 0xa7, 0xa6, 0xd1,
-	0x16, 0x32, 0xd0, 0xa2, 0x97, 0x61, 0xc1, 0xb0, 0x6f, 0x63, 0xcb, 0x71, 0x0f, 0xba, 0x43, 0xec,
-	0xf6, 0xb1, 0xed, 0x6b, 0x03, 0x2c, 0x68, 0x9c, 0x17, 0x63, 0x3b, 0xe1, 0x10, 0x7a, 0x15, 0x4e,
-	0x30, 0x29, 0x79, 0xd8, 0xdd, 0x37, 0xfa, 0xb8, 0xab, 0xed, 0x6b, 0x86, 0xa9, 0xf5, 0x4c, 0xdc,
-	0x2a, 0x9d, 0x2d, 0x2e, 0x57, 0xd4, 0x45, 0x3a, 0xdc, 0x61, 0xa3, 0xd7, 0xc4, 0xa0, 0xf2, 0x53,
-	0x09, 0x16, 0xc9, 0x09, 0x77, 0x34, 0xd7, 0x37, 0x9e, 0x02, 0x9f, 0x15, 0xa8, 0x47, 0xcf, 0xd6,
-	0x2a, 0xd2, 0xb1, 0x18, 0x8c, 0xcc, 0x19, 0x0a, 0xf4, 0x84, 0x27, 0x25, 0x7a, 0xcc, 0x18, 0x4c,
-	0xf9, 0x09, 0x57, 0x88, 0x28, 0x9d, 0xc7, 0x11, 0x44, 0x12, 0x67, 0x21, 0x8d, 0xf3, 0x08, 0x62,
-	0x50, 0xfe, 0x2e, 0xc1, 0xe2, 0x2d, 0x47, 0xd3, 0x43, 0x85, 0xf9, 0xf8, 0xd9, 0xf9, 0x06, 0x4c,
-	0x33, 0xeb, 0x6a, 0x95, 0x28, 0xae, 0xf3, 0x71, 0x5c, 0xdc, 0xf2, 0x42, 0x0a, 0x3b, 0x14, 0xa0,
-	0xf2, 0x45, 0xe8, 0x3c, 0x34, 0x5d, 0x3c, 0x34, 0x8d, 0xbe, 0xd6, 0xb5, 0x47, 0x56, 0x0f, 0xbb,
-	0xad, 0xf2, 0x59, 0x69, 0xb9, 0xac, 0x36, 0x38, 0x74, 0x9b, 0x02, 0x95, 0x1f, 0x4a, 0xd0, 0x52,
-	0xb1, 0x89, 0x35, 0x0f, 0x7f, 0x92, 0x87, 0x5d, 0x82, 0x69, 0xdb, 0xd1, 0xf1, 0xd6, 0x06, 0x3d,
-	0x6c, 0x51, 0xe5, 0x5f, 0xca, 0x7f, 0x24, 0x58, 0xd8, 0xc4, 0x3e, 0x91, 0xba, 0xe1, 0xf9, 0x46,
-	0x3f, 0x50, 0xeb, 0x37, 0xa0, 0xe8, 0xe2, 0x87, 0x9c, 0xb2, 0x8b, 0x71, 0xca, 0x02, 0x27, 0x95,
-	0xb5, 0x52, 0x25, 0xeb, 0xd0, 0x73, 0x50, 0xd7, 0x2d, 0xb3, 0xdb, 0xdf, 0xd3, 0x6c, 0x1b, 0x9b,
-	0x4c, 0x6f, 0xaa, 0x6a, 0x4d, 0xb7, 0xcc, 0x75, 0x0e, 0x42, 0xa7, 0x01, 0x3c, 0x3c, 0xb0, 0xb0,
-	0xed, 0x87, 0x7e, 0x25, 0x02, 0x41, 0x2b, 0x30, 0xb7, 0xeb, 0x3a, 0x56, 0xd7, 0xdb, 0xd3, 0x5c,
-	0xbd, 0x6b, 0x62, 0x4d, 0xc7, 0x2e, 0xa5, 0xbe, 0xa2, 0xce, 0x92, 0x81, 0x0e, 0x81, 0xdf, 0xa2,
-	0x60, 0x74, 0x05, 0xca, 0x5e, 0xdf, 0x19, 0x62, 0x2a, 0x83, 0xe6, 0xda, 0xa9, 0xd5, 0xb4, 0xdf,
-	0x5d, 0xdd, 0xd0, 0x7c, 0xad, 0x43, 0x26, 0xa9, 0x6c, 0xae, 0xf2, 0xed, 0x02, 0x53, 0xc2, 0x4f,
-	0xb9, 0x4d, 0x47, 0x14, 0xb5, 0xfc, 0xd1, 0x28, 0xea, 0x74, 0x96, 0xa2, 0xfe, 0x2e, 0x54, 0xd4,
-	0x4f, 0x3b, 0x43, 0x42, 0x65, 0x2e, 0xc7, 0x94, 0xf9, 0xe7, 0x12, 0x3c, 0xb3, 0x89, 0xfd, 0x80,
-	0x7c, 0xa2, 0x9b, 0xf8, 0x53, 0xea, 0xa8, 0x3f, 0x94, 0xa0, 0x9d, 0x45, 0xeb, 0x71, 0x9c, 0xf5,
-	0x7d, 0x58, 0x0a, 0x70, 0x74, 0x75, 0xec, 0xf5, 0x5d, 0x63, 0x48, 0xc5, 0x48, 0xcd, 0xaf, 0xb6,
-	0x76, 0x2e, 0xcb, 0x2c, 0x92, 0x14, 0x2c, 0x06, 0x5b, 0x6c, 0x44, 0x76, 0x50, 0xbe, 0x2b, 0xc1,
-	0x22, 0x31, 0x77, 0x6e, 0x9f, 0xf6, 0xae, 0x73, 0x74, 0xbe, 0xc6, 0x2d, 0xbf, 0x90, 0xb2, 0xfc,
-	0x09, 0x78, 0x4c, 0x33, 0x9f, 0x24, 0x3d, 0xc7, 0xe1, 0xdd, 0xe7, 0xa1, 0x6c, 0xd8, 0xbb, 0x8e,
-	0x60, 0xd5, 0x99, 0x2c, 0x56, 0x45, 0x91, 0xb1, 0xd9, 0x8a, 0xcd, 0xa8, 0x08, 0x5d, 0xd1, 0x31,
-	0xd4, 0x2d, 0x79, 0xec, 0x42, 0xc6, 0xb1, 0xbf, 0x23, 0xc1, 0x89, 0x14, 0xc2, 0xe3, 0x9c, 0xfb,
-	0x75, 0x98, 0xa6, 0x0e, 0x56, 0x1c, 0xfc, 0xf9, 0xcc, 0x83, 0x47, 0xd0, 0xdd, 0x32, 0x3c, 0x5f,
-	0xe5, 0x6b, 0x14, 0x07, 0xe4, 0xe4, 0x18, 0x71, 0xfd, 0xdc, 0xed, 0x77, 0x6d, 0xcd, 0x62, 0x0c,
-	0xa8, 0xaa, 0x35, 0x0e, 0xdb, 0xd6, 0x2c, 0x8c, 0x9e, 0x81, 0x0a, 0x31, 0xd9, 0xae, 0xa1, 0x0b,
-	0xf1, 0xcf, 0x50, 0x13, 0xd6, 0x3d, 0x74, 0x0a, 0x80, 0x0e, 0x69, 0xba, 0xee, 0xb2, 0xa8, 0x50,
-	0x55, 0xab, 0x04, 0x72, 0x8d, 0x00, 0x94, 0xef, 0x49, 0x50, 0x27, 0x3e, 0xfb, 0x36, 0xf6, 0x35,
-	0x22, 0x07, 0xf4, 0x1a, 0x54, 0x4d, 0x47, 0xd3, 0xbb, 0xfe, 0xc1, 0x90, 0xa1, 0x6a, 0x26, 0x79,
-	0xcd, 0x8e, 0x40, 0x16, 0xdd, 0x39, 0x18, 0x62, 0xb5, 0x62, 0xf2, 0x5f, 0x93, 0xf0, 0x3b, 0x65,
-	0xca, 0xc5, 0x0c, 0x53, 0xfe, 0xa0, 0x0c, 0x4b, 0x5f, 0xd1, 0xfc, 0xfe, 0xde, 0x86, 0x25, 0x82,
-	0xdb, 0xd1, 0x95, 0x20, 0xf4, 0x6d, 0x85, 0xa8, 0x6f, 0xfb, 0xc8, 0x7c, 0x67, 0xa0, 0xe7, 0xe5,
-	0x2c, 0x3d, 0x27, 0x05, 0xc6, 0xea, 0x3d, 0x2e, 0xaa, 0x88, 0x9e, 0x47, 0x62, 0xd0, 0xf4, 0x51,
-	0x62, 0xd0, 0x3a, 0x34, 0xf0, 0xe3, 0xbe, 0x39, 0x22, 0x32, 0xa7, 0xd8, 0x67, 0x28, 0xf6, 0xd3,
-	0x19, 0xd8, 0xa3, 0x46, 0x56, 0xe7, 0x8b, 0xb6, 0x38, 0x0d, 0x4c, 0xd4, 0x16, 0xf6, 0xb5, 0x56,
-	0x85, 0x92, 0x71, 0x36, 0x4f, 0xd4, 0x42, 0x3f, 0x98, 0xb8, 0xc9, 0x17, 0x3a, 0x09, 0x55, 0x1e,
-	0xf1, 0xb6, 0x36, 0x5a, 0x55, 0xca, 0xbe, 0x10, 0x80, 0x34, 0x68, 0x70, 0x0f, 0xc4, 0x29, 0x04,
-	0x4a, 0xe1, 0xeb, 0x59, 0x08, 0xb2, 0x85, 0x1d, 0xa5, 0xdc, 0x7b, 0xcb, 0xf6, 0xdd, 0x03, 0xb5,
-	0xee, 0x45, 0x40, 0xa4, 0xa8, 0x71, 0x76, 0x77, 0x4d, 0xc3, 0xc6, 0xdb, 0x4c, 0xc2, 0x35, 0x4a,
-	0x44, 0x1c, 0xd8, 0xee, 0xc2, 0x5c, 0x6a, 0x23, 0x24, 0x43, 0xf1, 0x01, 0x3e, 0xa0, 0x6a, 0x54,
-	0x54, 0xc9, 0x4f, 0xf4, 0x0a, 0x94, 0xf7, 0x35, 0x73, 0x84, 0xa9, 0x9a, 0x8c, 0xe7, 0x24, 0x9b,
-	0xfc, 0x85, 0xc2, 0x55, 0x49, 0xf9, 0x99, 0x04, 0x8b, 0x77, 0x6d, 0x6f, 0xd4, 0x0b, 0x4e, 0xf0,
-	0xc9, 0x68, 0x6b, 0xd2, 0x4f, 0x94, 0x52, 0x7e, 0x42, 0xf9, 0x6d, 0x09, 0x66, 0xf9, 0x29, 0x88,
-	0x50, 0xa9, 0xc1, 0x9f, 0x84, 0x6a, 0x10, 0x2a, 0x38, 0x43, 0x42, 0x00, 0x3a, 0x0b, 0xb5, 0x88,
-	0xba, 0x73, 0xaa, 0xa2, 0xa0, 0x89, 0x48, 0x13, 0x81, 0xbf, 0x14, 0x09, 0xfc, 0xa7, 0x00, 0x76,
-	0xcd, 0x91, 0xb7, 0xd7, 0xf5, 0x0d, 0x0b, 0xf3, 0xc4, 0xa3, 0x4a, 0x21, 0x77, 0x0c, 0x0b, 0xa3,
-	0x6b, 0x50, 0xef, 0x19, 0xb6, 0xe9, 0x0c, 0xba, 0x43, 0xcd, 0xdf, 0xf3, 0x5a, 0xd3, 0xb9, 0x0a,
-	0x7e, 0xc3, 0xc0, 0xa6, 0x7e, 0x9d, 0xce, 0x55, 0x6b, 0x6c, 0xcd, 0x0e, 0x59, 0x82, 0x4e, 0x43,
-	0xcd, 0x1e, 0x59, 0x5d, 0x67, 0xb7, 0xeb, 0x3a, 0x8f, 0x88, 0x89, 0x50, 0x14, 0xf6, 0xc8, 0x7a,
-	0x67, 0x57, 0x75, 0x1e, 0x11, 0x57, 0x5d, 0x25, 0x4e, 0xdb, 0x33, 0x9d, 0x81, 0xd7, 0xaa, 0x4c,
-	0xb4, 0x7f, 0xb8, 0x80, 0xac, 0xd6, 0xb1, 0xe9, 0x6b, 0x74, 0x75, 0x75, 0xb2, 0xd5, 0xc1, 0x02,
-	0x74, 0x01, 0x9a, 0x7d, 0xc7, 0x1a, 0x6a, 0x94, 0x43, 0x37, 0x5c, 0xc7, 0xa2, 0xf6, 0x51, 0x54,
-	0x13, 0x50, 0xb4, 0x0e, 0x35, 0xc3, 0xd6, 0xf1, 0x63, 0x6e, 0x44, 0x35, 0x8a, 0x47, 0xc9, 0x32,
-	0x22, 0x8a, 0x68, 0x8b, 0xcc, 0xa5, 0x0a, 0x0a, 0x86, 0xf8, 0xe9, 0x11, 0xcd, 0x10, 0xb6, 0xe8,
-	0x19, 0xef, 0xe3, 0x56, 0x9d, 0x49, 0x91, 0xc3, 0x3a, 0xc6, 0xfb, 0x98, 0x24, 0xb5, 0x86, 0xed,
-	0x61, 0xd7, 0x17, 0x25, 0x46, 0xab, 0x41, 0xd5, 0xa7, 0xc1, 0xa0, 0x5c, 0xb1, 0x95, 0x5f, 0x14,
-	0xa0, 0x19, 0x47, 0x84, 0x5a, 0x30, 0xb3, 0x4b, 0x21, 0x42, 0x7b, 0xc4, 0x27, 0x41, 0x8b, 0x6d,
-	0x52, 0xed, 0x77, 0x29, 0x2d, 0x54, 0x79, 0x2a, 0x6a, 0x8d, 0xc1, 0xe8, 0x06, 0x44, 0x09, 0xd8,
-	0xf1, 0xa8, 0xc6, 0x16, 0x29, 0xca, 0x2a, 0x85, 0xd0, 0xb8, 0xd6, 0x82, 0x19, 0x76, 0x0c, 0xa1,
-	0x3a, 0xe2, 0x93, 0x8c, 0xf4, 0x46, 0x06, 0xc5, 0xca, 0x54, 0x47, 0x7c, 0xa2, 0x0d, 0xa8, 0xb3,
-	0x2d, 0x87, 0x9a, 0xab, 0x59, 0x42, 0x71, 0x9e, 0xcb, 0x34, 0xbe, 0x9b, 0xf8, 0xe0, 0x1e, 0xb1,
-	0xe3, 0x1d, 0xcd, 0x70, 0x55, 0xc6, 0xe8, 0x1d, 0xba, 0x0a, 0x2d, 0x83, 0xcc, 0x76, 0xd9, 0x35,
-	0x4c, 0xcc, 0x55, 0x70, 0x86, 0x06, 0xcf, 0x26, 0x85, 0xdf, 0x30, 0x4c, 0xcc, 0xb4, 0x2c, 0x38,
-	0x02, 0x65, 0x6d, 0x85, 0x29, 0x19, 0x85, 0x10, 0xc6, 0x2a, 0xdf, 0x2f, 0xc1, 0x3c, 0xb1, 0x35,
-	0x6e, 0x76, 0xc7, 0x88, 0x64, 0xa7, 0x00, 0x74, 0xcf, 0xef, 0xc6, 0xfc, 0x43, 0x55, 0xf7, 0x7c,
-	0xe6, 0xe7, 0xd0, 0x6b, 0x22, 0x10, 0x15, 0xf3, 0x73, 0xd3, 0x84, 0xed, 0xa7, 0x83, 0xd1, 0x91,
-	0x2a, 0xf7, 0x73, 0xd0, 0xf0, 0x9c, 0x91, 0xdb, 0xc7, 0xdd, 0x58, 0x15, 0x51, 0x67, 0xc0, 0xed,
-	0x6c, 0x0f, 0x36, 0x9d, 0xd9, 0x41, 0x88, 0x04, 0xa4, 0x99, 0xe3, 0x05, 0xa4, 0x4a, 0x32, 0x20,
-	0xdd, 0x84, 0x59, 0x6a, 0x7e, 0xdd, 0xa1, 0xe3, 0xb1, 0x62, 0x8c, 0x5b, 0xad, 0x92, 0x53, 0x8c,
-	0xdf, 0xf6, 0x06, 0x3b, 0x7c, 0xaa, 0xda, 0xa4, 0x4b, 0xc5, 0xa7, 0x47, 0xd4, 0x6f, 0x1f, 0xbb,
-	0x9e, 0xe1, 0xd8, 0x2d, 0x60, 0xea, 0xc7, 0x3f, 0x09, 0x33, 0x6c, 0x8c, 0xf5, 0xae, 0xef, 0x6a,
-	0xb6, 0xb7, 0x8b, 0x5d, 0x1a, 0x94, 0x2a, 0x6a, 0x9d, 0x00, 0xef, 0x70, 0x98, 0xf2, 0xa7, 0x02,
-	0x2c, 0xf1, 0xda, 0xf0, 0xf8, 0x7a, 0x91, 0x17, 0x33, 0x84, 0xd3, 0x2d, 0x1e, 0x52, 0x6d, 0x95,
-	0x26, 0xc8, 0x7a, 0xca, 0x19, 0x59, 0x4f, 0xbc, 0xe2, 0x98, 0x4e, 0x55, 0x1c, 0x41, 0xff, 0x60,
-	0x66, 0xf2, 0xfe, 0x01, 0x5a, 0x80, 0x32, 0x4d, 0x83, 0xa9, 0xec, 0xaa, 0x2a, 0xfb, 0x98, 0x8c,
-	0xa1, 0xff, 0x90, 0xa0, 0xd1, 0xc1, 0x9a, 0xdb, 0xdf, 0x13, 0x7c, 0x7c, 0x35, 0xda, 0x6f, 0x79,
-	0x3e, 0x47, 0xc4, 0xb1, 0x25, 0x9f, 0x9d, 0x46, 0xcb, 0x3f, 0x25, 0xa8, 0x7f, 0x99, 0x0c, 0x89,
-	0xc3, 0x5e, 0x8d, 0x1e, 0xf6, 0x42, 0xce, 0x61, 0x55, 0xec, 0xbb, 0x06, 0xde, 0xc7, 0x9f, 0xb9,
-	0xe3, 0xfe, 0x41, 0x82, 0x76, 0xe7, 0xc0, 0xee, 0xab, 0xcc, 0x96, 0x8f, 0x6f, 0x31, 0xe7, 0xa0,
-	0xb1, 0x1f, 0x4b, 0x95, 0x0a, 0x54, 0xe1, 0xea, 0xfb, 0xd1, 0x9a, 0x4a, 0x05, 0x59, 0xb4, 0x79,
-	0xf8, 0x61, 0x85, 0x6b, 0x7d, 0x21, 0x8b, 0xea, 0x04, 0x71, 0xd4, 0x35, 0xcd, 0xba, 0x71, 0xa0,
-	0xe2, 0xc2, 0x7c, 0xc6, 0x3c, 0x74, 0x02, 0x66, 0x78, 0xf9, 0xc6, 0x43, 0x28, 0x33, 0x61, 0x9d,
-	0x48, 0x27, 0x6c, 0x40, 0x18, 0x7a, 0x3a, 0xfd, 0xd2, 0xd1, 0x19, 0xa8, 0x05, 0x79, 0xb6, 0x9e,
-	0x12, 0x8f, 0xee, 0x29, 0xbf, 0x91, 0x60, 0xe9, 0x6d, 0xcd, 0xd6, 0x9d, 0xdd, 0xdd, 0xe3, 0x73,
-	0x6e, 0x1d, 0x62, 0x29, 0xf8, 0xa4, 0xc5, 0x7d, 0x3c, 0x6f, 0xbf, 0x08, 0x73, 0x2e, 0x73, 0x7e,
-	0x7a, 0x9c, 0xb5, 0x45, 0x55, 0x16, 0x03, 0x01, 0xcb, 0x3e, 0x28, 0x00, 0x22, 0xfe, 0xfe, 0xba,
-	0x66, 0x6a, 0x76, 0x1f, 0x1f, 0x9d, 0xf4, 0xf3, 0xd0, 0x8c, 0x45, 0xa9, 0xe0, 0x0e, 0x24, 0x1a,
-	0xa6, 0x3c, 0x74, 0x13, 0x9a, 0x3d, 0x86, 0xaa, 0xeb, 0x62, 0xcd, 0x73, 0x6c, 0xea, 0x3f, 0x9b,
-	0xd9, 0x75, 0xfc, 0x1d, 0xd7, 0x18, 0x0c, 0xb0, 0xbb, 0xee, 0xd8, 0x3a, 0x8b, 0x13, 0x8d, 0x9e,
-	0x20, 0x93, 0x2c, 0x25, 0xc2, 0x09, 0x43, 0xb6, 0xa8, 0x1f, 0x21, 0x88, 0xd9, 0x94, 0x15, 0x1e,
-	0xd6, 0xcc, 0x90, 0x11, 0xa1, 0xc3, 0x95, 0xd9, 0x40, 0x27, 0xbf, 0x8d, 0x93, 0x11, 0x42, 0x95,
-	0x5f, 0x49, 0x80, 0x82, 0x3a, 0x84, 0xd6, 0x55, 0x54, 0xc3, 0x92, 0x4b, 0xa5, 0x0c, 0xbf, 0x7f,
-	0x12, 0xaa, 0xba, 0x58, 0xc9, 0x2d, 0x22, 0x04, 0x50, 0x37, 0x4c, 0x89, 0xee, 0x92, 0x78, 0x8b,
-	0x75, 0x91, 0xe7, 0x33, 0xe0, 0x2d, 0x0a, 0x8b, 0x47, 0xe0, 0x52, 0x32, 0x02, 0x47, 0xbb, 0x14,
-	0xe5, 0x58, 0x97, 0x42, 0xf9, 0xb0, 0x00, 0x32, 0xf5, 0x68, 0xeb, 0x61, 0xa9, 0x3c, 0x11, 0xd1,
-	0xe7, 0xa0, 0xc1, 0x6f, 0x09, 0x63, 0x84, 0xd7, 0x1f, 0x46, 0x36, 0x43, 0x97, 0x61, 0x81, 0x4d,
-	0x72, 0xb1, 0x37, 0x32, 0xc3, 0x14, 0x97, 0xe5, 0x9b, 0xe8, 0x21, 0x73, 0xa5, 0x64, 0x48, 0xac,
-	0xb8, 0x0b, 0x4b, 0x03, 0xd3, 0xe9, 0x69, 0x66, 0x37, 0x2e, 0x1e, 0x26, 0xc3, 0x09, 0x34, 0x7e,
-	0x81, 0x2d, 0xef, 0x44, 0x65, 0xe8, 0xa1, 0x4d, 0x52, 0x14, 0xe3, 0x07, 0x41, 0x0a, 0xc2, 0x1b,
-	0xd0, 0x93, 0x64, 0x20, 0x75, 0xb2, 0x50, 0x7c, 0x29, 0x3f, 0x96, 0x60, 0x36, 0xd1, 0x68, 0x4c,
-	0x96, 0x6a, 0x52, 0xba, 0x54, 0xbb, 0x0a, 0x65, 0x52, 0xbf, 0x30, 0x7f, 0xd7, 0xcc, 0x2e, 0x23,
-	0xe2, 0xbb, 0xaa, 0x6c, 0x01, 0xba, 0x04, 0xf3, 0x19, 0x57, 0x52, 0x5c, 0x07, 0x50, 0xfa, 0x46,
-	0x4a, 0xf9, 0x4b, 0x09, 0x6a, 0x11, 0x7e, 0x8c, 0xa9, 0x32, 0x27, 0xe9, 0x1c, 0x25, 0x8e, 0x57,
-	0x4c, 0x1f, 0x2f, 0xe7, 0x4e, 0x86, 0xe8, 0x9d, 0x85, 0x2d, 0x96, 0x9f, 0xf3, 0x62, 0xc1, 0xc2,
-	0x16, 0x2d, 0x7b, 0x88, 0x4a, 0x8e, 0x2c, 0x56, 0x1f, 0x32, 0x73, 0x9a, 0xb1, 0x47, 0x16, 0xad,
-	0x0e, 0xe3, 0xa5, 0xc9, 0xcc, 0x21, 0xa5, 0x49, 0x25, 0x5e, 0x9a, 0xc4, 0xec, 0xa8, 0x9a, 0xb4,
-	0xa3, 0x49, 0x0b, 0xbf, 0xcb, 0x30, 0xdf, 0x77, 0xb1, 0xe6, 0x63, 0xfd, 0xfa, 0xc1, 0x7a, 0x30,
-	0xc4, 0x93, 0x9f, 0xac, 0x21, 0x74, 0x23, 0xec, 0xb8, 0x30, 0x29, 0xd7, 0xa9, 0x94, 0xb3, 0x2b,
-	0x1f, 0x2e, 0x1b, 0x26, 0x64, 0xe1, 0x9e, 0xe9, 0x57, 0xb2, 0xe4, 0x6c, 0x1c, 0xa9, 0xe4, 0x3c,
-	0x03, 0x35, 0x11, 0x3d, 0x89, 0xb9, 0x37, 0x99, 0xe7, 0x13, 0xbe, 0x40, 0xf7, 0x62, 0xce, 0x60,
-	0x36, 0xde, 0xb2, 0x4c, 0xd6, 0x8d, 0x72, 0xaa, 0x6e, 0x54, 0xfe, 0x5c, 0x84, 0x66, 0x58, 0x8f,
-	0x4c, 0xec, 0x2d, 0x26, 0xb9, 0x7d, 0xdd, 0x06, 0x39, 0x8c, 0xb9, 0x94, 0x91, 0x87, 0x96, 0x54,
-	0xc9, 0x76, 0xff, 0xec, 0x30, 0x61, 0x96, 0xb1, 0x86, 0x6a, 0xe9, 0x89, 0x1a, 0xaa, 0xc7, 0xbc,
-	0xa8, 0xba, 0x02, 0x8b, 0x41, 0x9c, 0x8d, 0x1d, 0x9b, 0xe5, 0xeb, 0x0b, 0x62, 0x70, 0x27, 0x7a,
-	0xfc, 0x1c, 0x4b, 0x9f, 0xc9, 0xb3, 0xf4, 0xa4, 0xa4, 0x2b, 0x29, 0x49, 0xa7, 0xef, 0xcb, 0xaa,
-	0x59, 0xf7, 0x65, 0x77, 0x61, 0x9e, 0x76, 0xd1, 0xbc, 0xbe, 0x6b, 0xf4, 0x70, 0x90, 0x7d, 0x4e,
-	0x22, 0xd6, 0x36, 0x54, 0x12, 0x09, 0x6c, 0xf0, 0xad, 0x7c, 0x4b, 0x82, 0xa5, 0xf4, 0xbe, 0x54,
-	0x63, 0x42, 0x7f, 0x21, 0xc5, 0xfc, 0xc5, 0x57, 0x61, 0x3e, 0xdc, 0x3e, 0x9e, 0x1a, 0xe7, 0x24,
-	0x7f, 0x19, 0x84, 0xab, 0x28, 0xdc, 0x43, 0xc0, 0x94, 0x7f, 0x4b, 0x41, 0x33, 0x92, 0xc0, 0x06,
-	0xb4, 0x11, 0x4b, 0x62, 0x98, 0x63, 0x9b, 0x86, 0x1d, 0xd4, 0xcf, 0xfc, 0x8c, 0x0c, 0xc8, 0xeb,
-	0xe7, 0xb7, 0x61, 0x96, 0x4f, 0x0a, 0x42, 0xd1, 0x84, 0xc9, 0x57, 0x93, 0xad, 0x0b, 0x82, 0xd0,
-	0x79, 0x68, 0xf2, 0x0e, 0xa9, 0xc0, 0x57, 0xcc, 0xe8, 0x9b, 0xa2, 0x2f, 0x81, 0x2c, 0xa6, 0x3d,
-	0x69, 0xf0, 0x9b, 0xe5, 0x0b, 0x83, 0x24, 0xee, 0x9b, 0x12, 0xb4, 0xe2, 0xa1, 0x30, 0x72, 0xfc,
-	0x27, 0x4f, 0xe5, 0xbe, 0x18, 0xbf, 0x5b, 0x3a, 0x7f, 0x08, 0x3d, 0x21, 0x1e, 0x71, 0xc3, 0xb4,
-	0x4d, 0xef, 0x09, 0x49, 0x91, 0xb1, 0x61, 0x78, 0xbe, 0x6b, 0xf4, 0x46, 0xc7, 0x7a, 0x41, 0xa0,
-	0xfc, 0xad, 0x00, 0xcf, 0x66, 0x6e, 0x78, 0x9c, 0x5b, 0xa4, 0xbc, 0x9a, 0xfe, 0x25, 0x40, 0x03,
-	0xd7, 0x79, 0x64, 0xd8, 0x83, 0x6e, 0xaa, 0x26, 0x9b, 0xe3, 0x23, 0x91, 0x8c, 0xf1, 0x3a, 0x54,
-	0x12, 0xb2, 0xbb, 0x70, 0x08, 0xaf, 0xee, 0xb1, 0x16, 0x06, 0xeb, 0xaa, 0x88, 0x75, 0x64, 0x8f,
-	0xc0, 0x04, 0xca, 0xf9, 0x7b, 0x70, 0x1d, 0x8f, 0xed, 0x21, 0xd6, 0xa1, 0x37, 0xa1, 0xc6, 0xea,
-	0xc2, 0x7b, 0x06, 0x7e, 0x94, 0xd3, 0xcb, 0xe5, 0x5e, 0x30, 0x98, 0xa6, 0x46, 0x97, 0x28, 0xff,
-	0x92, 0x00, 0xc2, 0x31, 0x52, 0x93, 0x86, 0xe6, 0xc5, 0xed, 0x25, 0x02, 0x21, 0xd1, 0x39, 0x9e,
-	0x10, 0x8a, 0x4f, 0xf4, 0x1e, 0xc8, 0xfc, 0x68, 0x44, 0xcf, 0x77, 0x34, 0xc3, 0x15, 0xee, 0xfd,
-	0x95, 0xc3, 0xe9, 0x11, 0x5c, 0x0a, 0x96, 0xb1, 0x2b, 0x89, 0xd4, 0x6e, 0xed, 0x75, 0x58, 0xcc,
-	0x9c, 0x9a, 0x71, 0xe9, 0xb0, 0x10, 0xbd, 0x74, 0x28, 0x46, 0x2f, 0x15, 0x7e, 0x20, 0x01, 0x4a,
-	0x8b, 0x05, 0x35, 0xa1, 0x10, 0xf8, 0x87, 0xc2, 0xd6, 0x46, 0x82, 0x0f, 0x85, 0x14, 0x1f, 0x4e,
-	0x42, 0x35, 0xf0, 0xfc, 0xdc, 0xcc, 0x43, 0x40, 0x94, 0x4b, 0xa5, 0x38, 0x97, 0x22, 0xfd, 0xad,
-	0x72, 0xac, 0xbf, 0xa5, 0xec, 0x01, 0x4a, 0x8b, 0x3a, 0xba, 0x93, 0x14, 0xdf, 0x69, 0x1c, 0x85,
-	0x11, 0x4c, 0xc5, 0x38, 0xa6, 0x3f, 0x4a, 0x80, 0xc2, 0xd8, 0x16, 0xdc, 0x57, 0x4c, 0x12, 0x10,
-	0x2e, 0xc1, 0x7c, 0x3a, 0xf2, 0x89, 0x70, 0x8f, 0x52, 0x71, 0x2f, 0x2b, 0x46, 0x15, 0x33, 0x62,
-	0x14, 0x7a, 0x35, 0xb0, 0x65, 0x16, 0xc8, 0x4f, 0xe7, 0x05, 0xf2, 0xb8, 0x39, 0x2b, 0xbf, 0x96,
-	0x60, 0x2e, 0xc0, 0xf6, 0x44, 0x27, 0x19, 0x7f, 0xff, 0xf2, 0x94, 0x49, 0xef, 0xc0, 0x0c, 0x6f,
-	0x59, 0xa4, 0x94, 0x6f, 0x92, 0xac, 0x7d, 0x01, 0xca, 0xc4, 0x75, 0x09, 0x1f, 0xc5, 0x3e, 0x88,
-	0x76, 0x43, 0xe7, 0xc0, 0xee, 0x5f, 0x63, 0x3a, 0x70, 0x19, 0x4a, 0xe3, 0xae, 0x9b, 0xc9, 0x6c,
-	0x9a, 0x1d, 0xd1, 0x99, 0x13, 0xb0, 0x25, 0x56, 0x70, 0x14, 0x93, 0x05, 0x47, 0xde, 0xf3, 0xad,
-	0xdf, 0x4b, 0x70, 0x82, 0xa0, 0xfa, 0x48, 0x42, 0xc3, 0x44, 0x0c, 0x8a, 0x58, 0x4e, 0x31, 0x6e,
-	0x39, 0x57, 0x61, 0x86, 0x65, 0xf6, 0xc2, 0x77, 0x9f, 0xce, 0x63, 0x0c, 0x63, 0xa3, 0x2a, 0xa6,
-	0xaf, 0xbc, 0x09, 0xd5, 0xa0, 0x89, 0x86, 0x6a, 0x30, 0x73, 0xd7, 0xbe, 0x69, 0x3b, 0x8f, 0x6c,
-	0x79, 0x0a, 0xcd, 0x40, 0xf1, 0x9a, 0x69, 0xca, 0x12, 0x6a, 0x40, 0xb5, 0xe3, 0xbb, 0x58, 0xb3,
-	0x0c, 0x7b, 0x20, 0x17, 0x50, 0x13, 0xe0, 0x6d, 0xc3, 0xf3, 0x1d, 0xd7, 0xe8, 0x6b, 0xa6, 0x5c,
-	0x5c, 0x79, 0x1f, 0x9a, 0xf1, 0xc4, 0x16, 0xd5, 0xa1, 0xb2, 0xed, 0xf8, 0x6f, 0x3d, 0x36, 0x3c,
-	0x5f, 0x9e, 0x22, 0xf3, 0xb7, 0x1d, 0x7f, 0xc7, 0xc5, 0x1e, 0xb6, 0x7d, 0x59, 0x42, 0x00, 0xd3,
-	0xef, 0xd8, 0x1b, 0x86, 0xf7, 0x40, 0x2e, 0xa0, 0x79, 0x5e, 0x9a, 0x6a, 0xe6, 0x16, 0xcf, 0x16,
-	0xe5, 0x22, 0x59, 0x1e, 0x7c, 0x95, 0x90, 0x0c, 0xf5, 0x60, 0xca, 0xe6, 0xce, 0x5d, 0xb9, 0x8c,
-	0xaa, 0x50, 0x66, 0x3f, 0xa7, 0x57, 0x74, 0x90, 0x93, 0x7d, 0x15, 0xb2, 0x27, 0x3b, 0x44, 0x00,
-	0x92, 0xa7, 0xc8, 0xc9, 0x78, 0x63, 0x4b, 0x96, 0xd0, 0x2c, 0xd4, 0x22, 0x6d, 0x22, 0xb9, 0x40,
-	0x00, 0x9b, 0xee, 0xb0, 0xcf, 0xa5, 0xc7, 0x48, 0x20, 0x0e, 0x79, 0x83, 0x70, 0xa2, 0xb4, 0x72,
-	0x1d, 0x2a, 0x22, 0xe3, 0x26, 0x53, 0x39, 0x8b, 0xc8, 0xa7, 0x3c, 0x85, 0xe6, 0xa0, 0x11, 0x7b,
-	0xc8, 0x26, 0x4b, 0x08, 0x41, 0x33, 0xfe, 0xc0, 0x52, 0x2e, 0xac, 0xac, 0x01, 0x84, 0x16, 0x43,
-	0xc8, 0xd9, 0xb2, 0xf7, 0x35, 0xd3, 0xd0, 0x19, 0x6d, 0x64, 0x88, 0x70, 0x97, 0x72, 0x87, 0x35,
-	0x48, 0xe4, 0xc2, 0xca, 0x19, 0xa8, 0x08, 0x5d, 0x26, 0x70, 0x15, 0x5b, 0xce, 0x3e, 0x66, 0x92,
-	0xe9, 0x60, 0x5f, 0x96, 0xd6, 0x7e, 0xd4, 0x00, 0x60, 0xad, 0x10, 0xc7, 0x71, 0x75, 0x34, 0x04,
-	0xb4, 0x89, 0x7d, 0x52, 0xe6, 0x39, 0xb6, 0x28, 0xd1, 0x3c, 0x74, 0x39, 0xff, 0x01, 0x61, 0x62,
-	0x2a, 0x3f, 0x7f, 0x3b, 0xaf, 0x2b, 0x9c, 0x98, 0xae, 0x4c, 0x21, 0x8b, 0x62, 0xbc, 0x63, 0x58,
-	0xf8, 0x8e, 0xd1, 0x7f, 0x10, 0xf4, 0x50, 0xf2, 0x31, 0x26, 0xa6, 0x0a, 0x8c, 0x89, 0x72, 0x89,
-	0x7f, 0x74, 0x7c, 0xd7, 0xb0, 0x07, 0x22, 0x3b, 0x52, 0xa6, 0xd0, 0xc3, 0xc4, 0x83, 0x49, 0x81,
-	0x70, 0x6d, 0x92, 0x37, 0x92, 0x47, 0x43, 0x69, 0xc2, 0x6c, 0xe2, 0x75, 0x35, 0x5a, 0xc9, 0x7e,
-	0xa6, 0x93, 0xf5, 0x12, 0xbc, 0x7d, 0x71, 0xa2, 0xb9, 0x01, 0x36, 0x03, 0x9a, 0xf1, 0x17, 0xc4,
-	0xe8, 0x73, 0x79, 0x1b, 0xa4, 0x1e, 0x0a, 0xb6, 0x57, 0x26, 0x99, 0x1a, 0xa0, 0xba, 0xcf, 0x94,
-	0x74, 0x1c, 0xaa, 0xcc, 0x47, 0x9a, 0xed, 0xc3, 0x12, 0x53, 0x65, 0x0a, 0xbd, 0x07, 0x73, 0xa9,
-	0xe7, 0x8c, 0xe8, 0xc5, 0xec, 0x56, 0x78, 0xf6, 0xab, 0xc7, 0x71, 0x18, 0xee, 0x27, 0x4d, 0x2c,
-	0x9f, 0xfa, 0xd4, 0xd3, 0xdf, 0xc9, 0xa9, 0x8f, 0x6c, 0x7f, 0x18, 0xf5, 0x4f, 0x8c, 0x61, 0x44,
-	0xcd, 0x26, 0xd9, 0x94, 0x7b, 0x29, 0x0b, 0x45, 0xee, 0x9b, 0xca, 0xf6, 0xea, 0xa4, 0xd3, 0xa3,
-	0xda, 0x15, 0x7f, 0xb6, 0x97, 0xcd, 0xb4, 0xcc, 0xa7, 0x86, 0xd9, 0xda, 0x95, 0xfd, 0x0a, 0x50,
-	0x99, 0x42, 0x77, 0x62, 0x2e, 0x16, 0x5d, 0xc8, 0x13, 0x4e, 0xbc, 0x55, 0x3f, 0x8e, 0x6f, 0x5f,
-	0x07, 0xc4, 0x6c, 0xc7, 0xde, 0x35, 0x06, 0x23, 0x57, 0x63, 0x8a, 0x95, 0xe7, 0x6e, 0xd2, 0x53,
-	0x05, 0x9a, 0x97, 0x9f, 0x60, 0x45, 0x70, 0xa4, 0x2e, 0xc0, 0x26, 0xf6, 0x6f, 0x63, 0xdf, 0x35,
-	0xfa, 0x5e, 0xf2, 0x44, 0xfc, 0x23, 0x9c, 0x20, 0x50, 0xbd, 0x30, 0x76, 0x5e, 0x80, 0xa0, 0x07,
-	0xb5, 0x4d, 0xec, 0xf3, 0x0c, 0xca, 0x43, 0xb9, 0x2b, 0xc5, 0x0c, 0x81, 0x62, 0x79, 0xfc, 0xc4,
-	0xa8, 0x3b, 0x4b, 0x3c, 0x61, 0x44, 0xb9, 0x82, 0x4d, 0x3f, 0xac, 0xcc, 0x76, 0x67, 0x39, 0x6f,
-	0x22, 0x95, 0xa9, 0xb5, 0x5f, 0x36, 0xa1, 0x4a, 0xe3, 0x13, 0x09, 0xa6, 0xff, 0x0f, 0x4f, 0x4f,
-	0x21, 0x3c, 0xbd, 0x0b, 0xb3, 0x89, 0x17, 0x71, 0xd9, 0xf2, 0xcc, 0x7e, 0x36, 0x37, 0x81, 0x97,
-	0x8d, 0xbf, 0x56, 0xcb, 0x76, 0x18, 0x99, 0x2f, 0xda, 0xc6, 0xed, 0x7d, 0x8f, 0x3d, 0x26, 0x0d,
-	0x5a, 0x4d, 0x2f, 0xe4, 0x16, 0x19, 0xf1, 0x9b, 0xc8, 0x4f, 0xde, 0x7b, 0x3f, 0xfd, 0xe8, 0xf6,
-	0x2e, 0xcc, 0x26, 0x9e, 0x7c, 0x64, 0x4b, 0x35, 0xfb, 0x5d, 0xc8, 0xb8, 0xdd, 0x3f, 0xc6, 0x30,
-	0xa0, 0xc3, 0x7c, 0xc6, 0x6d, 0x3c, 0x5a, 0xcd, 0xab, 0x4e, 0xb2, 0xaf, 0xed, 0xc7, 0x1f, 0xa8,
-	0x11, 0x33, 0x25, 0xb4, 0x9c, 0x47, 0x64, 0xf2, 0x0f, 0x33, 0xed, 0x17, 0x27, 0xfb, 0x77, 0x4d,
-	0x70, 0xa0, 0x0e, 0x4c, 0xb3, 0x87, 0x20, 0xe8, 0xb9, 0xec, 0xee, 0x58, 0xe4, 0x91, 0x48, 0x7b,
-	0xdc, 0x53, 0x12, 0x6f, 0x64, 0xfa, 0x1e, 0xdd, 0xb4, 0x4c, 0xbd, 0x24, 0xca, 0x7c, 0xc1, 0x14,
-	0x7d, 0xbd, 0xd1, 0x1e, 0xff, 0x60, 0x43, 0x6c, 0xfa, 0xbf, 0x1d, 0x2b, 0x1f, 0xc3, 0x7c, 0x46,
-	0x23, 0x15, 0xe5, 0xe5, 0x44, 0x39, 0x2d, 0xdc, 0xf6, 0xa5, 0x89, 0xe7, 0x07, 0x98, 0xbf, 0x06,
-	0x72, 0xb2, 0xea, 0x47, 0x17, 0xf3, 0xf4, 0x39, 0x0b, 0xe7, 0xe1, 0xca, 0x7c, 0xfd, 0x95, 0xfb,
-	0x6b, 0x03, 0xc3, 0xdf, 0x1b, 0xf5, 0xc8, 0xc8, 0x25, 0x36, 0xf5, 0x25, 0xc3, 0xe1, 0xbf, 0x2e,
-	0x09, 0xfe, 0x5f, 0xa2, 0xab, 0x2f, 0x51, 0x54, 0xc3, 0x5e, 0x6f, 0x9a, 0x7e, 0x5e, 0xf9, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x43, 0x08, 0x3d, 0x08, 0x3b, 0x00, 0x00,
+	// 3501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x8f, 0x1c, 0x57,
+	0xb9, 0xae, 0x7e, 0x4c, 0x77, 0x7f, 0xfd, 0x98, 0x9a, 0x33, 0x0f, 0x77, 0x3a, 0x7e, 0xa5, 0x1c,
+	0x3b, 0x73, 0xc7, 0xc9, 0xd8, 0x19, 0xe7, 0x46, 0xce, 0xbd, 0x89, 0x14, 0x7b, 0x26, 0x9e, 0xcc,
+	0xb5, 0x3d, 0x99, 0x5b, 0x6d, 0xfb, 0x5e, 0x59, 0x11, 0x9d, 0xea, 0xae, 0x33, 0x3d, 0x25, 0x57,
+	0x57, 0xb5, 0xab, 0xaa, 0xc7, 0x9e, 0xb0, 0x65, 0xc3, 0x6b, 0x01, 0x0b, 0x56, 0xc0, 0x0a, 0x24,
+	0x90, 0x88, 0x10, 0x12, 0x0b, 0x16, 0x08, 0x21, 0x21, 0x04, 0x2b, 0xc4, 0x0f, 0x40, 0x62, 0xc1,
+	0x82, 0x05, 0xb0, 0x64, 0xc1, 0x0e, 0x9d, 0x57, 0xbd, 0x6b, 0xba, 0x3c, 0x13, 0xe7, 0x81, 0xd8,
+	0x75, 0x7d, 0xe7, 0x3b, 0xe7, 0xfb, 0xce, 0xf7, 0x3c, 0xdf, 0x77, 0x4e, 0xc3, 0xdc, 0xa3, 0x09,
+	0x76, 0x0e, 0x7a, 0x03, 0xdb, 0x76, 0xf4, 0xd5, 0xb1, 0x63, 0x7b, 0x36, 0x42, 0x23, 0xc3, 0xdc,
+	0x9f, 0xb8, 0xec, 0x6b, 0x95, 0x8e, 0x77, 0x1a, 0x03, 0x7b, 0x34, 0xb2, 0x2d, 0x06, 0xeb, 0x34,
+	0xc2, 0x18, 0x9d, 0x96, 0x61, 0x79, 0xd8, 0xb1, 0x34, 0x53, 0x8c, 0xba, 0x83, 0x3d, 0x3c, 0xd2,
+	0xf8, 0x97, 0xac, 0x6b, 0x9e, 0x16, 0x5e, 0x5f, 0xf9, 0x92, 0x04, 0x4b, 0xdd, 0x3d, 0xfb, 0xf1,
+	0xba, 0x6d, 0x9a, 0x78, 0xe0, 0x19, 0xb6, 0xe5, 0xaa, 0xf8, 0xd1, 0x04, 0xbb, 0x1e, 0xba, 0x02,
+	0xa5, 0xbe, 0xe6, 0xe2, 0xb6, 0x74, 0x4e, 0x5a, 0xae, 0xaf, 0x9d, 0x5a, 0x8d, 0x70, 0xc2, 0x59,
+	0xb8, 0xe3, 0x0e, 0x6f, 0x68, 0x2e, 0x56, 0x29, 0x26, 0x42, 0x50, 0xd2, 0xfb, 0x5b, 0x1b, 0xed,
+	0xc2, 0x39, 0x69, 0xb9, 0xa8, 0xd2, 0xdf, 0xe8, 0x45, 0x68, 0x0e, 0xfc, 0xb5, 0xb7, 0x36, 0xdc,
+	0x76, 0xf1, 0x5c, 0x71, 0xb9, 0xa8, 0x46, 0x81, 0xca, 0x1f, 0x25, 0x38, 0x99, 0x60, 0xc3, 0x1d,
+	0xdb, 0x96, 0x8b, 0xd1, 0x55, 0x98, 0x71, 0x3d, 0xcd, 0x9b, 0xb8, 0x9c, 0x93, 0xe7, 0x53, 0x39,
+	0xe9, 0x52, 0x14, 0x95, 0xa3, 0x26, 0xc9, 0x16, 0x52, 0xc8, 0xa2, 0x57, 0x61, 0xc1, 0xb0, 0xee,
+	0xe0, 0x91, 0xed, 0x1c, 0xf4, 0xc6, 0xd8, 0x19, 0x60, 0xcb, 0xd3, 0x86, 0x58, 0xf0, 0x38, 0x2f,
+	0xc6, 0x76, 0x82, 0x21, 0xf4, 0x3a, 0x9c, 0x64, 0x5a, 0x72, 0xb1, 0xb3, 0x6f, 0x0c, 0x70, 0x4f,
+	0xdb, 0xd7, 0x0c, 0x53, 0xeb, 0x9b, 0xb8, 0x5d, 0x3a, 0x57, 0x5c, 0xae, 0xaa, 0x8b, 0x74, 0xb8,
+	0xcb, 0x46, 0xaf, 0x8b, 0x41, 0xe5, 0xfb, 0x12, 0x2c, 0x92, 0x1d, 0xee, 0x68, 0x8e, 0x67, 0x3c,
+	0x03, 0x39, 0x2b, 0xd0, 0x08, 0xef, 0xad, 0x5d, 0xa4, 0x63, 0x11, 0x18, 0xc1, 0x19, 0x0b, 0xf2,
+	0x44, 0x26, 0x25, 0xba, 0xcd, 0x08, 0x4c, 0xf9, 0x1e, 0x37, 0x88, 0x30, 0x9f, 0xc7, 0x51, 0x44,
+	0x9c, 0x66, 0x21, 0x49, 0xf3, 0x08, 0x6a, 0x50, 0xfe, 0x2c, 0xc1, 0xe2, 0x6d, 0x5b, 0xd3, 0x03,
+	0x83, 0xf9, 0xe4, 0xc5, 0xf9, 0x16, 0xcc, 0x30, 0xef, 0x6a, 0x97, 0x28, 0xad, 0x0b, 0x51, 0x5a,
+	0x6c, 0x6c, 0x35, 0xe0, 0xb0, 0x4b, 0x01, 0x2a, 0x9f, 0x84, 0x2e, 0x40, 0xcb, 0xc1, 0x63, 0xd3,
+	0x18, 0x68, 0x3d, 0x6b, 0x32, 0xea, 0x63, 0xa7, 0x5d, 0x3e, 0x27, 0x2d, 0x97, 0xd5, 0x26, 0x87,
+	0x6e, 0x53, 0xa0, 0xf2, 0x6d, 0x09, 0xda, 0x2a, 0x36, 0xb1, 0xe6, 0xe2, 0x4f, 0x73, 0xb3, 0x4b,
+	0x30, 0x63, 0xd9, 0x3a, 0xde, 0xda, 0xa0, 0x9b, 0x2d, 0xaa, 0xfc, 0x4b, 0xf9, 0x87, 0x04, 0x0b,
+	0x9b, 0xd8, 0x23, 0x5a, 0x37, 0x5c, 0xcf, 0x18, 0xf8, 0x66, 0xfd, 0x16, 0x14, 0x1d, 0xfc, 0x88,
+	0x73, 0x76, 0x29, 0xca, 0x99, 0x1f, 0xa4, 0xd2, 0x66, 0xaa, 0x64, 0x1e, 0x7a, 0x01, 0x1a, 0xfa,
+	0xc8, 0xec, 0x0d, 0xf6, 0x34, 0xcb, 0xc2, 0x26, 0xb3, 0x9b, 0x9a, 0x5a, 0xd7, 0x47, 0xe6, 0x3a,
+	0x07, 0xa1, 0x33, 0x00, 0x2e, 0x1e, 0x8e, 0xb0, 0xe5, 0x05, 0x71, 0x25, 0x04, 0x41, 0x2b, 0x30,
+	0xb7, 0xeb, 0xd8, 0xa3, 0x9e, 0xbb, 0xa7, 0x39, 0x7a, 0xcf, 0xc4, 0x9a, 0x8e, 0x1d, 0xca, 0x7d,
+	0x55, 0x9d, 0x25, 0x03, 0x5d, 0x02, 0xbf, 0x4d, 0xc1, 0xe8, 0x2a, 0x94, 0xdd, 0x81, 0x3d, 0xc6,
+	0x54, 0x07, 0xad, 0xb5, 0xd3, 0xab, 0xc9, 0xb8, 0xbb, 0xba, 0xa1, 0x79, 0x5a, 0x97, 0x20, 0xa9,
+	0x0c, 0x57, 0xf9, 0x6a, 0x81, 0x19, 0xe1, 0x67, 0xdc, 0xa7, 0x43, 0x86, 0x5a, 0xfe, 0x78, 0x0c,
+	0x75, 0x26, 0xcd, 0x50, 0x7f, 0x19, 0x18, 0xea, 0x67, 0x5d, 0x20, 0x81, 0x31, 0x97, 0x23, 0xc6,
+	0xfc, 0x43, 0x09, 0x9e, 0xdb, 0xc4, 0x9e, 0xcf, 0x3e, 0xb1, 0x4d, 0xfc, 0x19, 0x0d, 0xd4, 0x1f,
+	0x49, 0xd0, 0x49, 0xe3, 0xf5, 0x38, 0xc1, 0xfa, 0x01, 0x2c, 0xf9, 0x34, 0x7a, 0x3a, 0x76, 0x07,
+	0x8e, 0x31, 0x26, 0xbf, 0x99, 0xfb, 0xd5, 0xd7, 0xce, 0xa7, 0xb9, 0x45, 0x9c, 0x83, 0x45, 0x7f,
+	0x89, 0x8d, 0xd0, 0x0a, 0xca, 0xd7, 0x25, 0x58, 0x24, 0xee, 0xce, 0xfd, 0xd3, 0xda, 0xb5, 0x8f,
+	0x2e, 0xd7, 0xa8, 0xe7, 0x17, 0x12, 0x9e, 0x9f, 0x43, 0xc6, 0xf4, 0xe4, 0x13, 0xe7, 0xe7, 0x38,
+	0xb2, 0xfb, 0x4f, 0x28, 0x1b, 0xd6, 0xae, 0x2d, 0x44, 0x75, 0x36, 0x4d, 0x54, 0x61, 0x62, 0x0c,
+	0x5b, 0xb1, 0x18, 0x17, 0x41, 0x28, 0x3a, 0x86, 0xb9, 0xc5, 0xb7, 0x5d, 0x48, 0xd9, 0xf6, 0xd7,
+	0x24, 0x38, 0x99, 0x20, 0x78, 0x9c, 0x7d, 0xbf, 0x09, 0x33, 0x34, 0xc0, 0x8a, 0x8d, 0xbf, 0x98,
+	0xba, 0xf1, 0x10, 0xb9, 0xdb, 0x86, 0xeb, 0xa9, 0x7c, 0x8e, 0x62, 0x83, 0x1c, 0x1f, 0x23, 0xa1,
+	0x9f, 0x87, 0xfd, 0x9e, 0xa5, 0x8d, 0x98, 0x00, 0x6a, 0x6a, 0x9d, 0xc3, 0xb6, 0xb5, 0x11, 0x46,
+	0xcf, 0x41, 0x95, 0xb8, 0x6c, 0xcf, 0xd0, 0x85, 0xfa, 0x2b, 0xd4, 0x85, 0x75, 0x17, 0x9d, 0x06,
+	0xa0, 0x43, 0x9a, 0xae, 0x3b, 0x2c, 0x2b, 0xd4, 0xd4, 0x1a, 0x81, 0x5c, 0x27, 0x00, 0xe5, 0x1b,
+	0x12, 0x34, 0x48, 0xcc, 0xbe, 0x83, 0x3d, 0x8d, 0xe8, 0x01, 0xbd, 0x01, 0x35, 0xd3, 0xd6, 0xf4,
+	0x9e, 0x77, 0x30, 0x66, 0xa4, 0x5a, 0x6b, 0xa7, 0xd2, 0xb6, 0x40, 0x26, 0xdd, 0x3d, 0x18, 0x63,
+	0xb5, 0x6a, 0xf2, 0x5f, 0x79, 0xe4, 0x9d, 0x70, 0xe5, 0x62, 0x8a, 0x2b, 0xff, 0xa8, 0x0c, 0x4b,
+	0xff, 0xa7, 0x79, 0x83, 0xbd, 0x8d, 0x91, 0x48, 0x6e, 0x47, 0x37, 0x82, 0x20, 0xb6, 0x15, 0xc2,
+	0xb1, 0xed, 0x63, 0x8b, 0x9d, 0xbe, 0x9d, 0x97, 0xd3, 0xec, 0x9c, 0x14, 0x18, 0xab, 0xf7, 0xb9,
+	0xaa, 0x42, 0x76, 0x1e, 0xca, 0x41, 0x33, 0x47, 0xc9, 0x41, 0xeb, 0xd0, 0xc4, 0x4f, 0x06, 0xe6,
+	0x84, 0xe8, 0x9c, 0x52, 0xaf, 0x50, 0xea, 0x67, 0x52, 0xa8, 0x87, 0x9d, 0xac, 0xc1, 0x27, 0x6d,
+	0x71, 0x1e, 0x98, 0xaa, 0x47, 0xd8, 0xd3, 0xda, 0x55, 0xca, 0xc6, 0xb9, 0x2c, 0x55, 0x0b, 0xfb,
+	0x60, 0xea, 0x26, 0x5f, 0xe8, 0x14, 0xd4, 0x78, 0xc6, 0xdb, 0xda, 0x68, 0xd7, 0xa8, 0xf8, 0x02,
+	0x00, 0xd2, 0xa0, 0xc9, 0x23, 0x10, 0xe7, 0x10, 0x28, 0x87, 0x6f, 0xa6, 0x11, 0x48, 0x57, 0x76,
+	0x98, 0x73, 0xf7, 0x1d, 0xcb, 0x73, 0x0e, 0xd4, 0x86, 0x1b, 0x02, 0x91, 0xa2, 0xc6, 0xde, 0xdd,
+	0x35, 0x0d, 0x0b, 0x6f, 0x33, 0x0d, 0xd7, 0x29, 0x13, 0x51, 0x60, 0xa7, 0x07, 0x73, 0x89, 0x85,
+	0x90, 0x0c, 0xc5, 0x87, 0xf8, 0x80, 0x9a, 0x51, 0x51, 0x25, 0x3f, 0xd1, 0x6b, 0x50, 0xde, 0xd7,
+	0xcc, 0x09, 0xa6, 0x66, 0x32, 0x5d, 0x92, 0x0c, 0xf9, 0xbf, 0x0a, 0xd7, 0x24, 0xe5, 0x07, 0x12,
+	0x2c, 0xde, 0xb3, 0xdc, 0x49, 0xdf, 0xdf, 0xc1, 0xa7, 0x63, 0xad, 0xf1, 0x38, 0x51, 0x4a, 0xc4,
+	0x09, 0xe5, 0x17, 0x25, 0x98, 0xe5, 0xbb, 0x20, 0x4a, 0xa5, 0x0e, 0x7f, 0x0a, 0x6a, 0x7e, 0xaa,
+	0xe0, 0x02, 0x09, 0x00, 0xe8, 0x1c, 0xd4, 0x43, 0xe6, 0xce, 0xb9, 0x0a, 0x83, 0x72, 0xb1, 0x26,
+	0x12, 0x7f, 0x29, 0x94, 0xf8, 0x4f, 0x03, 0xec, 0x9a, 0x13, 0x77, 0xaf, 0xe7, 0x19, 0x23, 0xcc,
+	0x0f, 0x1e, 0x35, 0x0a, 0xb9, 0x6b, 0x8c, 0x30, 0xba, 0x0e, 0x8d, 0xbe, 0x61, 0x99, 0xf6, 0xb0,
+	0x37, 0xd6, 0xbc, 0x3d, 0xb7, 0x3d, 0x93, 0x69, 0xe0, 0x37, 0x0d, 0x6c, 0xea, 0x37, 0x28, 0xae,
+	0x5a, 0x67, 0x73, 0x76, 0xc8, 0x14, 0x74, 0x06, 0xea, 0xd6, 0x64, 0xd4, 0xb3, 0x77, 0x7b, 0x8e,
+	0xfd, 0x98, 0xb8, 0x08, 0x25, 0x61, 0x4d, 0x46, 0xef, 0xed, 0xaa, 0xf6, 0x63, 0x12, 0xaa, 0x6b,
+	0x24, 0x68, 0xbb, 0xa6, 0x3d, 0x74, 0xdb, 0xd5, 0x5c, 0xeb, 0x07, 0x13, 0xc8, 0x6c, 0x1d, 0x9b,
+	0x9e, 0x46, 0x67, 0xd7, 0xf2, 0xcd, 0xf6, 0x27, 0xa0, 0x8b, 0xd0, 0x1a, 0xd8, 0xa3, 0xb1, 0x46,
+	0x25, 0x74, 0xd3, 0xb1, 0x47, 0xd4, 0x3f, 0x8a, 0x6a, 0x0c, 0x8a, 0xd6, 0xa1, 0x6e, 0x58, 0x3a,
+	0x7e, 0xc2, 0x9d, 0xa8, 0x4e, 0xe9, 0x28, 0x69, 0x4e, 0x44, 0x09, 0x6d, 0x11, 0x5c, 0x6a, 0xa0,
+	0x60, 0x88, 0x9f, 0x2e, 0xb1, 0x0c, 0xe1, 0x8b, 0xae, 0xf1, 0x21, 0x6e, 0x37, 0x98, 0x16, 0x39,
+	0xac, 0x6b, 0x7c, 0x88, 0xc9, 0xa1, 0xd6, 0xb0, 0x5c, 0xec, 0x78, 0xa2, 0xc4, 0x68, 0x37, 0xa9,
+	0xf9, 0x34, 0x19, 0x94, 0x1b, 0xb6, 0xf2, 0xe3, 0x02, 0xb4, 0xa2, 0x84, 0x50, 0x1b, 0x2a, 0xbb,
+	0x14, 0x22, 0xac, 0x47, 0x7c, 0x12, 0xb2, 0xd8, 0x22, 0xd5, 0x7e, 0x8f, 0xf2, 0x42, 0x8d, 0xa7,
+	0xaa, 0xd6, 0x19, 0x8c, 0x2e, 0x40, 0x8c, 0x80, 0x6d, 0x8f, 0x5a, 0x6c, 0x91, 0x92, 0xac, 0x51,
+	0x08, 0xcd, 0x6b, 0x6d, 0xa8, 0xb0, 0x6d, 0x08, 0xd3, 0x11, 0x9f, 0x64, 0xa4, 0x3f, 0x31, 0x28,
+	0x55, 0x66, 0x3a, 0xe2, 0x13, 0x6d, 0x40, 0x83, 0x2d, 0x39, 0xd6, 0x1c, 0x6d, 0x24, 0x0c, 0xe7,
+	0x85, 0x54, 0xe7, 0xbb, 0x85, 0x0f, 0xee, 0x13, 0x3f, 0xde, 0xd1, 0x0c, 0x47, 0x65, 0x82, 0xde,
+	0xa1, 0xb3, 0xd0, 0x32, 0xc8, 0x6c, 0x95, 0x5d, 0xc3, 0xc4, 0xdc, 0x04, 0x2b, 0x34, 0x79, 0xb6,
+	0x28, 0xfc, 0xa6, 0x61, 0x62, 0x66, 0x65, 0xfe, 0x16, 0xa8, 0x68, 0xab, 0xcc, 0xc8, 0x28, 0x84,
+	0x08, 0x56, 0xf9, 0x66, 0x09, 0xe6, 0x89, 0xaf, 0x71, 0xb7, 0x3b, 0x46, 0x26, 0x3b, 0x0d, 0xa0,
+	0xbb, 0x5e, 0x2f, 0x12, 0x1f, 0x6a, 0xba, 0xeb, 0xb1, 0x38, 0x87, 0xde, 0x10, 0x89, 0xa8, 0x98,
+	0x7d, 0x36, 0x8d, 0xf9, 0x7e, 0x32, 0x19, 0x1d, 0xa9, 0x72, 0x3f, 0x0f, 0x4d, 0xd7, 0x9e, 0x38,
+	0x03, 0xdc, 0x8b, 0x54, 0x11, 0x0d, 0x06, 0xdc, 0x4e, 0x8f, 0x60, 0x33, 0xa9, 0x1d, 0x84, 0x50,
+	0x42, 0xaa, 0x1c, 0x2f, 0x21, 0x55, 0xe3, 0x09, 0xe9, 0x16, 0xcc, 0x52, 0xf7, 0xeb, 0x8d, 0x6d,
+	0x97, 0x15, 0x63, 0xed, 0x5a, 0x9a, 0x37, 0xf9, 0xc5, 0xf8, 0x1d, 0x77, 0xb8, 0xc3, 0x51, 0xd5,
+	0x16, 0x9d, 0x2a, 0x3e, 0x5d, 0x62, 0x7e, 0xfb, 0xd8, 0x71, 0x0d, 0xdb, 0x6a, 0x03, 0x33, 0x3f,
+	0xfe, 0x49, 0x84, 0x61, 0x61, 0xac, 0xf7, 0x3c, 0x47, 0xb3, 0xdc, 0x5d, 0xec, 0xd0, 0xa4, 0x54,
+	0x55, 0x1b, 0x04, 0x78, 0x97, 0xc3, 0x94, 0xdf, 0x15, 0x60, 0x89, 0xd7, 0x86, 0xc7, 0xb7, 0x8b,
+	0xac, 0x9c, 0x21, 0x82, 0x6e, 0xf1, 0x90, 0x6a, 0xab, 0x94, 0xe3, 0xd4, 0x53, 0x4e, 0x39, 0xf5,
+	0x44, 0x2b, 0x8e, 0x99, 0x44, 0xc5, 0xe1, 0xf7, 0x0f, 0x2a, 0xf9, 0xfb, 0x07, 0x68, 0x01, 0xca,
+	0xf4, 0x18, 0x4c, 0x75, 0x57, 0x53, 0xd9, 0x47, 0x3e, 0x81, 0xfe, 0x45, 0x82, 0x66, 0x17, 0x6b,
+	0xce, 0x60, 0x4f, 0xc8, 0xf1, 0xf5, 0x70, 0xbf, 0xe5, 0xc5, 0x0c, 0x15, 0x47, 0xa6, 0x7c, 0x7e,
+	0x1a, 0x2d, 0x7f, 0x95, 0xa0, 0xf1, 0xbf, 0x64, 0x48, 0x6c, 0xf6, 0x5a, 0x78, 0xb3, 0x17, 0x33,
+	0x36, 0xab, 0x62, 0xcf, 0x31, 0xf0, 0x3e, 0xfe, 0xdc, 0x6d, 0xf7, 0x37, 0x12, 0x74, 0xba, 0x07,
+	0xd6, 0x40, 0x65, 0xbe, 0x7c, 0x7c, 0x8f, 0x39, 0x0f, 0xcd, 0xfd, 0xc8, 0x51, 0xa9, 0x40, 0x0d,
+	0xae, 0xb1, 0x1f, 0xae, 0xa9, 0x54, 0x90, 0x45, 0x9b, 0x87, 0x6f, 0x56, 0x84, 0xd6, 0x97, 0xd2,
+	0xb8, 0x8e, 0x31, 0x47, 0x43, 0xd3, 0xac, 0x13, 0x05, 0x2a, 0x0e, 0xcc, 0xa7, 0xe0, 0xa1, 0x93,
+	0x50, 0xe1, 0xe5, 0x5b, 0x5b, 0x0a, 0xb9, 0xb0, 0x4e, 0xb4, 0x13, 0x34, 0x20, 0x0c, 0x3d, 0x79,
+	0xfc, 0xd2, 0xd1, 0x59, 0xa8, 0xfb, 0xe7, 0x6c, 0x3d, 0xa1, 0x1e, 0xdd, 0x55, 0x7e, 0x2e, 0xc1,
+	0xd2, 0xbb, 0x9a, 0xa5, 0xdb, 0xbb, 0xbb, 0xc7, 0x97, 0xdc, 0x3a, 0x44, 0x8e, 0xe0, 0x79, 0x8b,
+	0xfb, 0xc8, 0x24, 0x74, 0x09, 0xe6, 0x1c, 0x16, 0xfc, 0xf4, 0xa8, 0x68, 0x8b, 0xaa, 0x2c, 0x06,
+	0x7c, 0x91, 0xfd, 0xba, 0x00, 0x88, 0xc4, 0xfb, 0x1b, 0x9a, 0xa9, 0x59, 0x03, 0x7c, 0x74, 0xd6,
+	0x2f, 0x40, 0x2b, 0x92, 0xa5, 0xfc, 0x3b, 0x90, 0x70, 0x9a, 0x72, 0xd1, 0x2d, 0x68, 0xf5, 0x19,
+	0xa9, 0x9e, 0x83, 0x35, 0xd7, 0xb6, 0x68, 0xfc, 0x6c, 0xa5, 0xd7, 0xf1, 0x77, 0x1d, 0x63, 0x38,
+	0xc4, 0xce, 0xba, 0x6d, 0xe9, 0x2c, 0x4f, 0x34, 0xfb, 0x82, 0x4d, 0x32, 0x95, 0x28, 0x27, 0x48,
+	0xd9, 0xa2, 0x7e, 0x04, 0x3f, 0x67, 0x53, 0x51, 0xb8, 0x58, 0x33, 0x03, 0x41, 0x04, 0x01, 0x57,
+	0x66, 0x03, 0xdd, 0xec, 0x36, 0x4e, 0x5a, 0x0a, 0x3d, 0x09, 0x15, 0xdd, 0x39, 0xe8, 0x39, 0x13,
+	0x8b, 0x86, 0xde, 0xaa, 0x3a, 0xa3, 0x3b, 0x07, 0xea, 0xc4, 0x52, 0x7e, 0x2a, 0x01, 0xf2, 0x0b,
+	0x14, 0x5a, 0x70, 0x51, 0xd3, 0x8b, 0xaf, 0x29, 0xa5, 0xac, 0x79, 0x0a, 0x6a, 0xba, 0x98, 0xc9,
+	0x5d, 0x25, 0x00, 0xd0, 0xf8, 0x4c, 0x77, 0xd3, 0x23, 0x89, 0x18, 0xeb, 0xa2, 0x00, 0x60, 0xc0,
+	0xdb, 0x14, 0x16, 0x4d, 0xcd, 0xa5, 0x78, 0x6a, 0x0e, 0xb7, 0x2f, 0xca, 0x91, 0xf6, 0x85, 0xf2,
+	0x51, 0x01, 0x64, 0x1a, 0xea, 0xd6, 0x83, 0x1a, 0x3a, 0x17, 0xd3, 0xe7, 0xa1, 0xc9, 0xaf, 0x0f,
+	0x23, 0x8c, 0x37, 0x1e, 0x85, 0x16, 0x43, 0x57, 0x60, 0x81, 0x21, 0x39, 0xd8, 0x9d, 0x98, 0xc1,
+	0xd9, 0x97, 0x1d, 0x44, 0xd1, 0x23, 0x16, 0x63, 0xc9, 0x90, 0x98, 0x71, 0x0f, 0x96, 0x86, 0xa6,
+	0xdd, 0xd7, 0xcc, 0x5e, 0x54, 0x6f, 0x4c, 0xb9, 0x39, 0x5c, 0x61, 0x81, 0x4d, 0xef, 0x86, 0x95,
+	0xeb, 0xa2, 0x4d, 0x52, 0x2d, 0xe3, 0x87, 0xfe, 0xd9, 0x84, 0x77, 0xa6, 0xf3, 0x1c, 0x4d, 0x1a,
+	0x64, 0xa2, 0xf8, 0x52, 0xbe, 0x2b, 0xc1, 0x6c, 0xac, 0x03, 0x19, 0xaf, 0xe1, 0xa4, 0x64, 0x0d,
+	0x77, 0x0d, 0xca, 0x2e, 0xc1, 0xa5, 0x42, 0x6a, 0xa5, 0xd7, 0x17, 0xd1, 0x55, 0x55, 0x36, 0x01,
+	0x5d, 0x86, 0xf9, 0x94, 0xbb, 0x2a, 0x6e, 0x03, 0x28, 0x79, 0x55, 0xa5, 0xfc, 0xa1, 0x04, 0xf5,
+	0x90, 0x3c, 0xa6, 0x94, 0x9f, 0x79, 0x5a, 0x4a, 0xb1, 0xed, 0x15, 0x93, 0xdb, 0xcb, 0xb8, 0xac,
+	0x21, 0x76, 0x37, 0xc2, 0x23, 0x76, 0x70, 0xe7, 0x55, 0xc4, 0x08, 0x8f, 0x68, 0x3d, 0x44, 0x4c,
+	0x72, 0x32, 0x62, 0x85, 0x23, 0xf3, 0xb3, 0x8a, 0x35, 0x19, 0xd1, 0xb2, 0x31, 0x5a, 0xb3, 0x54,
+	0x0e, 0xa9, 0x59, 0xaa, 0xd1, 0x9a, 0x25, 0xe2, 0x47, 0xb5, 0xb8, 0x1f, 0xe5, 0xad, 0x08, 0xaf,
+	0xc0, 0xfc, 0xc0, 0xc1, 0x9a, 0x87, 0xf5, 0x1b, 0x07, 0xeb, 0xfe, 0x10, 0x3f, 0x15, 0xa5, 0x0d,
+	0xa1, 0x9b, 0x41, 0x2b, 0x86, 0x69, 0xb9, 0x41, 0xb5, 0x9c, 0x5e, 0x12, 0x71, 0xdd, 0x30, 0x25,
+	0x37, 0xdc, 0xd0, 0x57, 0xbc, 0x16, 0x6d, 0x1e, 0xa9, 0x16, 0x3d, 0x0b, 0x75, 0x91, 0x56, 0x89,
+	0xbb, 0xb7, 0x58, 0x48, 0xe4, 0x20, 0xd2, 0xb0, 0x0c, 0x07, 0x83, 0xd9, 0x68, 0x2f, 0x33, 0x5e,
+	0x50, 0xca, 0x89, 0x82, 0x52, 0xf9, 0x7d, 0x11, 0x5a, 0x41, 0xa1, 0x92, 0x3b, 0x5a, 0xe4, 0xb9,
+	0x96, 0xdd, 0x06, 0x39, 0x48, 0xc6, 0x54, 0x90, 0x87, 0xd6, 0x5a, 0xf1, 0x7b, 0x80, 0xd9, 0x71,
+	0x14, 0x10, 0xed, 0xb4, 0x96, 0x9e, 0xaa, 0xd3, 0x7a, 0xcc, 0x1b, 0xac, 0xab, 0xb0, 0xe8, 0x27,
+	0xe0, 0xc8, 0xb6, 0xd9, 0x41, 0x7e, 0x41, 0x0c, 0xee, 0x84, 0xb7, 0x9f, 0xe1, 0xe9, 0x95, 0x2c,
+	0x4f, 0x8f, 0x6b, 0xba, 0x9a, 0xd0, 0x74, 0xf2, 0x22, 0xad, 0x96, 0x76, 0x91, 0x76, 0x0f, 0xe6,
+	0x69, 0x7b, 0x8d, 0x5c, 0x9e, 0xf4, 0xb1, 0x7f, 0x2c, 0xcd, 0xa3, 0xd6, 0x0e, 0x54, 0x63, 0x27,
+	0x5b, 0xff, 0x5b, 0xf9, 0x8a, 0x04, 0x4b, 0xc9, 0x75, 0xa9, 0xc5, 0x04, 0xf1, 0x42, 0x8a, 0xc4,
+	0x8b, 0xff, 0x87, 0xf9, 0x60, 0xf9, 0xe8, 0x99, 0x39, 0xe3, 0x54, 0x98, 0xc2, 0xb8, 0x8a, 0x82,
+	0x35, 0x04, 0x4c, 0xf9, 0xbb, 0xe4, 0x77, 0x29, 0x09, 0x6c, 0x48, 0x3b, 0xb4, 0x24, 0x87, 0xd9,
+	0x96, 0x69, 0x58, 0xb8, 0x17, 0x61, 0xa7, 0xc1, 0x80, 0xbc, 0xb0, 0x7e, 0x17, 0x66, 0x39, 0x92,
+	0x9f, 0x8a, 0x72, 0x9e, 0xca, 0x5a, 0x6c, 0x9e, 0x9f, 0x84, 0x2e, 0x40, 0x8b, 0xb7, 0x4e, 0x05,
+	0xbd, 0x62, 0x4a, 0x43, 0x15, 0xfd, 0x0f, 0xc8, 0x02, 0xed, 0x69, 0x93, 0xdf, 0x2c, 0x9f, 0xe8,
+	0x9f, 0xee, 0xbe, 0x2c, 0x41, 0x3b, 0x9a, 0x0a, 0x43, 0xdb, 0x7f, 0xfa, 0x33, 0xde, 0x7f, 0x47,
+	0x2f, 0x9d, 0x2e, 0x1c, 0xc2, 0x4f, 0x40, 0x47, 0x5c, 0x3d, 0x6d, 0xd3, 0x0b, 0x44, 0x52, 0x7d,
+	0x6c, 0x18, 0xae, 0xe7, 0x18, 0xfd, 0xc9, 0xb1, 0x9e, 0x16, 0x28, 0x7f, 0x2a, 0xc0, 0xf3, 0xa9,
+	0x0b, 0x1e, 0xe7, 0x7a, 0x29, 0xab, 0xd8, 0x7f, 0x05, 0xd0, 0xd0, 0xb1, 0x1f, 0x1b, 0xd6, 0xb0,
+	0x97, 0x28, 0xd6, 0xe6, 0xf8, 0x48, 0xe8, 0x28, 0x79, 0x03, 0xaa, 0x31, 0xdd, 0x5d, 0x3c, 0x44,
+	0x56, 0xf7, 0x59, 0x6f, 0x83, 0xb5, 0x5b, 0xc4, 0x3c, 0xb2, 0x86, 0xef, 0x02, 0xe5, 0xec, 0x35,
+	0xb8, 0x8d, 0x47, 0xd6, 0x10, 0xf3, 0xd0, 0xdb, 0x50, 0x67, 0x05, 0xe3, 0x7d, 0x03, 0x3f, 0xce,
+	0x68, 0xf2, 0xf2, 0x28, 0xe8, 0xa3, 0xa9, 0xe1, 0x29, 0xca, 0xdf, 0x24, 0x80, 0x60, 0x8c, 0x14,
+	0xab, 0x81, 0x7b, 0x71, 0x7f, 0x09, 0x41, 0x48, 0x76, 0x8e, 0x1e, 0x08, 0xc5, 0x27, 0xfa, 0x00,
+	0x64, 0xbe, 0x35, 0x62, 0xe7, 0xa4, 0x23, 0x28, 0xc2, 0xfb, 0x6b, 0x87, 0xf3, 0xb3, 0xda, 0x8d,
+	0x4d, 0x63, 0x77, 0x15, 0x89, 0xd5, 0x3a, 0xeb, 0xb0, 0x98, 0x8a, 0x9a, 0x72, 0x1b, 0xb1, 0x10,
+	0xbe, 0x8d, 0x28, 0x86, 0x6f, 0x1b, 0xbe, 0x25, 0x01, 0x4a, 0xaa, 0x05, 0xb5, 0xa0, 0xe0, 0xc7,
+	0x87, 0xc2, 0xd6, 0x46, 0x4c, 0x0e, 0x85, 0x84, 0x1c, 0x4e, 0x41, 0xcd, 0x8f, 0xfc, 0xdc, 0xcd,
+	0x03, 0x40, 0x58, 0x4a, 0xa5, 0xa8, 0x94, 0x42, 0x8d, 0xaf, 0x72, 0xa4, 0xf1, 0xa5, 0xec, 0x01,
+	0x4a, 0xaa, 0x3a, 0xbc, 0x92, 0x14, 0x5d, 0x69, 0x1a, 0x87, 0x21, 0x4a, 0xc5, 0x28, 0xa5, 0xdf,
+	0x4a, 0x80, 0x82, 0xdc, 0xe6, 0x5f, 0x64, 0xe4, 0x49, 0x08, 0x97, 0x61, 0x3e, 0x99, 0xf9, 0x44,
+	0xba, 0x47, 0x89, 0xbc, 0x97, 0x96, 0xa3, 0x8a, 0x29, 0x39, 0x0a, 0xbd, 0xee, 0xfb, 0x32, 0x4b,
+	0xe4, 0x67, 0xb2, 0x12, 0x79, 0xd4, 0x9d, 0x95, 0x9f, 0x49, 0x30, 0xe7, 0x53, 0x7b, 0xaa, 0x9d,
+	0x4c, 0xbf, 0x98, 0x79, 0xc6, 0xac, 0x77, 0xa1, 0xc2, 0x7b, 0x19, 0x09, 0xe3, 0xcb, 0x73, 0x6a,
+	0x5f, 0x80, 0x32, 0x09, 0x5d, 0x22, 0x46, 0xb1, 0x0f, 0x62, 0xdd, 0x40, 0x5a, 0x3d, 0xd7, 0x99,
+	0x0d, 0x5c, 0x81, 0xd2, 0xb4, 0x7b, 0x68, 0x82, 0x4d, 0x4f, 0x47, 0x14, 0x33, 0x87, 0x58, 0x22,
+	0x05, 0x47, 0x31, 0x5e, 0x70, 0x64, 0xbd, 0xeb, 0xfa, 0x15, 0x79, 0x91, 0x79, 0x60, 0x0d, 0x3e,
+	0x96, 0xd4, 0x90, 0x4b, 0x40, 0x21, 0xcf, 0x29, 0x46, 0x3d, 0xe7, 0x1a, 0x54, 0xd8, 0xc9, 0x5e,
+	0xc4, 0xee, 0x33, 0x59, 0x82, 0x61, 0x62, 0x54, 0x05, 0xfa, 0xca, 0xdb, 0x50, 0xf3, 0xbb, 0x6b,
+	0xa8, 0x0e, 0x95, 0x7b, 0xd6, 0x2d, 0xcb, 0x7e, 0x6c, 0xc9, 0x27, 0x50, 0x05, 0x8a, 0xd7, 0x4d,
+	0x53, 0x96, 0x50, 0x13, 0x6a, 0x5d, 0xcf, 0xc1, 0xda, 0xc8, 0xb0, 0x86, 0x72, 0x01, 0xb5, 0x00,
+	0xde, 0x35, 0x5c, 0xcf, 0x76, 0x8c, 0x81, 0x66, 0xca, 0xc5, 0x95, 0x0f, 0xa1, 0x15, 0x3d, 0xd8,
+	0xa2, 0x06, 0x54, 0xb7, 0x6d, 0xef, 0x9d, 0x27, 0x86, 0xeb, 0xc9, 0x27, 0x08, 0xfe, 0xb6, 0xed,
+	0xed, 0x38, 0xd8, 0xc5, 0x96, 0x27, 0x4b, 0x08, 0x60, 0xe6, 0x3d, 0x6b, 0xc3, 0x70, 0x1f, 0xca,
+	0x05, 0x34, 0xcf, 0x4b, 0x53, 0xcd, 0xdc, 0xe2, 0xa7, 0x45, 0xb9, 0x48, 0xa6, 0xfb, 0x5f, 0x25,
+	0x24, 0x43, 0xc3, 0x47, 0xd9, 0xdc, 0xb9, 0x27, 0x97, 0x51, 0x0d, 0xca, 0xec, 0xe7, 0xcc, 0x8a,
+	0x0e, 0x72, 0xbc, 0xe1, 0x42, 0xd6, 0x64, 0x9b, 0xf0, 0x41, 0xf2, 0x09, 0xb2, 0x33, 0xde, 0xf1,
+	0x92, 0x25, 0x34, 0x0b, 0xf5, 0x50, 0xff, 0x48, 0x2e, 0x10, 0xc0, 0xa6, 0x33, 0x1e, 0x70, 0xed,
+	0x31, 0x16, 0x48, 0x40, 0xde, 0x20, 0x92, 0x28, 0xad, 0xdc, 0x80, 0xaa, 0x38, 0x71, 0x13, 0x54,
+	0x2e, 0x22, 0xf2, 0x29, 0x9f, 0x40, 0x73, 0xd0, 0x8c, 0xbc, 0x70, 0x93, 0x25, 0x84, 0xa0, 0x15,
+	0x7d, 0x79, 0x29, 0x17, 0x56, 0xd6, 0x00, 0x02, 0x8f, 0x21, 0xec, 0x6c, 0x59, 0xfb, 0x9a, 0x69,
+	0xe8, 0x8c, 0x37, 0x32, 0x44, 0xa4, 0x4b, 0xa5, 0xc3, 0x1a, 0x24, 0x72, 0x61, 0xe5, 0x2c, 0x54,
+	0x85, 0x2d, 0x13, 0xb8, 0x8a, 0x47, 0xf6, 0x3e, 0x66, 0x9a, 0xe9, 0x62, 0x4f, 0x96, 0xd6, 0xbe,
+	0xd3, 0x04, 0x60, 0xad, 0x10, 0xdb, 0x76, 0x74, 0x34, 0x06, 0xb4, 0x89, 0x3d, 0x52, 0xe6, 0xd9,
+	0x96, 0x28, 0xd1, 0x5c, 0x74, 0x25, 0xfb, 0x65, 0x61, 0x0c, 0x95, 0xef, 0xbf, 0x93, 0xd5, 0x2e,
+	0x8e, 0xa1, 0x2b, 0x27, 0xd0, 0x88, 0x52, 0x24, 0xb7, 0xb3, 0x77, 0x8d, 0xc1, 0x43, 0xbf, 0x87,
+	0x92, 0x4d, 0x31, 0x86, 0x2a, 0x28, 0xc6, 0xca, 0x25, 0xfe, 0xd1, 0xf5, 0x1c, 0xc3, 0x1a, 0x8a,
+	0xd3, 0x91, 0x72, 0x02, 0x3d, 0x8a, 0xbd, 0xa4, 0x14, 0x04, 0xd7, 0xf2, 0x3c, 0x9e, 0x3c, 0x1a,
+	0x49, 0x13, 0x66, 0x63, 0xcf, 0xae, 0xd1, 0x4a, 0xfa, 0xfb, 0x9d, 0xb4, 0x27, 0xe2, 0x9d, 0x4b,
+	0xb9, 0x70, 0x7d, 0x6a, 0x06, 0xb4, 0xa2, 0x4f, 0x8b, 0xd1, 0x7f, 0x64, 0x2d, 0x90, 0x78, 0x41,
+	0xd8, 0x59, 0xc9, 0x83, 0xea, 0x93, 0x7a, 0xc0, 0x8c, 0x74, 0x1a, 0xa9, 0xd4, 0xd7, 0x9b, 0x9d,
+	0xc3, 0x0e, 0xa6, 0xca, 0x09, 0xf4, 0x01, 0xcc, 0x25, 0xde, 0x39, 0xa2, 0x97, 0xd3, 0x7b, 0xe4,
+	0xe9, 0xcf, 0x21, 0xa7, 0x51, 0x78, 0x10, 0x77, 0xb1, 0x6c, 0xee, 0x13, 0x6f, 0x82, 0xf3, 0x73,
+	0x1f, 0x5a, 0xfe, 0x30, 0xee, 0x9f, 0x9a, 0xc2, 0x84, 0xba, 0x4d, 0xbc, 0x29, 0xf7, 0x4a, 0x1a,
+	0x89, 0xcc, 0xc7, 0x96, 0x9d, 0xd5, 0xbc, 0xe8, 0x61, 0xeb, 0x8a, 0xbe, 0xe7, 0x4b, 0x17, 0x5a,
+	0xea, 0x1b, 0xc4, 0xce, 0x4a, 0x1e, 0x54, 0x9f, 0xd4, 0xdd, 0x48, 0x88, 0x45, 0x17, 0xb3, 0x94,
+	0x13, 0xed, 0xe1, 0x4f, 0x93, 0xdb, 0x17, 0x01, 0x31, 0xdf, 0xb1, 0x76, 0x8d, 0xe1, 0xc4, 0xd1,
+	0x98, 0x61, 0x65, 0x85, 0x9b, 0x24, 0xaa, 0x20, 0xf3, 0xea, 0x53, 0xcc, 0xf0, 0xb7, 0xd4, 0x03,
+	0xd8, 0xc4, 0xde, 0x1d, 0xec, 0x39, 0xc6, 0xc0, 0x8d, 0xef, 0x88, 0x7f, 0x04, 0x08, 0x82, 0xd4,
+	0x4b, 0x53, 0xf1, 0x7c, 0x02, 0x7d, 0xa8, 0x6f, 0x62, 0x8f, 0x9f, 0xa0, 0x5c, 0x94, 0x39, 0x53,
+	0x60, 0x08, 0x12, 0xcb, 0xd3, 0x11, 0xc3, 0xe1, 0x2c, 0xf6, 0xb6, 0x11, 0x65, 0x2a, 0x36, 0xf9,
+	0xe2, 0xb2, 0x73, 0x29, 0x17, 0xae, 0xa0, 0xb6, 0xf6, 0x93, 0x16, 0xd4, 0x68, 0x7e, 0x22, 0xc9,
+	0xf4, 0xdf, 0xe9, 0xe9, 0x19, 0xa4, 0xa7, 0xf7, 0x61, 0x36, 0xf6, 0x54, 0x2e, 0x5d, 0x9f, 0xe9,
+	0xef, 0xe9, 0x72, 0x44, 0xd9, 0xe8, 0x33, 0xb6, 0xf4, 0x80, 0x91, 0xfa, 0xd4, 0x6d, 0xda, 0xda,
+	0xf7, 0xd9, 0x2b, 0x53, 0xbf, 0xd5, 0xf4, 0x52, 0x66, 0x91, 0x11, 0xbd, 0xa2, 0xfc, 0xf4, 0xa3,
+	0xf7, 0xb3, 0xcf, 0x6e, 0xef, 0xc3, 0x6c, 0xec, 0x2d, 0x48, 0xba, 0x56, 0xd3, 0x1f, 0x8c, 0x4c,
+	0x5b, 0xfd, 0x13, 0x4c, 0x03, 0x3a, 0xcc, 0xa7, 0x5c, 0xd3, 0xa3, 0xd5, 0xac, 0xea, 0x24, 0xfd,
+	0x3e, 0x7f, 0xfa, 0x86, 0x9a, 0x11, 0x57, 0x42, 0xcb, 0x59, 0x4c, 0xc6, 0xff, 0x49, 0xd3, 0x79,
+	0x39, 0xdf, 0xdf, 0x6e, 0xfc, 0x0d, 0x75, 0x61, 0x86, 0xbd, 0x10, 0x41, 0x2f, 0xa4, 0xee, 0x21,
+	0xfc, 0x7a, 0xa4, 0x33, 0xed, 0x8d, 0x89, 0x3b, 0x31, 0x3d, 0x97, 0x2e, 0x5a, 0xa6, 0x51, 0x12,
+	0xa5, 0x3e, 0x6d, 0x0a, 0x3f, 0xeb, 0xe8, 0x4c, 0x7f, 0xc9, 0x21, 0x16, 0xfd, 0xd7, 0xce, 0x95,
+	0x4f, 0x60, 0x3e, 0xa5, 0x91, 0x8a, 0xb2, 0xce, 0x44, 0x19, 0x2d, 0xdc, 0xce, 0xe5, 0xdc, 0xf8,
+	0x3e, 0xe5, 0x2f, 0x80, 0x1c, 0xaf, 0xfa, 0xd1, 0xa5, 0x2c, 0x7b, 0x4e, 0xa3, 0x79, 0xb8, 0x31,
+	0xdf, 0x78, 0xed, 0xc1, 0xda, 0xd0, 0xf0, 0xf6, 0x26, 0x7d, 0x32, 0x72, 0x99, 0xa1, 0xbe, 0x62,
+	0xd8, 0xfc, 0xd7, 0x65, 0x21, 0xff, 0xcb, 0x74, 0xf6, 0x65, 0x4a, 0x6a, 0xdc, 0xef, 0xcf, 0xd0,
+	0xcf, 0xab, 0xff, 0x1c, 0x00, 0xba, 0xca, 0xb6, 0xc4, 0x21, 0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
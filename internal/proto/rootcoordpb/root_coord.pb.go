@@ -674,101 +674,104 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x13, 0x37,
-	0x14, 0xc6, 0x36, 0x49, 0xec, 0x63, 0xc7, 0x0e, 0x1a, 0x2e, 0xae, 0xa1, 0xd4, 0xb8, 0x14, 0xcc,
-	0xcd, 0xa1, 0x61, 0x86, 0x52, 0xde, 0x88, 0xcd, 0x04, 0x4f, 0x9b, 0x21, 0x5d, 0x43, 0x87, 0x5e,
-	0x18, 0x57, 0xde, 0x15, 0x8e, 0x26, 0xeb, 0x95, 0x59, 0xc9, 0xb9, 0x4c, 0x9f, 0x3a, 0xd3, 0xf7,
-	0xfe, 0xa5, 0x4e, 0xfb, 0x53, 0xfa, 0x47, 0x3a, 0x5a, 0xed, 0xca, 0xbb, 0xeb, 0x5d, 0x67, 0x03,
-	0xbc, 0x59, 0xda, 0x4f, 0xdf, 0x77, 0xf4, 0x49, 0x47, 0x47, 0x32, 0x6c, 0xb8, 0x8c, 0x89, 0xa1,
-	0xc9, 0x98, 0x6b, 0x75, 0xa6, 0x2e, 0x13, 0x0c, 0x5d, 0x9e, 0x50, 0xfb, 0x70, 0xc6, 0x55, 0xab,
-	0x23, 0x3f, 0x7b, 0x5f, 0x1b, 0x15, 0x93, 0x4d, 0x26, 0xcc, 0x51, 0xfd, 0x8d, 0x4a, 0x18, 0xd5,
-	0xa8, 0x52, 0x47, 0x10, 0xd7, 0xc1, 0xb6, 0xdf, 0x2e, 0x4f, 0x5d, 0x76, 0x7c, 0xe2, 0x37, 0x6a,
-	0x44, 0x98, 0xd6, 0x70, 0x42, 0x04, 0x56, 0x1d, 0xad, 0x21, 0x5c, 0x7a, 0x66, 0xdb, 0xcc, 0x7c,
-	0x45, 0x27, 0x84, 0x0b, 0x3c, 0x99, 0x1a, 0xe4, 0xfd, 0x8c, 0x70, 0x81, 0x1e, 0xc2, 0xf9, 0x11,
-	0xe6, 0xa4, 0x9e, 0x6b, 0xe6, 0xda, 0xe5, 0xad, 0x6b, 0x9d, 0x48, 0x24, 0xbe, 0xfc, 0x2e, 0x1f,
-	0x6f, 0x63, 0x4e, 0x0c, 0x0f, 0x89, 0x2e, 0xc2, 0x8a, 0xc9, 0x66, 0x8e, 0xa8, 0x17, 0x9a, 0xb9,
-	0xf6, 0xba, 0xa1, 0x1a, 0xad, 0x3f, 0x72, 0x70, 0x39, 0xae, 0xc0, 0xa7, 0xcc, 0xe1, 0x04, 0x3d,
-	0x82, 0x55, 0x2e, 0xb0, 0x98, 0x71, 0x5f, 0xe4, 0x6a, 0xa2, 0xc8, 0xc0, 0x83, 0x18, 0x3e, 0x14,
-	0x5d, 0x83, 0x92, 0x08, 0x98, 0xea, 0xf9, 0x66, 0xae, 0x7d, 0xde, 0x98, 0x77, 0xa4, 0xc4, 0xf0,
-	0x06, 0xaa, 0x5e, 0x08, 0xfd, 0xde, 0x27, 0x98, 0x5d, 0x3e, 0xcc, 0x6c, 0x43, 0x4d, 0x33, 0x7f,
-	0xcc, 0xac, 0xaa, 0x90, 0xef, 0xf7, 0x3c, 0xea, 0x82, 0x91, 0xef, 0xf7, 0x52, 0xe6, 0xf1, 0x4f,
-	0x1e, 0x2a, 0xfd, 0xc9, 0x94, 0xb9, 0xc2, 0x20, 0x7c, 0x66, 0x8b, 0x0f, 0xd3, 0xba, 0x02, 0x6b,
-	0x02, 0xf3, 0x83, 0x21, 0xb5, 0x7c, 0xc1, 0x55, 0xd9, 0xec, 0x5b, 0xe8, 0x0b, 0x28, 0x5b, 0x58,
-	0x60, 0x87, 0x59, 0x44, 0x7e, 0x2c, 0x78, 0x1f, 0x21, 0xe8, 0xea, 0x5b, 0xe8, 0x31, 0xac, 0x48,
-	0x0e, 0x52, 0x3f, 0xdf, 0xcc, 0xb5, 0xab, 0x5b, 0xcd, 0x44, 0x35, 0x15, 0xa0, 0xd4, 0x24, 0x86,
-	0x82, 0xa3, 0x06, 0x14, 0x39, 0x19, 0x4f, 0x88, 0x23, 0x78, 0x7d, 0xa5, 0x59, 0x68, 0x17, 0x0c,
-	0xdd, 0x46, 0x9f, 0x41, 0x11, 0xcf, 0x04, 0x1b, 0x52, 0x8b, 0xd7, 0x57, 0xbd, 0x6f, 0x6b, 0xb2,
-	0xdd, 0xb7, 0x38, 0xba, 0x0a, 0x25, 0x97, 0x1d, 0x0d, 0x95, 0x11, 0x6b, 0x5e, 0x34, 0x45, 0x97,
-	0x1d, 0x75, 0x65, 0x1b, 0x7d, 0x03, 0x2b, 0xd4, 0x79, 0xc7, 0x78, 0xbd, 0xd8, 0x2c, 0xb4, 0xcb,
-	0x5b, 0x37, 0x12, 0x63, 0xf9, 0x8e, 0x9c, 0xfc, 0x88, 0xed, 0x19, 0xd9, 0xc3, 0xd4, 0x35, 0x14,
-	0xbe, 0xf5, 0x57, 0x0e, 0xae, 0xf4, 0x08, 0x37, 0x5d, 0x3a, 0x22, 0x03, 0x3f, 0x8a, 0x0f, 0xdf,
-	0x16, 0x2d, 0xa8, 0x98, 0xcc, 0xb6, 0x89, 0x29, 0x28, 0x73, 0xf4, 0x12, 0x46, 0xfa, 0xd0, 0x75,
-	0x00, 0x7f, 0xba, 0xfd, 0x1e, 0xaf, 0x17, 0xbc, 0x49, 0x86, 0x7a, 0x5a, 0x33, 0xa8, 0xf9, 0x81,
-	0x48, 0xe2, 0xbe, 0xf3, 0x8e, 0x2d, 0xd0, 0xe6, 0x12, 0x68, 0x9b, 0x50, 0x9e, 0x62, 0x57, 0xd0,
-	0x88, 0x72, 0xb8, 0x4b, 0xe6, 0x8a, 0x96, 0xf1, 0x97, 0x73, 0xde, 0xd1, 0xfa, 0x2f, 0x0f, 0x15,
-	0x5f, 0x57, 0x6a, 0x72, 0xd4, 0x83, 0x92, 0x9c, 0xd3, 0x50, 0xfa, 0xe4, 0x5b, 0x70, 0xbb, 0x93,
-	0x7c, 0x02, 0x75, 0x62, 0x01, 0x1b, 0xc5, 0x51, 0x10, 0x7a, 0x0f, 0xca, 0xd4, 0xb1, 0xc8, 0xf1,
-	0x50, 0x2d, 0x4f, 0xde, 0x5b, 0x9e, 0x2f, 0xa3, 0x3c, 0xf2, 0x14, 0xea, 0x68, 0x6d, 0x8b, 0x1c,
-	0x7b, 0x1c, 0x40, 0x83, 0x9f, 0x1c, 0x11, 0xb8, 0x40, 0x8e, 0x85, 0x8b, 0x87, 0x61, 0xae, 0x82,
-	0xc7, 0xf5, 0xed, 0x29, 0x31, 0x79, 0x04, 0x9d, 0xe7, 0x72, 0xb4, 0xe6, 0xe6, 0xcf, 0x1d, 0xe1,
-	0x9e, 0x18, 0x35, 0x12, 0xed, 0x6d, 0xfc, 0x06, 0x17, 0x93, 0x80, 0x68, 0x03, 0x0a, 0x07, 0xe4,
-	0xc4, 0xb7, 0x5d, 0xfe, 0x44, 0x5b, 0xb0, 0x72, 0x28, 0xb7, 0x92, 0xe7, 0xf3, 0xc2, 0xde, 0xf0,
-	0x26, 0x34, 0x9f, 0x89, 0x82, 0x3e, 0xcd, 0x3f, 0xc9, 0xb5, 0xfe, 0xcd, 0x43, 0x7d, 0x71, 0xbb,
-	0x7d, 0xcc, 0x59, 0x91, 0x65, 0xcb, 0x8d, 0x61, 0xdd, 0x5f, 0xe8, 0x88, 0x75, 0xdb, 0x69, 0xd6,
-	0xa5, 0x45, 0x18, 0xf1, 0x54, 0x79, 0x58, 0xe1, 0xa1, 0xae, 0x06, 0x81, 0x0b, 0x0b, 0x90, 0x04,
-	0xf7, 0x9e, 0x46, 0xdd, 0xbb, 0x99, 0x65, 0x09, 0xc3, 0x2e, 0x5a, 0x70, 0x71, 0x87, 0x88, 0xae,
-	0x4b, 0x2c, 0xe2, 0x08, 0x8a, 0xed, 0x0f, 0x4f, 0xd8, 0x06, 0x14, 0x67, 0x5c, 0xd6, 0xc7, 0x89,
-	0x0a, 0xa6, 0x64, 0xe8, 0x76, 0xeb, 0xcf, 0x1c, 0x5c, 0x8a, 0xc9, 0x7c, 0xcc, 0x42, 0x2d, 0x91,
-	0x92, 0xdf, 0xa6, 0x98, 0xf3, 0x23, 0xe6, 0xaa, 0x83, 0xb6, 0x64, 0xe8, 0xf6, 0xd6, 0xdf, 0xd7,
-	0xa1, 0x64, 0x30, 0x26, 0xba, 0xd2, 0x12, 0x34, 0x05, 0x24, 0x63, 0x62, 0x93, 0x29, 0x73, 0x88,
-	0xa3, 0x0e, 0x56, 0x8e, 0x1e, 0x46, 0x03, 0xd0, 0x35, 0x7f, 0x11, 0xea, 0x5b, 0xd5, 0xb8, 0x95,
-	0x32, 0x22, 0x06, 0x6f, 0x9d, 0x43, 0x13, 0x4f, 0x51, 0xd6, 0xeb, 0x57, 0xd4, 0x3c, 0xe8, 0xee,
-	0x63, 0xc7, 0x21, 0xf6, 0x32, 0xc5, 0x18, 0x34, 0x50, 0x8c, 0x25, 0xbd, 0xdf, 0x18, 0x08, 0x97,
-	0x3a, 0xe3, 0xc0, 0xd9, 0xd6, 0x39, 0xf4, 0xde, 0x5b, 0x5b, 0xa9, 0x4e, 0xb9, 0xa0, 0x26, 0x0f,
-	0x04, 0xb7, 0xd2, 0x05, 0x17, 0xc0, 0x67, 0x94, 0x1c, 0xc2, 0x46, 0xd7, 0x25, 0x58, 0x90, 0xae,
-	0x4e, 0x1a, 0x74, 0x3f, 0x71, 0x68, 0x1c, 0x16, 0x08, 0x2d, 0xdb, 0x00, 0xad, 0x73, 0xe8, 0x17,
-	0xa8, 0xf6, 0x5c, 0x36, 0x0d, 0xd1, 0xdf, 0x4d, 0xa4, 0x8f, 0x82, 0x32, 0x92, 0x0f, 0x61, 0xfd,
-	0x05, 0xe6, 0x21, 0xee, 0x3b, 0x89, 0xdc, 0x11, 0x4c, 0x40, 0x7d, 0x23, 0x11, 0xba, 0xcd, 0x98,
-	0x1d, 0xb2, 0xe7, 0x08, 0x50, 0x70, 0x20, 0x84, 0x54, 0x3a, 0xc9, 0x33, 0x58, 0x00, 0x06, 0x52,
-	0x9b, 0x99, 0xf1, 0x5a, 0xf8, 0x35, 0x94, 0x95, 0xe1, 0xcf, 0x6c, 0x8a, 0x39, 0xba, 0xbd, 0x64,
-	0x49, 0x3c, 0x44, 0x46, 0xc3, 0x7e, 0x80, 0x92, 0x34, 0x5a, 0x91, 0x7e, 0x95, 0xba, 0x10, 0x67,
-	0xa1, 0x1c, 0x00, 0x3c, 0xb3, 0x05, 0x71, 0x15, 0xe7, 0xad, 0x44, 0xce, 0x39, 0x20, 0x23, 0xa9,
-	0x03, 0xb5, 0xc1, 0xbe, 0xbc, 0xe0, 0x04, 0xd6, 0x70, 0x74, 0x2f, 0x79, 0x43, 0x47, 0x51, 0x01,
-	0xfd, 0xfd, 0x6c, 0x60, 0x6d, 0xf7, 0x5b, 0xa8, 0x29, 0x33, 0xf7, 0x82, 0x4b, 0x43, 0x8a, 0x5e,
-	0x0c, 0x95, 0x71, 0x3a, 0x3f, 0xc1, 0xba, 0xb4, 0x75, 0x4e, 0x7e, 0x27, 0xd5, 0xfa, 0xb3, 0x52,
-	0xbf, 0x85, 0xca, 0x0b, 0xcc, 0xe7, 0xcc, 0xed, 0xb4, 0x0c, 0x58, 0x20, 0xce, 0x94, 0x00, 0x07,
-	0x50, 0x95, 0xae, 0xe9, 0xc1, 0x3c, 0x25, 0x7d, 0xa3, 0xa0, 0x40, 0xe2, 0x5e, 0x26, 0xac, 0x16,
-	0x23, 0x50, 0x91, 0xdf, 0x82, 0xd2, 0x9b, 0x32, 0x97, 0x30, 0x24, 0x10, 0xba, 0x93, 0x01, 0x19,
-	0x3a, 0x66, 0xab, 0xd1, 0x77, 0x18, 0x7a, 0x90, 0x56, 0x85, 0x13, 0x5f, 0x84, 0x8d, 0x4e, 0x56,
-	0xb8, 0x96, 0xfc, 0x15, 0xd6, 0xfc, 0xd7, 0x51, 0x3c, 0x43, 0x62, 0x83, 0xf5, 0xc3, 0xac, 0x71,
-	0xfb, 0x54, 0x9c, 0x66, 0xc7, 0x70, 0xe9, 0xf5, 0xd4, 0x92, 0xa7, 0xb3, 0xaa, 0x01, 0x41, 0x15,
-	0x8a, 0x6f, 0xb3, 0x79, 0xa5, 0x8b, 0xe2, 0x76, 0xf9, 0xf8, 0xb4, 0x6d, 0xe6, 0xc2, 0xe7, 0x7d,
-	0xe7, 0x10, 0xdb, 0xd4, 0x8a, 0x14, 0x81, 0x5d, 0x22, 0x70, 0x17, 0x9b, 0xfb, 0x24, 0x5e, 0xa3,
-	0xd4, 0x53, 0x3b, 0x3a, 0x44, 0x83, 0x33, 0x6e, 0xed, 0xdf, 0x01, 0xa9, 0x8c, 0x75, 0xde, 0xd1,
-	0xf1, 0xcc, 0xc5, 0x6a, 0xff, 0xa5, 0x55, 0xdf, 0x45, 0x68, 0x20, 0xf3, 0xf5, 0x19, 0x46, 0x84,
-	0x0a, 0x23, 0xec, 0x10, 0xb1, 0x4b, 0x84, 0x4b, 0xcd, 0xb4, 0x63, 0x6d, 0x0e, 0x48, 0x59, 0xb4,
-	0x04, 0x9c, 0x16, 0x18, 0xc0, 0xaa, 0x7a, 0x20, 0xa2, 0x56, 0xe2, 0xa0, 0xe0, 0x79, 0xbb, 0xac,
-	0x9c, 0xeb, 0x27, 0x70, 0x28, 0x5d, 0x77, 0x88, 0x08, 0x3d, 0x3c, 0x53, 0xd2, 0x35, 0x0a, 0x5a,
-	0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa0, 0xf6, 0x3d, 0xe5, 0xfe, 0xc7, 0x57, 0x98, 0x1f, 0xa4,
-	0x1d, 0xd2, 0x31, 0xd4, 0xf2, 0x43, 0x7a, 0x01, 0x1c, 0x72, 0xac, 0x62, 0x10, 0xf9, 0xc1, 0xf7,
-	0x2d, 0xf5, 0xee, 0x1c, 0xfe, 0x67, 0xe0, 0xb4, 0x4d, 0xf6, 0x46, 0x5f, 0x80, 0xf4, 0x5d, 0x37,
-	0x5e, 0x18, 0xe7, 0x69, 0xa3, 0x21, 0xf2, 0x5a, 0x9e, 0x81, 0xd9, 0xcf, 0xca, 0x4f, 0xcd, 0x3c,
-	0x84, 0x8d, 0x1e, 0xb1, 0x49, 0x84, 0xf9, 0x7e, 0xca, 0x1d, 0x23, 0x0a, 0xcb, 0x98, 0x79, 0xfb,
-	0xb0, 0x2e, 0x97, 0x41, 0x8e, 0x7b, 0xcd, 0x89, 0xcb, 0x53, 0xea, 0x55, 0x04, 0x13, 0x50, 0xdf,
-	0xcd, 0x02, 0x0d, 0xed, 0xa1, 0xf5, 0xc8, 0x3b, 0x23, 0x3e, 0x8f, 0xf9, 0xa2, 0x26, 0xbd, 0x7a,
-	0x1a, 0x0f, 0x32, 0xa2, 0x43, 0x7b, 0x08, 0xd4, 0x72, 0x1b, 0xcc, 0x26, 0x29, 0x69, 0x3d, 0x07,
-	0x64, 0xb4, 0xeb, 0x25, 0x14, 0x65, 0xe9, 0xf6, 0x28, 0x6f, 0xa6, 0x56, 0xf6, 0x33, 0x10, 0xbe,
-	0x85, 0xda, 0xcb, 0x29, 0x71, 0xb1, 0x20, 0xd2, 0x2f, 0x8f, 0x37, 0x39, 0xb3, 0x62, 0xa8, 0xcc,
-	0xd7, 0x66, 0x18, 0x10, 0x79, 0x82, 0x2f, 0x31, 0x61, 0x0e, 0x58, 0x7e, 0xb6, 0x85, 0x71, 0xe1,
-	0xc3, 0x53, 0xf5, 0xcb, 0xc0, 0x96, 0x0a, 0x78, 0x91, 0x67, 0x10, 0x50, 0xb8, 0xf0, 0xb3, 0xc5,
-	0x9f, 0xfa, 0x9e, 0x4b, 0x0f, 0xa9, 0x4d, 0xc6, 0x24, 0x25, 0x03, 0xe2, 0xb0, 0x8c, 0x16, 0x8d,
-	0xa0, 0xac, 0x84, 0x77, 0x5c, 0xec, 0x08, 0xb4, 0x2c, 0x34, 0x0f, 0x11, 0xd0, 0xb6, 0x4f, 0x07,
-	0xea, 0x49, 0x98, 0x00, 0x32, 0x2d, 0xf6, 0x98, 0x4d, 0xcd, 0x93, 0xf8, 0x65, 0x47, 0x1f, 0x0d,
-	0x73, 0x48, 0xca, 0x65, 0x27, 0x11, 0x19, 0x88, 0x6c, 0x3f, 0xf9, 0xf9, 0xf1, 0x98, 0x8a, 0xfd,
-	0xd9, 0x48, 0x4e, 0x71, 0x53, 0x0d, 0x7c, 0x40, 0x99, 0xff, 0x6b, 0x33, 0x18, 0xbc, 0xe9, 0x71,
-	0x6d, 0xea, 0x04, 0x9a, 0x8e, 0x46, 0xab, 0x5e, 0xd7, 0xa3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x19, 0x92, 0xda, 0x17, 0x8d, 0x17, 0x00, 0x00,
+	// 1545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdb, 0x6e, 0xdb, 0x36,
+	0x18, 0xae, 0xed, 0xe6, 0xe0, 0xdf, 0x8e, 0x9d, 0x12, 0x3d, 0x78, 0x6e, 0xb7, 0xb9, 0x5e, 0x0f,
+	0x4e, 0x9b, 0x3a, 0x5d, 0x0a, 0x74, 0x5d, 0xef, 0x1a, 0xbb, 0x48, 0x8d, 0x2d, 0x68, 0x26, 0xb7,
+	0x5b, 0xb7, 0x2e, 0xf0, 0x68, 0x89, 0x75, 0x84, 0xc8, 0xa2, 0x2b, 0xd2, 0x39, 0x60, 0x57, 0x03,
+	0x76, 0xbf, 0x47, 0x1a, 0xb0, 0x3d, 0xca, 0x5e, 0x64, 0xa0, 0x28, 0xc9, 0x92, 0x2c, 0x2a, 0x72,
+	0xdb, 0x3b, 0x93, 0xfa, 0xf8, 0x7d, 0x3f, 0xff, 0x13, 0x49, 0xc3, 0xba, 0x43, 0x29, 0x1f, 0xe8,
+	0x94, 0x3a, 0x46, 0x7b, 0xe2, 0x50, 0x4e, 0xd1, 0xd5, 0xb1, 0x69, 0x1d, 0x4f, 0x99, 0x1c, 0xb5,
+	0xc5, 0x67, 0xf7, 0x6b, 0xbd, 0xac, 0xd3, 0xf1, 0x98, 0xda, 0x72, 0xbe, 0x5e, 0x0e, 0xa3, 0xea,
+	0x15, 0xd3, 0xe6, 0xc4, 0xb1, 0xb1, 0xe5, 0x8d, 0x4b, 0x13, 0x87, 0x9e, 0x9e, 0x79, 0x83, 0x2a,
+	0xe1, 0xba, 0x31, 0x18, 0x13, 0x8e, 0xe5, 0x44, 0x73, 0x00, 0x57, 0x9e, 0x59, 0x16, 0xd5, 0x5f,
+	0x99, 0x63, 0xc2, 0x38, 0x1e, 0x4f, 0x34, 0xf2, 0x7e, 0x4a, 0x18, 0x47, 0x0f, 0xe1, 0xe2, 0x10,
+	0x33, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x4f, 0x7e, 0x8f, 0x8d,
+	0x76, 0x30, 0x23, 0x9a, 0x8b, 0x44, 0x97, 0x61, 0x49, 0xa7, 0x53, 0x9b, 0xd7, 0x0a, 0x8d, 0x5c,
+	0x6b, 0x4d, 0x93, 0x83, 0xe6, 0x1f, 0x39, 0xb8, 0x1a, 0x57, 0x60, 0x13, 0x6a, 0x33, 0x82, 0x1e,
+	0xc1, 0x32, 0xe3, 0x98, 0x4f, 0x99, 0x27, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x42, 0x34, 0x0f, 0x8a,
+	0x6e, 0x40, 0x91, 0xfb, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0xa8, 0xcd, 0x26, 0x14, 0x36, 0xbc,
+	0x81, 0x8a, 0x6b, 0x42, 0xaf, 0xfb, 0x09, 0x76, 0x97, 0x0f, 0x33, 0x5b, 0x50, 0x0d, 0x98, 0x3f,
+	0x66, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x2e, 0x75, 0x41, 0xcb, 0xf7, 0xba, 0x8a, 0x7d, 0xfc, 0x93,
+	0x87, 0x72, 0x6f, 0x3c, 0xa1, 0x0e, 0xd7, 0x08, 0x9b, 0x5a, 0xfc, 0xc3, 0xb4, 0xae, 0xc1, 0x0a,
+	0xc7, 0xec, 0x68, 0x60, 0x1a, 0x9e, 0xe0, 0xb2, 0x18, 0xf6, 0x0c, 0xf4, 0x25, 0x94, 0x0c, 0xcc,
+	0xb1, 0x4d, 0x0d, 0x22, 0x3e, 0x16, 0xdc, 0x8f, 0xe0, 0x4f, 0xf5, 0x0c, 0xf4, 0x18, 0x96, 0x04,
+	0x07, 0xa9, 0x5d, 0x6c, 0xe4, 0x5a, 0x95, 0xed, 0x46, 0xa2, 0x9a, 0x34, 0x50, 0x68, 0x12, 0x4d,
+	0xc2, 0x51, 0x1d, 0x56, 0x19, 0x19, 0x8d, 0x89, 0xcd, 0x59, 0x6d, 0xa9, 0x51, 0x68, 0x15, 0xb4,
+	0x60, 0x8c, 0x3e, 0x83, 0x55, 0x3c, 0xe5, 0x74, 0x60, 0x1a, 0xac, 0xb6, 0xec, 0x7e, 0x5b, 0x11,
+	0xe3, 0x9e, 0xc1, 0xd0, 0x75, 0x28, 0x3a, 0xf4, 0x64, 0x20, 0x1d, 0xb1, 0xe2, 0x5a, 0xb3, 0xea,
+	0xd0, 0x93, 0x8e, 0x18, 0xa3, 0x6f, 0x60, 0xc9, 0xb4, 0xdf, 0x51, 0x56, 0x5b, 0x6d, 0x14, 0x5a,
+	0xa5, 0xed, 0x9b, 0x89, 0xb6, 0x7c, 0x47, 0xce, 0x7e, 0xc4, 0xd6, 0x94, 0xec, 0x63, 0xd3, 0xd1,
+	0x24, 0xbe, 0xf9, 0x57, 0x0e, 0xae, 0x75, 0x09, 0xd3, 0x1d, 0x73, 0x48, 0xfa, 0x9e, 0x15, 0x1f,
+	0x9e, 0x16, 0x4d, 0x28, 0xeb, 0xd4, 0xb2, 0x88, 0xce, 0x4d, 0x6a, 0x07, 0x21, 0x8c, 0xcc, 0xa1,
+	0x2f, 0x00, 0xbc, 0xed, 0xf6, 0xba, 0xac, 0x56, 0x70, 0x37, 0x19, 0x9a, 0x69, 0x4e, 0xa1, 0xea,
+	0x19, 0x22, 0x88, 0x7b, 0xf6, 0x3b, 0x3a, 0x47, 0x9b, 0x4b, 0xa0, 0x6d, 0x40, 0x69, 0x82, 0x1d,
+	0x6e, 0x46, 0x94, 0xc3, 0x53, 0xa2, 0x56, 0x02, 0x19, 0x2f, 0x9c, 0xb3, 0x89, 0xe6, 0x7f, 0x79,
+	0x28, 0x7b, 0xba, 0x42, 0x93, 0xa1, 0x2e, 0x14, 0xc5, 0x9e, 0x06, 0xc2, 0x4f, 0x9e, 0x0b, 0xee,
+	0xb6, 0x93, 0x3b, 0x50, 0x3b, 0x66, 0xb0, 0xb6, 0x3a, 0xf4, 0x4d, 0xef, 0x42, 0xc9, 0xb4, 0x0d,
+	0x72, 0x3a, 0x90, 0xe1, 0xc9, 0xbb, 0xe1, 0xf9, 0x2a, 0xca, 0x23, 0xba, 0x50, 0x3b, 0xd0, 0x36,
+	0xc8, 0xa9, 0xcb, 0x01, 0xa6, 0xff, 0x93, 0x21, 0x02, 0x97, 0xc8, 0x29, 0x77, 0xf0, 0x20, 0xcc,
+	0x55, 0x70, 0xb9, 0xbe, 0x3d, 0xc7, 0x26, 0x97, 0xa0, 0xfd, 0x5c, 0xac, 0x0e, 0xb8, 0xd9, 0x73,
+	0x9b, 0x3b, 0x67, 0x5a, 0x95, 0x44, 0x67, 0xeb, 0xbf, 0xc1, 0xe5, 0x24, 0x20, 0x5a, 0x87, 0xc2,
+	0x11, 0x39, 0xf3, 0xdc, 0x2e, 0x7e, 0xa2, 0x6d, 0x58, 0x3a, 0x16, 0xa9, 0x54, 0xcb, 0x27, 0xe5,
+	0x86, 0xbb, 0xa1, 0xd9, 0x4e, 0x24, 0xf4, 0x69, 0xfe, 0x49, 0xae, 0xf9, 0x6f, 0x1e, 0x6a, 0xf3,
+	0xe9, 0xf6, 0x31, 0xbd, 0x22, 0x4b, 0xca, 0x8d, 0x60, 0xcd, 0x0b, 0x74, 0xc4, 0x75, 0x3b, 0x2a,
+	0xd7, 0xa9, 0x2c, 0x8c, 0xf8, 0x54, 0xfa, 0xb0, 0xcc, 0x42, 0x53, 0x75, 0x02, 0x97, 0xe6, 0x20,
+	0x09, 0xde, 0x7b, 0x1a, 0xf5, 0xde, 0xad, 0x2c, 0x21, 0x0c, 0x7b, 0xd1, 0x80, 0xcb, 0xbb, 0x84,
+	0x77, 0x1c, 0x62, 0x10, 0x9b, 0x9b, 0xd8, 0xfa, 0xf0, 0x82, 0xad, 0xc3, 0xea, 0x94, 0x89, 0xf3,
+	0x71, 0x2c, 0x8d, 0x29, 0x6a, 0xc1, 0xb8, 0xf9, 0x67, 0x0e, 0xae, 0xc4, 0x64, 0x3e, 0x26, 0x50,
+	0x29, 0x52, 0xe2, 0xdb, 0x04, 0x33, 0x76, 0x42, 0x1d, 0xd9, 0x68, 0x8b, 0x5a, 0x30, 0xde, 0xfe,
+	0xfb, 0x26, 0x14, 0x35, 0x4a, 0x79, 0x47, 0xb8, 0x04, 0x4d, 0x00, 0x09, 0x9b, 0xe8, 0x78, 0x42,
+	0x6d, 0x62, 0xcb, 0xc6, 0xca, 0xd0, 0xc3, 0xa8, 0x01, 0xc1, 0x99, 0x3f, 0x0f, 0xf5, 0x5c, 0x55,
+	0xbf, 0xa3, 0x58, 0x11, 0x83, 0x37, 0x2f, 0xa0, 0xb1, 0xab, 0x28, 0xce, 0xeb, 0x57, 0xa6, 0x7e,
+	0xd4, 0x39, 0xc4, 0xb6, 0x4d, 0xac, 0x34, 0xc5, 0x18, 0xd4, 0x57, 0x8c, 0x15, 0xbd, 0x37, 0xe8,
+	0x73, 0xc7, 0xb4, 0x47, 0xbe, 0x67, 0x9b, 0x17, 0xd0, 0x7b, 0x37, 0xb6, 0x42, 0xdd, 0x64, 0xdc,
+	0xd4, 0x99, 0x2f, 0xb8, 0xad, 0x16, 0x9c, 0x03, 0x2f, 0x28, 0x39, 0x80, 0xf5, 0x8e, 0x43, 0x30,
+	0x27, 0x9d, 0xa0, 0x68, 0xd0, 0x66, 0xe2, 0xd2, 0x38, 0xcc, 0x17, 0x4a, 0x4b, 0x80, 0xe6, 0x05,
+	0xf4, 0x16, 0x2a, 0x5d, 0x87, 0x4e, 0x42, 0xf4, 0xf7, 0x12, 0xe9, 0xa3, 0xa0, 0x8c, 0xe4, 0x03,
+	0x58, 0x7b, 0x81, 0x59, 0x88, 0x7b, 0x23, 0x91, 0x3b, 0x82, 0xf1, 0xa9, 0x6f, 0x26, 0x42, 0x77,
+	0x28, 0xb5, 0x42, 0xee, 0x39, 0x01, 0xe4, 0x37, 0x84, 0x90, 0x4a, 0x3b, 0x79, 0x07, 0x73, 0x40,
+	0x5f, 0x6a, 0x2b, 0x33, 0x3e, 0x10, 0x7e, 0x0d, 0x25, 0xe9, 0xf0, 0x67, 0x96, 0x89, 0x19, 0xba,
+	0x9b, 0x12, 0x12, 0x17, 0x91, 0xd1, 0x61, 0x3f, 0x40, 0x51, 0x38, 0x5a, 0x92, 0xde, 0x56, 0x06,
+	0x62, 0x11, 0xca, 0x3e, 0xc0, 0x33, 0x8b, 0x13, 0x47, 0x72, 0xde, 0x49, 0xe4, 0x9c, 0x01, 0x32,
+	0x92, 0x1e, 0x88, 0xdb, 0x24, 0x27, 0x4e, 0xc8, 0xe9, 0xf7, 0xd5, 0xcc, 0x0b, 0xe7, 0x8d, 0x0d,
+	0xd5, 0xfe, 0x21, 0x3d, 0x99, 0xad, 0x63, 0x0a, 0xfa, 0x18, 0xca, 0xa7, 0xdf, 0xcc, 0x06, 0x0e,
+	0xa2, 0x79, 0x00, 0x55, 0x19, 0xab, 0x7d, 0xff, 0x4e, 0xa2, 0xd0, 0x8b, 0xa1, 0x32, 0x6e, 0xe7,
+	0x67, 0x58, 0x13, 0x51, 0x9b, 0x91, 0x6f, 0x28, 0x23, 0xbb, 0x28, 0xf5, 0x01, 0x94, 0x5f, 0x60,
+	0x36, 0x63, 0x6e, 0xa9, 0x0a, 0x6c, 0x8e, 0x38, 0x53, 0x7d, 0x1d, 0x41, 0x45, 0x78, 0x2d, 0x58,
+	0xcc, 0x14, 0xdd, 0x21, 0x0a, 0xf2, 0x25, 0xee, 0x67, 0xc2, 0x06, 0x62, 0x04, 0xca, 0xe2, 0x9b,
+	0x7f, 0xb2, 0x2b, 0xf6, 0x12, 0x86, 0xf8, 0x42, 0x1b, 0x19, 0x90, 0xa1, 0x2e, 0x5e, 0x89, 0x3e,
+	0xf3, 0xd0, 0x03, 0xd5, 0x21, 0x9f, 0xf8, 0xe0, 0xac, 0xb7, 0xb3, 0xc2, 0x03, 0xc9, 0x5f, 0x61,
+	0xc5, 0x7b, 0x7c, 0xa1, 0x3b, 0xa9, 0x8b, 0x83, 0x77, 0x5f, 0xfd, 0xee, 0xb9, 0xb8, 0x80, 0x1d,
+	0xc3, 0x95, 0xd7, 0x13, 0x43, 0x34, 0x7f, 0x79, 0xc4, 0xf8, 0x87, 0x1c, 0xda, 0x50, 0x9c, 0x4b,
+	0x31, 0xdc, 0x1e, 0x1b, 0x9d, 0x97, 0x66, 0x0e, 0x7c, 0xde, 0xb3, 0x8f, 0xb1, 0x65, 0x1a, 0x91,
+	0x33, 0x66, 0x8f, 0x70, 0xdc, 0xc1, 0xfa, 0x21, 0x89, 0x1f, 0x81, 0xf2, 0x25, 0x1f, 0x5d, 0x12,
+	0x80, 0x33, 0xa6, 0xf6, 0xef, 0x80, 0x64, 0xc5, 0xda, 0xef, 0xcc, 0xd1, 0xd4, 0xc1, 0x32, 0xff,
+	0x54, 0x87, 0xfb, 0x3c, 0xd4, 0x97, 0xf9, 0x7a, 0x81, 0x15, 0xa1, 0x73, 0x17, 0x76, 0x09, 0xdf,
+	0x23, 0xdc, 0x31, 0x75, 0x55, 0xd7, 0x9c, 0x01, 0x14, 0x41, 0x4b, 0xc0, 0x05, 0x02, 0x7d, 0x58,
+	0x96, 0xef, 0x4f, 0xd4, 0x4c, 0x5c, 0xe4, 0xbf, 0x9e, 0xd3, 0x6e, 0x0b, 0x3e, 0x26, 0x5c, 0xae,
+	0xbb, 0x84, 0x87, 0xde, 0xb5, 0x8a, 0x72, 0x8d, 0x82, 0xd2, 0xcb, 0x35, 0x8e, 0x0d, 0xc4, 0x6c,
+	0xa8, 0x7e, 0x6f, 0x32, 0xef, 0xe3, 0x2b, 0xcc, 0x8e, 0x54, 0x4d, 0x3a, 0x86, 0x4a, 0x6f, 0xd2,
+	0x73, 0xe0, 0x90, 0xc7, 0xca, 0x1a, 0x11, 0x1f, 0x3c, 0xbf, 0x29, 0xaf, 0xe6, 0xe1, 0x3f, 0x1e,
+	0xce, 0x4b, 0xb2, 0x37, 0xc1, 0xfd, 0x2a, 0xb8, 0x4a, 0xa3, 0xdb, 0x8a, 0x84, 0x99, 0x41, 0xc4,
+	0xad, 0x3f, 0x03, 0xb3, 0x57, 0x95, 0x9f, 0x9a, 0x79, 0x00, 0xeb, 0x5d, 0x62, 0x91, 0x08, 0xf3,
+	0xa6, 0xe2, 0x0a, 0x13, 0x85, 0x65, 0xac, 0xbc, 0x43, 0x58, 0x13, 0x61, 0x10, 0xeb, 0x5e, 0x33,
+	0xe2, 0x30, 0xc5, 0x79, 0x15, 0xc1, 0xf8, 0xd4, 0xf7, 0xb2, 0x40, 0x43, 0x39, 0xb4, 0x16, 0x79,
+	0xc6, 0xa0, 0x4d, 0x55, 0x50, 0x93, 0x1e, 0x55, 0xf5, 0x07, 0x19, 0xd1, 0xa1, 0x1c, 0x02, 0x19,
+	0x6e, 0x8d, 0x5a, 0x44, 0x51, 0xd6, 0x33, 0x40, 0x46, 0x77, 0xbd, 0x84, 0x55, 0x71, 0x74, 0xbb,
+	0x94, 0xb7, 0x94, 0x27, 0xfb, 0x02, 0x84, 0x07, 0x50, 0x7d, 0x39, 0x21, 0x0e, 0xe6, 0x44, 0xf8,
+	0xcb, 0xe5, 0x4d, 0xae, 0xac, 0x18, 0x2a, 0xf3, 0xad, 0x1c, 0xfa, 0x44, 0x74, 0xf0, 0x14, 0x27,
+	0xcc, 0x00, 0xe9, 0xbd, 0x2d, 0x8c, 0x0b, 0x37, 0x4f, 0x39, 0x2f, 0x0c, 0x4b, 0x15, 0x70, 0x2d,
+	0xcf, 0x20, 0x20, 0x71, 0xe1, 0x57, 0x91, 0xb7, 0xf5, 0x7d, 0xc7, 0x3c, 0x36, 0x2d, 0x32, 0x22,
+	0x8a, 0x0a, 0x88, 0xc3, 0x32, 0xba, 0x68, 0x08, 0x25, 0x29, 0xbc, 0xeb, 0x60, 0x9b, 0xa3, 0x34,
+	0xd3, 0x5c, 0x84, 0x4f, 0xdb, 0x3a, 0x1f, 0x18, 0x6c, 0x42, 0x07, 0x10, 0x65, 0xb1, 0x4f, 0x2d,
+	0x53, 0x3f, 0x43, 0x2d, 0x45, 0x6b, 0x98, 0x41, 0x14, 0x97, 0x9d, 0x44, 0x64, 0x20, 0xf2, 0x16,
+	0x2a, 0x32, 0x9f, 0xbb, 0x98, 0x63, 0xf7, 0x6f, 0x85, 0x7b, 0x29, 0x49, 0xef, 0x83, 0x32, 0x7a,
+	0xe9, 0x27, 0x28, 0x8b, 0xcc, 0x0e, 0xa8, 0x5b, 0xca, 0xe4, 0x5f, 0x90, 0xd8, 0x6b, 0x40, 0xfe,
+	0xaa, 0xb4, 0x06, 0x14, 0x60, 0xce, 0x6f, 0x40, 0x21, 0xa8, 0xef, 0x9f, 0x9d, 0x27, 0xbf, 0x3c,
+	0x1e, 0x99, 0xfc, 0x70, 0x3a, 0x14, 0x36, 0x6c, 0x49, 0xf0, 0x03, 0x93, 0x7a, 0xbf, 0xb6, 0x7c,
+	0xe7, 0x6e, 0xb9, 0x64, 0x5b, 0x41, 0x83, 0x99, 0x0c, 0x87, 0xcb, 0xee, 0xd4, 0xa3, 0xff, 0x07,
+	0x00, 0x1b, 0x68, 0xf4, 0x35, 0x0c, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -818,6 +821,13 @@ type RootCoordClient interface {
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	//*
+	// @brief This method is used to set the properties of a collection.
+	//
+	// @param AlterCollectionRequest, target collection name and the properties to set.
+	//
+	// @return Status
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
@@ -874,6 +884,9 @@ type RootCoordClient interface {
 	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
 }
 
 type rootCoordClient struct {
@@ -974,6 +987,15 @@ func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return out, nil
 }
 
+func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	out := new(milvuspb.ShowCollectionsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowCollections", in, out, opts...)
@@ -1235,6 +1257,33 @@ func (c *rootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPol
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -1272,6 +1321,13 @@ type RootCoordServer interface {
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 	//*
+	// @brief This method is used to set the properties of a collection.
+	//
+	// @param AlterCollectionRequest, target collection name and the properties to set.
+	//
+	// @return Status
+	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
@@ -1328,6 +1384,9 @@ type RootCoordServer interface {
 	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1364,6 +1423,9 @@ func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvusp
 func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedRootCoordServer) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
@@ -1451,6 +1513,15 @@ func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvu
 func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -1636,6 +1707,24 @@ func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.ShowCollectionsRequest)
 	if err := dec(in); err != nil {
@@ -2158,6 +2247,60 @@ func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2202,6 +2345,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _RootCoord_AlterAlias_Handler,
 		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _RootCoord_AlterCollection_Handler,
+		},
 		{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
@@ -2318,6 +2465,18 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPolicy",
 			Handler:    _RootCoord_ListPolicy_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
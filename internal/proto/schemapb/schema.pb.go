@@ -121,6 +121,7 @@ type FieldSchema struct {
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	State                FieldState               `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.schema.FieldState" json:"state,omitempty"`
+	IsPartitionKey       bool                     `protobuf:"varint,10,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -214,6 +215,13 @@ func (m *FieldSchema) GetState() FieldState {
 	return FieldState_FieldCreated
 }
 
+func (m *FieldSchema) GetIsPartitionKey() bool {
+	if m != nil {
+		return m.IsPartitionKey
+	}
+	return false
+}
+
 //*
 // @brief Collection schema
 type CollectionSchema struct {
@@ -1059,6 +1067,171 @@ func (m *SearchResultData) GetTopks() []int64 {
 	return nil
 }
 
+// TemplateValue is the typed value of a placeholder in expression template.
+type TemplateValue struct {
+	// Types that are valid to be assigned to Val:
+	//	*TemplateValue_BoolVal
+	//	*TemplateValue_Int64Val
+	//	*TemplateValue_FloatVal
+	//	*TemplateValue_StringVal
+	//	*TemplateValue_ArrayVal
+	Val                  isTemplateValue_Val `protobuf_oneof:"val"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TemplateValue) Reset()         { *m = TemplateValue{} }
+func (m *TemplateValue) String() string { return proto.CompactTextString(m) }
+func (*TemplateValue) ProtoMessage()    {}
+func (*TemplateValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
+}
+
+func (m *TemplateValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateValue.Unmarshal(m, b)
+}
+func (m *TemplateValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateValue.Marshal(b, m, deterministic)
+}
+func (m *TemplateValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateValue.Merge(m, src)
+}
+func (m *TemplateValue) XXX_Size() int {
+	return xxx_messageInfo_TemplateValue.Size(m)
+}
+func (m *TemplateValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateValue proto.InternalMessageInfo
+
+type isTemplateValue_Val interface {
+	isTemplateValue_Val()
+}
+
+type TemplateValue_BoolVal struct {
+	BoolVal bool `protobuf:"varint,1,opt,name=bool_val,json=boolVal,proto3,oneof"`
+}
+
+type TemplateValue_Int64Val struct {
+	Int64Val int64 `protobuf:"varint,2,opt,name=int64_val,json=int64Val,proto3,oneof"`
+}
+
+type TemplateValue_FloatVal struct {
+	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
+}
+
+type TemplateValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
+type TemplateValue_ArrayVal struct {
+	ArrayVal *TemplateArrayValue `protobuf:"bytes,5,opt,name=array_val,json=arrayVal,proto3,oneof"`
+}
+
+func (*TemplateValue_BoolVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_Int64Val) isTemplateValue_Val() {}
+
+func (*TemplateValue_FloatVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_StringVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_ArrayVal) isTemplateValue_Val() {}
+
+func (m *TemplateValue) GetVal() isTemplateValue_Val {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+func (m *TemplateValue) GetBoolVal() bool {
+	if x, ok := m.GetVal().(*TemplateValue_BoolVal); ok {
+		return x.BoolVal
+	}
+	return false
+}
+
+func (m *TemplateValue) GetInt64Val() int64 {
+	if x, ok := m.GetVal().(*TemplateValue_Int64Val); ok {
+		return x.Int64Val
+	}
+	return 0
+}
+
+func (m *TemplateValue) GetFloatVal() float64 {
+	if x, ok := m.GetVal().(*TemplateValue_FloatVal); ok {
+		return x.FloatVal
+	}
+	return 0
+}
+
+func (m *TemplateValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*TemplateValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
+func (m *TemplateValue) GetArrayVal() *TemplateArrayValue {
+	if x, ok := m.GetVal().(*TemplateValue_ArrayVal); ok {
+		return x.ArrayVal
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TemplateValue) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TemplateValue_BoolVal)(nil),
+		(*TemplateValue_Int64Val)(nil),
+		(*TemplateValue_FloatVal)(nil),
+		(*TemplateValue_StringVal)(nil),
+		(*TemplateValue_ArrayVal)(nil),
+	}
+}
+
+type TemplateArrayValue struct {
+	Values               []*TemplateValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TemplateArrayValue) Reset()         { *m = TemplateArrayValue{} }
+func (m *TemplateArrayValue) String() string { return proto.CompactTextString(m) }
+func (*TemplateArrayValue) ProtoMessage()    {}
+func (*TemplateArrayValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
+}
+
+func (m *TemplateArrayValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateArrayValue.Unmarshal(m, b)
+}
+func (m *TemplateArrayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateArrayValue.Marshal(b, m, deterministic)
+}
+func (m *TemplateArrayValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateArrayValue.Merge(m, src)
+}
+func (m *TemplateArrayValue) XXX_Size() int {
+	return xxx_messageInfo_TemplateArrayValue.Size(m)
+}
+func (m *TemplateArrayValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateArrayValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateArrayValue proto.InternalMessageInfo
+
+func (m *TemplateArrayValue) GetValues() []*TemplateValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterEnum("milvus.proto.schema.FieldState", FieldState_name, FieldState_value)
@@ -1076,76 +1249,87 @@ func init() {
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
 	proto.RegisterType((*IDs)(nil), "milvus.proto.schema.IDs")
 	proto.RegisterType((*SearchResultData)(nil), "milvus.proto.schema.SearchResultData")
+	proto.RegisterType((*TemplateValue)(nil), "milvus.proto.schema.TemplateValue")
+	proto.RegisterType((*TemplateArrayValue)(nil), "milvus.proto.schema.TemplateArrayValue")
 }
 
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 1051 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x6f, 0x6f, 0x1b, 0xc5,
-	0x13, 0xf6, 0xfa, 0xfc, 0xe7, 0x6e, 0xce, 0xed, 0xef, 0x7e, 0xdb, 0x82, 0x0e, 0xa4, 0x36, 0xae,
-	0x05, 0x92, 0x55, 0x89, 0x44, 0x4d, 0xa0, 0x94, 0x8a, 0x0a, 0x70, 0xac, 0x28, 0x56, 0x50, 0x15,
-	0x2e, 0x28, 0x48, 0xbc, 0xb1, 0xd6, 0xbe, 0x6d, 0xb2, 0xca, 0xf9, 0xf6, 0xd8, 0x5d, 0x57, 0xf8,
-	0x03, 0xf0, 0x0d, 0x78, 0x81, 0x10, 0x2f, 0xf8, 0x62, 0xbc, 0xe2, 0x73, 0x20, 0xa1, 0x9d, 0x5d,
-	0xff, 0x29, 0x71, 0xa2, 0xbc, 0x9b, 0x9d, 0x9b, 0xe7, 0xb9, 0x99, 0x67, 0x66, 0x67, 0xa1, 0xa3,
-	0xa7, 0x97, 0x7c, 0xc6, 0x76, 0x2b, 0x25, 0x8d, 0xa4, 0x0f, 0x66, 0xa2, 0x78, 0x3b, 0xd7, 0xee,
-	0xb4, 0xeb, 0x3e, 0x7d, 0xd8, 0x99, 0xca, 0xd9, 0x4c, 0x96, 0xce, 0xd9, 0xfb, 0x2d, 0x80, 0xf8,
-	0x48, 0xf0, 0x22, 0x3f, 0xc3, 0xaf, 0x34, 0x85, 0xf6, 0x1b, 0x7b, 0x1c, 0x0d, 0x53, 0xd2, 0x25,
-	0xfd, 0x20, 0x5b, 0x1e, 0x29, 0x85, 0x46, 0xc9, 0x66, 0x3c, 0xad, 0x77, 0x49, 0x3f, 0xca, 0xd0,
-	0xa6, 0x1f, 0xc1, 0x7d, 0xa1, 0xc7, 0x95, 0x12, 0x33, 0xa6, 0x16, 0xe3, 0x2b, 0xbe, 0x48, 0x83,
-	0x2e, 0xe9, 0x87, 0x59, 0x47, 0xe8, 0x53, 0xe7, 0x3c, 0xe1, 0x0b, 0xda, 0x85, 0x38, 0xe7, 0x7a,
-	0xaa, 0x44, 0x65, 0x84, 0x2c, 0xd3, 0x06, 0x12, 0x6c, 0xba, 0xe8, 0x4b, 0x88, 0x72, 0x66, 0xd8,
-	0xd8, 0x2c, 0x2a, 0x9e, 0x36, 0xbb, 0xa4, 0x7f, 0x7f, 0xff, 0xd1, 0xee, 0x96, 0xe4, 0x77, 0x87,
-	0xcc, 0xb0, 0xef, 0x17, 0x15, 0xcf, 0xc2, 0xdc, 0x5b, 0x74, 0x00, 0xb1, 0x85, 0x8d, 0x2b, 0xa6,
-	0xd8, 0x4c, 0xa7, 0xad, 0x6e, 0xd0, 0x8f, 0xf7, 0x9f, 0xbc, 0x8b, 0xf6, 0x25, 0x9f, 0xf0, 0xc5,
-	0x39, 0x2b, 0xe6, 0xfc, 0x94, 0x09, 0x95, 0x81, 0x45, 0x9d, 0x22, 0x88, 0x0e, 0xa1, 0x23, 0xca,
-	0x9c, 0xff, 0xbc, 0x24, 0x69, 0xdf, 0x95, 0x24, 0x46, 0x98, 0x67, 0x79, 0x1f, 0x5a, 0x6c, 0x6e,
-	0xe4, 0x68, 0x98, 0x86, 0xa8, 0x82, 0x3f, 0xd1, 0xcf, 0xa0, 0xa9, 0x0d, 0x33, 0x3c, 0x8d, 0xb0,
-	0xb2, 0x9d, 0xad, 0x95, 0xb9, 0x26, 0xd8, 0xb0, 0xcc, 0x45, 0xf7, 0x7e, 0x27, 0x90, 0x1c, 0xca,
-	0xa2, 0xe0, 0x53, 0xab, 0x91, 0xef, 0xcf, 0xb2, 0x0b, 0x64, 0xa3, 0x0b, 0xff, 0xd1, 0xb7, 0x7e,
-	0x5d, 0xdf, 0x75, 0x66, 0xc1, 0x3b, 0x99, 0xbd, 0x80, 0x16, 0xb6, 0x57, 0xa7, 0x0d, 0xac, 0xb8,
-	0x7b, 0x4b, 0x6a, 0x68, 0x67, 0x3e, 0xbe, 0xb7, 0x03, 0xd1, 0x40, 0xca, 0xe2, 0x1b, 0xa5, 0xd8,
-	0xc2, 0x26, 0x65, 0xdb, 0x91, 0x92, 0x6e, 0xd0, 0x0f, 0x33, 0xb4, 0x7b, 0x8f, 0x21, 0x1c, 0x95,
-	0xe6, 0xfa, 0xf7, 0xa6, 0xff, 0xbe, 0x03, 0xd1, 0xb7, 0xb2, 0xbc, 0xb8, 0x1e, 0x10, 0xf8, 0x80,
-	0x2e, 0xc0, 0x51, 0x21, 0xd9, 0x16, 0x8a, 0xba, 0x8f, 0x78, 0x02, 0xf1, 0x50, 0xce, 0x27, 0x05,
-	0xbf, 0x1e, 0x42, 0xd6, 0x24, 0x83, 0x85, 0xe1, 0xfa, 0x7a, 0x44, 0x67, 0x4d, 0x72, 0x66, 0x94,
-	0xd8, 0x96, 0x49, 0xe4, 0x43, 0xfe, 0x0a, 0x20, 0x3e, 0x9b, 0xb2, 0x82, 0x29, 0x54, 0x82, 0xbe,
-	0x82, 0x68, 0x22, 0x65, 0x31, 0xf6, 0x81, 0xa4, 0x1f, 0xef, 0x3f, 0xde, 0x2a, 0xdc, 0x4a, 0xa1,
-	0xe3, 0x5a, 0x16, 0x5a, 0x88, 0x1d, 0x5f, 0xfa, 0x12, 0x42, 0x51, 0x1a, 0x87, 0xae, 0x23, 0x7a,
-	0xfb, 0xac, 0x2f, 0xe5, 0x3b, 0xae, 0x65, 0x6d, 0x51, 0x1a, 0xc4, 0xbe, 0x82, 0xa8, 0x90, 0xe5,
-	0x85, 0x03, 0x07, 0xb7, 0xfc, 0x7a, 0xa5, 0xad, 0xfd, 0xb5, 0x85, 0x20, 0xfc, 0x6b, 0x80, 0x37,
-	0x56, 0x53, 0x87, 0x6f, 0x20, 0xfe, 0x86, 0x71, 0x5c, 0x49, 0x7f, 0x5c, 0xcb, 0x22, 0x04, 0x21,
-	0xc3, 0x21, 0xc4, 0x39, 0x6a, 0xee, 0x28, 0x9a, 0x48, 0xb1, 0x7d, 0x6c, 0x36, 0x7a, 0x73, 0x5c,
-	0xcb, 0xc0, 0xc1, 0x96, 0x24, 0x1a, 0x35, 0x77, 0x24, 0xad, 0x5b, 0x48, 0x36, 0x7a, 0x63, 0x49,
-	0x1c, 0x6c, 0x59, 0xcb, 0xc4, 0xb6, 0xd6, 0x71, 0xb4, 0x6f, 0xa9, 0x65, 0x3d, 0x01, 0xb6, 0x16,
-	0x04, 0x59, 0x86, 0x41, 0xcb, 0xf5, 0xba, 0xf7, 0x2b, 0x81, 0xf8, 0x9c, 0x4f, 0x8d, 0xf4, 0xfd,
-	0x4d, 0x20, 0xc8, 0xc5, 0xcc, 0xef, 0x3f, 0x6b, 0xda, 0xfd, 0xe0, 0x74, 0x7b, 0x8b, 0x61, 0xbe,
-	0x6d, 0x77, 0x50, 0x2e, 0x46, 0x98, 0x23, 0xa7, 0x1f, 0xc3, 0xbd, 0x89, 0x28, 0xed, 0xa6, 0xf4,
-	0x34, 0xb6, 0x81, 0x9d, 0xe3, 0x5a, 0xd6, 0x71, 0x6e, 0x17, 0xb6, 0x4a, 0xeb, 0x1f, 0x02, 0x11,
-	0x26, 0x84, 0xe5, 0x3e, 0x83, 0x06, 0x6e, 0x47, 0x72, 0x97, 0xed, 0x88, 0xa1, 0xf4, 0x11, 0x00,
-	0xde, 0xd6, 0xf1, 0xc6, 0xde, 0x8e, 0xd0, 0xf3, 0xda, 0xae, 0x8d, 0x2f, 0xa1, 0xad, 0x71, 0xaa,
-	0xb5, 0x9f, 0xa4, 0x1b, 0x3a, 0xb0, 0x9e, 0x7c, 0x3b, 0x89, 0x1e, 0x62, 0xd1, 0xae, 0x0a, 0xed,
-	0xe7, 0x68, 0x3b, 0x7a, 0x43, 0x57, 0x8b, 0xf6, 0x10, 0xfa, 0x01, 0x84, 0x2e, 0x35, 0x91, 0xe3,
-	0x0c, 0xad, 0xde, 0x99, 0x7c, 0xd0, 0x86, 0x26, 0x9a, 0xbd, 0x5f, 0x08, 0x04, 0xa3, 0xa1, 0xa6,
-	0x9f, 0x43, 0xcb, 0xde, 0x17, 0x91, 0xdf, 0x7a, 0xd7, 0x36, 0x07, 0xbe, 0x29, 0x4a, 0x33, 0xca,
-	0xe9, 0x17, 0xd0, 0xd2, 0x46, 0x59, 0x60, 0xfd, 0xce, 0x13, 0xd6, 0xd4, 0x46, 0x8d, 0xf2, 0x01,
-	0x40, 0x28, 0xf2, 0xb1, 0xcb, 0xe3, 0x6f, 0x02, 0xc9, 0x19, 0x67, 0x6a, 0x7a, 0x99, 0x71, 0x3d,
-	0x2f, 0xdc, 0x3d, 0xd8, 0x81, 0xb8, 0x9c, 0xcf, 0xc6, 0x3f, 0xcd, 0xb9, 0x12, 0x5c, 0xfb, 0x59,
-	0x81, 0x72, 0x3e, 0xfb, 0xce, 0x79, 0xe8, 0x03, 0x68, 0x1a, 0x59, 0x8d, 0xaf, 0xf0, 0xdf, 0x41,
-	0xd6, 0x30, 0xb2, 0x3a, 0xa1, 0x5f, 0x41, 0xec, 0xf6, 0xe7, 0xf2, 0x02, 0x07, 0x37, 0xd6, 0xb3,
-	0xea, 0x7c, 0xe6, 0x9a, 0x88, 0x23, 0x6b, 0x17, 0xb9, 0x9e, 0x4a, 0xc5, 0xdd, 0xc2, 0xae, 0x67,
-	0xfe, 0x44, 0x9f, 0x42, 0x20, 0x72, 0xed, 0xaf, 0x63, 0xba, 0x7d, 0x9d, 0x0c, 0x75, 0x66, 0x83,
-	0xe8, 0x43, 0xcc, 0xec, 0xca, 0x3d, 0x95, 0x41, 0xe6, 0x0e, 0x4f, 0xff, 0x20, 0x10, 0x2e, 0xe7,
-	0x87, 0x86, 0xd0, 0x78, 0x2d, 0x4b, 0x9e, 0xd4, 0xac, 0x65, 0xb7, 0x58, 0x42, 0xac, 0x35, 0x2a,
-	0xcd, 0x8b, 0xa4, 0x4e, 0x23, 0x68, 0x8e, 0x4a, 0xf3, 0xec, 0x79, 0x12, 0x78, 0xf3, 0x60, 0x3f,
-	0x69, 0x78, 0xf3, 0xf9, 0xa7, 0x49, 0xd3, 0x9a, 0x78, 0x0b, 0x12, 0xa0, 0x00, 0x2d, 0xb7, 0x07,
-	0x92, 0xd8, 0xda, 0x4e, 0xec, 0xe4, 0x21, 0x8d, 0xa1, 0x7d, 0xce, 0xd4, 0xe1, 0x25, 0x53, 0xc9,
-	0x7b, 0x34, 0x81, 0xce, 0x60, 0xe3, 0x06, 0x24, 0x39, 0xfd, 0x1f, 0xc4, 0x47, 0xeb, 0x9b, 0x93,
-	0xf0, 0xa7, 0xe7, 0x00, 0xeb, 0x17, 0xd2, 0x02, 0xf0, 0x74, 0xa8, 0x38, 0x33, 0x3c, 0x4f, 0x6a,
-	0xf4, 0xff, 0x70, 0x6f, 0xed, 0xb1, 0xbf, 0x20, 0x2b, 0xd7, 0x50, 0xc9, 0xaa, 0xb2, 0xae, 0xfa,
-	0x0a, 0x87, 0x2e, 0x9e, 0x27, 0xc1, 0xe0, 0x07, 0xb8, 0x2f, 0xe4, 0x52, 0xaf, 0x0b, 0x55, 0x4d,
-	0x07, 0xb1, 0x7b, 0xe9, 0x4e, 0xad, 0x76, 0xa7, 0xe4, 0xc7, 0x83, 0x0b, 0x61, 0x2e, 0xe7, 0x13,
-	0xfb, 0xfa, 0xef, 0xb9, 0xb0, 0x4f, 0x84, 0xf4, 0xd6, 0x9e, 0x28, 0x0d, 0x57, 0x25, 0x2b, 0xf6,
-	0x50, 0xe9, 0x3d, 0xa7, 0x74, 0x35, 0xf9, 0x93, 0x90, 0x49, 0x0b, 0x5d, 0x07, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x31, 0x59, 0x18, 0x2e, 0x92, 0x09, 0x00, 0x00,
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xef, 0x8e, 0xdb, 0x44,
+	0x10, 0xcf, 0xc6, 0xf9, 0x63, 0x8f, 0xd3, 0xc3, 0x6c, 0x0b, 0x32, 0xa0, 0xf6, 0xd2, 0x08, 0x44,
+	0x54, 0x89, 0x3b, 0xf5, 0x5a, 0x4a, 0xa9, 0xa8, 0x80, 0x5c, 0x74, 0xba, 0xe8, 0x50, 0x15, 0x7c,
+	0xd5, 0x21, 0xf1, 0x25, 0xda, 0xc4, 0xdb, 0xbb, 0x55, 0x1d, 0xdb, 0xd8, 0x9b, 0x13, 0x79, 0x00,
+	0xde, 0x80, 0x4f, 0x88, 0x0f, 0x3c, 0x0b, 0x8f, 0x81, 0xc4, 0x27, 0x9e, 0x03, 0x09, 0xcd, 0xec,
+	0xe6, 0x4f, 0x49, 0x1a, 0xdd, 0xb7, 0xd9, 0xd9, 0xf9, 0xcd, 0xce, 0xfc, 0xe6, 0x8f, 0x0d, 0xad,
+	0x72, 0x72, 0x25, 0xa7, 0xe2, 0x20, 0x2f, 0x32, 0x9d, 0xf1, 0xdb, 0x53, 0x95, 0x5c, 0xcf, 0x4a,
+	0x73, 0x3a, 0x30, 0x57, 0x1f, 0xb6, 0x26, 0xd9, 0x74, 0x9a, 0xa5, 0x46, 0xd9, 0xf9, 0xd3, 0x01,
+	0xff, 0x44, 0xc9, 0x24, 0x3e, 0xa7, 0x5b, 0x1e, 0x42, 0xf3, 0x15, 0x1e, 0x07, 0xfd, 0x90, 0xb5,
+	0x59, 0xd7, 0x89, 0x16, 0x47, 0xce, 0xa1, 0x96, 0x8a, 0xa9, 0x0c, 0xab, 0x6d, 0xd6, 0xf5, 0x22,
+	0x92, 0xf9, 0xc7, 0xb0, 0xa7, 0xca, 0x51, 0x5e, 0xa8, 0xa9, 0x28, 0xe6, 0xa3, 0xd7, 0x72, 0x1e,
+	0x3a, 0x6d, 0xd6, 0x75, 0xa3, 0x96, 0x2a, 0x87, 0x46, 0x79, 0x26, 0xe7, 0xbc, 0x0d, 0x7e, 0x2c,
+	0xcb, 0x49, 0xa1, 0x72, 0xad, 0xb2, 0x34, 0xac, 0x91, 0x83, 0x75, 0x15, 0x7f, 0x06, 0x5e, 0x2c,
+	0xb4, 0x18, 0xe9, 0x79, 0x2e, 0xc3, 0x7a, 0x9b, 0x75, 0xf7, 0x8e, 0xee, 0x1e, 0x6c, 0x09, 0xfe,
+	0xa0, 0x2f, 0xb4, 0x78, 0x39, 0xcf, 0x65, 0xe4, 0xc6, 0x56, 0xe2, 0x3d, 0xf0, 0x11, 0x36, 0xca,
+	0x45, 0x21, 0xa6, 0x65, 0xd8, 0x68, 0x3b, 0x5d, 0xff, 0xe8, 0xfe, 0x9b, 0x68, 0x9b, 0xf2, 0x99,
+	0x9c, 0x5f, 0x88, 0x64, 0x26, 0x87, 0x42, 0x15, 0x11, 0x20, 0x6a, 0x48, 0x20, 0xde, 0x87, 0x96,
+	0x4a, 0x63, 0xf9, 0xf3, 0xc2, 0x49, 0xf3, 0xa6, 0x4e, 0x7c, 0x82, 0x59, 0x2f, 0xef, 0x43, 0x43,
+	0xcc, 0x74, 0x36, 0xe8, 0x87, 0x2e, 0xb1, 0x60, 0x4f, 0xfc, 0x73, 0xa8, 0x97, 0x5a, 0x68, 0x19,
+	0x7a, 0x94, 0xd9, 0xfe, 0xd6, 0xcc, 0x4c, 0x11, 0xd0, 0x2c, 0x32, 0xd6, 0xbc, 0x0b, 0x01, 0x92,
+	0x2b, 0x0a, 0xad, 0x90, 0x24, 0xa2, 0x17, 0xc8, 0xf1, 0x9e, 0x2a, 0x87, 0x0b, 0xf5, 0x99, 0x9c,
+	0x77, 0x7e, 0x63, 0x10, 0x1c, 0x67, 0x49, 0x22, 0x27, 0xa8, 0xb1, 0x95, 0x5c, 0xd4, 0x8b, 0xad,
+	0xd5, 0xeb, 0x7f, 0x95, 0xa8, 0x6e, 0x56, 0x62, 0x95, 0x83, 0xf3, 0x46, 0x0e, 0x4f, 0xa1, 0x41,
+	0x8d, 0x50, 0x86, 0x35, 0xe2, 0xa6, 0xbd, 0x23, 0x09, 0x92, 0x23, 0x6b, 0xdf, 0xd9, 0x07, 0xaf,
+	0x97, 0x65, 0xc9, 0xb7, 0x45, 0x21, 0xe6, 0x18, 0x14, 0x16, 0x2e, 0x64, 0x6d, 0xa7, 0xeb, 0x46,
+	0x24, 0x77, 0xee, 0x81, 0x3b, 0x48, 0xf5, 0xe6, 0x7d, 0xdd, 0xde, 0xef, 0x83, 0xf7, 0x5d, 0x96,
+	0x5e, 0x6e, 0x1a, 0x38, 0xd6, 0xa0, 0x0d, 0x70, 0x92, 0x64, 0x62, 0x8b, 0x8b, 0xaa, 0xb5, 0xb8,
+	0x0f, 0x7e, 0x3f, 0x9b, 0x8d, 0x13, 0xb9, 0x69, 0xc2, 0x56, 0x4e, 0x7a, 0x73, 0x2d, 0xcb, 0x4d,
+	0x8b, 0xd6, 0xca, 0xc9, 0xb9, 0x2e, 0xd4, 0xb6, 0x48, 0x3c, 0x6b, 0xf2, 0xb7, 0x03, 0xfe, 0xf9,
+	0x44, 0x24, 0xa2, 0x20, 0x26, 0xf8, 0x73, 0xf0, 0xc6, 0x59, 0x96, 0x8c, 0xac, 0x21, 0xeb, 0xfa,
+	0x47, 0xf7, 0xb6, 0x12, 0xb7, 0x64, 0xe8, 0xb4, 0x12, 0xb9, 0x08, 0xc1, 0x46, 0xe7, 0xcf, 0xc0,
+	0x55, 0xa9, 0x36, 0xe8, 0x2a, 0xa1, 0xb7, 0x4f, 0xc5, 0x82, 0xbe, 0xd3, 0x4a, 0xd4, 0x54, 0xa9,
+	0x26, 0xec, 0x73, 0xf0, 0x92, 0x2c, 0xbd, 0x34, 0x60, 0x67, 0xc7, 0xd3, 0x4b, 0x6e, 0xf1, 0x69,
+	0x84, 0x10, 0xfc, 0x1b, 0x80, 0x57, 0xc8, 0xa9, 0xc1, 0xd7, 0x08, 0xff, 0x96, 0xc6, 0x5d, 0x52,
+	0x7f, 0x5a, 0x89, 0x3c, 0x02, 0x91, 0x87, 0x63, 0xf0, 0x63, 0xe2, 0xdc, 0xb8, 0xa8, 0xb7, 0xd9,
+	0x5b, 0xdb, 0x66, 0xad, 0x36, 0xa7, 0x95, 0x08, 0x0c, 0x6c, 0xe1, 0xa4, 0x24, 0xce, 0x8d, 0x93,
+	0xc6, 0x0e, 0x27, 0x6b, 0xb5, 0x41, 0x27, 0x06, 0xb6, 0xc8, 0x65, 0x8c, 0xa5, 0x35, 0x3e, 0x9a,
+	0x3b, 0x72, 0x59, 0x75, 0x00, 0xe6, 0x42, 0x20, 0xf4, 0xd0, 0x6b, 0x98, 0x5a, 0x77, 0x7e, 0x65,
+	0xe0, 0x5f, 0xc8, 0x89, 0xce, 0x6c, 0x7d, 0x03, 0x70, 0x62, 0x35, 0xb5, 0x9b, 0x12, 0x45, 0xdc,
+	0x24, 0x86, 0xb7, 0x6b, 0x32, 0x0b, 0xab, 0x3b, 0x5e, 0x7b, 0x83, 0x39, 0x9f, 0x60, 0xc6, 0x39,
+	0xff, 0x04, 0x6e, 0x8d, 0x55, 0x8a, 0x3b, 0xd5, 0xba, 0xc1, 0x02, 0xb6, 0x4e, 0x2b, 0x51, 0xcb,
+	0xa8, 0x8d, 0xd9, 0x32, 0xac, 0x7f, 0x19, 0x78, 0x14, 0x10, 0xa5, 0xfb, 0x10, 0x6a, 0xb4, 0x47,
+	0xd9, 0x4d, 0xf6, 0x28, 0x99, 0xf2, 0xbb, 0x00, 0x34, 0xad, 0xa3, 0xb5, 0x0d, 0xef, 0x91, 0xe6,
+	0x05, 0xae, 0x8d, 0xaf, 0xa0, 0x59, 0x52, 0x57, 0x97, 0xa1, 0xb3, 0xab, 0x02, 0xab, 0xce, 0xc7,
+	0x4e, 0xb4, 0x10, 0x44, 0x9b, 0x2c, 0xca, 0xb0, 0xb6, 0x03, 0xbd, 0xc6, 0x2b, 0xa2, 0x2d, 0x84,
+	0x7f, 0x00, 0xae, 0x09, 0x4d, 0xc5, 0x61, 0x7d, 0xfd, 0x8b, 0x14, 0xf7, 0x9a, 0x50, 0x27, 0xb1,
+	0xf3, 0x0b, 0x03, 0x67, 0xd0, 0x2f, 0xf9, 0x17, 0xd0, 0xc0, 0x79, 0x51, 0x71, 0xc8, 0x6e, 0xd8,
+	0xf0, 0x75, 0x95, 0xea, 0x41, 0xcc, 0xbf, 0x84, 0x46, 0xa9, 0x0b, 0x04, 0x56, 0x6f, 0xdc, 0x61,
+	0xf5, 0x52, 0x17, 0x83, 0xb8, 0x07, 0xe0, 0xaa, 0x78, 0x64, 0xe2, 0xf8, 0x87, 0x41, 0x70, 0x2e,
+	0x45, 0x31, 0xb9, 0x8a, 0x64, 0x39, 0x4b, 0xcc, 0x1c, 0xec, 0x83, 0x9f, 0xce, 0xa6, 0xa3, 0x9f,
+	0x66, 0xb2, 0x50, 0xb2, 0xb4, 0xbd, 0x02, 0xe9, 0x6c, 0xfa, 0xbd, 0xd1, 0xf0, 0xdb, 0x50, 0xd7,
+	0x59, 0x3e, 0x7a, 0x4d, 0x6f, 0x3b, 0x51, 0x4d, 0x67, 0xf9, 0x19, 0xff, 0x1a, 0x7c, 0xb3, 0x3f,
+	0x17, 0x03, 0xec, 0xbc, 0x35, 0x9f, 0x65, 0xe5, 0x23, 0x53, 0x44, 0x6a, 0x59, 0x5c, 0xe4, 0xe5,
+	0x24, 0x2b, 0xa4, 0x59, 0xd8, 0xd5, 0xc8, 0x9e, 0xf8, 0x03, 0x70, 0x54, 0x5c, 0xda, 0x71, 0x0c,
+	0xb7, 0xaf, 0x93, 0x7e, 0x19, 0xa1, 0x11, 0xbf, 0x43, 0x91, 0xbd, 0x36, 0x1f, 0x55, 0x27, 0x32,
+	0x87, 0xce, 0x5f, 0x0c, 0x6e, 0xbd, 0x94, 0xd3, 0x3c, 0x11, 0x5a, 0xd2, 0x97, 0x90, 0x7f, 0x04,
+	0xb4, 0xb3, 0x46, 0xd7, 0x22, 0xa1, 0xfc, 0x5c, 0x2c, 0x20, 0x6a, 0x2e, 0x44, 0xc2, 0xef, 0x82,
+	0xa7, 0x52, 0xfd, 0xe4, 0x31, 0xdd, 0x52, 0x8a, 0xb8, 0x68, 0x48, 0x65, 0xaf, 0xed, 0xc0, 0x88,
+	0x84, 0xba, 0x8b, 0xe1, 0xb5, 0x19, 0x06, 0x91, 0xf0, 0x7d, 0xb0, 0x93, 0x4c, 0xf7, 0xf4, 0xeb,
+	0x80, 0xa3, 0x69, 0x74, 0x68, 0x70, 0x02, 0x9e, 0xc0, 0x8a, 0xd0, 0xbd, 0xc9, 0xea, 0xd3, 0xad,
+	0x59, 0x2d, 0x42, 0xa6, 0xfa, 0x51, 0xdc, 0xf8, 0x90, 0xb0, 0xa7, 0x5e, 0x1d, 0x9c, 0x6b, 0x91,
+	0x74, 0x86, 0xc0, 0x37, 0x0d, 0xf9, 0x33, 0x68, 0x5c, 0xa3, 0x50, 0xd2, 0xb6, 0xf7, 0x8f, 0x3a,
+	0x3b, 0x5f, 0x20, 0x4c, 0x64, 0x11, 0x0f, 0x7e, 0x67, 0xe0, 0x2e, 0xc6, 0x8d, 0xbb, 0x50, 0x7b,
+	0x91, 0xa5, 0x32, 0xa8, 0xa0, 0x84, 0x4b, 0x3f, 0x60, 0x28, 0x0d, 0x52, 0xfd, 0x34, 0xa8, 0x72,
+	0x0f, 0xea, 0x83, 0x54, 0x3f, 0x7c, 0x12, 0x38, 0x56, 0x7c, 0x74, 0x14, 0xd4, 0xac, 0xf8, 0xe4,
+	0x71, 0x50, 0x47, 0x91, 0x96, 0x46, 0x00, 0x1c, 0xa0, 0x61, 0xd6, 0x66, 0xe0, 0xa3, 0x6c, 0x7a,
+	0x33, 0xb8, 0xc3, 0x7d, 0x68, 0x5e, 0x88, 0xe2, 0xf8, 0x4a, 0x14, 0xc1, 0x7b, 0x3c, 0x80, 0x56,
+	0x6f, 0x6d, 0x61, 0x04, 0x31, 0x7f, 0x07, 0xfc, 0x93, 0xd5, 0xa2, 0x09, 0xe4, 0x83, 0x0b, 0x80,
+	0xd5, 0xaf, 0x07, 0x02, 0xe8, 0x74, 0x5c, 0x48, 0xa1, 0x65, 0x1c, 0x54, 0xf8, 0xbb, 0x70, 0x6b,
+	0xa5, 0xc1, 0x27, 0xd8, 0x52, 0xd5, 0x2f, 0xb2, 0x3c, 0x47, 0x55, 0x75, 0x89, 0x23, 0x95, 0x8c,
+	0x03, 0xa7, 0xf7, 0x03, 0xec, 0xa9, 0x6c, 0x41, 0xd3, 0x65, 0x91, 0x4f, 0x7a, 0xbe, 0xf9, 0x31,
+	0x18, 0x22, 0x65, 0x43, 0xf6, 0xe3, 0xa3, 0x4b, 0xa5, 0xaf, 0x66, 0x63, 0xfc, 0xad, 0x3a, 0x34,
+	0x66, 0x9f, 0xa9, 0xcc, 0x4a, 0x87, 0x2a, 0xd5, 0xb2, 0x48, 0x45, 0x72, 0x48, 0x04, 0x1f, 0x1a,
+	0x82, 0xf3, 0xf1, 0x1f, 0x8c, 0x8d, 0x1b, 0xa4, 0x7a, 0xf4, 0xdf, 0x00, 0x20, 0x3d, 0xd0, 0xda,
+	0xeb, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+const (
+	auditOutcomeSuccess = "success"
+	auditOutcomeFailure = "failure"
+)
+
+// AuditLogger writes an entry of a stable json schema for every ddl and credential operation, separately from
+// the debug logs. Only the names of the objects operated are logged, the payloads such as schemas and passwords never.
+// A nil AuditLogger logs nothing.
+type AuditLogger struct {
+	logger *zap.Logger
+}
+
+// NewAuditLogger returns the AuditLogger configured by Params, it returns nil if audit log is disabled.
+func NewAuditLogger() (*AuditLogger, error) {
+	if !Params.ProxyCfg.AuditLogEnabled {
+		return nil, nil
+	}
+	var sink zapcore.WriteSyncer = zapcore.Lock(os.Stdout)
+	if filename := Params.ProxyCfg.AuditLogFilename; filename != "" {
+		if st, err := os.Stat(filename); err == nil && st.IsDir() {
+			return nil, fmt.Errorf("can't use directory %s as audit log file", filename)
+		}
+		sink = zapcore.AddSync(&lumberjack.Logger{
+			Filename:  filename,
+			MaxSize:   Params.ProxyCfg.AuditLogMaxSize,
+			LocalTime: true,
+		})
+	}
+	return newAuditLoggerWithWriteSyncer(sink), nil
+}
+
+func newAuditLoggerWithWriteSyncer(sink zapcore.WriteSyncer) *AuditLogger {
+	encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+		TimeKey:        "time",
+		EncodeTime:     zapcore.RFC3339NanoTimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+		LineEnding:     zapcore.DefaultLineEnding,
+	})
+	return &AuditLogger{
+		logger: zap.New(zapcore.NewCore(encoder, sink, zapcore.InfoLevel)),
+	}
+}
+
+// isAuditedRequest returns whether the request is a ddl, database, credential or rbac operation. The ddl requests
+// are the ones executed by the tasks of the ddQueue.
+func isAuditedRequest(req interface{}) bool {
+	switch req.(type) {
+	case *milvuspb.CreateCollectionRequest, *milvuspb.DropCollectionRequest, *milvuspb.HasCollectionRequest,
+		*milvuspb.LoadCollectionRequest, *milvuspb.ReleaseCollectionRequest, *milvuspb.DescribeCollectionRequest,
+		*milvuspb.GetStatisticsRequest, *milvuspb.GetCollectionStatisticsRequest, *milvuspb.ShowCollectionsRequest,
+		*milvuspb.AlterCollectionRequest:
+		return true
+	case *milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest, *milvuspb.HasPartitionRequest,
+		*milvuspb.LoadPartitionsRequest, *milvuspb.ReleasePartitionsRequest, *milvuspb.GetPartitionStatisticsRequest,
+		*milvuspb.ShowPartitionsRequest:
+		return true
+	case *milvuspb.CreateIndexRequest, *milvuspb.DescribeIndexRequest, *milvuspb.DropIndexRequest,
+		*milvuspb.GetIndexBuildProgressRequest, *milvuspb.GetIndexStateRequest:
+		return true
+	case *milvuspb.FlushRequest, *milvuspb.CreateAliasRequest, *milvuspb.DropAliasRequest, *milvuspb.AlterAliasRequest:
+		return true
+	case *milvuspb.CreateDatabaseRequest, *milvuspb.DropDatabaseRequest, *milvuspb.ListDatabasesRequest:
+		return true
+	case *milvuspb.CreateCredentialRequest, *milvuspb.UpdateCredentialRequest, *milvuspb.DeleteCredentialRequest,
+		*milvuspb.ListCredUsersRequest:
+		return true
+	case *milvuspb.CreateRoleRequest, *milvuspb.DropRoleRequest, *milvuspb.OperateUserRoleRequest,
+		*milvuspb.SelectRoleRequest, *milvuspb.SelectUserRequest, *milvuspb.OperatePrivilegeRequest,
+		*milvuspb.SelectGrantRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+// auditObjects returns the names of the objects operated by the request, keyed by the kind of the object.
+func auditObjects(req interface{}) map[string]string {
+	objects := make(map[string]string)
+	add := func(kind string, name string) {
+		if name != "" {
+			objects[kind] = name
+		}
+	}
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		add("db", r.GetDbName())
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		add("collection", r.GetCollectionName())
+	}
+	if r, ok := req.(interface{ GetCollectionNames() []string }); ok {
+		add("collections", strings.Join(r.GetCollectionNames(), ","))
+	}
+	if r, ok := req.(interface{ GetPartitionName() string }); ok {
+		add("partition", r.GetPartitionName())
+	}
+	if r, ok := req.(interface{ GetPartitionNames() []string }); ok {
+		add("partitions", strings.Join(r.GetPartitionNames(), ","))
+	}
+	if r, ok := req.(interface{ GetFieldName() string }); ok {
+		add("field", r.GetFieldName())
+	}
+	if r, ok := req.(interface{ GetIndexName() string }); ok {
+		add("index", r.GetIndexName())
+	}
+	if r, ok := req.(interface{ GetAlias() string }); ok {
+		add("alias", r.GetAlias())
+	}
+	if r, ok := req.(interface{ GetUsername() string }); ok {
+		add("user", r.GetUsername())
+	}
+	if r, ok := req.(interface{ GetRoleName() string }); ok {
+		add("role", r.GetRoleName())
+	}
+	switch r := req.(type) {
+	case *milvuspb.CreateRoleRequest:
+		add("role", r.GetEntity().GetName())
+	case *milvuspb.SelectRoleRequest:
+		add("role", r.GetRole().GetName())
+	case *milvuspb.SelectUserRequest:
+		add("user", r.GetUser().GetName())
+	case *milvuspb.OperateUserRoleRequest:
+		add("operation", r.GetType().String())
+	case *milvuspb.OperatePrivilegeRequest:
+		addGrantObjects(add, r.GetEntity())
+		add("operation", r.GetType().String())
+	case *milvuspb.SelectGrantRequest:
+		addGrantObjects(add, r.GetEntity())
+	}
+	return objects
+}
+
+// addGrantObjects adds the role, the object and the privilege of the grant.
+func addGrantObjects(add func(kind string, name string), grant *milvuspb.GrantEntity) {
+	add("role", grant.GetRole().GetName())
+	add("object_type", grant.GetObject().GetName())
+	add("object", grant.GetObjectName())
+	add("privilege", grant.GetGrantor().GetPrivilege().GetName())
+}
+
+// statusOf returns the status of the response, nil if the response carries no status.
+func statusOf(resp interface{}) *commonpb.Status {
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		return r
+	case interface{ GetStatus() *commonpb.Status }:
+		return r.GetStatus()
+	default:
+		return nil
+	}
+}
+
+// Audit writes the audit entry of the request handled by the method if the request is audited. It's called by the
+// servers other than gRPC, e.g. the http server, the gRPC requests are audited by AuditInterceptor.
+func (a *AuditLogger) Audit(ctx context.Context, method string, clientAddr string, req interface{}, resp interface{}, err error) {
+	if a == nil || !isAuditedRequest(req) {
+		return
+	}
+	a.log(ctx, method, clientAddr, req, resp, err)
+}
+
+// log writes the audit entry of the request handled by the method.
+func (a *AuditLogger) log(ctx context.Context, method string, clientAddr string, req interface{}, resp interface{}, err error) {
+	user, _ := GetCurUserFromContext(ctx)
+	clientCN, _ := GetClientCNFromContext(ctx)
+	var requestID int64
+	if r, ok := req.(interface{ GetBase() *commonpb.MsgBase }); ok {
+		requestID = r.GetBase().GetMsgID()
+	}
+
+	outcome := auditOutcomeSuccess
+	errorCode := commonpb.ErrorCode_Success
+	var reason string
+	if err != nil {
+		outcome, errorCode, reason = auditOutcomeFailure, commonpb.ErrorCode_UnexpectedError, err.Error()
+	} else if status := statusOf(resp); status.GetErrorCode() != commonpb.ErrorCode_Success {
+		outcome, errorCode, reason = auditOutcomeFailure, status.GetErrorCode(), status.GetReason()
+	}
+
+	a.logger.Info("",
+		zap.String("user", user),
+		zap.String("client_addr", clientAddr),
+		zap.String("client_cn", clientCN),
+		zap.String("method", method),
+		zap.Any("objects", auditObjects(req)),
+		zap.Int64("request_id", requestID),
+		zap.String("outcome", outcome),
+		zap.String("error_code", errorCode.String()),
+		zap.String("reason", reason))
+}
+
+// AuditInterceptor returns a new unary server interceptor which writes the audit entries of the ddl and credential
+// operations once they complete.
+func AuditInterceptor(audit *AuditLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if audit == nil || !isAuditedRequest(req) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		var clientAddr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			clientAddr = p.Addr.String()
+		}
+		audit.log(ctx, method, clientAddr, req, resp, err)
+		return resp, err
+	}
+}
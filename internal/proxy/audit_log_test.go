@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+type auditEntry struct {
+	Time       string            `json:"time"`
+	User       string            `json:"user"`
+	ClientAddr string            `json:"client_addr"`
+	ClientCN   string            `json:"client_cn"`
+	Method     string            `json:"method"`
+	Objects    map[string]string `json:"objects"`
+	RequestID  int64             `json:"request_id"`
+	Outcome    string            `json:"outcome"`
+	ErrorCode  string            `json:"error_code"`
+	Reason     string            `json:"reason"`
+}
+
+func parseAuditEntries(t *testing.T, buf *bytes.Buffer) []auditEntry {
+	var entries []auditEntry
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry auditEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry), line)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	buf := &bytes.Buffer{}
+	interceptor := AuditInterceptor(newAuditLoggerWithWriteSyncer(zapcore.AddSync(buf)))
+
+	ctx := GetContext(context.Background(), "alice:Secret123")
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 12345}})
+	ctx = context.WithValue(ctx, clientCNKey{}, "client.example.com")
+	methodInfo := func(method string) *grpc.UnaryServerInfo {
+		return &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/" + method}
+	}
+
+	t.Run("CreateCollection", func(t *testing.T) {
+		buf.Reset()
+		req := &milvuspb.CreateCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgID: 100},
+			CollectionName: "coll",
+			Schema:         []byte("schema-payload"),
+		}
+		resp, err := interceptor(ctx, req, methodInfo("CreateCollection"), func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.(*commonpb.Status).GetErrorCode())
+
+		entries := parseAuditEntries(t, buf)
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		assert.NotEmpty(t, entry.Time)
+		assert.Equal(t, "alice", entry.User)
+		assert.Equal(t, "10.0.0.1:12345", entry.ClientAddr)
+		assert.Equal(t, "client.example.com", entry.ClientCN)
+		assert.Equal(t, "CreateCollection", entry.Method)
+		assert.Equal(t, map[string]string{"collection": "coll"}, entry.Objects)
+		assert.Equal(t, int64(100), entry.RequestID)
+		assert.Equal(t, auditOutcomeSuccess, entry.Outcome)
+		assert.Equal(t, commonpb.ErrorCode_Success.String(), entry.ErrorCode)
+		assert.NotContains(t, buf.String(), "schema-payload")
+	})
+
+	t.Run("DeleteCredential", func(t *testing.T) {
+		buf.Reset()
+		req := &milvuspb.DeleteCredentialRequest{Username: "bob"}
+		_, err := interceptor(ctx, req, methodInfo("DeleteCredential"), func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_DeleteCredentialFailure, Reason: "mock"}, nil
+		})
+		require.NoError(t, err)
+
+		entries := parseAuditEntries(t, buf)
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		assert.Equal(t, "alice", entry.User)
+		assert.Equal(t, "DeleteCredential", entry.Method)
+		assert.Equal(t, map[string]string{"user": "bob"}, entry.Objects)
+		assert.Equal(t, auditOutcomeFailure, entry.Outcome)
+		assert.Equal(t, commonpb.ErrorCode_DeleteCredentialFailure.String(), entry.ErrorCode)
+		assert.Equal(t, "mock", entry.Reason)
+	})
+
+	t.Run("passwords are not logged", func(t *testing.T) {
+		buf.Reset()
+		req := &milvuspb.CreateCredentialRequest{Username: "bob", Password: crypto.Base64Encode("Password456")}
+		_, err := interceptor(ctx, req, methodInfo("CreateCredential"), func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("mock")
+		})
+		assert.Error(t, err)
+
+		entries := parseAuditEntries(t, buf)
+		require.Len(t, entries, 1)
+		assert.Equal(t, auditOutcomeFailure, entries[0].Outcome)
+		assert.Equal(t, "mock", entries[0].Reason)
+		assert.NotContains(t, buf.String(), "Password456")
+		assert.NotContains(t, buf.String(), req.GetPassword())
+		assert.NotContains(t, buf.String(), "Secret123")
+	})
+
+	t.Run("not audited", func(t *testing.T) {
+		buf.Reset()
+		_, err := interceptor(ctx, &milvuspb.SearchRequest{CollectionName: "coll"}, methodInfo("Search"),
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &milvuspb.SearchResults{}, nil
+			})
+		assert.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		interceptor := AuditInterceptor(nil)
+		called := false
+		_, err := interceptor(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"}, methodInfo("DropCollection"),
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return &commonpb.Status{}, nil
+			})
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+func TestAuditObjects(t *testing.T) {
+	assert.Equal(t, map[string]string{"db": "db", "collection": "coll", "partition": "p"},
+		auditObjects(&milvuspb.CreatePartitionRequest{DbName: "db", CollectionName: "coll", PartitionName: "p"}))
+	assert.Equal(t, map[string]string{"collection": "coll", "field": "vec", "index": "idx"},
+		auditObjects(&milvuspb.CreateIndexRequest{CollectionName: "coll", FieldName: "vec", IndexName: "idx"}))
+	assert.Equal(t, map[string]string{"collections": "c1,c2"},
+		auditObjects(&milvuspb.FlushRequest{CollectionNames: []string{"c1", "c2"}}))
+	assert.Equal(t, map[string]string{"collection": "coll", "alias": "a"},
+		auditObjects(&milvuspb.CreateAliasRequest{CollectionName: "coll", Alias: "a"}))
+	assert.Equal(t, map[string]string{"user": "bob"},
+		auditObjects(&milvuspb.UpdateCredentialRequest{Username: "bob", OldPassword: "old", NewPassword: "new"}))
+	assert.Equal(t, map[string]string{"db": "db1"},
+		auditObjects(&milvuspb.CreateDatabaseRequest{DbName: "db1"}))
+	assert.Equal(t, map[string]string{"role": "admin"},
+		auditObjects(&milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "admin"}}))
+	assert.Equal(t, map[string]string{"user": "bob", "role": "admin", "operation": milvuspb.OperateUserRoleType_AddUserToRole.String()},
+		auditObjects(&milvuspb.OperateUserRoleRequest{Username: "bob", RoleName: "admin", Type: milvuspb.OperateUserRoleType_AddUserToRole}))
+	assert.Equal(t, map[string]string{"role": "admin", "object_type": "Collection", "object": "coll", "privilege": "Insert",
+		"operation": milvuspb.OperatePrivilegeType_Grant.String()},
+		auditObjects(&milvuspb.OperatePrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: "admin"},
+				Object:     &milvuspb.ObjectEntity{Name: "Collection"},
+				ObjectName: "coll",
+				Grantor:    &milvuspb.GrantorEntity{Privilege: &milvuspb.PrivilegeEntity{Name: "Insert"}},
+			},
+			Type: milvuspb.OperatePrivilegeType_Grant,
+		}))
+}
+
+func TestAuditLogger_Audit(t *testing.T) {
+	buf := &bytes.Buffer{}
+	audit := newAuditLoggerWithWriteSyncer(zapcore.AddSync(buf))
+	ctx := GetContext(context.Background(), "alice:Secret123")
+
+	audit.Audit(ctx, "DropRole", "10.0.0.1", &milvuspb.DropRoleRequest{RoleName: "admin"},
+		&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil)
+	entries := parseAuditEntries(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "alice", entries[0].User)
+	assert.Equal(t, "10.0.0.1", entries[0].ClientAddr)
+	assert.Equal(t, "DropRole", entries[0].Method)
+	assert.Equal(t, map[string]string{"role": "admin"}, entries[0].Objects)
+	assert.Equal(t, auditOutcomeSuccess, entries[0].Outcome)
+
+	// the requests not audited and the disabled logger write nothing
+	buf.Reset()
+	audit.Audit(ctx, "Query", "10.0.0.1", &milvuspb.QueryRequest{CollectionName: "coll"}, &milvuspb.QueryResults{}, nil)
+	var disabled *AuditLogger
+	disabled.Audit(ctx, "DropRole", "10.0.0.1", &milvuspb.DropRoleRequest{RoleName: "admin"}, &commonpb.Status{}, nil)
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// idAllocFunc allocates count contiguous ids [begin, end) from rootcoord, e.g. allocator.IDAllocator.Alloc.
+type idAllocFunc func(count uint32) (UniqueID, UniqueID, error)
+
+// idRange is the ids [begin, end).
+type idRange struct {
+	begin UniqueID
+	end   UniqueID
+}
+
+func (r idRange) size() int64 {
+	return r.end - r.begin
+}
+
+// idFetch is a batch of ids being fetched from rootcoord.
+type idFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// cachedIDAllocator allocates the ids of the inserts from the ids cached in proxy. The next batch is fetched in
+// background once the cached ids drop below the prefetch threshold, so that the inserts don't wait for rootcoord
+// in the steady state. If the cached ids run out, e.g. rootcoord is slow, an allocation waits for the ids at most
+// for the wait timeout or until its context is done.
+type cachedIDAllocator struct {
+	alloc             idAllocFunc
+	batchSize         uint32
+	prefetchThreshold uint32
+	waitTimeout       time.Duration
+
+	mu sync.Mutex
+	// cur is the range the ids are allocated from, next is the range fetched in background to replace cur
+	cur      idRange
+	next     idRange
+	fetching *idFetch
+}
+
+func newCachedIDAllocator(alloc idAllocFunc) *cachedIDAllocator {
+	return &cachedIDAllocator{
+		alloc:             alloc,
+		batchSize:         Params.ProxyCfg.IDAllocBatchSize,
+		prefetchThreshold: Params.ProxyCfg.IDAllocPrefetchThreshold,
+		waitTimeout:       Params.ProxyCfg.IDAllocWaitTimeout,
+	}
+}
+
+// Alloc allocates count contiguous ids [begin, end), it fails with RateLimit if the ids are not available in time.
+func (a *cachedIDAllocator) Alloc(ctx context.Context, count uint32) (UniqueID, UniqueID, error) {
+	timer := time.NewTimer(a.waitTimeout)
+	defer timer.Stop()
+	blocked := false
+	for {
+		a.mu.Lock()
+		if a.cur.size() < int64(count) && a.next.size() >= int64(count) {
+			a.cur, a.next = a.next, idRange{}
+		}
+		if a.cur.size() >= int64(count) {
+			begin := a.cur.begin
+			a.cur.begin += int64(count)
+			if a.cachedLocked() < int64(a.prefetchThreshold) {
+				a.fetchLocked(0)
+			}
+			a.updateMetricsLocked()
+			a.mu.Unlock()
+			return begin, begin + int64(count), nil
+		}
+		fetch := a.fetchLocked(count)
+		a.mu.Unlock()
+
+		if !blocked {
+			blocked = true
+			metrics.ProxyBlockingIDAllocCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Inc()
+		}
+		select {
+		case <-fetch.done:
+			if fetch.err != nil {
+				return 0, 0, fmt.Errorf("failed to allocate %d ids: %w", count, fetch.err)
+			}
+		case <-timer.C:
+			return 0, 0, newErrWithCode(commonpb.ErrorCode_RateLimit,
+				"allocating %d ids from rootcoord takes longer than %v, please retry later", count, a.waitTimeout)
+		case <-ctx.Done():
+			return 0, 0, newErrWithCode(commonpb.ErrorCode_RateLimit,
+				"failed to allocate %d ids from rootcoord: %v, please retry later", count, ctx.Err())
+		}
+	}
+}
+
+// AllocOne allocates one id.
+func (a *cachedIDAllocator) AllocOne(ctx context.Context) (UniqueID, error) {
+	id, _, err := a.Alloc(ctx, 1)
+	return id, err
+}
+
+func (a *cachedIDAllocator) cachedLocked() int64 {
+	return a.cur.size() + a.next.size()
+}
+
+func (a *cachedIDAllocator) updateMetricsLocked() {
+	metrics.ProxyCachedIDs.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Set(float64(a.cachedLocked()))
+}
+
+// fetchLocked fetches a batch of at least count ids in background unless a fetch is in progress, it returns the
+// fetch in progress. The fetched ids are taken as the next range.
+func (a *cachedIDAllocator) fetchLocked(count uint32) *idFetch {
+	if a.fetching != nil {
+		return a.fetching
+	}
+	size := a.batchSize
+	if count > size {
+		size = count
+	}
+	fetch := &idFetch{done: make(chan struct{})}
+	a.fetching = fetch
+	go func() {
+		begin, end, err := a.alloc(size)
+		a.mu.Lock()
+		if err != nil {
+			log.Warn("failed to fetch ids from rootcoord", zap.Uint32("count", size), zap.Error(err))
+		} else {
+			if a.next.size() > 0 {
+				a.cur = a.next
+			}
+			a.next = idRange{begin: begin, end: end}
+		}
+		fetch.err = err
+		a.fetching = nil
+		a.updateMetricsLocked()
+		a.mu.Unlock()
+		close(fetch.done)
+	}()
+	return fetch
+}
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// mockIDAllocFunc allocates the ids from a counter after the latency.
+type mockIDAllocFunc struct {
+	mu      sync.Mutex
+	next    UniqueID
+	calls   int
+	latency time.Duration
+	err     error
+}
+
+func (m *mockIDAllocFunc) alloc(count uint32) (UniqueID, UniqueID, error) {
+	time.Sleep(m.latency)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return 0, 0, m.err
+	}
+	begin := m.next
+	m.next += UniqueID(count)
+	return begin, m.next, nil
+}
+
+func (m *mockIDAllocFunc) getCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+func newTestCachedIDAllocator(m *mockIDAllocFunc, waitTimeout time.Duration) *cachedIDAllocator {
+	return &cachedIDAllocator{
+		alloc:             m.alloc,
+		batchSize:         100,
+		prefetchThreshold: 50,
+		waitTimeout:       waitTimeout,
+	}
+}
+
+func blockingIDAllocCount() float64 {
+	return testutil.ToFloat64(metrics.ProxyBlockingIDAllocCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)))
+}
+
+func TestCachedIDAllocator_prefetch(t *testing.T) {
+	ctx := context.Background()
+	m := &mockIDAllocFunc{latency: 10 * time.Millisecond}
+	a := newTestCachedIDAllocator(m, time.Second)
+
+	// the first allocation waits for the first batch
+	blocked := blockingIDAllocCount()
+	begin, end, err := a.Alloc(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, UniqueID(0), begin)
+	assert.Equal(t, UniqueID(10), end)
+	assert.Equal(t, blocked+1, blockingIDAllocCount())
+
+	// the next batch is fetched in background once the cached ids drop below the threshold
+	blocked = blockingIDAllocCount()
+	var ids []UniqueID
+	for i := 0; i < 5; i++ {
+		begin, end, err = a.Alloc(ctx, 10)
+		require.NoError(t, err)
+		for id := begin; id < end; id++ {
+			ids = append(ids, id)
+		}
+	}
+	assert.Eventually(t, func() bool { return m.getCalls() == 2 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.ProxyCachedIDs.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10))) == 140
+	}, time.Second, time.Millisecond)
+	for i := 0; i < 10; i++ {
+		begin, end, err = a.Alloc(ctx, 10)
+		require.NoError(t, err)
+		for id := begin; id < end; id++ {
+			ids = append(ids, id)
+		}
+	}
+	assert.Equal(t, blocked, blockingIDAllocCount())
+
+	// the ids are unique
+	unique := make(map[UniqueID]struct{})
+	for _, id := range ids {
+		unique[id] = struct{}{}
+	}
+	assert.Len(t, unique, len(ids))
+
+	// the allocation larger than the batch
+	begin, end, err = a.Alloc(ctx, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, UniqueID(1000), end-begin)
+
+	id, err := a.AllocOne(ctx)
+	require.NoError(t, err)
+	_, ok := unique[id]
+	assert.False(t, ok)
+}
+
+func TestCachedIDAllocator_exhausted(t *testing.T) {
+	t.Run("wait timeout", func(t *testing.T) {
+		m := &mockIDAllocFunc{latency: time.Second}
+		a := newTestCachedIDAllocator(m, 10*time.Millisecond)
+		_, _, err := a.Alloc(context.Background(), 10)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		m := &mockIDAllocFunc{latency: time.Second}
+		a := newTestCachedIDAllocator(m, time.Hour)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, _, err := a.Alloc(ctx, 10)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+	})
+
+	t.Run("alloc failed", func(t *testing.T) {
+		allocErr := errors.New("mock")
+		m := &mockIDAllocFunc{err: allocErr}
+		a := newTestCachedIDAllocator(m, time.Second)
+		_, _, err := a.Alloc(context.Background(), 10)
+		assert.ErrorIs(t, err, allocErr)
+
+		// retried by the next allocation
+		m.mu.Lock()
+		m.err = nil
+		m.mu.Unlock()
+		_, _, err = a.Alloc(context.Background(), 10)
+		assert.NoError(t, err)
+	})
+}
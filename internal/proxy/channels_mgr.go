@@ -18,11 +18,11 @@ package proxy
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"runtime"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"go.uber.org/zap"
 )
@@ -41,6 +42,8 @@ type channelsMgr interface {
 	getOrCreateDmlStream(collectionID UniqueID) (msgstream.MsgStream, error)
 	removeDMLStream(collectionID UniqueID) error
 	removeAllDMLStream() error
+	checkDmlBackpressure(collectionID UniqueID, threshold time.Duration) error
+	getDmlStreamNum() (streams int, pchans int)
 }
 
 type channelInfos struct {
@@ -103,7 +106,7 @@ func getDmlChannelsFunc(ctx context.Context, rc types.RootCoord) getChannelsFunc
 			log.Error("failed to describe collection",
 				zap.String("error_code", resp.GetStatus().GetErrorCode().String()),
 				zap.String("reason", resp.GetStatus().GetReason()))
-			return channelInfos{}, errors.New(resp.GetStatus().GetReason())
+			return channelInfos{}, newErrWithCode(resp.GetStatus().GetErrorCode(), "%s", resp.GetStatus().GetReason())
 		}
 
 		return newChannels(resp.GetVirtualChannelNames(), resp.GetPhysicalChannelNames())
@@ -126,6 +129,9 @@ type singleTypeChannelsMgr struct {
 	repackFunc       repackFuncType
 	singleStreamType streamType
 	msgStreamFactory msgstream.Factory
+
+	// monitor tracks the produces of the streams, nil if not tracked
+	monitor *produceMonitor
 }
 
 func (mgr *singleTypeChannelsMgr) getAllChannels(collectionID UniqueID) (channelInfos, error) {
@@ -243,6 +249,13 @@ func (mgr *singleTypeChannelsMgr) createMsgStream(collectionID UniqueID) (msgstr
 		log.Error("failed to create message stream", zap.Error(err), zap.Int64("collection", collectionID))
 		return nil, err
 	}
+	if mgr.monitor != nil {
+		stream = mgr.monitor.wrap(stream)
+	}
+	if mgr.singleStreamType == dmlStreamType && Params.ProxyCfg.InsertBatchingEnabled {
+		stream = newBatchingStream(stream, Params.ProxyCfg.InsertBatchingWindow, Params.ProxyCfg.InsertBatchingMaxSize,
+			Params.PulsarCfg.MaxMessageSize)
+	}
 
 	mgr.mu.Lock()
 	defer mgr.mu.Unlock()
@@ -300,19 +313,46 @@ func (mgr *singleTypeChannelsMgr) removeAllStream() error {
 	return nil
 }
 
+// getStreamNum returns the number of the message streams and the distinct physical channels they produce to.
+func (mgr *singleTypeChannelsMgr) getStreamNum() (streams int, pchans int) {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+	channels := typeutil.NewSet[pChan]()
+	for _, info := range mgr.infos {
+		channels.Insert(info.channelInfos.pchans...)
+	}
+	return len(mgr.infos), channels.Len()
+}
+
+// checkBackpressure returns an error if producing to any of the channels of the collection is slower than the threshold.
+func (mgr *singleTypeChannelsMgr) checkBackpressure(collectionID UniqueID, threshold time.Duration) error {
+	if mgr.monitor == nil {
+		return nil
+	}
+	pchans, err := mgr.getChannels(collectionID)
+	if err != nil {
+		return err
+	}
+	return mgr.monitor.checkBackpressure(pchans, threshold)
+}
+
 func newSingleTypeChannelsMgr(
 	getChannelsFunc getChannelsFuncType,
 	msgStreamFactory msgstream.Factory,
 	repackFunc repackFuncType,
 	singleStreamType streamType,
 ) *singleTypeChannelsMgr {
-	return &singleTypeChannelsMgr{
+	mgr := &singleTypeChannelsMgr{
 		infos:            make(map[UniqueID]streamInfos),
 		getChannelsFunc:  getChannelsFunc,
 		repackFunc:       repackFunc,
 		singleStreamType: singleStreamType,
 		msgStreamFactory: msgStreamFactory,
 	}
+	if singleStreamType == dmlStreamType {
+		mgr.monitor = newProduceMonitor()
+	}
+	return mgr
 }
 
 // implementation assertion
@@ -343,6 +383,14 @@ func (mgr *channelsMgrImpl) removeAllDMLStream() error {
 	return mgr.dmlChannelsMgr.removeAllStream()
 }
 
+func (mgr *channelsMgrImpl) checkDmlBackpressure(collectionID UniqueID, threshold time.Duration) error {
+	return mgr.dmlChannelsMgr.checkBackpressure(collectionID, threshold)
+}
+
+func (mgr *channelsMgrImpl) getDmlStreamNum() (streams int, pchans int) {
+	return mgr.dmlChannelsMgr.getStreamNum()
+}
+
 // newChannelsMgrImpl constructs a channels manager.
 func newChannelsMgrImpl(
 	getDmlChannelsFunc getChannelsFuncType,
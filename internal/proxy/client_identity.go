@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type clientCNKey struct{}
+
+// ClientIdentityInterceptor puts the common name of the verified client certificate into the request context,
+// the certificates are only verified if the server requires them, i.e. tlsMode is 2.
+func ClientIdentityInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if cn, ok := verifiedClientCN(ctx); ok {
+		ctx = context.WithValue(ctx, clientCNKey{}, cn)
+	}
+	return handler(ctx, req)
+}
+
+// verifiedClientCN returns the common name of the client certificate verified by the TLS handshake of the peer.
+func verifiedClientCN(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+// GetClientCNFromContext returns the common name of the verified client certificate put by ClientIdentityInterceptor.
+func GetClientCNFromContext(ctx context.Context) (string, bool) {
+	cn, ok := ctx.Value(clientCNKey{}).(string)
+	return cn, ok
+}
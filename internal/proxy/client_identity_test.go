@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIdentityInterceptor(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 12345}
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "client.example.com"}}
+	intercept := func(ctx context.Context) (string, bool) {
+		var cn string
+		var ok bool
+		_, err := ClientIdentityInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			cn, ok = GetClientCNFromContext(ctx)
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		return cn, ok
+	}
+
+	t.Run("verified client cert", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr:     addr,
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+		})
+		cn, ok := intercept(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "client.example.com", cn)
+	})
+
+	t.Run("unverified client cert", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr:     addr,
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+		})
+		_, ok := intercept(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("without tls", func(t *testing.T) {
+		_, ok := intercept(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}))
+		assert.False(t, ok)
+
+		_, ok = intercept(context.Background())
+		assert.False(t, ok)
+	})
+}
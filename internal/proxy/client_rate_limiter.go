@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// clientLimiterIdleTimeout is how long the limiter of a client is kept after its last request.
+const clientLimiterIdleTimeout = 10 * time.Minute
+
+type clientLimiter struct {
+	limiter  *ratelimitutil.Limiter
+	lastSeen time.Time
+}
+
+// clientRateLimiter limits the request rate of every client separately,
+// the limiters of the idle clients are dropped periodically.
+type clientRateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	limiters  map[string]*clientLimiter
+	lastClean time.Time
+}
+
+// newClientRateLimiter returns a clientRateLimiter which allows rate requests per second of each client.
+func newClientRateLimiter(rate float64) *clientRateLimiter {
+	return &clientRateLimiter{
+		rate:      rate,
+		limiters:  make(map[string]*clientLimiter),
+		lastClean: time.Now(),
+	}
+}
+
+// allow reports whether a request of the client can happen now, a nil clientRateLimiter allows all requests.
+func (l *clientRateLimiter) allow(client string) bool {
+	if l == nil {
+		return true
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastClean) > clientLimiterIdleTimeout {
+		for c, cl := range l.limiters {
+			if now.Sub(cl.lastSeen) > clientLimiterIdleTimeout {
+				delete(l.limiters, c)
+			}
+		}
+		l.lastClean = now
+	}
+
+	cl, ok := l.limiters[client]
+	if !ok {
+		burst := int(l.rate)
+		if burst < 1 {
+			burst = 1
+		}
+		cl = &clientLimiter{limiter: ratelimitutil.NewLimiter(ratelimitutil.Limit(l.rate), burst)}
+		l.limiters[client] = cl
+	}
+	cl.lastSeen = now
+	return cl.limiter.AllowN(now, 1)
+}
+
+// getClientIdentifier returns the user name carried by the request if there is one,
+// otherwise the host of the client address.
+func getClientIdentifier(ctx context.Context) string {
+	if username, err := GetCurUserFromContext(ctx); err == nil {
+		return username
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestClientRateLimiter(t *testing.T) {
+	t.Run("nil limiter", func(t *testing.T) {
+		var l *clientRateLimiter
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.allow("a"))
+		}
+	})
+
+	t.Run("limit each client", func(t *testing.T) {
+		l := newClientRateLimiter(1)
+		rejected := false
+		for i := 0; i < 3; i++ {
+			if !l.allow("a") {
+				rejected = true
+			}
+		}
+		assert.True(t, rejected)
+		// the other clients are not affected
+		assert.True(t, l.allow("b"))
+	})
+
+	t.Run("drop idle clients", func(t *testing.T) {
+		l := newClientRateLimiter(1)
+		assert.True(t, l.allow("a"))
+		l.limiters["a"].lastSeen = time.Now().Add(-2 * clientLimiterIdleTimeout)
+		l.lastClean = time.Now().Add(-2 * clientLimiterIdleTimeout)
+		assert.True(t, l.allow("b"))
+		assert.NotContains(t, l.limiters, "a")
+		assert.Contains(t, l.limiters, "b")
+	})
+}
+
+func TestGetClientIdentifier(t *testing.T) {
+	assert.Equal(t, "", getClientIdentifier(context.Background()))
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 19530}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	assert.Equal(t, "10.0.0.1", getClientIdentifier(ctx))
+
+	ctx = GetContext(ctx, fmt.Sprintf("%s%s%s", "alice", util.CredentialSeperator, "password"))
+	assert.Equal(t, "alice", getClientIdentifier(ctx))
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// globalClockSkew tracks the skew between the local clock of proxy and the TSO of rootcoord.
+var globalClockSkew = newClockSkewDetector()
+
+// clockSkewDetector measures the skew between the local clock and the physical time of the timestamps allocated
+// from the TSO. A large skew breaks the timestamps composed by the local clock, e.g. the timeout timestamps of
+// the requests, and confuses the users with the guarantee timestamps failures.
+type clockSkewDetector struct {
+	mu   sync.RWMutex
+	skew time.Duration
+	// skewedSince is when the skew exceeded the unhealthy threshold, zero if the skew is below it
+	skewedSince time.Time
+}
+
+func newClockSkewDetector() *clockSkewDetector {
+	return &clockSkewDetector{}
+}
+
+// observe records the skew of the timestamp allocated from the TSO, the allocation happened between the request
+// was sent and the response was received, so the local time of the allocation is taken as the middle of them.
+// The skew is positive if the local clock is ahead of the TSO.
+func (d *clockSkewDetector) observe(ts Timestamp, sent, received time.Time) {
+	physical, _ := tsoutil.ParseTS(ts)
+	skew := sent.Add(received.Sub(sent) / 2).Sub(physical)
+	metrics.ProxyTSOClockSkew.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Set(float64(skew.Milliseconds()))
+	if absDuration(skew) > Params.ProxyCfg.ClockSkewWarnThreshold {
+		log.RatedWarn(10, "clock skew between proxy and TSO exceeds the threshold, check the clock synchronization",
+			zap.Duration("skew", skew),
+			zap.Duration("threshold", Params.ProxyCfg.ClockSkewWarnThreshold),
+			zap.Time("localTime", received),
+			zap.Time("tsoTime", physical))
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.skew = skew
+	if absDuration(skew) <= Params.ProxyCfg.ClockSkewUnhealthyThreshold {
+		d.skewedSince = time.Time{}
+	} else if d.skewedSince.IsZero() {
+		d.skewedSince = received
+	}
+}
+
+// getSkew returns the last observed skew.
+func (d *clockSkewDetector) getSkew() time.Duration {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.skew
+}
+
+// unhealthyReason returns why the proxy is unhealthy if the skew has exceeded the unhealthy threshold
+// for the unhealthy duration until now, it returns an empty string otherwise.
+func (d *clockSkewDetector) unhealthyReason(now time.Time) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.skewedSince.IsZero() || now.Sub(d.skewedSince) < Params.ProxyCfg.ClockSkewUnhealthyDuration {
+		return ""
+	}
+	return fmt.Sprintf("clock skew between proxy and TSO is %s since %s, exceeds the threshold %s",
+		d.skew, d.skewedSince.Format(time.RFC3339), Params.ProxyCfg.ClockSkewUnhealthyThreshold)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
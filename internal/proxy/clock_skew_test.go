@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestClockSkewDetector(t *testing.T) {
+	Params.Init()
+	// the timestamps are of milliseconds
+	now := time.Now().Truncate(time.Millisecond)
+
+	t.Run("skew", func(t *testing.T) {
+		d := newClockSkewDetector()
+		// the allocation is taken as happened in the middle of the request
+		d.observe(tsoutil.ComposeTSByTime(now.Add(-3*time.Second), 0), now.Add(-time.Second), now.Add(time.Second))
+		assert.Equal(t, 3*time.Second, d.getSkew())
+
+		d.observe(tsoutil.ComposeTSByTime(now.Add(2*time.Second), 0), now, now)
+		assert.Equal(t, -2*time.Second, d.getSkew())
+	})
+
+	t.Run("persistent skew is unhealthy", func(t *testing.T) {
+		d := newClockSkewDetector()
+		skewed := now.Add(-2 * Params.ProxyCfg.ClockSkewUnhealthyThreshold)
+		d.observe(tsoutil.ComposeTSByTime(skewed, 0), now, now)
+		assert.Empty(t, d.unhealthyReason(now))
+		assert.Empty(t, d.unhealthyReason(now.Add(Params.ProxyCfg.ClockSkewUnhealthyDuration/2)))
+
+		// the skew since is not reset by the later skewed allocations
+		later := now.Add(Params.ProxyCfg.ClockSkewUnhealthyDuration / 2)
+		d.observe(tsoutil.ComposeTSByTime(later.Add(-2*Params.ProxyCfg.ClockSkewUnhealthyThreshold), 0), later, later)
+		reason := d.unhealthyReason(now.Add(Params.ProxyCfg.ClockSkewUnhealthyDuration))
+		assert.Contains(t, reason, "clock skew between proxy and TSO")
+
+		// healthy once the clock is synchronized
+		d.observe(tsoutil.ComposeTSByTime(later, 0), later, later)
+		assert.Empty(t, d.unhealthyReason(now.Add(2*Params.ProxyCfg.ClockSkewUnhealthyDuration)))
+	})
+}
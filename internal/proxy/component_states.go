@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// The keys of the extra info of the proxy component state.
+const (
+	BuildVersionKey = "build_version"
+	GitCommitKey    = "git_commit"
+	BuildTimeKey    = "build_time"
+	StartTimeKey    = "start_time"
+	AddressKey      = "address"
+)
+
+// The roles of the subcomponents in the component states of proxy.
+const (
+	TaskSchedulerRole = "task_scheduler"
+	ChannelsMgrRole   = "channels_mgr"
+	MetaCacheRole     = "meta_cache"
+)
+
+// componentExtraInfo returns the build and deploy information of proxy for the extra info of its component state.
+func (node *Proxy) componentExtraInfo() []*commonpb.KeyValuePair {
+	deploy := metricsinfo.DeployMetrics{}
+	metricsinfo.FillDeployMetricsWithEnv(&deploy)
+	address := Params.ProxyCfg.NetworkAddress
+	if node.session != nil && node.session.Address != "" {
+		address = node.session.Address
+	}
+	startTime := ""
+	if !Params.ProxyCfg.CreatedTime.IsZero() {
+		startTime = Params.ProxyCfg.CreatedTime.Format(time.RFC3339)
+	}
+	return []*commonpb.KeyValuePair{
+		{Key: BuildVersionKey, Value: deploy.BuildVersion},
+		{Key: GitCommitKey, Value: deploy.SystemVersion},
+		{Key: BuildTimeKey, Value: deploy.BuildTime},
+		{Key: StartTimeKey, Value: startTime},
+		{Key: AddressKey, Value: address},
+	}
+}
+
+// subcomponentStates returns the states of the task scheduler, the channels manager and the meta cache, a
+// subcomponent is initializing until it's created.
+func (node *Proxy) subcomponentStates(nodeID UniqueID) []*internalpb.ComponentInfo {
+	return []*internalpb.ComponentInfo{
+		node.taskSchedulerState(nodeID),
+		node.channelsMgrState(nodeID),
+		metaCacheState(nodeID),
+	}
+}
+
+func (node *Proxy) taskSchedulerState(nodeID UniqueID) *internalpb.ComponentInfo {
+	info := &internalpb.ComponentInfo{
+		NodeID:    nodeID,
+		Role:      TaskSchedulerRole,
+		StateCode: internalpb.StateCode_Initializing,
+	}
+	if node.sched == nil {
+		return info
+	}
+
+	info.StateCode = internalpb.StateCode_Healthy
+	var fullQueues []string
+	for _, queue := range []struct {
+		name string
+		*baseTaskQueue
+	}{
+		{"dd", node.sched.ddQueue.baseTaskQueue},
+		{"dm", node.sched.dmQueue.baseTaskQueue},
+		{"dq", node.sched.dqQueue.baseTaskQueue},
+	} {
+		unissued, active := queue.getTaskNum()
+		info.ExtraInfo = append(info.ExtraInfo,
+			&commonpb.KeyValuePair{Key: queue.name + "_unissued_tasks", Value: strconv.Itoa(unissued)},
+			&commonpb.KeyValuePair{Key: queue.name + "_active_tasks", Value: strconv.Itoa(active)})
+		if int64(unissued) >= queue.getMaxTaskNum() {
+			fullQueues = append(fullQueues, queue.name)
+		}
+	}
+	if len(fullQueues) > 0 {
+		// the new tasks are rejected until the queues are drained
+		info.StateCode = internalpb.StateCode_Abnormal
+		info.ExtraInfo = append(info.ExtraInfo, &commonpb.KeyValuePair{
+			Key:   StateReasonKey,
+			Value: fmt.Sprintf("task queues %s are full", strings.Join(fullQueues, ", ")),
+		})
+	}
+	return info
+}
+
+func (node *Proxy) channelsMgrState(nodeID UniqueID) *internalpb.ComponentInfo {
+	info := &internalpb.ComponentInfo{
+		NodeID:    nodeID,
+		Role:      ChannelsMgrRole,
+		StateCode: internalpb.StateCode_Initializing,
+	}
+	if node.chMgr == nil {
+		return info
+	}
+	streams, pchans := node.chMgr.getDmlStreamNum()
+	info.StateCode = internalpb.StateCode_Healthy
+	info.ExtraInfo = []*commonpb.KeyValuePair{
+		{Key: "dml_streams", Value: strconv.Itoa(streams)},
+		{Key: "dml_pchannels", Value: strconv.Itoa(pchans)},
+	}
+	return info
+}
+
+func metaCacheState(nodeID UniqueID) *internalpb.ComponentInfo {
+	info := &internalpb.ComponentInfo{
+		NodeID:    nodeID,
+		Role:      MetaCacheRole,
+		StateCode: internalpb.StateCode_Initializing,
+	}
+	if globalMetaCache == nil {
+		return info
+	}
+	info.StateCode = internalpb.StateCode_Healthy
+	info.ExtraInfo = []*commonpb.KeyValuePair{
+		{Key: "collections", Value: strconv.Itoa(globalMetaCache.GetCollectionNum())},
+	}
+	return info
+}
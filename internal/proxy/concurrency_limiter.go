@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// taskConcurrencyLimiter limits the number of concurrent tasks of each kind, so that a flood of one kind of
+// tasks can't starve the others in the same queue. Tasks are identified by their names case-insensitively,
+// tasks without limit are never blocked. A nil taskConcurrencyLimiter limits nothing.
+type taskConcurrencyLimiter struct {
+	slots map[string]chan struct{}
+}
+
+func newTaskConcurrencyLimiter(limits map[string]int) *taskConcurrencyLimiter {
+	slots := make(map[string]chan struct{}, len(limits))
+	for name, limit := range limits {
+		if limit > 0 {
+			slots[strings.ToLower(name)] = make(chan struct{}, limit)
+		}
+	}
+	return &taskConcurrencyLimiter{
+		slots: slots,
+	}
+}
+
+// acquire takes a slot of the task, it blocks until a slot is released or ctx is done.
+func (l *taskConcurrencyLimiter) acquire(ctx context.Context, name string) error {
+	if l == nil {
+		return nil
+	}
+	slot, ok := l.slots[strings.ToLower(name)]
+	if !ok {
+		return nil
+	}
+	select {
+	case slot <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("too many concurrent %s, limit: %d, err: %w", name, cap(slot), ctx.Err())
+	}
+}
+
+// release gives back the slot taken by acquire.
+func (l *taskConcurrencyLimiter) release(name string) {
+	if l == nil {
+		return
+	}
+	slot, ok := l.slots[strings.ToLower(name)]
+	if !ok {
+		return
+	}
+	select {
+	case <-slot:
+	default:
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// defaultConsistencyLevel returns the consistency level of the requests which don't specify one,
+// it's the level of the collection, or the default level of proxy if the collection is of Customized level.
+func defaultConsistencyLevel(collectionLevel commonpb.ConsistencyLevel) commonpb.ConsistencyLevel {
+	if collectionLevel != commonpb.ConsistencyLevel_Customized {
+		return collectionLevel
+	}
+	return commonpb.ConsistencyLevel(commonpb.ConsistencyLevel_value[Params.ProxyCfg.DefaultConsistencyLevel])
+}
+
+// guaranteeTsOfLevel returns the guarantee timestamp of the consistency level for the request at tMax.
+// Proxy doesn't know the last write of the client session, so Session is as strong as Strong.
+func guaranteeTsOfLevel(level commonpb.ConsistencyLevel, tMax Timestamp) Timestamp {
+	switch level {
+	case commonpb.ConsistencyLevel_Bounded:
+		return parseGuaranteeTs(boundedTS, tMax)
+	case commonpb.ConsistencyLevel_Eventually:
+		return eventuallyTS
+	default:
+		return tMax
+	}
+}
+
+// resolveGuaranteeTs returns the guarantee timestamp of a search or query at tMax. The consistency is decided
+// with the precedence: request > collection > proxy default. The request specifies its consistency by the guarantee
+// timestamp unless it sets use_default_consistency, then the consistency level of the collection is used,
+// and proxy.defaultConsistencyLevel is used if the collection is of Customized level.
+func resolveGuaranteeTs(ctx context.Context, database, collectionName string, guaranteeTs Timestamp, useDefaultConsistency bool, tMax Timestamp) (Timestamp, error) {
+	if !useDefaultConsistency {
+		guaranteeTs = parseGuaranteeTs(guaranteeTs, tMax)
+		if err := validateGuaranteeTs(guaranteeTs, tMax); err != nil {
+			return 0, err
+		}
+		return guaranteeTs, nil
+	}
+	info, err := globalMetaCache.GetCollectionInfo(ctx, database, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	return guaranteeTsOfLevel(defaultConsistencyLevel(info.consistencyLevel), tMax), nil
+}
+
+// validateGuaranteeTs checks the guarantee timestamp is not later than tMax, the timestamp just allocated from the TSO.
+// Such a guarantee timestamp is not allocated by the TSO yet, query nodes can't serve it until the TSO catches up,
+// it's usually composed from a clock ahead of the TSO, so the clock skew of proxy is reported along with it.
+func validateGuaranteeTs(guaranteeTs, tMax Timestamp) error {
+	if guaranteeTs <= tMax {
+		return nil
+	}
+	guarantee, _ := tsoutil.ParseTS(guaranteeTs)
+	current, _ := tsoutil.ParseTS(tMax)
+	return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"guarantee timestamp %d is in the future, %s later than the current timestamp %d of TSO, clock skew between proxy and TSO is %s",
+		guaranteeTs, guarantee.Sub(current), tMax, globalClockSkew.getSkew())
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestGuaranteeTsOfLevel(t *testing.T) {
+	Params.Init()
+	tMax := tsoutil.ComposeTSByTime(time.Now(), 0)
+
+	assert.Equal(t, tMax, guaranteeTsOfLevel(commonpb.ConsistencyLevel_Strong, tMax))
+	assert.Equal(t, tMax, guaranteeTsOfLevel(commonpb.ConsistencyLevel_Session, tMax))
+	assert.Equal(t, parseGuaranteeTs(boundedTS, tMax), guaranteeTsOfLevel(commonpb.ConsistencyLevel_Bounded, tMax))
+	assert.Less(t, guaranteeTsOfLevel(commonpb.ConsistencyLevel_Bounded, tMax), tMax)
+	assert.Equal(t, Timestamp(eventuallyTS), guaranteeTsOfLevel(commonpb.ConsistencyLevel_Eventually, tMax))
+}
+
+func TestResolveGuaranteeTs(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	tMax := tsoutil.ComposeTSByTime(time.Now(), 0)
+
+	cache := newMockCache()
+	cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		switch collectionName {
+		case "bounded":
+			return &collectionInfo{consistencyLevel: commonpb.ConsistencyLevel_Bounded}, nil
+		case "customized":
+			return &collectionInfo{consistencyLevel: commonpb.ConsistencyLevel_Customized}, nil
+		}
+		return nil, errors.New("collection not found")
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	defer func(level string) { Params.ProxyCfg.DefaultConsistencyLevel = level }(Params.ProxyCfg.DefaultConsistencyLevel)
+	Params.ProxyCfg.DefaultConsistencyLevel = "Eventually"
+
+	t.Run("request", func(t *testing.T) {
+		// the guarantee timestamp of the request overrides the levels of the collection and proxy
+		ts, err := resolveGuaranteeTs(ctx, "", "bounded", strongTS, false, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, tMax, ts)
+
+		ts, err = resolveGuaranteeTs(ctx, "", "customized", 100, false, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(100), ts)
+
+		// the collection is not even looked up
+		ts, err = resolveGuaranteeTs(ctx, "", "not_exists", boundedTS, false, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, parseGuaranteeTs(boundedTS, tMax), ts)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		ts, err := resolveGuaranteeTs(ctx, "", "bounded", strongTS, true, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, parseGuaranteeTs(boundedTS, tMax), ts)
+	})
+
+	t.Run("proxy default", func(t *testing.T) {
+		ts, err := resolveGuaranteeTs(ctx, "", "customized", strongTS, true, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(eventuallyTS), ts)
+
+		Params.ProxyCfg.DefaultConsistencyLevel = "Strong"
+		ts, err = resolveGuaranteeTs(ctx, "", "customized", boundedTS, true, tMax)
+		assert.NoError(t, err)
+		assert.Equal(t, tMax, ts)
+	})
+
+	t.Run("collection not exists", func(t *testing.T) {
+		_, err := resolveGuaranteeTs(ctx, "", "not_exists", strongTS, true, tMax)
+		assert.Error(t, err)
+	})
+
+	t.Run("future", func(t *testing.T) {
+		future := tsoutil.AddPhysicalDurationOnTs(tMax, time.Minute)
+		_, err := resolveGuaranteeTs(ctx, "", "bounded", future, false, tMax)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "clock skew between proxy and TSO")
+	})
+}
@@ -0,0 +1,467 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type breakerState int
+
+const (
+	// breakerClosed lets the calls through
+	breakerClosed breakerState = iota
+	// breakerOpen fast fails the calls until the cooldown passes
+	breakerOpen
+	// breakerHalfOpen lets a call through to probe the coord and fast fails the others
+	breakerHalfOpen
+)
+
+// circuitBreaker fast fails the calls of a coord after a number of consecutive calls failed for the coord is
+// unavailable, so the requests don't wait for the timeout of every call. After the cooldown a call is let through
+// to probe the coord, its success closes the breaker and its failure opens the breaker for another cooldown.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	role      string
+	threshold int
+	cooldown  time.Duration
+
+	state breakerState
+	// failures is the number of consecutive failed calls
+	failures int
+	// since is when the breaker is opened, or when the probe is let through if it's half-open
+	since time.Time
+}
+
+// newCircuitBreaker creates a breaker of the coord, it never fast fails if threshold is 0.
+func newCircuitBreaker(role string, threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		role:      role,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// newCoordBreaker creates a breaker of the coord configured by Params.ProxyCfg.CoordBreaker*.
+func newCoordBreaker(role string) *circuitBreaker {
+	return newCircuitBreaker(role, Params.ProxyCfg.CoordBreakerFailureThreshold, Params.ProxyCfg.CoordBreakerCooldown)
+}
+
+// allow returns an error with the CoordUnavailable code if the call should fast fail.
+func (b *circuitBreaker) allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.since) < b.cooldown {
+			return b.unavailable()
+		}
+		log.Info("probe the unavailable coord", zap.String("role", b.role))
+		b.state = breakerHalfOpen
+		b.since = time.Now()
+	case breakerHalfOpen:
+		// let another call through if the probe didn't reach the coord
+		if time.Since(b.since) < b.cooldown {
+			return b.unavailable()
+		}
+		b.since = time.Now()
+	}
+	return nil
+}
+
+func (b *circuitBreaker) unavailable() error {
+	return newErrWithCode(commonpb.ErrorCode_CoordUnavailable,
+		"%s is unavailable after %d consecutive failed calls, retry later", b.role, b.failures)
+}
+
+// record records the result of a call let through.
+func (b *circuitBreaker) record(failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !failed {
+		if b.state != breakerClosed {
+			log.Info("coord is available again, close its breaker", zap.String("role", b.role))
+		}
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state != breakerClosed || b.failures >= b.threshold {
+		if b.state == breakerClosed {
+			log.Warn("coord is unavailable, open its breaker", zap.String("role", b.role),
+				zap.Int("failures", b.failures), zap.Duration("cooldown", b.cooldown))
+		}
+		b.state = breakerOpen
+		b.since = time.Now()
+	}
+}
+
+// coordNotServingReasons are the reasons the coords reply with when they are not serving, e.g. during a failover.
+var coordNotServingReasons = []string{"not healthy", "not ready", "not serving"}
+
+// isCoordNotServingStatus reports whether the coord replied it's not serving the request for now.
+func isCoordNotServingStatus(status *commonpb.Status) bool {
+	if status.GetErrorCode() != commonpb.ErrorCode_UnexpectedError {
+		return false
+	}
+	for _, reason := range coordNotServingReasons {
+		if strings.Contains(status.GetReason(), reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCoordUnavailable reports whether a coord call failed for the coord is unavailable rather than rejecting the
+// request. Only the calls failed to connect to the coord, i.e. of the gRPC code Unavailable, and the replies of
+// the coord not serving count, the other errors are of the coord reached.
+func isCoordUnavailable(status *commonpb.Status, err error) bool {
+	if err == nil {
+		return isCoordNotServingStatus(status)
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if s, ok := grpcStatus.FromError(e); ok {
+			return s.Code() == codes.Unavailable
+		}
+	}
+	return false
+}
+
+// recordCall records the result of the call to the breaker. The calls canceled or timed out are not recorded,
+// a deadline exceeded may be of a slow request or a short deadline of the client rather than the coord down.
+func recordCall(b *circuitBreaker, status *commonpb.Status, err error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	if s, ok := grpcStatus.FromError(err); ok && (s.Code() == codes.Canceled || s.Code() == codes.DeadlineExceeded) {
+		return
+	}
+	b.record(isCoordUnavailable(status, err))
+}
+
+// callWithBreaker calls the coord unless its breaker is open, and records the result.
+func callWithBreaker(b *circuitBreaker, call func() (*commonpb.Status, error)) (*commonpb.Status, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	status, err := call()
+	recordCall(b, status, err)
+	return status, err
+}
+
+// callWithBreakerResp is callWithBreaker for the responses carrying a status.
+func callWithBreakerResp[T interface{ GetStatus() *commonpb.Status }](b *circuitBreaker, call func() (T, error)) (T, error) {
+	if err := b.allow(); err != nil {
+		var empty T
+		return empty, err
+	}
+	resp, err := call()
+	recordCall(b, resp.GetStatus(), err)
+	return resp, err
+}
+
+// rootCoordBreaker fast fails the calls of RootCoord if its breaker is open. AllocID and AllocTimestamp aren't
+// wrapped, every task needs them, so they aren't failed fast by the breaker opened by the failures of other calls.
+type rootCoordBreaker struct {
+	types.RootCoord
+	breaker *circuitBreaker
+}
+
+func (c *rootCoordBreaker) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreateCollection(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DropCollection(ctx, req) })
+}
+
+func (c *rootCoordBreaker) HasCollection(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.BoolResponse, error) { return c.RootCoord.HasCollection(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.DescribeCollectionResponse, error) { return c.RootCoord.DescribeCollection(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ShowCollectionsResponse, error) { return c.RootCoord.ShowCollections(ctx, req) })
+}
+
+func (c *rootCoordBreaker) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.AlterCollection(ctx, req) })
+}
+
+func (c *rootCoordBreaker) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreatePartition(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DropPartition(ctx, req) })
+}
+
+func (c *rootCoordBreaker) HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.BoolResponse, error) { return c.RootCoord.HasPartition(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ShowPartitionsResponse, error) { return c.RootCoord.ShowPartitions(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ShowSegments(ctx context.Context, req *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ShowSegmentsResponse, error) { return c.RootCoord.ShowSegments(ctx, req) })
+}
+
+func (c *rootCoordBreaker) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreateAlias(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DropAlias(ctx, req) })
+}
+
+func (c *rootCoordBreaker) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.AlterAlias(ctx, req) })
+}
+
+func (c *rootCoordBreaker) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreateDatabase(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DropDatabase(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ListDatabasesResponse, error) { return c.RootCoord.ListDatabases(ctx, req) })
+}
+
+func (c *rootCoordBreaker) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ImportResponse, error) { return c.RootCoord.Import(ctx, req) })
+}
+
+func (c *rootCoordBreaker) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.GetImportStateResponse, error) { return c.RootCoord.GetImportState(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ListImportTasksResponse, error) { return c.RootCoord.ListImportTasks(ctx, req) })
+}
+
+func (c *rootCoordBreaker) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreateCredential(ctx, req) })
+}
+
+func (c *rootCoordBreaker) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.UpdateCredential(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DeleteCredential(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ListCredUsersResponse, error) { return c.RootCoord.ListCredUsers(ctx, req) })
+}
+
+func (c *rootCoordBreaker) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*rootcoordpb.GetCredentialResponse, error) { return c.RootCoord.GetCredential(ctx, req) })
+}
+
+func (c *rootCoordBreaker) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.CreateRole(ctx, req) })
+}
+
+func (c *rootCoordBreaker) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.DropRole(ctx, req) })
+}
+
+func (c *rootCoordBreaker) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.OperateUserRole(ctx, req) })
+}
+
+func (c *rootCoordBreaker) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.SelectRoleResponse, error) { return c.RootCoord.SelectRole(ctx, req) })
+}
+
+func (c *rootCoordBreaker) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.SelectUserResponse, error) { return c.RootCoord.SelectUser(ctx, req) })
+}
+
+func (c *rootCoordBreaker) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.RootCoord.OperatePrivilege(ctx, req) })
+}
+
+func (c *rootCoordBreaker) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.SelectGrantResponse, error) { return c.RootCoord.SelectGrant(ctx, req) })
+}
+
+func (c *rootCoordBreaker) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*internalpb.ListPolicyResponse, error) { return c.RootCoord.ListPolicy(ctx, in) })
+}
+
+// dataCoordBreaker fast fails the calls of DataCoord if its breaker is open.
+type dataCoordBreaker struct {
+	types.DataCoord
+	breaker *circuitBreaker
+}
+
+func (c *dataCoordBreaker) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*datapb.FlushResponse, error) { return c.DataCoord.Flush(ctx, req) })
+}
+
+func (c *dataCoordBreaker) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*datapb.AssignSegmentIDResponse, error) { return c.DataCoord.AssignSegmentID(ctx, req) })
+}
+
+func (c *dataCoordBreaker) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.GetFlushStateResponse, error) { return c.DataCoord.GetFlushState(ctx, req) })
+}
+
+func (c *dataCoordBreaker) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*datapb.GetCollectionStatisticsResponse, error) {
+		return c.DataCoord.GetCollectionStatistics(ctx, req)
+	})
+}
+
+func (c *dataCoordBreaker) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*datapb.GetPartitionStatisticsResponse, error) {
+		return c.DataCoord.GetPartitionStatistics(ctx, req)
+	})
+}
+
+func (c *dataCoordBreaker) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*datapb.GetSegmentInfoResponse, error) { return c.DataCoord.GetSegmentInfo(ctx, req) })
+}
+
+func (c *dataCoordBreaker) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.ManualCompactionResponse, error) { return c.DataCoord.ManualCompaction(ctx, req) })
+}
+
+func (c *dataCoordBreaker) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.GetCompactionStateResponse, error) { return c.DataCoord.GetCompactionState(ctx, req) })
+}
+
+func (c *dataCoordBreaker) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.GetCompactionPlansResponse, error) {
+		return c.DataCoord.GetCompactionStateWithPlans(ctx, req)
+	})
+}
+
+// queryCoordBreaker fast fails the calls of QueryCoord if its breaker is open.
+type queryCoordBreaker struct {
+	types.QueryCoord
+	breaker *circuitBreaker
+}
+
+func (c *queryCoordBreaker) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*querypb.ShowCollectionsResponse, error) { return c.QueryCoord.ShowCollections(ctx, req) })
+}
+
+func (c *queryCoordBreaker) ShowPartitions(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*querypb.ShowPartitionsResponse, error) { return c.QueryCoord.ShowPartitions(ctx, req) })
+}
+
+func (c *queryCoordBreaker) LoadCollection(ctx context.Context, req *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.QueryCoord.LoadCollection(ctx, req) })
+}
+
+func (c *queryCoordBreaker) ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.QueryCoord.ReleaseCollection(ctx, req) })
+}
+
+func (c *queryCoordBreaker) LoadPartitions(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.QueryCoord.LoadPartitions(ctx, req) })
+}
+
+func (c *queryCoordBreaker) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.QueryCoord.ReleasePartitions(ctx, req) })
+}
+
+func (c *queryCoordBreaker) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.QueryCoord.LoadBalance(ctx, req) })
+}
+
+func (c *queryCoordBreaker) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*querypb.GetShardLeadersResponse, error) { return c.QueryCoord.GetShardLeaders(ctx, req) })
+}
+
+func (c *queryCoordBreaker) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*querypb.GetSegmentInfoResponse, error) { return c.QueryCoord.GetSegmentInfo(ctx, req) })
+}
+
+func (c *queryCoordBreaker) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*milvuspb.GetReplicasResponse, error) { return c.QueryCoord.GetReplicas(ctx, req) })
+}
+
+// indexCoordBreaker fast fails the calls of IndexCoord if its breaker is open.
+type indexCoordBreaker struct {
+	types.IndexCoord
+	breaker *circuitBreaker
+}
+
+func (c *indexCoordBreaker) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.IndexCoord.CreateIndex(ctx, req) })
+}
+
+func (c *indexCoordBreaker) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+	return callWithBreaker(c.breaker, func() (*commonpb.Status, error) { return c.IndexCoord.DropIndex(ctx, req) })
+}
+
+func (c *indexCoordBreaker) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*indexpb.DescribeIndexResponse, error) { return c.IndexCoord.DescribeIndex(ctx, req) })
+}
+
+func (c *indexCoordBreaker) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*indexpb.GetIndexStateResponse, error) { return c.IndexCoord.GetIndexState(ctx, req) })
+}
+
+func (c *indexCoordBreaker) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	return callWithBreakerResp(c.breaker, func() (*indexpb.GetIndexBuildProgressResponse, error) {
+		return c.IndexCoord.GetIndexBuildProgress(ctx, req)
+	})
+}
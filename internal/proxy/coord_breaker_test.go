@@ -0,0 +1,279 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	const cooldown = 50 * time.Millisecond
+	failed := func(b *circuitBreaker) {
+		assert.NoError(t, b.allow())
+		b.record(true)
+	}
+
+	t.Run("open after consecutive failures", func(t *testing.T) {
+		b := newCircuitBreaker(typeutil.RootCoordRole, 3, cooldown)
+		failed(b)
+		failed(b)
+		// a success resets the failures
+		assert.NoError(t, b.allow())
+		b.record(false)
+		failed(b)
+		failed(b)
+		assert.NoError(t, b.allow())
+		failed(b)
+
+		err := b.allow()
+		assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "rootcoord is unavailable after 3 consecutive failed calls")
+	})
+
+	t.Run("probe succeeded", func(t *testing.T) {
+		b := newCircuitBreaker(typeutil.RootCoordRole, 1, cooldown)
+		failed(b)
+		assert.Error(t, b.allow())
+
+		time.Sleep(cooldown)
+		// only the probe is let through
+		assert.NoError(t, b.allow())
+		assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(b.allow()))
+		b.record(false)
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, b.allow())
+		}
+	})
+
+	t.Run("probe failed", func(t *testing.T) {
+		b := newCircuitBreaker(typeutil.RootCoordRole, 1, cooldown)
+		failed(b)
+		time.Sleep(cooldown)
+		assert.NoError(t, b.allow())
+		b.record(true)
+		// open for another cooldown
+		assert.Error(t, b.allow())
+		time.Sleep(cooldown)
+		assert.NoError(t, b.allow())
+	})
+
+	t.Run("probe lost", func(t *testing.T) {
+		b := newCircuitBreaker(typeutil.RootCoordRole, 1, cooldown)
+		failed(b)
+		time.Sleep(cooldown)
+		assert.NoError(t, b.allow())
+		assert.Error(t, b.allow())
+		// the probe never reports, another call is let through after the cooldown
+		time.Sleep(cooldown)
+		assert.NoError(t, b.allow())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		b := newCircuitBreaker(typeutil.RootCoordRole, 0, cooldown)
+		for i := 0; i < 10; i++ {
+			failed(b)
+		}
+		assert.NoError(t, b.allow())
+	})
+}
+
+func TestCallWithBreaker(t *testing.T) {
+	b := newCircuitBreaker(typeutil.DataCoordRole, 2, time.Minute)
+	calls := 0
+	call := func(status *commonpb.Status, err error) func() (*commonpb.Status, error) {
+		return func() (*commonpb.Status, error) {
+			calls++
+			return status, err
+		}
+	}
+	unavailable := grpcStatus.Error(codes.Unavailable, "connection refused")
+	notServing := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "datacoord is not healthy"}
+	rejected := &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"}
+
+	// a rejection resets the failures and a canceled call is not counted
+	_, err := callWithBreaker(b, call(nil, unavailable))
+	assert.Error(t, err)
+	status, err := callWithBreaker(b, call(rejected, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, rejected, status)
+	_, err = callWithBreaker(b, call(nil, unavailable))
+	assert.Error(t, err)
+	_, err = callWithBreaker(b, call(nil, context.Canceled))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.NoError(t, b.allow())
+
+	_, err = callWithBreakerResp(b, func() (*milvuspb.GetFlushStateResponse, error) {
+		calls++
+		return &milvuspb.GetFlushStateResponse{Status: notServing}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, calls)
+
+	// fast fail without calling the coord
+	_, err = callWithBreaker(b, call(rejected, nil))
+	assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(err))
+	resp, err := callWithBreakerResp(b, func() (*milvuspb.GetFlushStateResponse, error) {
+		calls++
+		return &milvuspb.GetFlushStateResponse{}, nil
+	})
+	assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(err))
+	assert.Nil(t, resp)
+	assert.Equal(t, 5, calls)
+}
+
+func TestIsCoordUnavailable(t *testing.T) {
+	assert.True(t, isCoordUnavailable(nil, grpcStatus.Error(codes.Unavailable, "connection refused")))
+	assert.True(t, isCoordUnavailable(nil, fmt.Errorf("err: %w", grpcStatus.Error(codes.Unavailable, "connection refused"))))
+	assert.True(t, isCoordUnavailable(nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, isCoordUnavailable(&commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "rootcoord is not healthy"}, nil))
+
+	// the coord is reached
+	assert.False(t, isCoordUnavailable(nil, grpcStatus.Error(codes.Internal, "mock")))
+	assert.False(t, isCoordUnavailable(nil, errors.New("mock")))
+	assert.False(t, isCoordUnavailable(&commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}, nil))
+	assert.False(t, isCoordUnavailable(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil))
+}
+
+func TestCallWithBreaker_Timeout(t *testing.T) {
+	b := newCircuitBreaker(typeutil.DataCoordRole, 2, time.Minute)
+	call := func(err error) func() (*commonpb.Status, error) {
+		return func() (*commonpb.Status, error) { return nil, err }
+	}
+	// the calls timed out are of a slow request or a short deadline, they never open the breaker
+	for i := 0; i < 5; i++ {
+		_, err := callWithBreaker(b, call(context.DeadlineExceeded))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		_, err = callWithBreaker(b, call(grpcStatus.Error(codes.DeadlineExceeded, "mock")))
+		assert.Error(t, err)
+	}
+	assert.NoError(t, b.allow())
+
+	// nor reset the failures
+	_, err := callWithBreaker(b, call(grpcStatus.Error(codes.Unavailable, "connection refused")))
+	assert.Error(t, err)
+	_, err = callWithBreaker(b, call(context.DeadlineExceeded))
+	assert.Error(t, err)
+	_, err = callWithBreaker(b, call(grpcStatus.Error(codes.Unavailable, "connection refused")))
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(b.allow()))
+}
+
+// allocRootCoord fails all the calls as if it's down, but allocates the ids.
+type allocRootCoord struct {
+	types.RootCoord
+}
+
+func (c *allocRootCoord) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return nil, grpcStatus.Error(codes.Unavailable, "connection refused")
+}
+
+func (c *allocRootCoord) AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
+	return &rootcoordpb.AllocIDResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, ID: 1, Count: req.GetCount()}, nil
+}
+
+func TestRootCoordBreaker_Alloc(t *testing.T) {
+	rc := &rootCoordBreaker{RootCoord: &allocRootCoord{}, breaker: newCircuitBreaker(typeutil.RootCoordRole, 1, time.Minute)}
+	_, err := rc.DropAlias(context.Background(), &milvuspb.DropAliasRequest{})
+	assert.Error(t, err)
+	_, err = rc.DropAlias(context.Background(), &milvuspb.DropAliasRequest{})
+	assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, errorCodeOf(err))
+
+	// the allocations aren't failed fast by the open breaker
+	resp, err := rc.AllocID(context.Background(), &rootcoordpb.AllocIDRequest{Count: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), resp.GetCount())
+}
+
+// unavailableRootCoord fails DropAlias as if it's down until it's recovered.
+type unavailableRootCoord struct {
+	types.RootCoord
+	down  atomic.Value // bool
+	calls int32
+}
+
+func (c *unavailableRootCoord) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	atomic.AddInt32(&c.calls, 1)
+	if c.down.Load().(bool) {
+		return nil, grpcStatus.Error(codes.Unavailable, "connection refused")
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestProxy_CoordBreaker(t *testing.T) {
+	Params.Init()
+	defer func(threshold int, cooldown time.Duration) {
+		Params.ProxyCfg.CoordBreakerFailureThreshold = threshold
+		Params.ProxyCfg.CoordBreakerCooldown = cooldown
+	}(Params.ProxyCfg.CoordBreakerFailureThreshold, Params.ProxyCfg.CoordBreakerCooldown)
+	Params.ProxyCfg.CoordBreakerFailureThreshold = 2
+	Params.ProxyCfg.CoordBreakerCooldown = 100 * time.Millisecond
+	// the ddQueue resolves the collection names with the meta cache
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = nil
+	ctx := context.Background()
+
+	rc := &unavailableRootCoord{}
+	rc.down.Store(true)
+	node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+	node.SetRootCoordClient(rc)
+	require.NoError(t, node.sched.Start())
+	defer node.sched.Close()
+
+	dropAlias := func() *commonpb.Status {
+		status, err := node.DropAlias(ctx, &milvuspb.DropAliasRequest{Alias: "alias"})
+		require.NoError(t, err)
+		return status
+	}
+
+	// the calls wait for the coord until the breaker opens
+	for i := 0; i < 2; i++ {
+		status := dropAlias()
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.Contains(t, status.GetReason(), "connection refused")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&rc.calls))
+
+	status := dropAlias()
+	assert.Equal(t, commonpb.ErrorCode_CoordUnavailable, status.GetErrorCode())
+	assert.Contains(t, status.GetReason(), "rootcoord is unavailable")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&rc.calls))
+
+	// recovered after the cooldown
+	rc.down.Store(false)
+	assert.Eventually(t, func() bool {
+		return dropAlias().GetErrorCode() == commonpb.ErrorCode_Success
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, commonpb.ErrorCode_Success, dropAlias().GetErrorCode())
+	assert.Equal(t, int32(4), atomic.LoadInt32(&rc.calls))
+}
@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// isRetriableCoordError reports whether a coord call failed transiently and may succeed if retried,
+// only the gRPC codes of a coord unreachable or failing over are retriable.
+func isRetriableCoordError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if s, ok := grpcStatus.FromError(e); ok {
+			return s.Code() == codes.Unavailable || s.Code() == codes.Aborted
+		}
+	}
+	return false
+}
+
+// retryCoordCall calls the coord until it returns without a retriable error or the attempts run out,
+// the backoff between the attempts is configured by Params.ProxyCfg.CoordRetry*.
+// It returns the error of the last attempt, the response of the last attempt is kept by call.
+func retryCoordCall(ctx context.Context, method string, call func() error) error {
+	attempts := Params.ProxyCfg.CoordRetryMaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	attempt := 0
+	_ = retry.Do(ctx, func() error {
+		attempt++
+		lastErr = call()
+		if lastErr == nil {
+			return nil
+		}
+		// don't back off after the last attempt
+		if !isRetriableCoordError(lastErr) || uint(attempt) >= attempts {
+			return retry.Unrecoverable(lastErr)
+		}
+		log.Warn("coord call failed, retry later", zap.String("method", method), zap.Int("attempt", attempt), zap.Error(lastErr))
+		return lastErr
+	}, retry.Attempts(attempts), retry.Sleep(Params.ProxyCfg.CoordRetryInitialBackoff), retry.MaxSleepTime(Params.ProxyCfg.CoordRetryMaxBackoff))
+	return lastErr
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestIsRetriableCoordError(t *testing.T) {
+	assert.True(t, isRetriableCoordError(grpcStatus.Error(codes.Unavailable, "connection refused")))
+	assert.True(t, isRetriableCoordError(fmt.Errorf("err: %w", grpcStatus.Error(codes.Aborted, "aborted"))))
+	assert.False(t, isRetriableCoordError(grpcStatus.Error(codes.InvalidArgument, "invalid")))
+	assert.False(t, isRetriableCoordError(errors.New("mock error")))
+	assert.False(t, isRetriableCoordError(fmt.Errorf("err: %w", context.Canceled)))
+	assert.False(t, isRetriableCoordError(fmt.Errorf("err: %w", context.DeadlineExceeded)))
+	assert.False(t, isRetriableCoordError(grpcStatus.Error(codes.DeadlineExceeded, "timeout")))
+}
+
+func TestRetryCoordCall(t *testing.T) {
+	Params.Init()
+	initialBackoff, maxBackoff := Params.ProxyCfg.CoordRetryInitialBackoff, Params.ProxyCfg.CoordRetryMaxBackoff
+	defer func() {
+		Params.ProxyCfg.CoordRetryInitialBackoff, Params.ProxyCfg.CoordRetryMaxBackoff = initialBackoff, maxBackoff
+	}()
+	Params.ProxyCfg.CoordRetryInitialBackoff = time.Millisecond
+	Params.ProxyCfg.CoordRetryMaxBackoff = 2 * time.Millisecond
+	ctx := context.Background()
+
+	t.Run("retriable error then success", func(t *testing.T) {
+		calls := 0
+		err := retryCoordCall(ctx, "mock", func() error {
+			calls++
+			if calls == 1 {
+				return grpcStatus.Error(codes.Unavailable, "connection refused")
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("non-retriable error", func(t *testing.T) {
+		calls := 0
+		err := retryCoordCall(ctx, "mock", func() error {
+			calls++
+			return errors.New("mock error")
+		})
+		assert.EqualError(t, err, "mock error")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		calls := 0
+		err := retryCoordCall(ctx, "mock", func() error {
+			calls++
+			return grpcStatus.Error(codes.Unavailable, "connection refused")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, codes.Unavailable, grpcStatus.Code(err))
+		assert.Equal(t, int(Params.ProxyCfg.CoordRetryMaxAttempts), calls)
+	})
+
+	t.Run("no backoff after the last attempt", func(t *testing.T) {
+		maxAttempts := Params.ProxyCfg.CoordRetryMaxAttempts
+		defer func() { Params.ProxyCfg.CoordRetryMaxAttempts = maxAttempts }()
+		Params.ProxyCfg.CoordRetryMaxAttempts = 1
+		Params.ProxyCfg.CoordRetryInitialBackoff = 10 * time.Second
+		Params.ProxyCfg.CoordRetryMaxBackoff = 10 * time.Second
+		defer func() {
+			Params.ProxyCfg.CoordRetryInitialBackoff = time.Millisecond
+			Params.ProxyCfg.CoordRetryMaxBackoff = 2 * time.Millisecond
+		}()
+
+		start := time.Now()
+		err := retryCoordCall(ctx, "mock", func() error {
+			return grpcStatus.Error(codes.Unavailable, "connection refused")
+		})
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		calls := 0
+		err := retryCoordCall(ctx, "mock", func() error {
+			calls++
+			cancel()
+			return grpcStatus.Error(codes.Unavailable, "connection refused")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+// flakyDataCoord fails the first call of GetFlushState and GetCompactionState as if it's failing over.
+type flakyDataCoord struct {
+	DataCoordMock
+	calls int
+}
+
+func (coord *flakyDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	coord.calls++
+	if coord.calls == 1 {
+		return nil, grpcStatus.Error(codes.Unavailable, "connection refused")
+	}
+	return &milvuspb.GetFlushStateResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, Flushed: true}, nil
+}
+
+func (coord *flakyDataCoord) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	coord.calls++
+	if coord.calls == 1 {
+		return nil, grpcStatus.Error(codes.Aborted, "leader changed")
+	}
+	return &milvuspb.GetCompactionStateResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, State: commonpb.CompactionState_Completed}, nil
+}
+
+// flakyQueryCoord fails the first call of GetReplicas as if it's failing over.
+type flakyQueryCoord struct {
+	QueryCoordMock
+	calls int
+}
+
+func (coord *flakyQueryCoord) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+	coord.calls++
+	if coord.calls == 1 {
+		return nil, grpcStatus.Error(codes.Unavailable, "connection refused")
+	}
+	return &milvuspb.GetReplicasResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Replicas: []*milvuspb.ReplicaInfo{{ReplicaID: 1}},
+	}, nil
+}
+
+func TestProxy_RetryCoordPassThrough(t *testing.T) {
+	Params.Init()
+	initialBackoff, maxBackoff := Params.ProxyCfg.CoordRetryInitialBackoff, Params.ProxyCfg.CoordRetryMaxBackoff
+	defer func() {
+		Params.ProxyCfg.CoordRetryInitialBackoff, Params.ProxyCfg.CoordRetryMaxBackoff = initialBackoff, maxBackoff
+	}()
+	Params.ProxyCfg.CoordRetryInitialBackoff = time.Millisecond
+	Params.ProxyCfg.CoordRetryMaxBackoff = 2 * time.Millisecond
+	ctx := context.Background()
+
+	t.Run("GetFlushState", func(t *testing.T) {
+		dc := &flakyDataCoord{}
+		node := &Proxy{dataCoord: dc}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, resp.GetFlushed())
+		assert.Equal(t, 2, dc.calls)
+	})
+
+	t.Run("GetCompactionState", func(t *testing.T) {
+		dc := &flakyDataCoord{}
+		node := &Proxy{dataCoord: dc}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.GetCompactionState(ctx, &milvuspb.GetCompactionStateRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.CompactionState_Completed, resp.GetState())
+		assert.Equal(t, 2, dc.calls)
+	})
+
+	t.Run("GetReplicas", func(t *testing.T) {
+		qc := &flakyQueryCoord{}
+		node := &Proxy{queryCoord: qc}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetReplicas(), 1)
+		assert.Equal(t, 2, qc.calls)
+	})
+}
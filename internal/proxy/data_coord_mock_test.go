@@ -35,10 +35,14 @@ type DataCoordMock struct {
 
 	state atomic.Value // internal.StateCode
 
-	getMetricsFunc         getMetricsFuncType
-	showConfigurationsFunc showConfigurationsFuncType
-	statisticsChannel      string
-	timeTickChannel        string
+	getMetricsFunc              getMetricsFuncType
+	showConfigurationsFunc      showConfigurationsFuncType
+	getCollectionStatisticsFunc func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)
+	getSegmentInfoFunc          func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+	getPartitionStatisticsFunc  func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)
+	flushFunc                   func(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error)
+	statisticsChannel           string
+	timeTickChannel             string
 }
 
 func (coord *DataCoordMock) updateState(state internalpb.StateCode) {
@@ -111,6 +115,9 @@ func (coord *DataCoordMock) GetTimeTickChannel(ctx context.Context) (*milvuspb.S
 }
 
 func (coord *DataCoordMock) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	if coord.flushFunc != nil {
+		return coord.flushFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
@@ -135,14 +142,28 @@ func (coord *DataCoordMock) GetSegmentInfoChannel(ctx context.Context) (*milvusp
 }
 
 func (coord *DataCoordMock) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
-	panic("implement me")
+	if coord.getCollectionStatisticsFunc != nil {
+		return coord.getCollectionStatisticsFunc(ctx, req)
+	}
+	return &datapb.GetCollectionStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Stats: []*commonpb.KeyValuePair{{Key: "row_count", Value: "0"}},
+	}, nil
 }
 
 func (coord *DataCoordMock) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+	if coord.getPartitionStatisticsFunc != nil {
+		return coord.getPartitionStatisticsFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
 func (coord *DataCoordMock) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	if coord.getSegmentInfoFunc != nil {
+		return coord.getSegmentInfoFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
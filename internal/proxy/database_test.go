@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestProxy_Database(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node := &Proxy{rootCoord: rc}
+		node.stateCode.Store(internalpb.StateCode_Abnormal)
+		status, err := node.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), status)
+		status, err = node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), status)
+		resp, err := node.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+	})
+
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	t.Run("invalid name", func(t *testing.T) {
+		for _, name := range []string{"", "1db", "db-1"} {
+			status, err := node.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: name})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode(), name)
+			status, err = node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: name})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode(), name)
+		}
+	})
+
+	t.Run("drop default database", func(t *testing.T) {
+		status, err := node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: util.DefaultDBName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	})
+
+	t.Run("create, list and drop", func(t *testing.T) {
+		status, err := node.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := node.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.ElementsMatch(t, []string{util.DefaultDBName, "db1"}, resp.GetDbNames())
+
+		status, err = node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, status.GetErrorCode())
+	})
+}
+
+func TestProxy_DatabaseIsolation(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+
+	rc := NewRootCoordMock()
+	qc := NewQueryCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc.Start()
+	defer qc.Stop()
+	require.NoError(t, InitMetaCache(ctx, rc, qc, newShardClientMgr()))
+
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+	status, err := node.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "db1"})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	// the collections of the same name in the two databases have different schemas
+	const collectionName = "coll"
+	createCollection := func(dbName string, dataType schemapb.DataType) {
+		schema := constructCollectionSchemaByDataType(collectionName, map[string]schemapb.DataType{
+			testInt64Field:    schemapb.DataType_Int64,
+			testFloatVecField: schemapb.DataType_FloatVector,
+			testVarCharField:  dataType,
+		}, testInt64Field, false)
+		marshaledSchema, err := proto.Marshal(schema)
+		require.NoError(t, err)
+		task := &createCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				ShardsNum:      2,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		require.NoError(t, task.OnEnqueue())
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+	}
+	createCollection("", schemapb.DataType_VarChar)
+	createCollection("db1", schemapb.DataType_Int32)
+
+	defaultID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	require.NoError(t, err)
+	explicitDefaultID, err := globalMetaCache.GetCollectionID(ctx, util.DefaultDBName, collectionName)
+	require.NoError(t, err)
+	assert.Equal(t, defaultID, explicitDefaultID)
+	db1ID, err := globalMetaCache.GetCollectionID(ctx, "db1", collectionName)
+	require.NoError(t, err)
+	assert.NotEqual(t, defaultID, db1ID)
+
+	fieldType := func(dbName string) schemapb.DataType {
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+		require.NoError(t, err)
+		for _, field := range schema.GetFields() {
+			if field.GetName() == testVarCharField {
+				return field.GetDataType()
+			}
+		}
+		return schemapb.DataType_None
+	}
+	assert.Equal(t, schemapb.DataType_VarChar, fieldType(""))
+	assert.Equal(t, schemapb.DataType_Int32, fieldType("db1"))
+
+	// invalidating the collection of a database keeps the one of the same name in the other database
+	assert.Equal(t, 2, globalMetaCache.GetCollectionNum())
+	status, err = node.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{
+		DbName:         "db1",
+		CollectionName: collectionName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, globalMetaCache.GetCollectionNum())
+	id, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultID, id)
+
+	// the database not created is told from the collection not created
+	_, err = globalMetaCache.GetCollectionID(ctx, "db2", collectionName)
+	assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, errorCodeOf(err))
+	_, err = globalMetaCache.GetCollectionID(ctx, "db1", "absent")
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+
+	// the database of collections can't be dropped
+	status, err = node.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: "db1"})
+	require.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sync"
+)
+
+// ddTaskSerializer executes the ddl tasks of the same collection one by one in the order they are scheduled,
+// while the tasks of different collections are executed concurrently. The tasks not operating on any collection
+// are serialized with each other.
+type ddTaskSerializer struct {
+	mu sync.Mutex
+	// tails holds the done channel of the last scheduled task of each key.
+	tails map[string]chan struct{}
+}
+
+func newDdTaskSerializer() *ddTaskSerializer {
+	return &ddTaskSerializer{
+		tails: make(map[string]chan struct{}),
+	}
+}
+
+// schedule appends the task to the queues of the keys, it returns the done channels of the previous tasks of
+// the keys, which should be waited before executing the task, and the function to call once the task is done.
+func (s *ddTaskSerializer) schedule(keys []string) ([]chan struct{}, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	done := make(chan struct{})
+	prev := make([]chan struct{}, 0, len(keys))
+	for _, key := range keys {
+		if tail, ok := s.tails[key]; ok && tail != done {
+			prev = append(prev, tail)
+		}
+		s.tails[key] = done
+	}
+	return prev, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		close(done)
+		for _, key := range keys {
+			if s.tails[key] == done {
+				delete(s.tails, key)
+			}
+		}
+	}
+}
+
+// ddTaskKeys returns the keys serializing the ddl task, i.e. the names of the collections it operates on, with
+// the aliases resolved by the meta cache. It's called on enqueue, so only the cached aliases are resolved without
+// accessing rootCoord, the names which aren't cached, e.g. the collection to create, are used as they are.
+func ddTaskKeys(t task) []string {
+	var names []string
+	if req, ok := t.(interface{ GetCollectionName() string }); ok && req.GetCollectionName() != "" {
+		names = append(names, req.GetCollectionName())
+	}
+	if req, ok := t.(interface{ GetCollectionNames() []string }); ok {
+		names = append(names, req.GetCollectionNames()...)
+	}
+	if req, ok := t.(interface{ GetAlias() string }); ok && req.GetAlias() != "" {
+		names = append(names, req.GetAlias())
+	}
+	if len(names) == 0 {
+		return []string{""}
+	}
+
+	var database string
+	if req, ok := t.(interface{ GetDbName() string }); ok {
+		database = req.GetDbName()
+	}
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key := name
+		if globalMetaCache != nil {
+			if collectionName, ok := globalMetaCache.GetCachedCollectionName(database, name); ok {
+				key = collectionName
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// produceLatencyWindow is how long the latency of the last produce to a channel is taken into account, so that
+// a channel is not considered slow forever if no more messages are produced to it after the inserts are rejected.
+const produceLatencyWindow = 10 * time.Second
+
+// channelProduceStats is the produce stats of a physical channel.
+type channelProduceStats struct {
+	outstandingBytes int64
+	inflight         map[int64]time.Time // produce id -> start time
+	lastLatency      time.Duration
+	lastDone         time.Time
+}
+
+// produceMonitor tracks the produce latency and outstanding bytes of the dml channels, which are shared by the
+// streams of the collections.
+type produceMonitor struct {
+	mu       sync.Mutex
+	channels map[pChan]*channelProduceStats
+	nextID   int64
+}
+
+func newProduceMonitor() *produceMonitor {
+	return &produceMonitor{
+		channels: make(map[pChan]*channelProduceStats),
+	}
+}
+
+func (m *produceMonitor) getStatsPrivate(channel pChan) *channelProduceStats {
+	stats, ok := m.channels[channel]
+	if !ok {
+		stats = &channelProduceStats{inflight: make(map[int64]time.Time)}
+		m.channels[channel] = stats
+	}
+	return stats
+}
+
+// begin records a produce of the bytes to each channel starts, the returned id is passed to end.
+func (m *produceMonitor) begin(channelBytes map[pChan]int64) int64 {
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	for channel, bytes := range channelBytes {
+		stats := m.getStatsPrivate(channel)
+		stats.outstandingBytes += bytes
+		stats.inflight[m.nextID] = now
+		metrics.ProxyDmlChannelOutstandingBytes.WithLabelValues(nodeID, channel).Set(float64(stats.outstandingBytes))
+	}
+	return m.nextID
+}
+
+// end records the produce started by begin is done.
+func (m *produceMonitor) end(id int64, channelBytes map[pChan]int64) {
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for channel, bytes := range channelBytes {
+		stats := m.getStatsPrivate(channel)
+		latency := now.Sub(stats.inflight[id])
+		delete(stats.inflight, id)
+		stats.outstandingBytes -= bytes
+		stats.lastLatency = latency
+		stats.lastDone = now
+		metrics.ProxyDmlChannelOutstandingBytes.WithLabelValues(nodeID, channel).Set(float64(stats.outstandingBytes))
+		metrics.ProxyDmlChannelProduceLatency.WithLabelValues(nodeID, channel).Observe(float64(latency.Milliseconds()))
+	}
+}
+
+// latency returns the produce latency observed on the channel, which is the longer of the latency of the last
+// produce done recently and how long the oldest produce in flight has taken.
+func (m *produceMonitor) latency(channel pChan) time.Duration {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats, ok := m.channels[channel]
+	if !ok {
+		return 0
+	}
+	var latency time.Duration
+	if now.Sub(stats.lastDone) < produceLatencyWindow {
+		latency = stats.lastLatency
+	}
+	for _, start := range stats.inflight {
+		if elapsed := now.Sub(start); elapsed > latency {
+			latency = elapsed
+		}
+	}
+	return latency
+}
+
+// checkBackpressure returns a RateLimit error if the produce latency of any of the channels exceeds the threshold.
+func (m *produceMonitor) checkBackpressure(channels []pChan, threshold time.Duration) error {
+	for _, channel := range channels {
+		if latency := m.latency(channel); latency > threshold {
+			return newErrWithCode(commonpb.ErrorCode_RateLimit,
+				"dml channel %s is busy, produce latency %v exceeds the threshold %v, please retry later",
+				channel, latency, threshold)
+		}
+	}
+	return nil
+}
+
+// wrap returns the stream reporting its produces to the monitor.
+func (m *produceMonitor) wrap(stream msgstream.MsgStream) msgstream.MsgStream {
+	return &monitoredStream{MsgStream: stream, monitor: m}
+}
+
+// monitoredStream is a dml stream whose produces are tracked by the produceMonitor.
+type monitoredStream struct {
+	msgstream.MsgStream
+	monitor *produceMonitor
+}
+
+func (s *monitoredStream) Produce(msgPack *msgstream.MsgPack) error {
+	channelBytes := s.channelBytes(msgPack)
+	id := s.monitor.begin(channelBytes)
+	defer s.monitor.end(id, channelBytes)
+	return s.MsgStream.Produce(msgPack)
+}
+
+// channelBytes returns the bytes of the messages produced to each channel, the size of a message hashed to
+// multiple channels is split evenly among its rows.
+func (s *monitoredStream) channelBytes(msgPack *msgstream.MsgPack) map[pChan]int64 {
+	channelBytes := make(map[pChan]int64)
+	if msgPack == nil || len(msgPack.Msgs) == 0 {
+		return channelBytes
+	}
+	channels := s.GetProduceChannels()
+	indexes := s.ComputeProduceChannelIndexes(msgPack.Msgs)
+	for i, msg := range msgPack.Msgs {
+		if i >= len(indexes) || len(indexes[i]) == 0 {
+			continue
+		}
+		size := int64(msgSize(msg)) / int64(len(indexes[i]))
+		for _, index := range indexes[i] {
+			if int(index) < len(channels) {
+				channelBytes[channels[index]] += size
+			}
+		}
+	}
+	return channelBytes
+}
+
+// msgSize returns the size of the dml message.
+func msgSize(msg msgstream.TsMsg) int {
+	switch m := msg.(type) {
+	case *msgstream.InsertMsg:
+		return proto.Size(&m.InsertRequest)
+	case *msgstream.DeleteMsg:
+		return proto.Size(&m.DeleteRequest)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// delayedProduceStream is a fake dml stream whose produce is delayed, as if the message queue is slow.
+type delayedProduceStream struct {
+	mockMsgStream
+	channels []string
+	delay    time.Duration
+	// block blocks the produce until it's closed if not nil
+	block chan struct{}
+}
+
+func (s *delayedProduceStream) AsProducer(channels []string) {
+	s.channels = channels
+}
+
+func (s *delayedProduceStream) GetProduceChannels() []string {
+	return s.channels
+}
+
+func (s *delayedProduceStream) ComputeProduceChannelIndexes(tsMsgs []msgstream.TsMsg) [][]int32 {
+	indexes := make([][]int32, len(tsMsgs))
+	for i, msg := range tsMsgs {
+		for _, hash := range msg.HashKeys() {
+			indexes[i] = append(indexes[i], int32(hash%uint32(len(s.channels))))
+		}
+	}
+	return indexes
+}
+
+func (s *delayedProduceStream) Produce(msgPack *msgstream.MsgPack) error {
+	if s.block != nil {
+		<-s.block
+	}
+	time.Sleep(s.delay)
+	return nil
+}
+
+func newTestInsertMsg(hashValues ...uint32) *msgstream.InsertMsg {
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{HashValues: hashValues},
+		InsertRequest: internalpb.InsertRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			CollectionName: "coll",
+			RowIDs:         make([]int64, len(hashValues)),
+		},
+	}
+}
+
+func TestMonitoredStream_channelBytes(t *testing.T) {
+	stream := &monitoredStream{
+		MsgStream: &delayedProduceStream{channels: []string{"ch0", "ch1"}},
+		monitor:   newProduceMonitor(),
+	}
+	msg1 := newTestInsertMsg(0)
+	msg2 := newTestInsertMsg(0, 1)
+	size1 := int64(proto.Size(&msg1.InsertRequest))
+	size2 := int64(proto.Size(&msg2.InsertRequest))
+
+	channelBytes := stream.channelBytes(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{msg1, msg2}})
+	assert.Equal(t, map[pChan]int64{
+		"ch0": size1 + size2/2,
+		"ch1": size2 / 2,
+	}, channelBytes)
+
+	assert.Empty(t, stream.channelBytes(nil))
+	assert.Empty(t, stream.channelBytes(&msgstream.MsgPack{}))
+}
+
+func TestProduceMonitor(t *testing.T) {
+	pack := &msgstream.MsgPack{Msgs: []msgstream.TsMsg{newTestInsertMsg(0)}}
+
+	t.Run("slow produce", func(t *testing.T) {
+		monitor := newProduceMonitor()
+		stream := monitor.wrap(&delayedProduceStream{channels: []string{"ch0", "ch1"}, delay: 100 * time.Millisecond})
+
+		assert.NoError(t, monitor.checkBackpressure([]pChan{"ch0", "ch1"}, 50*time.Millisecond))
+		assert.NoError(t, stream.Produce(pack))
+		assert.GreaterOrEqual(t, monitor.latency("ch0"), 100*time.Millisecond)
+		// nothing is produced to ch1
+		assert.Equal(t, time.Duration(0), monitor.latency("ch1"))
+		assert.Equal(t, int64(0), monitor.channels["ch0"].outstandingBytes)
+
+		err := monitor.checkBackpressure([]pChan{"ch1", "ch0"}, 50*time.Millisecond)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "dml channel ch0 is busy")
+		assert.NoError(t, monitor.checkBackpressure([]pChan{"ch0"}, time.Second))
+		assert.NoError(t, monitor.checkBackpressure([]pChan{"ch1"}, 50*time.Millisecond))
+
+		// the latency of the last produce expires
+		monitor.channels["ch0"].lastDone = time.Now().Add(-produceLatencyWindow)
+		assert.NoError(t, monitor.checkBackpressure([]pChan{"ch0"}, 50*time.Millisecond))
+	})
+
+	t.Run("produce in flight", func(t *testing.T) {
+		monitor := newProduceMonitor()
+		block := make(chan struct{})
+		stream := monitor.wrap(&delayedProduceStream{channels: []string{"ch0"}, block: block})
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			assert.NoError(t, stream.Produce(pack))
+		}()
+		assert.Eventually(t, func() bool {
+			monitor.mu.Lock()
+			defer monitor.mu.Unlock()
+			stats, ok := monitor.channels["ch0"]
+			return ok && stats.outstandingBytes > 0
+		}, time.Second, time.Millisecond)
+
+		time.Sleep(60 * time.Millisecond)
+		err := monitor.checkBackpressure([]pChan{"ch0"}, 50*time.Millisecond)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+
+		close(block)
+		<-done
+		assert.Equal(t, int64(0), monitor.channels["ch0"].outstandingBytes)
+		assert.Empty(t, monitor.channels["ch0"].inflight)
+	})
+}
+
+func Test_singleTypeChannelsMgr_checkBackpressure(t *testing.T) {
+	factory := newMockMsgStreamFactory()
+	factory.f = func(ctx context.Context) (msgstream.MsgStream, error) {
+		return &delayedProduceStream{delay: 100 * time.Millisecond}, nil
+	}
+	mgr := newSingleTypeChannelsMgr(func(collectionID UniqueID) (channelInfos, error) {
+		return channelInfos{vchans: []string{"ch0_v0"}, pchans: []string{"ch0"}}, nil
+	}, factory, nil, dmlStreamType)
+
+	stream, err := mgr.getOrCreateStream(100)
+	assert.NoError(t, err)
+	assert.NoError(t, mgr.checkBackpressure(100, 50*time.Millisecond))
+
+	err = stream.Produce(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{newTestInsertMsg(0)}})
+	assert.NoError(t, err)
+	err = mgr.checkBackpressure(100, 50*time.Millisecond)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+
+	// the collections sharing the channel are rejected too
+	err = mgr.checkBackpressure(101, 50*time.Millisecond)
+	assert.Error(t, err)
+
+	// the dql streams are not monitored
+	dqlMgr := newSingleTypeChannelsMgr(nil, factory, nil, dqlStreamType)
+	assert.Nil(t, dqlMgr.monitor)
+	assert.NoError(t, dqlMgr.checkBackpressure(100, 50*time.Millisecond))
+}
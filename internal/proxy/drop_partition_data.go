@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// dropPartitionDataBatchSize is the max number of entities deleted by one delete request, it's the max limit of query.
+const dropPartitionDataBatchSize = 16384
+
+// partitionPKQueryFunc returns at most limit primary keys of the partition by a strong consistent query,
+// along with the timestamp the query is guaranteed at.
+type partitionPKQueryFunc func(ctx context.Context, database, collectionName, partitionName string, pkField *schemapb.FieldSchema, limit int64) (*schemapb.IDs, Timestamp, error)
+
+// pkDeleteFunc deletes the primary keys from the partition.
+type pkDeleteFunc func(ctx context.Context, database, collectionName, partitionName string, ids *schemapb.IDs) error
+
+// validateDropPartitionDataRequest guards against wiping a partition by accident.
+func validateDropPartitionDataRequest(request *milvuspb.DropPartitionDataRequest) error {
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s", err.Error())
+	}
+	if err := validatePartitionTag(request.GetPartitionName(), true); err != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s", err.Error())
+	}
+	if !request.GetConfirm() {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"all entities of partition %s will be deleted, set confirm to true to proceed", request.GetPartitionName())
+	}
+	if request.GetPartitionName() == Params.CommonCfg.DefaultPartitionName && !request.GetForce() {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"partition %s is the default partition, set force to true to delete its entities", request.GetPartitionName())
+	}
+	return nil
+}
+
+// matchAllPKsExpr returns the expression matching all the primary keys.
+func matchAllPKsExpr(pkField *schemapb.FieldSchema) string {
+	if pkField.GetDataType() == schemapb.DataType_VarChar {
+		return fmt.Sprintf(`%s >= ""`, pkField.GetName())
+	}
+	return fmt.Sprintf("%s < 0 || %s >= 0", pkField.GetName(), pkField.GetName())
+}
+
+// deletePartitionEntities deletes the entities of the partition batch by batch until a strong consistent query finds none.
+// It returns the number of deleted entities and the timestamp of the last query, the entities inserted before it are all deleted.
+func deletePartitionEntities(ctx context.Context, database, collectionName, partitionName string, pkField *schemapb.FieldSchema,
+	query partitionPKQueryFunc, del pkDeleteFunc) (int64, Timestamp, error) {
+	var deleteCnt int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleteCnt, 0, err
+		}
+		ids, ts, err := query(ctx, database, collectionName, partitionName, pkField, dropPartitionDataBatchSize)
+		if err != nil {
+			return deleteCnt, 0, fmt.Errorf("failed to query the entities of partition %s: %w", partitionName, err)
+		}
+		num := len(ids.GetIntId().GetData()) + len(ids.GetStrId().GetData())
+		if num == 0 {
+			return deleteCnt, ts, nil
+		}
+		if err := del(ctx, database, collectionName, partitionName, ids); err != nil {
+			return deleteCnt, 0, fmt.Errorf("failed to delete the entities of partition %s: %w", partitionName, err)
+		}
+		deleteCnt += int64(num)
+	}
+}
+
+// queryPartitionPrimaryKeys implements partitionPKQueryFunc.
+func (node *Proxy) queryPartitionPrimaryKeys(ctx context.Context, database, collectionName, partitionName string, pkField *schemapb.FieldSchema, limit int64) (*schemapb.IDs, Timestamp, error) {
+	return node.runPrimaryKeyQuery(ctx, &milvuspb.QueryRequest{
+		DbName:             database,
+		CollectionName:     collectionName,
+		Expr:               matchAllPKsExpr(pkField),
+		OutputFields:       []string{pkField.GetName()},
+		PartitionNames:     []string{partitionName},
+		GuaranteeTimestamp: strongTS,
+		QueryParams: []*commonpb.KeyValuePair{
+			{Key: LimitKey, Value: strconv.FormatInt(limit, 10)},
+		},
+	}, pkField)
+}
+
+// deletePrimaryKeys implements pkDeleteFunc.
+func (node *Proxy) deletePrimaryKeys(ctx context.Context, database, collectionName, partitionName string, ids *schemapb.IDs) error {
+	dt := &deleteTask{
+		ctx:         ctx,
+		Condition:   NewTaskCondition(ctx),
+		primaryKeys: ids,
+		BaseDeleteTask: BaseDeleteTask{
+			BaseMsg: msgstream.BaseMsg{},
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Delete,
+				},
+				DbName:         database,
+				CollectionName: collectionName,
+				PartitionName:  partitionName,
+			},
+		},
+		chMgr:    node.chMgr,
+		chTicker: node.chTicker,
+	}
+	if err := node.sched.dmQueue.Enqueue(dt); err != nil {
+		return err
+	}
+	return dt.WaitToFinish()
+}
@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestValidateDropPartitionDataRequest(t *testing.T) {
+	Params.Init()
+
+	cases := []struct {
+		name    string
+		request *milvuspb.DropPartitionDataRequest
+		valid   bool
+	}{
+		{
+			"confirmed",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "coll", PartitionName: "p1", Confirm: true},
+			true,
+		},
+		{
+			"not confirmed",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "coll", PartitionName: "p1", Force: true},
+			false,
+		},
+		{
+			"default partition without force",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "coll", PartitionName: Params.CommonCfg.DefaultPartitionName, Confirm: true},
+			false,
+		},
+		{
+			"default partition with force",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "coll", PartitionName: Params.CommonCfg.DefaultPartitionName, Confirm: true, Force: true},
+			true,
+		},
+		{
+			"empty partition name",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "coll", Confirm: true, Force: true},
+			false,
+		},
+		{
+			"invalid collection name",
+			&milvuspb.DropPartitionDataRequest{CollectionName: "$coll", PartitionName: "p1", Confirm: true},
+			false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateDropPartitionDataRequest(c.request)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+			}
+		})
+	}
+}
+
+func TestMatchAllPKsExpr(t *testing.T) {
+	assert.Equal(t, "pk < 0 || pk >= 0", matchAllPKsExpr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}))
+	assert.Equal(t, `pk >= ""`, matchAllPKsExpr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar}))
+}
+
+func TestDeletePartitionEntities(t *testing.T) {
+	ctx := context.Background()
+	pkField := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+
+	// partition holds the primary keys of the entities, queries return them in batches of limit
+	newPartition := func(num int) (*[]int64, partitionPKQueryFunc, pkDeleteFunc) {
+		pks := make([]int64, 0, num)
+		for i := 0; i < num; i++ {
+			pks = append(pks, int64(i))
+		}
+		ts := Timestamp(0)
+		query := func(ctx context.Context, database, collectionName, partitionName string, pkField *schemapb.FieldSchema, limit int64) (*schemapb.IDs, Timestamp, error) {
+			ts++
+			n := len(pks)
+			if int64(n) > limit {
+				n = int(limit)
+			}
+			return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: append([]int64{}, pks[:n]...)}}}, ts, nil
+		}
+		del := func(ctx context.Context, database, collectionName, partitionName string, ids *schemapb.IDs) error {
+			deleted := make(map[int64]struct{})
+			for _, pk := range ids.GetIntId().GetData() {
+				deleted[pk] = struct{}{}
+			}
+			remaining := pks[:0]
+			for _, pk := range pks {
+				if _, ok := deleted[pk]; !ok {
+					remaining = append(remaining, pk)
+				}
+			}
+			pks = remaining
+			return nil
+		}
+		return &pks, query, del
+	}
+
+	t.Run("delete in batches", func(t *testing.T) {
+		pks, query, del := newPartition(dropPartitionDataBatchSize*2 + 10)
+		deleteCnt, ts, err := deletePartitionEntities(ctx, "", "coll", "p1", pkField, query, del)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(dropPartitionDataBatchSize*2+10), deleteCnt)
+		// three batches deleted, then the last query finds nothing
+		assert.Equal(t, Timestamp(4), ts)
+		assert.Empty(t, *pks)
+	})
+
+	t.Run("empty partition", func(t *testing.T) {
+		_, query, del := newPartition(0)
+		deleteCnt, ts, err := deletePartitionEntities(ctx, "", "coll", "p1", pkField, query, del)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), deleteCnt)
+		assert.Equal(t, Timestamp(1), ts)
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		query := func(ctx context.Context, database, collectionName, partitionName string, pkField *schemapb.FieldSchema, limit int64) (*schemapb.IDs, Timestamp, error) {
+			return nil, 0, errors.New("mock error")
+		}
+		_, _, del := newPartition(10)
+		_, _, err := deletePartitionEntities(ctx, "", "coll", "p1", pkField, query, del)
+		assert.Error(t, err)
+	})
+
+	t.Run("delete failed", func(t *testing.T) {
+		_, query, _ := newPartition(10)
+		del := func(ctx context.Context, database, collectionName, partitionName string, ids *schemapb.IDs) error {
+			return errors.New("mock error")
+		}
+		deleteCnt, _, err := deletePartitionEntities(ctx, "", "coll", "p1", pkField, query, del)
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), deleteCnt)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		_, query, del := newPartition(10)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _, err := deletePartitionEntities(ctx, "", "coll", "p1", pkField, query, del)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestProxy_DropPartitionData(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	cache := newMockCache()
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		}, nil
+	})
+	cache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		return 0, errPartitionNotExists(collectionName, partitionName, []string{Params.CommonCfg.DefaultPartitionName})
+	})
+	prevCache := globalMetaCache
+	defer func() { globalMetaCache = prevCache }()
+	globalMetaCache = cache
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := node.DropPartitionData(ctx, &milvuspb.DropPartitionDataRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+	})
+
+	t.Run("not confirmed", func(t *testing.T) {
+		node := &Proxy{}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.DropPartitionData(ctx, &milvuspb.DropPartitionDataRequest{
+			CollectionName: "coll",
+			PartitionName:  "p1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("default partition without force", func(t *testing.T) {
+		node := &Proxy{}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.DropPartitionData(ctx, &milvuspb.DropPartitionDataRequest{
+			CollectionName: "coll",
+			PartitionName:  Params.CommonCfg.DefaultPartitionName,
+			Confirm:        true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("unknown partition", func(t *testing.T) {
+		node := &Proxy{}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.DropPartitionData(ctx, &milvuspb.DropPartitionDataRequest{
+			CollectionName: "coll",
+			PartitionName:  "p1",
+			Confirm:        true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, resp.GetStatus().GetErrorCode())
+	})
+}
@@ -0,0 +1,417 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const dummyProbeRequestType = "probe"
+
+const (
+	probePKField     = "pk"
+	probeVectorField = "vec"
+
+	probeDefaultDim         = 8
+	probeMaxDim             = 128
+	probeDefaultNumEntities = 10
+	probeMaxNumEntities     = 1000
+)
+
+// probeCheckInterval is the interval to check if the probe collection is flushed or loaded.
+var probeCheckInterval = 100 * time.Millisecond
+
+// probeHandlers are the handlers of Proxy the probe goes through.
+type probeHandlers interface {
+	CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error)
+	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
+	LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+}
+
+// probeStep is the result of a step of the probe.
+type probeStep struct {
+	Name      string `json:"name"`
+	Success   bool   `json:"success"`
+	LatencyMs int64  `json:"latency_ms"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// probeReport is the reply of the probe, the steps after the failed one are skipped except dropping the collection.
+type probeReport struct {
+	Status     string       `json:"status"`
+	Collection string       `json:"collection"`
+	Steps      []*probeStep `json:"steps"`
+}
+
+// run runs the step and records its result.
+func (r *probeReport) run(name string, step func() error) error {
+	start := time.Now()
+	err := step()
+	result := &probeStep{
+		Name:      name,
+		Success:   err == nil,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Reason = err.Error()
+	}
+	r.Steps = append(r.Steps, result)
+	return err
+}
+
+// dummyProber runs the probes one at a time, and at most one per min interval.
+type dummyProber struct {
+	mu          sync.Mutex
+	lastProbe   time.Time
+	minInterval time.Duration
+	timeout     time.Duration
+	handlers    probeHandlers
+}
+
+func newDummyProber(handlers probeHandlers, minInterval time.Duration, timeout time.Duration) *dummyProber {
+	return &dummyProber{
+		minInterval: minInterval,
+		timeout:     timeout,
+		handlers:    handlers,
+	}
+}
+
+// probe creates a temporary collection, inserts a few vectors into it, flushes, indexes and loads it, then searches
+// the vectors and checks every vector finds its own entity, the collection is dropped at last. It returns an error
+// with the RateLimit code if another probe is running or the last one is within the min interval, the failures of
+// the steps are reported by the report.
+func (p *dummyProber) probe(ctx context.Context, req *dummyProbeRequest) (*probeReport, error) {
+	dim, numEntities := req.Dim, req.NumEntities
+	if dim == 0 {
+		dim = probeDefaultDim
+	}
+	if numEntities == 0 {
+		numEntities = probeDefaultNumEntities
+	}
+	if dim < 0 || dim > probeMaxDim {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "dim should be within [1, %d], got %d", probeMaxDim, dim)
+	}
+	if numEntities < 0 || numEntities > probeMaxNumEntities {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"num_entities should be within [1, %d], got %d", probeMaxNumEntities, numEntities)
+	}
+
+	if !p.mu.TryLock() {
+		return nil, newErrWithCode(commonpb.ErrorCode_RateLimit, "another probe is running")
+	}
+	defer p.mu.Unlock()
+	if since := time.Since(p.lastProbe); since < p.minInterval {
+		return nil, newErrWithCode(commonpb.ErrorCode_RateLimit,
+			"too many probes, please retry after %v", p.minInterval-since)
+	}
+	p.lastProbe = time.Now()
+
+	collectionName := fmt.Sprintf("_probe_%d_%d", Params.ProxyCfg.GetNodeID(), time.Now().UnixNano())
+	report := &probeReport{Collection: collectionName}
+	log.Info("start probing", zap.String("collection", collectionName), zap.Int("dim", dim), zap.Int("numEntities", numEntities))
+
+	probeCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"create_collection", func() error { return p.createCollection(probeCtx, collectionName, dim) }},
+		{"insert", func() error { return p.insert(probeCtx, collectionName, dim, numEntities) }},
+		{"flush", func() error { return p.flush(probeCtx, collectionName) }},
+		{"create_index", func() error { return p.createIndex(probeCtx, collectionName) }},
+		{"load", func() error { return p.load(probeCtx, collectionName) }},
+		{"search", func() error { return p.search(probeCtx, collectionName, dim, numEntities) }},
+	}
+	var err error
+	created := false
+	for _, step := range steps {
+		if err = report.run(step.name, step.run); err != nil {
+			break
+		}
+		created = true
+	}
+	if created {
+		// drop the collection even if the probe timed out
+		dropCtx, dropCancel := context.WithTimeout(context.Background(), p.timeout)
+		defer dropCancel()
+		dropErr := report.run("drop_collection", func() error {
+			return statusError(p.handlers.DropCollection(dropCtx, &milvuspb.DropCollectionRequest{CollectionName: collectionName, IfExists: true}))
+		})
+		if err == nil {
+			err = dropErr
+		}
+	}
+
+	if err != nil {
+		report.Status = "fail"
+		log.Warn("probe failed", zap.String("collection", collectionName), zap.Error(err))
+	} else {
+		report.Status = "success"
+		log.Info("probe done", zap.String("collection", collectionName))
+	}
+	return report, nil
+}
+
+func (p *dummyProber) createCollection(ctx context.Context, collectionName string, dim int) error {
+	schema := &schemapb.CollectionSchema{
+		Name: collectionName,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         probePKField,
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       probeVectorField,
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: strconv.Itoa(dim)}},
+			},
+		},
+	}
+	bs, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return statusError(p.handlers.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         bs,
+		ShardsNum:      1,
+	}))
+}
+
+// probeVector returns the vector of the i-th entity, whose nearest entity is itself.
+func probeVector(i int, dim int) []float32 {
+	vector := make([]float32, dim)
+	for j := range vector {
+		vector[j] = float32(i)
+	}
+	return vector
+}
+
+func (p *dummyProber) insert(ctx context.Context, collectionName string, dim int, numEntities int) error {
+	pks := make([]int64, 0, numEntities)
+	vectors := make([]float32, 0, numEntities*dim)
+	for i := 0; i < numEntities; i++ {
+		pks = append(pks, int64(i))
+		vectors = append(vectors, probeVector(i, dim)...)
+	}
+	resp, err := p.handlers.Insert(ctx, &milvuspb.InsertRequest{
+		CollectionName: collectionName,
+		NumRows:        uint32(numEntities),
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: probePKField,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					},
+				},
+			},
+			{
+				Type:      schemapb.DataType_FloatVector,
+				FieldName: probeVectorField,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Dim:  int64(dim),
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+					},
+				},
+			},
+		},
+	})
+	if err := statusError(resp.GetStatus(), err); err != nil {
+		return err
+	}
+	if len(resp.GetErrIndex()) > 0 {
+		return fmt.Errorf("%d of %d entities failed to be inserted", len(resp.GetErrIndex()), numEntities)
+	}
+	return nil
+}
+
+func (p *dummyProber) flush(ctx context.Context, collectionName string) error {
+	resp, err := p.handlers.Flush(ctx, &milvuspb.FlushRequest{CollectionNames: []string{collectionName}})
+	if err := statusError(resp.GetStatus(), err); err != nil {
+		return err
+	}
+	segmentIDs := resp.GetCollSegIDs()[collectionName].GetData()
+	return waitUntil(ctx, func() (bool, error) {
+		resp, err := p.handlers.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: segmentIDs})
+		if err := statusError(resp.GetStatus(), err); err != nil {
+			return false, err
+		}
+		return resp.GetFlushed(), nil
+	})
+}
+
+func (p *dummyProber) createIndex(ctx context.Context, collectionName string) error {
+	return statusError(p.handlers.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+		CollectionName: collectionName,
+		FieldName:      probeVectorField,
+		ExtraParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: indexparamcheck.IndexFaissIDMap},
+			{Key: "metric_type", Value: distance.L2},
+			{Key: "params", Value: "{}"},
+		},
+	}))
+}
+
+func (p *dummyProber) load(ctx context.Context, collectionName string) error {
+	err := statusError(p.handlers.LoadCollection(ctx, &milvuspb.LoadCollectionRequest{CollectionName: collectionName}))
+	if err != nil {
+		return err
+	}
+	return waitUntil(ctx, func() (bool, error) {
+		resp, err := p.handlers.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Type:            milvuspb.ShowType_InMemory,
+			CollectionNames: []string{collectionName},
+		})
+		if err := statusError(resp.GetStatus(), err); err != nil {
+			return false, err
+		}
+		for i, name := range resp.GetCollectionNames() {
+			if name == collectionName && i < len(resp.GetInMemoryPercentages()) {
+				return resp.GetInMemoryPercentages()[i] >= 100, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func (p *dummyProber) search(ctx context.Context, collectionName string, dim int, numEntities int) error {
+	values := make([][]byte, 0, numEntities)
+	for i := 0; i < numEntities; i++ {
+		value := make([]byte, 0, dim*4)
+		for _, f := range probeVector(i, dim) {
+			value = append(value, typeutil.Float32ToBytes(f)...)
+		}
+		values = append(values, value)
+	}
+	placeholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   commonpb.PlaceholderType_FloatVector,
+			Values: values,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := p.handlers.Search(ctx, &milvuspb.SearchRequest{
+		CollectionName:   collectionName,
+		PlaceholderGroup: placeholderGroup,
+		DslType:          commonpb.DslType_BoolExprV1,
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: AnnsFieldKey, Value: probeVectorField},
+			{Key: TopKKey, Value: "1"},
+			{Key: MetricTypeKey, Value: distance.L2},
+			{Key: SearchParamsKey, Value: "{}"},
+		},
+	})
+	if err := statusError(resp.GetStatus(), err); err != nil {
+		return err
+	}
+	ids := resp.GetResults().GetIds().GetIntId().GetData()
+	if len(ids) != numEntities {
+		return fmt.Errorf("%d results are expected, got %d", numEntities, len(ids))
+	}
+	for i, id := range ids {
+		if id != int64(i) {
+			return fmt.Errorf("the nearest entity of vector %d should be %d, got %d", i, i, id)
+		}
+	}
+	return nil
+}
+
+// statusError returns the error of a handler reply.
+func statusError(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return newErrWithCode(status.GetErrorCode(), "%s", status.GetReason())
+	}
+	return nil
+}
+
+// waitUntil calls check every probeCheckInterval until it returns true or an error, or ctx is done.
+func waitUntil(ctx context.Context, check func() (bool, error)) error {
+	ticker := time.NewTicker(probeCheckInterval)
+	defer ticker.Stop()
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// dummyProbe handles the "probe" dummy request, it replies the JSON report of the probe.
+func (node *Proxy) dummyProbe(ctx context.Context, str string) *milvuspb.DummyResponse {
+	failed := func(err error) *milvuspb.DummyResponse {
+		bs, _ := json.Marshal(map[string]string{"status": "fail", "reason": err.Error()})
+		return &milvuspb.DummyResponse{Response: string(bs)}
+	}
+
+	if node.prober == nil {
+		return failed(errors.New("probe is disabled, it's enabled by proxy.dummyProbe.enabled"))
+	}
+	req, err := parseDummyProbeRequest(str)
+	if err != nil {
+		return failed(err)
+	}
+	report, err := node.prober.probe(ctx, req)
+	if err != nil {
+		return failed(err)
+	}
+	bs, err := json.Marshal(report)
+	if err != nil {
+		return failed(err)
+	}
+	return &milvuspb.DummyResponse{Response: string(bs)}
+}
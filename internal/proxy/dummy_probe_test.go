@@ -0,0 +1,364 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// mockProbeHandlers keeps the probe collection in memory and searches it by brute force.
+type mockProbeHandlers struct {
+	mu          sync.Mutex
+	dim         int
+	vectors     [][]float32
+	flushChecks int
+	loadChecks  int
+	dropped     []string
+
+	// failStep makes the handler of the step fail
+	failStep string
+	// blockCh blocks the creation of collection until it's closed if not nil
+	blockCh chan struct{}
+}
+
+func (m *mockProbeHandlers) status(step string) *commonpb.Status {
+	if m.failStep == step {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: step + " failed"}
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func (m *mockProbeHandlers) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	if m.blockCh != nil {
+		<-m.blockCh
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(request.GetSchema(), schema); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dim, err := funcutil.GetAttrByKeyFromRepeatedKV("dim", schema.GetFields()[1].GetTypeParams())
+	if err != nil {
+		return nil, err
+	}
+	m.dim, err = strconv.Atoi(dim)
+	if err != nil {
+		return nil, err
+	}
+	return m.status("create_collection"), nil
+}
+
+func (m *mockProbeHandlers) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dropped = append(m.dropped, request.GetCollectionName())
+	return m.status("drop_collection"), nil
+}
+
+func (m *mockProbeHandlers) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data := request.GetFieldsData()[1].GetVectors().GetFloatVector().GetData()
+	for i := 0; i+m.dim <= len(data); i += m.dim {
+		m.vectors = append(m.vectors, data[i:i+m.dim])
+	}
+	return &milvuspb.MutationResult{Status: m.status("insert")}, nil
+}
+
+func (m *mockProbeHandlers) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
+	return &milvuspb.FlushResponse{
+		Status: m.status("flush"),
+		CollSegIDs: map[string]*schemapb.LongArray{
+			request.GetCollectionNames()[0]: {Data: []int64{1}},
+		},
+	}, nil
+}
+
+func (m *mockProbeHandlers) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.flushChecks++
+	return &milvuspb.GetFlushStateResponse{
+		Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Flushed: m.flushChecks > 1,
+	}, nil
+}
+
+func (m *mockProbeHandlers) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	return m.status("create_index"), nil
+}
+
+func (m *mockProbeHandlers) LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	return m.status("load"), nil
+}
+
+func (m *mockProbeHandlers) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loadChecks++
+	percentage := int64(50)
+	if m.loadChecks > 1 {
+		percentage = 100
+	}
+	return &milvuspb.ShowCollectionsResponse{
+		Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionNames:     request.GetCollectionNames(),
+		InMemoryPercentages: []int64{percentage},
+	}, nil
+}
+
+func (m *mockProbeHandlers) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failStep == "search" {
+		// every vector finds the first entity
+		ids := make([]int64, len(m.vectors))
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Results: &schemapb.SearchResultData{
+				Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			},
+		}, nil
+	}
+
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(request.GetPlaceholderGroup(), placeholderGroup); err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for _, value := range placeholderGroup.GetPlaceholders()[0].GetValues() {
+		nearest, minDistance := int64(-1), float32(math.MaxFloat32)
+		for id, vector := range m.vectors {
+			var distance float32
+			for j, f := range vector {
+				diff := f - typeutil.BytesToFloat32(value[j*4:j*4+4])
+				distance += diff * diff
+			}
+			if distance < minDistance {
+				nearest, minDistance = int64(id), distance
+			}
+		}
+		ids = append(ids, nearest)
+	}
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Results: &schemapb.SearchResultData{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		},
+	}, nil
+}
+
+func stepNames(report *probeReport) []string {
+	names := make([]string, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func TestDummyProber_Probe(t *testing.T) {
+	defer func(interval time.Duration) { probeCheckInterval = interval }(probeCheckInterval)
+	probeCheckInterval = time.Millisecond
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		handlers := &mockProbeHandlers{}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{Dim: 4, NumEntities: 20})
+		require.NoError(t, err)
+		assert.Equal(t, "success", report.Status)
+		assert.Equal(t, []string{"create_collection", "insert", "flush", "create_index", "load", "search", "drop_collection"},
+			stepNames(report))
+		for _, step := range report.Steps {
+			assert.True(t, step.Success)
+			assert.Empty(t, step.Reason)
+		}
+		assert.Equal(t, 4, handlers.dim)
+		assert.Equal(t, 20, len(handlers.vectors))
+		assert.Equal(t, []string{report.Collection}, handlers.dropped)
+	})
+
+	t.Run("default size", func(t *testing.T) {
+		handlers := &mockProbeHandlers{}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "success", report.Status)
+		assert.Equal(t, probeDefaultDim, handlers.dim)
+		assert.Equal(t, probeDefaultNumEntities, len(handlers.vectors))
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		prober := newDummyProber(&mockProbeHandlers{}, 0, time.Minute)
+		_, err := prober.probe(ctx, &dummyProbeRequest{Dim: probeMaxDim + 1})
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		_, err = prober.probe(ctx, &dummyProbeRequest{NumEntities: -1})
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("search mismatch", func(t *testing.T) {
+		handlers := &mockProbeHandlers{failStep: "search"}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "fail", report.Status)
+		search := report.Steps[len(report.Steps)-2]
+		assert.Equal(t, "search", search.Name)
+		assert.False(t, search.Success)
+		assert.Contains(t, search.Reason, "the nearest entity of vector 1 should be 1")
+		// the collection is dropped even if the probe failed
+		assert.Equal(t, []string{report.Collection}, handlers.dropped)
+	})
+
+	t.Run("step failed", func(t *testing.T) {
+		handlers := &mockProbeHandlers{failStep: "flush"}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "fail", report.Status)
+		assert.Equal(t, []string{"create_collection", "insert", "flush", "drop_collection"}, stepNames(report))
+		assert.Equal(t, "flush failed", report.Steps[2].Reason)
+		assert.True(t, report.Steps[3].Success)
+	})
+
+	t.Run("create collection failed", func(t *testing.T) {
+		handlers := &mockProbeHandlers{failStep: "create_collection"}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "fail", report.Status)
+		assert.Equal(t, []string{"create_collection"}, stepNames(report))
+		assert.Empty(t, handlers.dropped)
+	})
+
+	t.Run("drop collection failed", func(t *testing.T) {
+		handlers := &mockProbeHandlers{failStep: "drop_collection"}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "fail", report.Status)
+		assert.False(t, report.Steps[len(report.Steps)-1].Success)
+	})
+
+	t.Run("min interval", func(t *testing.T) {
+		prober := newDummyProber(&mockProbeHandlers{}, time.Hour, time.Minute)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "success", report.Status)
+		_, err = prober.probe(ctx, &dummyProbeRequest{})
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+	})
+
+	t.Run("concurrent probe", func(t *testing.T) {
+		handlers := &mockProbeHandlers{blockCh: make(chan struct{})}
+		prober := newDummyProber(handlers, 0, time.Minute)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			report, err := prober.probe(ctx, &dummyProbeRequest{})
+			assert.NoError(t, err)
+			assert.Equal(t, "success", report.Status)
+		}()
+		assert.Eventually(t, func() bool {
+			_, err := prober.probe(ctx, &dummyProbeRequest{})
+			return errorCodeOf(err) == commonpb.ErrorCode_RateLimit
+		}, time.Second, time.Millisecond)
+		close(handlers.blockCh)
+		<-done
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		handlers := &mockProbeHandlers{}
+		// never flushed
+		handlers.flushChecks = math.MinInt32
+		prober := newDummyProber(handlers, 0, 50*time.Millisecond)
+		report, err := prober.probe(ctx, &dummyProbeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "fail", report.Status)
+		assert.Equal(t, []string{"create_collection", "insert", "flush", "drop_collection"}, stepNames(report))
+		assert.Equal(t, context.DeadlineExceeded.Error(), report.Steps[2].Reason)
+		assert.Equal(t, []string{report.Collection}, handlers.dropped)
+	})
+}
+
+func TestProxy_DummyProbe(t *testing.T) {
+	defer func(interval time.Duration) { probeCheckInterval = interval }(probeCheckInterval)
+	probeCheckInterval = time.Millisecond
+	ctx := context.Background()
+
+	probeRequest := func(m map[string]interface{}) *milvuspb.DummyRequest {
+		m["request_type"] = dummyProbeRequestType
+		bs, err := json.Marshal(m)
+		require.NoError(t, err)
+		return &milvuspb.DummyRequest{RequestType: string(bs)}
+	}
+	replyOf := func(resp *milvuspb.DummyResponse) map[string]interface{} {
+		reply := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &reply))
+		return reply
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		node := &Proxy{}
+		resp, err := node.Dummy(ctx, probeRequest(map[string]interface{}{}))
+		assert.NoError(t, err)
+		reply := replyOf(resp)
+		assert.Equal(t, "fail", reply["status"])
+		assert.Contains(t, reply["reason"], "probe is disabled")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		node := &Proxy{prober: newDummyProber(&mockProbeHandlers{}, time.Hour, time.Minute)}
+		resp, err := node.Dummy(ctx, probeRequest(map[string]interface{}{"dim": 2, "num_entities": 3}))
+		assert.NoError(t, err)
+		report := &probeReport{}
+		require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), report))
+		assert.Equal(t, "success", report.Status)
+		assert.Equal(t, 7, len(report.Steps))
+
+		// rate limited
+		resp, err = node.Dummy(ctx, probeRequest(map[string]interface{}{}))
+		assert.NoError(t, err)
+		reply := replyOf(resp)
+		assert.Equal(t, "fail", reply["status"])
+		assert.Contains(t, reply["reason"], "too many probes")
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		node := &Proxy{prober: newDummyProber(&mockProbeHandlers{}, 0, time.Minute)}
+		resp, err := node.Dummy(ctx, probeRequest(map[string]interface{}{"dim": "not a number"}))
+		assert.NoError(t, err)
+		assert.Equal(t, "fail", replyOf(resp)["status"])
+	})
+}
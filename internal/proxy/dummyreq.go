@@ -49,3 +49,33 @@ func parseDummyQueryRequest(str string) (*dummyQueryRequest, error) {
 	}
 	return dr, nil
 }
+
+type dummyProbeRequest struct {
+	RequestType string `json:"request_type"`
+	Dim         int    `json:"dim"`
+	NumEntities int    `json:"num_entities"`
+}
+
+func parseDummyProbeRequest(str string) (*dummyProbeRequest, error) {
+	dr := &dummyProbeRequest{}
+
+	if err := json.Unmarshal([]byte(str), &dr); err != nil {
+		return nil, err
+	}
+	return dr, nil
+}
+
+type dummySelectResourceRequest struct {
+	RequestType string `json:"request_type"`
+	Username    string `json:"username"`
+	IncludeAll  bool   `json:"include_all"`
+}
+
+func parseDummySelectResourceRequest(str string) (*dummySelectResourceRequest, error) {
+	dr := &dummySelectResourceRequest{}
+
+	if err := json.Unmarshal([]byte(str), &dr); err != nil {
+		return nil, err
+	}
+	return dr, nil
+}
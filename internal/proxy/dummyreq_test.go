@@ -218,3 +218,31 @@ func Test_parseDummyQueryRequest(t *testing.T) {
 // 	assert.Equal(t, len(drr2.PartitionNames), 0)
 // 	assert.Equal(t, drr2.OutputFields, []string{"_id", "age"})
 // }
+
+func Test_parseDummyProbeRequest(t *testing.T) {
+	_, err := parseDummyProbeRequest("not in json format string")
+	assert.NotNil(t, err)
+
+	ret, err := parseDummyProbeRequest(`{"request_type": "probe", "dim": 16, "num_entities": 100}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "probe", ret.RequestType)
+	assert.Equal(t, 16, ret.Dim)
+	assert.Equal(t, 100, ret.NumEntities)
+
+	// use the default size if not specified
+	ret, err = parseDummyProbeRequest(`{"request_type": "probe"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, ret.Dim)
+	assert.Equal(t, 0, ret.NumEntities)
+}
+
+func Test_parseDummySelectResourceRequest(t *testing.T) {
+	_, err := parseDummySelectResourceRequest("not in json format string")
+	assert.NotNil(t, err)
+
+	ret, err := parseDummySelectResourceRequest(`{"request_type": "select_resource", "username": "alice", "include_all": true}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "select_resource", ret.RequestType)
+	assert.Equal(t, "alice", ret.Username)
+	assert.True(t, ret.IncludeAll)
+}
@@ -19,10 +19,12 @@ package proxy
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -86,3 +88,65 @@ func ErrUnauthenticated() error {
 func ErrProxyNotReady() error {
 	return status.Errorf(codes.Unavailable, "internal: Milvus Proxy is not ready yet. please wait")
 }
+
+// errWithCode is an error which carries the error code that should be returned to the client.
+type errWithCode struct {
+	code commonpb.ErrorCode
+	msg  string
+}
+
+func (e *errWithCode) Error() string {
+	return e.msg
+}
+
+// Code returns the error code carried by the error.
+func (e *errWithCode) Code() commonpb.ErrorCode {
+	return e.code
+}
+
+func newErrWithCode(code commonpb.ErrorCode, format string, a ...interface{}) error {
+	return &errWithCode{
+		code: code,
+		msg:  fmt.Sprintf(format, a...),
+	}
+}
+
+// errorCodeOf returns the error code carried by err, UnexpectedError is returned if err doesn't carry any.
+func errorCodeOf(err error) commonpb.ErrorCode {
+	var e *errWithCode
+	if errors.As(err, &e) {
+		return e.Code()
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
+
+func errCollectionNotLoaded(collectionName string, partitionNames []string) error {
+	if len(partitionNames) == 0 {
+		return newErrWithCode(commonpb.ErrorCode_CollectionNotLoaded,
+			"collection %s is not loaded, please call LoadCollection before search or query", collectionName)
+	}
+	return newErrWithCode(commonpb.ErrorCode_CollectionNotLoaded,
+		"partitions %v of collection %s are not loaded, please call LoadCollection or LoadPartitions before search or query",
+		partitionNames, collectionName)
+}
+
+func errCollectionNotExists(collectionName string) error {
+	return newErrWithCode(commonpb.ErrorCode_CollectionNotExists, "collection %s does not exist", collectionName)
+}
+
+// maxReportedPartitionNames is the max number of available partition names listed in the error
+const maxReportedPartitionNames = 5
+
+// errPartitionNotExists lists some of the available partitions, as the partition name is likely a typo.
+func errPartitionNotExists(collectionName, partitionName string, availableNames []string) error {
+	hint := availableNames
+	if len(hint) > maxReportedPartitionNames {
+		hint = hint[:maxReportedPartitionNames]
+	}
+	available := strings.Join(hint, ", ")
+	if len(availableNames) > maxReportedPartitionNames {
+		available += fmt.Sprintf(", ... (%d in total)", len(availableNames))
+	}
+	return newErrWithCode(commonpb.ErrorCode_PartitionNotExists,
+		"partition %s does not exist in collection %s, available partitions: [%s]", partitionName, collectionName, available)
+}
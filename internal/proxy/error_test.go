@@ -17,8 +17,13 @@
 package proxy
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -150,3 +155,31 @@ func Test_errProxyIsUnhealthy(t *testing.T) {
 			zap.Error(errProxyIsUnhealthy(id)))
 	}
 }
+
+func Test_errorCodeOf(t *testing.T) {
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, errorCodeOf(errors.New("mock")))
+
+	err := errCollectionNotLoaded("test", nil)
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(err))
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(fmt.Errorf("wrapped: %w", err)))
+
+	err = errCollectionNotLoaded("test", []string{"p1"})
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "p1")
+}
+
+func Test_errCollectionNotExists(t *testing.T) {
+	err := errCollectionNotExists("coll")
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+	assert.Equal(t, "collection coll does not exist", err.Error())
+}
+
+func Test_errPartitionNotExists(t *testing.T) {
+	err := errPartitionNotExists("coll", "p9", []string{"p1", "p2"})
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Equal(t, "partition p9 does not exist in collection coll, available partitions: [p1, p2]", err.Error())
+
+	err = errPartitionNotExists("coll", "p9", []string{"p0", "p1", "p2", "p3", "p4", "p5", "p6", "p7"})
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Equal(t, "partition p9 does not exist in collection coll, available partitions: [p0, p1, p2, p3, p4, ... (8 in total)]", err.Error())
+}
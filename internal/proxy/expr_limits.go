@@ -0,0 +1,237 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// validateExprComplexity checks the number of elements in the IN lists and the nesting depth of the expression
+// don't exceed the limits. It only scans the expression, the plan parser allocates heavily for huge expressions.
+func validateExprComplexity(expr string) error {
+	termSize, depth := scanExprComplexity(expr)
+	if limit := Params.ProxyCfg.MaxExpressionTermSize; limit > 0 && termSize > limit {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"number of elements %d in the IN list of expression exceeds the limit %d", termSize, limit)
+	}
+	if limit := Params.ProxyCfg.MaxExpressionDepth; limit > 0 && depth > limit {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"nesting depth %d of expression exceeds the limit %d", depth, limit)
+	}
+	return nil
+}
+
+// scanExprComplexity returns the max number of elements in the IN lists and the max nesting depth of
+// the parentheses and brackets of the expression, the string literals are skipped.
+func scanExprComplexity(expr string) (termSize int64, depth int64) {
+	type frame struct {
+		bracket  bool
+		elements int64
+		empty    bool
+	}
+	var frames []frame
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if len(frames) > 0 && !isExprSpace(c) && c != ')' && c != ']' {
+			frames[len(frames)-1].empty = false
+		}
+		switch c {
+		case '"':
+			// skip the string literal, the escaped quotes included
+			for i++; i < len(expr) && expr[i] != '"'; i++ {
+				if expr[i] == '\\' {
+					i++
+				}
+			}
+		case '(', '[':
+			frames = append(frames, frame{bracket: c == '[', elements: 1, empty: true})
+			if int64(len(frames)) > depth {
+				depth = int64(len(frames))
+			}
+		case ',':
+			if len(frames) > 0 && frames[len(frames)-1].bracket {
+				frames[len(frames)-1].elements++
+			}
+		case ')', ']':
+			if len(frames) == 0 {
+				// unbalanced, left to the parser to report
+				continue
+			}
+			top := frames[len(frames)-1]
+			frames = frames[:len(frames)-1]
+			if top.bracket && !top.empty && top.elements > termSize {
+				termSize = top.elements
+			}
+		}
+	}
+	return termSize, depth
+}
+
+var pkInExprPattern = regexp.MustCompile(`(?s)^\s*([A-Za-z_][A-Za-z0-9_]*)\s+in\s+\[(.*)\]\s*$`)
+
+// parsePkInExpr returns the primary keys if the expression is exactly `pk in [...]`. The primary keys are parsed
+// without the plan parser, so the IN list of any size is allowed. It returns false if the expression is of any other form.
+func parsePkInExpr(schema *schemapb.CollectionSchema, expr string) (*schemapb.IDs, bool) {
+	matches := pkInExprPattern.FindStringSubmatch(expr)
+	if matches == nil {
+		return nil, false
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil || pkField.GetName() != matches[1] {
+		return nil, false
+	}
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		elements := strings.Split(matches[2], ",")
+		ids := make([]int64, 0, len(elements))
+		for _, element := range elements {
+			element = strings.TrimSpace(element)
+			// ParseInt accepts the underscores the expression grammar doesn't
+			if strings.Contains(element, "_") {
+				return nil, false
+			}
+			id, err := strconv.ParseInt(element, 0, 64)
+			if err != nil {
+				return nil, false
+			}
+			ids = append(ids, id)
+		}
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}, true
+	case schemapb.DataType_VarChar:
+		ids, ok := parseStringList(matches[2])
+		if !ok {
+			return nil, false
+		}
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids}}}, true
+	}
+	return nil, false
+}
+
+// parseStringList parses the comma separated double quoted string literals.
+func parseStringList(list string) ([]string, bool) {
+	var ret []string
+	i := 0
+	for {
+		for i < len(list) && isExprSpace(list[i]) {
+			i++
+		}
+		if i >= len(list) || list[i] != '"' {
+			return nil, false
+		}
+		end := i + 1
+		for ; end < len(list) && list[end] != '"'; end++ {
+			if list[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(list) {
+			return nil, false
+		}
+		s, err := strconv.Unquote(list[i : end+1])
+		if err != nil {
+			return nil, false
+		}
+		ret = append(ret, s)
+		for i = end + 1; i < len(list) && isExprSpace(list[i]); i++ {
+		}
+		if i == len(list) {
+			return ret, true
+		}
+		if list[i] != ',' {
+			return nil, false
+		}
+		i++
+	}
+}
+
+func isExprSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// pkTermExpr returns the plan expression of `pk in [ids]`.
+func pkTermExpr(pkField *schemapb.FieldSchema, ids *schemapb.IDs) *planpb.Expr {
+	var values []*planpb.GenericValue
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		for _, id := range ids.GetIntId().GetData() {
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: id}})
+		}
+	case schemapb.DataType_VarChar:
+		for _, id := range ids.GetStrId().GetData() {
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: id}})
+		}
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.GetFieldID(),
+					DataType:     pkField.GetDataType(),
+					IsPrimaryKey: pkField.GetIsPrimaryKey(),
+					IsAutoID:     pkField.GetAutoID(),
+				},
+				Values: values,
+			},
+		},
+	}
+}
+
+// createRetrievePlan creates the retrieve plan of the expression. The expression of exactly `pk in [...]` takes
+// the fast path without the plan parser, other expressions are parsed after they're checked against the limits.
+func createRetrievePlan(schema *schemapb.CollectionSchema, expr string) (*planpb.PlanNode, error) {
+	if ids, ok := parsePkInExpr(schema, expr); ok {
+		pkField, _ := typeutil.GetPrimaryFieldSchema(schema)
+		return &planpb.PlanNode{
+			Node: &planpb.PlanNode_Predicates{Predicates: pkTermExpr(pkField, ids)},
+		}, nil
+	}
+	if err := validateExprLength(expr); err != nil {
+		return nil, err
+	}
+	if err := validateExprComplexity(expr); err != nil {
+		return nil, err
+	}
+	return planparserv2.CreateRetrievePlan(schema, expr)
+}
+
+// createSearchPlan creates the search plan the same way as createRetrievePlan.
+func createSearchPlan(schema *schemapb.CollectionSchema, expr string, annsField string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
+	if ids, ok := parsePkInExpr(schema, expr); ok {
+		plan, err := planparserv2.CreateSearchPlan(schema, "", annsField, queryInfo)
+		if err != nil {
+			return nil, err
+		}
+		pkField, _ := typeutil.GetPrimaryFieldSchema(schema)
+		plan.GetVectorAnns().Predicates = pkTermExpr(pkField, ids)
+		return plan, nil
+	}
+	if err := validateExprLength(expr); err != nil {
+		return nil, err
+	}
+	if err := validateExprComplexity(expr); err != nil {
+		return nil, err
+	}
+	return planparserv2.CreateSearchPlan(schema, expr, annsField, queryInfo)
+}
@@ -0,0 +1,217 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func exprLimitsTestSchema(pkType schemapb.DataType) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: pkType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+	}
+}
+
+func intList(n int) string {
+	elements := make([]string, n)
+	for i := range elements {
+		elements[i] = fmt.Sprint(i)
+	}
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+func TestScanExprComplexity(t *testing.T) {
+	cases := []struct {
+		expr     string
+		termSize int64
+		depth    int64
+	}{
+		{"", 0, 0},
+		{"age > 1", 0, 0},
+		{"age in []", 0, 1},
+		{"age in [1]", 1, 1},
+		{"age in [1, 2, 3] and pk in [1, 2]", 3, 1},
+		{"((age > 1) or (age in [1, 2]))", 2, 3},
+		{`pk in ["a,b", "c[", "d\",("]`, 3, 1},
+		{"age > 1)", 0, 0},
+	}
+	for _, c := range cases {
+		termSize, depth := scanExprComplexity(c.expr)
+		assert.Equal(t, c.termSize, termSize, c.expr)
+		assert.Equal(t, c.depth, depth, c.expr)
+	}
+}
+
+func TestValidateExprComplexity(t *testing.T) {
+	defer func(termSize, depth int64) {
+		Params.ProxyCfg.MaxExpressionTermSize = termSize
+		Params.ProxyCfg.MaxExpressionDepth = depth
+	}(Params.ProxyCfg.MaxExpressionTermSize, Params.ProxyCfg.MaxExpressionDepth)
+	Params.ProxyCfg.MaxExpressionTermSize = 3
+	Params.ProxyCfg.MaxExpressionDepth = 2
+
+	assert.NoError(t, validateExprComplexity("age in "+intList(3)))
+	err := validateExprComplexity("age in " + intList(4))
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "limit 3")
+
+	assert.NoError(t, validateExprComplexity("((age > 1))"))
+	err = validateExprComplexity("(((age > 1)))")
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "limit 2")
+
+	// no limit
+	Params.ProxyCfg.MaxExpressionTermSize = 0
+	Params.ProxyCfg.MaxExpressionDepth = 0
+	assert.NoError(t, validateExprComplexity("(((age in "+intList(4)+")))"))
+}
+
+func TestParsePkInExpr(t *testing.T) {
+	int64Schema := exprLimitsTestSchema(schemapb.DataType_Int64)
+	varCharSchema := exprLimitsTestSchema(schemapb.DataType_VarChar)
+
+	ids, ok := parsePkInExpr(int64Schema, " pk in [1, -2,0x10 ] ")
+	require.True(t, ok)
+	assert.Equal(t, []int64{1, -2, 16}, ids.GetIntId().GetData())
+
+	ids, ok = parsePkInExpr(varCharSchema, `pk in ["a", "b,c" , "d\"e"]`)
+	require.True(t, ok)
+	assert.Equal(t, []string{"a", "b,c", `d"e`}, ids.GetStrId().GetData())
+
+	// other forms are left to the parser
+	for _, c := range []struct {
+		schema *schemapb.CollectionSchema
+		expr   string
+	}{
+		{int64Schema, "age in [1, 2]"},
+		{int64Schema, "pk in []"},
+		{int64Schema, "pk in [1, 2] and age > 1"},
+		{int64Schema, "pk not in [1, 2]"},
+		{int64Schema, "pk in [1, 1.5]"},
+		{int64Schema, "pk in [1_000]"},
+		{int64Schema, `pk in ["a"]`},
+		{varCharSchema, "pk in [1]"},
+		{varCharSchema, `pk in ["a" "b"]`},
+		{varCharSchema, `pk in ["a", ]`},
+		{varCharSchema, `pk in ["a"] or pk in ["b"]`},
+	} {
+		_, ok := parsePkInExpr(c.schema, c.expr)
+		assert.False(t, ok, c.expr)
+	}
+}
+
+func TestCreatePlan_ExprLimits(t *testing.T) {
+	defer func(termSize, depth int64) {
+		Params.ProxyCfg.MaxExpressionTermSize = termSize
+		Params.ProxyCfg.MaxExpressionDepth = depth
+	}(Params.ProxyCfg.MaxExpressionTermSize, Params.ProxyCfg.MaxExpressionDepth)
+	Params.ProxyCfg.MaxExpressionTermSize = 10
+	Params.ProxyCfg.MaxExpressionDepth = 10
+	schema := exprLimitsTestSchema(schemapb.DataType_Int64)
+
+	t.Run("retrieve", func(t *testing.T) {
+		plan, err := createRetrievePlan(schema, "age in "+intList(10))
+		require.NoError(t, err)
+		assert.Len(t, plan.GetPredicates().GetTermExpr().GetValues(), 10)
+
+		_, err = createRetrievePlan(schema, "age in "+intList(11))
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// pk in [...] takes the fast path whatever the size of the list, the plan is the same as the parser's
+		plan, err = createRetrievePlan(schema, "pk in "+intList(100))
+		require.NoError(t, err)
+		expected, err := planparserv2.CreateRetrievePlan(schema, "pk in "+intList(100))
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+
+		varCharSchema := exprLimitsTestSchema(schemapb.DataType_VarChar)
+		plan, err = createRetrievePlan(varCharSchema, `pk in ["a", "b"]`)
+		require.NoError(t, err)
+		expected, err = planparserv2.CreateRetrievePlan(varCharSchema, `pk in ["a", "b"]`)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		queryInfo := &planpb.QueryInfo{Topk: 10, MetricType: "L2"}
+		_, err := createSearchPlan(schema, strings.Repeat("(", 11)+"age > 1"+strings.Repeat(")", 11), "vec", queryInfo)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		plan, err := createSearchPlan(schema, "pk in "+intList(100), "vec", queryInfo)
+		require.NoError(t, err)
+		assert.Equal(t, int64(102), plan.GetVectorAnns().GetFieldId())
+		assert.Equal(t, queryInfo, plan.GetVectorAnns().GetQueryInfo())
+		assert.Len(t, plan.GetVectorAnns().GetPredicates().GetTermExpr().GetValues(), 100)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		dt := &deleteTask{deleteExpr: "pk in " + intList(100)}
+		ids, numRow, err := dt.getPrimaryKeys(schema)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), numRow)
+		assert.Len(t, ids.GetIntId().GetData(), 100)
+
+		dt = &deleteTask{deleteExpr: "pk in " + intList(10) + " and pk in " + intList(11)}
+		_, _, err = dt.getPrimaryKeys(schema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+	t.Run("length", func(t *testing.T) {
+		defer func(length int64) { Params.ProxyCfg.MaxExpressionLength = length }(Params.ProxyCfg.MaxExpressionLength)
+		expr := "age in " + intList(5)
+		Params.ProxyCfg.MaxExpressionLength = int64(len(expr))
+		_, err := createRetrievePlan(schema, expr)
+		assert.NoError(t, err)
+
+		Params.ProxyCfg.MaxExpressionLength = int64(len(expr)) - 1
+		_, err = createRetrievePlan(schema, expr)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		_, err = createSearchPlan(schema, expr, "vec", &planpb.QueryInfo{Topk: 10, MetricType: "L2"})
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		_, _, err = (&deleteTask{deleteExpr: "pk in " + intList(5) + " and age > 1"}).getPrimaryKeys(schema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// the pk in [...] fast path is exempted from the length limit
+		pkExpr := "pk in " + intList(100)
+		_, err = createRetrievePlan(schema, pkExpr)
+		assert.NoError(t, err)
+		_, err = createSearchPlan(schema, pkExpr, "vec", &planpb.QueryInfo{Topk: 10, MetricType: "L2"})
+		assert.NoError(t, err)
+		_, numRow, err := (&deleteTask{deleteExpr: pkExpr}).getPrimaryKeys(schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), numRow)
+	})
+}
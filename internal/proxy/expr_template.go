@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// templateOperandPattern matches the `field op` right before a placeholder, such as `id in ` or `age >= `.
+var templateOperandPattern = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\s*(==|!=|>=|<=|>|<|not\s+in|in)\s*$`)
+
+var templatePlaceholderPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// fillExpressionTemplate replaces the placeholders like `{name}` in expr with the literals of the
+// corresponding template values. Strings are quoted and escaped, numbers are encoded directly and
+// arrays are expanded to list literals, so that values never change the structure of the expression.
+func fillExpressionTemplate(schema *schemapb.CollectionSchema, expr string, values map[string]*schemapb.TemplateValue) (string, error) {
+	if len(values) == 0 && !strings.Contains(expr, "{") {
+		return expr, nil
+	}
+
+	var fields map[string]*schemapb.FieldSchema
+	if schema != nil {
+		fields = make(map[string]*schemapb.FieldSchema, len(schema.GetFields()))
+		for _, field := range schema.GetFields() {
+			fields[field.GetName()] = field
+		}
+	}
+
+	var (
+		builder strings.Builder
+		missing []string
+		used    = make(map[string]struct{})
+	)
+
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch c {
+		case '"':
+			// copy the string literal as is, placeholders inside string literals are not replaced.
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				j = len(expr) - 1
+			}
+			builder.WriteString(expr[i : j+1])
+			i = j
+		case '{':
+			end := strings.IndexByte(expr[i:], '}')
+			if end < 0 {
+				return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "unclosed placeholder in expression: %s", expr[i:])
+			}
+			name := strings.TrimSpace(expr[i+1 : i+end])
+			if !templatePlaceholderPattern.MatchString(name) {
+				return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "invalid placeholder name: %q", name)
+			}
+			i += end
+
+			value, ok := values[name]
+			if !ok {
+				missing = append(missing, name)
+				continue
+			}
+			used[name] = struct{}{}
+
+			if err := checkTemplateValue(name, value, builder.String(), fields); err != nil {
+				return "", err
+			}
+			literal, err := templateValueToLiteral(value)
+			if err != nil {
+				return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "invalid value of placeholder %s: %s", name, err)
+			}
+			builder.WriteString(literal)
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	if len(missing) > 0 {
+		return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "no value provided for placeholders: %v", missing)
+	}
+	unused := make([]string, 0)
+	for name := range values {
+		if _, ok := used[name]; !ok {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "template values are not used in expression: %v", unused)
+	}
+
+	return builder.String(), nil
+}
+
+// checkTemplateValue checks if the value of placeholder matches the data type of the field compared with,
+// prefix is the expression before the placeholder.
+func checkTemplateValue(name string, value *schemapb.TemplateValue, prefix string, fields map[string]*schemapb.FieldSchema) error {
+	matches := templateOperandPattern.FindStringSubmatch(prefix)
+	if matches == nil {
+		return nil
+	}
+	field, ok := fields[matches[1]]
+	if !ok {
+		return nil
+	}
+
+	isTerm := strings.HasSuffix(matches[2], "in")
+	if isTerm != (value.GetArrayVal() != nil) {
+		if isTerm {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"placeholder %s is used with operator %s, an array value is required", name, matches[2])
+		}
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"placeholder %s is used with operator %s, a scalar value is required", name, matches[2])
+	}
+
+	elements := []*schemapb.TemplateValue{value}
+	if isTerm {
+		elements = value.GetArrayVal().GetValues()
+	}
+	for _, element := range elements {
+		if !isTemplateValueCompatible(element, field.GetDataType()) {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"type of placeholder %s doesn't match the data type %s of field %s", name, field.GetDataType(), field.GetName())
+		}
+	}
+	return nil
+}
+
+func isTemplateValueCompatible(value *schemapb.TemplateValue, dataType schemapb.DataType) bool {
+	switch value.GetVal().(type) {
+	case *schemapb.TemplateValue_BoolVal:
+		return typeutil.IsBoolType(dataType)
+	case *schemapb.TemplateValue_Int64Val:
+		return typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType)
+	case *schemapb.TemplateValue_FloatVal:
+		return typeutil.IsFloatingType(dataType)
+	case *schemapb.TemplateValue_StringVal:
+		return typeutil.IsStringType(dataType)
+	default:
+		return false
+	}
+}
+
+func templateValueToLiteral(value *schemapb.TemplateValue) (string, error) {
+	switch v := value.GetVal().(type) {
+	case *schemapb.TemplateValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal), nil
+	case *schemapb.TemplateValue_Int64Val:
+		return strconv.FormatInt(v.Int64Val, 10), nil
+	case *schemapb.TemplateValue_FloatVal:
+		if math.IsNaN(v.FloatVal) || math.IsInf(v.FloatVal, 0) {
+			return "", fmt.Errorf("%v is not a valid float value", v.FloatVal)
+		}
+		literal := strconv.FormatFloat(v.FloatVal, 'g', -1, 64)
+		if !strings.ContainsAny(literal, ".e") {
+			literal += ".0"
+		}
+		return literal, nil
+	case *schemapb.TemplateValue_StringVal:
+		return strconv.Quote(v.StringVal), nil
+	case *schemapb.TemplateValue_ArrayVal:
+		literals := make([]string, 0, len(v.ArrayVal.GetValues()))
+		for _, element := range v.ArrayVal.GetValues() {
+			if element.GetArrayVal() != nil {
+				return "", fmt.Errorf("nested array is not supported")
+			}
+			literal, err := templateValueToLiteral(element)
+			if err != nil {
+				return "", err
+			}
+			literals = append(literals, literal)
+		}
+		return "[" + strings.Join(literals, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("value is not set")
+	}
+}
@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newTemplateStringValue(v string) *schemapb.TemplateValue {
+	return &schemapb.TemplateValue{Val: &schemapb.TemplateValue_StringVal{StringVal: v}}
+}
+
+func newTemplateInt64Value(v int64) *schemapb.TemplateValue {
+	return &schemapb.TemplateValue{Val: &schemapb.TemplateValue_Int64Val{Int64Val: v}}
+}
+
+func newTemplateFloatValue(v float64) *schemapb.TemplateValue {
+	return &schemapb.TemplateValue{Val: &schemapb.TemplateValue_FloatVal{FloatVal: v}}
+}
+
+func newTemplateArrayValue(values ...*schemapb.TemplateValue) *schemapb.TemplateValue {
+	return &schemapb.TemplateValue{Val: &schemapb.TemplateValue_ArrayVal{ArrayVal: &schemapb.TemplateArrayValue{Values: values}}}
+}
+
+func newTemplateTestSchema() *schemapb.CollectionSchema {
+	fieldName2Types := map[string]schemapb.DataType{
+		testInt64Field:    schemapb.DataType_Int64,
+		testFloatField:    schemapb.DataType_Float,
+		testBoolField:     schemapb.DataType_Bool,
+		testVarCharField:  schemapb.DataType_VarChar,
+		testFloatVecField: schemapb.DataType_FloatVector,
+	}
+	schema := constructCollectionSchemaByDataType("test_expr_template", fieldName2Types, testInt64Field, false)
+	for i, field := range schema.Fields {
+		field.FieldID = int64(common.StartOfUserFieldID + i)
+	}
+	return schema
+}
+
+func Test_fillExpressionTemplate(t *testing.T) {
+	schema := newTemplateTestSchema()
+
+	t.Run("no template", func(t *testing.T) {
+		expr := testInt64Field + " > 1"
+		ret, err := fillExpressionTemplate(schema, expr, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, expr, ret)
+	})
+
+	t.Run("numbers and bool", func(t *testing.T) {
+		ret, err := fillExpressionTemplate(schema,
+			testInt64Field+" > {min} && "+testFloatField+" < {max} && "+testBoolField+" == {flag}",
+			map[string]*schemapb.TemplateValue{
+				"min":  newTemplateInt64Value(-10),
+				"max":  newTemplateFloatValue(3),
+				"flag": {Val: &schemapb.TemplateValue_BoolVal{BoolVal: true}},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, testInt64Field+" > -10 && "+testFloatField+" < 3.0 && "+testBoolField+" == true", ret)
+	})
+
+	t.Run("in list", func(t *testing.T) {
+		ret, err := fillExpressionTemplate(schema, testInt64Field+" in {ids}",
+			map[string]*schemapb.TemplateValue{
+				"ids": newTemplateArrayValue(newTemplateInt64Value(1), newTemplateInt64Value(2), newTemplateInt64Value(3)),
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, testInt64Field+" in [1, 2, 3]", ret)
+
+		ret, err = fillExpressionTemplate(schema, testInt64Field+" not in {ids}",
+			map[string]*schemapb.TemplateValue{"ids": newTemplateArrayValue()})
+		assert.NoError(t, err)
+		assert.Equal(t, testInt64Field+" not in []", ret)
+	})
+
+	t.Run("placeholder in string literal is kept", func(t *testing.T) {
+		expr := testVarCharField + ` == "{name}\"{name}"`
+		ret, err := fillExpressionTemplate(schema, expr, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, expr, ret)
+	})
+
+	t.Run("special strings", func(t *testing.T) {
+		values := []string{
+			`it's "quoted"`,
+			`" || ` + testInt64Field + ` > 0 || "`,
+			`back\slash\`,
+			`\"`,
+			"line\nbreak\ttab",
+			"unicode 中文 ✓ 🚀",
+			"",
+		}
+		for _, v := range values {
+			ret, err := fillExpressionTemplate(schema, testVarCharField+" in {names} && "+testVarCharField+" != {name}",
+				map[string]*schemapb.TemplateValue{
+					"names": newTemplateArrayValue(newTemplateStringValue(v)),
+					"name":  newTemplateStringValue(v),
+				})
+			require.NoError(t, err, v)
+
+			plan, err := planparserv2.CreateRetrievePlan(schema, ret)
+			require.NoError(t, err, ret)
+
+			binaryExpr := plan.GetPredicates().GetBinaryExpr()
+			require.NotNil(t, binaryExpr, ret)
+			termValues := binaryExpr.GetLeft().GetTermExpr().GetValues()
+			require.Equal(t, 1, len(termValues))
+			assert.Equal(t, v, termValues[0].GetStringVal())
+			assert.Equal(t, v, binaryExpr.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+		}
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		_, err := fillExpressionTemplate(schema, testInt64Field+" > {min} && "+testInt64Field+" < {max}",
+			map[string]*schemapb.TemplateValue{"min": newTemplateInt64Value(1)})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "max")
+	})
+
+	t.Run("unused value", func(t *testing.T) {
+		_, err := fillExpressionTemplate(schema, testInt64Field+" > {min}",
+			map[string]*schemapb.TemplateValue{"min": newTemplateInt64Value(1), "other": newTemplateInt64Value(2)})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "other")
+	})
+
+	t.Run("invalid placeholder", func(t *testing.T) {
+		_, err := fillExpressionTemplate(schema, testInt64Field+" > {min", nil)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		_, err = fillExpressionTemplate(schema, testInt64Field+" > {1min}", nil)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		cases := []struct {
+			expr  string
+			value *schemapb.TemplateValue
+		}{
+			{testInt64Field + " > {v}", newTemplateStringValue("1")},
+			{testInt64Field + " > {v}", newTemplateFloatValue(1.5)},
+			{testVarCharField + " == {v}", newTemplateInt64Value(1)},
+			{testBoolField + " == {v}", newTemplateInt64Value(1)},
+			{testInt64Field + " in {v}", newTemplateInt64Value(1)},
+			{testInt64Field + " == {v}", newTemplateArrayValue(newTemplateInt64Value(1))},
+			{testInt64Field + " in {v}", newTemplateArrayValue(newTemplateInt64Value(1), newTemplateStringValue("2"))},
+			{testFloatField + " > {v}", newTemplateFloatValue(math.NaN())},
+			{testInt64Field + " in {v}", newTemplateArrayValue(newTemplateArrayValue())},
+			{testInt64Field + " > {v}", &schemapb.TemplateValue{}},
+		}
+		for _, c := range cases {
+			_, err := fillExpressionTemplate(schema, c.expr, map[string]*schemapb.TemplateValue{"v": c.value})
+			assert.Error(t, err, c.expr)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.expr)
+			assert.Contains(t, err.Error(), "v", c.expr)
+		}
+	})
+}
@@ -0,0 +1,321 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// mockFailedTsoAllocator fails all the allocations, so that no task can be enqueued.
+type mockFailedTsoAllocator struct {
+}
+
+func (tso *mockFailedTsoAllocator) AllocOne() (Timestamp, error) {
+	return 0, errors.New("mock")
+}
+
+type functionCallCase struct {
+	method  string
+	counter *prometheus.CounterVec
+	call    func(ctx context.Context, node *Proxy) *commonpb.Status
+}
+
+func functionCallCount(c functionCallCase, label string) float64 {
+	return testutil.ToFloat64(c.counter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), c.method, label))
+}
+
+// ddFunctionCallCases are the handlers that execute their tasks in the ddQueue.
+func ddFunctionCallCases() []functionCallCase {
+	return []functionCallCase{
+		{"CreateCollection", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{CollectionName: "coll"})
+			return status
+		}},
+		{"DropCollection", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.DropCollection(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"})
+			return status
+		}},
+		{"HasCollection", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.HasCollection(ctx, &milvuspb.HasCollectionRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"LoadCollection", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.LoadCollection(ctx, &milvuspb.LoadCollectionRequest{CollectionName: "coll"})
+			return status
+		}},
+		{"ReleaseCollection", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{CollectionName: "coll"})
+			return status
+		}},
+		{"DescribeCollection", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"GetStatistics", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.GetStatistics(ctx, &milvuspb.GetStatisticsRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"GetCollectionStatistics", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.GetCollectionStatistics(ctx, &milvuspb.GetCollectionStatisticsRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"ShowCollections", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{})
+			return resp.GetStatus()
+		}},
+		{"AlterCollection", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{CollectionName: "coll"})
+			return status
+		}},
+		{"CreatePartition", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{CollectionName: "coll", PartitionName: "p1"})
+			return status
+		}},
+		{"DropPartition", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.DropPartition(ctx, &milvuspb.DropPartitionRequest{CollectionName: "coll", PartitionName: "p1"})
+			return status
+		}},
+		{"HasPartition", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.HasPartition(ctx, &milvuspb.HasPartitionRequest{CollectionName: "coll", PartitionName: "p1"})
+			return resp.GetStatus()
+		}},
+		{"LoadPartitions", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.LoadPartitions(ctx, &milvuspb.LoadPartitionsRequest{CollectionName: "coll", PartitionNames: []string{"p1"}})
+			return status
+		}},
+		{"ReleasePartitions", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.ReleasePartitions(ctx, &milvuspb.ReleasePartitionsRequest{CollectionName: "coll", PartitionNames: []string{"p1"}})
+			return status
+		}},
+		{"GetPartitionStatistics", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.GetPartitionStatistics(ctx, &milvuspb.GetPartitionStatisticsRequest{CollectionName: "coll", PartitionName: "p1"})
+			return resp.GetStatus()
+		}},
+		{"ShowPartitions", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"CreateIndex", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.CreateIndex(ctx, &milvuspb.CreateIndexRequest{CollectionName: "coll", FieldName: "vec"})
+			return status
+		}},
+		{"DescribeIndex", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"DropIndex", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.DropIndex(ctx, &milvuspb.DropIndexRequest{CollectionName: "coll", FieldName: "vec"})
+			return status
+		}},
+		{"GetIndexBuildProgress", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.GetIndexBuildProgress(ctx, &milvuspb.GetIndexBuildProgressRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"GetIndexState", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.GetIndexState(ctx, &milvuspb.GetIndexStateRequest{CollectionName: "coll"})
+			return resp.GetStatus()
+		}},
+		{"Flush", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			resp, _ := node.Flush(ctx, &milvuspb.FlushRequest{CollectionNames: []string{"coll"}})
+			return resp.GetStatus()
+		}},
+		{"CreateAlias", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.CreateAlias(ctx, &milvuspb.CreateAliasRequest{CollectionName: "coll", Alias: "alias"})
+			return status
+		}},
+		{"DropAlias", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.DropAlias(ctx, &milvuspb.DropAliasRequest{Alias: "alias"})
+			return status
+		}},
+		{"AlterAlias", metrics.ProxyDDLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+			status, _ := node.AlterAlias(ctx, &milvuspb.AlterAliasRequest{CollectionName: "coll", Alias: "alias"})
+			return status
+		}},
+	}
+}
+
+func newFunctionCallTestProxy(t *testing.T, tso tsoAllocator) *Proxy {
+	sched, err := newTaskScheduler(context.Background(), newMockIDAllocatorInterface(), tso, nil)
+	require.NoError(t, err)
+	node := &Proxy{sched: sched}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+	return node
+}
+
+// setSucceededResult sets the successful result of the ddl task as if it's executed.
+func setSucceededResult(t task) {
+	success := func() *commonpb.Status {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	switch t := t.(type) {
+	case *createCollectionTask:
+		t.result = success()
+	case *dropCollectionTask:
+		t.result = success()
+	case *hasCollectionTask:
+		t.result = &milvuspb.BoolResponse{Status: success()}
+	case *loadCollectionTask:
+		t.result = success()
+	case *releaseCollectionTask:
+		t.result = success()
+	case *describeCollectionTask:
+		t.result = &milvuspb.DescribeCollectionResponse{Status: success()}
+	case *getStatisticsTask:
+		t.result = &milvuspb.GetStatisticsResponse{Status: success()}
+	case *getCollectionStatisticsTask:
+		t.result = &milvuspb.GetCollectionStatisticsResponse{Status: success()}
+	case *showCollectionsTask:
+		t.result = &milvuspb.ShowCollectionsResponse{Status: success()}
+	case *alterCollectionTask:
+		t.result = success()
+	case *createPartitionTask:
+		t.result = success()
+	case *dropPartitionTask:
+		t.result = success()
+	case *hasPartitionTask:
+		t.result = &milvuspb.BoolResponse{Status: success()}
+	case *loadPartitionsTask:
+		t.result = success()
+	case *releasePartitionsTask:
+		t.result = success()
+	case *getPartitionStatisticsTask:
+		t.result = &milvuspb.GetPartitionStatisticsResponse{Status: success()}
+	case *showPartitionsTask:
+		t.result = &milvuspb.ShowPartitionsResponse{Status: success()}
+	case *createIndexTask:
+		t.result = success()
+	case *describeIndexTask:
+		t.result = &milvuspb.DescribeIndexResponse{Status: success()}
+	case *dropIndexTask:
+		t.result = success()
+	case *getIndexBuildProgressTask:
+		t.result = &milvuspb.GetIndexBuildProgressResponse{Status: success()}
+	case *getIndexStateTask:
+		t.result = &milvuspb.GetIndexStateResponse{Status: success()}
+	case *flushTask:
+		t.result = &milvuspb.FlushResponse{Status: success()}
+	case *CreateAliasTask:
+		t.result = success()
+	case *DropAliasTask:
+		t.result = success()
+	case *AlterAliasTask:
+		t.result = success()
+	}
+}
+
+func TestProxy_FunctionCallTotal(t *testing.T) {
+	Params.Init()
+	if rateCol == nil {
+		var err error
+		rateCol, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+		require.NoError(t, err)
+	}
+	// the ddQueue resolves the collection names with the meta cache
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = nil
+	ctx := context.Background()
+
+	t.Run("abandoned", func(t *testing.T) {
+		node := newFunctionCallTestProxy(t, &mockFailedTsoAllocator{})
+		cases := append(ddFunctionCallCases(),
+			functionCallCase{"Insert", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+				resp, _ := node.Insert(ctx, &milvuspb.InsertRequest{CollectionName: "coll", NumRows: 1})
+				return resp.GetStatus()
+			}},
+			functionCallCase{"Delete", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+				resp, _ := node.Delete(ctx, &milvuspb.DeleteRequest{CollectionName: "coll", Expr: "pk in [1]"})
+				return resp.GetStatus()
+			}},
+			functionCallCase{"DropPartitionData", metrics.ProxyDMLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+				// not confirmed
+				resp, _ := node.DropPartitionData(ctx, &milvuspb.DropPartitionDataRequest{CollectionName: "coll", PartitionName: "p1"})
+				return resp.GetStatus()
+			}},
+			functionCallCase{"Search", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+				resp, _ := node.Search(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+				return resp.GetStatus()
+			}},
+			functionCallCase{"Query", metrics.ProxyDQLFunctionCall, func(ctx context.Context, node *Proxy) *commonpb.Status {
+				resp, _ := node.Query(ctx, &milvuspb.QueryRequest{CollectionName: "coll", Expr: "pk in [1]"})
+				return resp.GetStatus()
+			}},
+		)
+		for _, c := range cases {
+			total := functionCallCount(c, metrics.TotalLabel)
+			status := c.call(ctx, node)
+			assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode(), c.method)
+			assert.Equal(t, total+1, functionCallCount(c, metrics.TotalLabel), c.method)
+		}
+	})
+
+	// the tasks are popped from the ddQueue and notified with the result instead of being executed, the succeeded
+	// tasks are given a successful result as the handlers return it
+	finish := func(t *testing.T, node *Proxy, c functionCallCase, taskErr error) *commonpb.Status {
+		done := make(chan *commonpb.Status, 1)
+		go func() {
+			done <- c.call(ctx, node)
+		}()
+		var popped task
+		require.Eventually(t, func() bool {
+			popped = node.sched.ddQueue.PopUnissuedTask()
+			return popped != nil
+		}, time.Second, time.Millisecond, c.method)
+		node.sched.ddQueue.taskDone(popped)
+		if taskErr == nil {
+			setSucceededResult(popped)
+		}
+		popped.Notify(taskErr)
+		return <-done
+	}
+
+	t.Run("failed", func(t *testing.T) {
+		node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+		for _, c := range ddFunctionCallCases() {
+			total, fail := functionCallCount(c, metrics.TotalLabel), functionCallCount(c, metrics.FailLabel)
+			status := finish(t, node, c, errors.New("mock"))
+			assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode(), c.method)
+			assert.Equal(t, total+1, functionCallCount(c, metrics.TotalLabel), c.method)
+			assert.Equal(t, fail+1, functionCallCount(c, metrics.FailLabel), c.method)
+		}
+	})
+
+	t.Run("succeeded", func(t *testing.T) {
+		node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+		for _, c := range ddFunctionCallCases() {
+			total, success := functionCallCount(c, metrics.TotalLabel), functionCallCount(c, metrics.SuccessLabel)
+			status := finish(t, node, c, nil)
+			assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode(), c.method)
+			assert.Equal(t, total+1, functionCallCount(c, metrics.TotalLabel), c.method)
+			assert.Equal(t, success+1, functionCallCount(c, metrics.SuccessLabel), c.method)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestProxy_GetReplicas(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (UniqueID, error) {
+		if collectionName == "coll" {
+			return 1, nil
+		}
+		return 0, errors.New("collection not found")
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	qc := NewQueryCoordMock()
+	qc.updateState(internalpb.StateCode_Healthy)
+	var received *milvuspb.GetReplicasRequest
+	qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		received = req
+		return &milvuspb.GetReplicasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: []*milvuspb.ReplicaInfo{{
+				ReplicaID:    10,
+				CollectionID: req.GetCollectionID(),
+				ShardReplicas: []*milvuspb.ShardReplica{
+					{LeaderID: 1, DmChannelName: "dml-0", NodeIds: []int64{1, 3}},
+					{LeaderID: 2, LeaderAddr: "addr2", DmChannelName: "dml-1", NodeIds: []int64{2}},
+					{LeaderID: 4, DmChannelName: "dml-2", NodeIds: []int64{4}},
+				},
+				NodeIds: []int64{1, 2, 3, 4},
+			}},
+		}, nil
+	})
+
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		return &QueryNodeMock{}, nil
+	}))
+	require.NoError(t, mgr.UpdateShardLeaders(nil, map[string][]nodeInfo{
+		"dml-0": {{nodeID: 1, address: "addr1"}},
+		"dml-1": {{nodeID: 2, address: "addr2-cached"}},
+	}))
+	node := &Proxy{queryCoord: qc, shardMgr: mgr}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	t.Run("by name", func(t *testing.T) {
+		resp, err := node.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionName: "coll"})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), received.GetCollectionID())
+		assert.True(t, received.GetWithShardNodes())
+
+		require.Len(t, resp.GetReplicas(), 1)
+		shards := resp.GetReplicas()[0].GetShardReplicas()
+		require.Len(t, shards, 3)
+		// the missing address is joined with the cached node, the address from query coord is kept
+		assert.Equal(t, "addr1", shards[0].GetLeaderAddr())
+		assert.Equal(t, "addr2", shards[1].GetLeaderAddr())
+		// no client of the node
+		assert.Empty(t, shards[2].GetLeaderAddr())
+	})
+
+	t.Run("by id", func(t *testing.T) {
+		resp, err := node.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionID: 2})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(2), received.GetCollectionID())
+		assert.True(t, received.GetWithShardNodes())
+	})
+
+	t.Run("collection not exists", func(t *testing.T) {
+		received = nil
+		resp, err := node.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionName: "not_exist"})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, resp.GetStatus().GetErrorCode())
+		assert.Nil(t, received)
+	})
+
+	t.Run("id mismatch", func(t *testing.T) {
+		received = nil
+		resp, err := node.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionName: "coll", CollectionID: 2})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+		assert.Nil(t, received)
+	})
+}
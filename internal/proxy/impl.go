@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 
@@ -57,6 +58,14 @@ func (node *Proxy) UpdateStateCode(code internalpb.StateCode) {
 	node.stateCode.Store(code)
 }
 
+// StateReasonKey is the key of the reason in the extra info of the component states, set if Proxy is abnormal or degraded.
+const StateReasonKey = "reason"
+
+// SetStateReason records why Proxy is abnormal or degraded, the reason is cleared if it's empty.
+func (node *Proxy) SetStateReason(reason string) {
+	node.stateReason.Store(reason)
+}
+
 // GetComponentStates get state of Proxy.
 func (node *Proxy) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	stats := &internalpb.ComponentStates{
@@ -82,8 +91,13 @@ func (node *Proxy) GetComponentStates(ctx context.Context) (*internalpb.Componen
 		NodeID:    nodeID,
 		Role:      typeutil.ProxyRole,
 		StateCode: code,
+		ExtraInfo: node.componentExtraInfo(),
+	}
+	if reason, _ := node.stateReason.Load().(string); reason != "" {
+		info.ExtraInfo = append(info.ExtraInfo, &commonpb.KeyValuePair{Key: StateReasonKey, Value: reason})
 	}
 	stats.State = info
+	stats.SubcomponentStates = node.subcomponentStates(nodeID)
 	return stats, nil
 }
 
@@ -109,19 +123,31 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	collectionID := request.CollectionID
+	// the collection may be cached by its aliases too, all of them are removed along with their shard leaders
+	var removed []string
 	if globalMetaCache != nil {
 		if collectionName != "" {
-			globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+			removed = append(removed, globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName)...) // no need to return error, though collection may be not cached
 		}
 		if request.CollectionID != UniqueID(0) {
-			globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
+			removed = append(removed, globalMetaCache.RemoveCollectionsByID(ctx, collectionID)...)
 		}
 	}
+	if request.GetBase().GetMsgType() == commonpb.MsgType_DropCollection && collectionID != UniqueID(0) {
+		// the collection level metrics and query results are only cleaned up once the collection is dropped
+		metrics.CleanupProxyCollectionMetrics(Params.ProxyCfg.GetNodeID(), collectionID)
+		node.queryResultCache.drop(collectionID)
+	} else if collectionID != UniqueID(0) {
+		// the query results are keyed by collection id, the ones of an alias stay valid when the alias is altered
+		// or dropped since they belong to the collection the alias pointed to.
+		node.queryResultCache.invalidate(collectionID)
+	}
 	logutil.Logger(ctx).Info("complete to invalidate collection meta cache",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", collectionName),
-		zap.Int64("collectionID", collectionID))
+		zap.Int64("collectionID", collectionID),
+		zap.Strings("removed", removed))
 
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -154,14 +180,14 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	// avoid data race
 	lenOfSchema := len(request.Schema)
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
 		zap.Int("len(schema)", lenOfSchema),
 		zap.Int32("shards_num", request.ShardsNum),
+		zap.Int64("num_partitions", request.NumPartitions),
 		zap.String("consistency_level", request.ConsistencyLevel.String()))
 
 	if err := node.sched.ddQueue.Enqueue(cct); err != nil {
@@ -178,12 +204,12 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -214,13 +240,13 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	// the shards_num and num_partitions are filled with the defaults if not specified
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", cct.ID()),
@@ -230,6 +256,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 		zap.String("collection", request.CollectionName),
 		zap.Int("len(schema)", lenOfSchema),
 		zap.Int32("shards_num", request.ShardsNum),
+		zap.Int64("num_partitions", request.NumPartitions),
 		zap.String("consistency_level", request.ConsistencyLevel.String()))
 
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
@@ -275,7 +302,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -302,7 +329,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -362,7 +389,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 			metrics.AbandonLabel).Inc()
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -392,7 +419,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 			metrics.FailLabel).Inc()
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -424,6 +451,8 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "LoadCollection"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	lct := &loadCollectionTask{
 		ctx:                   ctx,
@@ -449,7 +478,7 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.AbandonLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -474,12 +503,10 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 			zap.String("db", request.DbName),
 			zap.String("collection", request.CollectionName))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -493,8 +520,6 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -512,6 +537,8 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "ReleaseCollection"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	rct := &releaseCollectionTask{
 		ctx:                      ctx,
@@ -521,8 +548,7 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 		chMgr:                    node.chMgr,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -540,12 +566,12 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.AbandonLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -567,18 +593,15 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 			zap.String("db", request.DbName),
 			zap.String("collection", request.CollectionName))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", rct.ID()),
@@ -587,8 +610,6 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -608,6 +629,8 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "DescribeCollection"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	dct := &describeCollectionTask{
 		ctx:                       ctx,
@@ -634,7 +657,7 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 			metrics.AbandonLabel).Inc()
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -660,14 +683,12 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 			zap.String("db", request.DbName),
 			zap.String("collection", request.CollectionName))
 
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -682,8 +703,6 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -704,6 +723,8 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "GetStatistics"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	g := &getStatisticsTask{
 		request:   request,
@@ -715,8 +736,7 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 		shardMgr:  node.shardMgr,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -738,13 +758,13 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 
 		return &milvuspb.GetStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -768,21 +788,18 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 			zap.String("collection", request.CollectionName),
 			zap.Strings("partitions", request.PartitionNames))
 
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.GetStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", g.ID()),
@@ -791,8 +808,6 @@ func (node *Proxy) GetStatistics(ctx context.Context, request *milvuspb.GetStati
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -812,6 +827,8 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "GetCollectionStatistics"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	g := &getCollectionStatisticsTask{
 		ctx:                            ctx,
@@ -820,8 +837,7 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 		dataCoord:                      node.dataCoord,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -841,13 +857,13 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 
 		return &milvuspb.GetCollectionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -869,21 +885,18 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 			zap.String("db", request.DbName),
 			zap.String("collection", request.CollectionName))
 
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.GetCollectionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", g.ID()),
@@ -892,8 +905,6 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -941,7 +952,7 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 		return &milvuspb.ShowCollectionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -972,7 +983,7 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 
 		return &milvuspb.ShowCollectionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -992,6 +1003,94 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 	return sct.result, nil
 }
 
+// AlterCollection sets the properties of a collection.
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterCollection")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	act := &alterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	method := "AlterCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	rpcLog := rpcReceived(ctx, method,
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.Properties))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	rpcLog.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", act.ID()),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	rpcDone(rpcLog, method,
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
 // CreatePartition create a partition in specific collection.
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
@@ -1013,8 +1112,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 		result:                 nil,
 	}
 
-	log.Debug(
-		rpcReceived("CreatePartition"),
+	rpcLog := rpcReceived(ctx, "CreatePartition",
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1034,12 +1132,12 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued("CreatePartition"),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1066,13 +1164,12 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone("CreatePartition"),
+	rpcDone(rpcLog, "CreatePartition",
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", cpt.ID()),
@@ -1108,8 +1205,7 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 		result:               nil,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1129,12 +1225,12 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1161,13 +1257,12 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", dpt.ID()),
@@ -1182,6 +1277,94 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	return dpt.result, nil
 }
 
+// DropPartitionData deletes all entities of a partition while keeping the partition.
+func (node *Proxy) DropPartitionData(ctx context.Context, request *milvuspb.DropPartitionDataRequest) (*milvuspb.DropPartitionDataResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.DropPartitionDataResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropPartitionData")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	method := "DropPartitionData"
+	tr := timerecord.NewTimeRecorder(method)
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel).Inc()
+
+	rpcLog := rpcReceived(ctx, method,
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("partition", request.GetPartitionName()),
+		zap.Bool("force", request.GetForce()))
+
+	failed := func(err error) *milvuspb.DropPartitionDataResponse {
+		log.Warn(
+			"failed to drop partition data",
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("collection", request.GetCollectionName()),
+			zap.String("partition", request.GetPartitionName()))
+		metrics.ProxyDMLFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel).Inc()
+		return &milvuspb.DropPartitionDataResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}
+	}
+
+	if err := validateDropPartitionDataRequest(request); err != nil {
+		return failed(err), nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return failed(err), nil
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return failed(err), nil
+	}
+	if _, err := globalMetaCache.GetPartitionID(ctx, request.GetDbName(), request.GetCollectionName(), request.GetPartitionName()); err != nil {
+		return failed(err), nil
+	}
+
+	deleteCnt, ts, err := deletePartitionEntities(ctx, request.GetDbName(), request.GetCollectionName(), request.GetPartitionName(), pkField,
+		node.queryPartitionPrimaryKeys, node.deletePrimaryKeys)
+	if deleteCnt > 0 {
+		if collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName()); err == nil {
+			node.queryResultCache.invalidate(collectionID)
+		}
+	}
+	if err != nil {
+		log.Warn("partition data is partially deleted", zap.String("traceID", traceID),
+			zap.String("collection", request.GetCollectionName()), zap.String("partition", request.GetPartitionName()),
+			zap.Int64("deleteCnt", deleteCnt))
+		return failed(err), nil
+	}
+
+	rpcDone(rpcLog, method,
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("partition", request.GetPartitionName()),
+		zap.Int64("deleteCnt", deleteCnt),
+		zap.Uint64("timestamp", ts))
+	metrics.ProxyDMLFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel).Inc()
+	metrics.ProxyMutationLatency.WithLabelValues(nodeID, metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.DropPartitionDataResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		DeleteCnt: deleteCnt,
+		Timestamp: ts,
+	}, nil
+}
+
 // HasPartition check if partition exist.
 func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	if !node.checkHealthy() {
@@ -1207,8 +1390,7 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 		result:              nil,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1230,14 +1412,14 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 			Value: false,
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1266,15 +1448,14 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 			Value: false,
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", hpt.ID()),
@@ -1301,6 +1482,8 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "LoadPartitions"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	lpt := &loadPartitionsTask{
 		ctx:                   ctx,
@@ -1309,8 +1492,7 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 		queryCoord:            node.queryCoord,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1331,12 +1513,12 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 			metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1360,19 +1542,16 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 			zap.String("collection", request.CollectionName),
 			zap.Any("partitions", request.PartitionNames))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", lpt.ID()),
@@ -1382,8 +1561,6 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 		zap.String("collection", request.CollectionName),
 		zap.Any("partitions", request.PartitionNames))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -1409,9 +1586,10 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 
 	method := "ReleasePartitions"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1432,12 +1610,12 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 			metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1461,19 +1639,16 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 			zap.String("collection", request.CollectionName),
 			zap.Any("partitions", request.PartitionNames))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", rpt.Base.MsgID),
@@ -1483,8 +1658,6 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 		zap.String("collection", request.CollectionName),
 		zap.Any("partitions", request.PartitionNames))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -1504,6 +1677,8 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	method := "GetPartitionStatistics"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
 	g := &getPartitionStatisticsTask{
 		ctx:                           ctx,
@@ -1512,8 +1687,7 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 		dataCoord:                     node.dataCoord,
 	}
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1535,13 +1709,13 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 
 		return &milvuspb.GetPartitionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1565,21 +1739,18 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 			zap.String("collection", request.CollectionName),
 			zap.String("partition", request.PartitionName))
 
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.GetPartitionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", g.ID()),
@@ -1589,8 +1760,6 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 		zap.String("collection", request.CollectionName),
 		zap.String("partition", request.PartitionName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -1615,6 +1784,7 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 		ShowPartitionsRequest: request,
 		rootCoord:             node.rootCoord,
 		queryCoord:            node.queryCoord,
+		dataCoord:             node.dataCoord,
 		result:                nil,
 	}
 
@@ -1624,8 +1794,7 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Any("request", request))
@@ -1643,13 +1812,13 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1678,14 +1847,13 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", spt.ID()),
@@ -1717,13 +1885,16 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 		CreateIndexRequest: request,
 		rootCoord:          node.rootCoord,
 		indexCoord:         node.indexCoord,
+		nodeCtx:            node.ctx,
+		nodeWg:             &node.wg,
 	}
 
 	method := "CreateIndex"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1746,12 +1917,12 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 			metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1777,19 +1948,16 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 			zap.String("field", request.FieldName),
 			zap.Any("extra_params", request.ExtraParams))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", cit.ID()),
@@ -1800,8 +1968,6 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 		zap.String("field", request.FieldName),
 		zap.Any("extra_params", request.ExtraParams))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -1831,9 +1997,10 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 	// avoid data race
 	indexName := request.IndexName
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1857,13 +2024,13 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 
 		return &milvuspb.DescribeIndexResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -1889,12 +2056,10 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 			zap.String("field", request.FieldName),
 			zap.String("index name", indexName))
 
-		errCode := commonpb.ErrorCode_UnexpectedError
-		if dit.result != nil {
+		errCode := errorCodeOf(err)
+		if dit.result != nil && dit.result.Status.GetErrorCode() != commonpb.ErrorCode_Success {
 			errCode = dit.result.Status.GetErrorCode()
 		}
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
@@ -1906,8 +2071,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", dit.ID()),
@@ -1918,8 +2082,6 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 		zap.String("field", request.FieldName),
 		zap.String("index name", indexName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -1945,9 +2107,10 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 
 	method := "DropIndex"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1969,12 +2132,12 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 			metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -2000,19 +2163,16 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 			zap.String("field", request.FieldName),
 			zap.String("index name", request.IndexName))
 
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", dit.ID()),
@@ -2023,8 +2183,6 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 		zap.String("field", request.FieldName),
 		zap.String("index name", request.IndexName))
 
-	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDMLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2055,9 +2213,10 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 
 	method := "GetIndexBuildProgress"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2080,13 +2239,13 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 
 		return &milvuspb.GetIndexBuildProgressResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -2111,21 +2270,18 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 			zap.String("collection", request.CollectionName),
 			zap.String("field", request.FieldName),
 			zap.String("index name", request.IndexName))
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.GetIndexBuildProgressResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", gibpt.ID()),
@@ -2137,8 +2293,6 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 		zap.String("index name", request.IndexName),
 		zap.Any("result", gibpt.result))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2167,9 +2321,10 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 
 	method := "GetIndexState"
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2193,13 +2348,13 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 
 		return &milvuspb.GetIndexStateResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -2225,21 +2380,18 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 			zap.String("field", request.FieldName),
 			zap.String("index name", request.IndexName))
 
-		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
 		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.GetIndexStateResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", dipt.ID()),
@@ -2250,8 +2402,6 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 		zap.String("field", request.FieldName),
 		zap.String("index name", request.IndexName))
 
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2263,25 +2413,53 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
-	log.Info("Start processing insert request in Proxy", zap.String("traceID", traceID))
-	defer log.Info("Finish processing insert request in Proxy", zap.String("traceID", traceID))
+	dedupToken := request.GetDedupToken()
+	method := "Insert"
+	rpcLog := rpcReceived(ctx, method, zap.String("traceID", traceID), zap.String("dedupToken", dedupToken))
+	defer rpcDone(rpcLog, method, zap.String("traceID", traceID), zap.String("dedupToken", dedupToken))
 
 	if !node.checkHealthy() {
 		return &milvuspb.MutationResult{
 			Status: unhealthyStatus(),
 		}, nil
 	}
-	method := "Insert"
+
 	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	var dedupEntry *insertDedupEntry
+	if dedupToken != "" {
+		result, entry, err := node.insertDedupCache.acquire(ctx, dedupToken, newInsertDigest(request))
+		if err != nil {
+			log.Warn("failed to deduplicate insert request", zap.String("traceID", traceID),
+				zap.String("dedupToken", dedupToken), zap.Error(err))
+			metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			return &milvuspb.MutationResult{
+				Status: &commonpb.Status{
+					ErrorCode: errorCodeOf(err),
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+		if result != nil {
+			rpcLog.Info("insert request is deduplicated, return the result of the previous attempt",
+				zap.String("traceID", traceID), zap.String("dedupToken", dedupToken))
+			label := metrics.SuccessLabel
+			if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				label = metrics.FailLabel
+			}
+			metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+				label).Inc()
+			return result, nil
+		}
+		dedupEntry = entry
+	}
 	receiveSize := proto.Size(request)
 	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(receiveSize))
 	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.InsertLabel).Add(float64(receiveSize))
 
-	defer func() {
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
-	}()
-
 	it := &insertTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -2296,6 +2474,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgID:    0,
 					SourceID: Params.ProxyCfg.GetNodeID(),
 				},
+				DbName:         request.GetDbName(),
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				FieldsData:     request.FieldsData,
@@ -2304,7 +2483,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 				// RowData: transfer column based request to this
 			},
 		},
-		idAllocator:   node.idAllocator,
+		idAllocator:   node.insertIDAllocator,
 		segIDAssigner: node.segAssigner,
 		chMgr:         node.chMgr,
 		chTicker:      node.chTicker,
@@ -2315,22 +2494,17 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	}
 
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
-		numRows := request.NumRows
-		errIndex := make([]uint32, numRows)
-		for i := uint32(0); i < numRows; i++ {
-			errIndex[i] = i
-		}
-
-		return &milvuspb.MutationResult{
+		result := &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
-			ErrIndex: errIndex,
 		}
+		setInsertErrIndex(result, request.NumRows, insertRowIndexes(request.NumRows))
+		return result
 	}
 
-	log.Debug("Enqueue insert request in Proxy",
+	rpcLog.Debug("Enqueue insert request in Proxy",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
@@ -2338,16 +2512,32 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		zap.Int("len(FieldsData)", len(request.FieldsData)),
 		zap.Int("len(HashKeys)", len(request.HashKeys)),
 		zap.Uint32("NumRows", request.NumRows),
-		zap.String("traceID", traceID))
+		zap.String("traceID", traceID),
+		zap.String("dedupToken", dedupToken))
+
+	if Params.ProxyCfg.InsertDuplicatePKCheck {
+		if err := node.checkInsertPrimaryKeys(ctx, request); err != nil {
+			log.Warn("check duplicate primary keys failed", zap.String("traceID", traceID),
+				zap.String("collection", request.CollectionName), zap.Error(err))
+			metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			result := constructFailedResponse(err)
+			node.insertDedupCache.finish(dedupToken, dedupEntry, result)
+			return result, nil
+		}
+	}
 
 	if err := node.sched.dmQueue.Enqueue(it); err != nil {
-		log.Debug("Failed to enqueue insert task: " + err.Error())
+		rpcLog.Warn(rpcFailedToEnqueue(method), zap.Error(err), zap.String("traceID", traceID),
+			zap.String("dedupToken", dedupToken))
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.AbandonLabel).Inc()
-		return constructFailedResponse(err), nil
+		result := constructFailedResponse(err)
+		node.insertDedupCache.finish(dedupToken, dedupEntry, result)
+		return result, nil
 	}
 
-	log.Debug("Detail of insert request in Proxy",
+	rpcLog.Debug(rpcEnqueued(method),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", it.Base.MsgID),
 		zap.Uint64("BeginTS", it.BeginTs()),
@@ -2356,27 +2546,25 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		zap.String("collection", request.CollectionName),
 		zap.String("partition", request.PartitionName),
 		zap.Uint32("NumRows", request.NumRows),
-		zap.String("traceID", traceID))
+		zap.String("traceID", traceID),
+		zap.String("dedupToken", dedupToken))
 
 	if err := it.WaitToFinish(); err != nil {
-		log.Debug("Failed to execute insert task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		rpcLog.Warn(rpcFailedToWaitToFinish(method), zap.Error(err), zap.String("traceID", traceID),
+			zap.String("dedupToken", dedupToken))
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
-		return constructFailedResponse(err), nil
+		result := constructFailedResponse(err)
+		node.insertDedupCache.finish(dedupToken, dedupEntry, result)
+		return result, nil
 	}
 
-	if it.result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		setErrorIndex := func() {
-			numRows := request.NumRows
-			errIndex := make([]uint32, numRows)
-			for i := uint32(0); i < numRows; i++ {
-				errIndex[i] = i
-			}
-			it.result.ErrIndex = errIndex
-		}
-
-		setErrorIndex()
+	// all the rows failed unless the failed rows are specified by the task
+	errIndex := it.result.ErrIndex
+	if it.result.Status.ErrorCode != commonpb.ErrorCode_Success && len(errIndex) == 0 {
+		errIndex = insertRowIndexes(request.NumRows)
 	}
+	setInsertErrIndex(it.result, request.NumRows, errIndex)
 
 	// InsertCnt always equals to the number of entities in the request
 	it.result.InsertCnt = int64(request.NumRows)
@@ -2386,6 +2574,9 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	successCnt := it.result.InsertCnt - int64(len(it.result.ErrIndex))
 	metrics.ProxyInsertVectors.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(successCnt))
 	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	node.queryResultCache.invalidate(it.CollectionID)
+	node.insertDedupCache.finish(dedupToken, dedupEntry, it.result)
+	node.userUsage.record(ctx, request.CollectionName, usageCounters{Requests: 1, RowsInserted: successCnt})
 	return it.result, nil
 }
 
@@ -2394,8 +2585,9 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Delete")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
-	log.Info("Start processing delete request in Proxy", zap.String("traceID", traceID))
-	defer log.Info("Finish processing delete request in Proxy", zap.String("traceID", traceID))
+	method := "Delete"
+	rpcLog := rpcReceived(ctx, method, zap.String("traceID", traceID))
+	defer rpcDone(rpcLog, method, zap.String("traceID", traceID))
 
 	receiveSize := proto.Size(request)
 	rateCol.Add(internalpb.RateType_DMLDelete.String(), float64(receiveSize))
@@ -2407,15 +2599,15 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		}, nil
 	}
 
-	method := "Delete"
 	tr := timerecord.NewTimeRecorder(method)
 
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 	dt := &deleteTask{
-		ctx:        ctx,
-		Condition:  NewTaskCondition(ctx),
-		deleteExpr: request.Expr,
+		ctx:         ctx,
+		Condition:   NewTaskCondition(ctx),
+		deleteExpr:  request.Expr,
+		primaryKeys: request.PrimaryKeys,
 		BaseDeleteTask: BaseDeleteTask{
 			BaseMsg: msgstream.BaseMsg{
 				HashValues: request.HashKeys,
@@ -2435,7 +2627,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		chTicker: node.chTicker,
 	}
 
-	log.Debug("Enqueue delete request in Proxy",
+	rpcLog.Debug("Enqueue delete request in Proxy",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
@@ -2444,19 +2636,19 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	// MsgID will be set by Enqueue()
 	if err := node.sched.dmQueue.Enqueue(dt); err != nil {
-		log.Error("Failed to enqueue delete task: "+err.Error(), zap.String("traceID", traceID))
+		rpcLog.Error(rpcFailedToEnqueue(method), zap.Error(err), zap.String("traceID", traceID))
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 
-	log.Debug("Detail of delete request in Proxy",
+	rpcLog.Debug(rpcEnqueued(method),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", dt.Base.MsgID),
 		zap.Uint64("timestamp", dt.Base.Timestamp),
@@ -2467,14 +2659,12 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		zap.String("traceID", traceID))
 
 	if err := dt.WaitToFinish(); err != nil {
-		log.Error("Failed to execute delete task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
-		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-			metrics.TotalLabel).Inc()
+		rpcLog.Error(rpcFailedToWaitToFinish(method), zap.Error(err), zap.String("traceID", traceID))
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -2483,6 +2673,8 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	node.queryResultCache.invalidate(dt.collectionID)
+	node.userUsage.record(ctx, request.CollectionName, usageCounters{Requests: 1})
 	return dt.result, nil
 }
 
@@ -2503,9 +2695,47 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 
+	if err := node.sched.dqQueue.shed(SearchTaskName); err != nil {
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
 	defer sp.Finish()
 
+	if names := splitCollectionNames(request.GetCollectionName()); len(names) > 1 {
+		rpcLog := rpcReceived(ctx, method,
+			zap.String("role", typeutil.ProxyRole),
+			zap.Strings("collections", names),
+			zap.Any("search_params", request.SearchParams))
+
+		result, err := searchCollections(ctx, names, request, node.searchCollection)
+		if err != nil {
+			log.Ctx(ctx).Warn(rpcFailedToWaitToFinish(method), zap.Strings("collections", names), zap.Error(err))
+			metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			return &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: errorCodeOf(err),
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		rpcDone(rpcLog, method, zap.Strings("collections", names))
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.SuccessLabel).Inc()
+		metrics.ProxySearchLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
+			metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		return result, nil
+	}
+
 	qt := &searchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -2516,17 +2746,19 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			},
 			ReqID: Params.ProxyCfg.GetNodeID(),
 		},
-		request:  request,
-		qc:       node.queryCoord,
-		tr:       timerecord.NewTimeRecorder("search"),
-		shardMgr: node.shardMgr,
+		request:        request,
+		qc:             node.queryCoord,
+		ic:             node.indexCoord,
+		tr:             timerecord.NewTimeRecorder("search"),
+		shardMgr:       node.shardMgr,
+		queryPKsAt:     node.queryPrimaryKeysAt,
+		queryVectorsAt: node.queryVectorsAt,
 	}
 
 	travelTs := request.TravelTimestamp
 	guaranteeTs := request.GuaranteeTimestamp
 
-	log.Ctx(ctx).Info(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
@@ -2558,14 +2790,14 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 	tr.CtxRecord(ctx, "search request enqueue")
 
-	log.Ctx(ctx).Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", qt.ID()),
@@ -2601,7 +2833,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -2610,8 +2842,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	span := tr.CtxRecord(ctx, "wait search result")
 	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
 		metrics.SearchLabel).Observe(float64(span.Milliseconds()))
-	log.Ctx(ctx).Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", qt.ID()),
 		zap.String("db", request.DbName),
@@ -2626,15 +2857,14 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
-	metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(qt.result.GetResults().GetNumQueries()))
-	searchDur := tr.ElapseSpan().Milliseconds()
+	metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SuccessLabel).Add(float64(qt.result.GetResults().GetNumQueries()))
+	searchDur := tr.ElapseSpan()
 	metrics.ProxySearchLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
-		metrics.SearchLabel).Observe(float64(searchDur))
+		metrics.SearchLabel).Observe(float64(searchDur.Milliseconds()))
+	logSlowDQL(ctx, method, request.CollectionName, qt.priority, searchDur)
 
-	if qt.result != nil {
-		sentSize := proto.Size(qt.result)
-		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(sentSize))
-	}
+	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(qt.resultSizeInBytes))
+	node.userUsage.record(ctx, request.CollectionName, usageCounters{Requests: 1, BytesRead: int64(qt.resultSizeInBytes)})
 	return qt.result, nil
 }
 
@@ -2666,12 +2896,12 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
-		zap.Any("collections", request.CollectionNames))
+		zap.Any("collections", request.CollectionNames),
+		zap.Strings("partitions", request.PartitionNames))
 
 	if err := node.sched.ddQueue.Enqueue(ft); err != nil {
 		log.Warn(
@@ -2688,7 +2918,7 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 		return resp, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -2712,13 +2942,12 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
-		resp.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		resp.Status.ErrorCode = errorCodeOf(err)
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", ft.ID()),
@@ -2747,7 +2976,37 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Query")
 	defer sp.Finish()
-	tr := timerecord.NewTimeRecorder("Query")
+	method := "Query"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	// the results are cached by collection id so that the collection and its aliases share them, the pages of a
+	// query iterator are never cached since each of them carries the cursor issued at its own snapshot, neither
+	// are the queries which must see the latest writes
+	var cacheKey queryResultCacheKey
+	var cacheGeneration uint64
+	if node.queryResultCache != nil && !isQueryIteratorRequest(request.GetQueryParams()) {
+		if guaranteeTs, ok := queryResultCacheGuaranteeTs(ctx, request); ok {
+			if collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.CollectionName); err == nil {
+				cacheKey = newQueryResultCacheKey(collectionID, guaranteeTs, request)
+			}
+		}
+	}
+	if cacheKey.collectionID != 0 {
+		var result *milvuspb.QueryResults
+		var ok bool
+		if result, cacheGeneration, ok = node.queryResultCache.get(cacheKey); ok {
+			log.Ctx(ctx).Debug("query result cache hit",
+				zap.String("role", typeutil.ProxyRole),
+				zap.String("collection", request.CollectionName),
+				zap.String("expr", request.Expr))
+			metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+				metrics.SuccessLabel).Inc()
+			node.userUsage.record(ctx, request.CollectionName, usageCounters{Requests: 1, BytesRead: int64(proto.Size(result))})
+			return result, nil
+		}
+	}
 
 	qt := &queryTask{
 		ctx:       ctx,
@@ -2765,13 +3024,18 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		shardMgr:         node.shardMgr,
 	}
 
-	method := "Query"
-
-	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
-		metrics.TotalLabel).Inc()
+	if err := node.sched.dqQueue.shed(QueryTaskName); err != nil {
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
 
-	log.Ctx(ctx).Info(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
@@ -2795,14 +3059,14 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
 	}
 	tr.CtxRecord(ctx, "query request enqueue")
 
-	log.Ctx(ctx).Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", qt.ID()),
@@ -2825,7 +3089,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -2833,8 +3097,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	span := tr.CtxRecord(ctx, "wait query result")
 	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
 		metrics.QueryLabel).Observe(float64(span.Milliseconds()))
-	log.Ctx(ctx).Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", qt.ID()),
 		zap.String("db", request.DbName),
@@ -2844,15 +3107,22 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 
+	queryDur := tr.ElapseSpan()
 	metrics.ProxySearchLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
-		metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		metrics.QueryLabel).Observe(float64(queryDur.Milliseconds()))
+	logSlowDQL(ctx, method, request.CollectionName, qt.priority, queryDur)
 
 	ret := &milvuspb.QueryResults{
-		Status:     qt.result.Status,
-		FieldsData: qt.result.FieldsData,
+		Status:              qt.result.Status,
+		FieldsData:          qt.result.FieldsData,
+		CollectionName:      qt.result.CollectionName,
+		QueryIteratorCursor: qt.result.QueryIteratorCursor,
+	}
+	if cacheKey.collectionID != 0 && ret.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		node.queryResultCache.put(cacheKey, cacheGeneration, ret)
 	}
-	sentSize := proto.Size(qt.result)
-	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(sentSize))
+	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(qt.resultSizeInBytes))
+	node.userUsage.record(ctx, request.CollectionName, usageCounters{Requests: 1, BytesRead: int64(qt.resultSizeInBytes)})
 	return ret, nil
 }
 
@@ -2877,8 +3147,7 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2898,12 +3167,12 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -2929,13 +3198,12 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", cat.ID()),
@@ -2971,8 +3239,7 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2989,12 +3256,12 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -3019,13 +3286,12 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", dat.ID()),
@@ -3060,8 +3326,7 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-	log.Debug(
-		rpcReceived(method),
+	rpcLog := rpcReceived(ctx, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -3080,12 +3345,12 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
+	rpcLog.Debug(
 		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
@@ -3112,13 +3377,12 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug(
-		rpcDone(method),
+	rpcDone(rpcLog, method,
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("MsgID", aat.ID()),
@@ -3133,6 +3397,113 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
+// CreateDatabase creates a database in rootCoord.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	method := "CreateDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	rpcLog := rpcReceived(ctx, method, zap.String("db", request.GetDbName()))
+
+	if err := validateDatabaseName(request.GetDbName()); err != nil {
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	status, err := node.rootCoord.CreateDatabase(ctx, request)
+	if err != nil {
+		status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()}
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		rpcLog.Warn("failed to create database", zap.String("db", request.GetDbName()), zap.String("reason", status.GetReason()))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return status, nil
+	}
+
+	rpcDone(rpcLog, method, zap.String("db", request.GetDbName()))
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return status, nil
+}
+
+// DropDatabase drops an empty database in rootCoord, the default database can't be dropped. The database has no
+// collection to drop along, so there is no meta cache to invalidate.
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	method := "DropDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	rpcLog := rpcReceived(ctx, method, zap.String("db", request.GetDbName()))
+
+	if err := validateDatabaseName(request.GetDbName()); err != nil {
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if request.GetDbName() == util.DefaultDBName {
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "the default database can't be dropped",
+		}, nil
+	}
+
+	status, err := node.rootCoord.DropDatabase(ctx, request)
+	if err != nil {
+		status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()}
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		rpcLog.Warn("failed to drop database", zap.String("db", request.GetDbName()), zap.String("reason", status.GetReason()))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return status, nil
+	}
+
+	rpcDone(rpcLog, method, zap.String("db", request.GetDbName()))
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return status, nil
+}
+
+// ListDatabases lists the databases in rootCoord, including the default one.
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{Status: unhealthyStatus()}, nil
+	}
+
+	method := "ListDatabases"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	rpcLog := rpcReceived(ctx, method)
+
+	resp, err := node.rootCoord.ListDatabases(ctx, request)
+	if err != nil {
+		resp = &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()},
+		}
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		rpcLog.Warn("failed to list databases", zap.String("reason", resp.GetStatus().GetReason()))
+		metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return resp, nil
+	}
+
+	rpcDone(rpcLog, method, zap.Strings("dbs", resp.GetDbNames()))
+	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDQLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
 // CalcDistance calculates the distances between vectors.
 func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	if !node.checkHealthy() {
@@ -3185,7 +3556,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 
 			return &milvuspb.QueryResults{
 				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					ErrorCode: errorCodeOf(err),
 					Reason:    err.Error(),
 				},
 			}, err
@@ -3199,7 +3570,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 
 			return &milvuspb.QueryResults{
 				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					ErrorCode: errorCodeOf(err),
 					Reason:    err.Error(),
 				},
 			}, err
@@ -3306,7 +3677,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		return resp, nil
 	}
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
 		return resp, nil
@@ -3448,6 +3819,14 @@ func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milv
 		}, nil
 	}
 
+	if drt.RequestType == dummyProbeRequestType {
+		return node.dummyProbe(ctx, req.RequestType), nil
+	}
+
+	if drt.RequestType == dummySelectResourceRequestType {
+		return node.dummySelectResource(ctx, req.RequestType), nil
+	}
+
 	log.Debug("cannot find specify dummy request type")
 	return failedResponse, nil
 }
@@ -3554,6 +3933,35 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.CollectionStatsMetrics {
+		ret, err := node.metricsCacheManager.GetCollectionMetrics()
+		if err == nil && ret != nil {
+			return ret, nil
+		}
+		log.Debug("failed to get collection metrics from cache, recompute instead",
+			zap.Error(err))
+
+		metrics, err := getCollectionMetrics(ctx, req, node)
+		if err != nil {
+			log.Warn("Proxy.GetMetrics failed to get collection metrics",
+				zap.Int64("node_id", Params.ProxyCfg.GetNodeID()),
+				zap.String("req", req.Request),
+				zap.Error(err))
+
+			return &milvuspb.GetMetricsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+				Response: "",
+			}, nil
+		}
+
+		node.metricsCacheManager.UpdateCollectionMetrics(metrics)
+
+		return metrics, nil
+	}
+
 	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.ProxyCfg.GetNodeID()),
 		zap.String("req", req.Request),
@@ -3671,12 +4079,24 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err := validateLoadBalanceNodes(req); err != nil {
+		log.Warn("invalid load balance request", zap.Any("req", req), zap.Error(err))
+		status.ErrorCode = errorCodeOf(err)
+		status.Reason = err.Error()
+		return status, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", req.GetCollectionName())
 	if err != nil {
 		log.Error("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
 		status.Reason = err.Error()
 		return status, nil
 	}
+	if err := validateLoadBalanceTargets(ctx, node.queryCoord, req, collectionID); err != nil {
+		log.Warn("invalid load balance request", zap.Any("req", req), zap.Error(err))
+		status.ErrorCode = errorCodeOf(err)
+		status.Reason = err.Error()
+		return status, nil
+	}
 	infoResp, err := node.queryCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_LoadBalanceSegments,
@@ -3689,6 +4109,7 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 		BalanceReason:    querypb.TriggerCondition_GrpcRequest,
 		SealedSegmentIDs: req.SealedSegmentIDs,
 		CollectionID:     collectionID,
+		DryRun:           req.GetDryRun(),
 	})
 	if err != nil {
 		log.Error("Failed to LoadBalance from Query Coordinator",
@@ -3703,6 +4124,10 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 	}
 	log.Debug("LoadBalance Done", zap.Any("req", req), zap.Any("status", infoResp))
 	status.ErrorCode = commonpb.ErrorCode_Success
+	if req.GetDryRun() {
+		// the planned movements are returned in the reason
+		status.Reason = infoResp.GetReason()
+	}
 	return status, nil
 }
 
@@ -3715,7 +4140,11 @@ func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetComp
 		return resp, nil
 	}
 
-	resp, err := node.dataCoord.GetCompactionState(ctx, req)
+	err := retryCoordCall(ctx, "GetCompactionState", func() error {
+		var err error
+		resp, err = node.dataCoord.GetCompactionState(ctx, req)
+		return err
+	})
 	log.Info("received GetCompactionState response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
 	return resp, err
 }
@@ -3743,7 +4172,11 @@ func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvusp
 		return resp, nil
 	}
 
-	resp, err := node.dataCoord.GetCompactionStateWithPlans(ctx, req)
+	err := retryCoordCall(ctx, "GetCompactionStateWithPlans", func() error {
+		var err error
+		resp, err = node.dataCoord.GetCompactionStateWithPlans(ctx, req)
+		return err
+	})
 	log.Info("received GetCompactionStateWithPlans response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
 	return resp, err
 }
@@ -3759,7 +4192,11 @@ func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStat
 		return resp, nil
 	}
 
-	resp, err = node.dataCoord.GetFlushState(ctx, req)
+	err = retryCoordCall(ctx, "GetFlushState", func() error {
+		var err error
+		resp, err = node.dataCoord.GetFlushState(ctx, req)
+		return err
+	})
 	if err != nil {
 		log.Info("failed to get flush state response", zap.Error(err))
 		return nil, err
@@ -3803,7 +4240,7 @@ func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*mi
 		return resp, nil
 	}
 	// Get collection ID and then channel names.
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
 	if err != nil {
 		log.Error("collection ID not found",
 			zap.String("collection name", req.GetCollectionName()),
@@ -3853,18 +4290,53 @@ func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportSt
 
 // ListImportTasks get id array of all import tasks from rootcoord
 func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
-	log.Info("received list import tasks request")
+	log.Info("received list import tasks request", zap.String("collection", req.GetCollectionName()))
 	resp := &milvuspb.ListImportTasksResponse{}
 	if !node.checkHealthy() {
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
 
+	var collectionName string
+	if req.GetCollectionName() != "" {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+		if err == nil {
+			schema, schemaErr := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+			collectionName, err = schema.GetName(), schemaErr
+		}
+		if err != nil {
+			resp.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+				Reason:    fmt.Sprintf("collection %s does not exist, err: %s", req.GetCollectionName(), err.Error()),
+			}
+			return resp, nil
+		}
+		req.CollectionID = collectionID
+	}
+
 	resp, err := node.rootCoord.ListImportTasks(ctx, req)
+	if err == nil && collectionName != "" {
+		// the rootCoord not aware of the collectionID lists all the tasks
+		resp.Tasks = filterImportTasks(resp.GetTasks(), collectionName)
+	}
 	log.Info("received list import tasks response")
 	return resp, err
 }
 
+// filterImportTasks returns the import tasks of the collection, the collection alias must be resolved to the name.
+func filterImportTasks(tasks []*milvuspb.GetImportStateResponse, collectionName string) []*milvuspb.GetImportStateResponse {
+	ret := make([]*milvuspb.GetImportStateResponse, 0, len(tasks))
+	for _, task := range tasks {
+		for _, info := range task.GetInfos() {
+			if info.GetKey() == "collection" && info.GetValue() == collectionName {
+				ret = append(ret, task)
+				break
+			}
+		}
+	}
+	return ret
+}
+
 // GetReplicas gets replica info
 func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	log.Info("received get replicas request")
@@ -3878,12 +4350,135 @@ func (node *Proxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReq
 		MsgType:  commonpb.MsgType_GetReplicas,
 		SourceID: Params.ProxyCfg.GetNodeID(),
 	}
+	if req.GetCollectionName() != "" {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", req.GetCollectionName())
+		if err != nil {
+			resp.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+				Reason:    fmt.Sprintf("collection %s does not exist, err: %s", req.GetCollectionName(), err.Error()),
+			}
+			return resp, nil
+		}
+		if req.GetCollectionID() != 0 && req.GetCollectionID() != collectionID {
+			resp.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason: fmt.Sprintf("collection id %d doesn't match the collection %s of id %d",
+					req.GetCollectionID(), req.GetCollectionName(), collectionID),
+			}
+			return resp, nil
+		}
+		req.CollectionID = collectionID
+	}
+	// the shard leaders are always returned, the nodes of each shard are needed to tell which node leads which channel
+	req.WithShardNodes = true
 
-	resp, err := node.queryCoord.GetReplicas(ctx, req)
+	err := retryCoordCall(ctx, "GetReplicas", func() error {
+		var err error
+		resp, err = node.queryCoord.GetReplicas(ctx, req)
+		return err
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success && node.shardMgr != nil {
+		fillInShardLeaderAddresses(resp.GetReplicas(), node.shardMgr)
+	}
 	log.Info("received get replicas response", zap.Any("resp", resp), zap.Error(err))
 	return resp, err
 }
 
+// fillInShardLeaderAddresses sets the addresses of the shard leaders missing in the replicas
+// by the nodes the proxy has clients of.
+func fillInShardLeaderAddresses(replicas []*milvuspb.ReplicaInfo, mgr *shardClientMgr) {
+	for _, replica := range replicas {
+		for _, shard := range replica.GetShardReplicas() {
+			if shard.GetLeaderAddr() != "" {
+				continue
+			}
+			if address, ok := mgr.GetAddress(shard.GetLeaderID()); ok {
+				shard.LeaderAddr = address
+			}
+		}
+	}
+}
+
+// AllocTimestamp allocates a timestamp from the TSO, the timestamp can be used as the travel timestamp
+// or guarantee timestamp of the later requests.
+func (node *Proxy) AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	metrics.ProxyAllocTimestampCount.WithLabelValues(nodeID, metrics.TotalLabel).Inc()
+	if !node.checkHealthy() {
+		metrics.ProxyAllocTimestampCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+		return &milvuspb.AllocTimestampResponse{Status: unhealthyStatus()}, nil
+	}
+
+	client := getClientIdentifier(ctx)
+	if !node.allocTsLimiter.allow(client) {
+		metrics.ProxyAllocTimestampCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+		return &milvuspb.AllocTimestampResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_RateLimit,
+				Reason:    fmt.Sprintf("too many AllocTimestamp requests from client %s, please retry later", client),
+			},
+		}, nil
+	}
+
+	ts, err := node.tsoAllocator.AllocOne()
+	if err != nil {
+		log.Warn("failed to allocate timestamp", zap.String("client", client), zap.Error(err))
+		metrics.ProxyAllocTimestampCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+		return &milvuspb.AllocTimestampResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	metrics.ProxyAllocTimestampCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+	return &milvuspb.AllocTimestampResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Timestamp: ts,
+	}, nil
+}
+
+// CheckHealth checks if the proxy is healthy to serve the requests, it's not if the proxy is not in the Healthy
+// state, or the clock of the proxy has kept skewing from the TSO, the reasons of the problems are returned.
+func (node *Proxy) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return &milvuspb.CheckHealthResponse{
+			Status:    unhealthyStatus(),
+			IsHealthy: false,
+			Reasons:   []string{fmt.Sprintf("proxy is in state %s", code.String())},
+		}, nil
+	}
+
+	var reasons []string
+	if reason := globalClockSkew.unhealthyReason(time.Now()); reason != "" {
+		reasons = append(reasons, reason)
+	}
+	return &milvuspb.CheckHealthResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IsHealthy: len(reasons) == 0,
+		Reasons:   reasons,
+	}, nil
+}
+
+// GetVersion returns the build info of the proxy and the features supported by the cluster. It calls no coordinator,
+// so that clients can detect the capabilities even if the proxy isn't healthy.
+func (node *Proxy) GetVersion(ctx context.Context, req *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
+	return &milvuspb.GetVersionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Version:   common.Version.String(),
+		GitCommit: os.Getenv(metricsinfo.GitCommitEnvKey),
+		BuildTime: os.Getenv(metricsinfo.MilvusBuildTimeEnvKey),
+		Features:  supportedFeatures(),
+	}, nil
+}
+
 // InvalidateCredentialCache invalidate the credential cache of specified username.
 func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
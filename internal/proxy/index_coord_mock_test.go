@@ -244,6 +244,9 @@ type GetIndexStateFunc func(ctx context.Context, request *indexpb.GetIndexStateR
 type mockIndexCoord struct {
 	types.IndexCoord
 	GetIndexStateFunc
+	CreateIndexFunc           func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
+	DescribeIndexFunc         func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
+	GetIndexBuildProgressFunc func(ctx context.Context, request *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
 }
 
 func (m *mockIndexCoord) GetIndexState(ctx context.Context, request *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
@@ -255,6 +258,27 @@ func (m *mockIndexCoord) GetIndexState(ctx context.Context, request *indexpb.Get
 	return nil, errors.New("mock")
 }
 
+func (m *mockIndexCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	if m.CreateIndexFunc != nil {
+		return m.CreateIndexFunc(ctx, req)
+	}
+	return nil, errors.New("mock")
+}
+
+func (m *mockIndexCoord) DescribeIndex(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	if m.DescribeIndexFunc != nil {
+		return m.DescribeIndexFunc(ctx, request)
+	}
+	return nil, errors.New("mock")
+}
+
+func (m *mockIndexCoord) GetIndexBuildProgress(ctx context.Context, request *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	if m.GetIndexBuildProgressFunc != nil {
+		return m.GetIndexBuildProgressFunc(ctx, request)
+	}
+	return nil, errors.New("mock")
+}
+
 func newMockIndexCoord() *mockIndexCoord {
 	return &mockIndexCoord{}
 }
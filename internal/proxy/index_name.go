@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// resolveIndexName returns the name of the index addressed by the index name and field name of a request.
+// The index name is the primary key to address an index, if it's empty, the only index on the field, or the only
+// index of the collection if the field name is empty too, is addressed. The cached indexes are refreshed once if
+// the index is not found, since it may be created by other proxies.
+func resolveIndexName(ctx context.Context, database, collectionName, fieldName, indexName string, indexCoord types.IndexCoord) (string, error) {
+	indexInfos, err := globalMetaCache.GetIndexInfos(ctx, database, collectionName, indexCoord)
+	if err != nil {
+		return "", err
+	}
+	name, err := resolveIndexNameFrom(indexInfos, collectionName, fieldName, indexName)
+	if errorCodeOf(err) != commonpb.ErrorCode_IndexNotExist {
+		return name, err
+	}
+
+	globalMetaCache.RemoveIndexInfos(database, collectionName)
+	indexInfos, err = globalMetaCache.GetIndexInfos(ctx, database, collectionName, indexCoord)
+	if err != nil {
+		return "", err
+	}
+	return resolveIndexNameFrom(indexInfos, collectionName, fieldName, indexName)
+}
+
+// unindexedRefreshInterval is the min interval to refresh the cached indexes of a collection for a field without
+// index, so that the searches of the field don't describe the indexes one by one.
+const unindexedRefreshInterval = 10 * time.Second
+
+// hasFieldIndex returns whether the field has an index. The cached indexes are refreshed if none is found and they're
+// older than unindexedRefreshInterval, since the index may be created by other proxies.
+func hasFieldIndex(ctx context.Context, database, collectionName string, fieldID int64, indexCoord types.IndexCoord) (bool, error) {
+	indexInfos, err := globalMetaCache.GetIndexInfos(ctx, database, collectionName, indexCoord)
+	if err != nil {
+		return false, err
+	}
+	if hasFieldIndexIn(indexInfos, fieldID) {
+		return true, nil
+	}
+
+	if !globalMetaCache.RemoveIndexInfosOlderThan(database, collectionName, unindexedRefreshInterval) {
+		return false, nil
+	}
+	indexInfos, err = globalMetaCache.GetIndexInfos(ctx, database, collectionName, indexCoord)
+	if err != nil {
+		return false, err
+	}
+	return hasFieldIndexIn(indexInfos, fieldID), nil
+}
+
+func hasFieldIndexIn(indexInfos map[string]*indexInfo, fieldID int64) bool {
+	for _, info := range indexInfos {
+		if info.fieldID == fieldID {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveIndexNameFrom(indexInfos map[string]*indexInfo, collectionName, fieldName, indexName string) (string, error) {
+	if indexName != "" {
+		info, ok := indexInfos[indexName]
+		if !ok {
+			return "", newErrWithCode(commonpb.ErrorCode_IndexNotExist, "index %s not found in collection %s", indexName, collectionName)
+		}
+		if fieldName != "" && info.fieldName != fieldName {
+			return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"index %s is built on field %s rather than field %s", indexName, info.fieldName, fieldName)
+		}
+		return indexName, nil
+	}
+
+	candidates := make([]string, 0, 1)
+	for name, info := range indexInfos {
+		if fieldName == "" || info.fieldName == fieldName {
+			candidates = append(candidates, name)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		if fieldName != "" {
+			return "", newErrWithCode(commonpb.ErrorCode_IndexNotExist, "no index found on field %s of collection %s", fieldName, collectionName)
+		}
+		return "", newErrWithCode(commonpb.ErrorCode_IndexNotExist, "no index found in collection %s", collectionName)
+	case 1:
+		return candidates[0], nil
+	default:
+		sort.Strings(candidates)
+		if fieldName != "" {
+			return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"multiple indexes [%s] found on field %s of collection %s, index name is required",
+				strings.Join(candidates, ", "), fieldName, collectionName)
+		}
+		return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"multiple indexes [%s] found in collection %s, index name is required", strings.Join(candidates, ", "), collectionName)
+	}
+}
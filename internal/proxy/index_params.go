@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// indexParamRange is the valid range of an integer index param.
+type indexParamRange struct {
+	key      string
+	min, max int
+}
+
+// vectorIndexSupport is the vector data types a vector index type can be built on and the metric types it supports.
+type vectorIndexSupport struct {
+	dataTypes []schemapb.DataType
+	metrics   []string
+}
+
+var (
+	floatVectorOnly  = []schemapb.DataType{schemapb.DataType_FloatVector}
+	binaryVectorOnly = []schemapb.DataType{schemapb.DataType_BinaryVector}
+
+	floatMetrics     = []string{indexparamcheck.L2, indexparamcheck.IP}
+	binaryMetrics    = []string{indexparamcheck.HAMMING, indexparamcheck.JACCARD, indexparamcheck.TANIMOTO}
+	binaryAllMetrics = []string{indexparamcheck.HAMMING, indexparamcheck.JACCARD, indexparamcheck.TANIMOTO,
+		indexparamcheck.SUBSTRUCTURE, indexparamcheck.SUPERSTRUCTURE}
+
+	// scalarIndexDataTypes is the scalar data types the default scalar indexes can be built on.
+	scalarIndexDataTypes = []schemapb.DataType{
+		schemapb.DataType_Bool,
+		schemapb.DataType_Int8,
+		schemapb.DataType_Int16,
+		schemapb.DataType_Int32,
+		schemapb.DataType_Int64,
+		schemapb.DataType_Float,
+		schemapb.DataType_Double,
+		schemapb.DataType_VarChar,
+	}
+
+	// vectorIndexes is the data types and metric types each vector index type supports, only the brute force
+	// binary index supports the substructure and superstructure metrics.
+	vectorIndexes = map[indexparamcheck.IndexType]vectorIndexSupport{
+		indexparamcheck.IndexFaissIDMap:      {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexFaissIvfFlat:    {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexFaissIvfPQ:      {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexFaissIvfSQ8:     {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexFaissIvfSQ8H:    {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexNSG:             {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexHNSW:            {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexRHNSWFlat:       {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexRHNSWPQ:         {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexRHNSWSQ:         {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexANNOY:           {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexNGTPANNG:        {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexNGTONNG:         {floatVectorOnly, floatMetrics},
+		indexparamcheck.IndexFaissBinIDMap:   {binaryVectorOnly, binaryAllMetrics},
+		indexparamcheck.IndexFaissBinIvfFlat: {binaryVectorOnly, binaryMetrics},
+	}
+
+	nlistRange          = indexParamRange{indexparamcheck.NLIST, indexparamcheck.MinNList, indexparamcheck.MaxNList}
+	hnswMRange          = indexParamRange{indexparamcheck.HNSWM, indexparamcheck.HNSWMinM, indexparamcheck.HNSWMaxM}
+	efConstructionRange = indexParamRange{indexparamcheck.EFConstruction, indexparamcheck.HNSWMinEfConstruction, indexparamcheck.HNSWMaxEfConstruction}
+
+	// indexRequiredParams is the params must be provided for each index type and their valid ranges,
+	// an index type absent here requires no extra params.
+	indexRequiredParams = map[indexparamcheck.IndexType][]indexParamRange{
+		indexparamcheck.IndexFaissIvfFlat:    {nlistRange},
+		indexparamcheck.IndexFaissIvfPQ:      {nlistRange},
+		indexparamcheck.IndexFaissIvfSQ8:     {nlistRange},
+		indexparamcheck.IndexFaissIvfSQ8H:    {nlistRange},
+		indexparamcheck.IndexFaissBinIvfFlat: {nlistRange},
+		indexparamcheck.IndexHNSW:            {hnswMRange, efConstructionRange},
+		indexparamcheck.IndexRHNSWFlat:       {hnswMRange, efConstructionRange},
+		indexparamcheck.IndexRHNSWPQ:         {hnswMRange, efConstructionRange},
+		indexparamcheck.IndexRHNSWSQ:         {hnswMRange, efConstructionRange},
+		indexparamcheck.IndexANNOY: {
+			{indexparamcheck.NTREES, indexparamcheck.MinNTrees, indexparamcheck.MaxNTrees},
+		},
+		indexparamcheck.IndexNSG: {
+			{indexparamcheck.KNNG, indexparamcheck.MinKNNG, indexparamcheck.MaxKNNG},
+			{indexparamcheck.SearchLength, indexparamcheck.MinSearchLength, indexparamcheck.MaxSearchLength},
+			{indexparamcheck.OutDegree, indexparamcheck.MinOutDegree, indexparamcheck.MaxOutDegree},
+			{indexparamcheck.CANDIDATE, indexparamcheck.MinCandidatePoolSize, indexparamcheck.MaxCandidatePoolSize},
+		},
+	}
+)
+
+// checkIndexableField checks an index can be built on the field, i.e. an ANN index on a vector field or a scalar
+// index on a scalar field of the supported types.
+func checkIndexableField(field *schemapb.FieldSchema) error {
+	if typeutil.IsVectorType(field.GetDataType()) || funcutil.SliceContain(scalarIndexDataTypes, field.GetDataType()) {
+		return nil
+	}
+	return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"cannot create index on field %s, the data type %s is not indexable", field.GetName(), field.GetDataType())
+}
+
+// validateIndexParams checks the index params against the field to be indexed, so that an index which can never
+// be built is rejected with a clear reason instead of failing later in indexCoord.
+func validateIndexParams(field *schemapb.FieldSchema, indexParams map[string]string) error {
+	indexType := indexParams["index_type"]
+	dataType := field.GetDataType()
+
+	if !typeutil.IsVectorType(dataType) {
+		if _, ok := vectorIndexes[indexType]; ok {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"index type %s can only be built on vector field, field %s is %s", indexType, field.GetName(), dataType)
+		}
+		return nil
+	}
+
+	supported, ok := vectorIndexes[indexType]
+	if !ok {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "invalid index type: %s", indexType)
+	}
+	if !funcutil.SliceContain(supported.dataTypes, dataType) {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"index type %s is not supported on field %s of %s", indexType, field.GetName(), dataType)
+	}
+
+	if err := validateIndexDimension(field, indexParams); err != nil {
+		return err
+	}
+
+	metricType, ok := indexParams[indexparamcheck.Metric]
+	if !ok {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "metric_type is required for index type %s", indexType)
+	}
+	if !funcutil.SliceContain(supported.metrics, metricType) {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"metric type %s is not supported by index type %s on field %s of %s, supported metric types: %v",
+			metricType, indexType, field.GetName(), dataType, supported.metrics)
+	}
+
+	required := indexRequiredParams[indexType]
+	missing := make([]string, 0, len(required))
+	for _, r := range required {
+		if _, ok := indexParams[r.key]; !ok {
+			missing = append(missing, r.key)
+		}
+	}
+	if len(missing) > 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"missing required params of index type %s: %s", indexType, strings.Join(missing, ", "))
+	}
+
+	for _, r := range required {
+		valueStr := indexParams[r.key]
+		value, err := strconv.Atoi(valueStr)
+		if err != nil || value < r.min || value > r.max {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"%s of index type %s should be an integer in range [%d, %d], got %s", r.key, indexType, r.min, r.max, valueStr)
+		}
+	}
+
+	return nil
+}
+
+// validateIndexDimension checks the dimension in the schema is valid and the one in index params is consistent with it.
+func validateIndexDimension(field *schemapb.FieldSchema, indexParams map[string]string) error {
+	params := make([]*commonpb.KeyValuePair, 0, len(field.GetTypeParams())+len(field.GetIndexParams()))
+	params = append(params, field.GetTypeParams()...)
+	params = append(params, field.GetIndexParams()...)
+	dimStr, err := funcutil.GetAttrByKeyFromRepeatedKV(indexparamcheck.DIM, params)
+	if err != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "dimension of field %s not found in schema", field.GetName())
+	}
+	dim, err := strconv.Atoi(dimStr)
+	if err != nil || dim < indexparamcheck.DefaultMinDim || dim > indexparamcheck.DefaultMaxDim {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"invalid dimension of field %s: %s", field.GetName(), dimStr)
+	}
+	if field.GetDataType() == schemapb.DataType_BinaryVector && dim%8 != 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"dimension of binary vector field %s should be multiple of 8, got %d", field.GetName(), dim)
+	}
+	if indexDim, ok := indexParams[indexparamcheck.DIM]; ok && indexDim != dimStr {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"dimension mismatch, dimension in schema: %s, dimension: %s", dimStr, indexDim)
+	}
+	return nil
+}
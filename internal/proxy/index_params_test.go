@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newIndexTestField(name string, dataType schemapb.DataType, dim string) *schemapb.FieldSchema {
+	field := &schemapb.FieldSchema{
+		Name:     name,
+		DataType: dataType,
+	}
+	if dim != "" {
+		field.TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: dim}}
+	}
+	return field
+}
+
+func Test_validateIndexParams(t *testing.T) {
+	floatVec := newIndexTestField("float_vec", schemapb.DataType_FloatVector, "128")
+	binaryVec := newIndexTestField("binary_vec", schemapb.DataType_BinaryVector, "128")
+	varChar := newIndexTestField("varchar", schemapb.DataType_VarChar, "")
+	int64Field := newIndexTestField("int64", schemapb.DataType_Int64, "")
+
+	t.Run("valid", func(t *testing.T) {
+		cases := []struct {
+			name   string
+			field  *schemapb.FieldSchema
+			params map[string]string
+		}{
+			{"ivf flat", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "1024"}},
+			{"hnsw", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "IP", "M": "16", "efConstruction": "200"}},
+			{"flat", floatVec, map[string]string{"index_type": "FLAT", "metric_type": "L2", "dim": "128"}},
+			{"bin ivf flat", binaryVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "JACCARD", "nlist": "128"}},
+			{"bin flat", binaryVec, map[string]string{"index_type": "BIN_FLAT", "metric_type": "SUBSTRUCTURE"}},
+			{"bin flat superstructure", binaryVec, map[string]string{"index_type": "BIN_FLAT", "metric_type": "SUPERSTRUCTURE"}},
+			{"ivf pq", floatVec, map[string]string{"index_type": "IVF_PQ", "metric_type": "L2", "nlist": "1024", "m": "16"}},
+			{"rhnsw sq", floatVec, map[string]string{"index_type": "RHNSW_SQ", "metric_type": "L2", "M": "16", "efConstruction": "200"}},
+			{"annoy", floatVec, map[string]string{"index_type": "ANNOY", "metric_type": "L2", "n_trees": "8"}},
+			{"nsg", floatVec, map[string]string{"index_type": "NSG", "metric_type": "L2", "knng": "20", "search_length": "40", "out_degree": "30", "candidate_pool_size": "100"}},
+			{"scalar", int64Field, map[string]string{"index_type": "scalar"}},
+			{"scalar without index type", varChar, map[string]string{}},
+		}
+		for _, c := range cases {
+			assert.NoError(t, validateIndexParams(c.field, c.params), c.name)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			name   string
+			field  *schemapb.FieldSchema
+			params map[string]string
+			reason string
+		}{
+			{"hnsw on varchar", varChar, map[string]string{"index_type": "HNSW", "metric_type": "L2", "M": "16", "efConstruction": "200"}, "only be built on vector field"},
+			{"ivf on int64", int64Field, map[string]string{"index_type": "IVF_FLAT"}, "only be built on vector field"},
+			{"unknown index type", floatVec, map[string]string{"index_type": "UNKNOWN", "metric_type": "L2"}, "invalid index type"},
+			{"float index on binary vector", binaryVec, map[string]string{"index_type": "HNSW", "metric_type": "HAMMING", "M": "16", "efConstruction": "200"}, "not supported on field"},
+			{"binary index on float vector", floatVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "L2", "nlist": "128"}, "not supported on field"},
+			{"binary metric on float vector", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "HAMMING", "nlist": "128"}, "metric type HAMMING"},
+			{"float metric on binary vector", binaryVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "L2", "nlist": "128"}, "metric type L2"},
+			{"substructure on bin ivf flat", binaryVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "SUBSTRUCTURE", "nlist": "128"}, "metric type SUBSTRUCTURE"},
+			{"superstructure on bin ivf flat", binaryVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "SUPERSTRUCTURE", "nlist": "128"}, "metric type SUPERSTRUCTURE"},
+			{"missing metric", floatVec, map[string]string{"index_type": "IVF_FLAT", "nlist": "128"}, "metric_type is required"},
+			{"missing nlist", floatVec, map[string]string{"index_type": "IVF_SQ8", "metric_type": "L2"}, "missing required params of index type IVF_SQ8: nlist"},
+			{"ivf flat missing nlist", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2"}, "missing required params of index type IVF_FLAT: nlist"},
+			{"bin ivf flat missing nlist", binaryVec, map[string]string{"index_type": "BIN_IVF_FLAT", "metric_type": "JACCARD"}, "missing required params of index type BIN_IVF_FLAT: nlist"},
+			{"zero nlist", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "0"}, "nlist"},
+			{"too large nlist", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "65537"}, "nlist"},
+			{"invalid nlist", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "abc"}, "nlist"},
+			{"missing M", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "L2", "efConstruction": "200"}, "missing required params of index type HNSW: M"},
+			{"hnsw missing all", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "L2"}, "missing required params of index type HNSW: M, efConstruction"},
+			{"annoy missing n_trees", floatVec, map[string]string{"index_type": "ANNOY", "metric_type": "L2"}, "missing required params of index type ANNOY: n_trees"},
+			{"nsg missing some", floatVec, map[string]string{"index_type": "NSG", "metric_type": "L2", "knng": "20", "out_degree": "30"}, "missing required params of index type NSG: search_length, candidate_pool_size"},
+			{"M out of range", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "L2", "M": "65", "efConstruction": "200"}, "M of index type HNSW"},
+			{"missing efConstruction", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "L2", "M": "16"}, "missing required params of index type HNSW: efConstruction"},
+			{"efConstruction out of range", floatVec, map[string]string{"index_type": "HNSW", "metric_type": "L2", "M": "16", "efConstruction": "4"}, "efConstruction of index type HNSW"},
+			{"dim mismatch", floatVec, map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "128", "dim": "64"}, "dimension mismatch"},
+			{"dim not found", newIndexTestField("float_vec", schemapb.DataType_FloatVector, ""), map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "128"}, "not found"},
+			{"invalid dim", newIndexTestField("float_vec", schemapb.DataType_FloatVector, "0"), map[string]string{"index_type": "IVF_FLAT", "metric_type": "L2", "nlist": "128"}, "invalid dimension"},
+			{"binary dim not multiple of 8", newIndexTestField("binary_vec", schemapb.DataType_BinaryVector, "12"), map[string]string{"index_type": "BIN_FLAT", "metric_type": "HAMMING"}, "multiple of 8"},
+		}
+		for _, c := range cases {
+			err := validateIndexParams(c.field, c.params)
+			assert.Error(t, err, c.name)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.name)
+			assert.Contains(t, err.Error(), c.reason, c.name)
+		}
+	})
+}
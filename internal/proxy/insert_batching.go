@@ -0,0 +1,236 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// batchingStream coalesces the inserts produced to the dml stream of a collection within a short window, the
+// insert messages of the same segment are merged, so that many tiny inserts produce fewer and larger messages.
+// Produce returns after the batch holding the messages is produced, so an insert still returns only after its data
+// is sent, and the batch is produced at once if an insert in it can't wait for the window before its deadline.
+type batchingStream struct {
+	msgstream.MsgStream
+	window         time.Duration
+	maxSize        int
+	maxMessageSize int
+
+	mu      sync.Mutex
+	pending *produceBatch
+}
+
+// produceBatch is the inserts waiting to be produced together.
+type produceBatch struct {
+	packs    []*msgstream.MsgPack
+	size     int
+	flushing bool
+	done     chan struct{}
+	err      error
+}
+
+func newBatchingStream(stream msgstream.MsgStream, window time.Duration, maxSize int, maxMessageSize int) *batchingStream {
+	return &batchingStream{
+		MsgStream:      stream,
+		window:         window,
+		maxSize:        maxSize,
+		maxMessageSize: maxMessageSize,
+	}
+}
+
+func (s *batchingStream) Produce(msgPack *msgstream.MsgPack) error {
+	if msgPack == nil || len(msgPack.Msgs) == 0 {
+		return s.MsgStream.Produce(msgPack)
+	}
+	if !isInsertPack(msgPack) {
+		// the other dml, e.g. delete, is produced after the pending inserts, as it may depend on them.
+		s.mu.Lock()
+		batch := s.pending
+		s.mu.Unlock()
+		if batch != nil {
+			s.flush(batch)
+		}
+		return s.MsgStream.Produce(msgPack)
+	}
+
+	size := 0
+	urgent := false
+	for _, msg := range msgPack.Msgs {
+		size += msgSize(msg)
+		if msg.TraceCtx() == nil {
+			continue
+		}
+		if deadline, ok := msg.TraceCtx().Deadline(); ok && time.Until(deadline) < s.window {
+			urgent = true
+		}
+	}
+
+	s.mu.Lock()
+	batch := s.pending
+	if batch == nil {
+		batch = &produceBatch{done: make(chan struct{})}
+		s.pending = batch
+		time.AfterFunc(s.window, func() { s.flush(batch) })
+	}
+	batch.packs = append(batch.packs, msgPack)
+	batch.size += size
+	full := batch.size >= s.maxSize
+	s.mu.Unlock()
+
+	if full || urgent {
+		s.flush(batch)
+	}
+	<-batch.done
+	return batch.err
+}
+
+// flush produces the batch if it's not produced yet, and waits until it's done.
+func (s *batchingStream) flush(batch *produceBatch) {
+	s.mu.Lock()
+	if s.pending == batch {
+		s.pending = nil
+	}
+	if batch.flushing {
+		s.mu.Unlock()
+		<-batch.done
+		return
+	}
+	batch.flushing = true
+	s.mu.Unlock()
+
+	batch.err = s.MsgStream.Produce(mergeInsertPacks(batch.packs, s.maxMessageSize))
+	close(batch.done)
+}
+
+func isInsertPack(msgPack *msgstream.MsgPack) bool {
+	for _, msg := range msgPack.Msgs {
+		if msg.Type() != commonpb.MsgType_Insert {
+			return false
+		}
+	}
+	return true
+}
+
+// insertMsgKey identifies the insert messages which can be merged.
+type insertMsgKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	shardName    string
+}
+
+// mergeInsertPacks merges the insert messages of the same segment in the packs, as long as the merged message
+// is not larger than maxMessageSize. The messages in the packs are not modified.
+func mergeInsertPacks(packs []*msgstream.MsgPack, maxMessageSize int) *msgstream.MsgPack {
+	if len(packs) == 1 {
+		return packs[0]
+	}
+	result := &msgstream.MsgPack{
+		BeginTs: packs[0].BeginTs,
+		EndTs:   packs[0].EndTs,
+	}
+	merging := make(map[insertMsgKey]*msgstream.InsertMsg)
+	sizes := make(map[*msgstream.InsertMsg]int)
+	for _, pack := range packs {
+		if pack.BeginTs < result.BeginTs {
+			result.BeginTs = pack.BeginTs
+		}
+		if pack.EndTs > result.EndTs {
+			result.EndTs = pack.EndTs
+		}
+		for _, msg := range pack.Msgs {
+			insertMsg := msg.(*msgstream.InsertMsg)
+			key := insertMsgKey{
+				collectionID: insertMsg.GetCollectionID(),
+				partitionID:  insertMsg.GetPartitionID(),
+				segmentID:    insertMsg.GetSegmentID(),
+				shardName:    insertMsg.GetShardName(),
+			}
+			if !insertMsg.IsColumnBased() || insertMsg.GetNumRows() == 0 {
+				result.Msgs = append(result.Msgs, insertMsg)
+				continue
+			}
+			size := msgSize(insertMsg)
+			merged, ok := merging[key]
+			if ok && sizes[merged]+size <= maxMessageSize && sameFields(merged.GetFieldsData(), insertMsg.GetFieldsData()) {
+				appendInsertMsg(merged, insertMsg)
+				sizes[merged] += size
+				continue
+			}
+			merged = cloneInsertMsg(insertMsg)
+			merging[key] = merged
+			sizes[merged] = size
+			result.Msgs = append(result.Msgs, merged)
+		}
+	}
+	return result
+}
+
+// sameFields returns true if the fields data hold the same fields in the same order.
+func sameFields(a, b []*schemapb.FieldData) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].GetFieldId() != b[i].GetFieldId() || a[i].GetType() != b[i].GetType() {
+			return false
+		}
+	}
+	return true
+}
+
+// cloneInsertMsg returns a copy of the insert message, which owns its own fields data to be appended.
+func cloneInsertMsg(msg *msgstream.InsertMsg) *msgstream.InsertMsg {
+	cloned := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            msg.Ctx,
+			BeginTimestamp: msg.BeginTimestamp,
+			EndTimestamp:   msg.EndTimestamp,
+			HashValues:     append([]uint32{}, msg.HashValues...),
+			MsgPosition:    msg.MsgPosition,
+		},
+		InsertRequest: *proto.Clone(&msg.InsertRequest).(*internalpb.InsertRequest),
+	}
+	return cloned
+}
+
+// appendInsertMsg appends the rows of src to the column based insert message dst.
+func appendInsertMsg(dst *msgstream.InsertMsg, src *msgstream.InsertMsg) {
+	typeutil.MergeFieldData(dst.FieldsData, src.GetFieldsData())
+	dst.HashValues = append(dst.HashValues, src.HashValues...)
+	dst.Timestamps = append(dst.Timestamps, src.GetTimestamps()...)
+	dst.RowIDs = append(dst.RowIDs, src.GetRowIDs()...)
+	dst.NumRows += src.GetNumRows()
+	if dst.Base != nil && src.GetBase().GetTimestamp() > dst.Base.Timestamp {
+		dst.Base.Timestamp = src.GetBase().GetTimestamp()
+	}
+	if src.BeginTimestamp < dst.BeginTimestamp {
+		dst.BeginTimestamp = src.BeginTimestamp
+	}
+	if src.EndTimestamp > dst.EndTimestamp {
+		dst.EndTimestamp = src.EndTimestamp
+	}
+}
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// recordingStream is a fake dml stream recording the produced packs.
+type recordingStream struct {
+	mockMsgStream
+	mu    sync.Mutex
+	packs []*msgstream.MsgPack
+}
+
+func (s *recordingStream) Produce(msgPack *msgstream.MsgPack) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.packs = append(s.packs, msgPack)
+	return nil
+}
+
+func (s *recordingStream) getPacks() []*msgstream.MsgPack {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*msgstream.MsgPack{}, s.packs...)
+}
+
+// newTestColumnInsertMsg returns a column based insert message of the segment holding the primary keys.
+func newTestColumnInsertMsg(ctx context.Context, segmentID UniqueID, ts Timestamp, pks ...int64) *msgstream.InsertMsg {
+	timestamps := make([]uint64, len(pks))
+	hashValues := make([]uint32, len(pks))
+	for i := range pks {
+		timestamps[i] = ts
+	}
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{Ctx: ctx, HashValues: hashValues},
+		InsertRequest: internalpb.InsertRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert, Timestamp: ts},
+			CollectionID: 1,
+			PartitionID:  2,
+			SegmentID:    segmentID,
+			ShardName:    "ch0",
+			Timestamps:   timestamps,
+			RowIDs:       append([]int64{}, pks...),
+			NumRows:      uint64(len(pks)),
+			Version:      internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: append([]int64{}, pks...)}},
+				}},
+			}},
+		},
+	}
+}
+
+func insertPack(msgs ...*msgstream.InsertMsg) *msgstream.MsgPack {
+	pack := &msgstream.MsgPack{}
+	for _, msg := range msgs {
+		pack.Msgs = append(pack.Msgs, msg)
+	}
+	return pack
+}
+
+func TestBatchingStream_coalesce(t *testing.T) {
+	inner := &recordingStream{}
+	stream := newBatchingStream(inner, 50*time.Millisecond, 1<<20, 1<<20)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			msg := newTestColumnInsertMsg(context.Background(), 10, Timestamp(i), int64(i))
+			assert.NoError(t, stream.Produce(insertPack(msg)))
+		}(i)
+	}
+	wg.Wait()
+
+	packs := inner.getPacks()
+	assert.Less(t, len(packs), 20)
+	numMsgs := 0
+	pks := make(map[int64]Timestamp)
+	for _, pack := range packs {
+		numMsgs += len(pack.Msgs)
+		for _, msg := range pack.Msgs {
+			insertMsg := msg.(*msgstream.InsertMsg)
+			data := insertMsg.GetFieldsData()[0].GetScalars().GetLongData().GetData()
+			require.Equal(t, int(insertMsg.GetNumRows()), len(data))
+			require.Equal(t, len(data), len(insertMsg.GetTimestamps()))
+			require.Equal(t, len(data), len(insertMsg.HashValues))
+			for j, pk := range data {
+				pks[pk] = insertMsg.GetTimestamps()[j]
+			}
+		}
+	}
+	// the inserts of the same segment are merged into fewer and larger messages.
+	assert.Less(t, numMsgs, 20)
+	assert.Len(t, pks, 20)
+	for pk, ts := range pks {
+		assert.Equal(t, Timestamp(pk), ts)
+	}
+}
+
+func TestBatchingStream_flush(t *testing.T) {
+	t.Run("batch full", func(t *testing.T) {
+		inner := &recordingStream{}
+		stream := newBatchingStream(inner, time.Hour, 1, 1<<20)
+		assert.NoError(t, stream.Produce(insertPack(newTestColumnInsertMsg(context.Background(), 10, 1, 1))))
+		assert.NoError(t, stream.Produce(insertPack(newTestColumnInsertMsg(context.Background(), 10, 2, 2))))
+		assert.Len(t, inner.getPacks(), 2)
+	})
+
+	t.Run("deadline within window", func(t *testing.T) {
+		inner := &recordingStream{}
+		stream := newBatchingStream(inner, time.Hour, 1<<20, 1<<20)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.NoError(t, stream.Produce(insertPack(newTestColumnInsertMsg(ctx, 10, 1, 1))))
+		assert.Len(t, inner.getPacks(), 1)
+	})
+
+	t.Run("delete after pending inserts", func(t *testing.T) {
+		inner := &recordingStream{}
+		stream := newBatchingStream(inner, time.Hour, 1<<20, 1<<20)
+		inserted := make(chan error, 1)
+		go func() {
+			inserted <- stream.Produce(insertPack(newTestColumnInsertMsg(context.Background(), 10, 1, 1)))
+		}()
+		assert.Eventually(t, func() bool {
+			stream.mu.Lock()
+			defer stream.mu.Unlock()
+			return stream.pending != nil
+		}, time.Second, time.Millisecond)
+
+		deletePack := &msgstream.MsgPack{Msgs: []msgstream.TsMsg{&msgstream.DeleteMsg{
+			DeleteRequest: internalpb.DeleteRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete}},
+		}}}
+		assert.NoError(t, stream.Produce(deletePack))
+		assert.NoError(t, <-inserted)
+
+		packs := inner.getPacks()
+		require.Len(t, packs, 2)
+		assert.Equal(t, commonpb.MsgType_Insert, packs[0].Msgs[0].Type())
+		assert.Equal(t, deletePack, packs[1])
+	})
+}
+
+func TestMergeInsertPacks(t *testing.T) {
+	ctx := context.Background()
+	msg1 := newTestColumnInsertMsg(ctx, 10, 1, 1, 2)
+	msg2 := newTestColumnInsertMsg(ctx, 10, 3, 3)
+	msg3 := newTestColumnInsertMsg(ctx, 11, 2, 4)
+	empty := newTestColumnInsertMsg(ctx, 10, 4)
+
+	merged := mergeInsertPacks([]*msgstream.MsgPack{
+		{BeginTs: 1, EndTs: 2, Msgs: []msgstream.TsMsg{msg1, msg3}},
+		{BeginTs: 3, EndTs: 3, Msgs: []msgstream.TsMsg{msg2, empty}},
+	}, 1<<20)
+	assert.Equal(t, Timestamp(1), merged.BeginTs)
+	assert.Equal(t, Timestamp(3), merged.EndTs)
+	require.Len(t, merged.Msgs, 3)
+
+	// the messages of the same segment are merged
+	segment10 := merged.Msgs[0].(*msgstream.InsertMsg)
+	assert.Equal(t, []int64{1, 2, 3}, segment10.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []uint64{1, 1, 3}, segment10.GetTimestamps())
+	assert.Equal(t, []int64{1, 2, 3}, segment10.GetRowIDs())
+	assert.Equal(t, uint64(3), segment10.GetNumRows())
+	assert.Equal(t, uint64(3), segment10.GetBase().GetTimestamp())
+	segment11 := merged.Msgs[1].(*msgstream.InsertMsg)
+	assert.Equal(t, int64(11), segment11.GetSegmentID())
+	assert.Equal(t, []int64{4}, segment11.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	// the message without rows is kept as it is
+	assert.Equal(t, empty, merged.Msgs[2])
+
+	// the source messages are not modified
+	assert.Equal(t, []int64{1, 2}, msg1.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, uint64(2), msg1.GetNumRows())
+
+	// the merged message is limited by the max message size
+	limited := mergeInsertPacks([]*msgstream.MsgPack{insertPack(msg1), insertPack(msg2)}, msgSize(msg1))
+	assert.Len(t, limited.Msgs, 2)
+
+	// a single pack is produced as it is
+	single := insertPack(msg1)
+	assert.Same(t, single, mergeInsertPacks([]*msgstream.MsgPack{single}, 1<<20))
+}
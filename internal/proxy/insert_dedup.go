@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/cache"
+)
+
+// insertDigest identifies the payload of an insert request, the retries with the same dedup token must carry the same payload.
+type insertDigest [sha256.Size]byte
+
+func newInsertDigest(request *milvuspb.InsertRequest) insertDigest {
+	h := sha256.New()
+	for _, s := range []string{request.GetDbName(), request.GetCollectionName(), request.GetPartitionName()} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, request.GetNumRows())
+	h.Write(buf)
+	for _, key := range request.GetHashKeys() {
+		binary.LittleEndian.PutUint32(buf, key)
+		h.Write(buf)
+	}
+	for _, fieldData := range request.GetFieldsData() {
+		// the field data is small compared with marshaling the whole request again, and it has no map to break the determinism
+		b, _ := proto.Marshal(fieldData)
+		h.Write(b)
+	}
+	var digest insertDigest
+	h.Sum(digest[:0])
+	return digest
+}
+
+type insertDedupEntry struct {
+	digest insertDigest
+	// done is closed once the insert finishes, result is set if it succeeded
+	done     chan struct{}
+	result   *milvuspb.MutationResult
+	expireAt time.Time
+}
+
+// insertDedupCache remembers the results of the successful insert requests by their dedup tokens, so the retries
+// of the requests return the remembered results instead of inserting the rows again.
+// The tokens are remembered by each proxy only, the retries sent to another proxy are not deduplicated, neither are
+// the retries after the results expire or are evicted.
+// A nil insertDedupCache is valid and remembers nothing.
+type insertDedupCache struct {
+	mu  sync.Mutex
+	lru *cache.LRU
+	ttl time.Duration
+}
+
+func newInsertDedupCache(size int, ttl time.Duration) (*insertDedupCache, error) {
+	lru, err := cache.NewLRU(size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &insertDedupCache{
+		lru: lru,
+		ttl: ttl,
+	}, nil
+}
+
+// acquire returns the remembered result of the insert with the token if there is one. Otherwise it returns an entry
+// which makes the retries with the token wait until the caller finishes the insert and calls finish with the entry.
+// An error is returned if the token is used by an insert with a different payload.
+func (c *insertDedupCache) acquire(ctx context.Context, token string, digest insertDigest) (*milvuspb.MutationResult, *insertDedupEntry, error) {
+	if c == nil {
+		return nil, nil, nil
+	}
+	for {
+		c.mu.Lock()
+		value, ok := c.lru.Get(token)
+		if !ok {
+			entry := &insertDedupEntry{digest: digest, done: make(chan struct{})}
+			c.lru.Add(token, entry)
+			c.mu.Unlock()
+			return nil, entry, nil
+		}
+		entry := value.(*insertDedupEntry)
+		if entry.digest != digest {
+			c.mu.Unlock()
+			return nil, nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"dedup token %s is used by another insert request with different data", token)
+		}
+		select {
+		case <-entry.done:
+			if time.Now().Before(entry.expireAt) {
+				c.mu.Unlock()
+				return entry.result, nil, nil
+			}
+			c.lru.Remove(token)
+			c.mu.Unlock()
+		default:
+			// the insert with the token is still running, wait for its result
+			c.mu.Unlock()
+			select {
+			case <-entry.done:
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			}
+		}
+	}
+}
+
+// finish remembers the result of the insert if it succeeded, otherwise the token is forgotten so the retries insert again.
+func (c *insertDedupCache) finish(token string, entry *insertDedupEntry, result *milvuspb.MutationResult) {
+	if c == nil || entry == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		entry.result = result
+		entry.expireAt = time.Now().Add(c.ttl)
+		// add it again in case it was evicted while the insert was running
+		c.lru.Add(token, entry)
+	} else if value, ok := c.lru.Get(token); ok && value == entry {
+		c.lru.Remove(token)
+	}
+	close(entry.done)
+}
+
+func (c *insertDedupCache) close() {
+	if c == nil {
+		return
+	}
+	c.lru.Close()
+}
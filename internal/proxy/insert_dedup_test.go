@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newDedupInsertRequest(token string, pks ...int64) *milvuspb.InsertRequest {
+	return &milvuspb.InsertRequest{
+		CollectionName: "coll",
+		DedupToken:     token,
+		NumRows:        uint32(len(pks)),
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "pk",
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newDedupInsertResult(pks ...int64) *milvuspb.MutationResult {
+	return &milvuspb.MutationResult{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		IDs:       &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		InsertCnt: int64(len(pks)),
+	}
+}
+
+func TestNewInsertDigest(t *testing.T) {
+	assert.Equal(t, newInsertDigest(newDedupInsertRequest("a", 1, 2)), newInsertDigest(newDedupInsertRequest("b", 1, 2)))
+	assert.NotEqual(t, newInsertDigest(newDedupInsertRequest("a", 1, 2)), newInsertDigest(newDedupInsertRequest("a", 1, 3)))
+
+	request := newDedupInsertRequest("a", 1, 2)
+	request.PartitionName = "p1"
+	assert.NotEqual(t, newInsertDigest(newDedupInsertRequest("a", 1, 2)), newInsertDigest(request))
+}
+
+func TestInsertDedupCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reuse token", func(t *testing.T) {
+		c, err := newInsertDedupCache(16, time.Minute)
+		require.NoError(t, err)
+		defer c.close()
+		digest := newInsertDigest(newDedupInsertRequest("token", 1, 2))
+
+		result, entry, err := c.acquire(ctx, "token", digest)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		require.NotNil(t, entry)
+		expected := newDedupInsertResult(1, 2)
+		c.finish("token", entry, expected)
+
+		result, entry, err = c.acquire(ctx, "token", digest)
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+		assert.Same(t, expected, result)
+	})
+
+	t.Run("different payload", func(t *testing.T) {
+		c, err := newInsertDedupCache(16, time.Minute)
+		require.NoError(t, err)
+		defer c.close()
+
+		_, entry, err := c.acquire(ctx, "token", newInsertDigest(newDedupInsertRequest("token", 1, 2)))
+		require.NoError(t, err)
+		// rejected whether the first insert is running or done
+		_, _, err = c.acquire(ctx, "token", newInsertDigest(newDedupInsertRequest("token", 1, 3)))
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		c.finish("token", entry, newDedupInsertResult(1, 2))
+		_, _, err = c.acquire(ctx, "token", newInsertDigest(newDedupInsertRequest("token", 1, 3)))
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		c, err := newInsertDedupCache(16, 10*time.Millisecond)
+		require.NoError(t, err)
+		defer c.close()
+		digest := newInsertDigest(newDedupInsertRequest("token", 1, 2))
+
+		_, entry, err := c.acquire(ctx, "token", digest)
+		require.NoError(t, err)
+		c.finish("token", entry, newDedupInsertResult(1, 2))
+		time.Sleep(20 * time.Millisecond)
+
+		result, entry, err := c.acquire(ctx, "token", digest)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.NotNil(t, entry)
+		// the expired token can be used by another payload as well
+		c.finish("token", entry, &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}})
+		_, entry, err = c.acquire(ctx, "token", newInsertDigest(newDedupInsertRequest("token", 1, 3)))
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+	})
+
+	t.Run("failed insert is forgotten", func(t *testing.T) {
+		c, err := newInsertDedupCache(16, time.Minute)
+		require.NoError(t, err)
+		defer c.close()
+		digest := newInsertDigest(newDedupInsertRequest("token", 1, 2))
+
+		_, entry, err := c.acquire(ctx, "token", digest)
+		require.NoError(t, err)
+		c.finish("token", entry, &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}})
+
+		result, entry, err := c.acquire(ctx, "token", digest)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.NotNil(t, entry)
+	})
+
+	t.Run("wait for running insert", func(t *testing.T) {
+		c, err := newInsertDedupCache(16, time.Minute)
+		require.NoError(t, err)
+		defer c.close()
+		digest := newInsertDigest(newDedupInsertRequest("token", 1, 2))
+
+		_, entry, err := c.acquire(ctx, "token", digest)
+		require.NoError(t, err)
+		expected := newDedupInsertResult(1, 2)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			c.finish("token", entry, expected)
+		}()
+
+		result, retryEntry, err := c.acquire(ctx, "token", digest)
+		assert.NoError(t, err)
+		assert.Nil(t, retryEntry)
+		assert.Same(t, expected, result)
+
+		// the retry gives up if its context is done before the running insert finishes
+		_, entry, err = c.acquire(ctx, "another", digest)
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, _, err = c.acquire(ctx, "another", digest)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		c.finish("another", entry, newDedupInsertResult(1, 2))
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *insertDedupCache
+		result, entry, err := c.acquire(ctx, "token", newInsertDigest(newDedupInsertRequest("token", 1)))
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+		assert.Nil(t, entry)
+		c.finish("token", entry, newDedupInsertResult(1))
+		c.close()
+	})
+}
+
+func TestProxy_InsertDedup(t *testing.T) {
+	c, err := newInsertDedupCache(16, time.Minute)
+	require.NoError(t, err)
+	defer c.close()
+
+	request := newDedupInsertRequest("token", 1, 2)
+	_, entry, err := c.acquire(context.Background(), "token", newInsertDigest(request))
+	require.NoError(t, err)
+	expected := newDedupInsertResult(1, 2)
+	c.finish("token", entry, expected)
+
+	node := &Proxy{insertDedupCache: c}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	// the retry returns the remembered result without inserting again
+	result, err := node.Insert(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Same(t, expected, result)
+
+	result, err = node.Insert(context.Background(), newDedupInsertRequest("token", 1, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, result.GetStatus().GetErrorCode())
+}
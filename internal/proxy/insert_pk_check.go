@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// pkCheckBatchSize is the max number of primary keys looked up by one query
+	pkCheckBatchSize = 1000
+	// maxReportedDuplicatePKs is the max number of duplicate primary keys listed in the error
+	maxReportedDuplicatePKs = 10
+)
+
+// pkQueryFunc returns the primary keys matching the expression in the collection.
+type pkQueryFunc func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error)
+
+// checkDuplicatePrimaryKeys rejects the primary keys to insert if any of them duplicates in the batch or already
+// exists in the collection. It's a best-effort check, the primary keys inserted concurrently may not be visible.
+func checkDuplicatePrimaryKeys(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, ids *schemapb.IDs, query pkQueryFunc) error {
+	var duplicates []interface{}
+	seen := make(map[interface{}]struct{})
+	num := typeutil.GetSizeOfIDs(ids)
+	for i := 0; i < num; i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		if _, ok := seen[pk]; ok {
+			duplicates = append(duplicates, pk)
+			continue
+		}
+		seen[pk] = struct{}{}
+	}
+	if len(duplicates) > 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"duplicate primary keys in the insert request: %s", formatPrimaryKeys(duplicates))
+	}
+
+	for begin := 0; begin < num; begin += pkCheckBatchSize {
+		end := begin + pkCheckBatchSize
+		if end > num {
+			end = num
+		}
+		existing, err := query(ctx, database, collectionName, pkField, pkInExpr(pkField.GetName(), ids, begin, end))
+		if err != nil {
+			return fmt.Errorf("failed to check the existence of primary keys: %w", err)
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(existing); i++ {
+			duplicates = append(duplicates, typeutil.GetPK(existing, int64(i)))
+		}
+	}
+	if len(duplicates) > 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"primary keys already exist in collection %s: %s", collectionName, formatPrimaryKeys(duplicates))
+	}
+	return nil
+}
+
+// checkInsertPrimaryKeys checks the primary keys of the insert request against the ones in the batch and the
+// collection. It must run before the insert task is enqueued: the strong consistent query waits for the time tick
+// of the collection, which doesn't advance while an insert task is pending in the dml queue.
+func (node *Proxy) checkInsertPrimaryKeys(ctx context.Context, request *milvuspb.InsertRequest) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		// leave it to the insert task to report
+		return nil
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil || pkField.GetAutoID() {
+		return nil
+	}
+	pkData, err := typeutil.GetPrimaryFieldData(request.GetFieldsData(), pkField)
+	if err != nil {
+		return nil
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return nil
+	}
+	return checkDuplicatePrimaryKeys(ctx, request.GetDbName(), request.GetCollectionName(), pkField, ids, node.queryPrimaryKeys)
+}
+
+// pkInExpr returns the expression matching the primary keys in [begin, end) of ids.
+func pkInExpr(pkName string, ids *schemapb.IDs, begin, end int) string {
+	values := make([]string, 0, end-begin)
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		for _, id := range ids.GetIntId().GetData()[begin:end] {
+			values = append(values, strconv.FormatInt(id, 10))
+		}
+	case *schemapb.IDs_StrId:
+		for _, id := range ids.GetStrId().GetData()[begin:end] {
+			values = append(values, strconv.Quote(id))
+		}
+	}
+	return fmt.Sprintf("%s in [%s]", pkName, strings.Join(values, ", "))
+}
+
+func formatPrimaryKeys(pks []interface{}) string {
+	n := len(pks)
+	if n > maxReportedDuplicatePKs {
+		pks = pks[:maxReportedDuplicatePKs]
+	}
+	strs := make([]string, 0, len(pks))
+	for _, pk := range pks {
+		strs = append(strs, fmt.Sprint(pk))
+	}
+	ret := "[" + strings.Join(strs, ", ") + "]"
+	if n > maxReportedDuplicatePKs {
+		ret += fmt.Sprintf(" and %d more", n-maxReportedDuplicatePKs)
+	}
+	return ret
+}
+
+// queryPrimaryKeys runs a strong consistent query of the primary keys bypassing the query result cache,
+// so that the primary keys just inserted are visible.
+func (node *Proxy) queryPrimaryKeys(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+	return node.queryPrimaryKeysAt(ctx, database, collectionName, pkField, expr, 0, strongTS)
+}
+
+// queryPrimaryKeysAt runs a query of the primary keys at the timestamps bypassing the query result cache.
+func (node *Proxy) queryPrimaryKeysAt(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (*schemapb.IDs, error) {
+	ids, _, err := node.runPrimaryKeyQuery(ctx, &milvuspb.QueryRequest{
+		DbName:             database,
+		CollectionName:     collectionName,
+		Expr:               expr,
+		OutputFields:       []string{pkField.GetName()},
+		TravelTimestamp:    travelTs,
+		GuaranteeTimestamp: guaranteeTs,
+	}, pkField)
+	return ids, err
+}
+
+// runPrimaryKeyQuery runs the query outputting the primary keys only, it returns the primary keys
+// and the begin timestamp of the query task.
+func (node *Proxy) runPrimaryKeyQuery(ctx context.Context, request *milvuspb.QueryRequest, pkField *schemapb.FieldSchema) (*schemapb.IDs, Timestamp, error) {
+	result, ts, err := node.runQuery(ctx, request)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(result.GetFieldsData()) == 0 {
+		return &schemapb.IDs{}, ts, nil
+	}
+	pkData, err := typeutil.GetPrimaryFieldData(result.GetFieldsData(), pkField)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ids, ts, nil
+}
+
+// runQuery runs the query bypassing the query result cache, it returns the results and the begin timestamp
+// of the query task.
+func (node *Proxy) runQuery(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, Timestamp, error) {
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyCfg.GetNodeID(),
+			},
+			ReqID: Params.ProxyCfg.GetNodeID(),
+		},
+		request:          request,
+		qc:               node.queryCoord,
+		queryShardPolicy: mergeRoundRobinPolicy,
+		shardMgr:         node.shardMgr,
+	}
+	if err := node.sched.enqueueDqTask(ctx, qt); err != nil {
+		return nil, 0, err
+	}
+	if err := qt.WaitToFinish(); err != nil {
+		return nil, 0, err
+	}
+	return qt.result, qt.BeginTs(), nil
+}
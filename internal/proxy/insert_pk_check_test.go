@@ -0,0 +1,217 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func Test_checkDuplicatePrimaryKeys(t *testing.T) {
+	ctx := context.Background()
+	int64PK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	varCharPK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+	intIDs := func(ids ...int64) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}
+	}
+	strIDs := func(ids ...string) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids}}}
+	}
+
+	t.Run("no duplicate", func(t *testing.T) {
+		var exprs []string
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+			exprs = append(exprs, expr)
+			return &schemapb.IDs{}, nil
+		}
+		assert.NoError(t, checkDuplicatePrimaryKeys(ctx, "", "coll", int64PK, intIDs(1, 2, 3), query))
+		assert.Equal(t, []string{"pk in [1, 2, 3]"}, exprs)
+
+		exprs = nil
+		assert.NoError(t, checkDuplicatePrimaryKeys(ctx, "", "coll", varCharPK, strIDs("a", `b"c`), query))
+		assert.Equal(t, []string{`pk in ["a", "b\"c"]`}, exprs)
+	})
+
+	t.Run("duplicate in request", func(t *testing.T) {
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+			t.Error("no query expected")
+			return nil, nil
+		}
+		err := checkDuplicatePrimaryKeys(ctx, "", "coll", int64PK, intIDs(1, 2, 1, 3, 2), query)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "[1, 2]")
+
+		err = checkDuplicatePrimaryKeys(ctx, "", "coll", varCharPK, strIDs("a", "a"), query)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "[a]")
+	})
+
+	t.Run("already exist", func(t *testing.T) {
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+			assert.Equal(t, "coll", collectionName)
+			return strIDs("b"), nil
+		}
+		err := checkDuplicatePrimaryKeys(ctx, "", "coll", varCharPK, strIDs("a", "b", "c"), query)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "already exist in collection coll: [b]")
+	})
+
+	t.Run("query by batch", func(t *testing.T) {
+		ids := make([]int64, pkCheckBatchSize*2+1)
+		for i := range ids {
+			ids[i] = int64(i)
+		}
+		batches := 0
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+			batches++
+			return intIDs(int64(batches * 10)), nil
+		}
+		err := checkDuplicatePrimaryKeys(ctx, "", "coll", int64PK, intIDs(ids...), query)
+		assert.Equal(t, 3, batches)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "[10, 20, 30]")
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string) (*schemapb.IDs, error) {
+			return nil, errors.New("mock")
+		}
+		err := checkDuplicatePrimaryKeys(ctx, "", "coll", int64PK, intIDs(1), query)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mock")
+	})
+}
+
+func Test_formatPrimaryKeys(t *testing.T) {
+	assert.Equal(t, "[1, 2]", formatPrimaryKeys([]interface{}{int64(1), int64(2)}))
+
+	pks := make([]interface{}, 0, maxReportedDuplicatePKs+3)
+	for i := 0; i < maxReportedDuplicatePKs+3; i++ {
+		pks = append(pks, i)
+	}
+	assert.Equal(t, "[0, 1, 2, 3, 4, 5, 6, 7, 8, 9] and 3 more", formatPrimaryKeys(pks))
+}
+
+// pkCheckQueryNode fails the query if any insert task is pending in the dml queue when the primary keys are queried
+type pkCheckQueryNode struct {
+	*QueryNodeMock
+	node *Proxy
+}
+
+func (qn *pkCheckQueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	if unissued, active := qn.node.sched.dmQueue.getTaskNum(); unissued+active > 0 {
+		return nil, errors.New("primary keys are queried with pending insert tasks")
+	}
+	return qn.QueryNodeMock.Query(ctx, req)
+}
+
+func TestProxy_InsertDuplicatePrimaryKeys(t *testing.T) {
+	Params.Init()
+	Params.ProxyCfg.InsertDuplicatePKCheck = true
+	defer func() { Params.ProxyCfg.InsertDuplicatePKCheck = false }()
+	if rateCol == nil {
+		var err error
+		rateCol, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+		require.NoError(t, err)
+	}
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	qc := NewQueryCoordMock(withValidShardLeaders())
+	rc.Start()
+	defer rc.Stop()
+	qc.Start()
+	defer qc.Stop()
+
+	node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+	qn := &pkCheckQueryNode{QueryNodeMock: &QueryNodeMock{}, node: node}
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		return qn, nil
+	}))
+	node.queryCoord = qc
+	node.shardMgr = mgr
+	require.NoError(t, InitMetaCache(ctx, rc, qc, mgr))
+	require.NoError(t, node.sched.Start())
+	defer node.sched.Close()
+
+	collectionName := t.Name() + funcutil.GenRandomStr()
+	schema := constructCollectionSchemaByDataType(collectionName, map[string]schemapb.DataType{
+		testInt64Field:    schemapb.DataType_Int64,
+		testFloatVecField: schemapb.DataType_FloatVector,
+	}, testInt64Field, false)
+	marshaledSchema, err := proto.Marshal(schema)
+	require.NoError(t, err)
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      2,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	require.NoError(t, createColT.OnEnqueue())
+	require.NoError(t, createColT.PreExecute(ctx))
+	require.NoError(t, createColT.Execute(ctx))
+	require.NoError(t, createColT.PostExecute(ctx))
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	require.NoError(t, err)
+	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{CollectionID: collectionID})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	qn.withQueryResult = &internalpb.RetrieveResults{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{2}}}},
+		FieldsData: []*schemapb.FieldData{generateFieldData(schemapb.DataType_Int64, testInt64Field, 1)},
+	}
+	qn.withQueryResult.FieldsData[0].GetScalars().GetLongData().Data = []int64{2}
+
+	nb := 3
+	pkData := generateFieldData(schemapb.DataType_Int64, testInt64Field, nb)
+	pkData.GetScalars().GetLongData().Data = []int64{1, 2, 3}
+	result, err := node.Insert(ctx, &milvuspb.InsertRequest{
+		CollectionName: collectionName,
+		FieldsData:     []*schemapb.FieldData{pkData, generateFieldData(schemapb.DataType_FloatVector, testFloatVecField, nb)},
+		HashKeys:       generateHashKeys(nb),
+		NumRows:        uint32(nb),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, result.GetStatus().GetErrorCode())
+	assert.Contains(t, result.GetStatus().GetReason(), "already exist in collection "+collectionName+": [2]")
+	assert.Equal(t, []uint32{0, 1, 2}, result.GetErrIndex())
+	unissued, active := node.sched.dmQueue.getTaskNum()
+	assert.Equal(t, 0, unissued+active)
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// validateLoadBalanceNodes checks the source node and the destination nodes of the load balance request,
+// it's done before the collection is resolved.
+func validateLoadBalanceNodes(req *milvuspb.LoadBalanceRequest) error {
+	if req.GetCollectionName() == "" {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "collection name is required to load balance")
+	}
+	if len(req.GetDstNodeIDs()) == 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "destination nodes are required to load balance")
+	}
+	for _, dstNodeID := range req.GetDstNodeIDs() {
+		if dstNodeID == req.GetSrcNodeID() {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"destination nodes %v contain the source node %d", req.GetDstNodeIDs(), req.GetSrcNodeID())
+		}
+	}
+	return nil
+}
+
+// validateLoadBalanceTargets checks the source node serves the collection, the destination nodes are in the same
+// replica of the source node, and the sealed segments to balance are on the source node.
+func validateLoadBalanceTargets(ctx context.Context, qc types.QueryCoord, req *milvuspb.LoadBalanceRequest, collectionID UniqueID) error {
+	replicasResp, err := qc.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetReplicas,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+	if err == nil && replicasResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(replicasResp.GetStatus().GetReason())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get replicas of collection %s: %w", req.GetCollectionName(), err)
+	}
+
+	var replicaNodes []int64
+	for _, replica := range replicasResp.GetReplicas() {
+		if funcutil.SliceContain(replica.GetNodeIds(), req.GetSrcNodeID()) {
+			replicaNodes = replica.GetNodeIds()
+			break
+		}
+	}
+	if replicaNodes == nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"source node %d is not serving collection %s", req.GetSrcNodeID(), req.GetCollectionName())
+	}
+	for _, dstNodeID := range req.GetDstNodeIDs() {
+		if !funcutil.SliceContain(replicaNodes, dstNodeID) {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"destination node %d is not in the replica of source node %d, nodes of the replica: %v",
+				dstNodeID, req.GetSrcNodeID(), replicaNodes)
+		}
+	}
+
+	if len(req.GetSealedSegmentIDs()) == 0 {
+		return nil
+	}
+	segmentsResp, err := qc.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+	if err == nil && segmentsResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(segmentsResp.GetStatus().GetReason())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get segments of collection %s: %w", req.GetCollectionName(), err)
+	}
+	segmentNodes := make(map[UniqueID][]int64, len(segmentsResp.GetInfos()))
+	for _, info := range segmentsResp.GetInfos() {
+		segmentNodes[info.GetSegmentID()] = info.GetNodeIds()
+	}
+	checked := typeutil.NewUniqueSet()
+	for _, segmentID := range req.GetSealedSegmentIDs() {
+		if checked.Contain(segmentID) {
+			continue
+		}
+		checked.Insert(segmentID)
+		nodes, ok := segmentNodes[segmentID]
+		if !ok {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"segment %d is not a loaded segment of collection %s", segmentID, req.GetCollectionName())
+		}
+		if !funcutil.SliceContain(nodes, req.GetSrcNodeID()) {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"segment %d is not on source node %d, it's on nodes %v", segmentID, req.GetSrcNodeID(), nodes)
+		}
+	}
+	return nil
+}
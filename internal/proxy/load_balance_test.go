@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestProxy_LoadBalance(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (UniqueID, error) {
+		if collectionName == "coll" {
+			return 1, nil
+		}
+		return 0, errors.New("collection not found")
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	qc := NewQueryCoordMock()
+	qc.updateState(internalpb.StateCode_Healthy)
+	qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		return &milvuspb.GetReplicasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: []*milvuspb.ReplicaInfo{
+				{ReplicaID: 10, CollectionID: req.GetCollectionID(), NodeIds: []int64{1, 2, 3}},
+				{ReplicaID: 11, CollectionID: req.GetCollectionID(), NodeIds: []int64{4, 5}},
+			},
+		}, nil
+	})
+	qc.SetGetSegmentInfoFunc(func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+		return &querypb.GetSegmentInfoResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Infos: []*querypb.SegmentInfo{
+				{SegmentID: 100, CollectionID: req.GetCollectionID(), NodeIds: []int64{1, 4}},
+				{SegmentID: 101, CollectionID: req.GetCollectionID(), NodeIds: []int64{2, 5}},
+			},
+		}, nil
+	})
+	var received *querypb.LoadBalanceRequest
+	qc.SetLoadBalanceFunc(func(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+		received = req
+		if req.GetDryRun() {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				Reason:    "dry run, planned movements: [segment 100: node 1 -> node 2]",
+			}, nil
+		}
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	})
+	node := &Proxy{queryCoord: qc}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	t.Run("balance", func(t *testing.T) {
+		received = nil
+		resp, err := node.LoadBalance(ctx, &milvuspb.LoadBalanceRequest{
+			CollectionName:   "coll",
+			SrcNodeID:        1,
+			DstNodeIDs:       []int64{2, 3},
+			SealedSegmentIDs: []int64{100},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		require.NotNil(t, received)
+		assert.Equal(t, int64(1), received.GetCollectionID())
+		assert.Equal(t, []int64{1}, received.GetSourceNodeIDs())
+		assert.Equal(t, []int64{2, 3}, received.GetDstNodeIDs())
+		assert.False(t, received.GetDryRun())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		received = nil
+		resp, err := node.LoadBalance(ctx, &milvuspb.LoadBalanceRequest{
+			CollectionName: "coll",
+			SrcNodeID:      1,
+			DstNodeIDs:     []int64{2},
+			DryRun:         true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, "dry run, planned movements: [segment 100: node 1 -> node 2]", resp.GetReason())
+		require.NotNil(t, received)
+		assert.True(t, received.GetDryRun())
+	})
+
+	invalidCases := []struct {
+		name   string
+		req    *milvuspb.LoadBalanceRequest
+		reason string
+	}{
+		{
+			name:   "empty collection name",
+			req:    &milvuspb.LoadBalanceRequest{SrcNodeID: 1, DstNodeIDs: []int64{2}},
+			reason: "collection name is required",
+		},
+		{
+			name:   "no destination nodes",
+			req:    &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 1},
+			reason: "destination nodes are required",
+		},
+		{
+			name:   "destination is the source",
+			req:    &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 1, DstNodeIDs: []int64{2, 1}},
+			reason: "contain the source node 1",
+		},
+		{
+			name:   "source node not serving the collection",
+			req:    &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 6, DstNodeIDs: []int64{2}},
+			reason: "source node 6 is not serving collection coll",
+		},
+		{
+			name:   "destination in another replica",
+			req:    &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 1, DstNodeIDs: []int64{2, 4}},
+			reason: "destination node 4 is not in the replica of source node 1",
+		},
+		{
+			name: "segment not exists",
+			req: &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 1, DstNodeIDs: []int64{2},
+				SealedSegmentIDs: []int64{100, 102}},
+			reason: "segment 102 is not a loaded segment of collection coll",
+		},
+		{
+			name: "segment not on the source node",
+			req: &milvuspb.LoadBalanceRequest{CollectionName: "coll", SrcNodeID: 1, DstNodeIDs: []int64{2},
+				SealedSegmentIDs: []int64{101}},
+			reason: "segment 101 is not on source node 1",
+		},
+	}
+	for _, c := range invalidCases {
+		t.Run(c.name, func(t *testing.T) {
+			received = nil
+			resp, err := node.LoadBalance(ctx, c.req)
+			require.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetErrorCode())
+			assert.Contains(t, resp.GetReason(), c.reason)
+			assert.Nil(t, received)
+		})
+	}
+
+	t.Run("collection not exists", func(t *testing.T) {
+		resp, err := node.LoadBalance(ctx, &milvuspb.LoadBalanceRequest{
+			CollectionName: "not_exists",
+			SrcNodeID:      1,
+			DstNodeIDs:     []int64{2},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("get replicas failed", func(t *testing.T) {
+		qc := NewQueryCoordMock()
+		qc.updateState(internalpb.StateCode_Healthy)
+		qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+			return nil, errors.New("mock")
+		})
+		node := &Proxy{queryCoord: qc}
+		node.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := node.LoadBalance(ctx, &milvuspb.LoadBalanceRequest{
+			CollectionName: "coll",
+			SrcNodeID:      1,
+			DstNodeIDs:     []int64{2},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Contains(t, resp.GetReason(), "failed to get replicas of collection coll")
+	})
+}
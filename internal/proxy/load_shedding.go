@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// cpuSampleInterval is how long a sample of the cpu usage is reused, sampling it for every request is too costly.
+const cpuSampleInterval = time.Second
+
+// loadShedder rejects the low priority requests proactively when the proxy is overloaded, so that the high priority
+// ones are still served instead of all the requests failing once the queue is full. A nil loadShedder sheds nothing.
+type loadShedder struct {
+	watermark   float64
+	lowPriority string // name of the task shed
+	retryAfter  time.Duration
+
+	// queueUsage and cpuUsage return the usages in range [0, 1]
+	queueUsage func() float64
+	cpuUsage   func() float64
+
+	mu         sync.Mutex
+	cpu        float64
+	cpuSampled time.Time
+}
+
+// newLoadShedder returns the loadShedder configured by Params, it returns nil if load shedding is disabled.
+func newLoadShedder(queueUsage func() float64) *loadShedder {
+	if !Params.ProxyCfg.LoadSheddingEnabled {
+		return nil
+	}
+	lowPriority := QueryTaskName
+	if Params.ProxyCfg.LoadSheddingLowPriority == "search" {
+		lowPriority = SearchTaskName
+	}
+	return &loadShedder{
+		watermark:   Params.ProxyCfg.LoadSheddingWatermark,
+		lowPriority: lowPriority,
+		retryAfter:  Params.ProxyCfg.LoadSheddingRetryAfter,
+		queueUsage:  queueUsage,
+		cpuUsage: func() float64 {
+			return metricsinfo.GetCPUUsage() / 100
+		},
+	}
+}
+
+// pressure returns the larger of the cpu usage and the queue usage.
+func (s *loadShedder) pressure() float64 {
+	s.mu.Lock()
+	if now := time.Now(); now.Sub(s.cpuSampled) >= cpuSampleInterval {
+		s.cpu = s.cpuUsage()
+		s.cpuSampled = now
+	}
+	cpu := s.cpu
+	s.mu.Unlock()
+	return math.Max(cpu, s.queueUsage())
+}
+
+// shed returns a RateLimit error if the task of the name is of low priority and the pressure reaches the watermark.
+func (s *loadShedder) shed(name string) error {
+	if s == nil || name != s.lowPriority {
+		return nil
+	}
+	if pressure := s.pressure(); pressure >= s.watermark {
+		return newErrWithCode(commonpb.ErrorCode_RateLimit,
+			"proxy is overloaded, pressure %.2f reaches the watermark %.2f, %s is rejected, please retry after %v",
+			pressure, s.watermark, name, s.retryAfter)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestLoadShedder(t *testing.T) {
+	Params.Init()
+	defer func(enabled bool, watermark float64, lowPriority string, retryAfter time.Duration) {
+		Params.ProxyCfg.LoadSheddingEnabled = enabled
+		Params.ProxyCfg.LoadSheddingWatermark = watermark
+		Params.ProxyCfg.LoadSheddingLowPriority = lowPriority
+		Params.ProxyCfg.LoadSheddingRetryAfter = retryAfter
+	}(Params.ProxyCfg.LoadSheddingEnabled, Params.ProxyCfg.LoadSheddingWatermark,
+		Params.ProxyCfg.LoadSheddingLowPriority, Params.ProxyCfg.LoadSheddingRetryAfter)
+	Params.ProxyCfg.LoadSheddingWatermark = 0.8
+	Params.ProxyCfg.LoadSheddingRetryAfter = time.Second
+
+	t.Run("disabled", func(t *testing.T) {
+		Params.ProxyCfg.LoadSheddingEnabled = false
+		queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		assert.Nil(t, queue.shedder)
+		queue.setMaxTaskNum(0)
+		assert.NoError(t, queue.shed(QueryTaskName))
+	})
+
+	Params.ProxyCfg.LoadSheddingEnabled = true
+	newQueue := func(cpu float64) *dqTaskQueue {
+		queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		require.NotNil(t, queue.shedder)
+		queue.shedder.cpuUsage = func() float64 { return cpu }
+		queue.setMaxTaskNum(10)
+		return queue
+	}
+
+	t.Run("queue depth", func(t *testing.T) {
+		Params.ProxyCfg.LoadSheddingLowPriority = "query"
+		queue := newQueue(0)
+
+		// 7 running tasks and 1 waiting task make the pressure 0.8, the watermark
+		for i := 0; i < 7; i++ {
+			queue.AddActiveTask(newDefaultMockDqlTask())
+		}
+		assert.NoError(t, queue.shed(QueryTaskName))
+		require.NoError(t, queue.Enqueue(newDefaultMockDqlTask()))
+
+		err := queue.shed(QueryTaskName)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "retry after 1s")
+		// the high priority requests are still admitted
+		assert.NoError(t, queue.shed(SearchTaskName))
+
+		queue.PopUnissuedTask()
+		assert.NoError(t, queue.shed(QueryTaskName))
+	})
+
+	t.Run("cpu usage", func(t *testing.T) {
+		Params.ProxyCfg.LoadSheddingLowPriority = "search"
+		queue := newQueue(0.9)
+		err := queue.shed(SearchTaskName)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+		assert.NoError(t, queue.shed(QueryTaskName))
+
+		// the cpu usage is sampled once per interval
+		samples := 0
+		queue.shedder.cpuUsage = func() float64 {
+			samples++
+			return 0
+		}
+		assert.Error(t, queue.shed(SearchTaskName))
+		assert.Equal(t, 0, samples)
+	})
+
+	t.Run("no room", func(t *testing.T) {
+		Params.ProxyCfg.LoadSheddingLowPriority = "query"
+		queue := newQueue(0)
+		queue.setMaxTaskNum(0)
+		assert.Error(t, queue.shed(QueryTaskName))
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -46,23 +48,44 @@ import (
 
 // Cache is the interface for system meta data cache
 type Cache interface {
+	// The collections are cached by database and name, the empty database is the default one.
+
 	// GetCollectionID get collection's id by name.
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error)
 	// GetCollectionInfo get collection's information by name, such as collection id, schema, and etc.
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
+	GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error)
 	// GetPartitionID get partition's identifier of specific collection.
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error)
 	// GetPartitions get all partitions' id of specific collection.
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
 	// GetPartitionInfo get partition's info.
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
+	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
 	// GetCollectionSchema get collection's schema.
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error)
-	ClearShards(collectionName string)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error)
+	// GetCachedCollectionName returns the name of the collection cached by the name or alias, it never accesses
+	// rootCoord, false is returned on cache miss.
+	GetCachedCollectionName(database, collectionName string) (string, bool)
+	// IsCollectionDisabled returns whether the collection is disabled by its properties.
+	IsCollectionDisabled(ctx context.Context, database, collectionName string) (bool, error)
+	// IsCollectionWriteBlocked returns whether the inserts and deletes of the collection are blocked by its properties.
+	IsCollectionWriteBlocked(ctx context.Context, database, collectionName string) (bool, error)
+	GetShards(ctx context.Context, withCache bool, database, collectionName string) (map[string][]nodeInfo, error)
+	ClearShards(database, collectionName string)
+	// RemoveCollection removes the collection cached by the name, and by its other names, i.e. its aliases or its
+	// name if collectionName is an alias, the removed names are returned.
+	RemoveCollection(ctx context.Context, database, collectionName string) []string
+	// RemoveCollectionsByID removes the collection cached by any name, the removed names are returned.
+	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string
+	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
+	// GetIndexInfos get the indexes of specific collection keyed by index name, they are fetched from indexCoord on cache miss.
+	GetIndexInfos(ctx context.Context, database, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error)
+	// RemoveIndexInfos removes the cached indexes of specific collection.
+	RemoveIndexInfos(database, collectionName string)
+	// RemoveIndexInfosOlderThan removes the cached indexes of specific collection if they're fetched longer than age ago,
+	// it returns whether they're removed.
+	RemoveIndexInfosOlderThan(database, collectionName string, age time.Duration) bool
+	// GetCollectionNum returns the number of the cached collections.
+	GetCollectionNum() int
 
 	// GetCredentialInfo operate credential cache
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
@@ -84,6 +107,12 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	isLoaded            bool
+	indexInfos          map[string]*indexInfo // nil if the indexes are not cached
+	indexInfosFetchedAt time.Time             // when indexInfos are fetched from indexCoord
+	missingPartitions   map[string]time.Time  // partitions not found in rootCoord, keyed by name with the time of the lookup
+	disabled            bool                  // the collection is disabled by the property common.CollectionDisabledKey
+	writeBlockedUntil   time.Time             // the writes are blocked until it by the property common.CollectionWriteBlockedUntilKey
+	consistencyLevel    commonpb.ConsistencyLevel
 }
 
 // CloneShardLeaders returns a copy of shard leaders
@@ -104,6 +133,55 @@ type partitionInfo struct {
 	createdUtcTimestamp uint64
 }
 
+// missingPartitionTTL is how long a partition not found in rootCoord is remembered as missing,
+// creating a partition invalidates the collection cache, so the ttl is only a safety net.
+const missingPartitionTTL = 10 * time.Second
+
+// maxMissingPartitions bounds the missing partitions remembered of a collection,
+// so that requests with arbitrary partition names can't grow the cache without limit.
+const maxMissingPartitions = 1024
+
+// addMissingPartition remembers the partition as missing, the expired entries are dropped first,
+// and the oldest entry is evicted if there are still maxMissingPartitions entries.
+func (c *collectionInfo) addMissingPartition(partitionName string, now time.Time) {
+	if c.missingPartitions == nil {
+		c.missingPartitions = make(map[string]time.Time)
+	}
+	if _, ok := c.missingPartitions[partitionName]; !ok && len(c.missingPartitions) >= maxMissingPartitions {
+		var oldestName string
+		var oldestTime time.Time
+		for name, missingTime := range c.missingPartitions {
+			if now.Sub(missingTime) >= missingPartitionTTL {
+				delete(c.missingPartitions, name)
+				continue
+			}
+			if oldestName == "" || missingTime.Before(oldestTime) {
+				oldestName, oldestTime = name, missingTime
+			}
+		}
+		if len(c.missingPartitions) >= maxMissingPartitions {
+			delete(c.missingPartitions, oldestName)
+		}
+	}
+	c.missingPartitions[partitionName] = now
+}
+
+// partitionNames returns the sorted names of the cached partitions.
+func (c *collectionInfo) partitionNames() []string {
+	names := make([]string, 0, len(c.partInfo))
+	for name := range c.partInfo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type indexInfo struct {
+	indexID   typeutil.UniqueID
+	fieldID   typeutil.UniqueID
+	fieldName string
+}
+
 // make sure MetaCache implements Cache.
 var _ Cache = (*MetaCache)(nil)
 
@@ -112,7 +190,7 @@ type MetaCache struct {
 	rootCoord  types.RootCoord
 	queryCoord types.QueryCoord
 
-	collInfo       map[string]*collectionInfo
+	collInfo       map[string]map[string]*collectionInfo // database -> collection name -> collection info
 	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
 	privilegeInfos map[string]struct{}                   // privileges cache
 	userToRoles    map[string]map[string]struct{}        // user to role cache
@@ -149,7 +227,7 @@ func NewMetaCache(rootCoord types.RootCoord, queryCoord types.QueryCoord, shardM
 	return &MetaCache{
 		rootCoord:      rootCoord,
 		queryCoord:     queryCoord,
-		collInfo:       map[string]*collectionInfo{},
+		collInfo:       map[string]map[string]*collectionInfo{},
 		credMap:        map[string]*internalpb.CredentialInfo{},
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
@@ -157,24 +235,38 @@ func NewMetaCache(rootCoord types.RootCoord, queryCoord types.QueryCoord, shardM
 	}, nil
 }
 
+// normalizeDBName returns the default database for the empty name, the requests of the clients not aware of
+// databases carry no database.
+func normalizeDBName(database string) string {
+	if database == "" {
+		return util.DefaultDBName
+	}
+	return database
+}
+
+// getCollection returns the collection cached by the database and name, the caller must hold m.mu.
+func (m *MetaCache) getCollection(database, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[normalizeDBName(database)][collectionName]
+	return collInfo, ok
+}
+
 // GetCollectionID returns the corresponding collection id for provided collection name
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
-		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GeCollectionID", metrics.CacheMissLabel).Inc()
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCollectionID", metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
+		collInfo = m.updateCollection(coll, database, collectionName)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
-		collInfo = m.collInfo[collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -185,24 +277,25 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 
 // GetCollectionInfo returns the collection information related to provided collection name
 // If the information is not found, proxy will try to fetch information for other source (RootCoord for now)
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCollectionInfo", metrics.CacheMissLabel).Inc()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		m.mu.Unlock()
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	} else {
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCollectionInfo", metrics.CacheHitLabel).Inc()
 	}
 
 	if !collInfo.isLoaded {
@@ -233,24 +326,25 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		}
 		if loaded {
 			m.mu.Lock()
-			m.collInfo[collectionName].isLoaded = true
+			if cached, ok := m.getCollection(database, collectionName); ok {
+				cached.isLoaded = true
+			}
 			m.mu.Unlock()
 		}
 	}
 
-	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCollectionInfo", metrics.CacheHitLabel).Inc()
 	return collInfo, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
 		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCollectionSchema", metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
 				zap.String("collection name ", collectionName),
@@ -259,8 +353,7 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("Reload collection from root coordinator ",
 			zap.String("collection name ", collectionName),
@@ -273,34 +366,107 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+// GetCachedCollectionName returns the name of the collection cached by the name or alias without fetching it.
+func (m *MetaCache) GetCachedCollectionName(database, collectionName string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	collInfo, ok := m.getCollection(database, collectionName)
+	if !ok || collInfo.schema.GetName() == "" {
+		return "", false
+	}
+	return collInfo.schema.GetName(), true
+}
+
+// getOrCreateCollection returns the collection cached by the database and name, an empty one is cached if
+// absent, the caller must hold m.mu.
+func (m *MetaCache) getOrCreateCollection(database, collectionName string) *collectionInfo {
+	database = normalizeDBName(database)
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = make(map[string]*collectionInfo)
+	}
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = &collectionInfo{}
+		m.collInfo[database][collectionName] = collInfo
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string) *collectionInfo {
+	collInfo := m.getOrCreateCollection(database, collectionName)
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.disabled = isCollectionDisabled(coll.Properties)
+	collInfo.writeBlockedUntil = collectionWriteBlockedUntil(coll.Properties)
+	collInfo.consistencyLevel = coll.ConsistencyLevel
+	return collInfo
+}
+
+func (m *MetaCache) IsCollectionDisabled(ctx context.Context, database, collectionName string) (bool, error) {
+	m.mu.RLock()
+	collInfo, ok := m.getCollection(database, collectionName)
+	if ok {
+		defer m.mu.RUnlock()
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "IsCollectionDisabled", metrics.CacheHitLabel).Inc()
+		return collInfo.disabled, nil
+	}
+	m.mu.RUnlock()
+
+	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "IsCollectionDisabled", metrics.CacheMissLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UpdateCache")
+	coll, err := m.describeCollection(ctx, database, collectionName)
+	if err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collInfo = m.updateCollection(coll, database, collectionName)
+	metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return collInfo.disabled, nil
+}
+
+func (m *MetaCache) IsCollectionWriteBlocked(ctx context.Context, database, collectionName string) (bool, error) {
+	m.mu.RLock()
+	collInfo, ok := m.getCollection(database, collectionName)
+	if ok {
+		defer m.mu.RUnlock()
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "IsCollectionWriteBlocked", metrics.CacheHitLabel).Inc()
+		return time.Now().Before(collInfo.writeBlockedUntil), nil
+	}
+	m.mu.RUnlock()
+
+	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "IsCollectionWriteBlocked", metrics.CacheMissLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UpdateCache")
+	coll, err := m.describeCollection(ctx, database, collectionName)
+	if err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collInfo = m.updateCollection(coll, database, collectionName)
+	metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return time.Now().Before(collInfo.writeBlockedUntil), nil
+}
+
+func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, database, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -311,7 +477,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitions", metrics.CacheMissLabel).Inc()
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -319,15 +485,15 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
-		for k, v := range partInfo {
+		collInfo, _ = m.getCollection(database, collectionName)
+		for k, v := range collInfo.partInfo {
 			ret[k] = v.partitionID
 		}
 		return ret, nil
@@ -337,23 +503,22 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitions", metrics.CacheHitLabel).Inc()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v.partitionID
 	}
 
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -361,30 +526,41 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 
 	var partInfo *partitionInfo
 	partInfo, ok = collInfo.partInfo[partitionName]
+	if !ok {
+		if missingTime, missing := collInfo.missingPartitions[partitionName]; missing && time.Since(missingTime) < missingPartitionTTL {
+			err := errPartitionNotExists(collectionName, partitionName, collInfo.partitionNames())
+			m.mu.RUnlock()
+			metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitionInfo", metrics.CacheHitLabel).Inc()
+			return nil, err
+		}
+	}
 	m.mu.RUnlock()
 
 	if !ok {
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitionInfo", metrics.CacheMissLabel).Inc()
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		collInfo, _ = m.getCollection(database, collectionName)
+		partInfo, ok = collInfo.partInfo[partitionName]
 		if !ok {
-			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
+			collInfo.addMissingPartition(partitionName, time.Now())
+			return nil, errPartitionNotExists(collectionName, partitionName, collInfo.partitionNames())
 		}
+	} else {
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitionInfo", metrics.CacheHitLabel).Inc()
 	}
-	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetPartitionInfo", metrics.CacheHitLabel).Inc()
 	return &partitionInfo{
 		partitionID:         partInfo.partitionID,
 		createdTimestamp:    partInfo.createdTimestamp,
@@ -393,11 +569,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 }
 
 // Get the collection information from rootcoord.
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, database, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 	coll, err := m.rootCoord.DescribeCollection(ctx, req)
@@ -405,7 +582,8 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		return nil, err
 	}
 	if coll.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, errors.New(coll.Status.Reason)
+		// keep the error code, so that a dropped collection can be told from the other failures
+		return nil, newErrWithCode(coll.Status.ErrorCode, "%s", coll.Status.Reason)
 	}
 	resp := &milvuspb.DescribeCollectionResponse{
 		Status: coll.Status,
@@ -420,6 +598,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		Properties:           coll.Properties,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -429,11 +608,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, database, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 
@@ -442,7 +622,7 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 		return nil, err
 	}
 	if partitions.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, fmt.Errorf("%s", partitions.Status.Reason)
+		return nil, newErrWithCode(partitions.Status.ErrorCode, "%s", partitions.Status.Reason)
 	}
 
 	if len(partitions.PartitionIDs) != len(partitions.PartitionNames) {
@@ -453,14 +633,9 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
-	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
-			partInfo: map[string]*partitionInfo{},
-		}
-	}
-	partInfo := m.collInfo[collectionName].partInfo
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string) error {
+	collInfo := m.getOrCreateCollection(database, collectionName)
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -470,7 +645,9 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 		return errors.New("partition names and timestamps number is not aligned, response " + partitions.String())
 	}
 
+	missingPartitions := collInfo.missingPartitions
 	for i := 0; i < len(partitions.PartitionIDs); i++ {
+		delete(missingPartitions, partitions.PartitionNames[i])
 		if _, ok := partInfo[partitions.PartitionNames[i]]; !ok {
 			partInfo[partitions.PartitionNames[i]] = &partitionInfo{
 				partitionID:         partitions.PartitionIDs[i],
@@ -479,38 +656,160 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
 	return nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionName string) []string {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	info, ok := m.getCollection(database, collectionName)
+	if !ok {
+		m.mu.Unlock()
+		return nil
+	}
+	delete(m.collInfo[normalizeDBName(database)], collectionName)
+	removed := map[string]*collectionInfo{collectionName: info}
+	if info.collID != 0 {
+		for name, info := range m.removeCollectionsByIDLocked(info.collID) {
+			removed[name] = info
+		}
+	}
+	m.mu.Unlock()
+	return m.releaseShards(removed)
 }
 
-func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) {
+func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	for k, v := range m.collInfo {
-		if v.collID == collectionID {
-			delete(m.collInfo, k)
+	removed := m.removeCollectionsByIDLocked(collectionID)
+	m.mu.Unlock()
+	return m.releaseShards(removed)
+}
+
+// removeCollectionsByIDLocked removes the collection cached by any name and returns the removed ones, the caller
+// must hold m.mu.
+func (m *MetaCache) removeCollectionsByIDLocked(collectionID UniqueID) map[string]*collectionInfo {
+	removed := make(map[string]*collectionInfo)
+	for _, collections := range m.collInfo {
+		for k, v := range collections {
+			if v.collID == collectionID {
+				removed[k] = v
+				delete(collections, k)
+			}
 		}
 	}
+	return removed
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+// releaseShards releases the shard leaders of the removed collections in shardClientMgr, and returns their names.
+func (m *MetaCache) releaseShards(removed map[string]*collectionInfo) []string {
+	names := make([]string, 0, len(removed))
+	for name, info := range removed {
+		names = append(names, name)
+		info.leaderMutex.Lock()
+		shardLeaders := info.shardLeaders
+		info.shardLeaders = nil
+		info.leaderMutex.Unlock()
+		if shardLeaders != nil && m.shardMgr != nil {
+			_ = m.shardMgr.UpdateShardLeaders(shardLeaders, nil)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *MetaCache) RemovePartition(ctx context.Context, database, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
-	if !ok {
+	collInfo, ok := m.getCollection(database, collectionName)
+	if !ok || collInfo.partInfo == nil {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
-	if partInfo == nil {
-		return
+	delete(collInfo.partInfo, partitionName)
+}
+
+// GetIndexInfos returns the indexes of the collection keyed by index name.
+// If the indexes are not cached, proxy will try to fetch them from indexCoord.
+func (m *MetaCache) GetIndexInfos(ctx context.Context, database, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+	collID, err := m.GetCollectionID(ctx, database, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := m.GetCollectionSchema(ctx, database, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	var indexInfos map[string]*indexInfo
+	if collInfo, ok := m.getCollection(database, collectionName); ok {
+		indexInfos = collInfo.indexInfos
+	}
+	m.mu.RUnlock()
+	if indexInfos != nil {
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetIndexInfos", metrics.CacheHitLabel).Inc()
+		return indexInfos, nil
+	}
+
+	metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetIndexInfos", metrics.CacheMissLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UpdateCache")
+	resp, err := indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: collID})
+	if err != nil {
+		return nil, err
 	}
-	delete(partInfo, partitionName)
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_IndexNotExist {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	indexInfos = make(map[string]*indexInfo, len(resp.GetIndexInfos()))
+	for _, info := range resp.GetIndexInfos() {
+		field, err := schemaHelper.GetFieldFromID(info.GetFieldID())
+		if err != nil {
+			return nil, err
+		}
+		indexInfos[info.GetIndexName()] = &indexInfo{
+			indexID:   info.GetIndexID(),
+			fieldID:   info.GetFieldID(),
+			fieldName: field.GetName(),
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if collInfo, ok := m.getCollection(database, collectionName); ok && collInfo.collID == collID {
+		collInfo.indexInfos = indexInfos
+		collInfo.indexInfosFetchedAt = time.Now()
+	}
+	metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return indexInfos, nil
+}
+
+// RemoveIndexInfos removes the cached indexes of the collection, they will be fetched again on next access.
+func (m *MetaCache) RemoveIndexInfos(database, collectionName string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if collInfo, ok := m.getCollection(database, collectionName); ok {
+		collInfo.indexInfos = nil
+	}
+}
+
+// RemoveIndexInfosOlderThan removes the cached indexes of the collection if they're fetched longer than age ago.
+// It returns false if the cached indexes are kept, true if they're removed or not cached at all.
+func (m *MetaCache) RemoveIndexInfosOlderThan(database, collectionName string, age time.Duration) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collInfo, ok := m.getCollection(database, collectionName)
+	if !ok || collInfo.indexInfos == nil {
+		return true
+	}
+	if time.Since(collInfo.indexInfosFetchedAt) < age {
+		return false
+	}
+	collInfo.indexInfos = nil
+	return true
 }
 
 // GetCredentialInfo returns the credential related to provided username
@@ -522,6 +821,7 @@ func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*in
 	m.credMut.RUnlock()
 
 	if !ok {
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCredentialInfo", metrics.CacheMissLabel).Inc()
 		req := &rootcoordpb.GetCredentialRequest{
 			Base: &commonpb.MsgBase{
 				MsgType: commonpb.MsgType_GetCredential,
@@ -536,6 +836,8 @@ func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*in
 			Username:          resp.Username,
 			EncryptedPassword: resp.Password,
 		}
+	} else {
+		metrics.ProxyCacheHitCounter.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), "GetCredentialInfo", metrics.CacheHitLabel).Inc()
 	}
 
 	return credInfo, nil
@@ -563,8 +865,8 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 }
 
 // GetShards update cache if withCache == false
-func (m *MetaCache) GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
-	info, err := m.GetCollectionInfo(ctx, collectionName)
+func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, collectionName string) (map[string][]nodeInfo, error) {
+	info, err := m.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -618,7 +920,9 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, collectionNam
 
 	// manipulate info in map, get map returns a copy of the information
 	m.mu.RLock()
-	info = m.collInfo[collectionName]
+	if cached, ok := m.getCollection(database, collectionName); ok {
+		info = cached
+	}
 	// lock leader
 	info.leaderMutex.Lock()
 	oldShards := info.shardLeaders
@@ -648,18 +952,32 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 	return shard2QueryNodes
 }
 
+// GetCollectionNum returns the number of the cached collections, the collections cached by aliases are counted
+// once for each alias.
+func (m *MetaCache) GetCollectionNum() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	num := 0
+	for _, collections := range m.collInfo {
+		num += len(collections)
+	}
+	return num
+}
+
 // ClearShards clear the shard leader cache of a collection
-func (m *MetaCache) ClearShards(collectionName string) {
-	log.Info("clearing shard cache for collection", zap.String("collectionName", collectionName))
+func (m *MetaCache) ClearShards(database, collectionName string) {
+	log.Info("clearing shard cache for collection", zap.String("database", database), zap.String("collectionName", collectionName))
 	m.mu.Lock()
-	info, ok := m.collInfo[collectionName]
+	info, ok := m.getCollection(database, collectionName)
+	var shardLeaders map[string][]nodeInfo
 	if ok {
-		m.collInfo[collectionName].shardLeaders = nil
+		shardLeaders = info.shardLeaders
+		info.shardLeaders = nil
 	}
 	m.mu.Unlock()
 	// delete refcnt in shardClientMgr
 	if ok {
-		_ = m.shardMgr.UpdateShardLeaders(info.shardLeaders, nil)
+		_ = m.shardMgr.UpdateShardLeaders(shardLeaders, nil)
 	}
 }
 
@@ -20,21 +20,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -218,24 +225,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -244,11 +251,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -258,6 +265,50 @@ func TestMetaCache_GetCollection(t *testing.T) {
 
 }
 
+func TestMetaCache_HitMissMetrics(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
+	require.NoError(t, err)
+
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	counterOf := func(cacheName, state string) float64 {
+		return testutil.ToFloat64(metrics.ProxyCacheHitCounter.WithLabelValues(nodeID, cacheName, state))
+	}
+	assertCounters := func(cacheName string, call func()) {
+		hits, misses := counterOf(cacheName, metrics.CacheHitLabel), counterOf(cacheName, metrics.CacheMissLabel)
+		// the first call misses, and the second one hits.
+		call()
+		assert.Equal(t, hits, counterOf(cacheName, metrics.CacheHitLabel), cacheName)
+		assert.Equal(t, misses+1, counterOf(cacheName, metrics.CacheMissLabel), cacheName)
+		call()
+		assert.Equal(t, hits+1, counterOf(cacheName, metrics.CacheHitLabel), cacheName)
+		assert.Equal(t, misses+1, counterOf(cacheName, metrics.CacheMissLabel), cacheName)
+	}
+
+	assertCounters("GetCollectionID", func() {
+		_, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
+		assert.NoError(t, err)
+	})
+	globalMetaCache.RemoveCollection(ctx, "", "collection1")
+	assertCounters("GetCollectionSchema", func() {
+		_, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
+		assert.NoError(t, err)
+	})
+	globalMetaCache.RemoveCollection(ctx, "", "collection1")
+	assertCounters("GetCollectionInfo", func() {
+		_, err := globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
+		assert.NoError(t, err)
+	})
+	assertCounters("GetCredentialInfo", func() {
+		_, err := globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+		assert.NoError(t, err)
+		globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "mockUser"})
+	})
+}
+
 func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	ctx := context.Background()
 	rootCoord := &MockRootCoordClientInterface{}
@@ -267,13 +318,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	rootCoord.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	rootCoord.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -297,10 +348,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -313,16 +364,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -336,29 +387,102 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, "", "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
 
+type showPartitionsCounter struct {
+	MockRootCoordClientInterface
+	showPartitionsCount int
+}
+
+func (m *showPartitionsCounter) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	m.showPartitionsCount++
+	return m.MockRootCoordClientInterface.ShowPartitions(ctx, in)
+}
+
+func TestMetaCache_GetMissingPartition(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &showPartitionsCounter{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
+	assert.Nil(t, err)
+
+	_, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "parl")
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "[par1, par2]")
+	assert.Equal(t, 1, rootCoord.showPartitionsCount)
+
+	// the missing partition is cached
+	_, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "parl")
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Equal(t, 1, rootCoord.showPartitionsCount)
+
+	// existing partitions are still served from the cache
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 1, rootCoord.showPartitionsCount)
+
+	// the missing partition is looked up again after the ttl
+	globalMetaCache.(*MetaCache).collInfo[util.DefaultDBName]["collection1"].missingPartitions["parl"] = time.Now().Add(-missingPartitionTTL)
+	_, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "parl")
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Equal(t, 2, rootCoord.showPartitionsCount)
+
+	// creating a partition invalidates the collection cache
+	globalMetaCache.RemoveCollection(ctx, "", "collection1")
+	_, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "parl")
+	assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+	assert.Equal(t, 3, rootCoord.showPartitionsCount)
+}
+
+func TestCollectionInfo_addMissingPartition(t *testing.T) {
+	now := time.Now()
+	collInfo := &collectionInfo{}
+	for i := 0; i < maxMissingPartitions; i++ {
+		collInfo.addMissingPartition(fmt.Sprintf("par%d", i), now.Add(time.Duration(i)*time.Millisecond))
+	}
+	assert.Len(t, collInfo.missingPartitions, maxMissingPartitions)
+
+	// refreshing a remembered partition evicts nothing
+	collInfo.addMissingPartition("par1", now.Add(time.Second))
+	assert.Len(t, collInfo.missingPartitions, maxMissingPartitions)
+
+	// the oldest entry is evicted when the cache is full
+	collInfo.addMissingPartition("new", now.Add(time.Second))
+	assert.Len(t, collInfo.missingPartitions, maxMissingPartitions)
+	assert.NotContains(t, collInfo.missingPartitions, "par0")
+	assert.Contains(t, collInfo.missingPartitions, "par1")
+	assert.Contains(t, collInfo.missingPartitions, "new")
+
+	// the expired entries are all dropped
+	collInfo.addMissingPartition("later", now.Add(missingPartitionTTL+time.Hour))
+	assert.Len(t, collInfo.missingPartitions, 1)
+	assert.Contains(t, collInfo.missingPartitions, "later")
+}
+
 func TestMetaCache_GetShards(t *testing.T) {
 	var (
 		ctx            = context.Background()
@@ -376,21 +500,21 @@ func TestMetaCache_GetShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("No collection in meta cache", func(t *testing.T) {
-		shards, err := globalMetaCache.GetShards(ctx, true, "non-exists")
+		shards, err := globalMetaCache.GetShards(ctx, true, "", "non-exists")
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info invalid shardLeaders", func(t *testing.T) {
 		qc.validShardLeaders = false
-		shards, err := globalMetaCache.GetShards(ctx, false, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, false, "", collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info", func(t *testing.T) {
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, "", collectionName)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
 		assert.Equal(t, 1, len(shards))
@@ -398,7 +522,7 @@ func TestMetaCache_GetShards(t *testing.T) {
 
 		// get from cache
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, "", collectionName)
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
@@ -424,26 +548,26 @@ func TestMetaCache_ClearShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("Clear with no collection info", func(t *testing.T) {
-		globalMetaCache.ClearShards("collection_not_exist")
+		globalMetaCache.ClearShards("", "collection_not_exist")
 	})
 
 	t.Run("Clear valid collection empty cache", func(t *testing.T) {
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards("", collectionName)
 	})
 
 	t.Run("Clear valid collection valid cache", func(t *testing.T) {
 
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, "", collectionName)
 		require.NoError(t, err)
 		require.NotEmpty(t, shards)
 		require.Equal(t, 1, len(shards))
 		require.Equal(t, 3, len(shards["channel-1"]))
 
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards("", collectionName)
 
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, "", collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
@@ -540,7 +664,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	assert.Nil(t, err)
 
 	t.Run("test IsCollectionLoaded", func(t *testing.T) {
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// no collectionInfo of collection1, should access RootCoord
@@ -548,7 +672,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		// not loaded, should access QueryCoord
 		assert.Equal(t, queryCoord.AccessCount, 1)
 
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// shouldn't access QueryCoord or RootCoord again
@@ -556,7 +680,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		assert.Equal(t, queryCoord.AccessCount, 1)
 
 		// test collection2 not fully loaded
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection2")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, "", "collection2")
 		assert.NoError(t, err)
 		assert.False(t, info.isLoaded)
 		// no collectionInfo of collection2, should access RootCoord
@@ -566,8 +690,8 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	})
 
 	t.Run("test RemoveCollectionLoadCache", func(t *testing.T) {
-		globalMetaCache.RemoveCollection(ctx, "collection1")
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		globalMetaCache.RemoveCollection(ctx, "", "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// should access QueryCoord
@@ -583,21 +707,21 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, shardMgr)
 	assert.Nil(t, err)
 
-	info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err := globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// no collectionInfo of collection1, should access RootCoord
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
-	globalMetaCache.RemoveCollection(ctx, "collection1")
+	globalMetaCache.RemoveCollection(ctx, "", "collection1")
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
@@ -605,9 +729,149 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 
 	globalMetaCache.RemoveCollectionsByID(ctx, UniqueID(1))
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, "", "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
 	assert.Equal(t, rootCoord.AccessCount, 3)
 }
+
+func TestMetaCache_RemoveCollectionCachedByAlias(t *testing.T) {
+	ctx := context.Background()
+	describes := 0
+	rootCoord := newMockRootCoord()
+	rootCoord.DescribeCollectionFunc = func(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+		describes++
+		// alias1 is the alias of collection1
+		return &milvuspb.DescribeCollectionResponse{
+			Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Schema:         newTestSchema(),
+			CollectionID:   1,
+			CollectionName: "collection1",
+		}, nil
+	}
+	qc := NewQueryCoordMock()
+	qc.Init()
+	qc.Start()
+	defer qc.Stop()
+	qc.validShardLeaders = true
+	mgr := newShardClientMgr()
+	require.NoError(t, InitMetaCache(ctx, rootCoord, qc, mgr))
+	node := &Proxy{}
+
+	cache := func() {
+		for _, name := range []string{"alias1", "collection1"} {
+			_, err := globalMetaCache.GetCollectionInfo(ctx, "", name)
+			require.NoError(t, err)
+			_, err = globalMetaCache.GetShards(ctx, true, "", name)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, globalMetaCache.GetCollectionNum())
+		require.NotEmpty(t, mgr.clients.data)
+	}
+
+	t.Run("invalidate by id", func(t *testing.T) {
+		cache()
+		status, err := node.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{CollectionID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, 0, globalMetaCache.GetCollectionNum())
+		// the shard leaders of both names are released
+		assert.Empty(t, mgr.clients.data)
+
+		// the next describe by the alias refetches
+		before := describes
+		_, err = globalMetaCache.GetCollectionInfo(ctx, "", "alias1")
+		assert.NoError(t, err)
+		assert.Equal(t, before+1, describes)
+	})
+
+	t.Run("invalidate by name", func(t *testing.T) {
+		cache()
+		status, err := node.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{CollectionName: "alias1"})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, 0, globalMetaCache.GetCollectionNum())
+		assert.Empty(t, mgr.clients.data)
+
+		assert.Empty(t, globalMetaCache.RemoveCollection(ctx, "", "alias1"))
+		assert.Empty(t, globalMetaCache.RemoveCollectionsByID(ctx, 1))
+	})
+}
+
+func TestMetaCache_GetIndexInfos(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := newMockRootCoord()
+	rootCoord.DescribeCollectionFunc = func(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+		return &milvuspb.DescribeCollectionResponse{
+			Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Schema:         newTestSchema(),
+			CollectionID:   1,
+			CollectionName: request.CollectionName,
+		}, nil
+	}
+	err := InitMetaCache(ctx, rootCoord, &MockQueryCoordClientInterface{}, newShardClientMgr())
+	require.NoError(t, err)
+
+	// the field of newTestSchema, the system fields such as FieldID are stripped by the cache
+	vecFieldID := 100 + int64(schemapb.DataType_FloatVector)
+	accessCount := 0
+	indexCoord := newMockIndexCoord()
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		accessCount++
+		assert.Equal(t, UniqueID(1), request.GetCollectionID())
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexInfos: []*indexpb.IndexInfo{
+				{CollectionID: 1, FieldID: vecFieldID, IndexName: "idx", IndexID: 10},
+			},
+		}, nil
+	}
+
+	indexInfos, err := globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*indexInfo{"idx": {indexID: 10, fieldID: vecFieldID, fieldName: "FloatVectorField"}}, indexInfos)
+	assert.Equal(t, 1, accessCount)
+
+	// hit the cache.
+	_, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, accessCount)
+
+	globalMetaCache.RemoveIndexInfos("", "collection1")
+	_, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, accessCount)
+
+	// no index is cached as empty.
+	globalMetaCache.RemoveIndexInfos("", "collection1")
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		accessCount++
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist},
+		}, nil
+	}
+	indexInfos, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Empty(t, indexInfos)
+	assert.Equal(t, 3, accessCount)
+
+	// the indexes fetched just now are kept.
+	assert.False(t, globalMetaCache.RemoveIndexInfosOlderThan("", "collection1", time.Minute))
+	_, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, accessCount)
+	assert.True(t, globalMetaCache.RemoveIndexInfosOlderThan("", "collection1", 0))
+	_, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, accessCount)
+
+	globalMetaCache.RemoveIndexInfos("", "collection1")
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+		}, nil
+	}
+	_, err = globalMetaCache.GetIndexInfos(ctx, "", "collection1", indexCoord)
+	assert.Error(t, err)
+}
@@ -18,11 +18,17 @@ package proxy
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -515,3 +521,133 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyCfg.GetNodeID()),
 	}, nil
 }
+
+// collectionMetricsConcurrency is the max number of collections whose stats are collected concurrently.
+const collectionMetricsConcurrency = 8
+
+// getCollectionMetrics returns the stats of all collections, including the row count, load state and index state.
+func getCollectionMetrics(
+	ctx context.Context,
+	request *milvuspb.GetMetricsRequest,
+	node *Proxy,
+) (*milvuspb.GetMetricsResponse, error) {
+	collections, err := node.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowCollections,
+			MsgID:    request.GetBase().GetMsgID(),
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if collections.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(collections.GetStatus().GetReason())
+	}
+
+	loaded, err := node.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowCollections,
+			MsgID:    request.GetBase().GetMsgID(),
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if loaded.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(loaded.GetStatus().GetReason())
+	}
+	inMemoryPercentages := make(map[UniqueID]int64, len(loaded.GetCollectionIDs()))
+	for i, collectionID := range loaded.GetCollectionIDs() {
+		inMemoryPercentages[collectionID] = loaded.GetInMemoryPercentages()[i]
+	}
+
+	collectionsMetrics := metricsinfo.CollectionsMetrics{
+		Collections: make([]metricsinfo.CollectionMetrics, len(collections.GetCollectionNames())),
+	}
+	sem := make(chan struct{}, collectionMetricsConcurrency)
+	wg := sync.WaitGroup{}
+	for i, collectionName := range collections.GetCollectionNames() {
+		metrics := &collectionsMetrics.Collections[i]
+		metrics.CollectionName = collectionName
+		metrics.CollectionID = collections.GetCollectionIds()[i]
+		metrics.InMemoryPercentage, metrics.Loaded = inMemoryPercentages[metrics.CollectionID]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			// the failure of one collection doesn't fail the others.
+			if err := fillCollectionMetrics(ctx, node, metrics); err != nil {
+				metrics.HasError = true
+				metrics.ErrorReason = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	resp, err := metricsinfo.MarshalCollectionsMetrics(&collectionsMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyCfg.GetNodeID()),
+	}, nil
+}
+
+// fillCollectionMetrics fills the row count and index states of the collection.
+func fillCollectionMetrics(ctx context.Context, node *Proxy, metrics *metricsinfo.CollectionMetrics) error {
+	stats, err := node.dataCoord.GetCollectionStatistics(ctx, &datapb.GetCollectionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetCollectionStatistics,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: metrics.CollectionID,
+	})
+	if err != nil {
+		return err
+	}
+	if stats.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to get statistics: %s", stats.GetStatus().GetReason())
+	}
+	rowCount, err := funcutil.GetAttrByKeyFromRepeatedKV("row_count", stats.GetStats())
+	if err != nil {
+		return err
+	}
+	metrics.RowCount, err = strconv.ParseInt(rowCount, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	dit := &describeIndexTask{
+		DescribeIndexRequest: &milvuspb.DescribeIndexRequest{
+			CollectionName: metrics.CollectionName,
+		},
+		indexCoord:   node.indexCoord,
+		collectionID: metrics.CollectionID,
+	}
+	if err := dit.Execute(ctx); err != nil {
+		if errorCodeOf(err) == commonpb.ErrorCode_IndexNotExist {
+			return nil
+		}
+		return err
+	}
+	for _, description := range dit.result.GetIndexDescriptions() {
+		metrics.Indexes = append(metrics.Indexes, metricsinfo.IndexMetrics{
+			IndexName:   description.GetIndexName(),
+			FieldName:   description.GetFieldName(),
+			State:       description.GetState().String(),
+			FailReason:  description.GetIndexStateFailReason(),
+			IndexedRows: description.GetIndexedRows(),
+			TotalRows:   description.GetTotalRows(),
+		})
+	}
+	return nil
+}
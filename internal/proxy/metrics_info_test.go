@@ -20,16 +20,24 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
@@ -288,3 +296,129 @@ func TestProxy_metrics(t *testing.T) {
 	dc.getMetricsFunc = nil
 	ic.getMetricsFunc = nil
 }
+
+func TestProxy_collectionMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	dc := NewDataCoordMock()
+	dc.Start()
+	defer dc.Stop()
+
+	ic := newMockIndexCoord()
+
+	createCollection := func(collectionName string) UniqueID {
+		schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, collectionName)
+		marshaledSchema, err := proto.Marshal(schema)
+		require.NoError(t, err)
+		status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+		require.NoError(t, err)
+		return collectionID
+	}
+
+	var loadedID UniqueID
+	qc := NewQueryCoordMock(SetQueryCoordShowCollectionsFunc(func(ctx context.Context, request *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+		return &querypb.ShowCollectionsResponse{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionIDs:       []int64{loadedID},
+			InMemoryPercentages: []int64{100},
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+
+	err := InitMetaCache(ctx, rc, qc, newShardClientMgr())
+	require.NoError(t, err)
+
+	loadedName := funcutil.GenRandomStr()
+	loadedID = createCollection(loadedName)
+	brokenName := funcutil.GenRandomStr()
+	brokenID := createCollection(brokenName)
+
+	dc.getCollectionStatisticsFunc = func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+		if req.GetCollectionID() == brokenID {
+			return &datapb.GetCollectionStatisticsResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+			}, nil
+		}
+		return &datapb.GetCollectionStatisticsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Stats:  []*commonpb.KeyValuePair{{Key: "row_count", Value: "10"}},
+		}, nil
+	}
+	ic.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexInfos: []*indexpb.IndexInfo{
+				{CollectionID: request.GetCollectionID(), FieldID: common.StartOfUserFieldID + 1, IndexName: "idx", IndexID: 1},
+			},
+		}, nil
+	}
+	ic.GetIndexStateFunc = func(ctx context.Context, request *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+		return &indexpb.GetIndexStateResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			State:  commonpb.IndexState_Finished,
+		}, nil
+	}
+	ic.GetIndexBuildProgressFunc = func(ctx context.Context, request *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+		return &indexpb.GetIndexBuildProgressResponse{
+			Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexedRows: 10,
+			TotalRows:   10,
+		}, nil
+	}
+
+	proxy := &Proxy{
+		rootCoord:  rc,
+		queryCoord: qc,
+		dataCoord:  dc,
+		indexCoord: ic,
+	}
+
+	req, _ := metricsinfo.ConstructRequestByMetricType(metricsinfo.CollectionStatsMetrics)
+	resp, err := getCollectionMetrics(ctx, req, proxy)
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	var metrics metricsinfo.CollectionsMetrics
+	require.NoError(t, metricsinfo.UnmarshalCollectionsMetrics(resp.GetResponse(), &metrics))
+	require.Equal(t, 2, len(metrics.Collections))
+	collections := make(map[string]metricsinfo.CollectionMetrics)
+	for _, collection := range metrics.Collections {
+		collections[collection.CollectionName] = collection
+	}
+
+	loaded := collections[loadedName]
+	assert.False(t, loaded.HasError)
+	assert.Equal(t, loadedID, loaded.CollectionID)
+	assert.Equal(t, int64(10), loaded.RowCount)
+	assert.True(t, loaded.Loaded)
+	assert.Equal(t, int64(100), loaded.InMemoryPercentage)
+	assert.Equal(t, []metricsinfo.IndexMetrics{{
+		IndexName:   "idx",
+		FieldName:   testFloatVecField,
+		State:       commonpb.IndexState_Finished.String(),
+		IndexedRows: 10,
+		TotalRows:   10,
+	}}, loaded.Indexes)
+
+	broken := collections[brokenName]
+	assert.True(t, broken.HasError)
+	assert.False(t, broken.Loaded)
+
+	// failed to show collections
+	rc.updateState(internalpb.StateCode_Abnormal)
+	_, err = getCollectionMetrics(ctx, req, proxy)
+	assert.Error(t, err)
+}
@@ -0,0 +1,231 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// MMRLambdaKey is the search param to re-rank the results of each query by Maximal Marginal Relevance.
+// It's in range [0, 1], 1 ranks the results by relevance only and 0 by diversity only.
+const MMRLambdaKey = "mmr_lambda"
+
+// vectorQueryAtFunc returns the vectors of the entities matching the expression keyed by their primary keys,
+// it queries the data visible at the travel timestamp once the guarantee timestamp is served.
+type vectorQueryAtFunc func(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema,
+	expr string, travelTs, guaranteeTs Timestamp) (map[interface{}][]float32, error)
+
+// parseMMRLambda returns the lambda of MMR re-ranking and whether the search asks for it.
+func parseMMRLambda(searchParams []*commonpb.KeyValuePair) (float64, bool, error) {
+	lambdaStr, err := funcutil.GetAttrByKeyFromRepeatedKV(MMRLambdaKey, searchParams)
+	if err != nil {
+		return 0, false, nil
+	}
+	lambda, err := strconv.ParseFloat(lambdaStr, 64)
+	if err != nil || lambda < 0 || lambda > 1 {
+		return 0, false, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be a number in range [0, 1]", MMRLambdaKey, lambdaStr)
+	}
+	return lambda, true, nil
+}
+
+// validateMMRField returns the vector field the results are re-ranked by. The vectors of the results are fetched
+// by query, so only the float vectors compared by L2 or IP are supported.
+func validateMMRField(schema *schemapb.CollectionSchema, annsField string, metricType string) (*schemapb.FieldSchema, error) {
+	var field *schemapb.FieldSchema
+	for _, f := range schema.GetFields() {
+		if f.GetName() == annsField {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "anns field %s does not exist", annsField)
+	}
+	if field.GetDataType() != schemapb.DataType_FloatVector {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s is only supported by float vector fields, %s is %s", MMRLambdaKey, annsField, field.GetDataType().String())
+	}
+	if metricType != distance.L2 && metricType != distance.IP {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s is only supported by metric type %s and %s, got %s", MMRLambdaKey, distance.L2, distance.IP, metricType)
+	}
+	return field, nil
+}
+
+// fetchResultVectors returns the vectors of the search results keyed by their primary keys.
+func fetchResultVectors(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema,
+	data *schemapb.SearchResultData, travelTs, guaranteeTs Timestamp, query vectorQueryAtFunc) (map[interface{}][]float32, error) {
+	total := len(data.GetIds().GetIntId().GetData()) + len(data.GetIds().GetStrId().GetData())
+	uniquePKs := &schemapb.IDs{}
+	seen := make(map[interface{}]struct{})
+	for i := 0; i < total; i++ {
+		pk := typeutil.GetPK(data.GetIds(), int64(i))
+		if _, ok := seen[pk]; !ok {
+			seen[pk] = struct{}{}
+			typeutil.AppendPKs(uniquePKs, pk)
+		}
+	}
+
+	vectors := make(map[interface{}][]float32, len(seen))
+	num := len(seen)
+	for begin := 0; begin < num; begin += pkCheckBatchSize {
+		end := begin + pkCheckBatchSize
+		if end > num {
+			end = num
+		}
+		batch, err := query(ctx, database, collectionName, pkField, vectorField, pkInExpr(pkField.GetName(), uniquePKs, begin, end), travelTs, guaranteeTs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the vectors of search results: %w", err)
+		}
+		for pk, vector := range batch {
+			vectors[pk] = vector
+		}
+	}
+	return vectors, nil
+}
+
+// mmrRerank reorders the results of each query greedily, the next result is the one maximizing
+// lambda * relevance - (1 - lambda) * max similarity to the results picked before it.
+// The relevance is the search score and the similarity is computed by the same metric, i.e. the inner product
+// for IP and the negative squared distance for L2. The similarity to the results without vectors is taken as 0.
+func mmrRerank(data *schemapb.SearchResultData, vectors map[interface{}][]float32, metricType string, lambda float64) {
+	similarity := func(a, b []float32) float64 {
+		var sum float64
+		for i := range a {
+			if metricType == distance.IP {
+				sum += float64(a[i]) * float64(b[i])
+			} else {
+				d := float64(a[i]) - float64(b[i])
+				sum -= d * d
+			}
+		}
+		return sum
+	}
+	relevance := func(score float32) float64 {
+		if metricType == distance.IP {
+			return float64(score)
+		}
+		// the L2 scores are distances, the smaller the more relevant
+		return -float64(score)
+	}
+
+	total := len(data.GetIds().GetIntId().GetData()) + len(data.GetIds().GetStrId().GetData())
+	if total == 0 {
+		return
+	}
+	order := make([]int64, 0, total)
+	offset := int64(0)
+	for _, topk := range data.GetTopks() {
+		candidates := make([]int64, 0, topk)
+		for i := offset; i < offset+topk; i++ {
+			candidates = append(candidates, i)
+		}
+		// maxSim[j] is the max similarity of candidates[j] to the picked results
+		maxSim := make([]float64, len(candidates))
+		for j := range maxSim {
+			maxSim[j] = math.Inf(-1)
+		}
+		for len(candidates) > 0 {
+			best, bestScore := 0, math.Inf(-1)
+			for j, c := range candidates {
+				penalty := maxSim[j]
+				if math.IsInf(penalty, -1) {
+					penalty = 0
+				}
+				score := lambda*relevance(data.GetScores()[c]) - (1-lambda)*penalty
+				if score > bestScore {
+					best, bestScore = j, score
+				}
+			}
+			picked := candidates[best]
+			order = append(order, picked)
+			candidates = append(candidates[:best], candidates[best+1:]...)
+			maxSim = append(maxSim[:best], maxSim[best+1:]...)
+
+			pickedVector, ok := vectors[typeutil.GetPK(data.GetIds(), picked)]
+			if !ok {
+				continue
+			}
+			for j, c := range candidates {
+				if vector, ok := vectors[typeutil.GetPK(data.GetIds(), c)]; ok && len(vector) == len(pickedVector) {
+					maxSim[j] = math.Max(maxSim[j], similarity(vector, pickedVector))
+				}
+			}
+		}
+		offset += topk
+	}
+
+	reorderSearchResults(data, order)
+}
+
+// queryVectorsAt implements vectorQueryAtFunc.
+func (node *Proxy) queryVectorsAt(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema,
+	expr string, travelTs, guaranteeTs Timestamp) (map[interface{}][]float32, error) {
+	result, _, err := node.runQuery(ctx, &milvuspb.QueryRequest{
+		DbName:             database,
+		CollectionName:     collectionName,
+		Expr:               expr,
+		OutputFields:       []string{pkField.GetName(), vectorField.GetName()},
+		TravelTimestamp:    travelTs,
+		GuaranteeTimestamp: guaranteeTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	vectors := make(map[interface{}][]float32)
+	if len(result.GetFieldsData()) == 0 {
+		return vectors, nil
+	}
+
+	pkData, err := typeutil.GetPrimaryFieldData(result.GetFieldsData(), pkField)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return nil, err
+	}
+	var vectorData *schemapb.VectorField
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == vectorField.GetFieldID() {
+			vectorData = fieldData.GetVectors()
+			break
+		}
+	}
+	dim := int(vectorData.GetDim())
+	data := vectorData.GetFloatVector().GetData()
+	num := typeutil.GetSizeOfIDs(ids)
+	if dim <= 0 || len(data) != num*dim {
+		return nil, fmt.Errorf("unexpected vectors of field %s, dim: %d, number of entities: %d, number of values: %d",
+			vectorField.GetName(), dim, num, len(data))
+	}
+	for i := 0; i < num; i++ {
+		vectors[typeutil.GetPK(ids, int64(i))] = data[i*dim : (i+1)*dim]
+	}
+	return vectors, nil
+}
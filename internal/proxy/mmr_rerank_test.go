@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func TestParseMMRLambda(t *testing.T) {
+	_, ok, err := parseMMRLambda(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	lambda, ok, err := parseMMRLambda([]*commonpb.KeyValuePair{{Key: MMRLambdaKey, Value: "0.7"}})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 0.7, lambda)
+
+	for _, value := range []string{"-0.1", "1.1", "abc"} {
+		_, _, err = parseMMRLambda([]*commonpb.KeyValuePair{{Key: MMRLambdaKey, Value: value}})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	}
+}
+
+func TestValidateMMRField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "float_vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "binary_vec", DataType: schemapb.DataType_BinaryVector},
+		},
+	}
+
+	field, err := validateMMRField(schema, "float_vec", distance.L2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), field.GetFieldID())
+	_, err = validateMMRField(schema, "float_vec", distance.IP)
+	assert.NoError(t, err)
+
+	_, err = validateMMRField(schema, "binary_vec", distance.HAMMING)
+	assert.Error(t, err)
+	_, err = validateMMRField(schema, "float_vec", "COSINE")
+	assert.Error(t, err)
+	_, err = validateMMRField(schema, "not_exist", distance.L2)
+	assert.Error(t, err)
+}
+
+func TestMMRRerank(t *testing.T) {
+	// the entity 2 is a near duplicate of the entity 1, the entity 3 is far from both of them
+	vectors := map[interface{}][]float32{
+		int64(1): {0, 0},
+		int64(2): {0.01, 0},
+		int64(3): {1, 1},
+	}
+	newData := func(scores ...float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     scores,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			Topks:      []int64{3},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "age",
+					FieldId:   102,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 20, 30}}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("L2", func(t *testing.T) {
+		data := newData(0.1, 0.2, 0.3)
+		mmrRerank(data, vectors, distance.L2, 0.5)
+		assert.Equal(t, []int64{1, 3, 2}, data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.1, 0.3, 0.2}, data.GetScores())
+		assert.Equal(t, []int64{10, 30, 20}, data.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{3}, data.GetTopks())
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		ipVectors := map[interface{}][]float32{
+			int64(1): {1, 0},
+			int64(2): {0.99, 0.01},
+			int64(3): {0, 1},
+		}
+		data := newData(0.9, 0.8, 0.7)
+		mmrRerank(data, ipVectors, distance.IP, 0.5)
+		assert.Equal(t, []int64{1, 3, 2}, data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.9, 0.7, 0.8}, data.GetScores())
+	})
+
+	t.Run("relevance only", func(t *testing.T) {
+		data := newData(0.1, 0.2, 0.3)
+		mmrRerank(data, vectors, distance.L2, 1)
+		assert.Equal(t, []int64{1, 2, 3}, data.GetIds().GetIntId().GetData())
+	})
+
+	t.Run("multiple queries", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Scores:     []float32{0.1, 0.2, 0.3, 0.1, 0.2},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 3, 1}}}},
+			Topks:      []int64{3, 2},
+		}
+		mmrRerank(data, vectors, distance.L2, 0.5)
+		assert.Equal(t, []int64{1, 3, 2, 3, 1}, data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3, 2}, data.GetTopks())
+	})
+
+	t.Run("varchar primary keys", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{0.1, 0.2, 0.3},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b", "c"}}}},
+			Topks:      []int64{3},
+		}
+		mmrRerank(data, map[interface{}][]float32{"a": {0, 0}, "b": {0.01, 0}, "c": {1, 1}}, distance.L2, 0.5)
+		assert.Equal(t, []string{"a", "c", "b"}, data.GetIds().GetStrId().GetData())
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		data := &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}
+		mmrRerank(data, vectors, distance.L2, 0.5)
+		assert.Nil(t, data.GetIds())
+	})
+}
+
+func TestFetchResultVectors(t *testing.T) {
+	ctx := context.Background()
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	vectorField := &schemapb.FieldSchema{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector}
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       2,
+		Scores:     []float32{0.1, 0.2, 0.1, 0.2},
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 2, 3}}}},
+		Topks:      []int64{2, 2},
+	}
+
+	var exprs []string
+	vectors, err := fetchResultVectors(ctx, "", "coll", pkField, vectorField, data, 100, 200,
+		func(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (map[interface{}][]float32, error) {
+			exprs = append(exprs, expr)
+			assert.Equal(t, Timestamp(100), travelTs)
+			assert.Equal(t, Timestamp(200), guaranteeTs)
+			return map[interface{}][]float32{int64(1): {1}, int64(3): {3}}, nil
+		})
+	assert.NoError(t, err)
+	// each primary key is looked up once
+	assert.Equal(t, []string{"pk in [1, 2, 3]"}, exprs)
+	assert.Equal(t, map[interface{}][]float32{int64(1): {1}, int64(3): {3}}, vectors)
+
+	_, err = fetchResultVectors(ctx, "", "coll", pkField, vectorField, data, 100, 200,
+		func(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (map[interface{}][]float32, error) {
+			return nil, errors.New("mock error")
+		})
+	assert.Error(t, err)
+}
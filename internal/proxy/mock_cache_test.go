@@ -2,46 +2,108 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type getCollectionIDFunc func(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
 type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
 type getCollectionInfoFunc func(ctx context.Context, collectionName string) (*collectionInfo, error)
+type getPartitionIDFunc func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
 type getUserRoleFunc func(username string) []string
+type getIndexInfosFunc func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error)
+type isCollectionDisabledFunc func(ctx context.Context, collectionName string) (bool, error)
+type isCollectionWriteBlockedFunc func(ctx context.Context, collectionName string) (bool, error)
+type getPartitionsFunc func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+type getShardsFunc func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error)
 
 type mockCache struct {
 	Cache
 	getIDFunc       getCollectionIDFunc
 	getSchemaFunc   getCollectionSchemaFunc
 	getInfoFunc     getCollectionInfoFunc
+	getPartIDFunc   getPartitionIDFunc
 	getUserRoleFunc getUserRoleFunc
+	getIndexFunc    getIndexInfosFunc
+	indexInfosFresh bool
+	isDisabledFunc  isCollectionDisabledFunc
+	isBlockedFunc   isCollectionWriteBlockedFunc
+	getPartsFunc    getPartitionsFunc
+	getShardsFunc   getShardsFunc
+
+	// removedCollections and clearedShards record the invalidated collections.
+	removedCollections []string
+	clearedShards      []string
 }
 
-func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *mockCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 	if m.getIDFunc != nil {
 		return m.getIDFunc(ctx, collectionName)
 	}
 	return 0, nil
 }
 
-func (m *mockCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *mockCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 	if m.getSchemaFunc != nil {
 		return m.getSchemaFunc(ctx, collectionName)
 	}
 	return nil, nil
 }
 
-func (m *mockCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+// GetCachedCollectionName takes the schemas returned by getSchemaFunc as the cached ones.
+func (m *mockCache) GetCachedCollectionName(database, collectionName string) (string, bool) {
+	if m.getSchemaFunc == nil {
+		return "", false
+	}
+	schema, err := m.getSchemaFunc(context.Background(), collectionName)
+	if err != nil || schema.GetName() == "" {
+		return "", false
+	}
+	return schema.GetName(), true
+}
+
+func (m *mockCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 	if m.getInfoFunc != nil {
 		return m.getInfoFunc(ctx, collectionName)
 	}
 	return nil, nil
 }
 
-func (m *mockCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *mockCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	if m.getPartIDFunc != nil {
+		return m.getPartIDFunc(ctx, collectionName, partitionName)
+	}
+	return 0, nil
+}
+
+func (m *mockCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	if m.getPartsFunc != nil {
+		return m.getPartsFunc(ctx, collectionName)
+	}
+	return nil, nil
+}
+
+func (m *mockCache) GetShards(ctx context.Context, withCache bool, database, collectionName string) (map[string][]nodeInfo, error) {
+	if m.getShardsFunc != nil {
+		return m.getShardsFunc(ctx, withCache, collectionName)
+	}
+	return nil, nil
+}
+
+func (m *mockCache) GetCollectionNum() int {
+	return 0
+}
+
+func (m *mockCache) RemoveCollection(ctx context.Context, database, collectionName string) []string {
+	m.removedCollections = append(m.removedCollections, collectionName)
+	return []string{collectionName}
+}
+
+func (m *mockCache) ClearShards(database, collectionName string) {
+	m.clearedShards = append(m.clearedShards, collectionName)
 }
 
 func (m *mockCache) GetUserRole(username string) []string {
@@ -51,6 +113,35 @@ func (m *mockCache) GetUserRole(username string) []string {
 	return []string{}
 }
 
+func (m *mockCache) GetIndexInfos(ctx context.Context, database, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+	if m.getIndexFunc != nil {
+		return m.getIndexFunc(ctx, collectionName, indexCoord)
+	}
+	return map[string]*indexInfo{}, nil
+}
+
+func (m *mockCache) RemoveIndexInfos(database, collectionName string) {
+}
+
+// RemoveIndexInfosOlderThan takes the cached indexes as stale unless indexInfosFresh is set.
+func (m *mockCache) RemoveIndexInfosOlderThan(database, collectionName string, age time.Duration) bool {
+	return !m.indexInfosFresh
+}
+
+func (m *mockCache) IsCollectionDisabled(ctx context.Context, database, collectionName string) (bool, error) {
+	if m.isDisabledFunc != nil {
+		return m.isDisabledFunc(ctx, collectionName)
+	}
+	return false, nil
+}
+
+func (m *mockCache) IsCollectionWriteBlocked(ctx context.Context, database, collectionName string) (bool, error) {
+	if m.isBlockedFunc != nil {
+		return m.isBlockedFunc(ctx, collectionName)
+	}
+	return false, nil
+}
+
 func (m *mockCache) setGetIDFunc(f getCollectionIDFunc) {
 	m.getIDFunc = f
 }
@@ -63,6 +154,30 @@ func (m *mockCache) setGetInfoFunc(f getCollectionInfoFunc) {
 	m.getInfoFunc = f
 }
 
+func (m *mockCache) setGetPartitionIDFunc(f getPartitionIDFunc) {
+	m.getPartIDFunc = f
+}
+
+func (m *mockCache) setGetIndexFunc(f getIndexInfosFunc) {
+	m.getIndexFunc = f
+}
+
+func (m *mockCache) setIsDisabledFunc(f isCollectionDisabledFunc) {
+	m.isDisabledFunc = f
+}
+
+func (m *mockCache) setIsWriteBlockedFunc(f isCollectionWriteBlockedFunc) {
+	m.isBlockedFunc = f
+}
+
+func (m *mockCache) setGetPartitionsFunc(f getPartitionsFunc) {
+	m.getPartsFunc = f
+}
+
+func (m *mockCache) setGetShardsFunc(f getShardsFunc) {
+	m.getShardsFunc = f
+}
+
 func newMockCache() *mockCache {
 	return &mockCache{}
 }
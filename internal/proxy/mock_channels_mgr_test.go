@@ -23,6 +23,10 @@ func (m *mockChannelsMgr) removeDMLStream(collectionID UniqueID) error {
 	return nil
 }
 
+func (m *mockChannelsMgr) getDmlStreamNum() (int, int) {
+	return 0, 0
+}
+
 func newMockChannelsMgr() *mockChannelsMgr {
 	return &mockChannelsMgr{}
 }
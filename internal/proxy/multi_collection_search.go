@@ -0,0 +1,378 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// AllowPartialKey is the search param allowing a search of multiple collections to return the results
+	// of the collections succeeded if some of them failed.
+	AllowPartialKey = "allow_partial"
+	// SourceCollectionFieldName is the output field of a search of multiple collections, which tells the collection
+	// every hit comes from.
+	SourceCollectionFieldName = "$collection"
+
+	// collectionNameSeparator separates the collections to search in the collection name,
+	// it never appears in a valid collection name.
+	collectionNameSeparator = ","
+)
+
+// collectionSearchFunc searches a single collection.
+type collectionSearchFunc func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+
+// splitCollectionNames returns the collections to search, there are multiple ones if they're separated by commas.
+func splitCollectionNames(collectionName string) []string {
+	if !strings.Contains(collectionName, collectionNameSeparator) {
+		return []string{collectionName}
+	}
+	names := strings.Split(collectionName, collectionNameSeparator)
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+	return names
+}
+
+// checkCollectionsSearchable makes sure the results of the collections could be merged, the vector fields searched
+// must have the same type and dim, and so do the primary keys and the output fields.
+func checkCollectionsSearchable(ctx context.Context, names []string, request *milvuspb.SearchRequest) error {
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.GetSearchParams())
+	if err != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s not found in search_params", AnnsFieldKey)
+	}
+
+	seen := make(map[string]struct{}, len(names))
+	var base *schemapb.CollectionSchema
+	for _, name := range names {
+		if err := validateCollectionName(name); err != nil {
+			return err
+		}
+		if _, ok := seen[name]; ok {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "duplicate collection %s to search", name)
+		}
+		seen[name] = struct{}{}
+
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), name)
+		if err != nil {
+			return fmt.Errorf("failed to get the schema of collection %s: %w", name, err)
+		}
+		if base == nil {
+			base = schema
+			continue
+		}
+		fieldNames := append([]string{annsField}, request.GetOutputFields()...)
+		if err := checkFieldsCompatible(base, schema, fieldNames); err != nil {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"collection %s can't be searched with collection %s: %s", name, names[0], err.Error())
+		}
+	}
+	return nil
+}
+
+// checkFieldsCompatible checks the primary keys and the named fields of two collections have the same type and dim.
+func checkFieldsCompatible(schema, other *schemapb.CollectionSchema, fieldNames []string) error {
+	fieldOf := func(schema *schemapb.CollectionSchema, name string) *schemapb.FieldSchema {
+		for _, field := range schema.GetFields() {
+			if field.GetName() == name {
+				return field
+			}
+		}
+		return nil
+	}
+
+	pk, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	otherPK, err := typeutil.GetPrimaryFieldSchema(other)
+	if err != nil {
+		return err
+	}
+	if pk.GetDataType() != otherPK.GetDataType() {
+		return fmt.Errorf("primary key type %s doesn't match %s", otherPK.GetDataType(), pk.GetDataType())
+	}
+
+	for _, name := range fieldNames {
+		field, otherField := fieldOf(schema, name), fieldOf(other, name)
+		if field == nil || otherField == nil {
+			// missing fields are reported by the search of the collection
+			continue
+		}
+		if field.GetDataType() != otherField.GetDataType() {
+			return fmt.Errorf("type %s of field %s doesn't match %s", otherField.GetDataType(), name, field.GetDataType())
+		}
+		if typeutil.IsVectorType(field.GetDataType()) {
+			dim, _ := funcutil.GetAttrByKeyFromRepeatedKV("dim", field.GetTypeParams())
+			otherDim, _ := funcutil.GetAttrByKeyFromRepeatedKV("dim", otherField.GetTypeParams())
+			if dim != otherDim {
+				return fmt.Errorf("dim %s of field %s doesn't match %s", otherDim, name, dim)
+			}
+		}
+	}
+	return nil
+}
+
+// searchCollections fans the search out to the collections concurrently and merges the results by score.
+// The same search params, including the metric type, are applied to all the collections.
+// If allow_partial is set, the results of the collections succeeded are returned with the failures in the reason.
+func searchCollections(ctx context.Context, names []string, request *milvuspb.SearchRequest, search collectionSearchFunc) (*milvuspb.SearchResults, error) {
+	if err := checkCollectionsSearchable(ctx, names, request); err != nil {
+		return nil, err
+	}
+	searchParams, err := normalizeSearchParams(request.GetSearchParams(), Params.ProxyCfg.SearchParamsStrict)
+	if err != nil {
+		return nil, err
+	}
+	queryInfo, offset, err := parseQueryInfo(searchParams)
+	if err != nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s", err.Error())
+	}
+	nq, err := getNq(request)
+	if err != nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s", err.Error())
+	}
+	allowPartial := false
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(AllowPartialKey, request.GetSearchParams()); err == nil {
+		allowPartial, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s [%s] is invalid", AllowPartialKey, value)
+		}
+	}
+
+	// every collection returns the top offset+limit hits, the offset is applied to the merged results
+	params := make([]*commonpb.KeyValuePair, 0, len(searchParams))
+	for _, kv := range searchParams {
+		switch kv.GetKey() {
+		case TopKKey, OffsetKey, AllowPartialKey:
+		default:
+			params = append(params, kv)
+		}
+	}
+	params = append(params, &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(queryInfo.GetTopk(), 10)})
+
+	results := make([]*milvuspb.SearchResults, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		subRequest := proto.Clone(request).(*milvuspb.SearchRequest)
+		subRequest.CollectionName = name
+		subRequest.SearchParams = params
+		wg.Add(1)
+		go func(i int, subRequest *milvuspb.SearchRequest) {
+			defer wg.Done()
+			results[i], errs[i] = search(ctx, subRequest)
+			if errs[i] == nil && results[i].GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				errs[i] = newErrWithCode(results[i].GetStatus().GetErrorCode(), "%s", results[i].GetStatus().GetReason())
+			}
+		}(i, subRequest)
+	}
+	wg.Wait()
+
+	var (
+		succeeded []string
+		failures  []string
+		firstErr  error
+	)
+	succeededResults := make([]*schemapb.SearchResultData, 0, len(names))
+	for i, name := range names {
+		if errs[i] != nil {
+			log.Ctx(ctx).Warn("failed to search collection", zap.String("collection", name), zap.Error(errs[i]))
+			failures = append(failures, fmt.Sprintf("%s: %s", name, errs[i].Error()))
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		succeeded = append(succeeded, name)
+		succeededResults = append(succeededResults, results[i].GetResults())
+	}
+	if len(failures) > 0 && (!allowPartial || len(succeeded) == 0) {
+		return nil, newErrWithCode(errorCodeOf(firstErr), "failed to search collections, %s", strings.Join(failures, "; "))
+	}
+
+	limit := queryInfo.GetTopk() - offset
+	merged := mergeCollectionSearchResults(succeeded, succeededResults, nq, offset, limit, queryInfo.GetMetricType())
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	if len(failures) > 0 {
+		status.Reason = "partial results, failed collections: " + strings.Join(failures, "; ")
+	}
+	return &milvuspb.SearchResults{
+		Status:         status,
+		Results:        merged,
+		CollectionName: strings.Join(names, collectionNameSeparator),
+	}, nil
+}
+
+// mergeCollectionSearchResults merges the sorted results of the collections by score, and appends the output
+// field SourceCollectionFieldName to tell where the hits come from. Unlike the results of shards, the primary keys
+// of different collections are not deduplicated.
+func mergeCollectionSearchResults(names []string, results []*schemapb.SearchResultData, nq, offset, limit int64, metricType string) *schemapb.SearchResultData {
+	tr := timerecord.NewTimeRecorder("mergeCollectionSearchResults")
+	defer tr.Elapse("done")
+
+	positivelyRelated := distance.PositivelyRelated(metricType)
+	better := func(a, b float32) bool {
+		if positivelyRelated {
+			return a > b
+		}
+		return a < b
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		Scores:     []float32{},
+		Ids:        &schemapb.IDs{},
+		Topks:      []int64{},
+	}
+
+	// the output fields are matched by name, as the field ids differ among collections
+	var fieldNames []string
+	fieldIndex := make(map[string]int)
+	for _, result := range results {
+		for _, field := range result.GetFieldsData() {
+			if _, ok := fieldIndex[field.GetFieldName()]; !ok {
+				fieldIndex[field.GetFieldName()] = len(fieldNames)
+				fieldNames = append(fieldNames, field.GetFieldName())
+			}
+		}
+	}
+	fieldsData := make([]*schemapb.FieldData, len(fieldNames))
+	sources := make([]string, 0)
+
+	// offsets of the results of each query in each collection
+	queryOffsets := make([][]int64, len(results))
+	for i, result := range results {
+		queryOffsets[i] = make([]int64, nq+1)
+		for q := int64(0); q < nq; q++ {
+			var topk int64
+			if q < int64(len(result.GetTopks())) {
+				topk = result.GetTopks()[q]
+			}
+			queryOffsets[i][q+1] = queryOffsets[i][q] + topk
+		}
+	}
+
+	cursors := make([]int64, len(results))
+	var realTopK int64
+	for q := int64(0); q < nq; q++ {
+		for i := range results {
+			cursors[i] = queryOffsets[i][q]
+		}
+		var selected int64
+		for skipped := int64(0); selected < limit; {
+			best := -1
+			for i, result := range results {
+				if cursors[i] >= queryOffsets[i][q+1] {
+					continue
+				}
+				if best == -1 || better(result.GetScores()[cursors[i]], results[best].GetScores()[cursors[best]]) {
+					best = i
+				}
+			}
+			if best == -1 {
+				break
+			}
+			idx := cursors[best]
+			cursors[best]++
+			if skipped < offset {
+				skipped++
+				continue
+			}
+
+			result := results[best]
+			for _, field := range result.GetFieldsData() {
+				j := fieldIndex[field.GetFieldName()]
+				dst := fieldsData[j : j+1]
+				typeutil.AppendFieldData(dst, []*schemapb.FieldData{field}, idx)
+			}
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(result.GetIds(), idx))
+			ret.Scores = append(ret.Scores, result.GetScores()[idx])
+			sources = append(sources, names[best])
+			selected++
+		}
+		ret.Topks = append(ret.Topks, selected)
+		realTopK = selected
+	}
+	ret.TopK = realTopK
+
+	// fields of which no hit is selected are left out
+	ret.FieldsData = make([]*schemapb.FieldData, 0, len(fieldsData)+1)
+	for _, field := range fieldsData {
+		if field != nil {
+			ret.FieldsData = append(ret.FieldsData, field)
+		}
+	}
+	ret.FieldsData = append(ret.FieldsData, &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: SourceCollectionFieldName,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: sources},
+				},
+			},
+		},
+	})
+	return ret
+}
+
+// searchCollection searches a single collection through the dqQueue.
+func (node *Proxy) searchCollection(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	qt := &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Search,
+				SourceID: Params.ProxyCfg.GetNodeID(),
+			},
+			ReqID: Params.ProxyCfg.GetNodeID(),
+		},
+		request:        request,
+		qc:             node.queryCoord,
+		ic:             node.indexCoord,
+		tr:             timerecord.NewTimeRecorder("search"),
+		shardMgr:       node.shardMgr,
+		queryPKsAt:     node.queryPrimaryKeysAt,
+		queryVectorsAt: node.queryVectorsAt,
+	}
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		return nil, err
+	}
+	if err := qt.WaitToFinish(); err != nil {
+		return nil, err
+	}
+	return qt.result, nil
+}
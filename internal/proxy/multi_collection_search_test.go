@@ -0,0 +1,283 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func Test_splitCollectionNames(t *testing.T) {
+	assert.Equal(t, []string{"logs"}, splitCollectionNames("logs"))
+	assert.Equal(t, []string{"logs_2024_01", "logs_2024_02"}, splitCollectionNames("logs_2024_01, logs_2024_02"))
+}
+
+// collectionResult builds the sorted search results of a collection, every query has the given scores,
+// the ids are the scores multiplied by 10 and the output field "tag" is the id plus the offset.
+func collectionResult(scores [][]float32, tagOffset int64) *schemapb.SearchResultData {
+	result := &schemapb.SearchResultData{
+		NumQueries: int64(len(scores)),
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}},
+	}
+	var tags []int64
+	for _, queryScores := range scores {
+		for _, score := range queryScores {
+			id := int64(math.Round(float64(score) * 10))
+			result.Ids.GetIntId().Data = append(result.Ids.GetIntId().Data, id)
+			result.Scores = append(result.Scores, score)
+			tags = append(tags, id+tagOffset)
+		}
+		result.Topks = append(result.Topks, int64(len(queryScores)))
+		result.TopK = int64(len(queryScores))
+	}
+	result.FieldsData = []*schemapb.FieldData{{
+		Type:      schemapb.DataType_Int64,
+		FieldName: "tag",
+		FieldId:   tagOffset,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tags}},
+			},
+		},
+	}}
+	return result
+}
+
+func Test_mergeCollectionSearchResults(t *testing.T) {
+	names := []string{"c1", "c2", "c3"}
+
+	t.Run("L2", func(t *testing.T) {
+		results := []*schemapb.SearchResultData{
+			collectionResult([][]float32{{0.1, 0.4, 0.7}, {0.2}}, 100),
+			collectionResult([][]float32{{0.2, 0.3}, {}}, 200),
+			collectionResult([][]float32{{0.5}, {0.1, 0.3}}, 300),
+		}
+		merged := mergeCollectionSearchResults(names, results, 2, 0, 3, distance.L2)
+
+		assert.Equal(t, int64(2), merged.GetNumQueries())
+		assert.Equal(t, []int64{3, 3}, merged.GetTopks())
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.1, 0.2, 0.3}, merged.GetScores())
+		assert.Equal(t, []int64{1, 2, 3, 1, 2, 3}, merged.GetIds().GetIntId().GetData())
+		require.Len(t, merged.GetFieldsData(), 2)
+		assert.Equal(t, "tag", merged.GetFieldsData()[0].GetFieldName())
+		assert.Equal(t, []int64{101, 202, 203, 301, 102, 303}, merged.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, SourceCollectionFieldName, merged.GetFieldsData()[1].GetFieldName())
+		assert.Equal(t, []string{"c1", "c2", "c2", "c3", "c1", "c3"}, merged.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("IP with offset", func(t *testing.T) {
+		results := []*schemapb.SearchResultData{
+			collectionResult([][]float32{{0.9, 0.5}}, 100),
+			collectionResult([][]float32{{0.8, 0.7, 0.1}}, 200),
+		}
+		merged := mergeCollectionSearchResults(names[:2], results, 1, 1, 3, distance.IP)
+
+		assert.Equal(t, []int64{3}, merged.GetTopks())
+		assert.Equal(t, []float32{0.8, 0.7, 0.5}, merged.GetScores())
+		assert.Equal(t, []string{"c2", "c2", "c1"}, merged.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("fewer hits than limit", func(t *testing.T) {
+		results := []*schemapb.SearchResultData{
+			collectionResult([][]float32{{0.9}}, 100),
+			{NumQueries: 1, Topks: []int64{0}, Ids: &schemapb.IDs{}},
+		}
+		merged := mergeCollectionSearchResults(names[:2], results, 1, 0, 10, distance.IP)
+
+		assert.Equal(t, []int64{1}, merged.GetTopks())
+		assert.Equal(t, int64(1), merged.GetTopK())
+		assert.Equal(t, []int64{9}, merged.GetIds().GetIntId().GetData())
+	})
+
+	t.Run("no hits", func(t *testing.T) {
+		merged := mergeCollectionSearchResults(names[:1], []*schemapb.SearchResultData{{NumQueries: 1, Topks: []int64{0}}}, 1, 0, 10, distance.IP)
+		assert.Equal(t, []int64{0}, merged.GetTopks())
+		// only the source collection field is returned
+		require.Len(t, merged.GetFieldsData(), 1)
+		assert.Empty(t, merged.GetFieldsData()[0].GetScalars().GetStringData().GetData())
+	})
+}
+
+func Test_searchCollections(t *testing.T) {
+	ctx := context.Background()
+	schemaOf := func(name string, dim int) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Name: name,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: strconv.Itoa(dim)},
+				}},
+			},
+		}
+	}
+	cache := &mockCache{}
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		switch collectionName {
+		case "c1", "c2", "c3":
+			return schemaOf(collectionName, 8), nil
+		case "c16":
+			return schemaOf(collectionName, 16), nil
+		}
+		return nil, errors.New("collection not found")
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	placeholderGroup, err := proto.Marshal(constructPlaceholderGroup(1, 8))
+	require.NoError(t, err)
+	newRequest := func(collectionName string, params ...*commonpb.KeyValuePair) *milvuspb.SearchRequest {
+		return &milvuspb.SearchRequest{
+			CollectionName:   collectionName,
+			Nq:               1,
+			PlaceholderGroup: placeholderGroup,
+			SearchParams: append([]*commonpb.KeyValuePair{
+				{Key: AnnsFieldKey, Value: "vec"},
+				{Key: TopKKey, Value: "2"},
+				{Key: OffsetKey, Value: "1"},
+				{Key: MetricTypeKey, Value: distance.L2},
+				{Key: SearchParamsKey, Value: `{"nprobe": 10}`},
+			}, params...),
+		}
+	}
+
+	var mu sync.Mutex
+	var searched []string
+	search := func(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		mu.Lock()
+		searched = append(searched, request.GetCollectionName())
+		mu.Unlock()
+
+		// every collection searches the top offset+limit without offset
+		topk, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, request.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "3", topk)
+		_, err = funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, request.GetSearchParams())
+		assert.Error(t, err)
+		_, err = funcutil.GetAttrByKeyFromRepeatedKV(AllowPartialKey, request.GetSearchParams())
+		assert.Error(t, err)
+
+		switch request.GetCollectionName() {
+		case "c1":
+			return &milvuspb.SearchResults{
+				Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Results: collectionResult([][]float32{{0.1, 0.3, 0.5}}, 100),
+			}, nil
+		case "c2":
+			return &milvuspb.SearchResults{
+				Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Results: collectionResult([][]float32{{0.2, 0.4, 0.6}}, 200),
+			}, nil
+		case "c3":
+			return &milvuspb.SearchResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotLoaded, Reason: "not loaded"},
+			}, nil
+		}
+		return nil, errors.New("unexpected collection")
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		searched = nil
+		result, err := searchCollections(ctx, []string{"c1", "c2"}, newRequest("c1,c2"), search)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"c1", "c2"}, searched)
+		assert.Equal(t, commonpb.ErrorCode_Success, result.GetStatus().GetErrorCode())
+		assert.Empty(t, result.GetStatus().GetReason())
+		assert.Equal(t, []float32{0.2, 0.3}, result.GetResults().GetScores())
+		assert.Equal(t, []string{"c2", "c1"}, result.GetResults().GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("failed collection", func(t *testing.T) {
+		_, err := searchCollections(ctx, []string{"c1", "c3"}, newRequest("c1,c3"), search)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "c3: not loaded")
+	})
+
+	t.Run("allow partial", func(t *testing.T) {
+		result, err := searchCollections(ctx, []string{"c1", "c3"}, newRequest("c1,c3", &commonpb.KeyValuePair{Key: AllowPartialKey, Value: "true"}), search)
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, result.GetStatus().GetErrorCode())
+		assert.Contains(t, result.GetStatus().GetReason(), "c3: not loaded")
+		assert.Equal(t, []float32{0.3, 0.5}, result.GetResults().GetScores())
+
+		// nothing to return if all the collections failed
+		_, err = searchCollections(ctx, []string{"c3", "c4"}, newRequest("c3,c4", &commonpb.KeyValuePair{Key: AllowPartialKey, Value: "true"}), search)
+		assert.Error(t, err)
+
+		_, err = searchCollections(ctx, []string{"c1", "c2"}, newRequest("c1,c2", &commonpb.KeyValuePair{Key: AllowPartialKey, Value: "yes"}), search)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("incompatible collections", func(t *testing.T) {
+		searched = nil
+		_, err := searchCollections(ctx, []string{"c1", "c16"}, newRequest("c1,c16"), search)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "dim 16 of field vec doesn't match 8")
+
+		_, err = searchCollections(ctx, []string{"c1", "c1"}, newRequest("c1,c1"), search)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		_, err = searchCollections(ctx, []string{"c1", ""}, newRequest("c1,"), search)
+		assert.Error(t, err)
+
+		_, err = searchCollections(ctx, []string{"c1", "c4"}, newRequest("c1,c4"), search)
+		assert.Error(t, err)
+		assert.Empty(t, searched)
+	})
+}
+
+func Test_checkFieldsCompatible(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			{Name: "tag", DataType: schemapb.DataType_Int64},
+		},
+	}
+	other := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			{Name: "tag", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	err := checkFieldsCompatible(schema, other, []string{"vec"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "primary key type")
+
+	other.Fields[0].DataType = schemapb.DataType_Int64
+	assert.NoError(t, checkFieldsCompatible(schema, other, []string{"vec"}))
+
+	err = checkFieldsCompatible(schema, other, []string{"vec", "tag"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "field tag")
+}
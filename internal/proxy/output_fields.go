@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// IncludeVectorsKey is the search/query param to expand the wildcard "*" of the output fields to the vector
+	// fields as well.
+	IncludeVectorsKey = "include_vectors"
+
+	// scalarFieldsWildcard is expanded to all the scalar fields, and the vector fields if include_vectors is set.
+	scalarFieldsWildcard = "*"
+	// vectorFieldsWildcard is expanded to all the vector fields.
+	vectorFieldsWildcard = "%"
+)
+
+// parseIncludeVectors returns whether the wildcard of the output fields includes the vector fields, it's false by
+// default.
+func parseIncludeVectors(params []*commonpb.KeyValuePair) (bool, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(IncludeVectorsKey, params)
+	if err != nil {
+		return false, nil
+	}
+	includeVectors, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be true or false", IncludeVectorsKey, value)
+	}
+	return includeVectors, nil
+}
+
+// translateOutputFields expands the wildcards of the output fields and removes the duplicates, the fields are in the
+// order of the request, and a wildcard is expanded in the order of the schema. The primary key field is appended if
+// addPrimary is set and it's not requested. An unknown field is rejected with the closest field name as a suggestion.
+//
+// Support wildcard in output fields:
+//
+//	"*" - all scalar fields, and all vector fields if includeVectors is set
+//	"%" - all vector fields
+//
+// For example, A and B are scalar fields, C and D are vector fields.
+//
+//	output_fields=["*"]     ==> [A,B]
+//	output_fields=["%"]     ==> [C,D]
+//	output_fields=["*","%"] ==> [A,B,C,D]
+//	output_fields=["*",A]   ==> [A,B]
+//	output_fields=["*",C]   ==> [A,B,C]
+//	output_fields=[B,"*"]   ==> [B,A]
+func translateOutputFields(outputFields []string, schema *schemapb.CollectionSchema, addPrimary bool, includeVectors bool) ([]string, error) {
+	var primaryFieldName string
+	fieldNames := make(map[string]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			primaryFieldName = field.GetName()
+		}
+		fieldNames[field.GetName()] = struct{}{}
+	}
+
+	resultFieldNames := make([]string, 0, len(outputFields)+1)
+	resultFieldNameSet := make(map[string]struct{}, len(outputFields)+1)
+	add := func(name string) {
+		if _, ok := resultFieldNameSet[name]; !ok {
+			resultFieldNameSet[name] = struct{}{}
+			resultFieldNames = append(resultFieldNames, name)
+		}
+	}
+	for _, outputFieldName := range outputFields {
+		outputFieldName = strings.TrimSpace(outputFieldName)
+		switch outputFieldName {
+		case scalarFieldsWildcard:
+			for _, field := range schema.GetFields() {
+				if includeVectors || !typeutil.IsVectorType(field.GetDataType()) {
+					add(field.GetName())
+				}
+			}
+		case vectorFieldsWildcard:
+			for _, field := range schema.GetFields() {
+				if typeutil.IsVectorType(field.GetDataType()) {
+					add(field.GetName())
+				}
+			}
+		default:
+			if _, ok := fieldNames[outputFieldName]; !ok {
+				return nil, errOutputFieldNotExist(outputFieldName, schema)
+			}
+			add(outputFieldName)
+		}
+	}
+
+	if addPrimary && primaryFieldName != "" {
+		add(primaryFieldName)
+	}
+	return resultFieldNames, nil
+}
+
+// errOutputFieldNotExist returns the error of an unknown output field, suggesting the closest field name of the
+// schema if it's likely a typo.
+func errOutputFieldNotExist(name string, schema *schemapb.CollectionSchema) error {
+	var (
+		closest     string
+		minDistance int
+	)
+	for _, field := range schema.GetFields() {
+		distance := editDistance(name, field.GetName())
+		if closest == "" || distance < minDistance {
+			closest, minDistance = field.GetName(), distance
+		}
+	}
+	// a suggestion which has to change most of the name is no help
+	if closest != "" && minDistance <= len(closest)/2 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"output field %s does not exist in collection %s, did you mean %s?", name, schema.GetName(), closest)
+	}
+	return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"output field %s does not exist in collection %s", name, schema.GetName())
+}
+
+// editDistance returns the Levenshtein distance between the two strings.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestParseIncludeVectors(t *testing.T) {
+	includeVectors, err := parseIncludeVectors(nil)
+	assert.NoError(t, err)
+	assert.False(t, includeVectors)
+
+	includeVectors, err = parseIncludeVectors([]*commonpb.KeyValuePair{{Key: IncludeVectorsKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, includeVectors)
+
+	includeVectors, err = parseIncludeVectors([]*commonpb.KeyValuePair{{Key: IncludeVectorsKey, Value: "false"}})
+	assert.NoError(t, err)
+	assert.False(t, includeVectors)
+
+	_, err = parseIncludeVectors([]*commonpb.KeyValuePair{{Key: IncludeVectorsKey, Value: "yes please"}})
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestTranslateOutputFields_OrderAndDedup(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestTranslateOutputFields_OrderAndDedup",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "age", DataType: schemapb.DataType_Int64},
+			{Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+			{Name: "name", DataType: schemapb.DataType_VarChar},
+			{Name: "binary_vector", DataType: schemapb.DataType_BinaryVector},
+		},
+	}
+
+	cases := []struct {
+		name           string
+		outputFields   []string
+		addPrimary     bool
+		includeVectors bool
+		expected       []string
+	}{
+		{"empty", nil, false, false, []string{}},
+		{"empty with primary", nil, true, false, []string{"id"}},
+		{"request order", []string{"name", "age"}, false, false, []string{"name", "age"}},
+		{"primary appended", []string{"name", "age"}, true, false, []string{"name", "age", "id"}},
+		{"primary requested", []string{"name", "id"}, true, false, []string{"name", "id"}},
+		{"duplicates", []string{"age", "name", "age", " age "}, false, false, []string{"age", "name"}},
+		{"wildcard in schema order", []string{"*"}, false, false, []string{"id", "age", "name"}},
+		{"wildcard with vectors", []string{"*"}, false, true, []string{"id", "age", "float_vector", "name", "binary_vector"}},
+		{"wildcard after name", []string{"name", "*"}, false, false, []string{"name", "id", "age"}},
+		{"wildcard twice", []string{"*", "*", "name"}, false, false, []string{"id", "age", "name"}},
+		{"vector wildcard", []string{"%"}, true, false, []string{"float_vector", "binary_vector", "id"}},
+		{"both wildcards", []string{"%", "*"}, false, false, []string{"float_vector", "binary_vector", "id", "age", "name"}},
+		{"wildcard and vector", []string{"*", "binary_vector"}, false, false, []string{"id", "age", "name", "binary_vector"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			outputFields, err := translateOutputFields(c.outputFields, schema, c.addPrimary, c.includeVectors)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, outputFields)
+		})
+	}
+}
+
+func TestTranslateOutputFields_NotExist(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestTranslateOutputFields_NotExist",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "title", DataType: schemapb.DataType_VarChar},
+			{Name: "embedding", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	// a typo is suggested the closest field
+	for name, suggestion := range map[string]string{
+		"titel":      "title",
+		"Title":      "title",
+		"embeding":   "embedding",
+		"embeddings": "embedding",
+		"ids":        "id",
+	} {
+		_, err := translateOutputFields([]string{"id", name}, schema, true, false)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), name)
+		assert.Contains(t, err.Error(), "output field "+name+" does not exist", name)
+		assert.Contains(t, err.Error(), "did you mean "+suggestion+"?", name)
+	}
+
+	// nothing is suggested if no field is close
+	for _, name := range []string{"price", "", "ts"} {
+		_, err := translateOutputFields([]string{name}, schema, false, false)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), name)
+		assert.NotContains(t, err.Error(), "did you mean", name)
+	}
+
+	// the wildcards never fail
+	_, err := translateOutputFields([]string{"*", "%"}, &schemapb.CollectionSchema{}, true, true)
+	assert.NoError(t, err)
+}
+
+func Test_editDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("", ""))
+	assert.Equal(t, 3, editDistance("", "abc"))
+	assert.Equal(t, 3, editDistance("abc", ""))
+	assert.Equal(t, 0, editDistance("title", "title"))
+	assert.Equal(t, 2, editDistance("titel", "title"))
+	assert.Equal(t, 1, editDistance("embeding", "embedding"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Equal(t, 1, editDistance("年龄", "年"))
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// field numbers of commonpb.PlaceholderGroup and commonpb.PlaceholderValue
+const (
+	placeholderGroupPlaceholdersField protowire.Number = 1
+	placeholderValueTypeField         protowire.Number = 2
+	placeholderValueValuesField       protowire.Number = 3
+)
+
+// placeholderGroupHeader is the summary of a serialized placeholder group.
+type placeholderGroupHeader struct {
+	nq              int64
+	placeholders    int
+	placeholderType commonpb.PlaceholderType
+}
+
+// parsePlaceholderGroupHeader decodes nq and the vector type of a serialized commonpb.PlaceholderGroup without
+// unmarshalling it, the vectors are skipped rather than copied, so the cost doesn't grow with the dimension.
+// nq is the number of the vectors of all the placeholders, and the placeholder type is the one of the first placeholder.
+func parsePlaceholderGroupHeader(data []byte) (*placeholderGroupHeader, error) {
+	header := &placeholderGroupHeader{}
+	first := true
+	err := walkProtoFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		if num != placeholderGroupPlaceholdersField || typ != protowire.BytesType {
+			return nil
+		}
+		header.placeholders++
+		return walkProtoFields(value, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+			switch {
+			case num == placeholderValueTypeField && typ == protowire.VarintType:
+				if first {
+					header.placeholderType = commonpb.PlaceholderType(varint)
+					first = false
+				}
+			case num == placeholderValueValuesField && typ == protowire.BytesType:
+				header.nq++
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid placeholder group: %w", err)
+	}
+	return header, nil
+}
+
+// vectorPlaceholderTypes maps the vector types to the placeholder types searching them.
+var vectorPlaceholderTypes = map[schemapb.DataType]commonpb.PlaceholderType{
+	schemapb.DataType_BinaryVector: commonpb.PlaceholderType_BinaryVector,
+	schemapb.DataType_FloatVector:  commonpb.PlaceholderType_FloatVector,
+}
+
+// validateVectorPlaceholderGroup checks the search vectors are of the type of the searched vector field, and are of
+// the dimension of the field: a float vector must have dim elements of 4 bytes each, and a binary vector dim bits.
+// If proxy.searchVectorNaNCheck is set, the float vectors are scanned for NaN and Inf too.
+// The vectors are checked without unmarshalling the placeholder group, which is forwarded to query nodes as is.
+func validateVectorPlaceholderGroup(field *schemapb.FieldSchema, data []byte) error {
+	expectedType, ok := vectorPlaceholderTypes[field.GetDataType()]
+	if !ok {
+		return nil
+	}
+	dimStr, err := funcutil.GetAttrByKeyFromRepeatedKV("dim", field.GetTypeParams())
+	if err != nil {
+		return fmt.Errorf("dimension of field %s not found in schema", field.GetName())
+	}
+	dim, err := strconv.ParseInt(dimStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid dimension of field %s: %s", field.GetName(), dimStr)
+	}
+	vectorLen := dim * 4
+	if field.GetDataType() == schemapb.DataType_BinaryVector {
+		vectorLen = dim / 8
+	}
+	checkNaN := Params.ProxyCfg.SearchVectorNaNCheck
+
+	var nq int64
+	err = walkProtoFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		if num != placeholderGroupPlaceholdersField || typ != protowire.BytesType {
+			return nil
+		}
+		var placeholderType commonpb.PlaceholderType
+		var vectors [][]byte
+		err := walkProtoFields(value, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+			switch {
+			case num == placeholderValueTypeField && typ == protowire.VarintType:
+				placeholderType = commonpb.PlaceholderType(varint)
+			case num == placeholderValueValuesField && typ == protowire.BytesType:
+				vectors = append(vectors, value)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if placeholderType != expectedType {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"%s vectors can't search field %s of %s", placeholderType, field.GetName(), field.GetDataType())
+		}
+		for _, vector := range vectors {
+			if int64(len(vector)) != vectorLen {
+				return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+					"search vector %d of field %s should be %d bytes for the dimension of the field, got %d bytes",
+					nq, field.GetName(), vectorLen, len(vector))
+			} else if checkNaN && !isFiniteVector(field.GetDataType(), vector) {
+				return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+					"search vector %d of field %s contains NaN or Inf", nq, field.GetName())
+			}
+			nq++
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid placeholder group: %w", err)
+	}
+	return nil
+}
+
+// isFiniteVector returns false if any element of the float vector is NaN or Inf, i.e. all the bits of its exponent
+// are set. The vectors of the other types are always finite.
+func isFiniteVector(dataType schemapb.DataType, vector []byte) bool {
+	if dataType != schemapb.DataType_FloatVector {
+		return true
+	}
+	for i := 0; i+4 <= len(vector); i += 4 {
+		if common.Endian.Uint32(vector[i:])&0x7f800000 == 0x7f800000 {
+			return false
+		}
+	}
+	return true
+}
+
+// validateNq checks the number of search vectors doesn't exceed proxy.maxSearchNq, no limit if it's 0.
+func validateNq(nq int64) error {
+	if limit := Params.ProxyCfg.MaxSearchNq; limit > 0 && nq > limit {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"number of search vectors %d exceeds the limit %d, split the search into smaller ones", nq, limit)
+	}
+	return nil
+}
+
+// walkProtoFields calls fn with every field of a serialized message, value is set for length-delimited fields
+// and varint for varint fields.
+func walkProtoFields(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		var (
+			value  []byte
+			varint uint64
+		)
+		switch typ {
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if err := fn(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
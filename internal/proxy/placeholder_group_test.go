@@ -0,0 +1,286 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func Test_parsePlaceholderGroupHeader(t *testing.T) {
+	t.Run("consistent with unmarshal", func(t *testing.T) {
+		groups := []*commonpb.PlaceholderGroup{
+			{},
+			constructPlaceholderGroup(1, 8),
+			constructPlaceholderGroup(10, 128),
+			{
+				Placeholders: []*commonpb.PlaceholderValue{
+					{Tag: "$0", Type: commonpb.PlaceholderType_BinaryVector, Values: [][]byte{{1}, {2}, {3}}},
+					{Tag: "$1", Type: commonpb.PlaceholderType_BinaryVector, Values: [][]byte{{4}}},
+				},
+			},
+			{
+				Placeholders: []*commonpb.PlaceholderValue{
+					{Tag: "$0", Values: [][]byte{{}, {}}},
+				},
+			},
+		}
+		for _, group := range groups {
+			data, err := proto.Marshal(group)
+			require.NoError(t, err)
+			header, err := parsePlaceholderGroupHeader(data)
+			require.NoError(t, err)
+
+			x := &commonpb.PlaceholderGroup{}
+			require.NoError(t, proto.Unmarshal(data, x))
+			nq := int64(0)
+			for _, h := range x.GetPlaceholders() {
+				nq += int64(len(h.GetValues()))
+			}
+			assert.Equal(t, nq, header.nq)
+			assert.Equal(t, len(x.GetPlaceholders()), header.placeholders)
+			if len(x.GetPlaceholders()) > 0 {
+				assert.Equal(t, x.GetPlaceholders()[0].GetType(), header.placeholderType)
+			}
+		}
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		data, err := proto.Marshal(constructPlaceholderGroup(2, 8))
+		require.NoError(t, err)
+		cases := [][]byte{
+			data[:len(data)-1],
+			{0x0a, 0x05, 0x1a},
+			{0xff},
+		}
+		for _, c := range cases {
+			_, err := parsePlaceholderGroupHeader(c)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func Test_getNq(t *testing.T) {
+	data, err := proto.Marshal(constructPlaceholderGroup(5, 8))
+	require.NoError(t, err)
+
+	nq, err := getNq(&milvuspb.SearchRequest{PlaceholderGroup: data})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), nq)
+
+	// the declared nq matches the placeholder group
+	nq, err = getNq(&milvuspb.SearchRequest{Nq: 5, PlaceholderGroup: data})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), nq)
+
+	// the declared nq mismatches the placeholder group
+	for _, declared := range []int64{3, 6} {
+		_, err = getNq(&milvuspb.SearchRequest{Nq: declared, PlaceholderGroup: data})
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "nq "+strconv.FormatInt(declared, 10)+" of the request mismatches the 5 search vectors")
+	}
+
+	// multiple placeholders
+	multiple, err := proto.Marshal(&commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{
+			{Tag: "$0", Type: commonpb.PlaceholderType_FloatVector, Values: [][]byte{make([]byte, 32), make([]byte, 32)}},
+			{Tag: "$1", Type: commonpb.PlaceholderType_FloatVector, Values: [][]byte{make([]byte, 32)}},
+		},
+	})
+	require.NoError(t, err)
+	for _, declared := range []int64{0, 3} {
+		_, err = getNq(&milvuspb.SearchRequest{Nq: declared, PlaceholderGroup: multiple})
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "placeholder group has 2 placeholders")
+	}
+
+	_, err = getNq(&milvuspb.SearchRequest{PlaceholderGroup: data[:len(data)-1]})
+	assert.Error(t, err)
+}
+
+func Test_validateVectorPlaceholderGroup_dim(t *testing.T) {
+	newGroup := func(placeholderType commonpb.PlaceholderType, vectorLen int) []byte {
+		data, err := proto.Marshal(&commonpb.PlaceholderGroup{
+			Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: placeholderType, Values: [][]byte{make([]byte, vectorLen)}}},
+		})
+		require.NoError(t, err)
+		return data
+	}
+	floatField := &schemapb.FieldSchema{
+		Name:       "vec",
+		DataType:   schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+	}
+	binaryField := &schemapb.FieldSchema{
+		Name:       "bin",
+		DataType:   schemapb.DataType_BinaryVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}},
+	}
+
+	assert.NoError(t, validateVectorPlaceholderGroup(floatField, newGroup(commonpb.PlaceholderType_FloatVector, 32)))
+	assert.NoError(t, validateVectorPlaceholderGroup(binaryField, newGroup(commonpb.PlaceholderType_BinaryVector, 2)))
+
+	invalid := []struct {
+		name  string
+		field *schemapb.FieldSchema
+		data  []byte
+	}{
+		{"float vectors of smaller dim", floatField, newGroup(commonpb.PlaceholderType_FloatVector, 28)},
+		{"float vectors of larger dim", floatField, newGroup(commonpb.PlaceholderType_FloatVector, 36)},
+		{"binary vectors of wrong dim", binaryField, newGroup(commonpb.PlaceholderType_BinaryVector, 1)},
+		{"binary vectors on float field", floatField, newGroup(commonpb.PlaceholderType_BinaryVector, 32)},
+		{"float vectors on binary field", binaryField, newGroup(commonpb.PlaceholderType_FloatVector, 2)},
+	}
+	for _, c := range invalid {
+		err := validateVectorPlaceholderGroup(c.field, c.data)
+		assert.Error(t, err, c.name)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.name)
+	}
+
+	data := newGroup(commonpb.PlaceholderType_FloatVector, 32)
+	assert.Error(t, validateVectorPlaceholderGroup(floatField, data[:len(data)-1]))
+}
+
+func Test_validateVectorPlaceholderGroup_NaN(t *testing.T) {
+	Params.Init()
+	defer func(check bool) { Params.ProxyCfg.SearchVectorNaNCheck = check }(Params.ProxyCfg.SearchVectorNaNCheck)
+
+	newField := func(dataType schemapb.DataType) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			Name:       "vec",
+			DataType:   dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+		}
+	}
+	newGroup := func(placeholderType commonpb.PlaceholderType, vector []byte) []byte {
+		data, err := proto.Marshal(&commonpb.PlaceholderGroup{
+			Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: placeholderType, Values: [][]byte{vector}}},
+		})
+		require.NoError(t, err)
+		return data
+	}
+	floatVector := func(values ...float32) []byte {
+		vector := make([]byte, len(values)*4)
+		for i, v := range values {
+			common.Endian.PutUint32(vector[i*4:], math.Float32bits(v))
+		}
+		return vector
+	}
+
+	cases := []struct {
+		name   string
+		field  *schemapb.FieldSchema
+		data   []byte
+		finite bool
+	}{
+		{"float", newField(schemapb.DataType_FloatVector),
+			newGroup(commonpb.PlaceholderType_FloatVector, floatVector(1, -1)), true},
+		{"float NaN", newField(schemapb.DataType_FloatVector),
+			newGroup(commonpb.PlaceholderType_FloatVector, floatVector(1, float32(math.NaN()))), false},
+		{"float Inf", newField(schemapb.DataType_FloatVector),
+			newGroup(commonpb.PlaceholderType_FloatVector, floatVector(float32(math.Inf(-1)), 1)), false},
+	}
+
+	Params.ProxyCfg.SearchVectorNaNCheck = false
+	for _, c := range cases {
+		assert.NoError(t, validateVectorPlaceholderGroup(c.field, c.data), c.name)
+	}
+
+	Params.ProxyCfg.SearchVectorNaNCheck = true
+	for _, c := range cases {
+		err := validateVectorPlaceholderGroup(c.field, c.data)
+		if c.finite {
+			assert.NoError(t, err, c.name)
+			continue
+		}
+		assert.Error(t, err, c.name)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.name)
+		assert.Contains(t, err.Error(), "NaN or Inf", c.name)
+	}
+}
+
+func Test_validateSearchVectors(t *testing.T) {
+	Params.Init()
+	defer func(maxNq int64) { Params.ProxyCfg.MaxSearchNq = maxNq }(Params.ProxyCfg.MaxSearchNq)
+	Params.ProxyCfg.MaxSearchNq = 4
+
+	field := &schemapb.FieldSchema{
+		Name:       "vec",
+		DataType:   schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+	}
+	abandoned := func() float64 {
+		return testutil.ToFloat64(metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.AbandonLabel))
+	}
+	newRequest := func(nq, dim int) *milvuspb.SearchRequest {
+		data, err := proto.Marshal(constructPlaceholderGroup(nq, dim))
+		require.NoError(t, err)
+		return &milvuspb.SearchRequest{PlaceholderGroup: data}
+	}
+
+	before := abandoned()
+	assert.NoError(t, validateSearchVectors(field, newRequest(4, 8)))
+	assert.Equal(t, before, abandoned())
+
+	// too many vectors
+	err := validateSearchVectors(field, newRequest(5, 8))
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Equal(t, before+5, abandoned())
+
+	// wrong dimension
+	err = validateSearchVectors(field, newRequest(3, 4))
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Equal(t, before+8, abandoned())
+}
+
+func BenchmarkPlaceholderGroupNq(b *testing.B) {
+	data, err := proto.Marshal(constructPlaceholderGroup(500, 768))
+	require.NoError(b, err)
+
+	b.Run("unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x := &commonpb.PlaceholderGroup{}
+			if err := proto.Unmarshal(data, x); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("header", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := parsePlaceholderGroupHeader(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
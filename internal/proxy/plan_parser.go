@@ -896,22 +896,3 @@ func createQueryPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vector
 	}
 	return planNode, nil
 }
-
-func createExprPlan(schemaPb *schemapb.CollectionSchema, exprStr string) (*planpb.PlanNode, error) {
-	schema, err := typeutil.CreateSchemaHelper(schemaPb)
-	if err != nil {
-		return nil, err
-	}
-
-	expr, err := parseExpr(schema, exprStr)
-	if err != nil {
-		return nil, err
-	}
-
-	planNode := &planpb.PlanNode{
-		Node: &planpb.PlanNode_Predicates{
-			Predicates: expr,
-		},
-	}
-	return planNode, nil
-}
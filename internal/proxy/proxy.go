@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -56,6 +57,9 @@ type Timestamp = typeutil.Timestamp
 // const sendTimeTickMsgInterval = 200 * time.Millisecond
 // const channelMgrTickerInterval = 100 * time.Millisecond
 
+// watchQueryNodesRetryInterval is the interval to watch the sessions of query nodes again after the watch fails.
+const watchQueryNodesRetryInterval = time.Second
+
 // make sure Proxy implements types.Proxy
 var _ types.Proxy = (*Proxy)(nil)
 
@@ -75,6 +79,8 @@ type Proxy struct {
 	port       int
 
 	stateCode atomic.Value
+	// stateReason is why proxy is abnormal or degraded, empty if it's not
+	stateReason atomic.Value
 
 	etcdCli    *clientv3.Client
 	rootCoord  types.RootCoord
@@ -83,6 +89,8 @@ type Proxy struct {
 	queryCoord types.QueryCoord
 
 	multiRateLimiter *MultiRateLimiter
+	// allocTsLimiter limits the AllocTimestamp requests of each client, nil if there is no limit
+	allocTsLimiter *clientRateLimiter
 
 	chMgr channelsMgr
 
@@ -94,6 +102,9 @@ type Proxy struct {
 	tsoAllocator *timestampAllocator
 	segAssigner  *segIDAssigner
 
+	// insertIDAllocator allocates the ids of the inserts from the ids cached in proxy
+	insertIDAllocator *cachedIDAllocator
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
 	session  *sessionutil.Session
@@ -103,6 +114,15 @@ type Proxy struct {
 
 	searchResultCh chan *internalpb.SearchResults
 
+	// queryResultCache is nil unless query result cache is enabled
+	queryResultCache *queryResultCache
+	// insertDedupCache is nil if insert dedup is disabled
+	insertDedupCache *insertDedupCache
+	// prober runs the probe dummy requests, nil if the probe is disabled
+	prober *dummyProber
+	// userUsage collects the usage of the collections by each user
+	userUsage *userUsageCollector
+
 	// Add callback functions at different stages
 	startCallbacks []func()
 	closeCallbacks []func()
@@ -120,6 +140,7 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		searchResultCh:   make(chan *internalpb.SearchResults, n),
 		shardMgr:         newShardClientMgr(),
 		multiRateLimiter: NewMultiRateLimiter(),
+		userUsage:        newUserUsageCollector(Params.ProxyCfg.UserUsageWindow),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -129,8 +150,8 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 // Register registers proxy at etcd
 func (node *Proxy) Register() error {
 	node.session.Register()
-	go node.session.LivenessCheck(node.ctx, func() {
-		log.Error("Proxy disconnected from etcd, process will exit", zap.Int64("Server Id", node.session.ServerID))
+	go node.keepSessionRegistered(node.ctx, node.session, func() {
+		log.Error("Proxy failed to re-register to etcd, process will exit", zap.Int64("Server Id", node.session.ServerID))
 		if err := node.Stop(); err != nil {
 			log.Fatal("failed to stop server", zap.Error(err))
 		}
@@ -139,7 +160,7 @@ func (node *Proxy) Register() error {
 				p.Signal(syscall.SIGINT)
 			}
 		}
-	})
+	}, retry.Attempts(sessionReregisterAttempts), retry.Sleep(time.Second), retry.MaxSleepTime(10*time.Second))
 	// TODO Reset the logger
 	//Params.initLogCfg()
 	return nil
@@ -199,6 +220,7 @@ func (node *Proxy) Init() error {
 		return err
 	}
 	node.idAllocator = idAllocator
+	node.insertIDAllocator = newCachedIDAllocator(idAllocator.Alloc)
 	log.Debug("create id allocator done", zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", Params.ProxyCfg.GetNodeID()))
 
 	log.Debug("create timestamp allocator", zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", Params.ProxyCfg.GetNodeID()))
@@ -212,6 +234,10 @@ func (node *Proxy) Init() error {
 	node.tsoAllocator = tsoAllocator
 	log.Debug("create timestamp allocator done", zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", Params.ProxyCfg.GetNodeID()))
 
+	if Params.ProxyCfg.AllocTimestampMaxRatePerClient > 0 {
+		node.allocTsLimiter = newClientRateLimiter(Params.ProxyCfg.AllocTimestampMaxRatePerClient)
+	}
+
 	log.Debug("create segment id assigner", zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", Params.ProxyCfg.GetNodeID()))
 	segAssigner, err := newSegIDAssigner(node.ctx, node.dataCoord, node.lastTick)
 	if err != nil {
@@ -255,6 +281,32 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	if Params.ProxyCfg.QueryResultCacheEnabled {
+		node.queryResultCache, err = newQueryResultCache(Params.ProxyCfg.QueryResultCacheSize, Params.ProxyCfg.QueryResultCacheTTL)
+		if err != nil {
+			log.Warn("failed to create query result cache", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+			return err
+		}
+		log.Debug("create query result cache done", zap.String("role", typeutil.ProxyRole),
+			zap.Int("size", Params.ProxyCfg.QueryResultCacheSize), zap.Duration("ttl", Params.ProxyCfg.QueryResultCacheTTL))
+	}
+
+	if Params.ProxyCfg.InsertDedupCacheSize > 0 {
+		node.insertDedupCache, err = newInsertDedupCache(Params.ProxyCfg.InsertDedupCacheSize, Params.ProxyCfg.InsertDedupTTL)
+		if err != nil {
+			log.Warn("failed to create insert dedup cache", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+			return err
+		}
+		log.Debug("create insert dedup cache done", zap.String("role", typeutil.ProxyRole),
+			zap.Int("size", Params.ProxyCfg.InsertDedupCacheSize), zap.Duration("ttl", Params.ProxyCfg.InsertDedupTTL))
+	}
+
+	if Params.ProxyCfg.DummyProbeEnabled {
+		node.prober = newDummyProber(node, Params.ProxyCfg.DummyProbeMinInterval, Params.ProxyCfg.DummyProbeTimeout)
+		log.Debug("enable dummy probe", zap.String("role", typeutil.ProxyRole),
+			zap.Duration("minInterval", Params.ProxyCfg.DummyProbeMinInterval), zap.Duration("timeout", Params.ProxyCfg.DummyProbeTimeout))
+	}
+
 	return nil
 }
 
@@ -326,6 +378,81 @@ func (node *Proxy) sendChannelsTimeTickLoop() {
 	}()
 }
 
+// watchQueryNodes starts a goroutine that watches the sessions of query nodes. The query nodes whose sessions are
+// deleted are stopping, they are marked as unroutable at once, so that requests are routed to the other replicas
+// before the shard leaders are updated. The sessions are watched again if the watch fails or the watcher is closed.
+func (node *Proxy) watchQueryNodes() {
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		for {
+			eventCh, err := node.watchQueryNodeSessions()
+			if err != nil {
+				log.Warn("failed to watch query node sessions, retry later", zap.Error(err))
+			} else if !node.handleQueryNodeSessionEvents(eventCh) {
+				return
+			}
+			select {
+			case <-node.ctx.Done():
+				log.Info("watch query nodes loop exit")
+				return
+			case <-time.After(watchQueryNodesRetryInterval):
+			}
+		}
+	}()
+}
+
+// watchQueryNodeSessions syncs the routable query nodes with the current sessions, and watches the later changes.
+func (node *Proxy) watchQueryNodeSessions() (<-chan *sessionutil.SessionEvent, error) {
+	sessions, revision, err := node.session.GetSessions(typeutil.QueryNodeRole)
+	if err != nil {
+		return nil, err
+	}
+	node.syncQueryNodeSessions(sessions)
+	return node.session.WatchServices(typeutil.QueryNodeRole, revision+1, func(sessions map[string]*sessionutil.Session) error {
+		node.syncQueryNodeSessions(sessions)
+		return nil
+	}), nil
+}
+
+// syncQueryNodeSessions marks the query nodes without session as unroutable, since their events may be missed.
+func (node *Proxy) syncQueryNodeSessions(sessions map[string]*sessionutil.Session) {
+	alive := make(map[UniqueID]struct{}, len(sessions))
+	for _, session := range sessions {
+		alive[session.ServerID] = struct{}{}
+	}
+	node.shardMgr.SyncRoutable(alive)
+}
+
+// handleQueryNodeSessionEvents handles the session events until the channel is closed, false is returned if the
+// proxy is stopped.
+func (node *Proxy) handleQueryNodeSessionEvents(eventCh <-chan *sessionutil.SessionEvent) bool {
+	for {
+		select {
+		case <-node.ctx.Done():
+			log.Info("watch query nodes loop exit")
+			return false
+		case event, ok := <-eventCh:
+			if !ok {
+				log.Warn("query node session watcher channel closed, watch again")
+				return true
+			}
+			node.handleQueryNodeSessionEvent(event)
+		}
+	}
+}
+
+func (node *Proxy) handleQueryNodeSessionEvent(event *sessionutil.SessionEvent) {
+	nodeID := event.Session.ServerID
+	switch event.EventType {
+	case sessionutil.SessionAddEvent:
+		node.shardMgr.MarkRoutable(nodeID)
+	case sessionutil.SessionDelEvent:
+		log.Info("query node is stopping, mark it as unroutable", zap.Int64("nodeID", nodeID))
+		node.shardMgr.MarkUnroutable(nodeID)
+	}
+}
+
 // Start starts a proxy node.
 func (node *Proxy) Start() error {
 	log.Debug("start task scheduler", zap.String("role", typeutil.ProxyRole))
@@ -358,11 +485,21 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	node.watchQueryNodes()
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
 	}
 
+	if Params.ProxyCfg.StartupWarmUpEnabled {
+		log.Debug("warm up caches", zap.String("role", typeutil.ProxyRole))
+		warmed := warmUpLoadedCollections(node.ctx, globalMetaCache, node.rootCoord, node.queryCoord,
+			Params.ProxyCfg.StartupWarmUpConcurrency, Params.ProxyCfg.StartupWarmUpBudget)
+		metrics.ProxyWarmedUpCollections.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Set(float64(warmed))
+		log.Debug("warm up caches done", zap.String("role", typeutil.ProxyRole), zap.Int("collections", warmed))
+	}
+
 	now := time.Now()
 	Params.ProxyCfg.CreatedTime = now
 	Params.ProxyCfg.UpdatedTime = now
@@ -412,6 +549,8 @@ func (node *Proxy) Stop() error {
 		node.shardMgr.Close()
 	}
 
+	node.insertDedupCache.close()
+
 	// https://github.com/milvus-io/milvus/issues/12282
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 
@@ -438,24 +577,24 @@ func (node *Proxy) SetEtcdClient(client *clientv3.Client) {
 	node.etcdCli = client
 }
 
-// SetRootCoordClient sets RootCoord client for proxy.
+// SetRootCoordClient sets RootCoord client for proxy, the calls fast fail while RootCoord is unavailable.
 func (node *Proxy) SetRootCoordClient(cli types.RootCoord) {
-	node.rootCoord = cli
+	node.rootCoord = &rootCoordBreaker{RootCoord: cli, breaker: newCoordBreaker(typeutil.RootCoordRole)}
 }
 
-// SetIndexCoordClient sets IndexCoord client for proxy.
+// SetIndexCoordClient sets IndexCoord client for proxy, the calls fast fail while IndexCoord is unavailable.
 func (node *Proxy) SetIndexCoordClient(cli types.IndexCoord) {
-	node.indexCoord = cli
+	node.indexCoord = &indexCoordBreaker{IndexCoord: cli, breaker: newCoordBreaker(typeutil.IndexCoordRole)}
 }
 
-// SetDataCoordClient sets DataCoord client for proxy.
+// SetDataCoordClient sets DataCoord client for proxy, the calls fast fail while DataCoord is unavailable.
 func (node *Proxy) SetDataCoordClient(cli types.DataCoord) {
-	node.dataCoord = cli
+	node.dataCoord = &dataCoordBreaker{DataCoord: cli, breaker: newCoordBreaker(typeutil.DataCoordRole)}
 }
 
-// SetQueryCoordClient sets QueryCoord client for proxy.
+// SetQueryCoordClient sets QueryCoord client for proxy, the calls fast fail while QueryCoord is unavailable.
 func (node *Proxy) SetQueryCoordClient(cli types.QueryCoord) {
-	node.queryCoord = cli
+	node.queryCoord = &queryCoordBreaker{QueryCoord: cli, breaker: newCoordBreaker(typeutil.QueryCoordRole)}
 }
 
 // GetRateLimiter returns the rateLimiter in Proxy.
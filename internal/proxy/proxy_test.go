@@ -32,6 +32,7 @@ import (
 	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -52,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -578,6 +580,14 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, Params.ProxyCfg.GetNodeID(), states.State.NodeID)
 		assert.Equal(t, typeutil.ProxyRole, states.State.Role)
 		assert.Equal(t, proxy.stateCode.Load().(internalpb.StateCode), states.State.StateCode)
+		address, err := funcutil.GetAttrByKeyFromRepeatedKV(AddressKey, states.State.ExtraInfo)
+		assert.NoError(t, err)
+		assert.Equal(t, Params.ProxyCfg.NetworkAddress, address)
+		assert.Len(t, states.SubcomponentStates, 3)
+		for _, subcomponent := range states.SubcomponentStates {
+			assert.Equal(t, internalpb.StateCode_Healthy, subcomponent.StateCode, subcomponent.Role)
+			assert.Equal(t, states.State.NodeID, subcomponent.NodeID, subcomponent.Role)
+		}
 	})
 
 	t.Run("get statistics channel", func(t *testing.T) {
@@ -854,7 +864,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -1013,7 +1023,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1258,7 +1268,7 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, 1, len(resp.CollectionNames))
 		assert.Equal(t, 1, len(resp.InMemoryPercentages))
 
-		// get in-memory percentage of not loaded collection -> fail
+		// get in-memory percentage of not exist collection -> fail
 		resp, err = proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
 			Base:            nil,
 			DbName:          dbName,
@@ -1274,7 +1284,7 @@ func TestProxy(t *testing.T) {
 	t.Run("get replicas", func(t *testing.T) {
 		defer wg.Done()
 
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
@@ -1480,7 +1490,8 @@ func TestProxy(t *testing.T) {
 	//         }
 	//         res, err := proxy.Query(ctx, queryReq)
 	//         assert.NoError(t, err)
-	//         assert.Equal(t, commonpb.ErrorCode_EmptyCollection, res.Status.ErrorCode)
+	//         assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+	//         assert.Equal(t, EmptyResultReason, res.Status.Reason)
 	//     })
 	// }
 
@@ -1570,7 +1581,7 @@ func TestProxy(t *testing.T) {
 			Base: nil,
 		})
 		assert.NoError(t, err)
-		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
 	})
 
 	// TODO(dragondriver): dummy
@@ -1611,6 +1622,22 @@ func TestProxy(t *testing.T) {
 		resp, err = proxy.GetMetrics(ctx, notImplemented)
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, metricsinfo.MsgUnimplementedMetric, resp.Status.Reason)
+
+		// collection metrics
+		req, err = metricsinfo.ConstructRequestByMetricType(metricsinfo.CollectionStatsMetrics)
+		assert.NoError(t, err)
+		resp, err = proxy.GetMetrics(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		var collectionsMetrics metricsinfo.CollectionsMetrics
+		assert.NoError(t, metricsinfo.UnmarshalCollectionsMetrics(resp.Response, &collectionsMetrics))
+		assert.NotEmpty(t, collectionsMetrics.Collections)
+
+		// get from cache
+		cached, err := proxy.GetMetrics(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, resp, cached)
 	})
 
 	wg.Add(1)
@@ -1694,7 +1721,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		_, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		_, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1729,13 +1756,26 @@ func TestProxy(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 		assert.Equal(t, 0, len(resp.CollectionNames))
+
+		// released collection is shown with zero in-memory percentage
+		resp, err = proxy.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base:            nil,
+			DbName:          dbName,
+			TimeStamp:       0,
+			Type:            milvuspb.ShowType_InMemory,
+			CollectionNames: []string{collectionName},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, []string{collectionName}, resp.CollectionNames)
+		assert.Equal(t, []int64{0}, resp.InMemoryPercentages)
 	})
 
 	pLoaded := true
 	wg.Add(1)
 	t.Run("load partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.LoadPartitions(ctx, &milvuspb.LoadPartitionsRequest{
@@ -1808,7 +1848,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1945,7 +1985,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1957,8 +1997,8 @@ func TestProxy(t *testing.T) {
 			Type:           milvuspb.ShowType_InMemory,
 		})
 		assert.NoError(t, err)
-		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
-		// default partition
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		// no partition loaded
 		assert.Equal(t, 0, len(resp.PartitionNames))
 
 		resp, err = proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1970,6 +2010,20 @@ func TestProxy(t *testing.T) {
 			Type:           milvuspb.ShowType_InMemory,
 		})
 		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, []string{partitionName}, resp.PartitionNames)
+		assert.Equal(t, []int64{0}, resp.InMemoryPercentages)
+
+		// not exist partition -> fail
+		resp, err = proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			CollectionID:   collectionID,
+			PartitionNames: []string{otherPartitionName},
+			Type:           milvuspb.ShowType_InMemory,
+		})
+		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
@@ -2023,7 +2077,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -2070,7 +2124,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		_, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		_, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -3698,6 +3752,97 @@ func TestProxy_GetComponentStates(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 }
 
+func TestProxy_GetComponentStates_reason(t *testing.T) {
+	p := &Proxy{}
+	p.stateCode.Store(internalpb.StateCode_Abnormal)
+	states, err := p.GetComponentStates(context.Background())
+	assert.NoError(t, err)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, states.State.ExtraInfo)
+	assert.Error(t, err)
+
+	p.SetStateReason("RootCoord is not healthy")
+	states, err = p.GetComponentStates(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.StateCode_Abnormal, states.State.StateCode)
+	reason, err := funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, states.State.ExtraInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "RootCoord is not healthy", reason)
+
+	p.SetStateReason("")
+	states, err = p.GetComponentStates(context.Background())
+	assert.NoError(t, err)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, states.State.ExtraInfo)
+	assert.Error(t, err)
+}
+
+func TestProxy_GetComponentStates_info(t *testing.T) {
+	Params.Init()
+	t.Setenv(metricsinfo.GitBuildTagsEnvKey, "v2.2.0")
+	t.Setenv(metricsinfo.GitCommitEnvKey, "abcdef")
+	defer func(address string, createdTime time.Time) {
+		Params.ProxyCfg.NetworkAddress = address
+		Params.ProxyCfg.CreatedTime = createdTime
+	}(Params.ProxyCfg.NetworkAddress, Params.ProxyCfg.CreatedTime)
+	Params.ProxyCfg.NetworkAddress = "localhost:19529"
+	Params.ProxyCfg.CreatedTime = time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = nil
+
+	p := &Proxy{}
+	p.stateCode.Store(internalpb.StateCode_Initializing)
+	states, err := p.GetComponentStates(context.Background())
+	assert.NoError(t, err)
+	for key, expected := range map[string]string{
+		BuildVersionKey: "v2.2.0",
+		GitCommitKey:    "abcdef",
+		StartTimeKey:    "2022-09-01T00:00:00Z",
+		AddressKey:      "localhost:19529",
+	} {
+		value, err := funcutil.GetAttrByKeyFromRepeatedKV(key, states.State.ExtraInfo)
+		assert.NoError(t, err, key)
+		assert.Equal(t, expected, value, key)
+	}
+	// the subcomponents are not created yet
+	require.Len(t, states.SubcomponentStates, 3)
+	for i, role := range []string{TaskSchedulerRole, ChannelsMgrRole, MetaCacheRole} {
+		assert.Equal(t, role, states.SubcomponentStates[i].Role)
+		assert.Equal(t, internalpb.StateCode_Initializing, states.SubcomponentStates[i].StateCode)
+	}
+
+	ctx := context.Background()
+	p.sched, err = newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	p.sched.dqQueue.setMaxTaskNum(1)
+	require.NoError(t, p.sched.dqQueue.Enqueue(newDefaultMockDqlTask()))
+	p.chMgr = newMockChannelsMgr()
+	globalMetaCache = newMockCache()
+
+	states, err = p.GetComponentStates(ctx)
+	assert.NoError(t, err)
+	require.Len(t, states.SubcomponentStates, 3)
+	sched := states.SubcomponentStates[0]
+	assert.Equal(t, internalpb.StateCode_Abnormal, sched.StateCode)
+	unissued, err := funcutil.GetAttrByKeyFromRepeatedKV("dq_unissued_tasks", sched.ExtraInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", unissued)
+	reason, err := funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, sched.ExtraInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "task queues dq are full", reason)
+
+	chMgr := states.SubcomponentStates[1]
+	assert.Equal(t, internalpb.StateCode_Healthy, chMgr.StateCode)
+	streams, err := funcutil.GetAttrByKeyFromRepeatedKV("dml_streams", chMgr.ExtraInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "0", streams)
+
+	cache := states.SubcomponentStates[2]
+	assert.Equal(t, internalpb.StateCode_Healthy, cache.StateCode)
+	collections, err := funcutil.GetAttrByKeyFromRepeatedKV("collections", cache.ExtraInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "0", collections)
+}
+
 func TestProxy_GetComponentStates_state_code(t *testing.T) {
 	p := &Proxy{}
 	p.stateCode.Store("not internalpb.StateCode")
@@ -3849,6 +3994,149 @@ func TestProxy_ListImportTasks(t *testing.T) {
 		assert.EqualValues(t, unhealthyStatus(), resp.Status)
 		assert.Nil(t, err)
 	})
+
+	t.Run("test list import tasks of collection", func(t *testing.T) {
+		defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+		cache := newMockCache()
+		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+			if collectionName == "c1" || collectionName == "a1" {
+				return 100, nil
+			}
+			return 0, errors.New("collection not found")
+		})
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			// the alias a1 refers to c1
+			return &schemapb.CollectionSchema{Name: "c1"}, nil
+		})
+		globalMetaCache = cache
+
+		tasks := []*milvuspb.GetImportStateResponse{
+			{Id: 1, Infos: []*commonpb.KeyValuePair{{Key: "collection", Value: "c1"}}},
+			{Id: 2, Infos: []*commonpb.KeyValuePair{{Key: "collection", Value: "c2"}}},
+			{Id: 3, Infos: []*commonpb.KeyValuePair{{Key: "collection", Value: "c1"}}},
+		}
+		rc := newMockRootCoord()
+		var listedCollectionID int64
+		// the rootcoord lists all the tasks regardless of the collection, the proxy filters them
+		rc.ListImportTasksFunc = func(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
+			listedCollectionID = req.GetCollectionID()
+			return &milvuspb.ListImportTasksResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Tasks:  tasks,
+			}, nil
+		}
+		proxy := &Proxy{rootCoord: rc}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		resp, err := proxy.ListImportTasks(context.TODO(), &milvuspb.ListImportTasksRequest{CollectionName: "c1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		ids := make([]int64, 0, len(resp.GetTasks()))
+		for _, task := range resp.GetTasks() {
+			ids = append(ids, task.GetId())
+		}
+		assert.ElementsMatch(t, []int64{1, 3}, ids)
+		assert.Equal(t, int64(100), listedCollectionID)
+
+		resp, err = proxy.ListImportTasks(context.TODO(), &milvuspb.ListImportTasksRequest{CollectionName: "a1"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(resp.GetTasks()))
+		for _, task := range resp.GetTasks() {
+			assert.Equal(t, "c1", task.GetInfos()[0].GetValue())
+		}
+
+		resp, err = proxy.ListImportTasks(context.TODO(), &milvuspb.ListImportTasksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(resp.GetTasks()))
+		assert.Equal(t, int64(0), listedCollectionID)
+
+		resp, err = proxy.ListImportTasks(context.TODO(), &milvuspb.ListImportTasksRequest{CollectionName: "c3"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetTasks())
+	})
+}
+
+func TestProxy_AllocTimestamp(t *testing.T) {
+	ctx := context.Background()
+	tsoAllocator, err := newTimestampAllocator(ctx, newMockTimestampAllocatorInterface(), 1)
+	assert.NoError(t, err)
+
+	t.Run("monotonic timestamps", func(t *testing.T) {
+		proxy := &Proxy{tsoAllocator: tsoAllocator}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		var last Timestamp
+		for i := 0; i < 10; i++ {
+			resp, err := proxy.AllocTimestamp(ctx, &milvuspb.AllocTimestampRequest{})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+			assert.Greater(t, resp.GetTimestamp(), last)
+			last = resp.GetTimestamp()
+		}
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		proxy := &Proxy{tsoAllocator: tsoAllocator, allocTsLimiter: newClientRateLimiter(1)}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+
+		var resp *milvuspb.AllocTimestampResponse
+		for i := 0; i < 3; i++ {
+			resp, err = proxy.AllocTimestamp(ctx, &milvuspb.AllocTimestampRequest{})
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		proxy := &Proxy{tsoAllocator: tsoAllocator}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.AllocTimestamp(ctx, &milvuspb.AllocTimestampRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+	})
+}
+
+func TestProxy_CheckHealth(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	t.Run("healthy", func(t *testing.T) {
+		proxy := &Proxy{}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		now := time.Now()
+		globalClockSkew.observe(tsoutil.ComposeTSByTime(now, 0), now, now)
+
+		resp, err := proxy.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, resp.GetIsHealthy())
+		assert.Empty(t, resp.GetReasons())
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		proxy := &Proxy{}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		now := time.Now()
+		skewed := now.Add(-Params.ProxyCfg.ClockSkewUnhealthyDuration)
+		globalClockSkew.observe(tsoutil.ComposeTSByTime(skewed.Add(-2*Params.ProxyCfg.ClockSkewUnhealthyThreshold), 0), skewed, skewed)
+		defer globalClockSkew.observe(tsoutil.ComposeTSByTime(now, 0), now, now)
+
+		resp, err := proxy.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.False(t, resp.GetIsHealthy())
+		assert.Len(t, resp.GetReasons(), 1)
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		proxy := &Proxy{}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+		assert.False(t, resp.GetIsHealthy())
+	})
 }
 
 func TestProxy_GetStatistics(t *testing.T) {
@@ -38,6 +38,10 @@ type queryCoordShowCollectionsFuncType func(ctx context.Context, request *queryp
 
 type queryCoordShowPartitionsFuncType func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error)
 
+type queryCoordGetReplicasFuncType func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
+type queryCoordGetSegmentInfoFuncType func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+type queryCoordLoadBalanceFuncType func(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
+
 type queryCoordShowConfigurationsFuncType func(ctx context.Context, request *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 
 func SetQueryCoordShowCollectionsFunc(f queryCoordShowCollectionsFuncType) QueryCoordMockOption {
@@ -66,6 +70,9 @@ type QueryCoordMock struct {
 	showCollectionsFunc    queryCoordShowCollectionsFuncType
 	getMetricsFunc         getMetricsFuncType
 	showPartitionsFunc     queryCoordShowPartitionsFuncType
+	getReplicasFunc        queryCoordGetReplicasFuncType
+	getSegmentInfoFunc     queryCoordGetSegmentInfoFuncType
+	loadBalanceFunc        queryCoordLoadBalanceFuncType
 
 	statisticsChannel string
 	timeTickChannel   string
@@ -231,9 +238,10 @@ func (coord *QueryCoordMock) ReleaseCollection(ctx context.Context, req *querypb
 		}
 	}
 
+	// like querycoord, releasing a collection which is not loaded succeeds
 	return &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		Reason:    fmt.Sprintf("collection %v not loaded", req.CollectionID),
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
 	}, nil
 }
 
@@ -245,6 +253,18 @@ func (coord *QueryCoordMock) ResetShowPartitionsFunc() {
 	coord.showPartitionsFunc = nil
 }
 
+func (coord *QueryCoordMock) SetGetReplicasFunc(f queryCoordGetReplicasFuncType) {
+	coord.getReplicasFunc = f
+}
+
+func (coord *QueryCoordMock) SetGetSegmentInfoFunc(f queryCoordGetSegmentInfoFuncType) {
+	coord.getSegmentInfoFunc = f
+}
+
+func (coord *QueryCoordMock) SetLoadBalanceFunc(f queryCoordLoadBalanceFuncType) {
+	coord.loadBalanceFunc = f
+}
+
 func (coord *QueryCoordMock) ShowPartitions(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 	if coord.showPartitionsFunc != nil {
 		return coord.showPartitionsFunc(ctx, req)
@@ -297,6 +317,10 @@ func (coord *QueryCoordMock) GetSegmentInfo(ctx context.Context, req *querypb.Ge
 		}, nil
 	}
 
+	if coord.getSegmentInfoFunc != nil {
+		return coord.getSegmentInfoFunc(ctx, req)
+	}
+
 	panic("implement me")
 }
 
@@ -308,6 +332,10 @@ func (coord *QueryCoordMock) LoadBalance(ctx context.Context, req *querypb.LoadB
 		}, nil
 	}
 
+	if coord.loadBalanceFunc != nil {
+		return coord.loadBalanceFunc(ctx, req)
+	}
+
 	panic("implement me")
 }
 
@@ -368,6 +396,10 @@ func (coord *QueryCoordMock) GetReplicas(ctx context.Context, req *milvuspb.GetR
 		}, nil
 	}
 
+	if coord.getReplicasFunc != nil {
+		return coord.getReplicasFunc(ctx, req)
+	}
+
 	return &milvuspb.GetReplicasResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// QueryIteratorKey is the query param to query in pages ordered by primary key, the result of a page carries the
+	// cursor of the next page in QueryResults.QueryIteratorCursor. The page size is the limit.
+	QueryIteratorKey = "query_iterator"
+	// QueryIteratorCursorKey is the query param to get the next page of a query iterator.
+	QueryIteratorCursorKey = "query_iterator_cursor"
+
+	queryIteratorCursorVersion = 1
+)
+
+// queryIteratorCursor is the opaque cursor returned to the client.
+type queryIteratorCursor struct {
+	Version      int    `json:"v"`
+	CollectionID int64  `json:"collection_id"`
+	SnapshotTs   uint64 `json:"snapshot_ts"`
+	// the last primary key returned, one of them is set by the type of the primary key
+	LastIntPK *int64  `json:"last_int_pk,omitempty"`
+	LastStrPK *string `json:"last_str_pk,omitempty"`
+}
+
+// queryIterator pages through the rows of a query by primary key. All the pages are queried at the snapshot timestamp
+// fixed by the first page, so that the rows inserted or deleted after it never show up in the later pages.
+type queryIterator struct {
+	collectionID int64
+	// snapshotTs is zero until it's fixed by the first page
+	snapshotTs Timestamp
+	// lastPK is the last primary key returned, it's nil for the first page
+	lastPK interface{}
+}
+
+// isQueryIteratorRequest returns whether the query asks for a page of a query iterator.
+func isQueryIteratorRequest(queryParams []*commonpb.KeyValuePair) bool {
+	for _, kv := range queryParams {
+		if kv.GetKey() == QueryIteratorKey || kv.GetKey() == QueryIteratorCursorKey {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQueryIterator returns the iterator of the query, it's nil unless the query asks for it.
+func parseQueryIterator(queryParams []*commonpb.KeyValuePair, collectionID int64) (*queryIterator, error) {
+	if cursorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(QueryIteratorCursorKey, queryParams); err == nil {
+		return decodeQueryIteratorCursor(cursorStr, collectionID)
+	}
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(QueryIteratorKey, queryParams)
+	if err != nil {
+		return nil, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be true or false", QueryIteratorKey, value)
+	}
+	if !enabled {
+		return nil, nil
+	}
+	return &queryIterator{collectionID: collectionID}, nil
+}
+
+func decodeQueryIteratorCursor(cursorStr string, collectionID int64) (*queryIterator, error) {
+	invalid := func(reason string) error {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, %s", QueryIteratorCursorKey, reason)
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return nil, invalid("it's garbled")
+	}
+	cursor := &queryIteratorCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, invalid("it's garbled")
+	}
+	if cursor.Version != queryIteratorCursorVersion {
+		return nil, invalid("version " + strconv.Itoa(cursor.Version) + " is not supported")
+	}
+	if cursor.CollectionID != collectionID {
+		return nil, invalid("it's issued by another collection")
+	}
+	if cursor.SnapshotTs == 0 || (cursor.LastIntPK == nil) == (cursor.LastStrPK == nil) {
+		return nil, invalid("it's garbled")
+	}
+
+	it := &queryIterator{collectionID: collectionID, snapshotTs: cursor.SnapshotTs}
+	if cursor.LastIntPK != nil {
+		it.lastPK = *cursor.LastIntPK
+	} else {
+		it.lastPK = *cursor.LastStrPK
+	}
+	return it, nil
+}
+
+// checkParams checks the page size is set by the limit, the pages are positioned by the cursor instead of the offset.
+func (it *queryIterator) checkParams(params *queryParams) error {
+	if params.limit <= 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is required by %s as the page size", LimitKey, QueryIteratorKey)
+	}
+	if params.offset > 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is not supported with %s", OffsetKey, QueryIteratorKey)
+	}
+	return nil
+}
+
+// fixSnapshot returns the timestamp all the pages are queried at. The first page takes the travel timestamp of the
+// request, or now if it's not set. The snapshot must be within the retention duration, otherwise the rows of the
+// snapshot may be compacted away.
+func (it *queryIterator) fixSnapshot(travelTs Timestamp, now Timestamp) (Timestamp, error) {
+	if it.snapshotTs == 0 {
+		it.snapshotTs = travelTs
+		if it.snapshotTs == 0 {
+			it.snapshotTs = now
+		}
+	} else if travelTs != 0 && travelTs != it.snapshotTs {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"travel timestamp %d differs from the snapshot timestamp %d of %s", travelTs, it.snapshotTs, QueryIteratorCursorKey)
+	}
+	if err := validateTravelTimestamp(it.snapshotTs, now); err != nil {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s is expired, the snapshot is out of the retention: %s", QueryIteratorCursorKey, err.Error())
+	}
+	return it.snapshotTs, nil
+}
+
+// filterExpr returns the expression of the page, which skips the rows returned by the previous pages.
+func (it *queryIterator) filterExpr(expr string, pkField *schemapb.FieldSchema) (string, error) {
+	if it.lastPK == nil {
+		return expr, nil
+	}
+	var lastPK string
+	switch pk := it.lastPK.(type) {
+	case int64:
+		if pkField.GetDataType() != schemapb.DataType_Int64 {
+			return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, the primary key %s is %s",
+				QueryIteratorCursorKey, pkField.GetName(), pkField.GetDataType())
+		}
+		lastPK = strconv.FormatInt(pk, 10)
+	case string:
+		if pkField.GetDataType() != schemapb.DataType_VarChar {
+			return "", newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, the primary key %s is %s",
+				QueryIteratorCursorKey, pkField.GetName(), pkField.GetDataType())
+		}
+		lastPK = strconv.Quote(pk)
+	}
+	return fmt.Sprintf("(%s) and %s > %s", expr, pkField.GetName(), lastPK), nil
+}
+
+// reduce orders the merged rows by primary key and keeps the first limit ones, the rows are returned by query nodes
+// in no particular order. It returns the cursor of the next page, which is empty if the rows are used up.
+func (it *queryIterator) reduce(result *milvuspb.QueryResults, pkIndex int, limit int64) (string, error) {
+	if pkIndex < 0 || pkIndex >= len(result.GetFieldsData()) {
+		return "", nil
+	}
+	pkData := result.GetFieldsData()[pkIndex].GetScalars()
+	var (
+		num  int
+		less func(i, j int) bool
+	)
+	switch {
+	case pkData.GetLongData() != nil:
+		pks := pkData.GetLongData().GetData()
+		num, less = len(pks), func(i, j int) bool { return pks[i] < pks[j] }
+	case pkData.GetStringData() != nil:
+		pks := pkData.GetStringData().GetData()
+		num, less = len(pks), func(i, j int) bool { return pks[i] < pks[j] }
+	default:
+		return "", fmt.Errorf("unexpected primary key data of query results")
+	}
+
+	order := make([]int, num)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return less(order[i], order[j]) })
+	if int64(len(order)) > limit {
+		order = order[:limit]
+	}
+	fieldsData := make([]*schemapb.FieldData, len(result.GetFieldsData()))
+	for _, row := range order {
+		typeutil.AppendFieldData(fieldsData, result.GetFieldsData(), int64(row))
+	}
+	result.FieldsData = fieldsData
+
+	if int64(len(order)) < limit {
+		return "", nil
+	}
+	cursor := &queryIteratorCursor{
+		Version:      queryIteratorCursorVersion,
+		CollectionID: it.collectionID,
+		SnapshotTs:   it.snapshotTs,
+	}
+	pkData = fieldsData[pkIndex].GetScalars()
+	if pks := pkData.GetLongData().GetData(); len(pks) > 0 {
+		cursor.LastIntPK = &pks[len(pks)-1]
+	} else {
+		pks := pkData.GetStringData().GetData()
+		cursor.LastStrPK = &pks[len(pks)-1]
+	}
+	bs, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
@@ -0,0 +1,342 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestParseQueryIterator(t *testing.T) {
+	Params.Init()
+	kv := func(key, value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: key, Value: value}}
+	}
+	encode := func(cursor *queryIteratorCursor) string {
+		bs, err := json.Marshal(cursor)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(bs)
+	}
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	snapshot := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+	lastPK := int64(10)
+	validCursor := func() *queryIteratorCursor {
+		return &queryIteratorCursor{Version: queryIteratorCursorVersion, CollectionID: 1, SnapshotTs: snapshot, LastIntPK: &lastPK}
+	}
+
+	it, err := parseQueryIterator(nil, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, it)
+	_, err = parseQueryIterator(kv(QueryIteratorKey, "yes please"), 1)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+	// the first page takes the travel timestamp, or now
+	it, err = parseQueryIterator(kv(QueryIteratorKey, "true"), 1)
+	require.NoError(t, err)
+	require.NotNil(t, it)
+	ts, err := it.fixSnapshot(0, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now, ts)
+	it, _ = parseQueryIterator(kv(QueryIteratorKey, "true"), 1)
+	ts, err = it.fixSnapshot(snapshot, now)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot, ts)
+
+	// the page size is the limit
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(it.checkParams(&queryParams{})))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(it.checkParams(&queryParams{limit: 10, offset: 10})))
+	assert.NoError(t, it.checkParams(&queryParams{limit: 10}))
+
+	it, err = parseQueryIterator(kv(QueryIteratorCursorKey, encode(validCursor())), 1)
+	require.NoError(t, err)
+	assert.Equal(t, lastPK, it.lastPK)
+	ts, err = it.fixSnapshot(0, now)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot, ts)
+	_, err = it.fixSnapshot(now, now)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+	invalidCursors := map[string]string{
+		"not base64": "!!!",
+		"not json":   base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		"version": encode(func() *queryIteratorCursor {
+			cursor := validCursor()
+			cursor.Version = queryIteratorCursorVersion + 1
+			return cursor
+		}()),
+		"collection": encode(func() *queryIteratorCursor {
+			cursor := validCursor()
+			cursor.CollectionID = 2
+			return cursor
+		}()),
+		"no primary key": encode(func() *queryIteratorCursor {
+			cursor := validCursor()
+			cursor.LastIntPK = nil
+			return cursor
+		}()),
+	}
+	for name, cursor := range invalidCursors {
+		_, err := parseQueryIterator(kv(QueryIteratorCursorKey, cursor), 1)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), name)
+	}
+
+	// the snapshot out of the retention is expired
+	expired := validCursor()
+	expired.SnapshotTs = tsoutil.ComposeTSByTime(time.Now().Add(-time.Duration(Params.CommonCfg.RetentionDuration+60)*time.Second), 0)
+	it, err = parseQueryIterator(kv(QueryIteratorCursorKey, encode(expired)), 1)
+	require.NoError(t, err)
+	_, err = it.fixSnapshot(0, now)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestQueryIterator_filterExpr(t *testing.T) {
+	int64PK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	varCharPK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	expr, err := (&queryIterator{}).filterExpr("age > 1", int64PK)
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 1", expr)
+
+	expr, err = (&queryIterator{lastPK: int64(10)}).filterExpr("age > 1", int64PK)
+	assert.NoError(t, err)
+	assert.Equal(t, "(age > 1) and pk > 10", expr)
+	expr, err = (&queryIterator{lastPK: `a"b`}).filterExpr("age > 1", varCharPK)
+	assert.NoError(t, err)
+	assert.Equal(t, `(age > 1) and pk > "a\"b"`, expr)
+
+	_, err = (&queryIterator{lastPK: int64(10)}).filterExpr("age > 1", varCharPK)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	_, err = (&queryIterator{lastPK: "a"}).filterExpr("age > 1", int64PK)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+// queryIteratorRow is a row of the synthetic shards.
+type queryIteratorRow struct {
+	pk    interface{}
+	ts    Timestamp
+	value int64
+}
+
+func TestQueryIterator_Pages(t *testing.T) {
+	Params.Init()
+	const (
+		rowNum   = 50
+		shardNum = 3
+		limit    = 7
+	)
+	ctx := context.Background()
+	snapshot := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+
+	for _, pkType := range []schemapb.DataType{schemapb.DataType_Int64, schemapb.DataType_VarChar} {
+		t.Run(pkType.String(), func(t *testing.T) {
+			newPK := func(i int) interface{} { return int64(i * 3) }
+			if pkType == schemapb.DataType_VarChar {
+				newPK = func(i int) interface{} { return fmt.Sprintf("pk_%03d", i*3) }
+			}
+
+			// every 5th row is inserted after the snapshot, the rows are spread across the shards in random order
+			shards := make([][]queryIteratorRow, shardNum)
+			var expected []queryIteratorRow
+			for _, i := range rand.Perm(rowNum) {
+				row := queryIteratorRow{pk: newPK(i), ts: snapshot - 1, value: int64(i)}
+				if i%5 == 0 {
+					row.ts = snapshot + 1
+				}
+				shards[i%shardNum] = append(shards[i%shardNum], row)
+				if i%7 == 0 {
+					// a duplicate served by another shard, e.g. during handoff
+					shards[(i+1)%shardNum] = append(shards[(i+1)%shardNum], row)
+				}
+			}
+			for i := 0; i < rowNum; i++ {
+				if i%5 != 0 {
+					expected = append(expected, queryIteratorRow{pk: newPK(i), ts: snapshot - 1, value: int64(i)})
+				}
+			}
+
+			pkGreater := func(a, b interface{}) bool {
+				if pk, ok := a.(int64); ok {
+					return pk > b.(int64)
+				}
+				return a.(string) > b.(string)
+			}
+			// queryShard plays a query node returning the rows after the cursor visible at the travel timestamp
+			queryShard := func(rows []queryIteratorRow, lastPK interface{}, travelTs Timestamp) *internalpb.RetrieveResults {
+				result := &internalpb.RetrieveResults{Ids: &schemapb.IDs{}}
+				pks := &schemapb.IDs{}
+				var values []int64
+				for _, row := range rows {
+					if row.ts > travelTs {
+						continue
+					}
+					if lastPK != nil && !pkGreater(row.pk, lastPK) {
+						continue
+					}
+					typeutil.AppendPKs(result.Ids, row.pk)
+					typeutil.AppendPKs(pks, row.pk)
+					values = append(values, row.value)
+				}
+				pkField := &schemapb.FieldData{Type: pkType, FieldId: 100}
+				if pkType == schemapb.DataType_Int64 {
+					pkField.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks.GetIntId().GetData()}}}}
+				} else {
+					pkField.Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: pks.GetStrId().GetData()}}}}
+				}
+				result.FieldsData = []*schemapb.FieldData{
+					{Type: schemapb.DataType_Int64, FieldId: 101, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}}}}},
+					pkField,
+				}
+				return result
+			}
+
+			var returned []queryIteratorRow
+			queryParams := []*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "true"}}
+			// only the first page sets the travel timestamp, the later pages are queried at the snapshot of the cursor
+			travelTs := snapshot
+			for page := 0; ; page++ {
+				require.Less(t, page, rowNum, "the pages never end")
+				it, err := parseQueryIterator(queryParams, 1)
+				require.NoError(t, err)
+				snapshotTs, err := it.fixSnapshot(travelTs, tsoutil.ComposeTSByTime(time.Now(), 0))
+				require.NoError(t, err)
+				require.Equal(t, snapshot, snapshotTs)
+
+				results := make([]*internalpb.RetrieveResults, 0, shardNum)
+				for _, rows := range shards {
+					results = append(results, queryShard(rows, it.lastPK, snapshotTs))
+				}
+				result, err := mergeRetrieveResults(ctx, results, true)
+				require.NoError(t, err)
+				cursor, err := it.reduce(result, 1, limit)
+				require.NoError(t, err)
+
+				if len(result.GetFieldsData()) > 0 {
+					values := result.GetFieldsData()[0].GetScalars().GetLongData().GetData()
+					assert.LessOrEqual(t, len(values), limit)
+					for i, value := range values {
+						var pk interface{}
+						if pkType == schemapb.DataType_Int64 {
+							pk = result.GetFieldsData()[1].GetScalars().GetLongData().GetData()[i]
+						} else {
+							pk = result.GetFieldsData()[1].GetScalars().GetStringData().GetData()[i]
+						}
+						returned = append(returned, queryIteratorRow{pk: pk, ts: snapshot - 1, value: value})
+					}
+				}
+				if cursor == "" {
+					break
+				}
+				queryParams = []*commonpb.KeyValuePair{{Key: QueryIteratorCursorKey, Value: cursor}}
+				travelTs = 0
+			}
+
+			// every row of the snapshot is returned once in primary key order, the rows inserted after it never are
+			assert.Equal(t, expected, returned)
+		})
+	}
+}
+
+func TestProxy_Query_Iterator(t *testing.T) {
+	Params.Init()
+	if rateCol == nil {
+		var err error
+		rateCol, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+		require.NoError(t, err)
+	}
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 1, nil
+	})
+	globalMetaCache = cache
+	ctx := context.Background()
+
+	node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+	var err error
+	node.queryResultCache, err = newQueryResultCache(10, time.Minute)
+	require.NoError(t, err)
+
+	// the query tasks are popped from the dqQueue and finished with the result instead of being executed
+	query := func(request *milvuspb.QueryRequest, cursor string) *milvuspb.QueryResults {
+		done := make(chan *milvuspb.QueryResults, 1)
+		go func() {
+			resp, err := node.Query(ctx, request)
+			assert.NoError(t, err)
+			done <- resp
+		}()
+		var popped task
+		require.Eventually(t, func() bool {
+			popped = node.sched.dqQueue.PopUnissuedTask()
+			return popped != nil
+		}, time.Second, time.Millisecond)
+		popped.(*queryTask).result = &milvuspb.QueryResults{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionName:      request.GetCollectionName(),
+			QueryIteratorCursor: cursor,
+		}
+		popped.Notify(nil)
+		return <-done
+	}
+
+	request := newTestQueryRequest("coll", "int64 > 0")
+	request.QueryParams = []*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "true"}, {Key: LimitKey, Value: "10"}}
+	resp := query(request, "cursor1")
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, "coll", resp.GetCollectionName())
+	assert.Equal(t, "cursor1", resp.GetQueryIteratorCursor())
+	_, _, ok := node.queryResultCache.get(newQueryResultCacheKey(1, request.GetGuaranteeTimestamp(), request))
+	assert.False(t, ok)
+
+	// the same page is queried again instead of being served by the cache
+	resp = query(request, "cursor2")
+	assert.Equal(t, "cursor2", resp.GetQueryIteratorCursor())
+
+	request = newTestQueryRequest("coll", "int64 > 0")
+	request.QueryParams = []*commonpb.KeyValuePair{{Key: QueryIteratorCursorKey, Value: "cursor2"}, {Key: LimitKey, Value: "10"}}
+	resp = query(request, "")
+	assert.Equal(t, "coll", resp.GetCollectionName())
+	_, _, ok = node.queryResultCache.get(newQueryResultCacheKey(1, request.GetGuaranteeTimestamp(), request))
+	assert.False(t, ok)
+
+	// the other queries are still cached
+	request = newTestQueryRequest("coll", "int64 > 0")
+	request.GuaranteeTimestamp = eventuallyTS
+	resp = query(request, "")
+	assert.Equal(t, "coll", resp.GetCollectionName())
+	cached, _, ok := node.queryResultCache.get(newQueryResultCacheKey(1, eventuallyTS, request))
+	assert.True(t, ok)
+	assert.Equal(t, "coll", cached.GetCollectionName())
+}
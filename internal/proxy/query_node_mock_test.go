@@ -18,7 +18,9 @@ package proxy
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/types"
 
@@ -43,6 +45,10 @@ type QueryNodeMock struct {
 	queryError             error
 	searchError            error
 	statisticsError        error
+
+	mu              sync.Mutex
+	searchRequests  []*querypb.SearchRequest // search requests received
+	searchDeadlines []time.Time              // deadlines of the contexts of the search requests, zero if not set
 }
 
 func (m *QueryNodeMock) GetStatistics(ctx context.Context, req *querypb.GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error) {
@@ -53,6 +59,11 @@ func (m *QueryNodeMock) GetStatistics(ctx context.Context, req *querypb.GetStati
 }
 
 func (m *QueryNodeMock) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	m.mu.Lock()
+	m.searchRequests = append(m.searchRequests, req)
+	deadline, _ := ctx.Deadline()
+	m.searchDeadlines = append(m.searchDeadlines, deadline)
+	m.mu.Unlock()
 	if m.searchError != nil {
 		return nil, m.searchError
 	}
@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// queryResultCacheKey identifies the identical query requests. The collection is identified by its id,
+// so that the requests on a collection and its aliases share the cached results. The guarantee timestamp
+// is the effective one of the request, either boundedTS or eventuallyTS.
+type queryResultCacheKey struct {
+	collectionID   UniqueID
+	expr           string
+	outputFields   string
+	partitions     string
+	queryParams    string
+	templateValues string
+	travelTs       uint64
+	guaranteeTs    uint64
+}
+
+type queryResultCacheEntry struct {
+	key      queryResultCacheKey
+	result   *milvuspb.QueryResults
+	expireAt time.Time
+}
+
+// queryResultCache caches the results of query requests, the cached results of a collection are
+// dropped when the collection is invalidated or the entries expire. Only the queries of Bounded or
+// Eventually consistency are cached, since they accept the results staler than the ttl.
+//
+// Every invalidation assigns the collection a new generation, a result is only cached if the
+// generation doesn't change since the query started, so that a query racing with an invalidation
+// never caches the stale result.
+// A nil queryResultCache is valid and caches nothing.
+type queryResultCache struct {
+	mu           sync.Mutex
+	capacity     int
+	ttl          time.Duration
+	evictList    *list.List
+	items        map[queryResultCacheKey]*list.Element
+	byCollection map[UniqueID]map[queryResultCacheKey]*list.Element
+	generations  map[UniqueID]uint64
+	lastGen      uint64
+}
+
+func newQueryResultCache(size int, ttl time.Duration) (*queryResultCache, error) {
+	if size <= 0 {
+		return nil, errors.New("cache size must be positive")
+	}
+	return &queryResultCache{
+		capacity:     size,
+		ttl:          ttl,
+		evictList:    list.New(),
+		items:        make(map[queryResultCacheKey]*list.Element),
+		byCollection: make(map[UniqueID]map[queryResultCacheKey]*list.Element),
+		generations:  make(map[UniqueID]uint64),
+	}, nil
+}
+
+// queryResultCacheGuaranteeTs returns the effective guarantee timestamp of the query request, false if the request
+// isn't of Bounded or Eventually consistency and its result mustn't be served from the cache. The consistency is
+// resolved the same way as the query task does, see resolveGuaranteeTs.
+func queryResultCacheGuaranteeTs(ctx context.Context, request *milvuspb.QueryRequest) (Timestamp, bool) {
+	if !request.GetUseDefaultConsistency() {
+		guaranteeTs := request.GetGuaranteeTimestamp()
+		return guaranteeTs, guaranteeTs == boundedTS || guaranteeTs == eventuallyTS
+	}
+	info, err := globalMetaCache.GetCollectionInfo(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil || info == nil {
+		return 0, false
+	}
+	switch defaultConsistencyLevel(info.consistencyLevel) {
+	case commonpb.ConsistencyLevel_Bounded:
+		return boundedTS, true
+	case commonpb.ConsistencyLevel_Eventually:
+		return eventuallyTS, true
+	}
+	return 0, false
+}
+
+func newQueryResultCacheKey(collectionID UniqueID, guaranteeTs Timestamp, request *milvuspb.QueryRequest) queryResultCacheKey {
+	queryParams := make([]string, 0, len(request.GetQueryParams()))
+	for _, kv := range request.GetQueryParams() {
+		queryParams = append(queryParams, kv.GetKey()+"="+kv.GetValue())
+	}
+	sort.Strings(queryParams)
+
+	templateValues := make([]string, 0, len(request.GetExprTemplateValues()))
+	for name, value := range request.GetExprTemplateValues() {
+		templateValues = append(templateValues, name+"="+value.String())
+	}
+	sort.Strings(templateValues)
+
+	return queryResultCacheKey{
+		collectionID:   collectionID,
+		expr:           request.GetExpr(),
+		outputFields:   strings.Join(request.GetOutputFields(), ","),
+		partitions:     strings.Join(request.GetPartitionNames(), ","),
+		queryParams:    strings.Join(queryParams, ","),
+		templateValues: strings.Join(templateValues, ","),
+		travelTs:       request.GetTravelTimestamp(),
+		guaranteeTs:    guaranteeTs,
+	}
+}
+
+// get returns the cached result of the key if there is one and it doesn't expire,
+// along with the current generation of the collection which should be passed to put on a miss.
+func (c *queryResultCache) get(key queryResultCacheKey) (*milvuspb.QueryResults, uint64, bool) {
+	if c == nil {
+		return nil, 0, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	generation := c.generations[key.collectionID]
+	e, ok := c.items[key]
+	if !ok {
+		return nil, generation, false
+	}
+	entry := e.Value.(*queryResultCacheEntry)
+	if time.Now().After(entry.expireAt) {
+		c.removeElement(e)
+		return nil, generation, false
+	}
+	c.evictList.MoveToFront(e)
+	return entry.result, generation, true
+}
+
+// put caches the result of the key, the result is dropped if the collection is invalidated
+// after the generation was got.
+func (c *queryResultCache) put(key queryResultCacheKey, generation uint64, result *milvuspb.QueryResults) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generations[key.collectionID] != generation {
+		return
+	}
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+	e := c.evictList.PushFront(&queryResultCacheEntry{
+		key:      key,
+		result:   result,
+		expireAt: time.Now().Add(c.ttl),
+	})
+	c.items[key] = e
+	keys, ok := c.byCollection[key.collectionID]
+	if !ok {
+		keys = make(map[queryResultCacheKey]*list.Element)
+		c.byCollection[key.collectionID] = keys
+	}
+	keys[key] = e
+	for c.evictList.Len() > c.capacity {
+		c.removeElement(c.evictList.Back())
+	}
+}
+
+// removeElement must be called with the lock held.
+func (c *queryResultCache) removeElement(e *list.Element) {
+	entry := c.evictList.Remove(e).(*queryResultCacheEntry)
+	delete(c.items, entry.key)
+	keys := c.byCollection[entry.key.collectionID]
+	delete(keys, entry.key)
+	if len(keys) == 0 {
+		delete(c.byCollection, entry.key.collectionID)
+	}
+}
+
+// invalidate drops all the cached results of the collection, the queries in flight won't cache their results.
+func (c *queryResultCache) invalidate(collectionID UniqueID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastGen++
+	c.generations[collectionID] = c.lastGen
+	for _, e := range c.byCollection[collectionID] {
+		c.removeElement(e)
+	}
+}
+
+// drop drops all the cached results and the generation of the dropped collection. A query racing with the drop
+// may still cache its result, which is never hit since the collection ids aren't reused, and is evicted in time.
+func (c *queryResultCache) drop(collectionID UniqueID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.generations, collectionID)
+	for _, e := range c.byCollection[collectionID] {
+		c.removeElement(e)
+	}
+}
+
+func (c *queryResultCache) len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictList.Len()
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func newTestQueryRequest(collectionName string, expr string) *milvuspb.QueryRequest {
+	return &milvuspb.QueryRequest{
+		CollectionName: collectionName,
+		Expr:           expr,
+		OutputFields:   []string{testInt64Field},
+	}
+}
+
+func newTestQueryCacheKey(collectionID UniqueID, collectionName string, expr string) queryResultCacheKey {
+	return newQueryResultCacheKey(collectionID, eventuallyTS, newTestQueryRequest(collectionName, expr))
+}
+
+func TestQueryResultCache(t *testing.T) {
+	result := &milvuspb.QueryResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *queryResultCache
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+		_, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+		c.invalidate(1)
+		assert.Equal(t, 0, c.len())
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, err := newQueryResultCache(0, time.Minute)
+		assert.Error(t, err)
+	})
+
+	t.Run("cache hit", func(t *testing.T) {
+		c, err := newQueryResultCache(10, time.Minute)
+		require.NoError(t, err)
+
+		_, gen, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), gen, result)
+		ret, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.True(t, ok)
+		assert.Equal(t, result, ret)
+
+		// the requests on an alias share the results of the collection
+		ret, _, ok = c.get(newTestQueryCacheKey(1, "alias", "int64 > 0"))
+		assert.True(t, ok)
+		assert.Equal(t, result, ret)
+
+		// different collection, expr, output fields or guarantee timestamp miss the cache
+		_, _, ok = c.get(newTestQueryCacheKey(2, "test", "int64 > 0"))
+		assert.False(t, ok)
+		_, _, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 1"))
+		assert.False(t, ok)
+		req := newTestQueryRequest("test", "int64 > 0")
+		req.OutputFields = []string{testFloatField}
+		_, _, ok = c.get(newQueryResultCacheKey(1, eventuallyTS, req))
+		assert.False(t, ok)
+		_, _, ok = c.get(newQueryResultCacheKey(1, boundedTS, newTestQueryRequest("test", "int64 > 0")))
+		assert.False(t, ok)
+	})
+
+	t.Run("ttl miss", func(t *testing.T) {
+		c, err := newQueryResultCache(10, time.Millisecond)
+		require.NoError(t, err)
+
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+		time.Sleep(10 * time.Millisecond)
+		_, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.len())
+	})
+
+	t.Run("lru eviction", func(t *testing.T) {
+		c, err := newQueryResultCache(2, time.Minute)
+		require.NoError(t, err)
+
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 1"), 0, result)
+		_, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.True(t, ok)
+		c.put(newTestQueryCacheKey(2, "other", "int64 > 0"), 0, result)
+		assert.Equal(t, 2, c.len())
+		_, _, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 1"))
+		assert.False(t, ok)
+		_, _, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.True(t, ok)
+
+		c.invalidate(1)
+		assert.Equal(t, 1, c.len())
+		assert.Len(t, c.byCollection, 1)
+	})
+
+	t.Run("invalidation miss", func(t *testing.T) {
+		c, err := newQueryResultCache(10, time.Minute)
+		require.NoError(t, err)
+
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+		c.put(newTestQueryCacheKey(2, "other", "int64 > 0"), 0, result)
+		c.invalidate(1)
+		_, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+		_, _, ok = c.get(newTestQueryCacheKey(2, "other", "int64 > 0"))
+		assert.True(t, ok)
+	})
+
+	t.Run("put after invalidation", func(t *testing.T) {
+		c, err := newQueryResultCache(10, time.Minute)
+		require.NoError(t, err)
+
+		// the query starts before the invalidation and finishes after it
+		_, gen, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+		c.invalidate(1)
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), gen, result)
+		_, gen, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), gen, result)
+		_, _, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.True(t, ok)
+	})
+
+	t.Run("invalidated by meta cache invalidation", func(t *testing.T) {
+		c, err := newQueryResultCache(10, time.Minute)
+		require.NoError(t, err)
+
+		cache := globalMetaCache
+		defer func() { globalMetaCache = cache }()
+		globalMetaCache = nil
+
+		node := &Proxy{queryResultCache: c}
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+
+		// altering or dropping an alias doesn't change the data of the collection
+		status, err := node.InvalidateCollectionMetaCache(context.Background(), &proxypb.InvalidateCollMetaCacheRequest{
+			CollectionName: "alias",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		_, _, ok := c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.True(t, ok)
+
+		status, err = node.InvalidateCollectionMetaCache(context.Background(), &proxypb.InvalidateCollMetaCacheRequest{
+			CollectionName: "test",
+			CollectionID:   1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		_, _, ok = c.get(newTestQueryCacheKey(1, "test", "int64 > 0"))
+		assert.False(t, ok)
+
+		// the generation of the dropped collection is dropped along with its results
+		c.put(newTestQueryCacheKey(1, "test", "int64 > 0"), 0, result)
+		assert.Len(t, c.generations, 1)
+		status, err = node.InvalidateCollectionMetaCache(context.Background(), &proxypb.InvalidateCollMetaCacheRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+			CollectionName: "test",
+			CollectionID:   1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, 0, c.len())
+		assert.Empty(t, c.generations)
+		assert.Empty(t, c.byCollection)
+	})
+
+	t.Run("guarantee timestamp", func(t *testing.T) {
+		cache := globalMetaCache
+		defer func() { globalMetaCache = cache }()
+		mockCache := newMockCache()
+		globalMetaCache = mockCache
+		level := commonpb.ConsistencyLevel_Bounded
+		mockCache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+			return &collectionInfo{consistencyLevel: level}, nil
+		})
+
+		// the guarantee timestamp of the request
+		for _, c := range []struct {
+			guaranteeTs Timestamp
+			cached      bool
+		}{
+			{strongTS, false},
+			{eventuallyTS, true},
+			{boundedTS, true},
+			// the session consistency of the client
+			{100, false},
+		} {
+			req := newTestQueryRequest("test", "int64 > 0")
+			req.GuaranteeTimestamp = c.guaranteeTs
+			guaranteeTs, ok := queryResultCacheGuaranteeTs(context.Background(), req)
+			assert.Equal(t, c.cached, ok, c.guaranteeTs)
+			if ok {
+				assert.Equal(t, c.guaranteeTs, guaranteeTs)
+			}
+		}
+
+		// the consistency level of the collection, the guarantee timestamp of the request is ignored
+		req := newTestQueryRequest("test", "int64 > 0")
+		req.UseDefaultConsistency = true
+		req.GuaranteeTimestamp = eventuallyTS
+		guaranteeTs, ok := queryResultCacheGuaranteeTs(context.Background(), req)
+		assert.True(t, ok)
+		assert.Equal(t, Timestamp(boundedTS), guaranteeTs)
+
+		level = commonpb.ConsistencyLevel_Eventually
+		guaranteeTs, ok = queryResultCacheGuaranteeTs(context.Background(), req)
+		assert.True(t, ok)
+		assert.Equal(t, Timestamp(eventuallyTS), guaranteeTs)
+
+		for _, level = range []commonpb.ConsistencyLevel{commonpb.ConsistencyLevel_Strong, commonpb.ConsistencyLevel_Session} {
+			_, ok = queryResultCacheGuaranteeTs(context.Background(), req)
+			assert.False(t, ok, level)
+		}
+
+		mockCache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+			return nil, errCollectionNotExists(collectionName)
+		})
+		_, ok = queryResultCacheGuaranteeTs(context.Background(), req)
+		assert.False(t, ok)
+	})
+}
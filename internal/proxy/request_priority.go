@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// RequestPriorityKey is the search/query param, or the gRPC metadata, to hint the priority of the request, e.g. the
+// interactive searches are of high priority and the batch jobs are of low priority.
+const RequestPriorityKey = "request_priority"
+
+// requestPriority is the band of the search and query tasks in the dq queue.
+type requestPriority int
+
+const (
+	requestPriorityHigh requestPriority = iota
+	requestPriorityNormal
+	requestPriorityLow
+
+	numRequestPriorities = 3
+)
+
+// requestPriorityWeights are the shares of the tasks popped from each band when all the bands are queued, so the high
+// priority tasks aren't blocked behind the others while the low priority tasks are never starved.
+var requestPriorityWeights = [numRequestPriorities]int{8, 4, 1}
+
+var requestPriorityNames = [numRequestPriorities]string{"high", "normal", "low"}
+
+func (p requestPriority) String() string {
+	return requestPriorityNames[p]
+}
+
+// parseRequestPriority returns the priority hinted by the params, or by the gRPC metadata if the params don't hint it,
+// it's normal by default. The tasks created without context, e.g. by the tests, have no gRPC metadata.
+func parseRequestPriority(ctx context.Context, params []*commonpb.KeyValuePair) (requestPriority, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(RequestPriorityKey, params)
+	if err != nil {
+		if ctx == nil {
+			return requestPriorityNormal, nil
+		}
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(md.Get(RequestPriorityKey)) == 0 {
+			return requestPriorityNormal, nil
+		}
+		value = md.Get(RequestPriorityKey)[0]
+	}
+	for p, name := range requestPriorityNames {
+		if strings.EqualFold(strings.TrimSpace(value), name) {
+			return requestPriority(p), nil
+		}
+	}
+	return requestPriorityNormal, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"%s [%s] is invalid, should be one of %s", RequestPriorityKey, value, strings.Join(requestPriorityNames[:], ", "))
+}
+
+// prioritizedTask is implemented by the tasks scheduled by their request priority, the others are of normal priority.
+type prioritizedTask interface {
+	getRequestPriority() requestPriority
+}
+
+func taskRequestPriority(t task) requestPriority {
+	if p, ok := t.(prioritizedTask); ok {
+		return p.getRequestPriority()
+	}
+	return requestPriorityNormal
+}
+
+// priorityPicker picks the band to pop by the smooth weighted round robin, the bands are picked in proportion to
+// their weights and interleaved evenly.
+type priorityPicker struct {
+	current [numRequestPriorities]int
+}
+
+// pick returns the band to pop among the queued ones, or false if none is queued.
+func (p *priorityPicker) pick(queued [numRequestPriorities]bool) (requestPriority, bool) {
+	total, picked := 0, -1
+	for band, weight := range requestPriorityWeights {
+		if !queued[band] {
+			continue
+		}
+		p.current[band] += weight
+		total += weight
+		if picked < 0 || p.current[band] > p.current[picked] {
+			picked = band
+		}
+	}
+	if picked < 0 {
+		return requestPriorityNormal, false
+	}
+	p.current[picked] -= total
+	return requestPriority(picked), true
+}
+
+// logSlowDQL logs the search or query request slower than proxy.slowDQLThreshold with its request priority, it returns
+// whether the request is slow.
+func logSlowDQL(ctx context.Context, method string, collectionName string, priority requestPriority, elapsed time.Duration) bool {
+	threshold := Params.ProxyCfg.SlowDQLThreshold
+	if threshold <= 0 || elapsed < threshold {
+		return false
+	}
+	queryType := metrics.QueryLabel
+	if method == "Search" {
+		queryType = metrics.SearchLabel
+	}
+	metrics.ProxySlowDQLCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), queryType,
+		priority.String()).Inc()
+	log.Ctx(ctx).Warn("slow "+method,
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", collectionName),
+		zap.String("priority", priority.String()),
+		zap.Duration("elapsed", elapsed),
+		zap.Duration("threshold", threshold))
+	return true
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+func TestParseRequestPriority(t *testing.T) {
+	ctx := context.Background()
+	params := func(value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: RequestPriorityKey, Value: value}}
+	}
+
+	priority, err := parseRequestPriority(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, requestPriorityNormal, priority)
+
+	for value, expected := range map[string]requestPriority{
+		"high":   requestPriorityHigh,
+		"normal": requestPriorityNormal,
+		"low":    requestPriorityLow,
+		" LOW ":  requestPriorityLow,
+	} {
+		priority, err = parseRequestPriority(ctx, params(value))
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, priority, value)
+	}
+
+	_, err = parseRequestPriority(ctx, params("urgent"))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "should be one of high, normal, low")
+
+	// the priority is hinted by the gRPC metadata if the params don't hint it
+	mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(RequestPriorityKey, "low"))
+	priority, err = parseRequestPriority(mdCtx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, requestPriorityLow, priority)
+	priority, err = parseRequestPriority(mdCtx, params("high"))
+	assert.NoError(t, err)
+	assert.Equal(t, requestPriorityHigh, priority)
+	_, err = parseRequestPriority(metadata.NewIncomingContext(ctx, metadata.Pairs(RequestPriorityKey, "")), nil)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestPriorityPicker(t *testing.T) {
+	all := [numRequestPriorities]bool{true, true, true}
+
+	var picker priorityPicker
+	_, ok := picker.pick([numRequestPriorities]bool{})
+	assert.False(t, ok)
+
+	// the bands are interleaved instead of popping all the high ones first
+	var picks []requestPriority
+	for i := 0; i < 13; i++ {
+		p, ok := picker.pick(all)
+		assert.True(t, ok)
+		picks = append(picks, p)
+	}
+	assert.Equal(t, []requestPriority{
+		requestPriorityHigh, requestPriorityNormal, requestPriorityHigh, requestPriorityHigh, requestPriorityNormal,
+		requestPriorityHigh, requestPriorityLow, requestPriorityHigh, requestPriorityNormal, requestPriorityHigh,
+		requestPriorityHigh, requestPriorityNormal, requestPriorityHigh,
+	}, picks)
+
+	// only the queued bands are picked
+	for i := 0; i < 5; i++ {
+		p, ok := picker.pick([numRequestPriorities]bool{false, false, true})
+		assert.True(t, ok)
+		assert.Equal(t, requestPriorityLow, p)
+	}
+}
+
+func TestLogSlowDQL(t *testing.T) {
+	defer func(threshold time.Duration) {
+		Params.ProxyCfg.SlowDQLThreshold = threshold
+	}(Params.ProxyCfg.SlowDQLThreshold)
+	ctx := context.Background()
+
+	Params.ProxyCfg.SlowDQLThreshold = time.Second
+	assert.False(t, logSlowDQL(ctx, "Search", "coll", requestPriorityHigh, time.Millisecond))
+	assert.True(t, logSlowDQL(ctx, "Search", "coll", requestPriorityHigh, 2*time.Second))
+	assert.True(t, logSlowDQL(ctx, "Query", "coll", requestPriorityLow, time.Second))
+
+	Params.ProxyCfg.SlowDQLThreshold = 0
+	assert.False(t, logSlowDQL(ctx, "Search", "coll", requestPriorityHigh, time.Hour))
+}
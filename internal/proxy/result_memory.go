@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+var errResultTooLarge = errors.New("result too large")
+
+// resultMemoryTracker accounts the shard results held by the running search and query tasks, so that a few large
+// results can't run the proxy out of memory. A nil resultMemoryTracker accounts nothing.
+type resultMemoryTracker struct {
+	maxInFlight int64 // 0 means unlimited
+	maxTask     int64 // 0 means unlimited
+	inFlight    int64
+}
+
+// newResultMemoryTracker returns the resultMemoryTracker configured by Params.
+func newResultMemoryTracker() *resultMemoryTracker {
+	return &resultMemoryTracker{
+		maxInFlight: Params.ProxyCfg.MaxInFlightResultBytes,
+		maxTask:     Params.ProxyCfg.MaxTaskResultBytes,
+	}
+}
+
+func (m *resultMemoryTracker) add(delta int64) {
+	inFlight := atomic.AddInt64(&m.inFlight, delta)
+	metrics.ProxyInFlightResultBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Set(float64(inFlight))
+}
+
+// admit returns a RateLimit error if the in-flight results exceed the ceiling, the task of the name is rejected then.
+func (m *resultMemoryTracker) admit(name string) error {
+	if m == nil || m.maxInFlight <= 0 {
+		return nil
+	}
+	if inFlight := atomic.LoadInt64(&m.inFlight); inFlight > m.maxInFlight {
+		rejectedByResultMemory(name, metrics.ServerBusyLabel)
+		return newErrWithCode(commonpb.ErrorCode_RateLimit,
+			"server is busy, the in-flight results of %d bytes exceed the limit %d bytes, %s is rejected",
+			inFlight, m.maxInFlight, name)
+	}
+	return nil
+}
+
+// newAccount returns the account of the results of the task of the name.
+func (m *resultMemoryTracker) newAccount(name string) *resultMemoryAccount {
+	if m == nil {
+		return nil
+	}
+	return &resultMemoryAccount{tracker: m, name: name}
+}
+
+// resultMemoryAccount is the size of the shard results held by a task, a nil resultMemoryAccount accounts nothing.
+type resultMemoryAccount struct {
+	tracker *resultMemoryTracker
+	name    string
+	bytes   int64
+}
+
+// grow accounts a shard result of size bytes to the task, it fails without accounting the result if the results of
+// the task exceed the cap then.
+func (a *resultMemoryAccount) grow(size int) error {
+	if a == nil {
+		return nil
+	}
+	bytes := atomic.AddInt64(&a.bytes, int64(size))
+	if a.tracker.maxTask > 0 && bytes > a.tracker.maxTask {
+		atomic.AddInt64(&a.bytes, -int64(size))
+		rejectedByResultMemory(a.name, metrics.ResultTooLargeLabel)
+		return fmt.Errorf("%w, the results of %s exceed the limit %d bytes", errResultTooLarge, a.name, a.tracker.maxTask)
+	}
+	a.tracker.add(int64(size))
+	return nil
+}
+
+// release gives back all the results accounted to the task.
+func (a *resultMemoryAccount) release() {
+	if a == nil {
+		return
+	}
+	if bytes := atomic.SwapInt64(&a.bytes, 0); bytes != 0 {
+		a.tracker.add(-bytes)
+	}
+}
+
+// resultMemoryAccountable is implemented by the tasks whose shard results are accounted.
+type resultMemoryAccountable interface {
+	setResultMemory(account *resultMemoryAccount)
+	getResultMemory() *resultMemoryAccount
+}
+
+func rejectedByResultMemory(name string, reason string) {
+	queryType := metrics.QueryLabel
+	if name == SearchTaskName {
+		queryType = metrics.SearchLabel
+	}
+	metrics.ProxyResultMemoryRejectCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
+		queryType, reason).Inc()
+}
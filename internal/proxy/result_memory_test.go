@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestResultMemoryTracker(t *testing.T) {
+	var nilTracker *resultMemoryTracker
+	assert.NoError(t, nilTracker.admit(QueryTaskName))
+	nilAccount := nilTracker.newAccount(QueryTaskName)
+	assert.NoError(t, nilAccount.grow(1024))
+	nilAccount.release()
+
+	m := &resultMemoryTracker{maxInFlight: 100, maxTask: 60}
+	a1 := m.newAccount(QueryTaskName)
+	a2 := m.newAccount(SearchTaskName)
+
+	assert.NoError(t, a1.grow(40))
+	assert.NoError(t, a1.grow(20))
+	// the result beyond the cap isn't accounted
+	err := a1.grow(1)
+	assert.ErrorIs(t, err, errResultTooLarge)
+	assert.Equal(t, int64(60), atomic.LoadInt64(&m.inFlight))
+	assert.NoError(t, m.admit(QueryTaskName))
+
+	assert.NoError(t, a2.grow(50))
+	err = m.admit(SearchTaskName)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+
+	a1.release()
+	a1.release()
+	assert.Equal(t, int64(50), atomic.LoadInt64(&m.inFlight))
+	assert.NoError(t, m.admit(SearchTaskName))
+	a2.release()
+	assert.Zero(t, atomic.LoadInt64(&m.inFlight))
+
+	unlimited := &resultMemoryTracker{}
+	assert.NoError(t, unlimited.newAccount(QueryTaskName).grow(1<<30))
+	assert.NoError(t, unlimited.admit(QueryTaskName))
+}
+
+func TestResultMemory_ConcurrentQueries(t *testing.T) {
+	Params.Init()
+	defer func(maxInFlight, maxTask int64) {
+		Params.ProxyCfg.MaxInFlightResultBytes = maxInFlight
+		Params.ProxyCfg.MaxTaskResultBytes = maxTask
+	}(Params.ProxyCfg.MaxInFlightResultBytes, Params.ProxyCfg.MaxTaskResultBytes)
+
+	const shardNum = 5
+	result := &internalpb.RetrieveResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: generateInt64Array(1000)}},
+		},
+	}
+	size := int64(proto.Size(result))
+	// every task holds the results of 4 shards at most, and the results of 2 tasks reach the ceiling
+	Params.ProxyCfg.MaxTaskResultBytes = 4 * size
+	Params.ProxyCfg.MaxInFlightResultBytes = 8 * size
+
+	ctx := context.Background()
+	queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+	qn := &QueryNodeMock{withQueryResult: result}
+	newTask := func() *queryTask {
+		return &queryTask{
+			ctx:             ctx,
+			Condition:       NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{}},
+			resultBuf:       make(chan *internalpb.RetrieveResults, shardNum),
+		}
+	}
+
+	tasks := make([]*queryTask, 3)
+	for i := range tasks {
+		tasks[i] = newTask()
+		require.NoError(t, queue.Enqueue(tasks[i]))
+		require.NotNil(t, tasks[i].resultMemory)
+	}
+
+	var tooLarge int32
+	wg := &sync.WaitGroup{}
+	for _, task := range tasks {
+		for shard := 0; shard < shardNum; shard++ {
+			wg.Add(1)
+			go func(task *queryTask, shard int) {
+				defer wg.Done()
+				err := task.queryShard(ctx, int64(shard), qn, []string{fmt.Sprintf("dml_%d", shard)})
+				if errors.Is(err, errResultTooLarge) {
+					atomic.AddInt32(&tooLarge, 1)
+				} else {
+					assert.NoError(t, err)
+				}
+			}(task, shard)
+		}
+	}
+	wg.Wait()
+
+	// a shard result of each task is beyond the cap
+	assert.Equal(t, int32(len(tasks)), tooLarge)
+	for _, task := range tasks {
+		assert.Equal(t, 4, len(task.resultBuf))
+	}
+	assert.Equal(t, 12*size, atomic.LoadInt64(&queue.resultMemory.inFlight))
+
+	// the new requests fail fast while the in-flight results exceed the ceiling
+	err := queue.Enqueue(newTask())
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+	assert.Equal(t, len(tasks), queue.unissuedTasks.Len())
+
+	// the results are released once the tasks are done
+	for range tasks {
+		task := queue.PopUnissuedTask()
+		queue.AddActiveTask(task)
+		queue.PopActiveTask(task.ID())
+	}
+	assert.Zero(t, atomic.LoadInt64(&queue.resultMemory.inFlight))
+	assert.NoError(t, queue.Enqueue(newTask()))
+}
@@ -20,12 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
@@ -56,6 +59,7 @@ type collectionMeta struct {
 	physicalChannelNames []string
 	createdTimestamp     uint64
 	createdUtcTimestamp  uint64
+	properties           []*commonpb.KeyValuePair
 }
 
 type partitionMeta struct {
@@ -86,7 +90,9 @@ type RootCoordMock struct {
 	statisticsChannel string
 	timeTickChannel   string
 
-	// naive inverted index
+	dbs map[string]struct{}
+
+	// naive inverted index, the names are keyed by collKey
 	collName2ID  map[string]typeutil.UniqueID
 	collID2Meta  map[typeutil.UniqueID]collectionMeta
 	collAlias2ID map[string]typeutil.UniqueID
@@ -111,6 +117,93 @@ type RootCoordMock struct {
 	lastTsMtx sync.Mutex
 }
 
+// collKey returns the key of the collection or alias name in the database.
+func collKey(dbName, name string) string {
+	return normalizeDBName(dbName) + "/" + name
+}
+
+// checkDatabase returns a DatabaseNotExist status if the database doesn't exist, the caller must hold collMtx.
+func (coord *RootCoordMock) checkDatabase(dbName string) *commonpb.Status {
+	if _, ok := coord.dbs[normalizeDBName(dbName)]; !ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DatabaseNotExist,
+			Reason:    fmt.Sprintf("database not exist: %s", dbName),
+		}
+	}
+	return nil
+}
+
+func (coord *RootCoordMock) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	if _, ok := coord.dbs[req.GetDbName()]; ok {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database already exist: %s", req.GetDbName()),
+		}, nil
+	}
+	coord.dbs[req.GetDbName()] = struct{}{}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	if status := coord.checkDatabase(req.GetDbName()); status != nil {
+		return status, nil
+	}
+	for key := range coord.collName2ID {
+		if strings.HasPrefix(key, collKey(req.GetDbName(), "")) {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("database %s is not empty", req.GetDbName()),
+			}, nil
+		}
+	}
+	delete(coord.dbs, req.GetDbName())
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (coord *RootCoordMock) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	dbNames := make([]string, 0, len(coord.dbs))
+	for dbName := range coord.dbs {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	return &milvuspb.ListDatabasesResponse{
+		Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		DbNames: dbNames,
+	}, nil
+}
+
 func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	code := coord.state.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
@@ -122,7 +215,7 @@ func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.Creat
 	coord.collMtx.Lock()
 	defer coord.collMtx.Unlock()
 
-	_, exist := coord.collAlias2ID[req.Alias]
+	_, exist := coord.collAlias2ID[collKey(req.GetDbName(), req.Alias)]
 	if exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -130,7 +223,7 @@ func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.Creat
 		}, nil
 	}
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -138,7 +231,7 @@ func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.Creat
 		}, nil
 	}
 
-	coord.collAlias2ID[req.Alias] = collID
+	coord.collAlias2ID[collKey(req.GetDbName(), req.Alias)] = collID
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 		Reason:    "",
@@ -156,7 +249,7 @@ func (coord *RootCoordMock) DropAlias(ctx context.Context, req *milvuspb.DropAli
 	coord.collMtx.Lock()
 	defer coord.collMtx.Unlock()
 
-	_, exist := coord.collAlias2ID[req.Alias]
+	_, exist := coord.collAlias2ID[collKey(req.GetDbName(), req.Alias)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -164,7 +257,7 @@ func (coord *RootCoordMock) DropAlias(ctx context.Context, req *milvuspb.DropAli
 		}, nil
 	}
 
-	delete(coord.collAlias2ID, req.Alias)
+	delete(coord.collAlias2ID, collKey(req.GetDbName(), req.Alias))
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 		Reason:    "",
@@ -182,21 +275,58 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	coord.collMtx.Lock()
 	defer coord.collMtx.Unlock()
 
-	_, exist := coord.collAlias2ID[req.Alias]
+	_, exist := coord.collAlias2ID[collKey(req.GetDbName(), req.Alias)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
 			Reason:    fmt.Sprintf("alias does not exist, alias = %s", req.Alias),
 		}, nil
 	}
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
 			Reason:    fmt.Sprintf("aliased collection name does not exist, name = %s", req.CollectionName),
 		}, nil
 	}
-	coord.collAlias2ID[req.Alias] = collID
+	coord.collAlias2ID[collKey(req.GetDbName(), req.Alias)] = collID
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.CollectionName),
+		}, nil
+	}
+	meta := coord.collID2Meta[collID]
+	properties := make(map[string]string)
+	for _, pair := range meta.properties {
+		properties[pair.GetKey()] = pair.GetValue()
+	}
+	for _, pair := range req.GetProperties() {
+		properties[pair.GetKey()] = pair.GetValue()
+	}
+	meta.properties = make([]*commonpb.KeyValuePair, 0, len(properties))
+	for key, value := range properties {
+		meta.properties = append(meta.properties, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	coord.collID2Meta[collID] = meta
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 		Reason:    "",
@@ -301,7 +431,10 @@ func (coord *RootCoordMock) CreateCollection(ctx context.Context, req *milvuspb.
 	coord.collMtx.Lock()
 	defer coord.collMtx.Unlock()
 
-	_, exist := coord.collName2ID[req.CollectionName]
+	if status := coord.checkDatabase(req.GetDbName()); status != nil {
+		return status, nil
+	}
+	_, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -322,7 +455,7 @@ func (coord *RootCoordMock) CreateCollection(ctx context.Context, req *milvuspb.
 	}
 
 	collID := typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
-	coord.collName2ID[req.CollectionName] = collID
+	coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)] = collID
 
 	var shardsNum int32
 	if req.ShardsNum <= 0 {
@@ -378,15 +511,15 @@ func (coord *RootCoordMock) DropCollection(ctx context.Context, req *milvuspb.Dr
 	coord.collMtx.Lock()
 	defer coord.collMtx.Unlock()
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
+		// dropping a collection is idempotent as rootcoord does
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
-			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+			ErrorCode: commonpb.ErrorCode_Success,
 		}, nil
 	}
 
-	delete(coord.collName2ID, req.CollectionName)
+	delete(coord.collName2ID, collKey(req.GetDbName(), req.CollectionName))
 
 	delete(coord.collID2Meta, collID)
 
@@ -415,7 +548,7 @@ func (coord *RootCoordMock) HasCollection(ctx context.Context, req *milvuspb.Has
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
 
-	_, exist := coord.collName2ID[req.CollectionName]
+	_, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 
 	return &milvuspb.BoolResponse{
 		Status: &commonpb.Status{
@@ -460,7 +593,10 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		usingID = true
 	}
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	if status := coord.checkDatabase(req.GetDbName()); status != nil {
+		return &milvuspb.DescribeCollectionResponse{Status: status}, nil
+	}
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist && !usingID {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
@@ -474,7 +610,15 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		collID = req.CollectionID
 	}
 
-	meta := coord.collID2Meta[collID]
+	meta, exist := coord.collID2Meta[collID]
+	if !exist {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+				Reason:    fmt.Sprintf("can't find collection: %d", collID),
+			},
+		}, nil
+	}
 	if meta.shardsNum == 0 {
 		meta.shardsNum = int32(len(meta.virtualChannelNames))
 	}
@@ -491,6 +635,7 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		PhysicalChannelNames: meta.physicalChannelNames,
 		CreatedTimestamp:     meta.createdUtcTimestamp,
 		CreatedUtcTimestamp:  meta.createdUtcTimestamp,
+		Properties:           meta.properties,
 	}, nil
 }
 
@@ -514,8 +659,12 @@ func (coord *RootCoordMock) ShowCollections(ctx context.Context, req *milvuspb.S
 	createdTimestamps := make([]uint64, 0, len(coord.collName2ID))
 	createdUtcTimestamps := make([]uint64, 0, len(coord.collName2ID))
 
-	for name, id := range coord.collName2ID {
-		names = append(names, name)
+	prefix := collKey(req.GetDbName(), "")
+	for key, id := range coord.collName2ID {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		names = append(names, strings.TrimPrefix(key, prefix))
 		ids = append(ids, id)
 		meta := coord.collID2Meta[id]
 		createdTimestamps = append(createdTimestamps, meta.createdTimestamp)
@@ -546,7 +695,7 @@ func (coord *RootCoordMock) CreatePartition(ctx context.Context, req *milvuspb.C
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
@@ -592,7 +741,7 @@ func (coord *RootCoordMock) DropPartition(ctx context.Context, req *milvuspb.Dro
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
@@ -634,7 +783,7 @@ func (coord *RootCoordMock) HasPartition(ctx context.Context, req *milvuspb.HasP
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
@@ -678,7 +827,7 @@ func (coord *RootCoordMock) ShowPartitions(ctx context.Context, req *milvuspb.Sh
 	coord.collMtx.RLock()
 	defer coord.collMtx.RUnlock()
 
-	collID, exist := coord.collName2ID[req.CollectionName]
+	collID, exist := coord.collName2ID[collKey(req.GetDbName(), req.CollectionName)]
 	if !exist {
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
@@ -1055,6 +1204,7 @@ func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 		address:           funcutil.GenRandomStr(), // TODO(dragondriver): random address
 		statisticsChannel: funcutil.GenRandomStr(),
 		timeTickChannel:   funcutil.GenRandomStr(),
+		dbs:               map[string]struct{}{util.DefaultDBName: {}},
 		collName2ID:       make(map[string]typeutil.UniqueID),
 		collID2Meta:       make(map[typeutil.UniqueID]collectionMeta),
 		collID2Partitions: make(map[typeutil.UniqueID]partitionMap),
@@ -1121,24 +1271,30 @@ func (coord *RootCoordMock) ListPolicy(ctx context.Context, in *internalpb.ListP
 }
 
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+type ShowCollectionsFunc func(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
 type DescribeIndexFunc func(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
 type ShowSegmentsFunc func(ctx context.Context, request *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error)
 type DescribeSegmentsFunc func(ctx context.Context, request *rootcoordpb.DescribeSegmentsRequest) (*rootcoordpb.DescribeSegmentsResponse, error)
 type ImportFunc func(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+type ListImportTasksFunc func(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 type DropCollectionFunc func(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+type CreateCollectionFunc func(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
 
 type GetGetCredentialFunc func(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
 
 type mockRootCoord struct {
 	types.RootCoord
 	DescribeCollectionFunc
+	ShowCollectionsFunc
 	ShowPartitionsFunc
 	DescribeIndexFunc
 	ShowSegmentsFunc
 	DescribeSegmentsFunc
 	ImportFunc
+	ListImportTasksFunc
 	DropCollectionFunc
+	CreateCollectionFunc
 	GetGetCredentialFunc
 }
 
@@ -1157,6 +1313,13 @@ func (m *mockRootCoord) DescribeCollection(ctx context.Context, request *milvusp
 	return nil, errors.New("mock")
 }
 
+func (m *mockRootCoord) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	if m.ShowCollectionsFunc != nil {
+		return m.ShowCollectionsFunc(ctx, request)
+	}
+	return nil, errors.New("mock")
+}
+
 func (m *mockRootCoord) ShowPartitions(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
 	if m.ShowPartitionsFunc != nil {
 		return m.ShowPartitionsFunc(ctx, request)
@@ -1178,6 +1341,20 @@ func (m *mockRootCoord) Import(ctx context.Context, request *milvuspb.ImportRequ
 	return nil, errors.New("mock")
 }
 
+func (m *mockRootCoord) ListImportTasks(ctx context.Context, request *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
+	if m.ListImportTasksFunc != nil {
+		return m.ListImportTasksFunc(ctx, request)
+	}
+	return nil, errors.New("mock")
+}
+
+func (m *mockRootCoord) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	if m.CreateCollectionFunc != nil {
+		return m.CreateCollectionFunc(ctx, request)
+	}
+	return nil, errors.New("mock")
+}
+
 func (m *mockRootCoord) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
 	if m.DropCollectionFunc != nil {
 		return m.DropCollectionFunc(ctx, request)
@@ -16,20 +16,22 @@
 
 package proxy
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 
-func rpcReceived(method string) string {
-	return fmt.Sprintf("%s received", method)
-}
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
 
 func rpcEnqueued(method string) string {
 	return fmt.Sprintf("%s enqueued", method)
 }
 
-func rpcDone(method string) string {
-	return fmt.Sprintf("%s done", method)
-}
-
 func rpcFailedToEnqueue(method string) string {
 	return fmt.Sprintf("%s failed to enqueue", method)
 }
@@ -37,3 +39,48 @@ func rpcFailedToEnqueue(method string) string {
 func rpcFailedToWaitToFinish(method string) string {
 	return fmt.Sprintf("%s failed to WaitToFinish", method)
 }
+
+// rpcLogSampler samples the requests whose lifecycle, i.e. received, enqueued and done, is logged, since logging every
+// request is overwhelming at high QPS. One of every Params.ProxyCfg.RequestLogSampleRate requests of each method is
+// sampled, starting from the first one.
+type rpcLogSampler struct {
+	counts sync.Map // method -> *uint64
+}
+
+func (s *rpcLogSampler) sample(method string) bool {
+	rate := Params.ProxyCfg.RequestLogSampleRate
+	if rate <= 1 {
+		return true
+	}
+	count, _ := s.counts.LoadOrStore(method, new(uint64))
+	return atomic.AddUint64(count.(*uint64), 1)%uint64(rate) == 1
+}
+
+var globalRPCLogSampler = &rpcLogSampler{}
+
+// rpcReceived samples the request of the method and logs that it's received, it returns the logger of the request to
+// log the rest of its lifecycle. The debug and info logs of the logger are discarded unless the request is sampled,
+// while the warnings and errors, e.g. the failures, are always logged.
+func rpcReceived(ctx context.Context, method string, fields ...zap.Field) *log.MLogger {
+	return globalRPCLogSampler.received(ctx, method, fields...)
+}
+
+// rpcDone logs that the request of the method is done by the logger returned by rpcReceived.
+func rpcDone(logger *log.MLogger, method string, fields ...zap.Field) {
+	logger.Debug(fmt.Sprintf("%s done", method), fields...)
+}
+
+func (s *rpcLogSampler) received(ctx context.Context, method string, fields ...zap.Field) *log.MLogger {
+	logger := s.logger(ctx, method)
+	logger.Debug(fmt.Sprintf("%s received", method), fields...)
+	return logger
+}
+
+func (s *rpcLogSampler) logger(ctx context.Context, method string) *log.MLogger {
+	logger := log.Ctx(ctx)
+	// the logger which doesn't log info drops the debug and info logs anyway, and can't be raised to warn
+	if s.sample(method) || !logger.Core().Enabled(zapcore.InfoLevel) {
+		return logger
+	}
+	return &log.MLogger{Logger: logger.WithOptions(zap.IncreaseLevel(zapcore.WarnLevel))}
+}
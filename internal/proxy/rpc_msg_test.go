@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func TestRPCLogSampler(t *testing.T) {
+	Params.InitOnce()
+	defer func(rate int64) { Params.ProxyCfg.RequestLogSampleRate = rate }(Params.ProxyCfg.RequestLogSampleRate)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	ctx := context.WithValue(context.Background(), log.CtxLogKey, &log.MLogger{Logger: zap.New(core)})
+	// every request logs its lifecycle, and the odd ones fail
+	serve := func(sampler *rpcLogSampler, method string, n int) {
+		for i := 0; i < n; i++ {
+			logger := sampler.received(ctx, method)
+			logger.Info(rpcEnqueued(method))
+			if i%2 == 1 {
+				logger.Warn(rpcFailedToWaitToFinish(method))
+				continue
+			}
+			rpcDone(logger, method)
+		}
+	}
+
+	t.Run("sampled", func(t *testing.T) {
+		Params.ProxyCfg.RequestLogSampleRate = 10
+		logs.TakeAll()
+		serve(&rpcLogSampler{}, "Search", 100)
+		serve(&rpcLogSampler{}, "Query", 5)
+
+		// one of every 10 requests of each method logs the lifecycle, starting from the first one
+		assert.Equal(t, 10, logs.FilterMessage("Search received").Len())
+		assert.Equal(t, 10, logs.FilterMessage(rpcEnqueued("Search")).Len())
+		assert.Equal(t, 10, logs.FilterMessage("Search done").Len())
+		assert.Equal(t, 1, logs.FilterMessage("Query received").Len())
+		// the failures are never dropped
+		assert.Equal(t, 50, logs.FilterMessage(rpcFailedToWaitToFinish("Search")).Len())
+		assert.Equal(t, 2, logs.FilterMessage(rpcFailedToWaitToFinish("Query")).Len())
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		Params.ProxyCfg.RequestLogSampleRate = 1
+		logs.TakeAll()
+		serve(&rpcLogSampler{}, "Search", 10)
+		assert.Equal(t, 10, logs.FilterMessage("Search received").Len())
+		assert.Equal(t, 10, logs.FilterMessage(rpcEnqueued("Search")).Len())
+		assert.Equal(t, 5, logs.FilterMessage("Search done").Len())
+		assert.Equal(t, 5, logs.FilterMessage(rpcFailedToWaitToFinish("Search")).Len())
+	})
+
+	t.Run("less verbose logger", func(t *testing.T) {
+		Params.ProxyCfg.RequestLogSampleRate = 10
+		errorCore, errorLogs := observer.New(zapcore.ErrorLevel)
+		ctx := context.WithValue(context.Background(), log.CtxLogKey, &log.MLogger{Logger: zap.New(errorCore)})
+		sampler := &rpcLogSampler{}
+		for i := 0; i < 10; i++ {
+			sampler.logger(ctx, "Search").Error(rpcFailedToEnqueue("Search"))
+		}
+		assert.Equal(t, 10, errorLogs.Len())
+	})
+}
+
+func TestProxy_RPCLogSampled(t *testing.T) {
+	Params.Init()
+	defer func(rate int64) { Params.ProxyCfg.RequestLogSampleRate = rate }(Params.ProxyCfg.RequestLogSampleRate)
+	defer func(sampler *rpcLogSampler) { globalRPCLogSampler = sampler }(globalRPCLogSampler)
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = nil
+	if rateCol == nil {
+		var err error
+		rateCol, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+		require.NoError(t, err)
+	}
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	ctx := context.WithValue(context.Background(), log.CtxLogKey, &log.MLogger{Logger: zap.New(core)})
+	Params.ProxyCfg.RequestLogSampleRate = 10
+	globalRPCLogSampler = &rpcLogSampler{}
+
+	// every request fails to enqueue without timestamps
+	node := newFunctionCallTestProxy(t, &mockFailedTsoAllocator{})
+	for i := 0; i < 20; i++ {
+		resp, err := node.Insert(ctx, &milvuspb.InsertRequest{CollectionName: "coll", NumRows: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		resp, err = node.Delete(ctx, &milvuspb.DeleteRequest{CollectionName: "coll", Expr: "pk in [1]"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	}
+
+	for _, method := range []string{"Insert", "Delete"} {
+		assert.Equal(t, 2, logs.FilterMessage(method+" received").Len())
+		assert.Equal(t, 2, logs.FilterMessage(method+" done").Len())
+		assert.Equal(t, 20, logs.FilterMessage(rpcFailedToEnqueue(method)).Len())
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// ConsistencyCheckKey is the search param to return the timestamps the search is served at in
+// SearchResults.ConsistencyInfo, it's for diagnosing why a write isn't visible to a search.
+const ConsistencyCheckKey = "consistency_check"
+
+// parseConsistencyCheck returns whether the search asks for its consistency info, it's false by default.
+func parseConsistencyCheck(searchParams []*commonpb.KeyValuePair) (bool, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ConsistencyCheckKey, searchParams)
+	if err != nil {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be true or false", ConsistencyCheckKey, value)
+	}
+	return enabled, nil
+}
+
+// searchConsistencyInfo collects the serviceable timestamps of the shards a search is served by.
+type searchConsistencyInfo struct {
+	mu     sync.Mutex
+	shards []*milvuspb.ShardConsistencyInfo
+}
+
+// resetShards forgets the shards, the search is sent to the shard leaders again.
+func (info *searchConsistencyInfo) resetShards() {
+	if info == nil {
+		return
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.shards = nil
+}
+
+// addShard records the serviceable timestamps of the channels searched by the shard leader, it's called by the shard
+// searches concurrently.
+func (info *searchConsistencyInfo) addShard(nodeID int64, result *internalpb.SearchResults) {
+	if info == nil {
+		return
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	for channel, ts := range result.GetChannelServiceableTs() {
+		info.shards = append(info.shards, &milvuspb.ShardConsistencyInfo{
+			ChannelName:          channel,
+			NodeID:               nodeID,
+			ServiceableTimestamp: ts,
+		})
+	}
+}
+
+// build returns the consistency info of the search at the guarantee timestamp, the shards are ordered by channel.
+func (info *searchConsistencyInfo) build(guaranteeTs uint64) *milvuspb.SearchConsistencyInfo {
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	shards := append([]*milvuspb.ShardConsistencyInfo(nil), info.shards...)
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].GetChannelName() < shards[j].GetChannelName()
+	})
+	return &milvuspb.SearchConsistencyInfo{
+		GuaranteeTimestamp: guaranteeTs,
+		Shards:             shards,
+	}
+}
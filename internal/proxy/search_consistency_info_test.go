@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestParseConsistencyCheck(t *testing.T) {
+	enabled, err := parseConsistencyCheck(nil)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = parseConsistencyCheck([]*commonpb.KeyValuePair{{Key: ConsistencyCheckKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = parseConsistencyCheck([]*commonpb.KeyValuePair{{Key: ConsistencyCheckKey, Value: "false"}})
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, err = parseConsistencyCheck([]*commonpb.KeyValuePair{{Key: ConsistencyCheckKey, Value: "abc"}})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestSearchTask_ConsistencyInfo(t *testing.T) {
+	ctx := context.Background()
+	nodes := map[int64]*QueryNodeMock{
+		1: {withSearchResult: &internalpb.SearchResults{
+			Status:               &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			ChannelServiceableTs: map[string]uint64{"dml-0": 100, "dml-2": 300},
+		}},
+		2: {withSearchResult: &internalpb.SearchResults{
+			Status:               &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			ChannelServiceableTs: map[string]uint64{"dml-1": 200},
+		}},
+	}
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		if address == "node1" {
+			return nodes[1], nil
+		}
+		return nodes[2], nil
+	}))
+	shard2Leaders := map[string][]nodeInfo{
+		"dml-0": {{nodeID: 1, address: "node1"}},
+		"dml-1": {{nodeID: 2, address: "node2"}},
+		"dml-2": {{nodeID: 1, address: "node1"}},
+	}
+	require.NoError(t, mgr.UpdateShardLeaders(nil, shard2Leaders))
+
+	newTask := func(consistencyCheck bool) *searchTask {
+		task := &searchTask{
+			SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}, GuaranteeTimestamp: 50},
+			result:        &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+			resultBuf:     make(chan *internalpb.SearchResults, len(shard2Leaders)),
+		}
+		if consistencyCheck {
+			task.consistencyInfo = &searchConsistencyInfo{}
+		}
+		return task
+	}
+
+	t.Run("asked", func(t *testing.T) {
+		task := newTask(true)
+		require.NoError(t, mergeRoundRobinPolicy(ctx, mgr, task.searchShard, shard2Leaders))
+		task.fillInConsistencyInfo()
+
+		info := task.result.GetConsistencyInfo()
+		require.NotNil(t, info)
+		assert.Equal(t, uint64(50), info.GetGuaranteeTimestamp())
+		require.Len(t, info.GetShards(), 3)
+		for i, expected := range []struct {
+			channel string
+			nodeID  int64
+			ts      uint64
+		}{{"dml-0", 1, 100}, {"dml-1", 2, 200}, {"dml-2", 1, 300}} {
+			shard := info.GetShards()[i]
+			assert.Equal(t, expected.channel, shard.GetChannelName())
+			assert.Equal(t, expected.nodeID, shard.GetNodeID())
+			assert.Equal(t, expected.ts, shard.GetServiceableTimestamp())
+		}
+
+		task.consistencyInfo.resetShards()
+		task.fillInConsistencyInfo()
+		assert.Empty(t, task.result.GetConsistencyInfo().GetShards())
+	})
+
+	t.Run("not asked", func(t *testing.T) {
+		task := newTask(false)
+		require.NoError(t, mergeRoundRobinPolicy(ctx, mgr, task.searchShard, shard2Leaders))
+		task.fillInConsistencyInfo()
+		assert.Nil(t, task.result.GetConsistencyInfo())
+	})
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// pkQueryAtFunc returns the primary keys matching the expression in the collection, it queries the data
+// visible at the travel timestamp once the guarantee timestamp is served.
+type pkQueryAtFunc func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (*schemapb.IDs, error)
+
+// filterDeletedSearchResults removes the entities which no longer exist at the timestamps from the search results,
+// e.g. the deleted entities resurfacing during handoff. It returns the number of the removed entities.
+func filterDeletedSearchResults(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema,
+	data *schemapb.SearchResultData, travelTs, guaranteeTs Timestamp, query pkQueryAtFunc) (int, error) {
+	total := len(data.GetIds().GetIntId().GetData()) + len(data.GetIds().GetStrId().GetData())
+	if total == 0 {
+		return 0, nil
+	}
+
+	// the same entity may be hit by multiple queries, look up each primary key once
+	uniquePKs := &schemapb.IDs{}
+	seen := make(map[interface{}]struct{})
+	for i := 0; i < total; i++ {
+		pk := typeutil.GetPK(data.GetIds(), int64(i))
+		if _, ok := seen[pk]; !ok {
+			seen[pk] = struct{}{}
+			typeutil.AppendPKs(uniquePKs, pk)
+		}
+	}
+
+	num := typeutil.GetSizeOfIDs(uniquePKs)
+	existing := make(map[interface{}]struct{}, num)
+	for begin := 0; begin < num; begin += pkCheckBatchSize {
+		end := begin + pkCheckBatchSize
+		if end > num {
+			end = num
+		}
+		ids, err := query(ctx, database, collectionName, pkField, pkInExpr(pkField.GetName(), uniquePKs, begin, end), travelTs, guaranteeTs)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check the existence of primary keys: %w", err)
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+			existing[typeutil.GetPK(ids, int64(i))] = struct{}{}
+		}
+	}
+	if len(existing) == num {
+		return 0, nil
+	}
+
+	return retainSearchResults(data, func(q int, i int64) bool {
+		_, ok := existing[typeutil.GetPK(data.GetIds(), i)]
+		return ok
+	}), nil
+}
+
+// retainSearchResults keeps the results for which keep returns true, keep is called with the query index and the
+// result index. It returns the number of the removed results.
+func retainSearchResults(data *schemapb.SearchResultData, keep func(q int, i int64) bool) int {
+	total := len(data.GetIds().GetIntId().GetData()) + len(data.GetIds().GetStrId().GetData())
+	ids := &schemapb.IDs{}
+	switch data.GetIds().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0, total)}}
+	case *schemapb.IDs_StrId:
+		ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: make([]string, 0, total)}}
+	}
+	scores := make([]float32, 0, total)
+	topks := make([]int64, len(data.GetTopks()))
+	fieldsData := make([]*schemapb.FieldData, len(data.GetFieldsData()))
+	removed := 0
+	offset := int64(0)
+	for q, topk := range data.GetTopks() {
+		for i := offset; i < offset+topk; i++ {
+			if !keep(q, i) {
+				removed++
+				continue
+			}
+			typeutil.AppendIDs(ids, data.GetIds(), int(i))
+			scores = append(scores, data.GetScores()[i])
+			typeutil.AppendFieldData(fieldsData, data.GetFieldsData(), i)
+			topks[q]++
+		}
+		offset += topk
+	}
+
+	data.Ids = ids
+	data.Scores = scores
+	data.Topks = topks
+	data.FieldsData = fieldsData
+	return removed
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestFilterDeletedSearchResults(t *testing.T) {
+	ctx := context.Background()
+	int64PK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	varCharPK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	// existingPKs returns a pkQueryAtFunc treating the primary keys out of the existing ones as deleted
+	existingPKs := func(exprs *[]string, existing ...interface{}) pkQueryAtFunc {
+		return func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (*schemapb.IDs, error) {
+			*exprs = append(*exprs, expr)
+			ids := &schemapb.IDs{}
+			for _, pk := range existing {
+				typeutil.AppendPKs(ids, pk)
+			}
+			return ids, nil
+		}
+	}
+
+	t.Run("filter deleted int64 primary keys", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 2, 4}}}},
+			Scores:     []float32{0.1, 0.2, 0.3, 0.4, 0.5},
+			Topks:      []int64{3, 2},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "age",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 20, 30, 20, 40}}},
+						},
+					},
+				},
+			},
+		}
+		var exprs []string
+		removed, err := filterDeletedSearchResults(ctx, "", "coll", int64PK, data, 100, 200, existingPKs(&exprs, int64(1), int64(3), int64(4)))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+		// each primary key is looked up once
+		assert.Equal(t, []string{"pk in [1, 2, 3, 4]"}, exprs)
+		assert.Equal(t, []int64{1, 3, 4}, data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.1, 0.3, 0.5}, data.GetScores())
+		assert.Equal(t, []int64{2, 1}, data.GetTopks())
+		assert.Equal(t, []int64{10, 30, 40}, data.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "age", data.GetFieldsData()[0].GetFieldName())
+		assert.Equal(t, int64(3), data.GetTopK())
+	})
+
+	t.Run("filter deleted varchar primary keys", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       2,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}},
+			Scores:     []float32{0.1, 0.2},
+			Topks:      []int64{2},
+		}
+		var exprs []string
+		removed, err := filterDeletedSearchResults(ctx, "", "coll", varCharPK, data, 100, 200, existingPKs(&exprs, "b"))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+		assert.Equal(t, []string{`pk in ["a", "b"]`}, exprs)
+		assert.Equal(t, []string{"b"}, data.GetIds().GetStrId().GetData())
+		assert.Equal(t, []float32{0.2}, data.GetScores())
+		assert.Equal(t, []int64{1}, data.GetTopks())
+	})
+
+	t.Run("nothing deleted", func(t *testing.T) {
+		ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+		data := &schemapb.SearchResultData{NumQueries: 1, TopK: 2, Ids: ids, Scores: []float32{0.1, 0.2}, Topks: []int64{2}}
+		var exprs []string
+		removed, err := filterDeletedSearchResults(ctx, "", "coll", int64PK, data, 100, 200, existingPKs(&exprs, int64(1), int64(2)))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, removed)
+		assert.Same(t, ids, data.GetIds())
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		data := &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}
+		var exprs []string
+		removed, err := filterDeletedSearchResults(ctx, "", "coll", int64PK, data, 100, 200, existingPKs(&exprs))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, removed)
+		assert.Empty(t, exprs)
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       1,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+			Scores:     []float32{0.1},
+			Topks:      []int64{1},
+		}
+		query := func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (*schemapb.IDs, error) {
+			return nil, errors.New("mock error")
+		}
+		_, err := filterDeletedSearchResults(ctx, "", "coll", int64PK, data, 100, 200, query)
+		assert.Error(t, err)
+		assert.Equal(t, []int64{1}, data.GetIds().GetIntId().GetData())
+	})
+}
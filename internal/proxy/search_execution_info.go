@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// ReturnExecutionInfoKey is the search param to return the time breakdown of the search in SearchResults.ExecutionInfo.
+const ReturnExecutionInfoKey = "return_execution_info"
+
+// parseReturnExecutionInfo returns whether the search asks for its execution info, it's false by default.
+func parseReturnExecutionInfo(searchParams []*commonpb.KeyValuePair) (bool, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ReturnExecutionInfoKey, searchParams)
+	if err != nil {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be true or false", ReturnExecutionInfoKey, value)
+	}
+	return enabled, nil
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// shardExecutionInfo is the search RPC sent to one shard leader.
+type shardExecutionInfo struct {
+	NodeID     int64    `json:"node_id"`
+	Channels   []string `json:"channels"`
+	DurationMs float64  `json:"duration_ms"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// searchExecutionInfo is where the time of a search went, all durations are in milliseconds.
+type searchExecutionInfo struct {
+	mu sync.Mutex
+
+	// EnqueueWaitMs is the time the task waited in the dql queue before it's executed
+	EnqueueWaitMs float64              `json:"enqueue_wait_ms"`
+	Shards        []shardExecutionInfo `json:"shards"`
+	ReduceMs      float64              `json:"reduce_ms"`
+	TotalMs       float64              `json:"total_ms"`
+}
+
+// resetShards forgets the shard RPCs, the search is sent to the shard leaders again.
+func (info *searchExecutionInfo) resetShards() {
+	if info == nil {
+		return
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.Shards = nil
+}
+
+// addShard records a shard RPC, it's called by the shard searches concurrently.
+func (info *searchExecutionInfo) addShard(nodeID int64, channels []string, duration time.Duration, status *commonpb.Status, err error) {
+	if info == nil {
+		return
+	}
+	shard := shardExecutionInfo{
+		NodeID:     nodeID,
+		Channels:   channels,
+		DurationMs: durationMs(duration),
+	}
+	if err != nil {
+		shard.Error = err.Error()
+	} else if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		shard.Error = status.GetReason()
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.Shards = append(info.Shards, shard)
+}
+
+func (info *searchExecutionInfo) marshal() (string, error) {
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	b, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
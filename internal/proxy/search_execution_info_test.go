@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestParseReturnExecutionInfo(t *testing.T) {
+	enabled, err := parseReturnExecutionInfo(nil)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = parseReturnExecutionInfo([]*commonpb.KeyValuePair{{Key: ReturnExecutionInfoKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = parseReturnExecutionInfo([]*commonpb.KeyValuePair{{Key: ReturnExecutionInfoKey, Value: "false"}})
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, err = parseReturnExecutionInfo([]*commonpb.KeyValuePair{{Key: ReturnExecutionInfoKey, Value: "abc"}})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestSearchTask_ExecutionInfo(t *testing.T) {
+	ctx := context.Background()
+	qn := &QueryNodeMock{
+		withSearchResult: &internalpb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+	}
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		return qn, nil
+	}))
+	shard2Leaders := map[string][]nodeInfo{
+		"dml-0": {{nodeID: 1, address: "node1"}},
+		"dml-1": {{nodeID: 2, address: "node2"}},
+		"dml-2": {{nodeID: 3, address: "node3"}},
+	}
+	require.NoError(t, mgr.UpdateShardLeaders(nil, shard2Leaders))
+
+	newTask := func() *searchTask {
+		return &searchTask{
+			SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+			result:        &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+			tr:            timerecord.NewTimeRecorder("search"),
+			resultBuf:     make(chan *internalpb.SearchResults, len(shard2Leaders)),
+			executionInfo: &searchExecutionInfo{EnqueueWaitMs: 1},
+		}
+	}
+
+	t.Run("shards", func(t *testing.T) {
+		task := newTask()
+		require.NoError(t, mergeRoundRobinPolicy(ctx, mgr, task.searchShard, shard2Leaders))
+		require.NoError(t, task.fillInExecutionInfo(2*time.Millisecond))
+
+		info := &searchExecutionInfo{}
+		require.NoError(t, json.Unmarshal([]byte(task.result.GetExecutionInfo()), info))
+		assert.Equal(t, float64(1), info.EnqueueWaitMs)
+		assert.Equal(t, float64(2), info.ReduceMs)
+		assert.GreaterOrEqual(t, info.TotalMs, float64(0))
+		// one shard RPC per shard leader the search fans out to
+		require.Len(t, info.Shards, len(shard2Leaders))
+		nodes := make(map[int64]string)
+		for _, shard := range info.Shards {
+			require.Len(t, shard.Channels, 1)
+			nodes[shard.NodeID] = shard.Channels[0]
+			assert.Empty(t, shard.Error)
+		}
+		assert.Equal(t, map[int64]string{1: "dml-0", 2: "dml-1", 3: "dml-2"}, nodes)
+	})
+
+	t.Run("failed shard", func(t *testing.T) {
+		task := newTask()
+		qn.searchError = errors.New("mock error")
+		defer func() { qn.searchError = nil }()
+		assert.Error(t, task.searchShard(ctx, 1, qn, []string{"dml-0"}))
+		require.NoError(t, task.fillInExecutionInfo(0))
+
+		info := &searchExecutionInfo{}
+		require.NoError(t, json.Unmarshal([]byte(task.result.GetExecutionInfo()), info))
+		require.Len(t, info.Shards, 1)
+		assert.Equal(t, "mock error", info.Shards[0].Error)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		task := newTask()
+		require.NoError(t, mergeRoundRobinPolicy(ctx, mgr, task.searchShard, shard2Leaders))
+		task.executionInfo.resetShards()
+		assert.Empty(t, task.executionInfo.Shards)
+	})
+
+	t.Run("not asked", func(t *testing.T) {
+		task := newTask()
+		task.executionInfo = nil
+		require.NoError(t, mergeRoundRobinPolicy(ctx, mgr, task.searchShard, shard2Leaders))
+		require.NoError(t, task.fillInExecutionInfo(0))
+		assert.Empty(t, task.result.GetExecutionInfo())
+	})
+}
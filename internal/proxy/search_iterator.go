@@ -0,0 +1,260 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// SearchIteratorKey is the search param to search in batches, the result of a batch carries the token of the
+	// next batch in SearchResults.SearchIteratorToken.
+	SearchIteratorKey = "search_iterator"
+	// SearchIteratorTokenKey is the search param to get the next batch of a search iterator.
+	SearchIteratorTokenKey = "search_iterator_token"
+
+	searchIteratorTokenVersion = 1
+	// searchIteratorTokenTTL is how long a token is valid after its batch is returned
+	searchIteratorTokenTTL = 30 * time.Minute
+)
+
+// searchIteratorBoundary is the last score returned to a query, the primary keys returned with that score and the
+// number of the results returned to the query. The score is the one compared in the reduce, i.e. the larger the better
+// for all metric types.
+type searchIteratorBoundary struct {
+	Score    float32  `json:"score"`
+	IntPKs   []int64  `json:"int_pks,omitempty"`
+	StrPKs   []string `json:"str_pks,omitempty"`
+	Returned int64    `json:"returned"`
+}
+
+func (b *searchIteratorBoundary) addPK(pk interface{}) {
+	switch v := pk.(type) {
+	case int64:
+		b.IntPKs = append(b.IntPKs, v)
+	case string:
+		b.StrPKs = append(b.StrPKs, v)
+	}
+}
+
+// searchIteratorToken is the opaque continuation token returned to the client.
+type searchIteratorToken struct {
+	Version      int    `json:"v"`
+	CollectionID int64  `json:"collection_id"`
+	MetricType   string `json:"metric_type"`
+	// ExpireAt is the unix time in milliseconds after which the token is rejected
+	ExpireAt int64 `json:"expire_at"`
+	// Boundaries are indexed by query, it's nil if nothing is returned to the query yet
+	Boundaries []*searchIteratorBoundary `json:"boundaries"`
+}
+
+// searchIterator skips the results returned by the previous batches of a search. Segcore has no range search, so
+// query nodes can't skip the returned results: a batch searches the topk plus the most results returned to a query
+// so far, and proxy skips the returned ones, i.e. the ones better than the boundaries and the ones returned on the
+// boundaries. So a batch costs as much as a search with offset, and the iterator ends once the topk of the search
+// exceeds the limit.
+type searchIterator struct {
+	collectionID int64
+	metricType   string
+	// topk is the number of the results of a batch
+	topk       int64
+	boundaries []*searchIteratorBoundary
+	// returnedPKs are the primary keys of the boundaries indexed by query
+	returnedPKs []map[interface{}]struct{}
+}
+
+// parseSearchIterator returns the iterator of the search, it's nil unless the search asks for it.
+func parseSearchIterator(searchParams []*commonpb.KeyValuePair, collectionID int64, now time.Time) (*searchIterator, error) {
+	if tokenStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchIteratorTokenKey, searchParams); err == nil {
+		return decodeSearchIteratorToken(tokenStr, collectionID, now)
+	}
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchIteratorKey, searchParams)
+	if err != nil {
+		return nil, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be true or false", SearchIteratorKey, value)
+	}
+	if !enabled {
+		return nil, nil
+	}
+	return &searchIterator{collectionID: collectionID}, nil
+}
+
+func decodeSearchIteratorToken(tokenStr string, collectionID int64, now time.Time) (*searchIterator, error) {
+	invalid := func(reason string) error {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, %s", SearchIteratorTokenKey, reason)
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(tokenStr)
+	if err != nil {
+		return nil, invalid("it's garbled")
+	}
+	token := &searchIteratorToken{}
+	if err := json.Unmarshal(bs, token); err != nil {
+		return nil, invalid("it's garbled")
+	}
+	if token.Version != searchIteratorTokenVersion {
+		return nil, invalid("version " + strconv.Itoa(token.Version) + " is not supported")
+	}
+	if token.CollectionID != collectionID {
+		return nil, invalid("it's issued by another collection")
+	}
+	if now.UnixMilli() > token.ExpireAt {
+		return nil, invalid("it's expired, please search from the beginning")
+	}
+
+	it := &searchIterator{
+		collectionID: collectionID,
+		metricType:   token.MetricType,
+		boundaries:   token.Boundaries,
+		returnedPKs:  make([]map[interface{}]struct{}, len(token.Boundaries)),
+	}
+	for q, b := range token.Boundaries {
+		if b == nil {
+			continue
+		}
+		if math.IsNaN(float64(b.Score)) || b.Returned < 0 {
+			return nil, invalid("it's garbled")
+		}
+		pks := make(map[interface{}]struct{}, len(b.IntPKs)+len(b.StrPKs))
+		for _, pk := range b.IntPKs {
+			pks[pk] = struct{}{}
+		}
+		for _, pk := range b.StrPKs {
+			pks[pk] = struct{}{}
+		}
+		it.returnedPKs[q] = pks
+	}
+	return it, nil
+}
+
+// prepare checks the search is the one the token is issued by, and grows the topk of the search by the results
+// returned so far, which are skipped by proxy.
+func (it *searchIterator) prepare(queryInfo *planpb.QueryInfo, offset int64) error {
+	if offset > 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is not supported with %s", OffsetKey, SearchIteratorKey)
+	}
+	it.topk = queryInfo.GetTopk()
+	if it.boundaries == nil {
+		it.metricType = queryInfo.GetMetricType()
+		return nil
+	}
+	if it.metricType != queryInfo.GetMetricType() {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, it's issued by a search of metric type %s, got %s",
+			SearchIteratorTokenKey, it.metricType, queryInfo.GetMetricType())
+	}
+	var returned int64
+	for _, b := range it.boundaries {
+		if b != nil && b.Returned > returned {
+			returned = b.Returned
+		}
+	}
+	if err := validateTopK(it.topk + returned); err != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s can't go on after %d results, %s",
+			SearchIteratorKey, returned, err.Error())
+	}
+	queryInfo.Topk = it.topk + returned
+	return nil
+}
+
+// checkNq checks the token is issued by a search of the same number of queries.
+func (it *searchIterator) checkNq(nq int64) error {
+	if it.boundaries != nil && int64(len(it.boundaries)) != nq {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is invalid, it's issued by a search of nq %d, got %d",
+			SearchIteratorTokenKey, len(it.boundaries), nq)
+	}
+	return nil
+}
+
+// skipReturned removes the results returned by the previous batches from the shard results, i.e. the ones better than
+// the boundaries and the ones returned on the boundaries. It returns the number of the removed results. The shard
+// results are searched by the grown topk, what's left is reduced as the results of the topk of the batch.
+func (it *searchIterator) skipReturned(data *schemapb.SearchResultData) int {
+	if it.boundaries == nil {
+		return 0
+	}
+	data.TopK = it.topk
+	return retainSearchResults(data, func(q int, i int64) bool {
+		if q >= len(it.boundaries) || it.boundaries[q] == nil {
+			return true
+		}
+		score := data.GetScores()[i]
+		if score != it.boundaries[q].Score {
+			return score < it.boundaries[q].Score
+		}
+		_, returned := it.returnedPKs[q][typeutil.GetPK(data.GetIds(), i)]
+		return !returned
+	})
+}
+
+// next returns the token of the next batch, given the reduced result of this batch whose scores are as seen by the
+// client. The boundaries of the queries getting nothing in this batch are kept.
+func (it *searchIterator) next(data *schemapb.SearchResultData, nq int64, now time.Time) (string, error) {
+	boundaries := make([]*searchIteratorBoundary, nq)
+	copy(boundaries, it.boundaries)
+	positive := distance.PositivelyRelated(it.metricType)
+	offset := int64(0)
+	for q, topk := range data.GetTopks() {
+		if topk == 0 || int64(q) >= nq {
+			offset += topk
+			continue
+		}
+		last := offset + topk - 1
+		score := data.GetScores()[last]
+		if !positive {
+			score = -score
+		}
+		b := &searchIteratorBoundary{Score: score, Returned: topk}
+		if prev := boundaries[q]; prev != nil {
+			b.Returned += prev.Returned
+			if prev.Score == score {
+				b.IntPKs = append(b.IntPKs, prev.IntPKs...)
+				b.StrPKs = append(b.StrPKs, prev.StrPKs...)
+			}
+		}
+		for i := last; i >= offset && data.GetScores()[i] == data.GetScores()[last]; i-- {
+			b.addPK(typeutil.GetPK(data.GetIds(), i))
+		}
+		boundaries[q] = b
+		offset += topk
+	}
+
+	bs, err := json.Marshal(&searchIteratorToken{
+		Version:      searchIteratorTokenVersion,
+		CollectionID: it.collectionID,
+		MetricType:   it.metricType,
+		ExpireAt:     now.Add(searchIteratorTokenTTL).UnixMilli(),
+		Boundaries:   boundaries,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
@@ -0,0 +1,275 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestParseSearchIterator(t *testing.T) {
+	now := time.Now()
+	kv := func(key, value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: key, Value: value}}
+	}
+	encode := func(token *searchIteratorToken) string {
+		bs, err := json.Marshal(token)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(bs)
+	}
+	validToken := func() *searchIteratorToken {
+		return &searchIteratorToken{
+			Version:      searchIteratorTokenVersion,
+			CollectionID: 1,
+			MetricType:   distance.IP,
+			ExpireAt:     now.Add(time.Minute).UnixMilli(),
+			Boundaries:   []*searchIteratorBoundary{{Score: 0.5, IntPKs: []int64{7}}, nil},
+		}
+	}
+
+	it, err := parseSearchIterator(nil, 1, now)
+	assert.NoError(t, err)
+	assert.Nil(t, it)
+	it, err = parseSearchIterator(kv(SearchIteratorKey, "false"), 1, now)
+	assert.NoError(t, err)
+	assert.Nil(t, it)
+	it, err = parseSearchIterator(kv(SearchIteratorKey, "true"), 1, now)
+	assert.NoError(t, err)
+	require.NotNil(t, it)
+	assert.Nil(t, it.boundaries)
+	_, err = parseSearchIterator(kv(SearchIteratorKey, "yes please"), 1, now)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+	it, err = parseSearchIterator(kv(SearchIteratorTokenKey, encode(validToken())), 1, now)
+	assert.NoError(t, err)
+	require.NotNil(t, it)
+	assert.Equal(t, distance.IP, it.metricType)
+	assert.Len(t, it.boundaries, 2)
+	assert.Contains(t, it.returnedPKs[0], int64(7))
+
+	invalidTokens := map[string]string{
+		"not base64": "!!!",
+		"not json":   base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		"version": encode(func() *searchIteratorToken {
+			token := validToken()
+			token.Version = searchIteratorTokenVersion + 1
+			return token
+		}()),
+		"collection": encode(func() *searchIteratorToken {
+			token := validToken()
+			token.CollectionID = 2
+			return token
+		}()),
+		"expired": encode(func() *searchIteratorToken {
+			token := validToken()
+			token.ExpireAt = now.Add(-time.Second).UnixMilli()
+			return token
+		}()),
+	}
+	for name, token := range invalidTokens {
+		_, err := parseSearchIterator(kv(SearchIteratorTokenKey, token), 1, now)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), name)
+		assert.Contains(t, err.Error(), SearchIteratorTokenKey, name)
+	}
+
+	// the token must be issued by the same search
+	it, err = parseSearchIterator(kv(SearchIteratorTokenKey, encode(validToken())), 1, now)
+	require.NoError(t, err)
+	err = it.prepare(&planpb.QueryInfo{MetricType: distance.L2, SearchParams: `{"nprobe":10}`}, 0)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	err = it.prepare(&planpb.QueryInfo{MetricType: distance.IP, SearchParams: `{"nprobe":10}`}, 10)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(it.checkNq(3)))
+	assert.NoError(t, it.checkNq(2))
+
+	// the topk is grown by the most results returned to a query, the search params are kept
+	token := validToken()
+	token.Boundaries[0].Returned = 30
+	it, err = parseSearchIterator(kv(SearchIteratorTokenKey, encode(token)), 1, now)
+	require.NoError(t, err)
+	queryInfo := &planpb.QueryInfo{Topk: 10, MetricType: distance.IP, SearchParams: `{"nprobe":10}`}
+	require.NoError(t, it.prepare(queryInfo, 0))
+	assert.Equal(t, int64(40), queryInfo.GetTopk())
+	assert.Equal(t, int64(10), it.topk)
+	assert.Equal(t, `{"nprobe":10}`, queryInfo.GetSearchParams())
+
+	token.Boundaries[0].Returned = 16380
+	it, err = parseSearchIterator(kv(SearchIteratorTokenKey, encode(token)), 1, now)
+	require.NoError(t, err)
+	err = it.prepare(&planpb.QueryInfo{Topk: 10, MetricType: distance.IP, SearchParams: `{"nprobe":10}`}, 0)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+	token.Boundaries[0].Returned = -1
+	_, err = parseSearchIterator(kv(SearchIteratorTokenKey, encode(token)), 1, now)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+// searchIteratorEntity is an entity of the synthetic shards, the score is the one seen by the client.
+type searchIteratorEntity struct {
+	pk    interface{}
+	score float32
+}
+
+func TestSearchIterator_Batches(t *testing.T) {
+	const (
+		nq        = 2
+		topk      = 4
+		shardNum  = 2
+		batchNum  = 3
+		entityNum = 16
+	)
+	ctx := context.Background()
+
+	for _, metricType := range []string{distance.IP, distance.L2} {
+		t.Run(metricType, func(t *testing.T) {
+			positive := distance.PositivelyRelated(metricType)
+			pkType := schemapb.DataType_Int64
+			newPK := func(i int) interface{} { return int64(i) }
+			if !positive {
+				pkType = schemapb.DataType_VarChar
+				newPK = func(i int) interface{} { return fmt.Sprintf("pk_%02d", i) }
+			}
+
+			// the entities of each query ordered as seen by the client, some of them tie on the batch boundaries
+			entities := make([][]searchIteratorEntity, nq)
+			for q := 0; q < nq; q++ {
+				for i := 0; i < entityNum; i++ {
+					score := float32(100 - i)
+					if q == 0 && i >= 3 && i <= 5 {
+						// ties across the boundary of the 1st and the 2nd batch
+						score = 97
+					}
+					if q == 1 && i >= 6 && i <= 9 {
+						// ties across the boundary of the 2nd and the 3rd batch
+						score = 94
+					}
+					if !positive {
+						score = -score + 200
+					}
+					entities[q] = append(entities[q], searchIteratorEntity{pk: newPK(q*100 + i), score: score})
+				}
+			}
+
+			// searchShard plays a query node returning the best topk hits of the shard, like segcore it has no range
+			// search. The scores are the ones compared in the reduce.
+			searchShard := func(shard int, queryInfo *planpb.QueryInfo) *schemapb.SearchResultData {
+				shardTopk := int(queryInfo.GetTopk())
+				data := &schemapb.SearchResultData{NumQueries: nq, TopK: int64(shardTopk), Ids: &schemapb.IDs{}}
+				for q := 0; q < nq; q++ {
+					var hits []searchIteratorEntity
+					for i, e := range entities[q] {
+						if i%shardNum == shard {
+							hits = append(hits, e)
+						}
+					}
+					if len(hits) > shardTopk {
+						hits = hits[:shardTopk]
+					}
+					for _, e := range hits {
+						typeutil.AppendPKs(data.Ids, e.pk)
+						score := e.score
+						if !positive {
+							score = -score
+						}
+						data.Scores = append(data.Scores, score)
+					}
+					data.Topks = append(data.Topks, int64(len(hits)))
+				}
+				return data
+			}
+
+			returned := make([][]searchIteratorEntity, nq)
+			searchParams := []*commonpb.KeyValuePair{{Key: SearchIteratorKey, Value: "true"}}
+			for batch := 0; batch < batchNum; batch++ {
+				it, err := parseSearchIterator(searchParams, 1, time.Now())
+				require.NoError(t, err)
+				require.NotNil(t, it)
+				queryInfo := &planpb.QueryInfo{Topk: topk, MetricType: metricType, SearchParams: `{"nprobe":10}`}
+				require.NoError(t, it.prepare(queryInfo, 0))
+				require.NoError(t, it.checkNq(nq))
+				assert.Equal(t, int64((batch+1)*topk), queryInfo.GetTopk())
+
+				shardResults := make([]*schemapb.SearchResultData, 0, shardNum)
+				for shard := 0; shard < shardNum; shard++ {
+					data := searchShard(shard, queryInfo)
+					it.skipReturned(data)
+					assert.Equal(t, int64(topk), data.GetTopK())
+					shardResults = append(shardResults, data)
+				}
+				result, err := reduceSearchResultData(ctx, shardResults, nq, it.topk, metricType, pkType, 0)
+				require.NoError(t, err)
+
+				offset := int64(0)
+				for q, n := range result.GetResults().GetTopks() {
+					assert.NotZero(t, n, "batch %d of query %d is empty", batch, q)
+					for i := offset; i < offset+n; i++ {
+						returned[q] = append(returned[q], searchIteratorEntity{
+							pk:    typeutil.GetPK(result.GetResults().GetIds(), i),
+							score: result.GetResults().GetScores()[i],
+						})
+					}
+					offset += n
+				}
+
+				token, err := it.next(result.GetResults(), nq, time.Now())
+				require.NoError(t, err)
+				searchParams = []*commonpb.KeyValuePair{{Key: SearchIteratorTokenKey, Value: token}}
+			}
+
+			for q := 0; q < nq; q++ {
+				// no duplicates
+				seen := make(map[interface{}]struct{})
+				for _, e := range returned[q] {
+					_, dup := seen[e.pk]
+					assert.False(t, dup, "%v of query %d is returned twice", e.pk, q)
+					seen[e.pk] = struct{}{}
+				}
+				// no gaps, the results are the best ones of the query in order
+				require.Equal(t, batchNum*topk, len(returned[q]))
+				expected := append([]searchIteratorEntity(nil), entities[q][:batchNum*topk]...)
+				actual := append([]searchIteratorEntity(nil), returned[q]...)
+				for _, s := range [][]searchIteratorEntity{expected, actual} {
+					sort.SliceStable(s, func(i, j int) bool {
+						return fmt.Sprint(s[i].pk) < fmt.Sprint(s[j].pk)
+					})
+				}
+				assert.Equal(t, expected, actual)
+				for i := 1; i < len(returned[q]); i++ {
+					if positive {
+						assert.GreaterOrEqual(t, returned[q][i-1].score, returned[q][i].score)
+					} else {
+						assert.LessOrEqual(t, returned[q][i-1].score, returned[q][i].score)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// searchParamRule is the type and range of a search param known to proxy.
+type searchParamRule struct {
+	integer bool
+	min     float64
+	max     float64
+	// allowed is a value allowed out of the range
+	allowed *float64
+}
+
+var searchKDefault = float64(-1)
+
+// knownSearchParams are the index search params known to proxy, besides in the JSON of SearchParamsKey,
+// some SDK versions send them as discrete search params.
+var knownSearchParams = map[string]searchParamRule{
+	"nprobe":       {integer: true, min: 1, max: 65536},
+	"ef":           {integer: true, min: 1, max: 32768},
+	"search_k":     {integer: true, min: 1, max: math.MaxInt32, allowed: &searchKDefault},
+	"search_list":  {integer: true, min: 1, max: 65535},
+	"radius":       {min: -math.MaxFloat32, max: math.MaxFloat32},
+	"range_filter": {min: -math.MaxFloat32, max: math.MaxFloat32},
+}
+
+// searchParamsReservedKeys are the discrete search params which aren't index search params, they're dropped if an
+// SDK sends them in the JSON of SearchParamsKey along with the nested index search params.
+var searchParamsReservedKeys = map[string]struct{}{
+	AnnsFieldKey:    {},
+	TopKKey:         {},
+	MetricTypeKey:   {},
+	RoundDecimalKey: {},
+	OffsetKey:       {},
+}
+
+// normalizeSearchParams merges the index search params of the shapes sent by different SDK versions into a JSON
+// object under SearchParamsKey, which is what query nodes expect. The shapes are, in order of precedence:
+//  1. the JSON object nested under "params" of the JSON of SearchParamsKey, e.g. {"metric_type": "L2", "params": {"nprobe": 10}},
+//     whose other keys are merged too except the reserved ones like metric_type;
+//  2. the JSON object of SearchParamsKey, e.g. {"nprobe": 10};
+//  3. the known index search params sent as discrete search params, e.g. nprobe=10, which are removed.
+//
+// The known params are converted to numbers and checked against their ranges, the unknown ones are passed as is,
+// or rejected if strict is set. The search params are returned as is if none of the shapes is present.
+func normalizeSearchParams(searchParams []*commonpb.KeyValuePair, strict bool) ([]*commonpb.KeyValuePair, error) {
+	merged := make(map[string]json.RawMessage)
+	found := false
+	normalized := make([]*commonpb.KeyValuePair, 0, len(searchParams)+1)
+	var blob *commonpb.KeyValuePair
+	for _, kv := range searchParams {
+		if _, ok := knownSearchParams[kv.GetKey()]; ok {
+			merged[kv.GetKey()] = json.RawMessage(strconv.Quote(kv.GetValue()))
+			found = true
+			continue
+		}
+		if kv.GetKey() == SearchParamsKey {
+			blob = kv
+			found = true
+			continue
+		}
+		normalized = append(normalized, kv)
+	}
+	if !found {
+		return searchParams, nil
+	}
+
+	if blob != nil {
+		params, err := parseSearchParamsObject(blob.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		nested, hasNested := params[SearchParamsKey]
+		if hasNested {
+			delete(params, SearchParamsKey)
+			for key := range searchParamsReservedKeys {
+				delete(params, key)
+			}
+		}
+		for key, value := range params {
+			merged[key] = value
+		}
+		if hasNested {
+			// the nested object may be encoded as a JSON string too
+			nestedStr := string(nested)
+			var str string
+			if json.Unmarshal(nested, &str) == nil {
+				nestedStr = str
+			}
+			nestedParams, err := parseSearchParamsObject(nestedStr)
+			if err != nil {
+				return nil, err
+			}
+			for key, value := range nestedParams {
+				merged[key] = value
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rule, ok := knownSearchParams[key]
+		if !ok {
+			if strict {
+				return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "unknown search param %s", key)
+			}
+			continue
+		}
+		value, err := rule.normalize(key, merged[key])
+		if err != nil {
+			return nil, err
+		}
+		merged[key] = value
+	}
+
+	bs, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return append(normalized, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: string(bs)}), nil
+}
+
+// parseSearchParamsObject parses the JSON object of the search params, keeping the values as they are.
+func parseSearchParamsObject(str string) (map[string]json.RawMessage, error) {
+	params := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(str), &params); err != nil || params == nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is invalid, should be a JSON object", SearchParamsKey, str)
+	}
+	return params, nil
+}
+
+// normalize converts the value to a JSON number, the value may be a number or a string of a number.
+func (r searchParamRule) normalize(key string, raw json.RawMessage) (json.RawMessage, error) {
+	str := string(bytes.TrimSpace(raw))
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		str = unquoted
+	}
+	invalid := func() error {
+		expected := "a number"
+		if r.integer {
+			expected = "an integer"
+		}
+		rangeStr := "in range [" + strconv.FormatFloat(r.min, 'g', -1, 64) + ", " + strconv.FormatFloat(r.max, 'g', -1, 64) + "]"
+		if r.allowed != nil {
+			rangeStr = strconv.FormatFloat(*r.allowed, 'g', -1, 64) + " or " + rangeStr
+		}
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"search param %s [%s] is invalid, should be %s %s", key, string(raw), expected, rangeStr)
+	}
+
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, invalid()
+	}
+	if r.integer && value != math.Trunc(value) {
+		return nil, invalid()
+	}
+	if (value < r.min || value > r.max) && (r.allowed == nil || value != *r.allowed) {
+		return nil, invalid()
+	}
+	if r.integer {
+		return json.RawMessage(strconv.FormatInt(int64(value), 10)), nil
+	}
+	return json.RawMessage(strconv.FormatFloat(value, 'g', -1, 64)), nil
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func Test_normalizeSearchParams(t *testing.T) {
+	kv := func(key, value string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: key, Value: value}
+	}
+	common := []*commonpb.KeyValuePair{
+		kv(AnnsFieldKey, "vec"),
+		kv(TopKKey, "10"),
+		kv(MetricTypeKey, distance.L2),
+	}
+
+	t.Run("sdk shapes", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			params   []*commonpb.KeyValuePair
+			expected string
+		}{
+			{"json", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": 10}`)}, `{"nprobe":10}`},
+			{"json of string values", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": "10", "radius": "0.5"}`)}, `{"nprobe":10,"radius":0.5}`},
+			{"discrete", []*commonpb.KeyValuePair{kv("nprobe", "10")}, `{"nprobe":10}`},
+			{"discrete of several", []*commonpb.KeyValuePair{kv("ef", "64"), kv("radius", "1.5"), kv("range_filter", "0.5")},
+				`{"ef":64,"radius":1.5,"range_filter":0.5}`},
+			{"nested", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"metric_type": "L2", "offset": 0, "params": {"nprobe": 10}}`)},
+				`{"nprobe":10}`},
+			{"nested in string", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"metric_type": "L2", "params": "{\"ef\": 32}"}`)},
+				`{"ef":32}`},
+			{"json over discrete", []*commonpb.KeyValuePair{kv("nprobe", "5"), kv("ef", "16"), kv(SearchParamsKey, `{"nprobe": 10}`)},
+				`{"ef":16,"nprobe":10}`},
+			{"nested over json", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": 5, "search_k": -1, "params": {"nprobe": 10}}`)},
+				`{"nprobe":10,"search_k":-1}`},
+			{"unknown passed as is", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": 10, "level": 2, "hints": ["a"]}`)},
+				`{"hints":["a"],"level":2,"nprobe":10}`},
+			{"empty json", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{}`)}, `{}`},
+		}
+		for _, c := range cases {
+			params := append(append([]*commonpb.KeyValuePair{}, common...), c.params...)
+			normalized, err := normalizeSearchParams(params, false)
+			require.NoError(t, err, c.name)
+
+			searchParams, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, normalized)
+			assert.NoError(t, err, c.name)
+			assert.Equal(t, c.expected, searchParams, c.name)
+			// the discrete index search params are merged, the other ones are kept
+			assert.Equal(t, append(append([]*commonpb.KeyValuePair{}, common...), kv(SearchParamsKey, c.expected)), normalized, c.name)
+
+			queryInfo, _, err := parseQueryInfo(normalized)
+			assert.NoError(t, err, c.name)
+			assert.Equal(t, c.expected, queryInfo.GetSearchParams(), c.name)
+		}
+	})
+
+	t.Run("no search params", func(t *testing.T) {
+		normalized, err := normalizeSearchParams(common, true)
+		assert.NoError(t, err)
+		assert.Equal(t, common, normalized)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			name   string
+			params []*commonpb.KeyValuePair
+			reason string
+		}{
+			{"not json", []*commonpb.KeyValuePair{kv(SearchParamsKey, `nprobe=10`)}, "should be a JSON object"},
+			{"not object", []*commonpb.KeyValuePair{kv(SearchParamsKey, `[10]`)}, "should be a JSON object"},
+			{"nested not object", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"params": 10}`)}, "should be a JSON object"},
+			{"not number", []*commonpb.KeyValuePair{kv("nprobe", "ten")}, "search param nprobe"},
+			{"not integer", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"ef": 1.5}`)}, "should be an integer in range [1, 32768]"},
+			{"out of range", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": 0}`)}, "search param nprobe [0]"},
+			{"out of range discrete", []*commonpb.KeyValuePair{kv("nprobe", "65537")}, "search param nprobe"},
+			{"invalid search_k", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"search_k": 0}`)}, "should be an integer -1 or in range"},
+			{"invalid radius", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"radius": "NaN"}`)}, "should be a number"},
+			{"invalid nested", []*commonpb.KeyValuePair{kv(SearchParamsKey, `{"nprobe": 10, "params": {"nprobe": -1}}`)}, "search param nprobe [-1]"},
+		}
+		for _, c := range cases {
+			_, err := normalizeSearchParams(append(append([]*commonpb.KeyValuePair{}, common...), c.params...), false)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.name)
+			assert.Contains(t, err.Error(), c.reason, c.name)
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		params := append(append([]*commonpb.KeyValuePair{}, common...), kv(SearchParamsKey, `{"nprobe": 10, "nprboe": 20}`))
+		_, err := normalizeSearchParams(params, true)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "unknown search param nprboe")
+
+		// the reserved keys beside the nested params are not unknown
+		params = append(append([]*commonpb.KeyValuePair{}, common...),
+			kv(SearchParamsKey, `{"metric_type": "L2", "params": {"nprobe": 10}}`), kv("ef", "16"))
+		normalized, err := normalizeSearchParams(params, true)
+		assert.NoError(t, err)
+		searchParams, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, normalized)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"ef":16,"nprobe":10}`, searchParams)
+	})
+}
@@ -0,0 +1,249 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// RankerKey is the search param of the ranker to combine or re-rank the search results.
+	RankerKey = "ranker"
+	// RankerParamsKey is the search param of the ranker params in json, e.g. {"field": "price", "order": "asc"} of scalar.
+	RankerParamsKey = "ranker_params"
+)
+
+// rankerParamValidator checks the value of a ranker param decoded from json.
+type rankerParamValidator func(schema *schemapb.CollectionSchema, value interface{}) bool
+
+// rankerSpec is a supported ranker.
+type rankerSpec struct {
+	// params is the allowlist of the ranker params
+	params map[string]rankerParamValidator
+	// required is the params must be set
+	required []string
+	// hint is how the valid params look like in the error messages
+	hint string
+}
+
+// supportedRankers are the rankers applied by proxy after the search results are reduced. The rankers fusing the
+// results of multiple vector fields, e.g. rrf and weighted, aren't supported since a search has only one anns field.
+var supportedRankers = map[string]rankerSpec{
+	"scalar": {
+		params: map[string]rankerParamValidator{
+			"field": func(schema *schemapb.CollectionSchema, value interface{}) bool {
+				name, ok := value.(string)
+				return ok && rankerScalarField(schema, name) != nil
+			},
+			"order": func(_ *schemapb.CollectionSchema, value interface{}) bool {
+				order, ok := value.(string)
+				return ok && (order == "asc" || order == "desc")
+			},
+		},
+		required: []string{"field"},
+		hint:     `{"field": name of a numeric scalar field, "order": "asc" or "desc"}`,
+	},
+}
+
+// rankerScalarField returns the numeric scalar field of the name, nil if there isn't.
+func rankerScalarField(schema *schemapb.CollectionSchema, name string) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetName() == name {
+			if typeutil.IsIntegerType(field.GetDataType()) || typeutil.IsFloatingType(field.GetDataType()) {
+				return field
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func supportedRankerNames() string {
+	names := make([]string, 0, len(supportedRankers))
+	for name := range supportedRankers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// scalarRanker re-orders the results of each query by the values of a numeric scalar field, the results of
+// the same value keep their order by score.
+type scalarRanker struct {
+	field *schemapb.FieldSchema
+	asc   bool
+	// fieldIndex is the index of the field in the output fields
+	fieldIndex int
+	// hidden is set if the field is output only for ranking, it's removed from the results after ranking
+	hidden bool
+}
+
+// parseRanker checks the ranker of the search is supported and its params are known and valid. It returns nil
+// if the search has no ranker.
+func parseRanker(schema *schemapb.CollectionSchema, searchParams []*commonpb.KeyValuePair) (*scalarRanker, error) {
+	ranker, rankerErr := funcutil.GetAttrByKeyFromRepeatedKV(RankerKey, searchParams)
+	paramsStr, paramsErr := funcutil.GetAttrByKeyFromRepeatedKV(RankerParamsKey, searchParams)
+	if rankerErr != nil {
+		if paramsErr == nil {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is set without %s", RankerParamsKey, RankerKey)
+		}
+		return nil, nil
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(MMRLambdaKey, searchParams); err == nil {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s and %s can't be specified at the same time, both of them re-rank the results", RankerKey, MMRLambdaKey)
+	}
+
+	spec, ok := supportedRankers[ranker]
+	if !ok {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s [%s] is not supported, should be one of [%s]", RankerKey, ranker, supportedRankerNames())
+	}
+	params := make(map[string]interface{})
+	if paramsErr == nil {
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"%s [%s] is invalid, should be a json object", RankerParamsKey, paramsStr)
+		}
+	}
+	for key, value := range params {
+		validate, ok := spec.params[key]
+		if !ok {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"unknown param %s of ranker %s, the params should be %s", key, ranker, spec.hint)
+		}
+		if !validate(schema, value) {
+			b, _ := json.Marshal(value)
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"param %s [%s] of ranker %s is invalid, the params should be %s", key, string(b), ranker, spec.hint)
+		}
+	}
+	for _, key := range spec.required {
+		if _, ok := params[key]; !ok {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"param %s of ranker %s is required, the params should be %s", key, ranker, spec.hint)
+		}
+	}
+	return &scalarRanker{
+		field: rankerScalarField(schema, params["field"].(string)),
+		asc:   params["order"] == "asc",
+	}, nil
+}
+
+// addOutputField makes sure the ranking field is in the output fields and returns the output fields.
+func (r *scalarRanker) addOutputField(outputFields []string) []string {
+	for i, name := range outputFields {
+		if name == r.field.GetName() {
+			r.fieldIndex = i
+			return outputFields
+		}
+	}
+	r.fieldIndex = len(outputFields)
+	r.hidden = true
+	return append(outputFields, r.field.GetName())
+}
+
+// rerank re-orders the results of each query by the ranking field, and removes the field from the results and
+// the output fields if it's hidden. It returns the output fields.
+func (r *scalarRanker) rerank(data *schemapb.SearchResultData, outputFields []string) ([]string, error) {
+	total := len(data.GetIds().GetIntId().GetData()) + len(data.GetIds().GetStrId().GetData())
+	if total > 0 {
+		if r.fieldIndex >= len(data.GetFieldsData()) {
+			return nil, fmt.Errorf("the ranking field %s is missing in the search results", r.field.GetName())
+		}
+		values, err := rankerFieldValues(data.GetFieldsData()[r.fieldIndex].GetScalars(), total)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rank the search results by field %s: %w", r.field.GetName(), err)
+		}
+		order := make([]int64, 0, total)
+		offset := int64(0)
+		for _, topk := range data.GetTopks() {
+			begin := len(order)
+			for i := offset; i < offset+topk; i++ {
+				order = append(order, i)
+			}
+			query := order[begin:]
+			sort.SliceStable(query, func(i, j int) bool {
+				if r.asc {
+					return values[query[i]] < values[query[j]]
+				}
+				return values[query[i]] > values[query[j]]
+			})
+			offset += topk
+		}
+		reorderSearchResults(data, order)
+	}
+	if !r.hidden {
+		return outputFields, nil
+	}
+	if r.fieldIndex < len(data.GetFieldsData()) {
+		data.FieldsData = append(data.FieldsData[:r.fieldIndex], data.FieldsData[r.fieldIndex+1:]...)
+	}
+	return outputFields[:r.fieldIndex], nil
+}
+
+// rankerFieldValues returns the numeric values of the field data.
+func rankerFieldValues(scalars *schemapb.ScalarField, total int) ([]float64, error) {
+	values := make([]float64, 0, total)
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_IntData:
+		for _, v := range data.IntData.GetData() {
+			values = append(values, float64(v))
+		}
+	case *schemapb.ScalarField_LongData:
+		for _, v := range data.LongData.GetData() {
+			values = append(values, float64(v))
+		}
+	case *schemapb.ScalarField_FloatData:
+		for _, v := range data.FloatData.GetData() {
+			values = append(values, float64(v))
+		}
+	case *schemapb.ScalarField_DoubleData:
+		for _, v := range data.DoubleData.GetData() {
+			values = append(values, v)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected field data %T", data)
+	}
+	if len(values) != total {
+		return nil, fmt.Errorf("number of values %d doesn't match number of results %d", len(values), total)
+	}
+	return values, nil
+}
+
+// reorderSearchResults re-orders the ids, scores and fields data of the results by the offsets in order.
+func reorderSearchResults(data *schemapb.SearchResultData, order []int64) {
+	ids := &schemapb.IDs{}
+	scores := make([]float32, 0, len(order))
+	fieldsData := make([]*schemapb.FieldData, len(data.GetFieldsData()))
+	for _, i := range order {
+		typeutil.AppendIDs(ids, data.GetIds(), int(i))
+		scores = append(scores, data.GetScores()[i])
+		typeutil.AppendFieldData(fieldsData, data.GetFieldsData(), i)
+	}
+	data.Ids = ids
+	data.Scores = scores
+	data.FieldsData = fieldsData
+}
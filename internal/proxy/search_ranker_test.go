@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestParseRanker(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "price", DataType: schemapb.DataType_Float},
+			{FieldID: 102, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	params := func(kvs ...string) []*commonpb.KeyValuePair {
+		pairs := []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}
+		for i := 0; i < len(kvs); i += 2 {
+			pairs = append(pairs, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+		}
+		return pairs
+	}
+
+	ranker, err := parseRanker(schema, params())
+	assert.NoError(t, err)
+	assert.Nil(t, ranker)
+
+	ranker, err = parseRanker(schema, params(RankerKey, "scalar", RankerParamsKey, `{"field": "price"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "price", ranker.field.GetName())
+	assert.False(t, ranker.asc)
+
+	ranker, err = parseRanker(schema, params(RankerKey, "scalar", RankerParamsKey, `{"field": "price", "order": "asc"}`))
+	assert.NoError(t, err)
+	assert.True(t, ranker.asc)
+
+	invalid := []struct {
+		searchParams []*commonpb.KeyValuePair
+		reason       string
+	}{
+		{params(RankerKey, "unknown"), "should be one of [scalar]"},
+		// the rankers fusing multiple vector fields aren't applied to the search of one anns field
+		{params(RankerKey, "rrf", RankerParamsKey, `{"k": 60}`), "ranker [rrf] is not supported"},
+		{params(RankerKey, "weighted", RankerParamsKey, `{"weights": [0.3, 0.7]}`), "ranker [weighted] is not supported"},
+		{params(RankerKey, "bm25"), "ranker [bm25] is not supported"},
+		{params(RankerParamsKey, `{"field": "price"}`), "ranker_params is set without ranker"},
+		{params(RankerKey, "scalar", MMRLambdaKey, "0.5"), "can't be specified at the same time"},
+		{params(RankerKey, "scalar", RankerParamsKey, `["price"]`), "should be a json object"},
+		{params(RankerKey, "scalar", RankerParamsKey, `{"field": "price", "k": 60}`), "unknown param k of ranker scalar"},
+		{params(RankerKey, "scalar"), "param field of ranker scalar is required"},
+		{params(RankerKey, "scalar", RankerParamsKey, `{"field": "title"}`), `param field ["title"] of ranker scalar is invalid`},
+		{params(RankerKey, "scalar", RankerParamsKey, `{"field": "price", "order": "up"}`), `param order ["up"] of ranker scalar is invalid`},
+	}
+	for _, c := range invalid {
+		_, err := parseRanker(schema, c.searchParams)
+		if assert.Error(t, err, c.searchParams) {
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+			assert.Contains(t, err.Error(), c.reason)
+		}
+	}
+}
+
+func TestScalarRanker_rerank(t *testing.T) {
+	priceField := &schemapb.FieldSchema{FieldID: 101, Name: "price", DataType: schemapb.DataType_Float}
+	newResults := func() *schemapb.SearchResultData {
+		// two queries of 3 and 2 results ordered by score
+		return &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Topks:      []int64{3, 2},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}}},
+			Scores:     []float32{0.1, 0.2, 0.3, 0.4, 0.5},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int64,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}},
+					}},
+				},
+				{
+					Type:    schemapb.DataType_Float,
+					FieldId: 101,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{5, 9, 5, 1, 2}}},
+					}},
+				},
+			},
+		}
+	}
+
+	t.Run("desc", func(t *testing.T) {
+		ranker := &scalarRanker{field: priceField}
+		outputFields := ranker.addOutputField([]string{"pk", "price"})
+		assert.Equal(t, []string{"pk", "price"}, outputFields)
+
+		data := newResults()
+		outputFields, err := ranker.rerank(data, outputFields)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pk", "price"}, outputFields)
+		// the results of the same price keep their order by score
+		assert.Equal(t, []int64{2, 1, 3, 5, 4}, data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.2, 0.1, 0.3, 0.5, 0.4}, data.GetScores())
+		assert.Equal(t, []float32{9, 5, 5, 2, 1}, data.GetFieldsData()[1].GetScalars().GetFloatData().GetData())
+	})
+
+	t.Run("asc and hidden", func(t *testing.T) {
+		ranker := &scalarRanker{field: priceField, asc: true}
+		outputFields := ranker.addOutputField([]string{"pk"})
+		assert.Equal(t, []string{"pk", "price"}, outputFields)
+
+		data := newResults()
+		outputFields, err := ranker.rerank(data, outputFields)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pk"}, outputFields)
+		assert.Equal(t, []int64{1, 3, 2, 4, 5}, data.GetIds().GetIntId().GetData())
+		require.Len(t, data.GetFieldsData(), 1)
+		assert.Equal(t, []int64{1, 3, 2, 4, 5}, data.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		ranker := &scalarRanker{field: priceField}
+		outputFields := ranker.addOutputField([]string{"pk"})
+		outputFields, err := ranker.rerank(&schemapb.SearchResultData{}, outputFields)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pk"}, outputFields)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		ranker := &scalarRanker{field: priceField}
+		outputFields := ranker.addOutputField([]string{"pk"})
+		data := newResults()
+		data.FieldsData = data.FieldsData[:1]
+		_, err := ranker.rerank(data, outputFields)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// sessionReregisterAttempts is how many times Proxy tries to re-register its session after the lease is lost,
+// each attempt retries registering to etcd by itself.
+const sessionReregisterAttempts = 5
+
+// sessionRegistry is the part of the session keeping Proxy registered to etcd.
+type sessionRegistry interface {
+	LivenessCheck(ctx context.Context, callback func())
+	Reregister() error
+}
+
+// keepSessionRegistered re-registers the session every time its lease is lost, so that Proxy can still be found by
+// the other components after a network blip. Proxy is Abnormal until the session is re-registered, so that the load
+// balancers drain it meanwhile. onGiveUp is called if the session can't be re-registered after the retries.
+func (node *Proxy) keepSessionRegistered(ctx context.Context, session sessionRegistry, onGiveUp func(), opts ...retry.Option) {
+	for {
+		lost := make(chan struct{})
+		go session.LivenessCheck(ctx, func() {
+			close(lost)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-lost:
+		}
+
+		stateCode, _ := node.stateCode.Load().(internalpb.StateCode)
+		log.Warn("Proxy lost its session, re-registering", zap.String("state", stateCode.String()))
+		node.UpdateStateCode(internalpb.StateCode_Abnormal)
+		node.SetStateReason("session lease lost, re-registering to etcd")
+
+		nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+		err := retry.Do(ctx, func() error {
+			if err := session.Reregister(); err != nil {
+				log.Warn("Proxy failed to re-register its session", zap.Error(err))
+				metrics.ProxySessionReregisterCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+				return err
+			}
+			return nil
+		}, opts...)
+		if err != nil {
+			if ctx.Err() == nil && onGiveUp != nil {
+				onGiveUp()
+			}
+			return
+		}
+
+		metrics.ProxySessionReregisterCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+		node.SetStateReason("")
+		// the state may have been changed meanwhile, e.g. Proxy is stopping
+		if current, _ := node.stateCode.Load().(internalpb.StateCode); ctx.Err() == nil && current == internalpb.StateCode_Abnormal {
+			node.UpdateStateCode(stateCode)
+		}
+		log.Info("Proxy re-registered its session", zap.String("state", stateCode.String()))
+	}
+}
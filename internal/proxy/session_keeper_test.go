@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// mockSessionRegistry simulates the lease revocation of a session.
+type mockSessionRegistry struct {
+	lost chan struct{}
+
+	mu         sync.Mutex
+	reregister func() error
+	calls      int
+}
+
+func newMockSessionRegistry(reregister func() error) *mockSessionRegistry {
+	return &mockSessionRegistry{
+		lost:       make(chan struct{}),
+		reregister: reregister,
+	}
+}
+
+func (m *mockSessionRegistry) LivenessCheck(ctx context.Context, callback func()) {
+	select {
+	case <-m.lost:
+		go callback()
+	case <-ctx.Done():
+	}
+}
+
+func (m *mockSessionRegistry) Reregister() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	return m.reregister()
+}
+
+func (m *mockSessionRegistry) getCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+// revokeLease makes the liveness check of the session fail.
+func (m *mockSessionRegistry) revokeLease() {
+	m.lost <- struct{}{}
+}
+
+func TestProxy_keepSessionRegistered(t *testing.T) {
+	opts := []retry.Option{retry.Attempts(3), retry.Sleep(time.Millisecond), retry.MaxSleepTime(5 * time.Millisecond)}
+
+	t.Run("re-register after lease loss", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node := &Proxy{}
+		node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+		var statesWhileLost []internalpb.StateCode
+		failures := 2
+		session := newMockSessionRegistry(func() error {
+			statesWhileLost = append(statesWhileLost, node.stateCode.Load().(internalpb.StateCode))
+			if failures > 0 {
+				failures--
+				return errors.New("mock etcd unavailable")
+			}
+			return nil
+		})
+		go node.keepSessionRegistered(ctx, session, func() { t.Error("should not give up") }, opts...)
+
+		session.revokeLease()
+		assert.Eventually(t, func() bool {
+			return node.stateCode.Load().(internalpb.StateCode) == internalpb.StateCode_Healthy
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 3, session.getCalls())
+		assert.Equal(t, []internalpb.StateCode{
+			internalpb.StateCode_Abnormal, internalpb.StateCode_Abnormal, internalpb.StateCode_Abnormal,
+		}, statesWhileLost)
+		states, err := node.GetComponentStates(ctx)
+		assert.NoError(t, err)
+		// the extra info carries the build and deploy information, but no reason
+		_, err = funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, states.State.ExtraInfo)
+		assert.Error(t, err)
+
+		// keeps watching the session after the re-registration
+		session.revokeLease()
+		assert.Eventually(t, func() bool {
+			return session.getCalls() == 4
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("give up", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node := &Proxy{}
+		node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+		session := newMockSessionRegistry(func() error {
+			return errors.New("mock etcd unavailable")
+		})
+		gaveUp := make(chan struct{})
+		go node.keepSessionRegistered(ctx, session, func() { close(gaveUp) }, opts...)
+
+		session.revokeLease()
+		select {
+		case <-gaveUp:
+		case <-time.After(5 * time.Second):
+			t.Fatal("onGiveUp is not called")
+		}
+		assert.Equal(t, 3, session.getCalls())
+		assert.Equal(t, internalpb.StateCode_Abnormal, node.stateCode.Load().(internalpb.StateCode))
+		states, err := node.GetComponentStates(ctx)
+		assert.NoError(t, err)
+		reason, err := funcutil.GetAttrByKeyFromRepeatedKV(StateReasonKey, states.State.ExtraInfo)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, reason)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		node := &Proxy{}
+		session := newMockSessionRegistry(func() error {
+			return nil
+		})
+		done := make(chan struct{})
+		go func() {
+			node.keepSessionRegistered(ctx, session, nil, opts...)
+			close(done)
+		}()
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("keepSessionRegistered doesn't return after the context is done")
+		}
+		assert.Equal(t, 0, session.getCalls())
+	})
+}
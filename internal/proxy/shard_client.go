@@ -8,6 +8,7 @@ import (
 
 	qnClient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 )
 
 type queryNodeCreatorFunc func(ctx context.Context, addr string) (types.QueryNode, error)
@@ -96,6 +97,12 @@ type shardClientMgr struct {
 		sync.RWMutex
 		data map[UniqueID]*shardClient
 	}
+	// unroutable is the nodes which are stopping, requests should be routed to other replicas if possible.
+	// It's kept apart from the clients, so that a node stays unroutable when its client is released and created again.
+	unroutable struct {
+		sync.RWMutex
+		data map[UniqueID]struct{}
+	}
 	clientCreator queryNodeCreatorFunc
 }
 
@@ -107,7 +114,16 @@ func withShardClientCreator(creator queryNodeCreatorFunc) shardClientMgrOpt {
 }
 
 func defaultShardClientCreator(ctx context.Context, addr string) (types.QueryNode, error) {
-	return qnClient.NewClient(ctx, addr)
+	compression, err := compressor.GrpcCompressorName(compressor.CompressType(Params.ProxyCfg.GrpcCompression))
+	if err != nil {
+		return nil, err
+	}
+	client, err := qnClient.NewClient(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	client.SetCompression(compression)
+	return client, nil
 }
 
 // NewShardClientMgr creates a new shardClientMgr
@@ -119,6 +135,7 @@ func newShardClientMgr(options ...shardClientMgrOpt) *shardClientMgr {
 		}{data: make(map[UniqueID]*shardClient)},
 		clientCreator: defaultShardClientCreator,
 	}
+	s.unroutable.data = make(map[UniqueID]struct{})
 	for _, opt := range options {
 		opt(s)
 	}
@@ -194,6 +211,57 @@ func (c *shardClientMgr) GetClient(ctx context.Context, nodeID UniqueID) (types.
 	return client.getClient(ctx)
 }
 
+// GetAddress returns the address of the node if the proxy has a client of it.
+func (c *shardClientMgr) GetAddress(nodeID UniqueID) (string, bool) {
+	c.clients.RLock()
+	defer c.clients.RUnlock()
+	client, ok := c.clients.data[nodeID]
+	if !ok {
+		return "", false
+	}
+	return client.info.address, true
+}
+
+// MarkUnroutable marks the node as unroutable, e.g. the node is stopping, so that requests prefer other replicas.
+// The client of the node is kept, since it may be the only leader of some shards.
+func (c *shardClientMgr) MarkUnroutable(nodeID UniqueID) {
+	c.unroutable.Lock()
+	defer c.unroutable.Unlock()
+	c.unroutable.data[nodeID] = struct{}{}
+}
+
+// MarkRoutable marks the node as routable again.
+func (c *shardClientMgr) MarkRoutable(nodeID UniqueID) {
+	c.unroutable.Lock()
+	defer c.unroutable.Unlock()
+	delete(c.unroutable.data, nodeID)
+}
+
+// SyncRoutable resets the unroutable nodes by the alive ones, i.e. the nodes with client but not alive are
+// unroutable. It's used when the events of node sessions may be missed.
+func (c *shardClientMgr) SyncRoutable(alive map[UniqueID]struct{}) {
+	c.clients.RLock()
+	unroutable := make(map[UniqueID]struct{})
+	for nodeID := range c.clients.data {
+		if _, ok := alive[nodeID]; !ok {
+			unroutable[nodeID] = struct{}{}
+		}
+	}
+	c.clients.RUnlock()
+
+	c.unroutable.Lock()
+	defer c.unroutable.Unlock()
+	c.unroutable.data = unroutable
+}
+
+// IsRoutable returns whether requests could be routed to the node.
+func (c *shardClientMgr) IsRoutable(nodeID UniqueID) bool {
+	c.unroutable.RLock()
+	defer c.unroutable.RUnlock()
+	_, ok := c.unroutable.data[nodeID]
+	return !ok
+}
+
 // Close release clients
 func (c *shardClientMgr) Close() {
 	c.clients.Lock()
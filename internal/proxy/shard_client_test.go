@@ -98,3 +98,45 @@ func TestShardClientMgr_UpdateShardLeaders_Ref(t *testing.T) {
 	_, err = mgr.GetClient(context.Background(), UniqueID(3))
 	assert.NoError(t, err)
 }
+
+func TestShardClientMgr_GetAddress(t *testing.T) {
+	mgr := newShardClientMgr()
+	_, ok := mgr.GetAddress(1)
+	assert.False(t, ok)
+
+	err := mgr.UpdateShardLeaders(nil, genShardLeaderInfo("c1", []UniqueID{1}))
+	assert.NoError(t, err)
+	address, ok := mgr.GetAddress(1)
+	assert.True(t, ok)
+	assert.Equal(t, "fake", address)
+}
+
+func TestShardClientMgr_Routable(t *testing.T) {
+	mgr := newShardClientMgr()
+	leaders := genShardLeaderInfo("c1", []UniqueID{1, 2})
+	err := mgr.UpdateShardLeaders(nil, leaders)
+	assert.NoError(t, err)
+
+	mgr.MarkUnroutable(1)
+	assert.False(t, mgr.IsRoutable(1))
+	assert.True(t, mgr.IsRoutable(2))
+
+	// the node stays unroutable when its client is released and created again
+	err = mgr.UpdateShardLeaders(leaders, nil)
+	assert.NoError(t, err)
+	_, err = mgr.GetClient(context.Background(), UniqueID(1))
+	assert.Error(t, err)
+	err = mgr.UpdateShardLeaders(nil, leaders)
+	assert.NoError(t, err)
+	assert.False(t, mgr.IsRoutable(1))
+
+	mgr.MarkRoutable(1)
+	assert.True(t, mgr.IsRoutable(1))
+
+	// the nodes with client but without session are unroutable
+	mgr.MarkUnroutable(3)
+	mgr.SyncRoutable(map[UniqueID]struct{}{1: {}})
+	assert.True(t, mgr.IsRoutable(1))
+	assert.False(t, mgr.IsRoutable(2))
+	assert.True(t, mgr.IsRoutable(3))
+}
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+var (
+	// warmUpCheckInterval is the interval to check if the loading collection is fully loaded
+	warmUpCheckInterval = time.Second
+	// warmUpTimeout is how long to wait for the loading collection to be fully loaded at most
+	warmUpTimeout = 10 * time.Minute
+)
+
+// warmUpShardLeaders waits until the collection is fully loaded, then fetches its shard leaders into the meta cache
+// and the shard client manager, so that the first searches and queries don't pay for fetching them. It's best-effort,
+// the failures are only logged, the shard leaders are fetched by the first search or query as usual then.
+func warmUpShardLeaders(cache Cache, queryCoord types.QueryCoord, database, collectionName string, collectionID UniqueID) {
+	ctx, cancel := context.WithTimeout(context.Background(), warmUpTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(warmUpCheckInterval)
+	defer ticker.Stop()
+	for {
+		loaded, err := isCollectionFullyLoaded(ctx, queryCoord, collectionID)
+		if err != nil {
+			log.Warn("failed to check the loading progress, skip warming up the shard leaders",
+				zap.String("collection", collectionName), zap.Error(err))
+			return
+		}
+		if loaded {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			log.Warn("collection is not fully loaded in time, skip warming up the shard leaders",
+				zap.String("collection", collectionName), zap.Duration("timeout", warmUpTimeout))
+			return
+		case <-ticker.C:
+		}
+	}
+
+	shards, err := cache.GetShards(ctx, false, database, collectionName)
+	if err != nil {
+		log.Warn("failed to warm up the shard leaders", zap.String("collection", collectionName), zap.Error(err))
+		return
+	}
+	log.Info("shard leaders warmed up", zap.String("collection", collectionName), zap.Int("shards", len(shards)))
+}
+
+// isCollectionFullyLoaded returns true if the collection is 100% loaded, or an error if it's not being loaded.
+func isCollectionFullyLoaded(ctx context.Context, queryCoord types.QueryCoord, collectionID UniqueID) (bool, error) {
+	resp, err := queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowCollections,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionIDs: []int64{collectionID},
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return false, errors.New(resp.GetStatus().GetReason())
+	}
+	for i, id := range resp.GetCollectionIDs() {
+		if id == collectionID && i < len(resp.GetInMemoryPercentages()) {
+			return resp.GetInMemoryPercentages()[i] >= 100, nil
+		}
+	}
+	return false, fmt.Errorf("collection %d is not being loaded", collectionID)
+}
+
+// warmUpLoadedCollections fetches the schemas and the shard leaders of all the fully loaded collections into the
+// meta cache at most concurrency collections at a time, so that the first requests after proxy restarts don't pay
+// for fetching them. It gives up once the budget runs out, the failures are only logged, the meta and shard leaders
+// are fetched by the first requests as usual then. It returns the number of the collections warmed up.
+func warmUpLoadedCollections(ctx context.Context, cache Cache, rootCoord types.RootCoord, queryCoord types.QueryCoord,
+	concurrency int, budget time.Duration) int {
+	ctx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	collections, err := listLoadedCollections(ctx, rootCoord, queryCoord)
+	if err != nil {
+		log.Warn("failed to list the loaded collections, skip warming up the caches", zap.Error(err))
+		return 0
+	}
+
+	var warmed int32
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, collectionName := range collections {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(collectionName string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if _, err := cache.GetCollectionInfo(ctx, "", collectionName); err != nil {
+				log.Warn("failed to warm up the collection meta", zap.String("collection", collectionName), zap.Error(err))
+				return
+			}
+			if _, err := cache.GetShards(ctx, true, "", collectionName); err != nil {
+				log.Warn("failed to warm up the shard leaders", zap.String("collection", collectionName), zap.Error(err))
+				return
+			}
+			atomic.AddInt32(&warmed, 1)
+		}(collectionName)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		log.Warn("caches are not warmed up within the budget", zap.Duration("budget", budget),
+			zap.Int("loaded", len(collections)), zap.Int32("warmed", atomic.LoadInt32(&warmed)))
+	}
+	return int(atomic.LoadInt32(&warmed))
+}
+
+// listLoadedCollections returns the names of the fully loaded collections of the default database.
+func listLoadedCollections(ctx context.Context, rootCoord types.RootCoord, queryCoord types.QueryCoord) ([]string, error) {
+	loadedResp, err := queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowCollections,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if loadedResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(loadedResp.GetStatus().GetReason())
+	}
+	loaded := make(map[UniqueID]struct{})
+	for i, id := range loadedResp.GetCollectionIDs() {
+		if i < len(loadedResp.GetInMemoryPercentages()) && loadedResp.GetInMemoryPercentages()[i] >= 100 {
+			loaded[id] = struct{}{}
+		}
+	}
+	if len(loaded) == 0 {
+		return nil, nil
+	}
+
+	resp, err := rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowCollections,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	collections := make([]string, 0, len(loaded))
+	for i, name := range resp.GetCollectionNames() {
+		if i >= len(resp.GetCollectionIds()) {
+			break
+		}
+		if _, ok := loaded[resp.GetCollectionIds()[i]]; ok {
+			collections = append(collections, name)
+		}
+	}
+	return collections, nil
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestWarmUpLoadedCollections(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	// collections 1 to 6 exist, the even ones are fully loaded, 3 is being loaded
+	rc := newMockRootCoord()
+	rc.ShowCollectionsFunc = func(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+		resp := &milvuspb.ShowCollectionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		for id := int64(1); id <= 6; id++ {
+			resp.CollectionIds = append(resp.CollectionIds, id)
+			resp.CollectionNames = append(resp.CollectionNames, fmt.Sprintf("c%d", id))
+		}
+		return resp, nil
+	}
+	qc := NewQueryCoordMock(SetQueryCoordShowCollectionsFunc(func(ctx context.Context, request *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+		return &querypb.ShowCollectionsResponse{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionIDs:       []int64{2, 3, 4, 6},
+			InMemoryPercentages: []int64{100, 50, 100, 100},
+		}, nil
+	}))
+	require.NoError(t, qc.Start())
+	defer qc.Stop()
+
+	t.Run("warm up", func(t *testing.T) {
+		var mu sync.Mutex
+		var described, sharded []string
+		var inflight, maxInflight int32
+		cache := newMockCache()
+		cache.getInfoFunc = func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			described = append(described, collectionName)
+			return &collectionInfo{}, nil
+		}
+		cache.getShardsFunc = func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInflight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.True(t, withCache)
+			sharded = append(sharded, collectionName)
+			if collectionName == "c6" {
+				return nil, errors.New("mock")
+			}
+			return map[string][]nodeInfo{}, nil
+		}
+
+		warmed := warmUpLoadedCollections(ctx, cache, rc, qc, 2, time.Minute)
+		assert.Equal(t, 2, warmed)
+		sort.Strings(described)
+		sort.Strings(sharded)
+		assert.Equal(t, []string{"c2", "c4", "c6"}, described)
+		assert.Equal(t, []string{"c2", "c4", "c6"}, sharded)
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxInflight), int32(2))
+	})
+
+	t.Run("budget runs out", func(t *testing.T) {
+		cache := newMockCache()
+		cache.getShardsFunc = func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		start := time.Now()
+		warmed := warmUpLoadedCollections(ctx, cache, rc, qc, 1, 100*time.Millisecond)
+		assert.Equal(t, 0, warmed)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("failed to list the loaded collections", func(t *testing.T) {
+		cache := newMockCache()
+		warmed := warmUpLoadedCollections(ctx, cache, newMockRootCoord(), qc, 1, time.Minute)
+		assert.Equal(t, 0, warmed)
+
+		qc.SetShowCollectionsFunc(func(ctx context.Context, request *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+			return nil, errors.New("mock")
+		})
+		defer qc.ResetShowCollectionsFunc()
+		warmed = warmUpLoadedCollections(ctx, cache, rc, qc, 1, time.Minute)
+		assert.Equal(t, 0, warmed)
+	})
+}
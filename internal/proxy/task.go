@@ -18,11 +18,15 @@ package proxy
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 
@@ -33,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/types"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -70,6 +75,7 @@ const (
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
 	GetPartitionStatisticsTaskName  = "GetPartitionStatisticsTask"
 	ShowCollectionTaskName          = "ShowCollectionTask"
+	AlterCollectionTaskName         = "AlterCollectionTask"
 	CreatePartitionTaskName         = "CreatePartitionTask"
 	DropPartitionTaskName           = "DropPartitionTask"
 	HasPartitionTaskName            = "HasPartitionTask"
@@ -91,6 +97,9 @@ const (
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
+
+	// describeIndexProgressConcurrency is the max number of indexes whose progress are got from indexCoord concurrently.
+	describeIndexProgressConcurrency = 8
 )
 
 type task interface {
@@ -177,8 +186,11 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 	}
 	cct.schema.AutoID = false
 
-	if cct.ShardsNum > Params.ProxyCfg.MaxShardNum {
-		return fmt.Errorf("maximum shards's number should be limited to %d", Params.ProxyCfg.MaxShardNum)
+	if err := validateShardsNum(cct.ShardsNum); err != nil {
+		return err
+	}
+	if cct.ShardsNum == 0 {
+		cct.ShardsNum = Params.ProxyCfg.DefaultShardNum
 	}
 
 	if int64(len(cct.schema.Fields)) > Params.ProxyCfg.MaxFieldNum {
@@ -205,6 +217,15 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// validate partition key definition and the number of partitions hashed into
+	partitionKey, err := validatePartitionKey(cct.schema)
+	if err != nil {
+		return err
+	}
+	if err := validateNumPartitions(partitionKey, cct.NumPartitions); err != nil {
+		return err
+	}
+
 	// validate field type definition
 	if err := validateFieldType(cct.schema); err != nil {
 		return err
@@ -216,7 +237,7 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 			return err
 		}
 		// validate vector field type parameters
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+		if typeutil.IsVectorType(field.DataType) {
 			err = validateDimension(field)
 			if err != nil {
 				return err
@@ -245,11 +266,74 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (cct *createCollectionTask) Execute(ctx context.Context) error {
+	if cct.GetIfNotExists() {
+		existed, err := cct.checkExistedSchema(ctx)
+		if err != nil {
+			return err
+		}
+		if existed {
+			cct.result = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+			return nil
+		}
+	}
 	var err error
 	cct.result, err = cct.rootCoord.CreateCollection(ctx, cct.CreateCollectionRequest)
 	return err
 }
 
+// checkExistedSchema returns whether the collection exists with the same schema, the field order aside.
+// SchemaMismatch is returned if it exists with a different schema.
+func (cct *createCollectionTask) checkExistedSchema(ctx context.Context) (bool, error) {
+	existedSchema, err := globalMetaCache.GetCollectionSchema(ctx, cct.GetDbName(), cct.CollectionName)
+	if errorCodeOf(err) == commonpb.ErrorCode_CollectionNotExists {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if collectionSchemaHash(existedSchema) != collectionSchemaHash(cct.schema) {
+		return false, newErrWithCode(commonpb.ErrorCode_SchemaMismatch,
+			"collection %s already exists with a different schema", cct.CollectionName)
+	}
+	log.Ctx(ctx).Info("collection already exists with the same schema, skip creating",
+		zap.String("collection", cct.CollectionName))
+	return true, nil
+}
+
+// collectionSchemaHash returns the hash of the schema regardless of the order of the fields. The field ids and
+// states assigned by rootCoord and the system fields are left out, so that the schema of a request can be compared
+// with the one of an existing collection.
+func collectionSchemaHash(schema *schemapb.CollectionSchema) string {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetName() == common.RowIDFieldName || field.GetName() == common.TimeStampFieldName {
+			continue
+		}
+		typeParams := make([]*commonpb.KeyValuePair, len(field.GetTypeParams()))
+		copy(typeParams, field.GetTypeParams())
+		sort.Slice(typeParams, func(i, j int) bool { return typeParams[i].GetKey() < typeParams[j].GetKey() })
+		fields = append(fields, &schemapb.FieldSchema{
+			Name:           field.GetName(),
+			IsPrimaryKey:   field.GetIsPrimaryKey(),
+			Description:    field.GetDescription(),
+			DataType:       field.GetDataType(),
+			TypeParams:     typeParams,
+			AutoID:         field.GetAutoID(),
+			IsPartitionKey: field.GetIsPartitionKey(),
+		})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].GetName() < fields[j].GetName() })
+
+	hash := sha256.New()
+	hash.Write([]byte(schema.GetDescription()))
+	for _, field := range fields {
+		// the marshaling of a message without maps is deterministic
+		bs, _ := proto.Marshal(field)
+		hash.Write(bs)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 func (cct *createCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -312,11 +396,14 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.GetDbName(), dct.CollectionName)
 	if err != nil {
-		// make dropping collection idempotent.
-		dct.result = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
-		return nil
+		// make dropping collection idempotent, if_exists or not, but the other failures are returned.
+		if errorCodeOf(err) == commonpb.ErrorCode_CollectionNotExists {
+			dct.result = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+			return nil
+		}
+		return err
 	}
 
 	dct.result, err = dct.rootCoord.DropCollection(ctx, dct.DropCollectionRequest)
@@ -325,65 +412,13 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	_ = dct.chMgr.removeDMLStream(collID)
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.GetDbName(), dct.CollectionName)
 	return nil
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
-	return nil
-}
-
-// Support wildcard in output fields:
-//   "*" - all scalar fields
-//   "%" - all vector fields
-// For example, A and B are scalar fields, C and D are vector fields, duplicated fields will automatically be removed.
-//   output_fields=["*"] 	 ==> [A,B]
-//   output_fields=["%"] 	 ==> [C,D]
-//   output_fields=["*","%"] ==> [A,B,C,D]
-//   output_fields=["*",A] 	 ==> [A,B]
-//   output_fields=["*",C]   ==> [A,B,C]
-func translateOutputFields(outputFields []string, schema *schemapb.CollectionSchema, addPrimary bool) ([]string, error) {
-	var primaryFieldName string
-	scalarFieldNameMap := make(map[string]bool)
-	vectorFieldNameMap := make(map[string]bool)
-	resultFieldNameMap := make(map[string]bool)
-	resultFieldNames := make([]string, 0)
-
-	for _, field := range schema.Fields {
-		if field.IsPrimaryKey {
-			primaryFieldName = field.Name
-		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
-			vectorFieldNameMap[field.Name] = true
-		} else {
-			scalarFieldNameMap[field.Name] = true
-		}
-	}
-
-	for _, outputFieldName := range outputFields {
-		outputFieldName = strings.TrimSpace(outputFieldName)
-		if outputFieldName == "*" {
-			for fieldName := range scalarFieldNameMap {
-				resultFieldNameMap[fieldName] = true
-			}
-		} else if outputFieldName == "%" {
-			for fieldName := range vectorFieldNameMap {
-				resultFieldNameMap[fieldName] = true
-			}
-		} else {
-			resultFieldNameMap[outputFieldName] = true
-		}
-	}
-
-	if addPrimary {
-		resultFieldNameMap[primaryFieldName] = true
-	}
-
-	for fieldName := range resultFieldNameMap {
-		resultFieldNames = append(resultFieldNames, fieldName)
-	}
-	return resultFieldNames, nil
+	globalMetaCache.RemoveCollection(ctx, dct.GetDbName(), dct.CollectionName)
+	return nil
 }
 
 type hasCollectionTask struct {
@@ -554,6 +589,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.ShardsNum = result.ShardsNum
 		dct.result.ConsistencyLevel = result.ConsistencyLevel
 		dct.result.Aliases = result.Aliases
+		dct.result.Properties = publicCollectionProperties(result.Properties)
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= common.StartOfUserFieldID {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
@@ -659,7 +695,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -669,6 +705,8 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			IDs2Names[collectionID] = collectionName
 		}
 
+		// collections not loaded are reported with zero in-memory percentage instead of error,
+		// so only ask QueryCoord for all the loaded collections.
 		resp, err := sct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_ShowCollections,
@@ -677,7 +715,6 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 				SourceID:  sct.Base.SourceID,
 			},
 			//DbID: sct.ShowCollectionsRequest.DbName,
-			CollectionIDs: collectionIDs,
 		})
 
 		if err != nil {
@@ -697,24 +734,37 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			return errors.New(newErrorReason)
 		}
 
+		percentages := make(map[UniqueID]int64, len(resp.CollectionIDs))
+		availables := make(map[UniqueID]bool, len(resp.CollectionIDs))
+		for offset, id := range resp.CollectionIDs {
+			percentages[id] = resp.InMemoryPercentages[offset]
+			if offset < len(resp.QueryServiceAvailable) {
+				availables[id] = resp.QueryServiceAvailable[offset]
+			}
+		}
+		// without specified collections, all the loaded collections are returned.
+		if len(collectionIDs) == 0 {
+			collectionIDs = resp.CollectionIDs
+		}
+
 		sct.result = &milvuspb.ShowCollectionsResponse{
 			Status:                resp.Status,
-			CollectionNames:       make([]string, 0, len(resp.CollectionIDs)),
-			CollectionIds:         make([]int64, 0, len(resp.CollectionIDs)),
-			CreatedTimestamps:     make([]uint64, 0, len(resp.CollectionIDs)),
-			CreatedUtcTimestamps:  make([]uint64, 0, len(resp.CollectionIDs)),
-			InMemoryPercentages:   make([]int64, 0, len(resp.CollectionIDs)),
-			QueryServiceAvailable: make([]bool, 0, len(resp.CollectionIDs)),
+			CollectionNames:       make([]string, 0, len(collectionIDs)),
+			CollectionIds:         make([]int64, 0, len(collectionIDs)),
+			CreatedTimestamps:     make([]uint64, 0, len(collectionIDs)),
+			CreatedUtcTimestamps:  make([]uint64, 0, len(collectionIDs)),
+			InMemoryPercentages:   make([]int64, 0, len(collectionIDs)),
+			QueryServiceAvailable: make([]bool, 0, len(collectionIDs)),
 		}
 
-		for offset, id := range resp.CollectionIDs {
+		for _, id := range collectionIDs {
 			collectionName, ok := IDs2Names[id]
 			if !ok {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -724,8 +774,8 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 			sct.result.CollectionNames = append(sct.result.CollectionNames, collectionName)
 			sct.result.CreatedTimestamps = append(sct.result.CreatedTimestamps, collectionInfo.createdTimestamp)
 			sct.result.CreatedUtcTimestamps = append(sct.result.CreatedUtcTimestamps, collectionInfo.createdUtcTimestamp)
-			sct.result.InMemoryPercentages = append(sct.result.InMemoryPercentages, resp.InMemoryPercentages[offset])
-			sct.result.QueryServiceAvailable = append(sct.result.QueryServiceAvailable, resp.QueryServiceAvailable[offset])
+			sct.result.InMemoryPercentages = append(sct.result.InMemoryPercentages, percentages[id])
+			sct.result.QueryServiceAvailable = append(sct.result.QueryServiceAvailable, availables[id])
 		}
 	} else {
 		sct.result = respFromRootCoord
@@ -738,6 +788,86 @@ func (sct *showCollectionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type alterCollectionTask struct {
+	Condition
+	*milvuspb.AlterCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (act *alterCollectionTask) TraceCtx() context.Context {
+	return act.ctx
+}
+
+func (act *alterCollectionTask) ID() UniqueID {
+	return act.Base.MsgID
+}
+
+func (act *alterCollectionTask) SetID(uid UniqueID) {
+	act.Base.MsgID = uid
+}
+
+func (act *alterCollectionTask) Name() string {
+	return AlterCollectionTaskName
+}
+
+func (act *alterCollectionTask) Type() commonpb.MsgType {
+	return act.Base.MsgType
+}
+
+func (act *alterCollectionTask) BeginTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *alterCollectionTask) EndTs() Timestamp {
+	return act.Base.Timestamp
+}
+
+func (act *alterCollectionTask) SetTs(ts Timestamp) {
+	act.Base.Timestamp = ts
+}
+
+func (act *alterCollectionTask) OnEnqueue() error {
+	act.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (act *alterCollectionTask) PreExecute(ctx context.Context) error {
+	act.Base.MsgType = commonpb.MsgType_AlterCollection
+	act.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(act.CollectionName); err != nil {
+		return err
+	}
+	if len(act.GetProperties()) == 0 {
+		return errors.New("the properties to alter are empty")
+	}
+	for _, pair := range act.GetProperties() {
+		if pair.GetKey() == common.CollectionDisabledKey {
+			if _, err := strconv.ParseBool(pair.GetValue()); err != nil {
+				return fmt.Errorf("%s [%s] is invalid, should be true or false", common.CollectionDisabledKey, pair.GetValue())
+			}
+		}
+		if pair.GetKey() == common.CollectionWriteBlockedUntilKey {
+			if _, err := strconv.ParseInt(pair.GetValue(), 10, 64); err != nil {
+				return fmt.Errorf("%s [%s] is invalid, should be a unix timestamp in milliseconds", common.CollectionWriteBlockedUntilKey, pair.GetValue())
+			}
+		}
+	}
+	return nil
+}
+
+func (act *alterCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	act.result, err = act.rootCoord.AlterCollection(ctx, act.AlterCollectionRequest)
+	return err
+}
+
+func (act *alterCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
@@ -974,6 +1104,7 @@ type showPartitionsTask struct {
 	ctx        context.Context
 	rootCoord  types.RootCoord
 	queryCoord types.QueryCoord
+	dataCoord  types.DataCoord
 	result     *milvuspb.ShowPartitionsResponse
 }
 
@@ -1049,7 +1180,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1062,7 +1193,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1071,46 +1202,32 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 			partitionIDs = append(partitionIDs, partitionID)
 			IDs2Names[partitionID] = partitionName
 		}
-		resp, err := spt.queryCoord.ShowPartitions(ctx, &querypb.ShowPartitionsRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:   commonpb.MsgType_ShowCollections,
-				MsgID:     spt.Base.MsgID,
-				Timestamp: spt.Base.Timestamp,
-				SourceID:  spt.Base.SourceID,
-			},
-			CollectionID: collectionID,
-			PartitionIDs: partitionIDs,
-		})
-
+		percentages, loadedIDs, err := spt.getLoadedPartitions(ctx, collectionID)
 		if err != nil {
 			return err
 		}
-
-		if resp == nil {
-			return errors.New("failed to show partitions")
-		}
-
-		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-			return errors.New(resp.Status.Reason)
+		// without specified partitions, all the loaded partitions are returned.
+		if len(partitionIDs) == 0 {
+			partitionIDs = loadedIDs
 		}
 
 		spt.result = &milvuspb.ShowPartitionsResponse{
-			Status:               resp.Status,
-			PartitionNames:       make([]string, 0, len(resp.PartitionIDs)),
-			PartitionIDs:         make([]int64, 0, len(resp.PartitionIDs)),
-			CreatedTimestamps:    make([]uint64, 0, len(resp.PartitionIDs)),
-			CreatedUtcTimestamps: make([]uint64, 0, len(resp.PartitionIDs)),
-			InMemoryPercentages:  make([]int64, 0, len(resp.PartitionIDs)),
+			Status:               &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			PartitionNames:       make([]string, 0, len(partitionIDs)),
+			PartitionIDs:         make([]int64, 0, len(partitionIDs)),
+			CreatedTimestamps:    make([]uint64, 0, len(partitionIDs)),
+			CreatedUtcTimestamps: make([]uint64, 0, len(partitionIDs)),
+			InMemoryPercentages:  make([]int64, 0, len(partitionIDs)),
 		}
 
-		for offset, id := range resp.PartitionIDs {
+		for _, id := range partitionIDs {
 			partitionName, ok := IDs2Names[id]
 			if !ok {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1120,15 +1237,102 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 			spt.result.PartitionNames = append(spt.result.PartitionNames, partitionName)
 			spt.result.CreatedTimestamps = append(spt.result.CreatedTimestamps, partitionInfo.createdTimestamp)
 			spt.result.CreatedUtcTimestamps = append(spt.result.CreatedUtcTimestamps, partitionInfo.createdUtcTimestamp)
-			spt.result.InMemoryPercentages = append(spt.result.InMemoryPercentages, resp.InMemoryPercentages[offset])
+			spt.result.InMemoryPercentages = append(spt.result.InMemoryPercentages, percentages[id])
 		}
 	} else {
 		spt.result = respFromRootCoord
 	}
 
+	if spt.GetWithRowCounts() {
+		return spt.fillInRowCounts(ctx)
+	}
+	return nil
+}
+
+// fillInRowCounts fills in the row counts of the partitions in the result, which are fetched from dataCoord in one call.
+func (spt *showPartitionsTask) fillInRowCounts(ctx context.Context) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), spt.CollectionName)
+	if err != nil {
+		return err
+	}
+	resp, err := spt.dataCoord.GetPartitionStatistics(ctx, &datapb.GetPartitionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_GetPartitionStatistics,
+			MsgID:     spt.Base.MsgID,
+			Timestamp: spt.Base.Timestamp,
+			SourceID:  spt.Base.SourceID,
+		},
+		CollectionID: collectionID,
+		PartitionIDs: spt.result.GetPartitionIDs(),
+		PerPartition: true,
+	})
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errors.New("failed to get the row counts of partitions")
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+
+	spt.result.NumRows = make([]int64, 0, len(spt.result.GetPartitionIDs()))
+	for _, partitionID := range spt.result.GetPartitionIDs() {
+		spt.result.NumRows = append(spt.result.NumRows, resp.GetPartitionRowCounts()[partitionID])
+	}
 	return nil
 }
 
+// getLoadedPartitions returns the in-memory percentages and ids of the loaded partitions of the collection,
+// nothing is returned if the collection is not loaded at all.
+func (spt *showPartitionsTask) getLoadedPartitions(ctx context.Context, collectionID UniqueID) (map[UniqueID]int64, []UniqueID, error) {
+	resp, err := spt.queryCoord.ShowPartitions(ctx, &querypb.ShowPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     spt.Base.MsgID,
+			Timestamp: spt.Base.Timestamp,
+			SourceID:  spt.Base.SourceID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil {
+		return nil, nil, errors.New("failed to show partitions")
+	}
+
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		// QueryCoord fails to show partitions of the collection not loaded, tell it from the other errors.
+		showResp, err := spt.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ShowCollections,
+				MsgID:     spt.Base.MsgID,
+				Timestamp: spt.Base.Timestamp,
+				SourceID:  spt.Base.SourceID,
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if showResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, nil, errors.New(showResp.GetStatus().GetReason())
+		}
+		for _, id := range showResp.GetCollectionIDs() {
+			if id == collectionID {
+				return nil, nil, errors.New(resp.Status.Reason)
+			}
+		}
+		return map[UniqueID]int64{}, nil, nil
+	}
+
+	percentages := make(map[UniqueID]int64, len(resp.PartitionIDs))
+	for offset, id := range resp.PartitionIDs {
+		percentages[id] = resp.InMemoryPercentages[offset]
+	}
+	return percentages, resp.PartitionIDs, nil
+}
+
 func (spt *showPartitionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -1143,6 +1347,10 @@ type createIndexTask struct {
 
 	collectionID UniqueID
 	fieldSchema  *schemapb.FieldSchema
+
+	// the lifecycle of proxy, which the writes blocked by the task are unblocked within
+	nodeCtx context.Context
+	nodeWg  *sync.WaitGroup
 }
 
 func (cit *createIndexTask) TraceCtx() context.Context {
@@ -1197,15 +1405,11 @@ func parseIndexParams(m []*commonpb.KeyValuePair) (map[string]string, error) {
 			indexParams[kv.Key] = kv.Value
 		}
 	}
-	_, exist := indexParams["index_type"] // TODO(dragondriver): change `index_type` to const variable
-	if !exist {
-		indexParams["index_type"] = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
-	}
 	return indexParams, nil
 }
 
 func (cit *createIndexTask) getIndexedField(ctx context.Context) (*schemapb.FieldSchema, error) {
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.GetCollectionName())
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.GetDbName(), cit.GetCollectionName())
 	if err != nil {
 		log.Error("failed to get collection schema", zap.Error(err))
 		return nil, fmt.Errorf("failed to get collection schema: %s", err)
@@ -1218,7 +1422,11 @@ func (cit *createIndexTask) getIndexedField(ctx context.Context) (*schemapb.Fiel
 	field, err := schemaHelper.GetFieldFromName(cit.GetFieldName())
 	if err != nil {
 		log.Error("create index on non-exist field", zap.Error(err))
-		return nil, fmt.Errorf("cannot create index on non-exist field: %s", cit.GetFieldName())
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"cannot create index on non-exist field: %s", cit.GetFieldName())
+	}
+	if err := checkIndexableField(field); err != nil {
+		return nil, err
 	}
 	return field, nil
 }
@@ -1286,7 +1494,16 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 
 	collName := cit.CollectionName
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	if err := validateCollectionName(collName); err != nil {
+		return err
+	}
+	if cit.IndexName != "" {
+		if err := validateIndexName(cit.IndexName); err != nil {
+			return err
+		}
+	}
+
+	collID, err := globalMetaCache.GetCollectionID(ctx, cit.GetDbName(), collName)
 	if err != nil {
 		return err
 	}
@@ -1298,14 +1515,44 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	}
 	cit.fieldSchema = field
 
+	if cit.IndexName == "" {
+		cit.IndexName = Params.CommonCfg.DefaultIndexName
+	}
+	// index name is unique in a collection, check it with the latest indexes.
+	globalMetaCache.RemoveIndexInfos(cit.GetDbName(), collName)
+	indexInfos, err := globalMetaCache.GetIndexInfos(ctx, cit.GetDbName(), collName, cit.indexCoord)
+	if err != nil {
+		return err
+	}
+	if info, ok := indexInfos[cit.IndexName]; ok && info.fieldID != field.GetFieldID() {
+		return newErrWithCode(commonpb.ErrorCode_IndexNameDuplicated,
+			"index %s already exists on field %s of collection %s", cit.IndexName, info.fieldName, collName)
+	}
+
 	// check index param, not accurate, only some static rules
 	indexParams, err := parseIndexParams(cit.GetExtraParams())
 	if err != nil {
 		log.Error("failed to parse index params", zap.Error(err))
 		return fmt.Errorf("failed to parse index params: %s", err)
 	}
+	_, exist := indexParams["index_type"] // TODO(dragondriver): change `index_type` to const variable
+	if !exist && typeutil.IsVectorType(field.GetDataType()) {
+		indexParams["index_type"] = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+	}
+
+	if err := validateIndexParams(field, indexParams); err != nil {
+		log.Warn("invalid index params", zap.String("field", field.GetName()), zap.Any("index_params", indexParams), zap.Error(err))
+		return err
+	}
 
-	return checkTrain(field, indexParams)
+	if err := checkTrain(field, indexParams); err != nil {
+		return err
+	}
+	if !exist && typeutil.IsVectorType(field.GetDataType()) {
+		// store the default index type with the index params, so that the index is described as it's built
+		cit.ExtraParams = append(cit.ExtraParams, &commonpb.KeyValuePair{Key: "index_type", Value: indexParams["index_type"]})
+	}
+	return nil
 }
 
 func (cit *createIndexTask) Execute(ctx context.Context) error {
@@ -1320,10 +1567,14 @@ func (cit *createIndexTask) Execute(ctx context.Context) error {
 			indexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: DefaultIndexType}}
 		}
 	}
-	if cit.IndexName == "" {
-		cit.IndexName = Params.CommonCfg.DefaultIndexName
-	}
 	var err error
+	var blockedUntil time.Time
+	if cit.GetBlockWrites() {
+		blockedUntil = time.Now().Add(Params.ProxyCfg.IndexBuildWriteBlockTimeout)
+		if err = cit.setWriteBlockedUntil(ctx, blockedUntil); err != nil {
+			return err
+		}
+	}
 	req := &indexpb.CreateIndexRequest{
 		CollectionID: cit.collectionID,
 		FieldID:      cit.fieldSchema.GetFieldID(),
@@ -1334,16 +1585,103 @@ func (cit *createIndexTask) Execute(ctx context.Context) error {
 	}
 	cit.result, err = cit.indexCoord.CreateIndex(ctx, req)
 	//cit.result, err = cit.rootCoord.CreateIndex(ctx, cit.CreateIndexRequest)
+	if err == nil && cit.result.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(cit.result.Reason)
+	}
+	if cit.GetBlockWrites() {
+		if err != nil {
+			// the index won't be built, don't leave the writes blocked until the timeout
+			if unblockErr := cit.setWriteBlockedUntil(ctx, time.Time{}); unblockErr != nil {
+				log.Warn("failed to unblock the writes after failing to create index",
+					zap.String("collection", cit.CollectionName), zap.Error(unblockErr))
+			}
+		} else {
+			cit.nodeWg.Add(1)
+			go func() {
+				defer cit.nodeWg.Done()
+				cit.unblockWritesOnceBuilt(cit.nodeCtx, blockedUntil)
+			}()
+		}
+	}
+	return err
+}
+
+// setWriteBlockedUntil sets the collection property to block the writes until the deadline, the zero time unblocks
+// the writes. Though the deadline blocks the writes no longer than the timeout even if the unblocking is lost, e.g. the
+// proxy crashes during the build.
+func (cit *createIndexTask) setWriteBlockedUntil(ctx context.Context, deadline time.Time) error {
+	value := "0"
+	if !deadline.IsZero() {
+		value = strconv.FormatInt(deadline.UnixMilli(), 10)
+	}
+	status, err := cit.rootCoord.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_AlterCollection,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		DbName:         cit.GetDbName(),
+		CollectionName: cit.CollectionName,
+		Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionWriteBlockedUntilKey, Value: value}},
+	})
 	if err != nil {
 		return err
 	}
-	if cit.result.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(cit.result.Reason)
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return newErrWithCode(status.GetErrorCode(), "failed to block the writes of collection %s: %s", cit.CollectionName, status.GetReason())
+	}
+	// rootCoord expires the caches of all the proxies, expire it here as well in case it's done asynchronously
+	globalMetaCache.RemoveCollection(ctx, cit.GetDbName(), cit.CollectionName)
+	return nil
+}
+
+// unblockWritesOnceBuilt polls the index state and unblocks the writes once the index is built or failed, or the
+// deadline of the block is reached. It returns as soon as ctx is done, then the writes stay blocked until the
+// CollectionWriteBlockedUntilKey property expires, since no other proxy polls the index state for them.
+func (cit *createIndexTask) unblockWritesOnceBuilt(ctx context.Context, deadline time.Time) {
+	ticker := time.NewTicker(Params.ProxyCfg.IndexBuildWriteBlockCheckInterval)
+	defer ticker.Stop()
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			log.Warn("proxy is stopping, the writes are blocked until the timeout",
+				zap.String("collection", cit.CollectionName), zap.String("indexName", cit.GetIndexName()),
+				zap.Time("deadline", deadline))
+			return
+		case <-timer.C:
+			log.Warn("the index isn't built before the write block times out, unblock the writes",
+				zap.String("collection", cit.CollectionName), zap.String("indexName", cit.GetIndexName()))
+			break loop
+		case <-ticker.C:
+			resp, err := cit.indexCoord.GetIndexState(ctx, &indexpb.GetIndexStateRequest{
+				CollectionID: cit.collectionID,
+				IndexName:    cit.GetIndexName(),
+			})
+			if err != nil || resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				log.Warn("failed to get the index state to unblock the writes",
+					zap.String("collection", cit.CollectionName), zap.String("indexName", cit.GetIndexName()),
+					zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+				continue
+			}
+			if resp.GetState() == commonpb.IndexState_Finished || resp.GetState() == commonpb.IndexState_Failed {
+				log.Info("the index build is done, unblock the writes",
+					zap.String("collection", cit.CollectionName), zap.String("indexName", cit.GetIndexName()),
+					zap.String("state", resp.GetState().String()))
+				break loop
+			}
+		}
+	}
+	if err := cit.setWriteBlockedUntil(ctx, time.Time{}); err != nil {
+		log.Warn("failed to unblock the writes, they are blocked until the timeout",
+			zap.String("collection", cit.CollectionName), zap.Time("deadline", deadline), zap.Error(err))
 	}
-	return err
 }
 
 func (cit *createIndexTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveIndexInfos(cit.GetDbName(), cit.CollectionName)
 	return nil
 }
 
@@ -1401,14 +1739,19 @@ func (dit *describeIndexTask) PreExecute(ctx context.Context) error {
 	if err := validateCollectionName(dit.CollectionName); err != nil {
 		return err
 	}
+	if dit.IndexName != "" {
+		if err := validateIndexName(dit.IndexName); err != nil {
+			return err
+		}
+	}
 
-	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.CollectionName)
+	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.GetDbName(), dit.CollectionName)
 	dit.collectionID = collID
 	return nil
 }
 
 func (dit *describeIndexTask) Execute(ctx context.Context) error {
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, dit.GetCollectionName())
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dit.GetDbName(), dit.GetCollectionName())
 	if err != nil {
 		log.Error("failed to get collection schema", zap.Error(err))
 		return fmt.Errorf("failed to get collection schema: %s", err)
@@ -1419,14 +1762,17 @@ func (dit *describeIndexTask) Execute(ctx context.Context) error {
 		return fmt.Errorf("failed to parse collection schema: %s", err)
 	}
 
-	resp, err := dit.indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: dit.collectionID})
+	resp, err := dit.indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: dit.collectionID,
+		IndexName:    dit.IndexName,
+	})
 	if err != nil || resp == nil {
 		return err
 	}
 	dit.result = &milvuspb.DescribeIndexResponse{}
 	dit.result.Status = resp.GetStatus()
 	if dit.result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(dit.result.Status.Reason)
+		return newErrWithCode(dit.result.Status.GetErrorCode(), "%s", dit.result.Status.GetReason())
 	}
 	for _, indexInfo := range resp.IndexInfos {
 		field, err := schemaHelper.GetFieldFromID(indexInfo.FieldID)
@@ -1439,10 +1785,83 @@ func (dit *describeIndexTask) Execute(ctx context.Context) error {
 			IndexName: indexInfo.GetIndexName(),
 			IndexID:   indexInfo.GetIndexID(),
 			FieldName: field.Name,
-			Params:    indexInfo.GetIndexParams(),
+			Params:    describedIndexParams(indexInfo),
 		})
 	}
-	return err
+	if dit.GetSkipIndexProgress() {
+		return nil
+	}
+	dit.fillIndexProgress(ctx)
+	return nil
+}
+
+// describedIndexParams returns the index params stored with the index, followed by the type params of the indexed
+// field which aren't in the index params, e.g. dim, so that the index can be reproduced from the description.
+func describedIndexParams(info *indexpb.IndexInfo) []*commonpb.KeyValuePair {
+	params := append([]*commonpb.KeyValuePair{}, info.GetIndexParams()...)
+	for _, kv := range info.GetTypeParams() {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(kv.GetKey(), info.GetIndexParams()); err != nil {
+			params = append(params, kv)
+		}
+	}
+	return params
+}
+
+// fillIndexProgress gets the state and build progress of the described indexes from indexCoord concurrently.
+// The progress of an index is left unknown, i.e. IndexStateNone, if it fails to be got, so that the indexes
+// are still described.
+func (dit *describeIndexTask) fillIndexProgress(ctx context.Context) {
+	sem := make(chan struct{}, describeIndexProgressConcurrency)
+	var wg sync.WaitGroup
+	for _, description := range dit.result.IndexDescriptions {
+		description := description
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if err := dit.getIndexProgress(ctx, description); err != nil {
+				log.Warn("failed to get index progress, leave it unknown",
+					zap.Int64("collectionID", dit.collectionID),
+					zap.String("indexName", description.GetIndexName()),
+					zap.Error(err))
+				description.State = commonpb.IndexState_IndexStateNone
+				description.IndexStateFailReason = ""
+				description.IndexedRows = 0
+				description.TotalRows = 0
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (dit *describeIndexTask) getIndexProgress(ctx context.Context, description *milvuspb.IndexDescription) error {
+	state, err := dit.indexCoord.GetIndexState(ctx, &indexpb.GetIndexStateRequest{
+		CollectionID: dit.collectionID,
+		IndexName:    description.GetIndexName(),
+	})
+	if err != nil {
+		return err
+	}
+	if state.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to get state of index %s: %s", description.GetIndexName(), state.GetStatus().GetReason())
+	}
+	description.State = state.GetState()
+	description.IndexStateFailReason = state.GetFailReason()
+
+	progress, err := dit.indexCoord.GetIndexBuildProgress(ctx, &indexpb.GetIndexBuildProgressRequest{
+		CollectionID: dit.collectionID,
+		IndexName:    description.GetIndexName(),
+	})
+	if err != nil {
+		return err
+	}
+	if progress.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to get build progress of index %s: %s", description.GetIndexName(), progress.GetStatus().GetReason())
+	}
+	description.IndexedRows = progress.GetIndexedRows()
+	description.TotalRows = progress.GetTotalRows()
+	return nil
 }
 
 func (dit *describeIndexTask) PostExecute(ctx context.Context) error {
@@ -1505,18 +1924,32 @@ func (dit *dropIndexTask) PreExecute(ctx context.Context) error {
 	if err := validateCollectionName(collName); err != nil {
 		return err
 	}
-
-	if err := validateFieldName(fieldName); err != nil {
-		return err
+	if dit.IndexName != "" {
+		if err := validateIndexName(dit.IndexName); err != nil {
+			return err
+		}
 	}
 
-	if dit.IndexName == "" {
-		dit.IndexName = Params.CommonCfg.DefaultIndexName
+	if fieldName != "" || dit.IndexName == "" {
+		if err := validateFieldName(fieldName); err != nil {
+			return err
+		}
 	}
 
-	collID, _ := globalMetaCache.GetCollectionID(ctx, dit.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dit.GetDbName(), dit.CollectionName)
+	if err != nil {
+		return err
+	}
 	dit.collectionID = collID
 
+	// resolve the index with the latest indexes, so that a wrong index is never dropped.
+	globalMetaCache.RemoveIndexInfos(dit.GetDbName(), collName)
+	indexName, err := resolveIndexName(ctx, dit.GetDbName(), collName, fieldName, dit.IndexName, dit.indexCoord)
+	if err != nil {
+		return err
+	}
+	dit.IndexName = indexName
+
 	return nil
 }
 
@@ -1536,6 +1969,7 @@ func (dit *dropIndexTask) Execute(ctx context.Context) error {
 }
 
 func (dit *dropIndexTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveIndexInfos(dit.GetDbName(), dit.CollectionName)
 	return nil
 }
 
@@ -1595,20 +2029,26 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 	if err := validateCollectionName(gibpt.CollectionName); err != nil {
 		return err
 	}
+	if gibpt.IndexName != "" {
+		if err := validateIndexName(gibpt.IndexName); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
 	gibpt.collectionID = collectionID
 
-	if gibpt.IndexName == "" {
-		gibpt.IndexName = Params.CommonCfg.DefaultIndexName
+	gibpt.IndexName, err = resolveIndexName(ctx, gibpt.GetDbName(), collectionName, gibpt.FieldName, gibpt.IndexName, gibpt.indexCoord)
+	if err != nil {
+		return err
 	}
 
 	resp, err := gibpt.indexCoord.GetIndexBuildProgress(ctx, &indexpb.GetIndexBuildProgressRequest{
@@ -1687,16 +2127,21 @@ func (gist *getIndexStateTask) PreExecute(ctx context.Context) error {
 	if err := validateCollectionName(gist.CollectionName); err != nil {
 		return err
 	}
+	if gist.IndexName != "" {
+		if err := validateIndexName(gist.IndexName); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
-
-	if gist.IndexName == "" {
-		gist.IndexName = Params.CommonCfg.DefaultIndexName
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.GetDbName(), gist.CollectionName)
+	if err != nil {
+		return err
 	}
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.CollectionName)
+	gist.IndexName, err = resolveIndexName(ctx, gist.GetDbName(), gist.CollectionName, gist.FieldName, gist.IndexName, gist.indexCoord)
 	if err != nil {
 		return err
 	}
@@ -1772,6 +2217,16 @@ func (ft *flushTask) OnEnqueue() error {
 func (ft *flushTask) PreExecute(ctx context.Context) error {
 	ft.Base.MsgType = commonpb.MsgType_Flush
 	ft.Base.SourceID = Params.ProxyCfg.GetNodeID()
+	// the partition names belong to a single collection
+	if len(ft.PartitionNames) > 0 && len(ft.CollectionNames) != 1 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"flush partitions requires exactly one collection, but got %d", len(ft.CollectionNames))
+	}
+	for _, partitionName := range ft.PartitionNames {
+		if err := validatePartitionTag(partitionName, true); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1780,10 +2235,19 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 	flushColl2Segments := make(map[string]*schemapb.LongArray)
 	coll2SealTimes := make(map[string]int64)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.GetDbName(), collName)
 		if err != nil {
 			return err
 		}
+		partitionIDs := make([]UniqueID, 0, len(ft.PartitionNames))
+		for _, partitionName := range ft.PartitionNames {
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, ft.GetDbName(), collName, partitionName)
+			if err != nil {
+				log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partitionName))
+				return err
+			}
+			partitionIDs = append(partitionIDs, partitionID)
+		}
 		flushReq := &datapb.FlushRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_Flush,
@@ -1793,6 +2257,7 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 			},
 			DbID:         0,
 			CollectionID: collID,
+			PartitionIDs: partitionIDs,
 		}
 		resp, err := ft.dataCoord.Flush(ctx, flushReq)
 		if err != nil {
@@ -1810,7 +2275,7 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 			ErrorCode: commonpb.ErrorCode_Success,
 			Reason:    "",
 		},
-		DbName:          "",
+		DbName:          ft.GetDbName(),
 		CollSegIDs:      coll2Segments,
 		FlushCollSegIDs: flushColl2Segments,
 		CollSealTimes:   coll2SealTimes,
@@ -1890,13 +2355,13 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", typeutil.ProxyRole), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
 
 	lct.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1925,6 +2390,9 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 func (lct *loadCollectionTask) PostExecute(ctx context.Context) error {
 	log.Debug("loadCollectionTask PostExecute", zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", lct.Base.MsgID))
+	if lct.result.GetErrorCode() == commonpb.ErrorCode_Success {
+		go warmUpShardLeaders(globalMetaCache, lct.queryCoord, lct.GetDbName(), lct.CollectionName, lct.collectionID)
+	}
 	return nil
 }
 
@@ -1990,7 +2458,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.GetDbName(), rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2008,13 +2476,14 @@ func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
 
 	rct.result, err = rct.queryCoord.ReleaseCollection(ctx, request)
 
-	globalMetaCache.RemoveCollection(ctx, rct.CollectionName)
+	// the cached load info is stale whether the release succeeds or not.
+	globalMetaCache.RemoveCollection(ctx, rct.GetDbName(), rct.CollectionName)
+	globalMetaCache.ClearShards(rct.GetDbName(), rct.CollectionName)
 
 	return err
 }
 
 func (rct *releaseCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rct.CollectionName)
 	return nil
 }
 
@@ -2075,22 +2544,57 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	return lpt.checkReplicaNumber(ctx)
+}
+
+// checkReplicaNumber returns an error if some partitions of the collection are loaded with a different replica number,
+// as all the loaded partitions of a collection share the same replicas.
+func (lpt *loadPartitionsTask) checkReplicaNumber(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
+	if err != nil {
+		return err
+	}
+	resp, err := lpt.queryCoord.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetReplicas,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collID,
+	})
+	if err != nil {
+		return err
+	}
+	// queryCoord fails to get the replicas if nothing of the collection is loaded
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success || len(resp.GetReplicas()) == 0 {
+		return nil
+	}
+
+	// queryCoord loads one replica by default
+	replicaNumber := lpt.ReplicaNumber
+	if replicaNumber < 1 {
+		replicaNumber = 1
+	}
+	if loaded := len(resp.GetReplicas()); int(replicaNumber) != loaded {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"replica number %d doesn't match the replica number %d of the loaded partitions of collection %s, "+
+				"release the collection first to load with a different replica number", replicaNumber, loaded, lpt.CollectionName)
+	}
 	return nil
 }
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	lpt.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.GetDbName(), lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -2179,13 +2683,13 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.GetDbName(), rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	rpt.collectionID = collID
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.GetDbName(), rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -2207,7 +2711,7 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 }
 
 func (rpt *releasePartitionsTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rpt.CollectionName)
+	globalMetaCache.ClearShards(rpt.GetDbName(), rpt.CollectionName)
 	return nil
 }
 
@@ -2218,6 +2722,8 @@ type deleteTask struct {
 	BaseDeleteTask
 	ctx        context.Context
 	deleteExpr string
+	// primaryKeys is the primary keys specified in the request directly, it's exclusive with deleteExpr
+	primaryKeys *schemapb.IDs
 	//req       *milvuspb.DeleteRequest
 	result    *milvuspb.MutationResult
 	chMgr     channelsMgr
@@ -2287,37 +2793,43 @@ func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (dt *deleteTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.GetDbName(), dt.CollectionName)
 	if err != nil {
 		return nil, err
 	}
 	return dt.chMgr.getChannels(collID)
 }
 
+// getPrimaryKeysFromExpr returns the primary keys of the delete expr, which must be of the form `pk in [...]`. The
+// expr is parsed by the same parser as the query expr, so the string literals are quoted and escaped the same way.
 func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res *schemapb.IDs, rowNum int64, err error) {
 	if len(expr) == 0 {
 		log.Warn("empty expr")
 		return
 	}
 
-	plan, err := createExprPlan(schema, expr)
+	plan, err := planparserv2.CreateRetrievePlan(schema, expr)
 	if err != nil {
-		return res, 0, fmt.Errorf("failed to create expr plan, expr = %s", expr)
+		return res, 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "failed to create expr plan, expr = %s: %v", expr, err)
 	}
 
 	// delete request only support expr "id in [a, b]"
-	termExpr, ok := plan.Node.(*planpb.PlanNode_Predicates).Predicates.Expr.(*planpb.Expr_TermExpr)
-	if !ok {
-		return res, 0, fmt.Errorf("invalid plan node type, only pk in [1, 2] supported")
+	termExpr, ok := plan.GetPredicates().GetExpr().(*planpb.Expr_TermExpr)
+	if !ok || !termExpr.TermExpr.GetColumnInfo().GetIsPrimaryKey() {
+		return res, 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "invalid delete expr %s, only pk in [1, 2] supported", expr)
 	}
 
 	res = &schemapb.IDs{}
 	rowNum = int64(len(termExpr.TermExpr.Values))
 	switch termExpr.TermExpr.ColumnInfo.GetDataType() {
 	case schemapb.DataType_Int64:
-		ids := make([]int64, 0)
+		ids := make([]int64, 0, rowNum)
 		for _, v := range termExpr.TermExpr.Values {
-			ids = append(ids, v.GetInt64Val())
+			id, ok := v.GetVal().(*planpb.GenericValue_Int64Val)
+			if !ok {
+				return nil, 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "value %v in delete expr is not of type %s", v, schemapb.DataType_Int64)
+			}
+			ids = append(ids, id.Int64Val)
 		}
 		res.IdField = &schemapb.IDs_IntId{
 			IntId: &schemapb.LongArray{
@@ -2325,9 +2837,13 @@ func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res
 			},
 		}
 	case schemapb.DataType_VarChar:
-		ids := make([]string, 0)
+		ids := make([]string, 0, rowNum)
 		for _, v := range termExpr.TermExpr.Values {
-			ids = append(ids, v.GetStringVal())
+			id, ok := v.GetVal().(*planpb.GenericValue_StringVal)
+			if !ok {
+				return nil, 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "value %v in delete expr is not of type %s", v, schemapb.DataType_VarChar)
+			}
+			ids = append(ids, id.StringVal)
 		}
 		res.IdField = &schemapb.IDs_StrId{
 			StrId: &schemapb.StringArray{
@@ -2341,6 +2857,54 @@ func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res
 	return res, rowNum, nil
 }
 
+// checkPrimaryKeys checks the type of primary keys matches the primary key field, and returns the number of keys.
+func checkPrimaryKeys(schema *schemapb.CollectionSchema, primaryKeys *schemapb.IDs) (int64, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return 0, err
+	}
+	var matched bool
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		matched = primaryKeys.GetIntId() != nil
+	case schemapb.DataType_VarChar:
+		matched = primaryKeys.GetStrId() != nil
+	}
+	if !matched {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"type of primary keys doesn't match the primary key field %s of %s", pkField.GetName(), pkField.GetDataType())
+	}
+	numRow := int64(typeutil.GetSizeOfIDs(primaryKeys))
+	if numRow == 0 {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "primary keys to delete are empty")
+	}
+	return numRow, nil
+}
+
+// getPrimaryKeys returns the primary keys to delete, which are specified directly or by the delete expr.
+func (dt *deleteTask) getPrimaryKeys(schema *schemapb.CollectionSchema) (*schemapb.IDs, int64, error) {
+	if dt.primaryKeys == nil {
+		if ids, ok := parsePkInExpr(schema, dt.deleteExpr); ok {
+			return ids, int64(typeutil.GetSizeOfIDs(ids)), nil
+		}
+		if err := validateExprLength(dt.deleteExpr); err != nil {
+			return nil, 0, err
+		}
+		if err := validateExprComplexity(dt.deleteExpr); err != nil {
+			return nil, 0, err
+		}
+		return getPrimaryKeysFromExpr(schema, dt.deleteExpr)
+	}
+	if dt.deleteExpr != "" {
+		return nil, 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument, "expr and primary keys can't be specified at the same time")
+	}
+	numRow, err := checkPrimaryKeys(schema, dt.primaryKeys)
+	if err != nil {
+		return nil, 0, err
+	}
+	return dt.primaryKeys, numRow, nil
+}
+
 func (dt *deleteTask) PreExecute(ctx context.Context) error {
 	dt.Base.MsgType = commonpb.MsgType_Delete
 	dt.Base.SourceID = Params.ProxyCfg.GetNodeID()
@@ -2360,11 +2924,17 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Error("Invalid collection name", zap.String("collectionName", collName))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.GetDbName(), collName)
 	if err != nil {
 		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
 		return err
 	}
+	if err := checkCollectionEnabled(ctx, dt.GetDbName(), collName); err != nil {
+		return err
+	}
+	if err := checkCollectionWritable(ctx, dt.GetDbName(), collName); err != nil {
+		return err
+	}
 	dt.DeleteRequest.CollectionID = collID
 	dt.collectionID = collID
 
@@ -2375,7 +2945,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Error("Invalid partition name", zap.String("partitionName", partName))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.GetDbName(), collName, partName)
 		if err != nil {
 			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
 			return err
@@ -2385,17 +2955,17 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.DeleteRequest.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.GetDbName(), collName)
 	if err != nil {
 		log.Error("Failed to get collection schema", zap.String("collectionName", collName))
 		return err
 	}
 	dt.schema = schema
 
-	// get delete.primaryKeys from delete expr
-	primaryKeys, numRow, err := getPrimaryKeysFromExpr(schema, dt.deleteExpr)
+	// get delete.primaryKeys from the request or delete expr
+	primaryKeys, numRow, err := dt.getPrimaryKeys(schema)
 	if err != nil {
-		log.Error("Failed to get primary keys from expr", zap.Error(err))
+		log.Error("Failed to get primary keys", zap.Error(err))
 		return err
 	}
 
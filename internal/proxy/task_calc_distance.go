@@ -132,6 +132,20 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		}, nil
 	}
 
+	if err := checkCalcDistanceSize(request); err != nil {
+		log.Debug("CalcDistance exceeds the limits",
+			zap.Error(err),
+			zap.String("traceID", t.traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
 	// the vectors retrieved are random order, we need re-arrange the vectors by the order of input ids
 	arrangeFunc := func(ids *milvuspb.VectorIDs, retrievedFields []*schemapb.FieldData) (*schemapb.VectorField, error) {
 		var retrievedIds *schemapb.ScalarField
@@ -301,14 +315,14 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 	}
 
 	if vectorsLeft.GetDim() != vectorsRight.GetDim() {
-		msg := "Vectors dimension is not equal"
+		msg := fmt.Sprintf("Vectors dimension is not equal, left dim: %d, right dim: %d", vectorsLeft.GetDim(), vectorsRight.GetDim())
 		log.Debug(msg,
 			zap.String("traceID", t.traceID),
 			zap.String("role", typeutil.ProxyRole))
 
 		return &milvuspb.CalcDistanceResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
 				Reason:    msg,
 			},
 		}, nil
@@ -432,3 +446,62 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		},
 	}, nil
 }
+
+// vectorNumOf returns the number of vectors of an operand of CalcDistance, the vectors given by ids are counted
+// before they're fetched.
+func vectorNumOf(op *milvuspb.VectorsArray) (int64, error) {
+	if op.GetIdArray() != nil {
+		ids := op.GetIdArray().GetIdArray()
+		return int64(len(ids.GetIntId().GetData()) + len(ids.GetStrId().GetData())), nil
+	}
+	vectors := op.GetDataArray()
+	dim := vectors.GetDim()
+	switch {
+	case vectors.GetFloatVector() != nil:
+		n := int64(len(vectors.GetFloatVector().GetData()))
+		if n == 0 {
+			return 0, nil
+		}
+		if dim <= 0 || n%dim != 0 {
+			return 0, fmt.Errorf("invalid float vectors, dim: %d, number of floats: %d", dim, n)
+		}
+		return n / dim, nil
+	case vectors.GetBinaryVector() != nil:
+		n := int64(len(vectors.GetBinaryVector()))
+		if n == 0 {
+			return 0, nil
+		}
+		if dim <= 0 || n%(distance.SingleBitLen(dim)/8) != 0 {
+			return 0, fmt.Errorf("invalid binary vectors, dim: %d, number of bytes: %d", dim, n)
+		}
+		return n / (distance.SingleBitLen(dim) / 8), nil
+	}
+	return 0, nil
+}
+
+// checkCalcDistanceSize rejects the request if it computes too many distances, the result is a matrix of the
+// left vectors multiplied by the right ones.
+func checkCalcDistanceSize(request *milvuspb.CalcDistanceRequest) error {
+	leftNum, err := vectorNumOf(request.GetOpLeft())
+	if err != nil {
+		return fmt.Errorf("left vectors: %w", err)
+	}
+	rightNum, err := vectorNumOf(request.GetOpRight())
+	if err != nil {
+		return fmt.Errorf("right vectors: %w", err)
+	}
+
+	maxVectorNum := Params.ProxyCfg.CalcDistanceMaxVectorNum
+	if maxVectorNum > 0 && leftNum > maxVectorNum {
+		return fmt.Errorf("number of left vectors %d exceeds the limit %d", leftNum, maxVectorNum)
+	}
+	if maxVectorNum > 0 && rightNum > maxVectorNum {
+		return fmt.Errorf("number of right vectors %d exceeds the limit %d", rightNum, maxVectorNum)
+	}
+	maxPairNum := Params.ProxyCfg.CalcDistanceMaxPairNum
+	if maxPairNum > 0 && leftNum*rightNum > maxPairNum {
+		return fmt.Errorf("number of distances %d (%d left vectors * %d right vectors) exceeds the limit %d",
+			leftNum*rightNum, leftNum, rightNum, maxPairNum)
+	}
+	return nil
+}
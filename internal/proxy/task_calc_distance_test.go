@@ -301,7 +301,8 @@ func TestCalcDistanceTask_ExecuteFloat(t *testing.T) {
 	// different dimension
 	calcResult, err = task.Execute(ctx, request)
 	assert.Nil(t, err)
-	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, calcResult.Status.ErrorCode)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, calcResult.Status.ErrorCode)
+	assert.Contains(t, calcResult.Status.Reason, "left dim: 8, right dim: 5")
 
 	request.OpRight = &milvuspb.VectorsArray{
 		Array: &milvuspb.VectorsArray_DataArray{
@@ -488,3 +489,145 @@ func TestCalcDistanceTask_ExecuteBinary(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, calcResult.Status.ErrorCode)
 }
+
+func TestCalcDistanceTask_ExecuteLimits(t *testing.T) {
+	defer func(maxVectorNum, maxPairNum int64) {
+		Params.ProxyCfg.CalcDistanceMaxVectorNum = maxVectorNum
+		Params.ProxyCfg.CalcDistanceMaxPairNum = maxPairNum
+	}(Params.ProxyCfg.CalcDistanceMaxVectorNum, Params.ProxyCfg.CalcDistanceMaxPairNum)
+
+	ctx := context.Background()
+	task := &calcDistanceTask{
+		traceID: "dummy",
+		queryFunc: func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+			t.Error("vectors shouldn't be fetched if the request exceeds the limits")
+			return nil, errors.New("unexpected")
+		},
+	}
+
+	dim := 4
+	floatArray := func(num int) *milvuspb.VectorsArray {
+		return &milvuspb.VectorsArray{
+			Array: &milvuspb.VectorsArray_DataArray{
+				DataArray: &schemapb.VectorField{
+					Dim: int64(dim),
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: make([]float32, num*dim),
+						},
+					},
+				},
+			},
+		}
+	}
+	idArray := func(num int) *milvuspb.VectorsArray {
+		return &milvuspb.VectorsArray{
+			Array: &milvuspb.VectorsArray_IdArray{
+				IdArray: &milvuspb.VectorIDs{
+					FieldName: "vec",
+					IdArray: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{
+							IntId: &schemapb.LongArray{
+								Data: make([]int64, num),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newRequest := func(left, right *milvuspb.VectorsArray) *milvuspb.CalcDistanceRequest {
+		return &milvuspb.CalcDistanceRequest{
+			OpLeft:  left,
+			OpRight: right,
+			Params: []*commonpb.KeyValuePair{
+				{Key: "metric", Value: "L2"},
+			},
+		}
+	}
+
+	Params.ProxyCfg.CalcDistanceMaxVectorNum = 10
+	Params.ProxyCfg.CalcDistanceMaxPairNum = 50
+
+	calcResult, err := task.Execute(ctx, newRequest(floatArray(5), floatArray(10)))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+	assert.Len(t, calcResult.GetFloatDist().GetData(), 50)
+
+	// too many left vectors, the ids are counted before fetching the vectors
+	calcResult, err = task.Execute(ctx, newRequest(idArray(11), floatArray(1)))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, calcResult.Status.ErrorCode)
+	assert.Contains(t, calcResult.Status.Reason, "number of left vectors 11 exceeds the limit 10")
+
+	// too many right vectors
+	calcResult, err = task.Execute(ctx, newRequest(floatArray(1), floatArray(11)))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, calcResult.Status.ErrorCode)
+	assert.Contains(t, calcResult.Status.Reason, "number of right vectors 11 exceeds the limit 10")
+
+	// too many pairs
+	calcResult, err = task.Execute(ctx, newRequest(floatArray(6), idArray(10)))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, calcResult.Status.ErrorCode)
+	assert.Contains(t, calcResult.Status.Reason, "number of distances 60 (6 left vectors * 10 right vectors) exceeds the limit 50")
+
+	// vectors not aligned with the dimension
+	invalid := floatArray(1)
+	invalid.GetDataArray().Dim = 3
+	calcResult, err = task.Execute(ctx, newRequest(floatArray(1), invalid))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, calcResult.Status.ErrorCode)
+	assert.Contains(t, calcResult.Status.Reason, "invalid float vectors, dim: 3")
+
+	// no limit
+	Params.ProxyCfg.CalcDistanceMaxVectorNum = 0
+	Params.ProxyCfg.CalcDistanceMaxPairNum = 0
+	calcResult, err = task.Execute(ctx, newRequest(floatArray(20), floatArray(20)))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+}
+
+func Test_vectorNumOf(t *testing.T) {
+	num, err := vectorNumOf(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), num)
+
+	num, err = vectorNumOf(&milvuspb.VectorsArray{
+		Array: &milvuspb.VectorsArray_DataArray{
+			DataArray: &schemapb.VectorField{
+				Dim: 16,
+				Data: &schemapb.VectorField_BinaryVector{
+					BinaryVector: make([]byte, 6),
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), num)
+
+	_, err = vectorNumOf(&milvuspb.VectorsArray{
+		Array: &milvuspb.VectorsArray_DataArray{
+			DataArray: &schemapb.VectorField{
+				Dim: 16,
+				Data: &schemapb.VectorField_BinaryVector{
+					BinaryVector: make([]byte, 5),
+				},
+			},
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = vectorNumOf(&milvuspb.VectorsArray{
+		Array: &milvuspb.VectorsArray_DataArray{
+			DataArray: &schemapb.VectorField{
+				Data: &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{
+						Data: make([]float32, 4),
+					},
+				},
+			},
+		},
+	})
+	assert.Error(t, err)
+}
@@ -18,21 +18,35 @@ package proxy
 
 import (
 	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetIndexStateTask_Execute(t *testing.T) {
 	dbName := funcutil.GenRandomStr()
 	collectionName := funcutil.GenRandomStr()
 	collectionID := UniqueID(1)
-	fieldName := funcutil.GenRandomStr()
+	fieldName := "FloatVectorField"
 	indexName := ""
 	ctx := context.Background()
 
@@ -74,7 +88,26 @@ func TestGetIndexStateTask_Execute(t *testing.T) {
 		}, nil
 	}
 
+	// no index on the field.
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist},
+		}, nil
+	}
+	err := gist.Execute(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IndexNotExist, errorCodeOf(err))
+
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexInfos: []*indexpb.IndexInfo{
+				{CollectionID: collectionID, FieldID: 100 + int64(schemapb.DataType_FloatVector), IndexName: "idx", IndexID: 10},
+			},
+		}, nil
+	}
 	indexCoord.GetIndexStateFunc = func(ctx context.Context, request *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+		assert.Equal(t, "idx", request.GetIndexName())
 		return &indexpb.GetIndexStateResponse{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_Success,
@@ -86,4 +119,552 @@ func TestGetIndexStateTask_Execute(t *testing.T) {
 
 	assert.NoError(t, gist.Execute(ctx))
 	assert.Equal(t, commonpb.IndexState_Finished, gist.result.GetState())
+	assert.Equal(t, "idx", gist.IndexName)
+}
+
+func Test_resolveIndexNameFrom(t *testing.T) {
+	indexInfos := map[string]*indexInfo{
+		"idx1": {indexID: 1, fieldID: 100, fieldName: "vec"},
+		"idx2": {indexID: 2, fieldID: 100, fieldName: "vec"},
+		"idx3": {indexID: 3, fieldID: 101, fieldName: "age"},
+	}
+
+	cases := []struct {
+		name      string
+		infos     map[string]*indexInfo
+		fieldName string
+		indexName string
+		expected  string
+		code      commonpb.ErrorCode
+	}{
+		{"by index name", indexInfos, "", "idx1", "idx1", commonpb.ErrorCode_Success},
+		{"by index name and field name", indexInfos, "age", "idx3", "idx3", commonpb.ErrorCode_Success},
+		{"index not found", indexInfos, "", "idx4", "", commonpb.ErrorCode_IndexNotExist},
+		{"index on other field", indexInfos, "age", "idx1", "", commonpb.ErrorCode_IllegalArgument},
+		{"only index on field", indexInfos, "age", "", "idx3", commonpb.ErrorCode_Success},
+		{"multiple indexes on field", indexInfos, "vec", "", "", commonpb.ErrorCode_IllegalArgument},
+		{"no index on field", indexInfos, "name", "", "", commonpb.ErrorCode_IndexNotExist},
+		{"multiple indexes in collection", indexInfos, "", "", "", commonpb.ErrorCode_IllegalArgument},
+		{"only index in collection", map[string]*indexInfo{"idx3": indexInfos["idx3"]}, "", "", "idx3", commonpb.ErrorCode_Success},
+		{"no index in collection", map[string]*indexInfo{}, "", "", "", commonpb.ErrorCode_IndexNotExist},
+	}
+	for _, c := range cases {
+		name, err := resolveIndexNameFrom(c.infos, "coll", c.fieldName, c.indexName)
+		if c.code == commonpb.ErrorCode_Success {
+			assert.NoError(t, err, c.name)
+			assert.Equal(t, c.expected, name, c.name)
+		} else {
+			assert.Error(t, err, c.name)
+			assert.Equal(t, c.code, errorCodeOf(err), c.name)
+		}
+	}
+}
+
+func TestDescribeIndexTask_Execute(t *testing.T) {
+	ctx := context.Background()
+	collectionID := UniqueID(1)
+	schema := newTestSchema()
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return schema, nil
+	})
+	globalMetaCache = mockCache
+
+	indexCoord := newMockIndexCoord()
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexInfos: []*indexpb.IndexInfo{
+				{CollectionID: collectionID, FieldID: 0, IndexName: "finished"},
+				{CollectionID: collectionID, FieldID: 0, IndexName: "failed"},
+				{CollectionID: collectionID, FieldID: 0, IndexName: "inProgress"},
+			},
+		}, nil
+	}
+	indexCoord.GetIndexStateFunc = func(ctx context.Context, request *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+		resp := &indexpb.GetIndexStateResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			State:  commonpb.IndexState_Finished,
+		}
+		switch request.GetIndexName() {
+		case "failed":
+			resp.State = commonpb.IndexState_Failed
+			resp.FailReason = "mock failure"
+		case "inProgress":
+			resp.State = commonpb.IndexState_InProgress
+		}
+		return resp, nil
+	}
+	indexCoord.GetIndexBuildProgressFunc = func(ctx context.Context, request *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+		resp := &indexpb.GetIndexBuildProgressResponse{
+			Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexedRows: 100,
+			TotalRows:   100,
+		}
+		if request.GetIndexName() != "finished" {
+			resp.IndexedRows = 10
+		}
+		return resp, nil
+	}
+
+	newTask := func(skipIndexProgress bool) *describeIndexTask {
+		return &describeIndexTask{
+			DescribeIndexRequest: &milvuspb.DescribeIndexRequest{
+				Base:              &commonpb.MsgBase{},
+				CollectionName:    "test",
+				SkipIndexProgress: skipIndexProgress,
+			},
+			ctx:          ctx,
+			indexCoord:   indexCoord,
+			collectionID: collectionID,
+		}
+	}
+
+	t.Run("with progress", func(t *testing.T) {
+		dit := newTask(false)
+		assert.NoError(t, dit.Execute(ctx))
+		descriptions := dit.result.GetIndexDescriptions()
+		assert.Equal(t, 3, len(descriptions))
+		for _, description := range descriptions {
+			assert.Equal(t, int64(100), description.GetTotalRows())
+			switch description.GetIndexName() {
+			case "finished":
+				assert.Equal(t, commonpb.IndexState_Finished, description.GetState())
+				assert.Equal(t, int64(100), description.GetIndexedRows())
+			case "failed":
+				assert.Equal(t, commonpb.IndexState_Failed, description.GetState())
+				assert.Equal(t, "mock failure", description.GetIndexStateFailReason())
+				assert.Equal(t, int64(10), description.GetIndexedRows())
+			case "inProgress":
+				assert.Equal(t, commonpb.IndexState_InProgress, description.GetState())
+				assert.Equal(t, int64(10), description.GetIndexedRows())
+			}
+		}
+	})
+
+	t.Run("skip progress", func(t *testing.T) {
+		stateFunc := indexCoord.GetIndexStateFunc
+		defer func() { indexCoord.GetIndexStateFunc = stateFunc }()
+		indexCoord.GetIndexStateFunc = nil
+
+		dit := newTask(true)
+		assert.NoError(t, dit.Execute(ctx))
+		assert.Equal(t, 3, len(dit.result.GetIndexDescriptions()))
+		for _, description := range dit.result.GetIndexDescriptions() {
+			assert.Equal(t, commonpb.IndexState_IndexStateNone, description.GetState())
+		}
+	})
+
+	t.Run("failed to get progress", func(t *testing.T) {
+		progressFunc := indexCoord.GetIndexBuildProgressFunc
+		defer func() { indexCoord.GetIndexBuildProgressFunc = progressFunc }()
+		indexCoord.GetIndexBuildProgressFunc = func(ctx context.Context, request *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+			if request.GetIndexName() == "failed" {
+				return &indexpb.GetIndexBuildProgressResponse{
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+				}, nil
+			}
+			return progressFunc(ctx, request)
+		}
+
+		// the index whose progress fails to be got is still described, with unknown progress
+		dit := newTask(false)
+		assert.NoError(t, dit.Execute(ctx))
+		descriptions := dit.result.GetIndexDescriptions()
+		assert.Equal(t, 3, len(descriptions))
+		for _, description := range descriptions {
+			switch description.GetIndexName() {
+			case "finished":
+				assert.Equal(t, commonpb.IndexState_Finished, description.GetState())
+				assert.Equal(t, int64(100), description.GetIndexedRows())
+			case "failed":
+				assert.Equal(t, commonpb.IndexState_IndexStateNone, description.GetState())
+				assert.Empty(t, description.GetIndexStateFailReason())
+				assert.Equal(t, int64(0), description.GetTotalRows())
+			case "inProgress":
+				assert.Equal(t, commonpb.IndexState_InProgress, description.GetState())
+			}
+		}
+	})
+}
+
+func TestDescribeIndexTask_params(t *testing.T) {
+	ctx := context.Background()
+	collectionID := UniqueID(1)
+	fieldName := "vec"
+	typeParams := []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: fieldName, DataType: schemapb.DataType_FloatVector, TypeParams: typeParams},
+		},
+	}
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return collectionID, nil
+	})
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return schema, nil
+	})
+	globalMetaCache = mockCache
+
+	// the index coord stores the index as it's created
+	var created *indexpb.CreateIndexRequest
+	indexCoord := newMockIndexCoord()
+	indexCoord.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+		created = req
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	indexCoord.DescribeIndexFunc = func(ctx context.Context, request *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexInfos: []*indexpb.IndexInfo{{
+				CollectionID: created.GetCollectionID(),
+				FieldID:      created.GetFieldID(),
+				IndexName:    created.GetIndexName(),
+				TypeParams:   created.GetTypeParams(),
+				IndexParams:  created.GetIndexParams(),
+			}},
+		}, nil
+	}
+
+	createAndDescribe := func(t *testing.T, params []*commonpb.KeyValuePair) *milvuspb.IndexDescription {
+		cit := &createIndexTask{
+			CreateIndexRequest: &milvuspb.CreateIndexRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "test",
+				FieldName:      fieldName,
+				ExtraParams:    params,
+			},
+			ctx:        ctx,
+			indexCoord: indexCoord,
+		}
+		require.NoError(t, cit.PreExecute(ctx))
+		require.NoError(t, cit.Execute(ctx))
+
+		dit := &describeIndexTask{
+			DescribeIndexRequest: &milvuspb.DescribeIndexRequest{
+				Base:              &commonpb.MsgBase{},
+				CollectionName:    "test",
+				SkipIndexProgress: true,
+			},
+			ctx:          ctx,
+			indexCoord:   indexCoord,
+			collectionID: collectionID,
+		}
+		require.NoError(t, dit.Execute(ctx))
+		require.Equal(t, 1, len(dit.result.GetIndexDescriptions()))
+		return dit.result.GetIndexDescriptions()[0]
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		params := []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "HNSW"},
+			{Key: "metric_type", Value: "IP"},
+			{Key: "params", Value: `{"M": 16, "efConstruction": 200}`},
+		}
+		description := createAndDescribe(t, params)
+		assert.Equal(t, fieldName, description.GetFieldName())
+		assert.Equal(t, Params.CommonCfg.DefaultIndexName, description.GetIndexName())
+		// the index params are described as they're created, with the dimension of the field
+		assert.Equal(t, append(params, typeParams...), description.GetParams())
+	})
+
+	t.Run("default index type", func(t *testing.T) {
+		params := []*commonpb.KeyValuePair{
+			{Key: "metric_type", Value: "L2"},
+			{Key: "nlist", Value: "1024"},
+			{Key: "m", Value: "8"},
+		}
+		description := createAndDescribe(t, params)
+		indexType, err := funcutil.GetAttrByKeyFromRepeatedKV("index_type", description.GetParams())
+		assert.NoError(t, err)
+		assert.Equal(t, indexparamcheck.IndexFaissIvfPQ, indexType)
+		nlist, err := funcutil.GetAttrByKeyFromRepeatedKV("nlist", description.GetParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "1024", nlist)
+	})
+
+	t.Run("dimension in index params", func(t *testing.T) {
+		description := describedIndexParams(&indexpb.IndexInfo{
+			TypeParams:  typeParams,
+			IndexParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}, {Key: "index_type", Value: "FLAT"}},
+		})
+		assert.Equal(t, 2, len(description))
+	})
+}
+
+func TestDropIndexTask_PreExecute(t *testing.T) {
+	ctx := context.Background()
+	collectionName := "coll"
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 1, nil
+	})
+	globalMetaCache = mockCache
+
+	newTask := func(fieldName, indexName string) *dropIndexTask {
+		return &dropIndexTask{
+			ctx: ctx,
+			DropIndexRequest: &milvuspb.DropIndexRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+				FieldName:      fieldName,
+				IndexName:      indexName,
+			},
+			indexCoord: newMockIndexCoord(),
+		}
+	}
+
+	t.Run("single index", func(t *testing.T) {
+		mockCache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return map[string]*indexInfo{
+				"idx": {indexID: 1, fieldID: 100, fieldName: "vec"},
+			}, nil
+		})
+
+		// index name is optional
+		dit := newTask("vec", "")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx", dit.IndexName)
+		assert.Equal(t, UniqueID(1), dit.collectionID)
+
+		dit = newTask("vec", "idx")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx", dit.IndexName)
+
+		dit = newTask("", "idx")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx", dit.IndexName)
+
+		dit = newTask("vec", "idx2")
+		err := dit.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IndexNotExist, errorCodeOf(err))
+	})
+
+	t.Run("multiple indexes", func(t *testing.T) {
+		mockCache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return map[string]*indexInfo{
+				"idx1": {indexID: 1, fieldID: 100, fieldName: "vec"},
+				"idx2": {indexID: 2, fieldID: 100, fieldName: "vec"},
+				"idx3": {indexID: 3, fieldID: 101, fieldName: "age"},
+			}, nil
+		})
+
+		// index name is required
+		dit := newTask("vec", "")
+		err := dit.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "[idx1, idx2] found on field vec")
+
+		dit = newTask("vec", "idx2")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx2", dit.IndexName)
+
+		dit = newTask("", "idx2")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx2", dit.IndexName)
+
+		// the index is on another field
+		dit = newTask("age", "idx2")
+		err = dit.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// the only index on the field
+		dit = newTask("age", "")
+		assert.NoError(t, dit.PreExecute(ctx))
+		assert.Equal(t, "idx3", dit.IndexName)
+	})
+}
+
+func TestCreateIndexTask_BlockWrites(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	InitMetaCache(ctx, rc, qc, mgr)
+	collectionName := "TestCreateIndexTask_BlockWrites" + funcutil.GenRandomStr()
+
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	require.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	require.NoError(t, err)
+
+	defer func(timeout, interval time.Duration) {
+		Params.ProxyCfg.IndexBuildWriteBlockTimeout = timeout
+		Params.ProxyCfg.IndexBuildWriteBlockCheckInterval = interval
+	}(Params.ProxyCfg.IndexBuildWriteBlockTimeout, Params.ProxyCfg.IndexBuildWriteBlockCheckInterval)
+	Params.ProxyCfg.IndexBuildWriteBlockTimeout = time.Minute
+	Params.ProxyCfg.IndexBuildWriteBlockCheckInterval = 10 * time.Millisecond
+
+	// the index is in progress until it's set finished
+	var indexState int32
+	var createStatus atomic.Value
+	indexCoord := newMockIndexCoord()
+	indexCoord.CreateIndexFunc = func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+		return createStatus.Load().(*commonpb.Status), nil
+	}
+	indexCoord.GetIndexStateFunc = func(ctx context.Context, request *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+		return &indexpb.GetIndexStateResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			State:  commonpb.IndexState(atomic.LoadInt32(&indexState)),
+		}, nil
+	}
+
+	nodeCtx, stopNode := context.WithCancel(ctx)
+	defer stopNode()
+	var nodeWg sync.WaitGroup
+	newTask := func(blockWrites bool) *createIndexTask {
+		return &createIndexTask{
+			Condition: NewTaskCondition(ctx),
+			CreateIndexRequest: &milvuspb.CreateIndexRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+				FieldName:      "fvec",
+				IndexName:      "fvec",
+				ExtraParams:    []*commonpb.KeyValuePair{{Key: "index_type", Value: "FLAT"}, {Key: "metric_type", Value: "L2"}},
+				BlockWrites:    blockWrites,
+			},
+			ctx:          ctx,
+			rootCoord:    rc,
+			indexCoord:   indexCoord,
+			collectionID: collectionID,
+			fieldSchema:  schema.GetFields()[1],
+			nodeCtx:      nodeCtx,
+			nodeWg:       &nodeWg,
+		}
+	}
+	deleteErrorCode := func() commonpb.ErrorCode {
+		dt := &deleteTask{
+			Condition: NewTaskCondition(ctx),
+			BaseDeleteTask: msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base:           &commonpb.MsgBase{},
+					CollectionName: collectionName,
+				},
+			},
+			deleteExpr: "int64 in [1]",
+			ctx:        ctx,
+			chMgr:      newMockChannelsMgr(),
+		}
+		return errorCodeOf(dt.PreExecute(ctx))
+	}
+	assertBlocked := func(t *testing.T) {
+		err := checkCollectionWritable(ctx, "", collectionName)
+		assert.Equal(t, commonpb.ErrorCode_CollectionWriteBlocked, errorCodeOf(err))
+		assert.Equal(t, commonpb.ErrorCode_CollectionWriteBlocked, deleteErrorCode())
+	}
+	assertUnblockedEventually := func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			return checkCollectionWritable(ctx, "", collectionName) == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.NotEqual(t, commonpb.ErrorCode_CollectionWriteBlocked, deleteErrorCode())
+	}
+
+	t.Run("writes not blocked by default", func(t *testing.T) {
+		createStatus.Store(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_InProgress))
+		assert.NoError(t, newTask(false).Execute(ctx))
+		assert.NoError(t, checkCollectionWritable(ctx, "", collectionName))
+	})
+
+	t.Run("writes blocked until index built", func(t *testing.T) {
+		createStatus.Store(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_InProgress))
+		assert.NoError(t, newTask(true).Execute(ctx))
+		assertBlocked(t)
+
+		// still blocked while the index is in progress
+		time.Sleep(5 * Params.ProxyCfg.IndexBuildWriteBlockCheckInterval)
+		assertBlocked(t)
+
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_Finished))
+		assertUnblockedEventually(t)
+		nodeWg.Wait()
+	})
+
+	t.Run("writes unblocked on timeout", func(t *testing.T) {
+		Params.ProxyCfg.IndexBuildWriteBlockTimeout = 300 * time.Millisecond
+		defer func() { Params.ProxyCfg.IndexBuildWriteBlockTimeout = time.Minute }()
+		createStatus.Store(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_InProgress))
+		assert.NoError(t, newTask(true).Execute(ctx))
+		assertBlocked(t)
+		assertUnblockedEventually(t)
+		nodeWg.Wait()
+	})
+
+	t.Run("writes unblocked if creating fails", func(t *testing.T) {
+		createStatus.Store(&commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"})
+		assert.Error(t, newTask(true).Execute(ctx))
+		assert.NoError(t, checkCollectionWritable(ctx, "", collectionName))
+	})
+
+	t.Run("writes blocked until timeout once proxy stops", func(t *testing.T) {
+		createStatus.Store(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success})
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_InProgress))
+		task := newTask(true)
+		assert.NoError(t, task.Execute(ctx))
+		assertBlocked(t)
+
+		stopNode()
+		nodeWg.Wait()
+		atomic.StoreInt32(&indexState, int32(commonpb.IndexState_Finished))
+		time.Sleep(5 * Params.ProxyCfg.IndexBuildWriteBlockCheckInterval)
+		assertBlocked(t)
+
+		require.NoError(t, task.setWriteBlockedUntil(ctx, time.Time{}))
+	})
+
+	t.Run("invalid write block property", func(t *testing.T) {
+		act := &alterCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			AlterCollectionRequest: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+				Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionWriteBlockedUntilKey, Value: "tomorrow"}},
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.Error(t, act.PreExecute(ctx))
+	})
+}
+
+func Test_collectionWriteBlockedUntil(t *testing.T) {
+	assert.True(t, collectionWriteBlockedUntil(nil).IsZero())
+	assert.True(t, collectionWriteBlockedUntil([]*commonpb.KeyValuePair{{Key: common.CollectionWriteBlockedUntilKey, Value: "0"}}).IsZero())
+	assert.True(t, collectionWriteBlockedUntil([]*commonpb.KeyValuePair{{Key: common.CollectionWriteBlockedUntilKey, Value: "x"}}).IsZero())
+	deadline := time.UnixMilli(time.Now().Add(time.Hour).UnixMilli())
+	properties := []*commonpb.KeyValuePair{
+		{Key: common.CollectionDisabledKey, Value: "false"},
+		{Key: common.CollectionWriteBlockedUntilKey, Value: strconv.FormatInt(deadline.UnixMilli(), 10)},
+	}
+	assert.Equal(t, deadline, collectionWriteBlockedUntil(properties))
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -13,7 +12,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
-	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -27,7 +25,7 @@ type insertTask struct {
 	ctx context.Context
 
 	result        *milvuspb.MutationResult
-	idAllocator   *allocator.IDAllocator
+	idAllocator   *cachedIDAllocator
 	segIDAssigner *segIDAssigner
 	chMgr         channelsMgr
 	chTicker      channelsTimeTicker
@@ -91,7 +89,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.GetDbName(), it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +161,48 @@ func (it *insertTask) checkPrimaryFieldData() error {
 	return nil
 }
 
+// resolveCollection gets the schema of the collection and checks the collection accepts the insert.
+func (it *insertTask) resolveCollection(ctx context.Context) error {
+	collectionName := it.CollectionName
+	if err := checkCollectionEnabled(ctx, it.GetDbName(), collectionName); err != nil {
+		return err
+	}
+	if err := checkCollectionWritable(ctx, it.GetDbName(), collectionName); err != nil {
+		return err
+	}
+
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+	it.schema = collSchema
+
+	// check the partition exists before preparing the data, rows without partition go to the default one
+	partitionTag := it.PartitionName
+	if len(partitionTag) == 0 {
+		partitionTag = Params.CommonCfg.DefaultPartitionName
+	}
+	if _, err := globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, partitionTag); err != nil {
+		log.Warn("get partition id from global meta cache failed", zap.String("collection name", collectionName),
+			zap.String("partition name", partitionTag), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// checkCollectionDropped returns a CollectionNotExists error if rootcoord reports the collection dropped. The cached
+// meta of a collection being dropped may not be invalidated yet, so it's evicted then. The other errors, e.g. the
+// ones of the rpcs to rootcoord, are returned as is and keep the cache.
+func checkCollectionDropped(ctx context.Context, database, collectionName string, err error) error {
+	if errorCodeOf(err) != commonpb.ErrorCode_CollectionNotExists {
+		return err
+	}
+	log.Warn("collection is dropped", zap.String("collection name", collectionName), zap.Error(err))
+	globalMetaCache.RemoveCollection(ctx, database, collectionName)
+	return errCollectionNotExists(collectionName)
+}
+
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-PreExecute")
 	defer sp.Finish()
@@ -189,19 +229,20 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
-	if err != nil {
-		log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
-		return err
+	if err := it.resolveCollection(ctx); err != nil {
+		return checkCollectionDropped(ctx, it.GetDbName(), collectionName, err)
 	}
-	it.schema = collSchema
 
 	rowNums := uint32(it.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
 	var rowIDEnd UniqueID
 	tr := timerecord.NewTimeRecorder("applyPK")
-	rowIDBegin, rowIDEnd, _ = it.idAllocator.Alloc(rowNums)
+	rowIDBegin, rowIDEnd, err := it.idAllocator.Alloc(ctx, rowNums)
+	if err != nil {
+		log.Warn("failed to allocate row ids", zap.String("collection name", collectionName), zap.Uint32("rows", rowNums), zap.Error(err))
+		return err
+	}
 	metrics.ProxyApplyPrimaryKeyLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan()))
 
 	it.RowIDs = make([]UniqueID, rowNums)
@@ -217,11 +258,7 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 
 	// set result.SuccIndex
-	sliceIndex := make([]uint32, rowNums)
-	for i := uint32(0); i < rowNums; i++ {
-		sliceIndex[i] = i
-	}
-	it.result.SuccIndex = sliceIndex
+	it.result.SuccIndex = insertRowIndexes(rowNums)
 
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
@@ -232,7 +269,7 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 
 	// set field ID to insert field data
-	err = fillFieldIDBySchema(it.GetFieldsData(), collSchema)
+	err = fillFieldIDBySchema(it.GetFieldsData(), it.schema)
 	if err != nil {
 		log.Error("set fieldID to fieldData failed", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
 		return err
@@ -291,10 +328,7 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 	// lazy fetch, get first batch after first getMsgID called
 	getMsgID := func() (int64, error) {
 		if idBegin == idEnd {
-			err = retry.Do(it.ctx, func() error {
-				idBegin, idEnd, err = it.idAllocator.Alloc(16)
-				return err
-			})
+			idBegin, idEnd, err = it.idAllocator.Alloc(it.ctx, 16)
 			if err != nil {
 				log.Error("failed to allocate msg id", zap.Int64("base.MsgID", it.Base.MsgID), zap.Error(err))
 				return 0, err
@@ -322,8 +356,6 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			ShardName:      channelName,
 			Version:        internalpb.InsertDataVersion_ColumnBased,
 		}
-		insertReq.FieldsData = make([]*schemapb.FieldData, len(it.GetFieldsData()))
-
 		insertMsg := &msgstream.InsertMsg{
 			BaseMsg: msgstream.BaseMsg{
 				Ctx: it.TraceCtx(),
@@ -334,16 +366,36 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 		return insertMsg
 	}
 
-	// repack the row data corresponding to the offset to insertMsg
-	getInsertMsgsBySegmentID := func(segmentID UniqueID, rowOffsets []int, channelName string, maxMessageSize int) ([]msgstream.TsMsg, error) {
-		repackedMsgs := make([]msgstream.TsMsg, 0)
-		requestSize := 0
+	// repack the row data corresponding to the offsets to insertMsg
+	createInsertMsgByRowOffsets := func(segmentID UniqueID, rowOffsets []int, channelName string) (*msgstream.InsertMsg, error) {
 		msgID, err := getMsgID()
 		if err != nil {
 			return nil, err
 		}
 		insertMsg := createInsertMsg(segmentID, channelName, msgID)
+		insertMsg.FieldsData = typeutil.GatherFieldData(it.GetFieldsData(), rowOffsets)
+		if len(rowOffsets) == 0 {
+			return insertMsg, nil
+		}
+		insertMsg.HashValues = make([]uint32, 0, len(rowOffsets))
+		insertMsg.Timestamps = make([]uint64, 0, len(rowOffsets))
+		insertMsg.RowIDs = make([]int64, 0, len(rowOffsets))
 		for _, offset := range rowOffsets {
+			insertMsg.HashValues = append(insertMsg.HashValues, it.HashValues[offset])
+			insertMsg.Timestamps = append(insertMsg.Timestamps, it.Timestamps[offset])
+			insertMsg.RowIDs = append(insertMsg.RowIDs, it.RowIDs[offset])
+		}
+		insertMsg.NumRows = uint64(len(rowOffsets))
+		return insertMsg, nil
+	}
+
+	// split the rows of a segment into insertMsgs by the size threshold, the columns of each insertMsg
+	// are built in a single pass after its rows are decided.
+	getInsertMsgsBySegmentID := func(segmentID UniqueID, rowOffsets []int, channelName string, maxMessageSize int) ([]msgstream.TsMsg, error) {
+		repackedMsgs := make([]msgstream.TsMsg, 0)
+		requestSize := 0
+		start := 0
+		for i, offset := range rowOffsets {
 			curRowMessageSize, err := typeutil.EstimateEntitySize(it.InsertRequest.GetFieldsData(), offset)
 			if err != nil {
 				return nil, err
@@ -351,22 +403,20 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 
 			// if insertMsg's size is greater than the threshold, split into multiple insertMsgs
 			if requestSize+curRowMessageSize >= maxMessageSize {
-				repackedMsgs = append(repackedMsgs, insertMsg)
-				msgID, err = getMsgID()
+				insertMsg, err := createInsertMsgByRowOffsets(segmentID, rowOffsets[start:i], channelName)
 				if err != nil {
 					return nil, err
 				}
-				insertMsg = createInsertMsg(segmentID, channelName, msgID)
+				repackedMsgs = append(repackedMsgs, insertMsg)
+				start = i
 				requestSize = 0
 			}
-
-			typeutil.AppendFieldData(insertMsg.FieldsData, it.GetFieldsData(), int64(offset))
-			insertMsg.HashValues = append(insertMsg.HashValues, it.HashValues[offset])
-			insertMsg.Timestamps = append(insertMsg.Timestamps, it.Timestamps[offset])
-			insertMsg.RowIDs = append(insertMsg.RowIDs, it.RowIDs[offset])
-			insertMsg.NumRows++
 			requestSize += curRowMessageSize
 		}
+		insertMsg, err := createInsertMsgByRowOffsets(segmentID, rowOffsets[start:], channelName)
+		if err != nil {
+			return nil, err
+		}
 		repackedMsgs = append(repackedMsgs, insertMsg)
 
 		return repackedMsgs, nil
@@ -410,19 +460,19 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("insert execute done")
 
 	collectionName := it.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
 	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.GetDbName(), collectionName, Params.CommonCfg.DefaultPartitionName)
 		if err != nil {
 			return err
 		}
@@ -430,6 +480,15 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	it.PartitionID = partitionID
 	tr.Record("get collection id & partition id from cache")
 
+	if Params.ProxyCfg.DmlBackpressureEnabled {
+		if err := it.chMgr.checkDmlBackpressure(collID, Params.ProxyCfg.DmlBackpressureLatencyThreshold); err != nil {
+			log.Warn("reject insert request by backpressure", zap.Int64("msgID", it.Base.MsgID), zap.Int64("collectionID", collID), zap.Error(err))
+			it.result.Status.ErrorCode = errorCodeOf(err)
+			it.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+
 	stream, err := it.chMgr.getOrCreateDmlStream(collID)
 	if err != nil {
 		return err
@@ -439,7 +498,8 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	channelNames, err := it.chMgr.getVChannels(collID)
 	if err != nil {
 		log.Error("get vChannels failed", zap.Int64("msgID", it.Base.MsgID), zap.Int64("collectionID", collID), zap.Error(err))
-		it.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err = checkCollectionDropped(ctx, it.GetDbName(), collectionName, err)
+		it.result.Status.ErrorCode = errorCodeOf(err)
 		it.result.Status.Reason = err.Error()
 		return err
 	}
@@ -479,3 +539,32 @@ func (it *insertTask) Execute(ctx context.Context) error {
 func (it *insertTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// insertRowIndexes returns the indexes of all the rows of the insert request.
+func insertRowIndexes(numRows uint32) []uint32 {
+	indexes := make([]uint32, numRows)
+	for i := range indexes {
+		indexes[i] = uint32(i)
+	}
+	return indexes
+}
+
+// setInsertErrIndex marks the rows of errIndex as failed and the other rows as succeeded, so that SuccIndex and
+// ErrIndex are sorted and partition the rows of the request. The IDs are left as they are, which are mapped to the
+// rows positionally, i.e. IDs[i] is the primary key of the row i whether it succeeded or not.
+func setInsertErrIndex(result *milvuspb.MutationResult, numRows uint32, errIndex []uint32) {
+	failed := make([]bool, numRows)
+	for _, i := range errIndex {
+		if i < numRows {
+			failed[i] = true
+		}
+	}
+	result.SuccIndex, result.ErrIndex = nil, nil
+	for i, f := range failed {
+		if f {
+			result.ErrIndex = append(result.ErrIndex, uint32(i))
+		} else {
+			result.SuccIndex = append(result.SuccIndex, uint32(i))
+		}
+	}
+}
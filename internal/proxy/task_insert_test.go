@@ -1,12 +1,17 @@
 package proxy
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInsertTask_checkLengthOfFieldsData(t *testing.T) {
@@ -346,3 +351,133 @@ func TestInsertTask_CheckAligned(t *testing.T) {
 	err = case2.CheckAligned()
 	assert.NoError(t, err)
 }
+
+func TestInsertTask_resultIndex(t *testing.T) {
+	numRows := uint32(5)
+	it := &insertTask{
+		schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+				NumRows: uint64(numRows),
+				RowIDs:  []int64{100, 101, 102, 103, 104},
+				Version: internalpb.InsertDataVersion_ColumnBased,
+				FieldsData: []*schemapb.FieldData{{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "age",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{0, 1, 2, 3, 4}}},
+					}},
+				}},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			SuccIndex: insertRowIndexes(numRows),
+		},
+	}
+	require.NoError(t, it.checkPrimaryFieldData())
+
+	// the auto generated ids are mapped to the rows positionally
+	assertIDsOfRows := func() {
+		ids := it.result.GetIDs().GetIntId().GetData()
+		require.Equal(t, int(numRows), len(ids))
+		for i, id := range ids {
+			assert.Equal(t, it.RowIDs[i], id)
+		}
+	}
+	assertIDsOfRows()
+
+	setInsertErrIndex(it.result, numRows, nil)
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4}, it.result.GetSuccIndex())
+	assert.Empty(t, it.result.GetErrIndex())
+
+	// partial failure, the duplicated and out of range indexes are ignored
+	setInsertErrIndex(it.result, numRows, []uint32{3, 1, 1, 7})
+	assert.Equal(t, []uint32{0, 2, 4}, it.result.GetSuccIndex())
+	assert.Equal(t, []uint32{1, 3}, it.result.GetErrIndex())
+	assertIDsOfRows()
+
+	setInsertErrIndex(it.result, numRows, insertRowIndexes(numRows))
+	assert.Empty(t, it.result.GetSuccIndex())
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4}, it.result.GetErrIndex())
+	assertIDsOfRows()
+}
+
+func TestInsertTask_PreExecute_CollectionDropped(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+
+	newTask := func() *insertTask {
+		return &insertTask{
+			ctx: ctx,
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+					CollectionName: "coll",
+					PartitionName:  Params.CommonCfg.DefaultPartitionName,
+				},
+			},
+		}
+	}
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	notExists := func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 0, newErrWithCode(commonpb.ErrorCode_CollectionNotExists, "can't find collection: %s", collectionName)
+	}
+
+	t.Run("insert after drop", func(t *testing.T) {
+		cache := newMockCache()
+		cache.setIsDisabledFunc(func(ctx context.Context, collectionName string) (bool, error) {
+			_, err := notExists(ctx, collectionName)
+			return false, err
+		})
+		globalMetaCache = cache
+
+		err := newTask().PreExecute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+		assert.Equal(t, "collection coll does not exist", err.Error())
+		assert.Equal(t, []string{"coll"}, cache.removedCollections)
+	})
+
+	t.Run("insert during drop", func(t *testing.T) {
+		// the cached meta isn't invalidated yet, but rootcoord reports the collection dropped on showing partitions
+		cache := newMockCache()
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return schema, nil
+		})
+		cache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+			return notExists(ctx, collectionName)
+		})
+		globalMetaCache = cache
+
+		err := newTask().PreExecute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+		assert.Equal(t, []string{"coll"}, cache.removedCollections)
+	})
+
+	t.Run("collection still exists", func(t *testing.T) {
+		cache := newMockCache()
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return nil, errors.New("rootcoord is unavailable")
+		})
+		globalMetaCache = cache
+
+		err := newTask().PreExecute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, errorCodeOf(err))
+		assert.Equal(t, "rootcoord is unavailable", err.Error())
+		// the cache is kept on the failures of the rpcs
+		assert.Empty(t, cache.removedCollections)
+	})
+}
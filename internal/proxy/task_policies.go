@@ -4,10 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"go.uber.org/zap"
 )
@@ -21,6 +29,213 @@ var (
 	errInvalidShardLeaders = errors.New("Invalid shard leader")
 )
 
+const (
+	// ShardPolicyKey is the key of the search and query param to override the default shard policy.
+	ShardPolicyKey = "shard_policy"
+	// ExcludeNodeIDsKey is the key of the search and query param listing the query nodes not to send the request to,
+	// e.g. "1,2" or "[1, 2]".
+	ExcludeNodeIDsKey = "exclude_node_ids"
+	// ReplicaGroupKey is the key of the search and query param pinning the request to the query nodes of a replica,
+	// the value is the replica id listed by GetReplicas.
+	ReplicaGroupKey = "replica_group"
+
+	roundRobinShardPolicy     = "round_robin"
+	randomShardPolicy         = "random"
+	leaderAffinityShardPolicy = "leader_affinity"
+)
+
+// shardPolicies maps the name of each shard policy to its implementation, the policies differ only in the order of
+// the shard leaders to try, so all of them fall back to the other replicas on failure.
+var shardPolicies = map[string]pickShardPolicy{
+	roundRobinShardPolicy:     mergeRoundRobinPolicy,
+	randomShardPolicy:         randomPolicy,
+	leaderAffinityShardPolicy: leaderAffinityPolicy,
+}
+
+// selectShardPolicy returns the shard policy specified by the request params, or the default one in Params.
+func selectShardPolicy(params []*commonpb.KeyValuePair) (pickShardPolicy, error) {
+	name, err := funcutil.GetAttrByKeyFromRepeatedKV(ShardPolicyKey, params)
+	if err != nil {
+		name = Params.ProxyCfg.ShardPolicy
+	}
+	policy, ok := shardPolicies[strings.ToLower(name)]
+	if !ok {
+		return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"invalid %s: %s, should be one of %s, %s and %s", ShardPolicyKey, name,
+			roundRobinShardPolicy, randomShardPolicy, leaderAffinityShardPolicy)
+	}
+	return policy, nil
+}
+
+// parseExcludeNodeIDs returns the query nodes excluded by the request params.
+func parseExcludeNodeIDs(params []*commonpb.KeyValuePair) (typeutil.UniqueSet, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExcludeNodeIDsKey, params)
+	if err != nil {
+		return nil, nil
+	}
+	excluded := typeutil.NewUniqueSet()
+	value = strings.Trim(strings.TrimSpace(value), "[]")
+	for _, str := range strings.Split(value, ",") {
+		str = strings.TrimSpace(str)
+		if str == "" {
+			continue
+		}
+		nodeID, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"invalid %s: %s, should be a list of node ids", ExcludeNodeIDsKey, value)
+		}
+		excluded.Insert(nodeID)
+	}
+	return excluded, nil
+}
+
+// excludeNodesPolicy wraps the policy to never pick the excluded query nodes, it fails if all the shard leaders of
+// a shard are excluded.
+func excludeNodesPolicy(policy pickShardPolicy, excluded typeutil.UniqueSet) pickShardPolicy {
+	return func(ctx context.Context,
+		mgr *shardClientMgr,
+		query func(context.Context, UniqueID, types.QueryNode, []string) error,
+		dml2leaders map[string][]nodeInfo) error {
+		filtered := make(map[string][]nodeInfo, len(dml2leaders))
+		for dml, leaders := range dml2leaders {
+			remains := make([]nodeInfo, 0, len(leaders))
+			for _, leader := range leaders {
+				if !excluded.Contain(leader.nodeID) {
+					remains = append(remains, leader)
+				}
+			}
+			if len(remains) == 0 {
+				return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+					"all shard leaders %v of channel %s are excluded by %s", leaders, dml, ExcludeNodeIDsKey)
+			}
+			filtered[dml] = remains
+		}
+		return policy(ctx, mgr, query, filtered)
+	}
+}
+
+// parseReplicaGroup returns the replica the request params pin the request to, ok is false if it's not specified.
+func parseReplicaGroup(params []*commonpb.KeyValuePair) (replicaID UniqueID, ok bool, err error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ReplicaGroupKey, params)
+	if err != nil {
+		return 0, false, nil
+	}
+	replicaID, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, false, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"invalid %s: %s, should be a replica id", ReplicaGroupKey, value)
+	}
+	return replicaID, true, nil
+}
+
+// getReplicaGroupNodes returns the query nodes of the replica of the collection, it fails if the collection has
+// no such replica.
+func getReplicaGroupNodes(ctx context.Context, qc types.QueryCoord, collectionName string, collectionID UniqueID, replicaID UniqueID) (typeutil.UniqueSet, error) {
+	resp, err := qc.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetReplicas,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(resp.GetStatus().GetReason())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replicas of collection %s: %w", collectionName, err)
+	}
+
+	replicaIDs := make([]int64, 0, len(resp.GetReplicas()))
+	for _, replica := range resp.GetReplicas() {
+		if replica.GetReplicaID() != replicaID {
+			replicaIDs = append(replicaIDs, replica.GetReplicaID())
+			continue
+		}
+		nodes := typeutil.NewUniqueSet(replica.GetNodeIds()...)
+		for _, shard := range replica.GetShardReplicas() {
+			nodes.Insert(shard.GetLeaderID())
+		}
+		return nodes, nil
+	}
+	return nil, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"%s %d doesn't exist in collection %s, the replicas are %v", ReplicaGroupKey, replicaID, collectionName, replicaIDs)
+}
+
+// replicaGroupPolicy wraps the policy to pick only the shard leaders on the query nodes of the replica, it fails if
+// a shard has no leader in the replica, e.g. the cached shard leaders are out of date.
+func replicaGroupPolicy(policy pickShardPolicy, replicaID UniqueID, nodes typeutil.UniqueSet) pickShardPolicy {
+	return func(ctx context.Context,
+		mgr *shardClientMgr,
+		query func(context.Context, UniqueID, types.QueryNode, []string) error,
+		dml2leaders map[string][]nodeInfo) error {
+		filtered := make(map[string][]nodeInfo, len(dml2leaders))
+		for dml, leaders := range dml2leaders {
+			remains := make([]nodeInfo, 0, 1)
+			for _, leader := range leaders {
+				if nodes.Contain(leader.nodeID) {
+					remains = append(remains, leader)
+				}
+			}
+			if len(remains) == 0 {
+				return fmt.Errorf("%w: none of shard leaders %v of channel %s is in %s %d",
+					errInvalidShardLeaders, leaders, dml, ReplicaGroupKey, replicaID)
+			}
+			filtered[dml] = remains
+		}
+		return policy(ctx, mgr, query, filtered)
+	}
+}
+
+// pinReplicaGroup wraps the policy by replicaGroupPolicy if the request params specify the replica group.
+func pinReplicaGroup(ctx context.Context, policy pickShardPolicy, params []*commonpb.KeyValuePair,
+	qc types.QueryCoord, collectionName string, collectionID UniqueID) (pickShardPolicy, error) {
+	replicaID, ok, err := parseReplicaGroup(params)
+	if err != nil || !ok {
+		return policy, err
+	}
+	nodes, err := getReplicaGroupNodes(ctx, qc, collectionName, collectionID, replicaID)
+	if err != nil {
+		return nil, err
+	}
+	return replicaGroupPolicy(policy, replicaID, nodes), nil
+}
+
+// randomPolicy tries the shard leaders in a random order.
+func randomPolicy(
+	ctx context.Context,
+	mgr *shardClientMgr,
+	query func(context.Context, UniqueID, types.QueryNode, []string) error,
+	dml2leaders map[string][]nodeInfo) error {
+	shuffled := make(map[string][]nodeInfo, len(dml2leaders))
+	for dml, leaders := range dml2leaders {
+		leaders = append([]nodeInfo(nil), leaders...)
+		rand.Shuffle(len(leaders), func(i, j int) {
+			leaders[i], leaders[j] = leaders[j], leaders[i]
+		})
+		shuffled[dml] = leaders
+	}
+	return mergeRoundRobinPolicy(ctx, mgr, query, shuffled)
+}
+
+// leaderAffinityPolicy always tries the shard leaders in the order of their node ids, so that requests of a shard are
+// pinned to the same replica as long as it's available, which reduces the cache thrash on query nodes.
+func leaderAffinityPolicy(
+	ctx context.Context,
+	mgr *shardClientMgr,
+	query func(context.Context, UniqueID, types.QueryNode, []string) error,
+	dml2leaders map[string][]nodeInfo) error {
+	sorted := make(map[string][]nodeInfo, len(dml2leaders))
+	for dml, leaders := range dml2leaders {
+		leaders = append([]nodeInfo(nil), leaders...)
+		sort.Slice(leaders, func(i, j int) bool {
+			return leaders[i].nodeID < leaders[j].nodeID
+		})
+		sorted[dml] = leaders
+	}
+	return mergeRoundRobinPolicy(ctx, mgr, query, sorted)
+}
+
 func updateShardsWithRoundRobin(shardsLeaders map[string][]nodeInfo) {
 	for channelID, leaders := range shardsLeaders {
 		if len(leaders) <= 1 {
@@ -31,6 +246,25 @@ func updateShardsWithRoundRobin(shardsLeaders map[string][]nodeInfo) {
 	}
 }
 
+// preferRoutableLeaders returns the shard leaders with the unroutable ones moved to the end, so that they are only
+// tried if all the other replicas fail. The order of the leaders is kept otherwise.
+func preferRoutableLeaders(mgr *shardClientMgr, shard2leaders map[string][]nodeInfo) map[string][]nodeInfo {
+	ret := make(map[string][]nodeInfo, len(shard2leaders))
+	for dml, leaders := range shard2leaders {
+		routable := make([]nodeInfo, 0, len(leaders))
+		unroutable := make([]nodeInfo, 0)
+		for _, leader := range leaders {
+			if mgr.IsRoutable(leader.nodeID) {
+				routable = append(routable, leader)
+			} else {
+				unroutable = append(unroutable, leader)
+			}
+		}
+		ret[dml] = append(routable, unroutable...)
+	}
+	return ret
+}
+
 // group dml shard leader with same nodeID
 func groupShardleadersWithSameQueryNode(
 	ctx context.Context,
@@ -91,6 +325,7 @@ func mergeRoundRobinPolicy(
 	mgr *shardClientMgr,
 	query func(context.Context, UniqueID, types.QueryNode, []string) error,
 	dml2leaders map[string][]nodeInfo) error {
+	dml2leaders = preferRoutableLeaders(mgr, dml2leaders)
 	nexts := make(map[string]int)
 	errSet := make(map[string]error) // record err for dml channels
 	for dml := range dml2leaders {
@@ -3,12 +3,18 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sort"
 	"sync"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
 
@@ -158,6 +164,174 @@ func TestMergeRoundRobinPolicy(t *testing.T) {
 	assert.Equal(t, err, mockerr)
 }
 
+func TestMergeRoundRobinPolicy_StoppingNode(t *testing.T) {
+	Params.Init()
+	ctx := context.TODO()
+
+	mgr := newShardClientMgr(withShardClientCreator(mockQueryNodeCreator))
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}, {nodeID: 2, address: "fake"}},
+		"c1": {{nodeID: 1, address: "fake"}, {nodeID: 2, address: "fake"}, {nodeID: 3, address: "fake"}},
+		"c2": {{nodeID: 0, address: "fake"}, {nodeID: 2, address: "fake"}, {nodeID: 3, address: "fake"}},
+		"c3": {{nodeID: 0, address: "fake"}},
+	}
+	err := mgr.UpdateShardLeaders(nil, shard2leaders)
+	assert.NoError(t, err)
+	node := &Proxy{shardMgr: mgr}
+
+	// the session of node 0 is deleted, it's only used by the shard without other replicas.
+	node.handleQueryNodeSessionEvent(&sessionutil.SessionEvent{
+		EventType: sessionutil.SessionDelEvent,
+		Session:   &sessionutil.Session{ServerID: 0},
+	})
+	assert.False(t, mgr.IsRoutable(0))
+	assert.True(t, mgr.IsRoutable(1))
+
+	querier := &mockQuery{}
+	querier.init()
+	err = mergeRoundRobinPolicy(ctx, mgr, querier.query, shard2leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{0: {"c3"}, 1: {"c0", "c1"}, 2: {"c2"}}, querier.records())
+	// the cached shard leaders are not reordered.
+	assert.Equal(t, UniqueID(0), shard2leaders["c0"][0].nodeID)
+
+	// the request failed on a replica is retried on the next routable one before node 0.
+	querier.init()
+	querier.failset[2] = fmt.Errorf("mock query node error")
+	err = mergeRoundRobinPolicy(ctx, mgr, querier.query, map[string][]nodeInfo{"c2": shard2leaders["c2"]})
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{3: {"c2"}}, querier.records())
+
+	// node 0 is up again.
+	node.handleQueryNodeSessionEvent(&sessionutil.SessionEvent{
+		EventType: sessionutil.SessionAddEvent,
+		Session:   &sessionutil.Session{ServerID: 0},
+	})
+	assert.True(t, mgr.IsRoutable(0))
+	querier.init()
+	err = mergeRoundRobinPolicy(ctx, mgr, querier.query, shard2leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{0: {"c0", "c2", "c3"}, 1: {"c1"}}, querier.records())
+}
+
+func TestProxy_handleQueryNodeSessionEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr := newShardClientMgr(withShardClientCreator(mockQueryNodeCreator))
+	err := mgr.UpdateShardLeaders(nil, map[string][]nodeInfo{
+		"c0": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+	})
+	assert.NoError(t, err)
+	node := &Proxy{ctx: ctx, shardMgr: mgr}
+
+	// the events missed while the sessions are not watched are synced by the current sessions
+	node.syncQueryNodeSessions(map[string]*sessionutil.Session{"querynode-1": {ServerID: 1}})
+	assert.False(t, mgr.IsRoutable(0))
+	assert.True(t, mgr.IsRoutable(1))
+
+	// the sessions are watched again once the watcher is closed
+	eventCh := make(chan *sessionutil.SessionEvent, 2)
+	eventCh <- &sessionutil.SessionEvent{EventType: sessionutil.SessionAddEvent, Session: &sessionutil.Session{ServerID: 0}}
+	eventCh <- &sessionutil.SessionEvent{EventType: sessionutil.SessionDelEvent, Session: &sessionutil.Session{ServerID: 1}}
+	close(eventCh)
+	assert.True(t, node.handleQueryNodeSessionEvents(eventCh))
+	assert.True(t, mgr.IsRoutable(0))
+	assert.False(t, mgr.IsRoutable(1))
+
+	// the watch stops with the proxy
+	cancel()
+	assert.False(t, node.handleQueryNodeSessionEvents(make(chan *sessionutil.SessionEvent)))
+}
+
+func TestSelectShardPolicy(t *testing.T) {
+	Params.Init()
+	pointerOf := func(policy pickShardPolicy) uintptr {
+		return reflect.ValueOf(policy).Pointer()
+	}
+
+	policy, err := selectShardPolicy(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pointerOf(mergeRoundRobinPolicy), pointerOf(policy))
+
+	policy, err = selectShardPolicy([]*commonpb.KeyValuePair{{Key: ShardPolicyKey, Value: "Random"}})
+	assert.NoError(t, err)
+	assert.Equal(t, pointerOf(randomPolicy), pointerOf(policy))
+
+	policy, err = selectShardPolicy([]*commonpb.KeyValuePair{{Key: ShardPolicyKey, Value: leaderAffinityShardPolicy}})
+	assert.NoError(t, err)
+	assert.Equal(t, pointerOf(leaderAffinityPolicy), pointerOf(policy))
+
+	Params.ProxyCfg.ShardPolicy = leaderAffinityShardPolicy
+	defer func() { Params.ProxyCfg.ShardPolicy = roundRobinShardPolicy }()
+	policy, err = selectShardPolicy(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pointerOf(leaderAffinityPolicy), pointerOf(policy))
+
+	_, err = selectShardPolicy([]*commonpb.KeyValuePair{{Key: ShardPolicyKey, Value: "unknown"}})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestShardPolicies(t *testing.T) {
+	Params.Init()
+	ctx := context.TODO()
+
+	mgr := newShardClientMgr(withShardClientCreator(mockQueryNodeCreator))
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 2, address: "fake"}, {nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+		"c1": {{nodeID: 1, address: "fake"}, {nodeID: 2, address: "fake"}, {nodeID: 0, address: "fake"}},
+	}
+	err := mgr.UpdateShardLeaders(nil, shard2leaders)
+	assert.NoError(t, err)
+	querier := &mockQuery{}
+
+	t.Run("round robin", func(t *testing.T) {
+		// the order of leaders is rotated by the meta cache, the policy takes it as is.
+		querier.init()
+		err := mergeRoundRobinPolicy(ctx, mgr, querier.query, shard2leaders)
+		assert.NoError(t, err)
+		assert.Equal(t, map[UniqueID][]string{2: {"c0"}, 1: {"c1"}}, querier.records())
+	})
+
+	t.Run("leader affinity", func(t *testing.T) {
+		leaders := map[string][]nodeInfo{
+			"c0": shard2leaders["c0"],
+			"c1": shard2leaders["c1"],
+		}
+		for i := 0; i < 3; i++ {
+			querier.init()
+			err := leaderAffinityPolicy(ctx, mgr, querier.query, leaders)
+			assert.NoError(t, err)
+			assert.Equal(t, map[UniqueID][]string{0: {"c0", "c1"}}, querier.records())
+			updateShardsWithRoundRobin(leaders)
+		}
+
+		// fall back to the next replica if the pinned one fails.
+		querier.init()
+		querier.failset[0] = fmt.Errorf("mock query node error")
+		err := leaderAffinityPolicy(ctx, mgr, querier.query, leaders)
+		assert.NoError(t, err)
+		assert.Equal(t, map[UniqueID][]string{1: {"c0", "c1"}}, querier.records())
+	})
+
+	t.Run("random", func(t *testing.T) {
+		picked := make(map[UniqueID]struct{})
+		for i := 0; i < 100; i++ {
+			querier.init()
+			err := randomPolicy(ctx, mgr, querier.query, map[string][]nodeInfo{"c0": shard2leaders["c0"]})
+			assert.NoError(t, err)
+			records := querier.records()
+			assert.Equal(t, 1, len(records))
+			for nodeID := range records {
+				picked[nodeID] = struct{}{}
+			}
+		}
+		assert.Greater(t, len(picked), 1)
+		// the cached shard leaders are not shuffled.
+		assert.Equal(t, UniqueID(2), shard2leaders["c0"][0].nodeID)
+	})
+}
+
 func mockQueryNodeCreator(ctx context.Context, address string) (types.QueryNode, error) {
 	return &QueryNodeMock{address: address}, nil
 }
@@ -195,3 +369,171 @@ func (m *mockQuery) records() map[UniqueID][]string {
 	}
 	return m.queryset
 }
+
+func TestParseExcludeNodeIDs(t *testing.T) {
+	excluded, err := parseExcludeNodeIDs(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, excluded)
+
+	for _, value := range []string{"1,2", "[1, 2]", " 1 , 2, "} {
+		excluded, err = parseExcludeNodeIDs([]*commonpb.KeyValuePair{{Key: ExcludeNodeIDsKey, Value: value}})
+		assert.NoError(t, err, value)
+		assert.Equal(t, typeutil.NewUniqueSet(1, 2), excluded, value)
+	}
+
+	_, err = parseExcludeNodeIDs([]*commonpb.KeyValuePair{{Key: ExcludeNodeIDsKey, Value: "1,a"}})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestExcludeNodesPolicy(t *testing.T) {
+	Params.Init()
+	ctx := context.TODO()
+
+	mgr := newShardClientMgr(withShardClientCreator(mockQueryNodeCreator))
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 2, address: "fake"}, {nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+		"c1": {{nodeID: 1, address: "fake"}, {nodeID: 2, address: "fake"}, {nodeID: 0, address: "fake"}},
+	}
+	err := mgr.UpdateShardLeaders(nil, shard2leaders)
+	assert.NoError(t, err)
+	querier := &mockQuery{}
+
+	t.Run("excluded node never chosen", func(t *testing.T) {
+		for name, policy := range shardPolicies {
+			for i := 0; i < 10; i++ {
+				querier.init()
+				err := excludeNodesPolicy(policy, typeutil.NewUniqueSet(1, 2))(ctx, mgr, querier.query, shard2leaders)
+				assert.NoError(t, err, name)
+				assert.Equal(t, map[UniqueID][]string{0: {"c0", "c1"}}, querier.records(), name)
+			}
+		}
+		// the cached shard leaders are not changed.
+		assert.Equal(t, 3, len(shard2leaders["c0"]))
+	})
+
+	t.Run("no fall back to excluded node", func(t *testing.T) {
+		querier.init()
+		querier.failset[0] = fmt.Errorf("mock query node error")
+		err := excludeNodesPolicy(mergeRoundRobinPolicy, typeutil.NewUniqueSet(2))(ctx, mgr, querier.query, shard2leaders)
+		assert.NoError(t, err)
+		assert.Equal(t, map[UniqueID][]string{1: {"c0", "c1"}}, querier.records())
+	})
+
+	t.Run("all replicas excluded", func(t *testing.T) {
+		querier.init()
+		err := excludeNodesPolicy(mergeRoundRobinPolicy, typeutil.NewUniqueSet(0, 1, 2))(ctx, mgr, querier.query, shard2leaders)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Empty(t, querier.records())
+	})
+}
+
+func TestParseReplicaGroup(t *testing.T) {
+	_, ok, err := parseReplicaGroup(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	replicaID, ok, err := parseReplicaGroup([]*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: " 10 "}})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(10), replicaID)
+
+	_, _, err = parseReplicaGroup([]*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: "a"}})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestPinReplicaGroup(t *testing.T) {
+	Params.Init()
+	ctx := context.TODO()
+
+	qc := NewQueryCoordMock()
+	qc.updateState(internalpb.StateCode_Healthy)
+	qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		return &milvuspb.GetReplicasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: []*milvuspb.ReplicaInfo{
+				{
+					ReplicaID: 10,
+					NodeIds:   []int64{0, 3},
+					ShardReplicas: []*milvuspb.ShardReplica{
+						{LeaderID: 0, DmChannelName: "c0"},
+						{LeaderID: 0, DmChannelName: "c1"},
+					},
+				},
+				{
+					ReplicaID: 11,
+					NodeIds:   []int64{1, 2},
+					ShardReplicas: []*milvuspb.ShardReplica{
+						{LeaderID: 2, DmChannelName: "c0"},
+						{LeaderID: 1, DmChannelName: "c1"},
+					},
+				},
+			},
+		}, nil
+	})
+
+	mgr := newShardClientMgr(withShardClientCreator(mockQueryNodeCreator))
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 2, address: "fake"}, {nodeID: 0, address: "fake"}},
+		"c1": {{nodeID: 1, address: "fake"}, {nodeID: 0, address: "fake"}},
+	}
+	err := mgr.UpdateShardLeaders(nil, shard2leaders)
+	assert.NoError(t, err)
+	querier := &mockQuery{}
+
+	t.Run("not specified", func(t *testing.T) {
+		policy, err := pinReplicaGroup(ctx, mergeRoundRobinPolicy, nil, qc, "coll", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, reflect.ValueOf(mergeRoundRobinPolicy).Pointer(), reflect.ValueOf(policy).Pointer())
+	})
+
+	t.Run("pinned to replica group", func(t *testing.T) {
+		for replicaID, expected := range map[string]map[UniqueID][]string{
+			"10": {0: {"c0", "c1"}},
+			"11": {1: {"c1"}, 2: {"c0"}},
+		} {
+			params := []*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: replicaID}}
+			for name, shardPolicy := range shardPolicies {
+				policy, err := pinReplicaGroup(ctx, shardPolicy, params, qc, "coll", 1)
+				assert.NoError(t, err)
+				for i := 0; i < 10; i++ {
+					querier.init()
+					assert.NoError(t, policy(ctx, mgr, querier.query, shard2leaders), name)
+					assert.Equal(t, expected, querier.records(), name)
+				}
+			}
+		}
+	})
+
+	t.Run("no fall back to other replicas", func(t *testing.T) {
+		policy, err := pinReplicaGroup(ctx, mergeRoundRobinPolicy,
+			[]*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: "10"}}, qc, "coll", 1)
+		assert.NoError(t, err)
+		querier.init()
+		querier.failset[0] = fmt.Errorf("mock query node error")
+		assert.Error(t, policy(ctx, mgr, querier.query, shard2leaders))
+		assert.Empty(t, querier.records())
+
+		// the shard leaders out of the replica group are refreshed
+		err = policy(ctx, mgr, querier.query, map[string][]nodeInfo{"c0": {{nodeID: 2, address: "fake"}}})
+		assert.ErrorIs(t, err, errInvalidShardLeaders)
+	})
+
+	t.Run("replica group not exist", func(t *testing.T) {
+		_, err := pinReplicaGroup(ctx, mergeRoundRobinPolicy,
+			[]*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: "12"}}, qc, "coll", 1)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "[10 11]")
+	})
+
+	t.Run("get replicas failed", func(t *testing.T) {
+		qc.updateState(internalpb.StateCode_Abnormal)
+		defer qc.updateState(internalpb.StateCode_Healthy)
+		_, err := pinReplicaGroup(ctx, mergeRoundRobinPolicy,
+			[]*commonpb.KeyValuePair{{Key: ReplicaGroupKey, Value: "10"}}, qc, "coll", 1)
+		assert.Error(t, err)
+	})
+}
@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -45,12 +45,22 @@ type queryTask struct {
 	ids            *schemapb.IDs
 	collectionName string
 	queryParams    *queryParams
+	// iterator pages through the rows by primary key, it's nil unless QueryIteratorKey is true or
+	// QueryIteratorCursorKey is set
+	iterator *queryIterator
 
 	resultBuf       chan *internalpb.RetrieveResults
 	toReduceResults []*internalpb.RetrieveResults
 
 	queryShardPolicy pickShardPolicy
 	shardMgr         *shardClientMgr
+
+	// resultSizeInBytes is the size of the result sent back to client, set in PostExecute.
+	resultSizeInBytes int
+	// resultMemory accounts the shard results, set when the task is enqueued.
+	resultMemory *resultMemoryAccount
+	// priority is hinted by the request, set when the task is enqueued.
+	priority requestPriority
 }
 
 type queryParams struct {
@@ -63,7 +73,7 @@ func translateToOutputFieldIDs(outputFields []string, schema *schemapb.Collectio
 	outputFieldIDs := make([]UniqueID, 0, len(outputFields)+1)
 	if len(outputFields) == 0 {
 		for _, field := range schema.Fields {
-			if field.FieldID >= common.StartOfUserFieldID && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+			if field.FieldID >= common.StartOfUserFieldID && !typeutil.IsVectorType(field.DataType) {
 				outputFieldIDs = append(outputFieldIDs, field.FieldID)
 			}
 		}
@@ -143,7 +153,18 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 
 func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.queryShardPolicy == nil {
-		t.queryShardPolicy = mergeRoundRobinPolicy
+		policy, err := selectShardPolicy(t.request.GetQueryParams())
+		if err != nil {
+			return err
+		}
+		t.queryShardPolicy = policy
+	}
+	excludedNodes, err := parseExcludeNodeIDs(t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+	if len(excludedNodes) > 0 {
+		t.queryShardPolicy = excludeNodesPolicy(t.queryShardPolicy, excludedNodes)
 	}
 
 	t.Base.MsgType = commonpb.MsgType_Retrieve
@@ -160,14 +181,21 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	log.Ctx(ctx).Debug("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
 		log.Ctx(ctx).Warn("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
 		return err
 	}
+	if err := checkCollectionEnabled(ctx, t.request.GetDbName(), collectionName); err != nil {
+		return err
+	}
 
 	t.CollectionID = collID
+	if t.queryShardPolicy, err = pinReplicaGroup(ctx, t.queryShardPolicy, t.request.GetQueryParams(),
+		t.qc, collectionName, collID); err != nil {
+		return err
+	}
 	log.Ctx(ctx).Debug("Get collection ID by name",
 		zap.Int64("collectionID", t.CollectionID), zap.String("collection name", collectionName),
 		zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
@@ -182,7 +210,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	log.Ctx(ctx).Debug("Validate partition names.",
 		zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
 
-	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		log.Ctx(ctx).Warn("failed to get partitions in collection.", zap.String("collection name", collectionName),
 			zap.Error(err),
@@ -199,15 +227,25 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.RetrieveRequest.GetPartitionIDs())
+	t.iterator, err = parseQueryIterator(t.request.GetQueryParams(), collID)
+	if err != nil {
+		return err
+	}
+	if t.iterator != nil {
+		if err := t.iterator.checkParams(queryParams); err != nil {
+			return err
+		}
+	}
+
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.RetrieveRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when query, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
 	if !loaded {
-		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when query", collectionName, t.request.GetPartitionNames())
+		return errCollectionNotLoaded(collectionName, t.request.GetPartitionNames())
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 
 	if t.ids != nil {
 		pkField := ""
@@ -223,11 +261,28 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("query expression is empty")
 	}
 
-	plan, err := planparserv2.CreateRetrievePlan(schema, t.request.Expr)
+	t.request.Expr, err = fillExpressionTemplate(schema, t.request.Expr, t.request.GetExprTemplateValues())
+	if err != nil {
+		return err
+	}
+	if t.iterator != nil {
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return err
+		}
+		if t.request.Expr, err = t.iterator.filterExpr(t.request.Expr, pkField); err != nil {
+			return err
+		}
+	}
+	plan, err := createRetrievePlan(schema, t.request.Expr)
 	if err != nil {
 		return err
 	}
-	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
+	includeVectors, err := parseIncludeVectors(t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true, includeVectors)
 	if err != nil {
 		return err
 	}
@@ -248,7 +303,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	if t.request.TravelTimestamp == 0 {
+	if t.iterator != nil {
+		if t.TravelTimestamp, err = t.iterator.fixSnapshot(t.request.TravelTimestamp, t.BeginTs()); err != nil {
+			return err
+		}
+	} else if t.request.TravelTimestamp == 0 {
 		t.TravelTimestamp = t.BeginTs()
 	} else {
 		t.TravelTimestamp = t.request.TravelTimestamp
@@ -260,7 +319,15 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
-	t.GuaranteeTimestamp = parseGuaranteeTs(guaranteeTs, t.BeginTs())
+	t.GuaranteeTimestamp, err = resolveGuaranteeTs(ctx, t.request.GetDbName(), collectionName, guaranteeTs,
+		t.request.GetUseDefaultConsistency(), t.BeginTs())
+	if err != nil {
+		return err
+	}
+	if t.iterator != nil && t.GuaranteeTimestamp < t.TravelTimestamp {
+		// all the rows of the snapshot must be visible to every page
+		t.GuaranteeTimestamp = t.TravelTimestamp
+	}
 
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
@@ -280,7 +347,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	defer tr.CtxElapse(ctx, "done")
 
 	executeQuery := func(withCache bool) error {
-		shards, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
@@ -331,27 +398,32 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
-	t.result, err = mergeRetrieveResults(ctx, t.toReduceResults)
+	// the rows of a query iterator are positioned by primary key, which must be unique
+	t.result, err = mergeRetrieveResults(ctx, t.toReduceResults, Params.ProxyCfg.QueryResultDedup || t.iterator != nil)
 	if err != nil {
 		return err
 	}
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	if t.iterator != nil {
+		if err := t.reduceIteratorPage(ctx); err != nil {
+			return err
+		}
+	}
+	reduceDuration := tr.RecordSpan()
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(reduceDuration.Milliseconds()))
 	t.result.CollectionName = t.collectionName
+	defer t.recordCollectionMetrics(reduceDuration)
 
-	if len(t.result.FieldsData) > 0 {
-		t.result.Status = &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_Success,
-		}
-	} else {
-		log.Ctx(ctx).Warn("Query result is nil", zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
-		t.result.Status = &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_EmptyCollection,
-			Reason:    "empty collection", // TODO
-		}
+	t.result.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if len(t.result.FieldsData) == 0 {
+		// no entity is found, e.g. the collection is empty, which is not an error
+		log.Ctx(ctx).Debug("Query result is empty", zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
+		t.result.Status.Reason = EmptyResultReason
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -368,6 +440,36 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// reduceIteratorPage keeps the rows of the page of the query iterator and sets the cursor of the next page.
+func (t *queryTask) reduceIteratorPage(ctx context.Context) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
+	if err != nil {
+		return err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	pkIndex := -1
+	for i, fieldID := range t.OutputFieldsId {
+		if fieldID == pkField.GetFieldID() {
+			pkIndex = i
+		}
+	}
+	t.result.QueryIteratorCursor, err = t.iterator.reduce(t.result, pkIndex, t.queryParams.limit)
+	return err
+}
+
+// recordCollectionMetrics records result size and reduce duration of the query in per-collection metrics.
+func (t *queryTask) recordCollectionMetrics(reduceDuration time.Duration) {
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	collection := metrics.ProxyCollectionLabel(t.GetCollectionID())
+	t.resultSizeInBytes = proto.Size(t.result)
+
+	metrics.ProxySearchResultBytes.WithLabelValues(nodeID, metrics.QueryLabel, collection).Observe(float64(t.resultSizeInBytes))
+	metrics.ProxyReduceResultDuration.WithLabelValues(nodeID, metrics.QueryLabel, collection).Add(float64(reduceDuration.Milliseconds()))
+}
+
 func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	req := &querypb.QueryRequest{
 		Req:         t.RetrieveRequest,
@@ -392,6 +494,10 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	}
 
 	log.Ctx(ctx).Debug("get query result", zap.Int64("msgID", t.ID()), zap.Int64("nodeID", nodeID), zap.Strings("channelIDs", channelIDs))
+	if err := t.resultMemory.grow(proto.Size(result)); err != nil {
+		log.Ctx(ctx).Warn("query result is too large", zap.Int64("msgID", t.ID()), zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
 	t.resultBuf <- result
 	return nil
 }
@@ -409,16 +515,21 @@ func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	return fieldName + " in [ " + idsStr + " ]"
 }
 
-func mergeRetrieveResults(ctx context.Context, retrieveResults []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+// mergeRetrieveResults merges the results of the query nodes in order. If dedup is set, only the first row in the
+// merge order is kept for each primary key, the query nodes don't return the row timestamps to tell the latest one.
+func mergeRetrieveResults(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, dedup bool) (*milvuspb.QueryResults, error) {
 	var ret *milvuspb.QueryResults
 	var skipDupCnt int64
 	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
-		numPks := typeutil.GetSizeOfIDs(rr.GetIds())
 		// skip empty result, it will break merge result
-		if rr == nil || rr.Ids == nil || rr.GetIds() == nil || numPks == 0 {
+		if rr == nil || rr.GetIds() == nil {
+			continue
+		}
+		numPks := typeutil.GetSizeOfIDs(rr.GetIds())
+		if numPks == 0 {
 			continue
 		}
 
@@ -433,14 +544,16 @@ func mergeRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Ret
 		}
 
 		for i := 0; i < numPks; i++ {
-			id := typeutil.GetPK(rr.GetIds(), int64(i))
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
+			if dedup {
+				id := typeutil.GetPK(rr.GetIds(), int64(i))
+				if _, ok := idSet[id]; ok {
+					// primary keys duplicate
+					skipDupCnt++
+					continue
+				}
 				idSet[id] = struct{}{}
-			} else {
-				// primary keys duplicate
-				skipDupCnt++
 			}
+			typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
 		}
 	}
 	log.Ctx(ctx).Debug("skip duplicated query result", zap.Int64("count", skipDupCnt))
@@ -488,5 +601,19 @@ func (t *queryTask) SetTs(ts Timestamp) {
 
 func (t *queryTask) OnEnqueue() error {
 	t.Base.MsgType = commonpb.MsgType_Retrieve
-	return nil
+	var err error
+	t.priority, err = parseRequestPriority(t.TraceCtx(), t.request.GetQueryParams())
+	return err
+}
+
+func (t *queryTask) getRequestPriority() requestPriority {
+	return t.priority
+}
+
+func (t *queryTask) setResultMemory(account *resultMemoryAccount) {
+	t.resultMemory = account
+}
+
+func (t *queryTask) getResultMemory() *resultMemoryAccount {
+	return t.resultMemory
 }
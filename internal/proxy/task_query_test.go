@@ -91,9 +91,31 @@ func TestQueryTask_all(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
+	// query before the collection is loaded
+	notLoadedTask := &queryTask{
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyCfg.GetNodeID(),
+			},
+		},
+		ctx: ctx,
+		request: &milvuspb.QueryRequest{
+			CollectionName: collectionName,
+			Expr:           expr,
+		},
+		qc:       qc,
+		shardMgr: mgr,
+	}
+	assert.NoError(t, notLoadedTask.OnEnqueue())
+	err = notLoadedTask.PreExecute(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(err))
+
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:  commonpb.MsgType_LoadCollection,
@@ -195,6 +217,20 @@ func TestQueryTask_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 
 	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+	assert.Empty(t, task.result.GetStatus().GetReason())
+
+	// querying an empty collection succeeds with empty result
+	qn.withQueryResult = &internalpb.RetrieveResults{
+		Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_RetrieveResult},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}},
+	}
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+	assert.Equal(t, EmptyResultReason, task.result.GetStatus().GetReason())
+	assert.Empty(t, task.result.GetFieldsData())
 }
 
 func Test_translateToOutputFieldIDs(t *testing.T) {
@@ -384,3 +420,75 @@ func TestTaskQuery_functions(t *testing.T) {
 		}
 	})
 }
+
+func TestMergeRetrieveResults(t *testing.T) {
+	const ageFieldID = 102
+	longField := func(fieldID int64, data ...int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:    schemapb.DataType_Int64,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+				},
+			},
+		}
+	}
+	intResult := func(pks []int64, ages []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			FieldsData: []*schemapb.FieldData{longField(ageFieldID, ages...)},
+		}
+	}
+	strResult := func(pks []string, ages []int64) *internalpb.RetrieveResults {
+		rr := intResult(nil, ages)
+		rr.Ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pks}}}
+		return rr
+	}
+	ages := func(ret *milvuspb.QueryResults) []int64 {
+		return ret.GetFieldsData()[0].GetScalars().GetLongData().GetData()
+	}
+	ctx := context.Background()
+
+	t.Run("int64 pks keep the first", func(t *testing.T) {
+		// the pks 2 and 4 are both in a sealed segment and a growing one around a flush
+		sealed := intResult([]int64{1, 2, 3, 4}, []int64{10, 20, 30, 40})
+		growing := intResult([]int64{2, 5, 4}, []int64{21, 50, 41})
+		ret, err := mergeRetrieveResults(ctx, []*internalpb.RetrieveResults{sealed, growing}, true)
+		require.NoError(t, err)
+		require.Len(t, ret.GetFieldsData(), 1)
+		// the kept rows are in the merge order
+		assert.Equal(t, []int64{10, 20, 30, 40, 50}, ages(ret))
+	})
+
+	t.Run("varchar pks keep the first", func(t *testing.T) {
+		sealed := strResult([]string{"a", "b", "c"}, []int64{10, 20, 30})
+		growing := strResult([]string{"b", "d"}, []int64{21, 40})
+		ret, err := mergeRetrieveResults(ctx, []*internalpb.RetrieveResults{sealed, growing}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 20, 30, 40}, ages(ret))
+	})
+
+	t.Run("dedup disabled", func(t *testing.T) {
+		first := intResult([]int64{1, 2}, []int64{10, 20})
+		second := intResult([]int64{2, 3}, []int64{21, 30})
+		ret, err := mergeRetrieveResults(ctx, []*internalpb.RetrieveResults{first, second}, false)
+		require.NoError(t, err)
+		require.Len(t, ret.GetFieldsData(), 1)
+		assert.Equal(t, []int64{10, 20, 21, 30}, ages(ret))
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		ret, err := mergeRetrieveResults(ctx, []*internalpb.RetrieveResults{nil, {}}, true)
+		require.NoError(t, err)
+		assert.Empty(t, ret.GetFieldsData())
+	})
+
+	t.Run("mismatched fields", func(t *testing.T) {
+		first := intResult([]int64{1}, []int64{10})
+		second := intResult([]int64{2}, []int64{20})
+		second.FieldsData = append(second.FieldsData, longField(103, 1))
+		_, err := mergeRetrieveResults(ctx, []*internalpb.RetrieveResults{first, second}, true)
+		assert.Error(t, err)
+	})
+}
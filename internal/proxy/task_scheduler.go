@@ -21,11 +21,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
@@ -63,6 +67,10 @@ type baseTaskQueue struct {
 
 	utBufChan chan int // to block scheduler
 
+	// follows reports whether the task must be popped after the queued one, the tasks are popped in the order
+	// they are enqueued if it's nil.
+	follows func(t, queued task) bool
+
 	tsoAllocatorIns tsoAllocator
 	idAllocatorIns  idAllocatorInterface
 }
@@ -81,6 +89,17 @@ func (queue *baseTaskQueue) utFull() bool {
 	return int64(queue.unissuedTasks.Len()) >= queue.getMaxTaskNum()
 }
 
+// getTaskNum returns the number of the unissued tasks and the active tasks.
+func (queue *baseTaskQueue) getTaskNum() (unissued int, active int) {
+	queue.utLock.RLock()
+	unissued = queue.unissuedTasks.Len()
+	queue.utLock.RUnlock()
+	queue.atLock.RLock()
+	active = len(queue.activeTasks)
+	queue.atLock.RUnlock()
+	return unissued, active
+}
+
 func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
@@ -88,7 +107,18 @@ func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 	if queue.utFull() {
 		return errors.New("task queue is full")
 	}
-	queue.unissuedTasks.PushBack(t)
+	// the task is inserted after the last queued task it must follow
+	e := queue.unissuedTasks.Back()
+	if queue.follows != nil {
+		for e != nil && !queue.follows(t, e.Value.(task)) {
+			e = e.Prev()
+		}
+	}
+	if e == nil {
+		queue.unissuedTasks.PushFront(t)
+	} else {
+		queue.unissuedTasks.InsertAfter(t, e)
+	}
 	queue.utBufChan <- 1
 	return nil
 }
@@ -165,6 +195,14 @@ func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
 }
 
 func (queue *baseTaskQueue) Enqueue(t task) error {
+	if err := queue.prepare(t); err != nil {
+		return err
+	}
+	return queue.addUnissuedTask(t)
+}
+
+// prepare calls OnEnqueue of the task and allocates its timestamp and id.
+func (queue *baseTaskQueue) prepare(t task) error {
 	err := t.OnEnqueue()
 	if err != nil {
 		return err
@@ -181,8 +219,7 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 		return err
 	}
 	t.SetID(reqID)
-
-	return queue.addUnissuedTask(t)
+	return nil
 }
 
 func (queue *baseTaskQueue) setMaxTaskNum(num int64) {
@@ -215,6 +252,15 @@ func newBaseTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorIn
 type ddTaskQueue struct {
 	*baseTaskQueue
 	lock sync.Mutex
+
+	limiter *taskConcurrencyLimiter
+	// priorities of the tasks keyed by lower case task name, the tasks not in it are of priority 0
+	priorities map[string]int
+
+	serializer *ddTaskSerializer
+	keysLock   sync.Mutex
+	taskKeys   map[task][]string // the serializing keys of the unissued tasks
+	popLock    sync.Mutex        // the tasks are appended to the serializer in the order they are popped
 }
 
 type pChanStatInfo struct {
@@ -341,18 +387,192 @@ func (queue *dmTaskQueue) getPChanStatsInfo() (map[pChan]*pChanStatistics, error
 
 type dqTaskQueue struct {
 	*baseTaskQueue
+
+	shedder      *loadShedder
+	resultMemory *resultMemoryTracker
+
+	// picker is guarded by utLock, enqueueTimes by timesLock
+	picker       priorityPicker
+	enqueueTimes map[task]time.Time
+	timesLock    sync.Mutex
+}
+
+// usage returns the number of the unissued and active tasks relative to maxTaskNum.
+func (queue *dqTaskQueue) usage() float64 {
+	queue.utLock.RLock()
+	num := queue.unissuedTasks.Len()
+	queue.utLock.RUnlock()
+	queue.atLock.RLock()
+	num += len(queue.activeTasks)
+	queue.atLock.RUnlock()
+	maxTaskNum := queue.getMaxTaskNum()
+	if maxTaskNum <= 0 {
+		return 1
+	}
+	return float64(num) / float64(maxTaskNum)
+}
+
+// shed returns a RateLimit error if the proxy is overloaded and the task of the name is of low priority,
+// it's checked by the gRPC handlers before the task is created.
+func (queue *dqTaskQueue) shed(name string) error {
+	return queue.shedder.shed(name)
+}
+
+// admit fails fast if the in-flight results exceed the ceiling, the shard results of the task are accounted then.
+func (queue *dqTaskQueue) admit(t task) error {
+	if err := queue.resultMemory.admit(t.Name()); err != nil {
+		return err
+	}
+	if a, ok := t.(resultMemoryAccountable); ok {
+		a.setResultMemory(queue.resultMemory.newAccount(t.Name()))
+	}
+	return nil
+}
+
+// Enqueue admits the task by the in-flight results before queueing it.
+func (queue *dqTaskQueue) Enqueue(t task) error {
+	if err := queue.admit(t); err != nil {
+		return err
+	}
+	queue.timesLock.Lock()
+	queue.enqueueTimes[t] = time.Now()
+	queue.timesLock.Unlock()
+	err := queue.baseTaskQueue.Enqueue(t)
+	if err != nil {
+		queue.timesLock.Lock()
+		delete(queue.enqueueTimes, t)
+		queue.timesLock.Unlock()
+	}
+	return err
+}
+
+// PopUnissuedTask pops the first task of the priority band picked by the weighted round robin, the tasks of the same
+// priority are popped in the order they are enqueued.
+func (queue *dqTaskQueue) PopUnissuedTask() task {
+	queue.utLock.Lock()
+	var (
+		fronts [numRequestPriorities]*list.Element
+		queued [numRequestPriorities]bool
+		found  int
+	)
+	for e := queue.unissuedTasks.Front(); e != nil && found < numRequestPriorities; e = e.Next() {
+		if p := taskRequestPriority(e.Value.(task)); fronts[p] == nil {
+			fronts[p], queued[p] = e, true
+			found++
+		}
+	}
+	p, ok := queue.picker.pick(queued)
+	if !ok {
+		queue.utLock.Unlock()
+		return nil
+	}
+	t := queue.unissuedTasks.Remove(fronts[p]).(task)
+	queue.utLock.Unlock()
+
+	queue.timesLock.Lock()
+	enqueueTime, ok := queue.enqueueTimes[t]
+	delete(queue.enqueueTimes, t)
+	queue.timesLock.Unlock()
+	if ok {
+		queryType := metrics.QueryLabel
+		if t.Name() == SearchTaskName {
+			queryType = metrics.SearchLabel
+		}
+		metrics.ProxyDQLQueueLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), queryType,
+			p.String()).Observe(float64(time.Since(enqueueTime).Milliseconds()))
+	}
+	return t
+}
+
+// PopActiveTask releases the shard results accounted to the task once it's done.
+func (queue *dqTaskQueue) PopActiveTask(taskID UniqueID) task {
+	t := queue.baseTaskQueue.PopActiveTask(taskID)
+	if a, ok := t.(resultMemoryAccountable); ok {
+		a.getResultMemory().release()
+	}
+	return t
 }
 
 func (queue *ddTaskQueue) Enqueue(t task) error {
+	// wait for the concurrency slot before holding the lock, so that other kinds of tasks can still be enqueued.
+	if err := queue.limiter.acquire(t.TraceCtx(), t.Name()); err != nil {
+		return err
+	}
+	keys := ddTaskKeys(t)
+	queue.keysLock.Lock()
+	queue.taskKeys[t] = keys
+	queue.keysLock.Unlock()
+
 	queue.lock.Lock()
 	defer queue.lock.Unlock()
-	return queue.baseTaskQueue.Enqueue(t)
+	err := queue.baseTaskQueue.Enqueue(t)
+	if err != nil {
+		queue.limiter.release(t.Name())
+		queue.keysLock.Lock()
+		delete(queue.taskKeys, t)
+		queue.keysLock.Unlock()
+	}
+	return err
+}
+
+// popAndSchedule pops the first unissued task and appends it to the serializer, it returns the channels to wait
+// before executing the task and the function to call once the task is done. The task is nil if none is queued.
+func (queue *ddTaskQueue) popAndSchedule() (task, []chan struct{}, func()) {
+	queue.popLock.Lock()
+	defer queue.popLock.Unlock()
+	t := queue.PopUnissuedTask()
+	if t == nil {
+		return nil, nil, nil
+	}
+	queue.keysLock.Lock()
+	keys, ok := queue.taskKeys[t]
+	delete(queue.taskKeys, t)
+	queue.keysLock.Unlock()
+	if !ok {
+		keys = []string{""}
+	}
+	prev, done := queue.serializer.schedule(keys)
+	return t, prev, done
+}
+
+// taskDone releases the concurrency slot taken by the task.
+func (queue *ddTaskQueue) taskDone(t task) {
+	queue.limiter.release(t.Name())
+}
+
+func (queue *ddTaskQueue) priority(t task) int {
+	return queue.priorities[strings.ToLower(t.Name())]
+}
+
+// follows reports whether the task must be popped after the queued one, i.e. the queued one is of higher or
+// the same priority, or they share a serializing key. So the tasks of higher priority are popped ahead of the
+// others, but never ahead of the queued tasks of the same collection.
+func (queue *ddTaskQueue) follows(t, queued task) bool {
+	if queue.priority(queued) >= queue.priority(t) {
+		return true
+	}
+	queue.keysLock.Lock()
+	defer queue.keysLock.Unlock()
+	for _, key := range queue.taskKeys[t] {
+		for _, queuedKey := range queue.taskKeys[queued] {
+			if key == queuedKey {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func newDdTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *ddTaskQueue {
-	return &ddTaskQueue{
+	queue := &ddTaskQueue{
 		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+		limiter:       newTaskConcurrencyLimiter(Params.ProxyCfg.DDLConcurrencyLimits),
+		priorities:    Params.ProxyCfg.DDLPriorities,
+		serializer:    newDdTaskSerializer(),
+		taskKeys:      make(map[task][]string),
 	}
+	queue.baseTaskQueue.follows = queue.follows
+	return queue
 }
 
 func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dmTaskQueue {
@@ -363,9 +583,13 @@ func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInte
 }
 
 func newDqTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dqTaskQueue {
-	return &dqTaskQueue{
+	queue := &dqTaskQueue{
 		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+		enqueueTimes:  make(map[task]time.Time),
 	}
+	queue.shedder = newLoadShedder(queue.usage)
+	queue.resultMemory = newResultMemoryTracker()
+	return queue
 }
 
 // taskScheduler schedules the gRPC tasks.
@@ -406,10 +630,6 @@ func newTaskScheduler(ctx context.Context,
 	return s, nil
 }
 
-func (sched *taskScheduler) scheduleDdTask() task {
-	return sched.ddQueue.PopUnissuedTask()
-}
-
 func (sched *taskScheduler) scheduleDmTask() task {
 	return sched.dmQueue.PopUnissuedTask()
 }
@@ -431,6 +651,26 @@ func (sched *taskScheduler) getTaskByReqID(reqID UniqueID) task {
 	return nil
 }
 
+// dqTaskCtxKey marks the context of the dq task being executed.
+type dqTaskCtxKey struct{}
+
+// enqueueDqTask enqueues the dq task, or executes it right away if it's issued by a dq task being executed, e.g. the
+// query of the vectors to re-rank the results of a search by MMR. Otherwise all the dq workers could be taken by the
+// tasks waiting for their nested tasks, which never get a free worker.
+func (sched *taskScheduler) enqueueDqTask(ctx context.Context, t task) error {
+	if ctx.Value(dqTaskCtxKey{}) == nil {
+		return sched.dqQueue.Enqueue(t)
+	}
+	if err := sched.dqQueue.admit(t); err != nil {
+		return err
+	}
+	if err := sched.dqQueue.prepare(t); err != nil {
+		return err
+	}
+	sched.processTask(t, sched.dqQueue)
+	return nil
+}
+
 func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
 		opentracing.Tags{
@@ -438,6 +678,9 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 			"ID":   t.ID(),
 		})
 	defer span.Finish()
+	if q == taskQueue(sched.dqQueue) {
+		ctx = context.WithValue(ctx, dqTaskCtxKey{}, struct{}{})
+	}
 	traceID, _, _ := trace.InfoFromSpan(span)
 
 	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
@@ -481,7 +724,10 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 }
 
-// definitionLoop schedules the ddl tasks.
+// definitionLoop is a worker of the ddl tasks, proxy.ddlWorkerNum of them are started. A worker pops a task only
+// when it's free, so the tasks wait in the queue in the order of priority. The tasks of the same collection are
+// executed in FIFO order, and the tasks of different collections are executed concurrently. A worker may wait for
+// the previous tasks of the same collection, they are popped earlier and executed by other workers.
 func (sched *taskScheduler) definitionLoop() {
 	defer sched.wg.Done()
 	for {
@@ -489,10 +735,16 @@ func (sched *taskScheduler) definitionLoop() {
 		case <-sched.ctx.Done():
 			return
 		case <-sched.ddQueue.utChan():
-			if !sched.ddQueue.utEmpty() {
-				t := sched.scheduleDdTask()
-				sched.processTask(t, sched.ddQueue)
+			t, prev, done := sched.ddQueue.popAndSchedule()
+			if t == nil {
+				continue
 			}
+			for _, ch := range prev {
+				<-ch
+			}
+			sched.processTask(t, sched.ddQueue)
+			sched.ddQueue.taskDone(t)
+			done()
 		}
 	}
 }
@@ -512,6 +764,8 @@ func (sched *taskScheduler) manipulationLoop() {
 	}
 }
 
+// queryLoop is a worker of the search and query tasks, proxy.dqlWorkerNum of them are started. A worker pops a task
+// only when it's free, so the tasks wait in the queue and are picked by their request priority.
 func (sched *taskScheduler) queryLoop() {
 	defer sched.wg.Done()
 
@@ -520,25 +774,29 @@ func (sched *taskScheduler) queryLoop() {
 		case <-sched.ctx.Done():
 			return
 		case <-sched.dqQueue.utChan():
-			if !sched.dqQueue.utEmpty() {
-				t := sched.scheduleDqTask()
-				go sched.processTask(t, sched.dqQueue)
-			} else {
+			t := sched.scheduleDqTask()
+			if t == nil {
 				log.Debug("query queue is empty ...")
+				continue
 			}
+			sched.processTask(t, sched.dqQueue)
 		}
 	}
 }
 
 func (sched *taskScheduler) Start() error {
-	sched.wg.Add(1)
-	go sched.definitionLoop()
+	for i := int64(0); i < Params.ProxyCfg.DDLWorkerNum; i++ {
+		sched.wg.Add(1)
+		go sched.definitionLoop()
+	}
 
 	sched.wg.Add(1)
 	go sched.manipulationLoop()
 
-	sched.wg.Add(1)
-	go sched.queryLoop()
+	for i := int64(0); i < Params.ProxyCfg.DQLWorkerNum; i++ {
+		sched.wg.Add(1)
+		go sched.queryLoop()
+	}
 
 	return nil
 }
@@ -18,11 +18,20 @@ package proxy
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestBaseTaskQueue(t *testing.T) {
@@ -183,6 +192,444 @@ func TestDdTaskQueue(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDdTaskQueue_ConcurrencyLimit(t *testing.T) {
+	Params.Init()
+
+	newTask := func(ctx context.Context, name string) *mockDdlTask {
+		task := newMockDdlTask(ctx)
+		task.name = name
+		return task
+	}
+
+	queue := newDdTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+	queue.limiter = newTaskConcurrencyLimiter(map[string]int{CreateIndexTaskName: 1})
+
+	createIndex := newTask(context.Background(), CreateIndexTaskName)
+	err := queue.Enqueue(createIndex)
+	assert.NoError(t, err)
+
+	// all the CreateIndex slots are taken, the next CreateIndex waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = queue.Enqueue(newTask(ctx, CreateIndexTaskName))
+	assert.Error(t, err)
+
+	// CreateCollection isn't blocked by the saturated CreateIndex
+	done := make(chan error, 1)
+	go func() {
+		done <- queue.Enqueue(newTask(context.Background(), CreateCollectionTaskName))
+	}()
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("CreateCollection is blocked by CreateIndex")
+	}
+
+	// the slot is available again after the task is done
+	queue.taskDone(createIndex)
+	err = queue.Enqueue(newTask(context.Background(), CreateIndexTaskName))
+	assert.NoError(t, err)
+
+	// the slot is released if the task failed to enqueue
+	queue.taskDone(createIndex)
+	queue.setMaxTaskNum(int64(queue.unissuedTasks.Len()))
+	err = queue.Enqueue(newTask(context.Background(), CreateIndexTaskName))
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(queue.limiter.slots["createindextask"]))
+}
+
+func TestProxy_DDLConcurrencyLimitFromConfig(t *testing.T) {
+	// the cleanups run in reverse order, so the params are reloaded after the env is restored
+	t.Cleanup(Params.Init)
+	t.Setenv("PROXY_DDLCONCURRENCYLIMITS", "CreateIndexTask:1")
+	Params.Init()
+	require.Equal(t, map[string]int{"createindextask": 1}, Params.ProxyCfg.DDLConcurrencyLimits)
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = nil
+
+	ctx := context.Background()
+	node := newFunctionCallTestProxy(t, newMockTsoAllocator())
+	createIndex := func(ctx context.Context) *commonpb.Status {
+		status, err := node.CreateIndex(ctx, &milvuspb.CreateIndexRequest{CollectionName: "coll", FieldName: "vec"})
+		assert.NoError(t, err)
+		return status
+	}
+	// the tasks are notified with an error instead of being executed, only the scheduling matters
+	popAndFinish := func(name string, done chan *commonpb.Status) {
+		var popped task
+		require.Eventually(t, func() bool {
+			popped = node.sched.ddQueue.PopUnissuedTask()
+			return popped != nil
+		}, time.Second, time.Millisecond, name)
+		assert.Equal(t, name, popped.Name())
+		node.sched.ddQueue.taskDone(popped)
+		popped.Notify(errors.New("mock"))
+		<-done
+	}
+
+	// the first CreateIndex takes the only slot until it's done
+	indexDone := make(chan *commonpb.Status, 1)
+	go func() {
+		indexDone <- createIndex(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return len(node.sched.ddQueue.limiter.slots["createindextask"]) == 1
+	}, time.Second, time.Millisecond)
+
+	// the next CreateIndex waits for the slot until its context is done
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.NotEqual(t, commonpb.ErrorCode_Success, createIndex(timeoutCtx).GetErrorCode())
+
+	// CreateCollection isn't blocked by the saturated CreateIndex
+	collectionDone := make(chan *commonpb.Status, 1)
+	go func() {
+		status, err := node.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		collectionDone <- status
+	}()
+	popAndFinish(CreateIndexTaskName, indexDone)
+	popAndFinish(CreateCollectionTaskName, collectionDone)
+}
+
+func TestDdTaskQueue_Priority(t *testing.T) {
+	Params.Init()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	globalMetaCache = nil
+
+	ctx := context.Background()
+	newTask := func(name string, collectionName string) *collectionDdlTask {
+		task := newCollectionDdlTask(ctx, collectionName, nil)
+		task.name = name
+		return task
+	}
+	popAll := func(queue *ddTaskQueue) []task {
+		var tasks []task
+		for t := queue.PopUnissuedTask(); t != nil; t = queue.PopUnissuedTask() {
+			tasks = append(tasks, t)
+		}
+		return tasks
+	}
+
+	t.Run("no priority", func(t *testing.T) {
+		queue := newDdTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		queue.priorities = map[string]int{}
+		tasks := []task{
+			newTask(CreateIndexTaskName, "c1"),
+			newTask(DropCollectionTaskName, "c2"),
+			newTask(CreateIndexTaskName, "c3"),
+		}
+		for _, task := range tasks {
+			require.NoError(t, queue.Enqueue(task))
+		}
+		assert.Equal(t, tasks, popAll(queue))
+	})
+
+	t.Run("high priority ahead", func(t *testing.T) {
+		queue := newDdTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		queue.priorities = map[string]int{"dropcollectiontask": 1, "releasecollectiontask": 1}
+		low1 := newTask(CreateIndexTaskName, "c1")
+		low2 := newTask(CreateIndexTaskName, "c2")
+		low3 := newTask(LoadCollectionTaskName, "c3")
+		high1 := newTask(DropCollectionTaskName, "c4")
+		high2 := newTask(ReleaseCollectionTaskName, "c5")
+		// the queued task of the same collection is still popped first
+		high3 := newTask(DropCollectionTaskName, "c2")
+		low4 := newTask(CreateIndexTaskName, "c6")
+		for _, task := range []task{low1, low2, low3, high1, high2, high3, low4} {
+			require.NoError(t, queue.Enqueue(task))
+		}
+		assert.Equal(t, []task{high1, high2, low1, low2, high3, low3, low4}, popAll(queue))
+		assert.Equal(t, 7, len(queue.utChan()))
+	})
+}
+
+func TestTaskScheduler_DdlPriority(t *testing.T) {
+	Params.Init()
+	defer func(workerNum int64, priorities map[string]int) {
+		Params.ProxyCfg.DDLWorkerNum, Params.ProxyCfg.DDLPriorities = workerNum, priorities
+	}(Params.ProxyCfg.DDLWorkerNum, Params.ProxyCfg.DDLPriorities)
+	Params.ProxyCfg.DDLWorkerNum = 1
+	Params.ProxyCfg.DDLPriorities = map[string]int{"dropcollectiontask": 1}
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	globalMetaCache = nil
+
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	require.NoError(t, sched.Start())
+	defer sched.Close()
+
+	var mu sync.Mutex
+	var order []string
+	newTask := func(name string, collectionName string, execute func()) *collectionDdlTask {
+		task := newCollectionDdlTask(ctx, collectionName, func() {
+			mu.Lock()
+			order = append(order, collectionName)
+			mu.Unlock()
+			if execute != nil {
+				execute()
+			}
+		})
+		task.name = name
+		return task
+	}
+
+	// the only worker is busy, so the tasks enqueued later wait in the queue
+	release := make(chan struct{})
+	started := make(chan struct{})
+	blocking := newTask(CreateIndexTaskName, "c0", func() {
+		close(started)
+		<-release
+	})
+	require.NoError(t, sched.ddQueue.Enqueue(blocking))
+	<-started
+	tasks := []*collectionDdlTask{
+		blocking,
+		newTask(CreateIndexTaskName, "c1", nil),
+		newTask(CreateIndexTaskName, "c2", nil),
+		newTask(DropCollectionTaskName, "c3", nil),
+	}
+	for _, task := range tasks[1:] {
+		require.NoError(t, sched.ddQueue.Enqueue(task))
+	}
+
+	close(release)
+	for _, task := range tasks {
+		require.NoError(t, task.WaitToFinish())
+	}
+	// the task of higher priority is executed ahead of the ones enqueued before it
+	assert.Equal(t, []string{"c0", "c3", "c1", "c2"}, order)
+}
+
+func TestTaskConcurrencyLimiter(t *testing.T) {
+	var nilLimiter *taskConcurrencyLimiter
+	assert.NoError(t, nilLimiter.acquire(context.Background(), CreateIndexTaskName))
+	nilLimiter.release(CreateIndexTaskName)
+
+	limiter := newTaskConcurrencyLimiter(map[string]int{"CreateIndexTask": 2, "LoadCollectionTask": 0})
+	assert.Equal(t, 1, len(limiter.slots))
+
+	// tasks without limit are never blocked
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, limiter.acquire(context.Background(), LoadCollectionTaskName))
+	}
+
+	assert.NoError(t, limiter.acquire(context.Background(), CreateIndexTaskName))
+	assert.NoError(t, limiter.acquire(context.Background(), "createindextask"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, limiter.acquire(ctx, CreateIndexTaskName))
+
+	limiter.release(CreateIndexTaskName)
+	assert.NoError(t, limiter.acquire(context.Background(), CreateIndexTaskName))
+}
+
+func TestDdTaskSerializer(t *testing.T) {
+	serializer := newDdTaskSerializer()
+
+	prev1, done1 := serializer.schedule([]string{"c1"})
+	assert.Empty(t, prev1)
+	prev2, done2 := serializer.schedule([]string{"c2", "c2"})
+	assert.Empty(t, prev2)
+	// waits for both c1 and c2
+	prev3, done3 := serializer.schedule([]string{"c1", "c2"})
+	assert.Len(t, prev3, 2)
+	// waits for the last task of c1 only
+	prev4, done4 := serializer.schedule([]string{"c1"})
+	assert.Len(t, prev4, 1)
+
+	done1()
+	done2()
+	for _, ch := range prev3 {
+		<-ch
+	}
+	select {
+	case <-prev4[0]:
+		t.Fatal("the previous task of c1 is not done")
+	default:
+	}
+	done3()
+	<-prev4[0]
+	done4()
+	assert.Empty(t, serializer.tails)
+}
+
+func TestDdTaskKeys(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		if collectionName == "alias" || collectionName == "coll" {
+			return &schemapb.CollectionSchema{Name: "coll"}, nil
+		}
+		return nil, errors.New("collection not found")
+	})
+	globalMetaCache = mockCache
+
+	assert.Equal(t, []string{""}, ddTaskKeys(newDefaultMockDdlTask()))
+	assert.Equal(t, []string{"coll"}, ddTaskKeys(newCollectionDdlTask(context.Background(), "alias", nil)))
+	assert.Equal(t, []string{"new_coll"}, ddTaskKeys(newCollectionDdlTask(context.Background(), "new_coll", nil)))
+	assert.Equal(t, []string{"coll", "new_alias"}, ddTaskKeys(&CreateAliasTask{
+		CreateAliasRequest: &milvuspb.CreateAliasRequest{CollectionName: "coll", Alias: "new_alias"},
+	}))
+	assert.Equal(t, []string{"coll"}, ddTaskKeys(&DropAliasTask{
+		DropAliasRequest: &milvuspb.DropAliasRequest{Alias: "alias"},
+	}))
+	assert.Equal(t, []string{"coll", "c2"}, ddTaskKeys(&flushTask{
+		FlushRequest: &milvuspb.FlushRequest{CollectionNames: []string{"alias", "c2"}},
+	}))
+}
+
+// collectionDdlTask is a ddl task of the collection, calling execute in Execute.
+type collectionDdlTask struct {
+	*mockDdlTask
+	collectionName string
+	execute        func()
+}
+
+func newCollectionDdlTask(ctx context.Context, collectionName string, execute func()) *collectionDdlTask {
+	return &collectionDdlTask{
+		mockDdlTask:    newMockDdlTask(ctx),
+		collectionName: collectionName,
+		execute:        execute,
+	}
+}
+
+func (t *collectionDdlTask) GetCollectionName() string {
+	return t.collectionName
+}
+
+func (t *collectionDdlTask) Execute(ctx context.Context) error {
+	if t.execute != nil {
+		t.execute()
+	}
+	return nil
+}
+
+func TestTaskScheduler_DdlSerialization(t *testing.T) {
+	Params.Init()
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		if collectionName == "alias" || collectionName == "coll" {
+			return &schemapb.CollectionSchema{Name: "coll"}, nil
+		}
+		return nil, errors.New("collection not found")
+	})
+	globalMetaCache = mockCache
+
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	require.NoError(t, sched.Start())
+	defer sched.Close()
+
+	t.Run("same collection in order", func(t *testing.T) {
+		var mu sync.Mutex
+		var order []int
+		tasks := make([]*collectionDdlTask, 0, 10)
+		for i := 0; i < 10; i++ {
+			i := i
+			name := "coll"
+			if i%2 == 1 {
+				name = "alias"
+			}
+			task := newCollectionDdlTask(ctx, name, func() {
+				// give the later tasks a chance to overtake if they were not serialized
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, i)
+			})
+			require.NoError(t, sched.ddQueue.Enqueue(task))
+			tasks = append(tasks, task)
+		}
+		for _, task := range tasks {
+			require.NoError(t, task.WaitToFinish())
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, order)
+	})
+
+	t.Run("different collections in parallel", func(t *testing.T) {
+		release := make(chan struct{})
+		var aliasStarted bool
+		var mu sync.Mutex
+		blocking := newCollectionDdlTask(ctx, "coll", func() { <-release })
+		sameCollection := newCollectionDdlTask(ctx, "alias", func() {
+			mu.Lock()
+			defer mu.Unlock()
+			aliasStarted = true
+		})
+		otherCollection := newCollectionDdlTask(ctx, "other", nil)
+		require.NoError(t, sched.ddQueue.Enqueue(blocking))
+		require.NoError(t, sched.ddQueue.Enqueue(sameCollection))
+		require.NoError(t, sched.ddQueue.Enqueue(otherCollection))
+
+		// the task of the other collection isn't blocked
+		finished := make(chan error, 1)
+		go func() { finished <- otherCollection.WaitToFinish() }()
+		select {
+		case err := <-finished:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the ddl of another collection is blocked")
+		}
+
+		// the task of the alias waits for the blocking task of the same collection
+		time.Sleep(50 * time.Millisecond)
+		mu.Lock()
+		assert.False(t, aliasStarted)
+		mu.Unlock()
+
+		close(release)
+		require.NoError(t, blocking.WaitToFinish())
+		require.NoError(t, sameCollection.WaitToFinish())
+		assert.True(t, aliasStarted)
+	})
+}
+
+func TestTaskScheduler_DdlWorkers(t *testing.T) {
+	Params.Init()
+	defer func(workerNum int64) { Params.ProxyCfg.DDLWorkerNum = workerNum }(Params.ProxyCfg.DDLWorkerNum)
+	Params.ProxyCfg.DDLWorkerNum = 2
+
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	require.NoError(t, sched.Start())
+	defer sched.Close()
+
+	release := make(chan struct{})
+	var started atomic.Int32
+	tasks := make([]*collectionDdlTask, 0, 4)
+	for i := 0; i < 4; i++ {
+		task := newCollectionDdlTask(ctx, fmt.Sprintf("coll%d", i), func() {
+			started.Inc()
+			<-release
+		})
+		require.NoError(t, sched.ddQueue.Enqueue(task))
+		tasks = append(tasks, task)
+	}
+
+	// the tasks of different collections are executed by no more than ddlWorkerNum workers
+	assert.Eventually(t, func() bool { return started.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), started.Load())
+	assert.False(t, sched.ddQueue.utEmpty())
+
+	close(release)
+	for _, task := range tasks {
+		require.NoError(t, task.WaitToFinish())
+	}
+	assert.Equal(t, int32(4), started.Load())
+}
+
 // test the logic of queue
 func TestDmTaskQueue_Basic(t *testing.T) {
 	Params.Init()
@@ -383,6 +830,167 @@ func TestDqTaskQueue(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+type prioritizedMockDqlTask struct {
+	*mockDqlTask
+	priority requestPriority
+	execute  func(ctx context.Context)
+}
+
+func (t *prioritizedMockDqlTask) getRequestPriority() requestPriority {
+	return t.priority
+}
+
+func (t *prioritizedMockDqlTask) Execute(ctx context.Context) error {
+	if t.execute != nil {
+		t.execute(ctx)
+	}
+	return nil
+}
+
+func TestDqTaskQueue_Priority(t *testing.T) {
+	Params.Init()
+
+	newTask := func(priority requestPriority) *prioritizedMockDqlTask {
+		return &prioritizedMockDqlTask{mockDqlTask: newDefaultMockDqlTask(), priority: priority}
+	}
+	popAll := func(queue *dqTaskQueue) []task {
+		var tasks []task
+		for t := queue.PopUnissuedTask(); t != nil; t = queue.PopUnissuedTask() {
+			tasks = append(tasks, t)
+		}
+		return tasks
+	}
+
+	t.Run("same priority in order", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		tasks := []task{newTask(requestPriorityLow), newTask(requestPriorityLow), newDefaultMockDqlTask(), newTask(requestPriorityLow)}
+		for _, task := range tasks {
+			require.NoError(t, queue.Enqueue(task))
+		}
+		// the task without a priority is of normal priority
+		assert.Equal(t, []task{tasks[2], tasks[0], tasks[1], tasks[3]}, popAll(queue))
+		assert.Empty(t, queue.enqueueTimes)
+	})
+
+	t.Run("weighted under mixed load", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		// the batch jobs of low priority are queued ahead of the interactive ones
+		var queued [numRequestPriorities][]task
+		for i := 0; i < 16; i++ {
+			for _, priority := range []requestPriority{requestPriorityLow, requestPriorityNormal, requestPriorityHigh} {
+				task := newTask(priority)
+				require.NoError(t, queue.Enqueue(task))
+				queued[priority] = append(queued[priority], task)
+			}
+		}
+
+		popped := popAll(queue)
+		require.Equal(t, 48, len(popped))
+		// a round of the weights pops 8 high, 4 normal and 1 low tasks
+		var counts [numRequestPriorities]int
+		for _, task := range popped[:13] {
+			counts[taskRequestPriority(task)]++
+		}
+		assert.Equal(t, requestPriorityWeights, counts)
+		// the first task popped is of high priority, and the low priority tasks aren't starved
+		assert.Equal(t, requestPriorityHigh, taskRequestPriority(popped[0]))
+
+		// the tasks of each band are popped in the order they are enqueued
+		var poppedByPriority [numRequestPriorities][]task
+		for _, task := range popped {
+			p := taskRequestPriority(task)
+			poppedByPriority[p] = append(poppedByPriority[p], task)
+		}
+		assert.Equal(t, queued, poppedByPriority)
+	})
+
+	t.Run("high priority not blocked", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+		for i := 0; i < 10; i++ {
+			require.NoError(t, queue.Enqueue(newTask(requestPriorityLow)))
+		}
+		high := newTask(requestPriorityHigh)
+		require.NoError(t, queue.Enqueue(high))
+		assert.Equal(t, high, queue.PopUnissuedTask())
+	})
+}
+
+func TestTaskScheduler_DqlWorkers(t *testing.T) {
+	Params.Init()
+	defer func(workerNum int64) { Params.ProxyCfg.DQLWorkerNum = workerNum }(Params.ProxyCfg.DQLWorkerNum)
+	Params.ProxyCfg.DQLWorkerNum = 1
+
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	require.NoError(t, sched.Start())
+	defer sched.Close()
+
+	var mu sync.Mutex
+	var order []int
+	newTask := func(i int, priority requestPriority, execute func(ctx context.Context)) *prioritizedMockDqlTask {
+		return &prioritizedMockDqlTask{
+			mockDqlTask: newDefaultMockDqlTask(),
+			priority:    priority,
+			execute: func(ctx context.Context) {
+				mu.Lock()
+				order = append(order, i)
+				mu.Unlock()
+				if execute != nil {
+					execute(ctx)
+				}
+			},
+		}
+	}
+
+	t.Run("picked by priority", func(t *testing.T) {
+		order = nil
+		// the only worker is busy, so the tasks enqueued later wait in the queue
+		release := make(chan struct{})
+		started := make(chan struct{})
+		tasks := []*prioritizedMockDqlTask{
+			newTask(0, requestPriorityHigh, func(ctx context.Context) {
+				close(started)
+				<-release
+			}),
+			newTask(1, requestPriorityLow, nil),
+			newTask(2, requestPriorityLow, nil),
+			newTask(3, requestPriorityHigh, nil),
+		}
+		require.NoError(t, sched.dqQueue.Enqueue(tasks[0]))
+		<-started
+		for _, task := range tasks[1:] {
+			require.NoError(t, sched.dqQueue.Enqueue(task))
+		}
+
+		close(release)
+		for _, task := range tasks {
+			require.NoError(t, task.WaitToFinish())
+		}
+		assert.Equal(t, []int{0, 3, 1, 2}, order)
+	})
+
+	t.Run("nested task not waiting for a worker", func(t *testing.T) {
+		order = nil
+		nested := newTask(1, requestPriorityNormal, nil)
+		outer := newTask(0, requestPriorityNormal, func(ctx context.Context) {
+			assert.NoError(t, sched.enqueueDqTask(ctx, nested))
+			assert.NoError(t, nested.WaitToFinish())
+		})
+		require.NoError(t, sched.enqueueDqTask(ctx, outer))
+
+		finished := make(chan error, 1)
+		go func() { finished <- outer.WaitToFinish() }()
+		select {
+		case err := <-finished:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the nested task waits for the worker taken by its issuer")
+		}
+		assert.Equal(t, []int{0, 1}, order)
+	})
+}
+
 func TestTaskScheduler(t *testing.T) {
 	Params.Init()
 
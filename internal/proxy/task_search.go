@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"runtime"
+	"sort"
 	"strconv"
-
-	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -40,6 +43,7 @@ type searchTask struct {
 	result         *milvuspb.SearchResults
 	request        *milvuspb.SearchRequest
 	qc             types.QueryCoord
+	ic             types.IndexCoord
 	tr             *timerecord.TimeRecorder
 	collectionName string
 	schema         *schemapb.CollectionSchema
@@ -48,18 +52,60 @@ type searchTask struct {
 	resultBuf       chan *internalpb.SearchResults
 	toReduceResults []*internalpb.SearchResults
 
+	// resultSizeInBytes is the size of the result sent back to client, set in PostExecute.
+	resultSizeInBytes int
+	// resultMemory accounts the shard results, set when the task is enqueued.
+	resultMemory *resultMemoryAccount
+	// priority is hinted by the request, set when the task is enqueued.
+	priority requestPriority
+
 	searchShardPolicy pickShardPolicy
 	shardMgr          *shardClientMgr
+
+	// queryPKsAt looks up the result primary keys to filter the deleted entities if Params.ProxyCfg.SearchDeleteCheck
+	queryPKsAt pkQueryAtFunc
+	// queryVectorsAt fetches the vectors of the results to re-rank them by MMR
+	queryVectorsAt vectorQueryAtFunc
+	// mmrField is the vector field the results are re-ranked by, it's nil unless MMRLambdaKey is in the search params
+	mmrField  *schemapb.FieldSchema
+	mmrLambda float64
+	// ranker re-orders the results by a scalar field, it's nil unless RankerKey is in the search params
+	ranker *scalarRanker
+	// executionInfo is the time breakdown of the search, it's nil unless ReturnExecutionInfoKey is true
+	executionInfo *searchExecutionInfo
+	// consistencyInfo is the timestamps the search is served at, it's nil unless ConsistencyCheckKey is true
+	consistencyInfo *searchConsistencyInfo
+	// iterator skips the results returned by the previous batches, it's nil unless SearchIteratorKey is true or
+	// SearchIteratorTokenKey is set
+	iterator *searchIterator
+	// shardDeadline is the deadline of the search requests to query nodes, it's zero if the client sets no deadline
+	shardDeadline time.Time
+	// bruteForce is set if the searched vector field has no index, see Params.ProxyCfg.SearchWithoutIndex
+	bruteForce bool
+}
+
+// searchReduceBudgetRatio is the ratio of the time left before the client deadline that is reserved for proxy to
+// reduce the search results, query nodes have to return before the rest of the time is used up.
+const searchReduceBudgetRatio = 0.1
+
+// deriveShardDeadline returns the deadline of the search requests to query nodes derived from the client deadline,
+// so that query nodes abort early instead of searching on after the results are of no use to the client.
+func deriveShardDeadline(now time.Time, clientDeadline time.Time) time.Time {
+	budget := clientDeadline.Sub(now)
+	if budget <= 0 {
+		return clientDeadline
+	}
+	return now.Add(budget - time.Duration(float64(budget)*searchReduceBudgetRatio))
 }
 
-func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
+func getPartitionIDs(ctx context.Context, database, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
 	for _, tag := range partitionNames {
 		if err := validatePartitionTag(tag, false); err != nil {
 			return nil, err
 		}
 	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +201,7 @@ func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string)
 		hitField := false
 		for _, field := range schema.GetFields() {
 			if field.Name == name {
-				if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+				if typeutil.IsVectorType(field.DataType) {
 					return nil, errors.New("search doesn't support vector field as output_fields")
 				}
 				outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
@@ -172,21 +218,65 @@ func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string)
 	return outputFieldIDs, nil
 }
 
+// validateSearchOutputFields checks the output fields exist in the schema and don't exceed the configured cap,
+// so that a search which would fail downstream or return a huge payload is rejected early.
+func validateSearchOutputFields(schema *schemapb.CollectionSchema, outputFields []string) error {
+	if maxNum := Params.ProxyCfg.MaxOutputFieldNum; maxNum > 0 && len(outputFields) > maxNum {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"the number of output fields %d exceeds the limit %d", len(outputFields), maxNum)
+	}
+	fieldNames := make(map[string]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldNames[field.GetName()] = struct{}{}
+	}
+	for _, name := range outputFields {
+		if _, ok := fieldNames[name]; !ok {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"output field %s does not exist in collection %s", name, schema.GetName())
+		}
+	}
+	return nil
+}
+
+// getNq returns the number of the search vectors in the placeholder group of the request. The vectors must be in
+// a single placeholder, since all of them are searched with the same search params and query nodes search only the
+// first placeholder. The nq declared by the request, if set, must match the vectors.
 func getNq(req *milvuspb.SearchRequest) (int64, error) {
-	if req.GetNq() == 0 {
-		// keep compatible with older client version.
-		x := &commonpb.PlaceholderGroup{}
-		err := proto.Unmarshal(req.GetPlaceholderGroup(), x)
-		if err != nil {
-			return 0, err
+	header, err := parsePlaceholderGroupHeader(req.GetPlaceholderGroup())
+	if err != nil {
+		return 0, err
+	}
+	if header.placeholders > 1 {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"placeholder group has %d placeholders, searching the vectors of multiple placeholders with different search params isn't supported, "+
+				"put all the vectors in one placeholder or send a search for each", header.placeholders)
+	}
+	// nq isn't set by older client versions
+	if req.GetNq() != 0 && req.GetNq() != header.nq {
+		return 0, newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"nq %d of the request mismatches the %d search vectors in the placeholder group", req.GetNq(), header.nq)
+	}
+	return header.nq, nil
+}
+
+// validateSearchVectors checks the search vectors of the request against the searched field and proxy.maxSearchNq,
+// the vectors of the rejected request are recorded as abandoned.
+func validateSearchVectors(field *schemapb.FieldSchema, req *milvuspb.SearchRequest) error {
+	err := validateVectorPlaceholderGroup(field, req.GetPlaceholderGroup())
+	if err == nil {
+		var nq int64
+		if nq, err = getNq(req); err == nil {
+			err = validateNq(nq)
 		}
-		total := int64(0)
-		for _, h := range x.GetPlaceholders() {
-			total += int64(len(h.Values))
+	}
+	if err != nil {
+		if header, parseErr := parsePlaceholderGroupHeader(req.GetPlaceholderGroup()); parseErr == nil {
+			metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
+				metrics.AbandonLabel).Add(float64(header.nq))
 		}
-		return total, nil
+		return err
 	}
-	return req.GetNq(), nil
+	return nil
 }
 
 func (t *searchTask) PreExecute(ctx context.Context) error {
@@ -194,7 +284,33 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	defer sp.Finish()
 
 	if t.searchShardPolicy == nil {
-		t.searchShardPolicy = mergeRoundRobinPolicy
+		policy, err := selectShardPolicy(t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+		t.searchShardPolicy = policy
+	}
+	excludedNodes, err := parseExcludeNodeIDs(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if len(excludedNodes) > 0 {
+		t.searchShardPolicy = excludeNodesPolicy(t.searchShardPolicy, excludedNodes)
+	}
+	returnExecutionInfo, err := parseReturnExecutionInfo(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if returnExecutionInfo {
+		// the time recorder starts when the task is created right before it's enqueued
+		t.executionInfo = &searchExecutionInfo{EnqueueWaitMs: durationMs(t.tr.ElapseSpan())}
+	}
+	consistencyCheck, err := parseConsistencyCheck(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if consistencyCheck {
+		t.consistencyInfo = &searchConsistencyInfo{}
 	}
 
 	t.Base.MsgType = commonpb.MsgType_Search
@@ -202,36 +318,51 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 	collectionName := t.request.CollectionName
 	t.collectionName = collectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
+	if err := checkCollectionEnabled(ctx, t.request.GetDbName(), collectionName); err != nil {
+		return err
+	}
 
 	t.SearchRequest.DbID = 0 // todo
 	t.SearchRequest.CollectionID = collID
-	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if t.searchShardPolicy, err = pinReplicaGroup(ctx, t.searchShardPolicy, t.request.GetSearchParams(),
+		t.qc, collectionName, collID); err != nil {
+		return err
+	}
+	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 
 	// translate partition name to partition ids. Use regex-pattern to match partition name.
-	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		return err
 	}
 
 	// check if collection/partitions are loaded into query node
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.SearchRequest.GetPartitionIDs())
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.SearchRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when search, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
 	if !loaded {
-		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when search", collectionName, t.request.GetPartitionNames())
+		return errCollectionNotLoaded(collectionName, t.request.GetPartitionNames())
 	}
 
-	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, false)
+	includeVectors, err := parseIncludeVectors(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	// the primary key is always returned, so that clients can join the results with their own data
+	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, true, includeVectors)
 	if err != nil {
 		return err
 	}
 	log.Ctx(ctx).Debug("translate output fields", zap.Int64("msgID", t.ID()),
 		zap.Strings("output fields", t.request.GetOutputFields()))
+	if err := validateSearchOutputFields(t.schema, t.request.GetOutputFields()); err != nil {
+		return err
+	}
 
 	if t.request.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, t.request.GetSearchParams())
@@ -239,23 +370,90 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(AnnsFieldKey + " not found in search_params")
 		}
 
+		t.request.SearchParams, err = normalizeSearchParams(t.request.GetSearchParams(), Params.ProxyCfg.SearchParamsStrict)
+		if err != nil {
+			return err
+		}
+		searchParams, _ := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, t.request.GetSearchParams())
+		log.Ctx(ctx).Debug("normalize search params", zap.Int64("msgID", t.ID()), zap.String("params", searchParams))
+
 		queryInfo, offset, err := parseQueryInfo(t.request.GetSearchParams())
 		if err != nil {
 			return err
 		}
 		t.offset = offset
 
-		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
+		t.iterator, err = parseSearchIterator(t.request.GetSearchParams(), collID, time.Now())
+		if err != nil {
+			return err
+		}
+		if t.iterator != nil {
+			if err := t.iterator.prepare(queryInfo, offset); err != nil {
+				return err
+			}
+		}
+
+		lambda, mmr, err := parseMMRLambda(t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+		if mmr && t.iterator != nil {
+			// the results re-ranked by MMR aren't ordered by score, the boundaries of the batches don't hold
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is not supported with %s", MMRLambdaKey, SearchIteratorKey)
+		}
+		if mmr {
+			if t.queryVectorsAt == nil {
+				return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is not supported by this search", MMRLambdaKey)
+			}
+			t.mmrField, err = validateMMRField(t.schema, annsField, queryInfo.GetMetricType())
+			if err != nil {
+				return err
+			}
+			t.mmrLambda = lambda
+		}
+		t.ranker, err = parseRanker(t.schema, t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+		if t.ranker != nil {
+			if t.iterator != nil {
+				// the results re-ranked aren't ordered by score, the boundaries of the batches don't hold
+				return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "%s is not supported with %s", RankerKey, SearchIteratorKey)
+			}
+			t.request.OutputFields = t.ranker.addOutputField(t.request.GetOutputFields())
+		}
+
+		t.request.Dsl, err = fillExpressionTemplate(t.schema, t.request.Dsl, t.request.GetExprTemplateValues())
+		if err != nil {
+			return err
+		}
+		plan, err := createSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err), zap.Int64("msgID", t.ID()),
 				zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 				zap.String("anns field", annsField), zap.Any("query info", queryInfo))
-			return fmt.Errorf("failed to create query plan: %v", err)
+			return fmt.Errorf("failed to create query plan: %w", err)
 		}
 		log.Ctx(ctx).Debug("create query plan", zap.Int64("msgID", t.ID()),
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
+		schemaHelper, err := typeutil.CreateSchemaHelper(t.schema)
+		if err != nil {
+			return err
+		}
+		vectorField, err := schemaHelper.GetFieldFromName(annsField)
+		if err != nil {
+			return err
+		}
+		if err := validateSearchVectors(vectorField, t.request); err != nil {
+			return err
+		}
+
+		if err := t.checkVectorIndex(ctx, vectorField); err != nil {
+			return err
+		}
+
 		outputFieldIDs, err := getOutputFieldIDs(t.schema, t.request.GetOutputFields())
 		if err != nil {
 			return err
@@ -287,21 +485,31 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	}
 	t.SearchRequest.TravelTimestamp = travelTimestamp
 
-	guaranteeTs := t.request.GetGuaranteeTimestamp()
-	guaranteeTs = parseGuaranteeTs(guaranteeTs, t.BeginTs())
+	guaranteeTs, err := resolveGuaranteeTs(ctx, t.request.GetDbName(), collectionName, t.request.GetGuaranteeTimestamp(),
+		t.request.GetUseDefaultConsistency(), t.BeginTs())
+	if err != nil {
+		return err
+	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
-		t.SearchRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
+		t.shardDeadline = deriveShardDeadline(time.Now(), deadline)
+		t.SearchRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(t.shardDeadline, 0)
 	}
 
 	t.SearchRequest.Dsl = t.request.Dsl
+	// the placeholder group is forwarded to query nodes as is, it's never unmarshalled in proxy.
 	t.SearchRequest.PlaceholderGroup = t.request.PlaceholderGroup
 	nq, err := getNq(t.request)
 	if err != nil {
 		return err
 	}
 	t.SearchRequest.Nq = nq
+	if t.iterator != nil {
+		if err := t.iterator.checkNq(nq); err != nil {
+			return err
+		}
+	}
 
 	log.Ctx(ctx).Debug("search PreExecute done.", zap.Int64("msgID", t.ID()),
 		zap.Uint64("travel_ts", travelTimestamp), zap.Uint64("guarantee_ts", guaranteeTs),
@@ -318,12 +526,14 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	defer tr.CtxElapse(ctx, "done")
 
 	executeSearch := func(withCache bool) error {
-		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
 		t.resultBuf = make(chan *internalpb.SearchResults, len(shard2Leaders))
 		t.toReduceResults = make([]*internalpb.SearchResults, 0, len(shard2Leaders))
+		t.executionInfo.resetShards()
+		t.consistencyInfo.resetShards()
 		if err := t.searchShardPolicy(ctx, t.shardMgr, t.searchShard, shard2Leaders); err != nil {
 			log.Ctx(ctx).Warn("failed to do search", zap.Error(err), zap.String("Shards", fmt.Sprintf("%v", shard2Leaders)))
 			return err
@@ -377,7 +587,13 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		log.Ctx(ctx).Warn("search result is empty", zap.Int64("msgID", t.ID()))
 
 		t.fillInEmptyResult(Nq)
-		return nil
+		t.result.BruteForce = t.bruteForce
+		t.fillInConsistencyInfo()
+		if err := t.fillInIteratorToken(); err != nil {
+			return err
+		}
+		t.recordCollectionMetrics(0)
+		return t.fillInExecutionInfo(0)
 	}
 
 	// Reduce all search results
@@ -388,27 +604,196 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
+	if t.iterator != nil {
+		skipped := 0
+		for _, data := range validSearchResults {
+			skipped += t.iterator.skipReturned(data)
+		}
+		log.Ctx(ctx).Debug("skip the results returned by the previous batches", zap.Int64("msgID", t.ID()), zap.Int("skipped", skipped))
+		// the topk searched is grown by the returned results
+		Topk = t.iterator.topk
+	}
+
 	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
 	if err != nil {
 		return err
 	}
 
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	reduceDuration := tr.RecordSpan()
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(reduceDuration.Milliseconds()))
+
+	if Params.ProxyCfg.SearchDeleteCheck && t.queryPKsAt != nil {
+		removed, err := filterDeletedSearchResults(ctx, t.request.GetDbName(), t.collectionName, primaryFieldSchema, t.result.GetResults(),
+			t.SearchRequest.GetTravelTimestamp(), t.SearchRequest.GetGuaranteeTimestamp(), t.queryPKsAt)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to filter deleted entities of search results", zap.Int64("msgID", t.ID()), zap.Error(err))
+			return err
+		}
+		if removed > 0 {
+			log.Ctx(ctx).Info("filtered deleted entities out of search results", zap.Int64("msgID", t.ID()),
+				zap.String("collection", t.collectionName), zap.Int("removed", removed))
+		}
+		tr.CtxRecord(ctx, "filterDeletedResults")
+	}
+
+	if t.mmrField != nil {
+		vectors, err := fetchResultVectors(ctx, t.request.GetDbName(), t.collectionName, primaryFieldSchema, t.mmrField, t.result.GetResults(),
+			t.SearchRequest.GetTravelTimestamp(), t.SearchRequest.GetGuaranteeTimestamp(), t.queryVectorsAt)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to re-rank search results by MMR", zap.Int64("msgID", t.ID()), zap.Error(err))
+			return err
+		}
+		mmrRerank(t.result.GetResults(), vectors, MetricType, t.mmrLambda)
+		tr.CtxRecord(ctx, "mmrRerank")
+	}
+	if t.ranker != nil {
+		t.request.OutputFields, err = t.ranker.rerank(t.result.GetResults(), t.request.GetOutputFields())
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to re-rank search results", zap.Int64("msgID", t.ID()), zap.Error(err))
+			return err
+		}
+		tr.CtxRecord(ctx, "scalarRerank")
+	}
 
 	t.result.CollectionName = t.collectionName
+	if len(t.result.GetResults().GetScores()) == 0 {
+		t.result.Status.Reason = EmptyResultReason
+	}
 	t.fillInFieldInfo()
+	t.result.BruteForce = t.bruteForce
+	t.fillInConsistencyInfo()
+	if err := t.fillInIteratorToken(); err != nil {
+		return err
+	}
+	t.recordCollectionMetrics(reduceDuration)
+	if err := t.fillInExecutionInfo(reduceDuration); err != nil {
+		return err
+	}
+	if err := checkSearchResultSize(t.result, Params.ProxyCfg.ServerMaxSendSize); err != nil {
+		log.Ctx(ctx).Warn("search result is too large to send", zap.Int64("msgID", t.ID()),
+			zap.String("collection", t.collectionName), zap.Error(err))
+		return err
+	}
 
 	log.Ctx(ctx).Debug("Search post execute done", zap.Int64("msgID", t.ID()))
 	return nil
 }
 
+// checkVectorIndex checks whether the searched vector field has an index. The field without index is searched by
+// brute force if Params.ProxyCfg.SearchWithoutIndex is set, otherwise the search is rejected with IndexNotExist.
+func (t *searchTask) checkVectorIndex(ctx context.Context, vectorField *schemapb.FieldSchema) error {
+	if t.ic == nil {
+		return nil
+	}
+	indexed, err := hasFieldIndex(ctx, t.request.GetDbName(), t.collectionName, vectorField.GetFieldID(), t.ic)
+	if err != nil {
+		if !Params.ProxyCfg.SearchWithoutIndex {
+			return err
+		}
+		// the search works without index anyway, it's not failed for the index lookup
+		log.Ctx(ctx).Warn("failed to check the index of the search field", zap.Int64("msgID", t.ID()),
+			zap.String("collection", t.collectionName), zap.String("field", vectorField.GetName()), zap.Error(err))
+		return nil
+	}
+	if indexed {
+		return nil
+	}
+	if !Params.ProxyCfg.SearchWithoutIndex {
+		return newErrWithCode(commonpb.ErrorCode_IndexNotExist,
+			"no index found on field %s of collection %s, create an index before searching it", vectorField.GetName(), t.collectionName)
+	}
+	t.bruteForce = true
+	log.Ctx(ctx).Warn("search the field without index by brute force", zap.Int64("msgID", t.ID()),
+		zap.String("collection", t.collectionName), zap.String("field", vectorField.GetName()))
+	return nil
+}
+
+// checkSearchResultSize rejects the result larger than the max send size of the grpc server, which would fail to
+// be sent to the client anyway. The sizes of the output fields are reported, so that the client knows what to cut.
+// Nothing is checked if maxSize isn't set.
+func checkSearchResultSize(result *milvuspb.SearchResults, maxSize int) error {
+	if maxSize <= 0 {
+		return nil
+	}
+	size := proto.Size(result)
+	if size <= maxSize {
+		return nil
+	}
+	fieldsData := make([]*schemapb.FieldData, len(result.GetResults().GetFieldsData()))
+	copy(fieldsData, result.GetResults().GetFieldsData())
+	sort.SliceStable(fieldsData, func(i, j int) bool { return proto.Size(fieldsData[i]) > proto.Size(fieldsData[j]) })
+	fieldSizes := make([]string, 0, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		fieldSizes = append(fieldSizes, fmt.Sprintf("%s: %d", fieldData.GetFieldName(), proto.Size(fieldData)))
+	}
+	return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+		"search result of %d bytes exceeds the max send size %d bytes, the output fields take [%s] bytes, "+
+			"please search with fewer output fields, or a smaller nq or topk", size, maxSize, strings.Join(fieldSizes, ", "))
+}
+
+// fillInExecutionInfo sets the time breakdown of the search into the result if the search asks for it.
+func (t *searchTask) fillInExecutionInfo(reduceDuration time.Duration) error {
+	if t.executionInfo == nil {
+		return nil
+	}
+	t.executionInfo.ReduceMs = durationMs(reduceDuration)
+	t.executionInfo.TotalMs = durationMs(t.tr.ElapseSpan())
+	info, err := t.executionInfo.marshal()
+	if err != nil {
+		return err
+	}
+	t.result.ExecutionInfo = info
+	return nil
+}
+
+// fillInConsistencyInfo sets the timestamps the search is served at into the result if the search asks for them.
+func (t *searchTask) fillInConsistencyInfo() {
+	if t.consistencyInfo == nil {
+		return
+	}
+	t.result.ConsistencyInfo = t.consistencyInfo.build(t.SearchRequest.GetGuaranteeTimestamp())
+}
+
+// fillInIteratorToken sets the token of the next batch into the result if the search is a search iterator.
+func (t *searchTask) fillInIteratorToken() error {
+	if t.iterator == nil {
+		return nil
+	}
+	token, err := t.iterator.next(t.result.GetResults(), t.SearchRequest.GetNq(), time.Now())
+	if err != nil {
+		return err
+	}
+	t.result.SearchIteratorToken = token
+	return nil
+}
+
+// recordCollectionMetrics records nq, topk, result size and reduce duration of the search in per-collection metrics.
+func (t *searchTask) recordCollectionMetrics(reduceDuration time.Duration) {
+	nodeID := strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)
+	collection := metrics.ProxyCollectionLabel(t.GetCollectionID())
+	t.resultSizeInBytes = proto.Size(t.result)
+
+	metrics.ProxySearchNQ.WithLabelValues(nodeID, collection).Observe(float64(t.GetNq()))
+	metrics.ProxySearchTopK.WithLabelValues(nodeID, collection).Observe(float64(t.GetTopk()))
+	metrics.ProxySearchResultBytes.WithLabelValues(nodeID, metrics.SearchLabel, collection).Observe(float64(t.resultSizeInBytes))
+	metrics.ProxyReduceResultDuration.WithLabelValues(nodeID, metrics.SearchLabel, collection).Add(float64(reduceDuration.Milliseconds()))
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	req := &querypb.SearchRequest{
 		Req:         t.SearchRequest,
 		DmlChannels: channelIDs,
 		Scope:       querypb.DataScope_All,
 	}
+	// the deadline is carried to the query node by the grpc context
+	if !t.shardDeadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, t.shardDeadline)
+		defer cancel()
+	}
+	start := time.Now()
 	result, err := qn.Search(ctx, req)
+	t.executionInfo.addShard(nodeID, channelIDs, time.Since(start), result.GetStatus(), err)
 	if err != nil {
 		log.Ctx(ctx).Warn("QueryNode search return error", zap.Int64("msgID", t.ID()),
 			zap.Int64("nodeID", nodeID), zap.Strings("channels", channelIDs), zap.Error(err))
@@ -424,16 +809,25 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 			zap.String("reason", result.GetStatus().GetReason()))
 		return fmt.Errorf("fail to Search, QueryNode ID=%d, reason=%s", nodeID, result.GetStatus().GetReason())
 	}
+	if err := t.resultMemory.grow(proto.Size(result)); err != nil {
+		log.Ctx(ctx).Warn("search result is too large", zap.Int64("msgID", t.ID()), zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+	t.consistencyInfo.addShard(nodeID, result)
 	t.resultBuf <- result
 
 	return nil
 }
 
+// EmptyResultReason is the reason in the success status of a search or a query which finds no entity, e.g. the
+// collection is empty, it tells the empty result from the others without failing the request.
+const EmptyResultReason = "no entities found"
+
 func (t *searchTask) fillInEmptyResult(numQueries int64) {
 	t.result = &milvuspb.SearchResults{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
-			Reason:    "search result is empty",
+			Reason:    EmptyResultReason,
 		},
 		CollectionName: t.collectionName,
 		Results: &schemapb.SearchResultData{
@@ -474,8 +868,8 @@ func (t *searchTask) collectSearchResults(ctx context.Context) error {
 }
 
 // checkIfLoaded check if collection was loaded into QueryNode
-func checkIfLoaded(ctx context.Context, qc types.QueryCoord, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
-	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+func checkIfLoaded(ctx context.Context, qc types.QueryCoord, database, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
+	info, err := globalMetaCache.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return false, fmt.Errorf("GetCollectionInfo failed, collection = %s, err = %s", collectionName, err)
 	}
@@ -568,6 +962,83 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
+// searchResultSelection locates a result selected by reduce in the sub search results.
+type searchResultSelection struct {
+	subSearchIdx  int
+	resultDataIdx int64
+	id            interface{}
+}
+
+// minNqPerReduceWorker avoids reducing a few queries in parallel, which costs more than it saves.
+const minNqPerReduceWorker = 8
+
+// reduceParallelism returns the number of workers to reduce the results of nq queries.
+func reduceParallelism(nq int64) int {
+	parallelism := runtime.GOMAXPROCS(0)
+	if maxParallelism := Params.ProxyCfg.MaxReduceParallelism; parallelism > maxParallelism {
+		parallelism = maxParallelism
+	}
+	if maxWorkers := int(nq / minNqPerReduceWorker); parallelism > maxWorkers {
+		parallelism = maxWorkers
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	return parallelism
+}
+
+// selectSearchResultsOfQuery selects the results of the qi-th query from all the sub search results,
+// duplicated ids are skipped and the number of them is returned.
+// cursors and idSet are buffers reused across queries, they're reset before use.
+func selectSearchResultsOfQuery(subSearchResultData []*schemapb.SearchResultData, subSearchNqOffset [][]int64,
+	qi int64, offset int64, limit int64, cursors []int64, idSet map[interface{}]struct{}) ([]searchResultSelection, int64) {
+	// cursor of current data of each subSearch for merging the j-th data of TopK.
+	// sum(cursors) == j
+	for k := range cursors {
+		cursors[k] = 0
+	}
+	for id := range idSet {
+		delete(idSet, id)
+	}
+
+	// skip offset results
+	for k := int64(0); k < offset; k++ {
+		subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, qi)
+		if subSearchIdx == -1 {
+			break
+		}
+
+		cursors[subSearchIdx]++
+	}
+
+	var (
+		selections []searchResultSelection
+		skipDupCnt int64
+	)
+	// keep limit results
+	for int64(len(selections)) < limit {
+		// From all the sub-query result sets of the qi-th query vector,
+		//   find the sub-query result set index of the score j-th data,
+		//   and the index of the data in schemapb.SearchResultData
+		subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, qi)
+		if subSearchIdx == -1 {
+			break
+		}
+
+		id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+		// remove duplicates
+		if _, ok := idSet[id]; !ok {
+			selections = append(selections, searchResultSelection{subSearchIdx: subSearchIdx, resultDataIdx: resultDataIdx, id: id})
+			idSet[id] = struct{}{}
+		} else {
+			// skip entity with same id
+			skipDupCnt++
+		}
+		cursors[subSearchIdx]++
+	}
+	return selections, skipDupCnt
+}
+
 func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
@@ -638,6 +1109,25 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		}
 	}
 
+	// select the results of each query in parallel, then assemble them in the order of queries.
+	selections := make([][]searchResultSelection, nq)
+	skipDupCnts := make([]int64, nq)
+	parallelism := reduceParallelism(nq)
+	var wg sync.WaitGroup
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// buffers reused by all the queries of the worker
+			cursors := make([]int64, subSearchNum)
+			idSet := make(map[interface{}]struct{})
+			for i := int64(w); i < nq; i += int64(parallelism) {
+				selections[i], skipDupCnts[i] = selectSearchResultsOfQuery(subSearchResultData, subSearchNqOffset, i, offset, limit, cursors, idSet)
+			}
+		}(w)
+	}
+	wg.Wait()
+
 	var (
 		skipDupCnt int64
 		realTopK   int64 = -1
@@ -645,52 +1135,15 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 
 	// reducing nq * topk results
 	for i := int64(0); i < nq; i++ {
-
-		var (
-			// cursor of current data of each subSearch for merging the j-th data of TopK.
-			// sum(cursors) == j
-			cursors = make([]int64, subSearchNum)
-
-			j     int64
-			idSet = make(map[interface{}]struct{})
-		)
-
-		// skip offset results
-		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
-			if subSearchIdx == -1 {
-				break
-			}
-
-			cursors[subSearchIdx]++
+		for _, sel := range selections[i] {
+			subSearchIdx, resultDataIdx := sel.subSearchIdx, sel.resultDataIdx
+			typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, sel.id)
+			ret.Results.Scores = append(ret.Results.Scores, subSearchResultData[subSearchIdx].Scores[resultDataIdx])
 		}
+		skipDupCnt += skipDupCnts[i]
 
-		// keep limit results
-		for j = 0; j < limit; {
-			// From all the sub-query result sets of the i-th query vector,
-			//   find the sub-query result set index of the score j-th data,
-			//   and the index of the data in schemapb.SearchResultData
-			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
-			if subSearchIdx == -1 {
-				break
-			}
-
-			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
-			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
-
-			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
-				typeutil.AppendPKs(ret.Results.Ids, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
-				// skip entity with same id
-				skipDupCnt++
-			}
-			cursors[subSearchIdx]++
-		}
+		j := int64(len(selections[i]))
 		if realTopK != -1 && realTopK != j {
 			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
@@ -762,5 +1215,19 @@ func (t *searchTask) OnEnqueue() error {
 	t.Base = &commonpb.MsgBase{}
 	t.Base.MsgType = commonpb.MsgType_Search
 	t.Base.SourceID = Params.ProxyCfg.GetNodeID()
-	return nil
+	var err error
+	t.priority, err = parseRequestPriority(t.TraceCtx(), t.request.GetSearchParams())
+	return err
+}
+
+func (t *searchTask) getRequestPriority() requestPriority {
+	return t.priority
+}
+
+func (t *searchTask) setResultMemory(account *resultMemoryAccount) {
+	t.resultMemory = account
+}
+
+func (t *searchTask) getResultMemory() *resultMemoryAccount {
+	return t.resultMemory
 }
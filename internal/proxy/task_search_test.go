@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -24,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -58,6 +62,154 @@ func TestSearchTask_PostExecute(t *testing.T) {
 		err := qt.PostExecute(context.TODO())
 		assert.NoError(t, err)
 		assert.Equal(t, qt.result.Status.ErrorCode, commonpb.ErrorCode_Success)
+		assert.Equal(t, EmptyResultReason, qt.result.Status.Reason)
+		assert.Equal(t, proto.Size(qt.result), qt.resultSizeInBytes)
+	})
+
+	t.Run("Test empty collection", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		qt := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(context.TODO()),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Search,
+					SourceID: Params.ProxyCfg.GetNodeID(),
+				},
+				Nq:         2,
+				Topk:       10,
+				MetricType: distance.L2,
+			},
+			request: &milvuspb.SearchRequest{},
+			schema:  constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, "test_empty_collection"),
+			tr:      timerecord.NewTimeRecorder("search"),
+
+			resultBuf:       make(chan *internalpb.SearchResults, 10),
+			toReduceResults: make([]*internalpb.SearchResults, 0),
+		}
+		// the segments of the shards have no entity
+		for i := 0; i < 2; i++ {
+			blob, err := proto.Marshal(&schemapb.SearchResultData{
+				NumQueries: 2,
+				TopK:       10,
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}},
+				Topks:      []int64{0, 0},
+			})
+			require.NoError(t, err)
+			qt.resultBuf <- &internalpb.SearchResults{SlicedBlob: blob}
+		}
+
+		err := qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, qt.result.GetStatus().GetErrorCode())
+		assert.Equal(t, EmptyResultReason, qt.result.GetStatus().GetReason())
+		assert.Equal(t, int64(2), qt.result.GetResults().GetNumQueries())
+		assert.Equal(t, []int64{0, 0}, qt.result.GetResults().GetTopks())
+		assert.Empty(t, qt.result.GetResults().GetScores())
+	})
+
+	t.Run("Test filter deleted entities", func(t *testing.T) {
+		Params.ProxyCfg.SearchDeleteCheck = true
+		defer func() { Params.ProxyCfg.SearchDeleteCheck = false }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var queriedTravelTs, queriedGuaranteeTs Timestamp
+		qt := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(context.TODO()),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Search,
+					SourceID: Params.ProxyCfg.GetNodeID(),
+				},
+				Nq:                 1,
+				Topk:               3,
+				MetricType:         distance.L2,
+				TravelTimestamp:    100,
+				GuaranteeTimestamp: 200,
+			},
+			request: &milvuspb.SearchRequest{},
+			schema:  constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, "test_filter_deleted"),
+			tr:      timerecord.NewTimeRecorder("search"),
+
+			resultBuf:       make(chan *internalpb.SearchResults, 10),
+			toReduceResults: make([]*internalpb.SearchResults, 0),
+
+			// the entity 2 has been deleted
+			queryPKsAt: func(ctx context.Context, database, collectionName string, pkField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (*schemapb.IDs, error) {
+				queriedTravelTs, queriedGuaranteeTs = travelTs, guaranteeTs
+				return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 3}}}}, nil
+			},
+		}
+		blob, err := proto.Marshal(&schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{-0.1, -0.2, -0.3},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			Topks:      []int64{3},
+		})
+		require.NoError(t, err)
+		qt.resultBuf <- &internalpb.SearchResults{SlicedBlob: blob}
+
+		err = qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 3}, qt.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.1, 0.3}, qt.result.GetResults().GetScores())
+		assert.Equal(t, []int64{2}, qt.result.GetResults().GetTopks())
+		assert.Equal(t, Timestamp(100), queriedTravelTs)
+		assert.Equal(t, Timestamp(200), queriedGuaranteeTs)
+	})
+
+	t.Run("Test MMR re-rank", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		schema := constructCollectionSchema(testInt64Field, testFloatVecField, 2, "test_mmr")
+		qt := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(context.TODO()),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Search,
+					SourceID: Params.ProxyCfg.GetNodeID(),
+				},
+				Nq:         1,
+				Topk:       3,
+				MetricType: distance.L2,
+			},
+			request:   &milvuspb.SearchRequest{},
+			schema:    schema,
+			tr:        timerecord.NewTimeRecorder("search"),
+			mmrField:  schema.GetFields()[1],
+			mmrLambda: 0.5,
+
+			resultBuf:       make(chan *internalpb.SearchResults, 10),
+			toReduceResults: make([]*internalpb.SearchResults, 0),
+
+			// the entity 2 is a near duplicate of the entity 1
+			queryVectorsAt: func(ctx context.Context, database, collectionName string, pkField, vectorField *schemapb.FieldSchema, expr string, travelTs, guaranteeTs Timestamp) (map[interface{}][]float32, error) {
+				return map[interface{}][]float32{int64(1): {0, 0}, int64(2): {0.01, 0}, int64(3): {1, 1}}, nil
+			},
+		}
+		blob, err := proto.Marshal(&schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{-0.1, -0.2, -0.3},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			Topks:      []int64{3},
+		})
+		require.NoError(t, err)
+		qt.resultBuf <- &internalpb.SearchResults{SlicedBlob: blob}
+
+		err = qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		// the plain top k is [1, 2, 3], the near duplicate is moved behind the diverse one
+		assert.Equal(t, []int64{1, 3, 2}, qt.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.1, 0.3, 0.2}, qt.result.GetResults().GetScores())
 	})
 }
 
@@ -195,7 +347,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("test checkIfLoaded error", func(t *testing.T) {
 		collName := "test_checkIfLoaded_error" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		_, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		_, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
 		require.NoError(t, err)
 		task := getSearchTask(t, collName)
 		task.collectionName = collName
@@ -218,10 +370,45 @@ func TestSearchTask_PreExecute(t *testing.T) {
 		qc.ResetShowPartitionsFunc()
 	})
 
+	t.Run("collection not loaded", func(t *testing.T) {
+		collName := "search_not_loaded" + funcutil.GenRandomStr()
+		createColl(t, collName, rc)
+
+		task := getSearchTask(t, collName)
+		task.request.SearchParams = getValidSearchParams()
+		task.request.DslType = commonpb.DslType_BoolExprV1
+
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotLoaded, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "LoadCollection")
+	})
+
+	t.Run("collection loaded", func(t *testing.T) {
+		collName := "search_loaded" + funcutil.GenRandomStr()
+		createColl(t, collName, rc)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
+		require.NoError(t, err)
+		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID: collID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		task := getSearchTask(t, collName)
+		task.request.SearchParams = getValidSearchParams()
+		task.request.DslType = commonpb.DslType_BoolExprV1
+
+		assert.NoError(t, task.PreExecute(ctx))
+	})
+
 	t.Run("search with timeout", func(t *testing.T) {
 		collName := "search_with_timeout" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
 		require.NoError(t, err)
 		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -243,16 +430,103 @@ func TestSearchTask_PreExecute(t *testing.T) {
 		task.ctx = ctxTimeout
 		assert.NoError(t, task.PreExecute(ctx))
 		assert.Greater(t, task.TimeoutTimestamp, typeutil.ZeroTimestamp)
+		// the query nodes have to return before the client deadline, leaving time for proxy to reduce
+		clientDeadline, _ := ctxTimeout.Deadline()
+		assert.True(t, task.shardDeadline.Before(clientDeadline))
+		assert.Equal(t, tsoutil.ComposeTSByTime(task.shardDeadline, 0), task.TimeoutTimestamp)
 
 		// field not exist
 		task.ctx = context.TODO()
 		task.request.OutputFields = []string{testInt64Field + funcutil.GenRandomStr()}
-		assert.Error(t, task.PreExecute(ctx))
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
 
 		// contain vector field
 		task.request.OutputFields = []string{testFloatVecField}
 		assert.Error(t, task.PreExecute(ctx))
 	})
+
+	t.Run("too many output fields", func(t *testing.T) {
+		collName := "search_output_fields" + funcutil.GenRandomStr()
+		createColl(t, collName, rc)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), "", collName)
+		require.NoError(t, err)
+		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID: collID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		maxOutputFieldNum := Params.ProxyCfg.MaxOutputFieldNum
+		defer func() { Params.ProxyCfg.MaxOutputFieldNum = maxOutputFieldNum }()
+		Params.ProxyCfg.MaxOutputFieldNum = 1
+
+		task := getSearchTask(t, collName)
+		task.request.SearchParams = getValidSearchParams()
+		task.request.DslType = commonpb.DslType_BoolExprV1
+		task.request.OutputFields = []string{testInt64Field}
+		assert.NoError(t, task.PreExecute(ctx))
+
+		task = getSearchTask(t, collName)
+		task.request.SearchParams = getValidSearchParams()
+		task.request.DslType = commonpb.DslType_BoolExprV1
+		task.request.OutputFields = []string{testInt64Field, testFloatVecField}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "exceeds the limit 1")
+	})
+}
+
+func Test_deriveShardDeadline(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, now.Add(9*time.Second), deriveShardDeadline(now, now.Add(10*time.Second)))
+	assert.Equal(t, now.Add(900*time.Millisecond), deriveShardDeadline(now, now.Add(time.Second)))
+	// already exceeded
+	assert.Equal(t, now.Add(-time.Second), deriveShardDeadline(now, now.Add(-time.Second)))
+}
+
+func TestSearchTask_searchShardDeadline(t *testing.T) {
+	ctx := context.Background()
+	qn := &QueryNodeMock{
+		withSearchResult: &internalpb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+	}
+	newTask := func() *searchTask {
+		return &searchTask{
+			SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+			resultBuf:     make(chan *internalpb.SearchResults, 1),
+		}
+	}
+
+	t.Run("with deadline", func(t *testing.T) {
+		task := newTask()
+		task.shardDeadline = time.Now().Add(time.Minute)
+		assert.NoError(t, task.searchShard(ctx, 1, qn, []string{"dml-0"}))
+		require.Len(t, qn.searchDeadlines, 1)
+		assert.Equal(t, task.shardDeadline, qn.searchDeadlines[0])
+	})
+
+	t.Run("without deadline", func(t *testing.T) {
+		task := newTask()
+		assert.NoError(t, task.searchShard(ctx, 1, qn, []string{"dml-0"}))
+		require.Len(t, qn.searchDeadlines, 2)
+		assert.True(t, qn.searchDeadlines[1].IsZero())
+	})
+
+	t.Run("earlier caller deadline", func(t *testing.T) {
+		task := newTask()
+		task.shardDeadline = time.Now().Add(time.Minute)
+		callerCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		callerDeadline, _ := callerCtx.Deadline()
+		assert.NoError(t, task.searchShard(callerCtx, 1, qn, []string{"dml-0"}))
+		require.Len(t, qn.searchDeadlines, 3)
+		assert.Equal(t, callerDeadline, qn.searchDeadlines[2])
+	})
 }
 
 func TestSearchTaskV2_Execute(t *testing.T) {
@@ -1477,7 +1751,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		_, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{})
 		assert.Error(t, err)
 	})
 
@@ -1488,7 +1762,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
@@ -1503,7 +1777,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1517,7 +1791,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1531,7 +1805,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 100}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
@@ -1546,7 +1820,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 50}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1561,7 +1835,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1575,7 +1849,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1589,7 +1863,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{1, 2}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1604,7 +1878,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, "", "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1674,7 +1948,7 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -1687,6 +1961,9 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
+	placeholderGroup, err := proto.Marshal(constructPlaceholderGroup(2, testVecDim))
+	require.NoError(t, err)
+
 	// test begins
 	task := &searchTask{
 		Condition: NewTaskCondition(ctx),
@@ -1709,7 +1986,8 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 				MsgType:  commonpb.MsgType_Retrieve,
 				SourceID: Params.ProxyCfg.GetNodeID(),
 			},
-			CollectionName: collectionName,
+			CollectionName:   collectionName,
+			PlaceholderGroup: placeholderGroup,
 		},
 		qc:       qc,
 		shardMgr: mgr,
@@ -1753,6 +2031,14 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	}
 	qn.withSearchResult = result1
 	assert.NoError(t, task.Execute(ctx))
+
+	// the placeholder group is forwarded to query nodes untouched.
+	assert.Equal(t, int64(2), task.SearchRequest.GetNq())
+	require.NotEmpty(t, qn.searchRequests)
+	for _, req := range qn.searchRequests {
+		assert.Equal(t, placeholderGroup, req.GetReq().GetPlaceholderGroup())
+		assert.Same(t, &placeholderGroup[0], &req.GetReq().GetPlaceholderGroup()[0])
+	}
 }
 
 func TestTaskSearch_parseQueryInfo(t *testing.T) {
@@ -1858,3 +2144,328 @@ func getSearchResultData(nq, topk int64) *schemapb.SearchResultData {
 	}
 	return &result
 }
+
+// genRandomSearchResults generates the search results of subSearchNum shards with random scores and ids,
+// ids may be duplicated among the shards.
+func genRandomSearchResults(r *rand.Rand, subSearchNum int, nq int64, topk int64) []*schemapb.SearchResultData {
+	results := make([]*schemapb.SearchResultData, 0, subSearchNum)
+	for i := 0; i < subSearchNum; i++ {
+		result := getSearchResultData(nq, topk)
+		ids := make([]int64, 0)
+		for q := int64(0); q < nq; q++ {
+			k := r.Int63n(topk + 1)
+			scores := make([]float32, k)
+			for j := range scores {
+				scores[j] = r.Float32()
+			}
+			sort.Slice(scores, func(a, b int) bool { return scores[a] > scores[b] })
+			for j := int64(0); j < k; j++ {
+				ids = append(ids, r.Int63n(topk*int64(subSearchNum)))
+			}
+			result.Scores = append(result.Scores, scores...)
+			result.Topks = append(result.Topks, k)
+		}
+		result.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		result.FieldsData = []*schemapb.FieldData{{
+			Type:      schemapb.DataType_Int64,
+			FieldName: testInt64Field,
+			FieldId:   100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ids}},
+				},
+			},
+		}}
+		results = append(results, result)
+	}
+	return results
+}
+
+// sequentialReduceSearchResultData is the reduce of search results before it's parallelized, it's kept as the
+// reference of the parallel reduce.
+func sequentialReduceSearchResultData(subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, offset int64) *schemapb.SearchResultData {
+	limit := topk - offset
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		FieldsData: make([]*schemapb.FieldData, len(subSearchResultData[0].FieldsData)),
+		Scores:     []float32{},
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0)}}},
+		Topks:      []int64{},
+	}
+
+	subSearchNum := len(subSearchResultData)
+	subSearchNqOffset := make([][]int64, subSearchNum)
+	for i := 0; i < subSearchNum; i++ {
+		subSearchNqOffset[i] = make([]int64, subSearchResultData[i].GetNumQueries())
+		for j := int64(1); j < nq; j++ {
+			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
+		}
+	}
+
+	var realTopK int64 = -1
+	for i := int64(0); i < nq; i++ {
+		cursors := make([]int64, subSearchNum)
+		idSet := make(map[interface{}]struct{})
+		for k := int64(0); k < offset; k++ {
+			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+		}
+
+		var j int64
+		for j = 0; j < limit; {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+			if _, ok := idSet[id]; !ok {
+				typeutil.AppendFieldData(ret.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+				typeutil.AppendPKs(ret.Ids, id)
+				ret.Scores = append(ret.Scores, subSearchResultData[subSearchIdx].Scores[resultDataIdx])
+				idSet[id] = struct{}{}
+				j++
+			}
+			cursors[subSearchIdx]++
+		}
+		realTopK = j
+		ret.Topks = append(ret.Topks, realTopK)
+	}
+	ret.TopK = realTopK
+	if !distance.PositivelyRelated(metricType) {
+		for k := range ret.Scores {
+			ret.Scores[k] *= -1
+		}
+	}
+	return ret
+}
+
+func TestTaskSearch_reduceSearchResultDataInParallel(t *testing.T) {
+	maxProcs := runtime.GOMAXPROCS(8)
+	defer runtime.GOMAXPROCS(maxProcs)
+	maxParallelism := Params.ProxyCfg.MaxReduceParallelism
+	defer func() { Params.ProxyCfg.MaxReduceParallelism = maxParallelism }()
+	Params.ProxyCfg.MaxReduceParallelism = 8
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, c := range []struct {
+		nq, topk, offset int64
+	}{
+		{1, 10, 0},
+		{100, 10, 0},
+		{333, 20, 5},
+		{1000, 5, 0},
+	} {
+		results := genRandomSearchResults(r, 16, c.nq, c.topk)
+		expected := sequentialReduceSearchResultData(results, c.nq, c.topk, distance.L2, c.offset)
+
+		actual, err := reduceSearchResultData(context.TODO(), results, c.nq, c.topk, distance.L2, schemapb.DataType_Int64, c.offset)
+		require.NoError(t, err)
+
+		assert.True(t, proto.Equal(expected, actual.GetResults()), "nq: %d, topk: %d, offset: %d", c.nq, c.topk, c.offset)
+	}
+}
+
+func Test_validateSearchOutputFields(t *testing.T) {
+	schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, "test_output_fields")
+	maxOutputFieldNum := Params.ProxyCfg.MaxOutputFieldNum
+	defer func() { Params.ProxyCfg.MaxOutputFieldNum = maxOutputFieldNum }()
+
+	Params.ProxyCfg.MaxOutputFieldNum = 0
+	assert.NoError(t, validateSearchOutputFields(schema, nil))
+	assert.NoError(t, validateSearchOutputFields(schema, []string{testInt64Field, testFloatVecField}))
+
+	err := validateSearchOutputFields(schema, []string{testInt64Field, "not_exist"})
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "not_exist")
+
+	Params.ProxyCfg.MaxOutputFieldNum = 1
+	assert.NoError(t, validateSearchOutputFields(schema, []string{testInt64Field}))
+	err = validateSearchOutputFields(schema, []string{testInt64Field, testFloatVecField})
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func Test_checkSearchResultSize(t *testing.T) {
+	result := &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       2,
+			Scores:     []float32{0.2, 0.1},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+			Topks:      []int64{2},
+			FieldsData: []*schemapb.FieldData{
+				{FieldName: "age", Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{18, 19}}}}}},
+				{FieldName: "embedding", Type: schemapb.DataType_FloatVector, Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim: 128, Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: make([]float32, 256)}}}}},
+			},
+		},
+	}
+	size := proto.Size(result)
+
+	assert.NoError(t, checkSearchResultSize(result, 0))
+	assert.NoError(t, checkSearchResultSize(result, size+1))
+	// right on the boundary
+	assert.NoError(t, checkSearchResultSize(result, size))
+
+	err := checkSearchResultSize(result, size-1)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), fmt.Sprintf("search result of %d bytes exceeds the max send size %d bytes", size, size-1))
+	// the largest output field is reported first
+	embeddingSize := proto.Size(result.GetResults().GetFieldsData()[1])
+	ageSize := proto.Size(result.GetResults().GetFieldsData()[0])
+	assert.Contains(t, err.Error(), fmt.Sprintf("[embedding: %d, age: %d]", embeddingSize, ageSize))
+	// the result is left as it is
+	assert.Equal(t, size, proto.Size(result))
+}
+
+func Test_reduceParallelism(t *testing.T) {
+	maxProcs := runtime.GOMAXPROCS(8)
+	defer runtime.GOMAXPROCS(maxProcs)
+	maxParallelism := Params.ProxyCfg.MaxReduceParallelism
+	defer func() { Params.ProxyCfg.MaxReduceParallelism = maxParallelism }()
+
+	Params.ProxyCfg.MaxReduceParallelism = 4
+	assert.Equal(t, 1, reduceParallelism(1))
+	assert.Equal(t, 2, reduceParallelism(2*minNqPerReduceWorker))
+	assert.Equal(t, 4, reduceParallelism(1000))
+
+	Params.ProxyCfg.MaxReduceParallelism = 16
+	assert.Equal(t, 8, reduceParallelism(1000))
+}
+
+func BenchmarkReduceSearchResultData(b *testing.B) {
+	const (
+		subSearchNum       = 32
+		nq           int64 = 1000
+		topk         int64 = 10
+	)
+	results := genRandomSearchResults(rand.New(rand.NewSource(0)), subSearchNum, nq, topk)
+	maxParallelism := Params.ProxyCfg.MaxReduceParallelism
+	defer func() { Params.ProxyCfg.MaxReduceParallelism = maxParallelism }()
+
+	for _, parallelism := range []int{1, runtime.GOMAXPROCS(0)} {
+		b.Run(fmt.Sprintf("parallelism %d", parallelism), func(b *testing.B) {
+			Params.ProxyCfg.MaxReduceParallelism = parallelism
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, 0)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchTask_checkVectorIndex(t *testing.T) {
+	Params.InitOnce()
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	defer func(allowed bool) { Params.ProxyCfg.SearchWithoutIndex = allowed }(Params.ProxyCfg.SearchWithoutIndex)
+
+	ctx := context.Background()
+	cache := newMockCache()
+	globalMetaCache = cache
+	field := &schemapb.FieldSchema{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector}
+	newTask := func() *searchTask {
+		return &searchTask{
+			ctx:             ctx,
+			SearchRequest:   &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+			collectionName:  "coll",
+			ic:              &mockIndexCoord{},
+			tr:              timerecord.NewTimeRecorder("search"),
+			resultBuf:       make(chan *internalpb.SearchResults, 1),
+			toReduceResults: make([]*internalpb.SearchResults, 0),
+		}
+	}
+	indexesOf := func(fieldIDs ...int64) getIndexInfosFunc {
+		return func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			indexInfos := make(map[string]*indexInfo)
+			for _, fieldID := range fieldIDs {
+				indexInfos[fmt.Sprintf("idx_%d", fieldID)] = &indexInfo{fieldID: fieldID}
+			}
+			return indexInfos, nil
+		}
+	}
+
+	t.Run("indexed", func(t *testing.T) {
+		cache.setGetIndexFunc(indexesOf(100, 101))
+		for _, allowed := range []bool{true, false} {
+			Params.ProxyCfg.SearchWithoutIndex = allowed
+			task := newTask()
+			assert.NoError(t, task.checkVectorIndex(ctx, field))
+			assert.False(t, task.bruteForce)
+		}
+	})
+
+	t.Run("indexed by other proxies", func(t *testing.T) {
+		Params.ProxyCfg.SearchWithoutIndex = false
+		calls := 0
+		cache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			calls++
+			if calls == 1 {
+				return indexesOf()(ctx, collectionName, indexCoord)
+			}
+			return indexesOf(101)(ctx, collectionName, indexCoord)
+		})
+		task := newTask()
+		assert.NoError(t, task.checkVectorIndex(ctx, field))
+		assert.False(t, task.bruteForce)
+		assert.Equal(t, 2, calls)
+
+		// the indexes fetched recently aren't refreshed
+		calls = 0
+		cache.indexInfosFresh = true
+		defer func() { cache.indexInfosFresh = false }()
+		task = newTask()
+		assert.Error(t, task.checkVectorIndex(ctx, field))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("brute force allowed", func(t *testing.T) {
+		Params.ProxyCfg.SearchWithoutIndex = true
+		cache.setGetIndexFunc(indexesOf(100))
+		task := newTask()
+		assert.NoError(t, task.checkVectorIndex(ctx, field))
+		assert.True(t, task.bruteForce)
+
+		// the result is flagged
+		task.resultBuf <- &internalpb.SearchResults{}
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.True(t, task.result.GetBruteForce())
+
+		// the search isn't failed for the index lookup
+		cache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return nil, errors.New("mock")
+		})
+		assert.NoError(t, newTask().checkVectorIndex(ctx, field))
+	})
+
+	t.Run("brute force disallowed", func(t *testing.T) {
+		Params.ProxyCfg.SearchWithoutIndex = false
+		cache.setGetIndexFunc(indexesOf(100))
+		task := newTask()
+		err := task.checkVectorIndex(ctx, field)
+		assert.Equal(t, commonpb.ErrorCode_IndexNotExist, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "no index found on field vec of collection coll")
+		assert.False(t, task.bruteForce)
+
+		cache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return nil, errors.New("mock")
+		})
+		assert.Error(t, newTask().checkVectorIndex(ctx, field))
+	})
+
+	t.Run("without indexCoord", func(t *testing.T) {
+		Params.ProxyCfg.SearchWithoutIndex = false
+		cache.setGetIndexFunc(indexesOf())
+		task := newTask()
+		task.ic = nil
+		assert.NoError(t, task.checkVectorIndex(ctx, field))
+	})
+}
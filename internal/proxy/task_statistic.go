@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -19,9 +23,18 @@ import (
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
+const (
+	rowCountKey = "row_count"
+	// inMemoryRowCountKey is the row count of the loaded partitions, including the growing segments
+	inMemoryRowCountKey = "in_memory_row_count"
+	// flushedRowCountKey is the row count of the unloaded partitions recorded by DataCoord
+	flushedRowCountKey = "flushed_row_count"
+)
+
 type getStatisticsTask struct {
 	request *milvuspb.GetStatisticsRequest
 	result  *milvuspb.GetStatisticsResponse
@@ -32,11 +45,22 @@ type getStatisticsTask struct {
 	loadedPartitionIDs []UniqueID
 	// partition ids that are not loaded into query node, require get statistics from DataCoord
 	unloadedPartitionIDs []UniqueID
-
-	ctx             context.Context
-	dc              types.DataCoord
-	tr              *timerecord.TimeRecorder
-	toReduceResults []*internalpb.GetStatisticsResponse
+	// segment ids asked by the request that are loaded into query node, require get statistics from their shard leaders
+	loadedSegmentIDs []UniqueID
+	// channels of the loaded segments asked by the request
+	loadedSegmentChannels map[string]struct{}
+	// segments asked by the request that are not loaded into query node, their statistics are from DataCoord segment info
+	unloadedSegments []*datapb.SegmentInfo
+
+	ctx context.Context
+	dc  types.DataCoord
+	tr  *timerecord.TimeRecorder
+	// statistics of the loaded data from QueryNode, including the growing segments
+	queryNodeResults []*internalpb.GetStatisticsResponse
+	// statistics of the unloaded data from DataCoord
+	dataCoordResults []*internalpb.GetStatisticsResponse
+	// statistics of each partition, only if asked by the request
+	partitionStats []*milvuspb.PartitionStatistics
 
 	fromDataCoord bool
 	fromQueryNode bool
@@ -44,7 +68,6 @@ type getStatisticsTask struct {
 	// if query from shard
 	*internalpb.GetStatisticsRequest
 	qc                   types.QueryCoord
-	resultBuf            chan *internalpb.GetStatisticsResponse
 	statisticShardPolicy pickShardPolicy
 	shardMgr             *shardClientMgr
 }
@@ -106,11 +129,11 @@ func (g *getStatisticsTask) PreExecute(ctx context.Context) error {
 	g.Base.MsgType = commonpb.MsgType_GetPartitionStatistics
 	g.Base.SourceID = Params.ProxyCfg.GetNodeID()
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.request.GetDbName(), g.collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
-	partIDs, err := getPartitionIDs(ctx, g.collectionName, g.partitionNames)
+	partIDs, err := getPartitionIDs(ctx, g.request.GetDbName(), g.collectionName, g.partitionNames)
 	if err != nil { // err is not nil if partition not exists
 		return err
 	}
@@ -128,14 +151,25 @@ func (g *getStatisticsTask) PreExecute(ctx context.Context) error {
 	}
 
 	g.GuaranteeTimestamp = parseGuaranteeTs(g.GuaranteeTimestamp, g.BeginTs())
+	if err := validateGuaranteeTs(g.GuaranteeTimestamp, g.BeginTs()); err != nil {
+		return err
+	}
 
 	deadline, ok := g.TraceCtx().Deadline()
 	if ok {
 		g.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
 	}
 
+	if len(g.request.GetSegmentIDs()) > 0 {
+		if g.request.GetWithPartitionStats() {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"partition statistics can't be got with segment ids, collection %s", g.collectionName)
+		}
+		return g.prepareSegmentStatistics(ctx, collID, partIDs)
+	}
+
 	// check if collection/partitions are loaded into query node
-	loaded, unloaded, err := checkFullLoaded(ctx, g.qc, g.collectionName, partIDs)
+	loaded, unloaded, err := checkFullLoaded(ctx, g.qc, g.request.GetDbName(), g.collectionName, partIDs)
 	if err != nil {
 		g.fromDataCoord = true
 		g.unloadedPartitionIDs = partIDs
@@ -161,69 +195,189 @@ func (g *getStatisticsTask) Execute(ctx context.Context) error {
 	if g.fromQueryNode {
 		// if request get statistics of collection which is full loaded into query node
 		// then we need not pass partition ids params
-		if len(g.request.GetPartitionNames()) == 0 && len(g.unloadedPartitionIDs) == 0 {
-			g.loadedPartitionIDs = []UniqueID{}
+		partIDs := g.loadedPartitionIDs
+		if len(g.request.GetPartitionNames()) == 0 && len(g.unloadedPartitionIDs) == 0 && len(g.loadedSegmentIDs) == 0 {
+			partIDs = []UniqueID{}
 		}
-		err := g.getStatisticsFromQueryNode(ctx)
+		results, err := g.getStatisticsFromQueryNode(ctx, partIDs)
 		if err != nil {
 			return err
 		}
+		g.queryNodeResults = results
 		log.Debug("get collection statistics from QueryNode execute done", zap.Int64("msgID", g.ID()))
 	}
 	if g.fromDataCoord {
-		err := g.getStatisticsFromDataCoord(ctx)
+		result, err := g.getStatisticsFromDataCoord(ctx, g.unloadedPartitionIDs)
 		if err != nil {
 			return err
 		}
+		g.dataCoordResults = append(g.dataCoordResults, result)
 		log.Debug("get collection statistics from DataCoord execute done", zap.Int64("msgID", g.ID()))
 	}
+	if len(g.unloadedSegments) > 0 {
+		var rowCount int64
+		for _, info := range g.unloadedSegments {
+			rowCount += info.GetNumOfRows()
+		}
+		g.dataCoordResults = append(g.dataCoordResults, &internalpb.GetStatisticsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Stats:  []*commonpb.KeyValuePair{{Key: rowCountKey, Value: strconv.FormatInt(rowCount, 10)}},
+		})
+	}
+	if g.request.GetWithPartitionStats() {
+		if err := g.getPartitionStatistics(ctx); err != nil {
+			return err
+		}
+		log.Debug("get partition statistics execute done", zap.Int64("msgID", g.ID()), zap.Int("partitions", len(g.partitionStats)))
+	}
 	return nil
 }
 
-func (g *getStatisticsTask) PostExecute(ctx context.Context) error {
-	sp, _ := trace.StartSpanFromContextWithOperationName(g.TraceCtx(), "Proxy-GetStatistic-PostExecute")
-	defer sp.Finish()
-	tr := timerecord.NewTimeRecorder("getStatisticTask PostExecute")
-	defer func() {
-		tr.Elapse("done")
-	}()
+// getPartitionStatistics gets the statistics of each partition asked by the request, the loaded ones from QueryNode
+// and the others from DataCoord, the same as the statistics of the whole request.
+func (g *getStatisticsTask) getPartitionStatistics(ctx context.Context) error {
+	partitions, err := globalMetaCache.GetPartitions(ctx, g.request.GetDbName(), g.collectionName)
+	if err != nil {
+		return err
+	}
+	partitionNames := make(map[UniqueID]string, len(partitions))
+	for name, partitionID := range partitions {
+		partitionNames[partitionID] = name
+	}
 
-	if g.fromQueryNode {
-		select {
-		case <-g.TraceCtx().Done():
-			log.Debug("wait to finish timeout!", zap.Int64("msgID", g.ID()))
-			return nil
-		default:
-			log.Debug("all get statistics are finished or canceled", zap.Int64("msgID", g.ID()))
-			close(g.resultBuf)
-			for res := range g.resultBuf {
-				g.toReduceResults = append(g.toReduceResults, res)
-				log.Debug("proxy receives one get statistic response", zap.Int64("sourceID", res.GetBase().GetSourceID()), zap.Int64("msgID", g.ID()))
-			}
+	loadedSet := typeutil.NewUniqueSet(g.loadedPartitionIDs...)
+	partIDs := make([]UniqueID, 0, len(g.loadedPartitionIDs)+len(g.unloadedPartitionIDs))
+	partIDs = append(partIDs, g.loadedPartitionIDs...)
+	partIDs = append(partIDs, g.unloadedPartitionIDs...)
+	if len(partIDs) == 0 {
+		// failed to check the load state of the whole collection, all the partitions are got from DataCoord
+		for partitionID := range partitionNames {
+			partIDs = append(partIDs, partitionID)
 		}
 	}
+	sort.Slice(partIDs, func(i, j int) bool { return partIDs[i] < partIDs[j] })
+
+	for _, partitionID := range partIDs {
+		var queryNodeResults, dataCoordResults []*internalpb.GetStatisticsResponse
+		if loadedSet.Contain(partitionID) {
+			queryNodeResults, err = g.getStatisticsFromQueryNode(ctx, []UniqueID{partitionID})
+		} else {
+			var result *internalpb.GetStatisticsResponse
+			result, err = g.getStatisticsFromDataCoord(ctx, []UniqueID{partitionID})
+			dataCoordResults = append(dataCoordResults, result)
+		}
+		if err != nil {
+			return fmt.Errorf("fail to get statistics of partition %d, err=%w", partitionID, err)
+		}
+		stats, err := mergeStatisticResults(queryNodeResults, dataCoordResults)
+		if err != nil {
+			return err
+		}
+		g.partitionStats = append(g.partitionStats, &milvuspb.PartitionStatistics{
+			PartitionName: partitionNames[partitionID],
+			PartitionID:   partitionID,
+			Stats:         stats,
+		})
+	}
+	return nil
+}
 
-	validResults, err := decodeGetStatisticsResults(g.toReduceResults)
+// prepareSegmentStatistics validates the segments asked by the request belong to the collection and partitions.
+// The statistics of the loaded segments are got from the shard leaders of their channels,
+// the others are from the segment info of DataCoord.
+func (g *getStatisticsTask) prepareSegmentStatistics(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
+	resp, err := g.dc.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_SegmentInfo,
+			MsgID:     g.Base.MsgID,
+			Timestamp: g.Base.Timestamp,
+			SourceID:  g.Base.SourceID,
+		},
+		SegmentIDs: g.request.GetSegmentIDs(),
+	})
 	if err != nil {
 		return err
 	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	if err := validateStatisticsSegments(g.collectionName, collID, partIDs, resp.GetInfos()); err != nil {
+		return err
+	}
 
-	result, err := reduceStatisticResponse(validResults)
+	segPartIDs := make([]UniqueID, 0, len(resp.GetInfos()))
+	partitionSet := typeutil.NewUniqueSet()
+	for _, info := range resp.GetInfos() {
+		if !partitionSet.Contain(info.GetPartitionID()) {
+			partitionSet.Insert(info.GetPartitionID())
+			segPartIDs = append(segPartIDs, info.GetPartitionID())
+		}
+	}
+	loaded, _, err := checkFullLoaded(ctx, g.qc, g.request.GetDbName(), g.collectionName, segPartIDs)
+	if err != nil {
+		log.Debug("checkFullLoaded failed, try get segment statistics from DataCoord", zap.Int64("msgID", g.ID()), zap.Error(err))
+		loaded = nil
+	}
+	loadedSet := typeutil.NewUniqueSet(loaded...)
+	g.loadedSegmentChannels = make(map[string]struct{})
+	for _, info := range resp.GetInfos() {
+		if loadedSet.Contain(info.GetPartitionID()) {
+			g.loadedSegmentIDs = append(g.loadedSegmentIDs, info.GetID())
+			g.loadedSegmentChannels[info.GetInsertChannel()] = struct{}{}
+			continue
+		}
+		g.unloadedSegments = append(g.unloadedSegments, info)
+	}
+	if len(g.loadedSegmentIDs) > 0 {
+		g.fromQueryNode = true
+		g.loadedPartitionIDs = loaded
+	}
+	log.Debug("get statistics of segments", zap.Int64("msgID", g.ID()), zap.String("collection", g.collectionName),
+		zap.Int64s("loaded segments", g.loadedSegmentIDs), zap.Int("unloaded segments", len(g.unloadedSegments)))
+	return nil
+}
+
+// validateStatisticsSegments checks the segments belong to the collection, and to the partitions if any is specified.
+func validateStatisticsSegments(collectionName string, collID UniqueID, partIDs []UniqueID, infos []*datapb.SegmentInfo) error {
+	partitionSet := typeutil.NewUniqueSet(partIDs...)
+	for _, info := range infos {
+		if info.GetCollectionID() != collID {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"segment %d does not belong to collection %s", info.GetID(), collectionName)
+		}
+		if len(partIDs) > 0 && !partitionSet.Contain(info.GetPartitionID()) {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"segment %d does not belong to the partitions of collection %s", info.GetID(), collectionName)
+		}
+	}
+	return nil
+}
+
+func (g *getStatisticsTask) PostExecute(ctx context.Context) error {
+	sp, _ := trace.StartSpanFromContextWithOperationName(g.TraceCtx(), "Proxy-GetStatistic-PostExecute")
+	defer sp.Finish()
+	tr := timerecord.NewTimeRecorder("getStatisticTask PostExecute")
+	defer func() {
+		tr.Elapse("done")
+	}()
+
+	result, err := mergeStatisticResults(g.queryNodeResults, g.dataCoordResults)
 	if err != nil {
 		return err
 	}
 	g.result = &milvuspb.GetStatisticsResponse{
-		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-		Stats:  result,
+		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Stats:          result,
+		PartitionStats: g.partitionStats,
 	}
 
 	log.Info("get statistics post execute done", zap.Int64("msgID", g.ID()), zap.Any("result", result))
 	return nil
 }
 
-func (g *getStatisticsTask) getStatisticsFromDataCoord(ctx context.Context) error {
+// getStatisticsFromDataCoord gets the statistics of the partitions from DataCoord, all partitions if partIDs is empty.
+func (g *getStatisticsTask) getStatisticsFromDataCoord(ctx context.Context, partIDs []UniqueID) (*internalpb.GetStatisticsResponse, error) {
 	collID := g.CollectionID
-	partIDs := g.unloadedPartitionIDs
 
 	req := &datapb.GetPartitionStatisticsRequest{
 		Base: &commonpb.MsgBase{
@@ -238,28 +392,51 @@ func (g *getStatisticsTask) getStatisticsFromDataCoord(ctx context.Context) erro
 
 	result, err := g.dc.GetPartitionStatistics(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(result.Status.Reason)
+		return nil, errors.New(result.Status.Reason)
 	}
-	g.toReduceResults = append(g.toReduceResults, &internalpb.GetStatisticsResponse{
+	return &internalpb.GetStatisticsResponse{
 		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 		Stats:  result.Stats,
-	})
-	return nil
+	}, nil
 }
 
-func (g *getStatisticsTask) getStatisticsFromQueryNode(ctx context.Context) error {
-	g.GetStatisticsRequest.PartitionIDs = g.loadedPartitionIDs
+// getStatisticsFromQueryNode gets the statistics of the loaded partitions from the shard leaders, all partitions
+// if partIDs is empty. It returns a result per shard.
+func (g *getStatisticsTask) getStatisticsFromQueryNode(ctx context.Context, partIDs []UniqueID) ([]*internalpb.GetStatisticsResponse, error) {
+	req := proto.Clone(g.GetStatisticsRequest).(*internalpb.GetStatisticsRequest)
+	req.PartitionIDs = partIDs
+
+	var mu sync.Mutex
+	var results []*internalpb.GetStatisticsResponse
+	getStatisticsShard := func(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
+		result, err := g.getStatisticsShard(ctx, req, nodeID, qn, channelIDs)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		results = append(results, result)
+		return nil
+	}
 
 	executeGetStatistics := func(withCache bool) error {
-		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, g.collectionName)
+		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, g.request.GetDbName(), g.collectionName)
 		if err != nil {
 			return err
 		}
-		g.resultBuf = make(chan *internalpb.GetStatisticsResponse, len(shard2Leaders))
-		if err := g.statisticShardPolicy(ctx, g.shardMgr, g.getStatisticsShard, shard2Leaders); err != nil {
+		if g.loadedSegmentChannels != nil {
+			// only the shard leaders of the segments asked by the request
+			for channel := range shard2Leaders {
+				if _, ok := g.loadedSegmentChannels[channel]; !ok {
+					delete(shard2Leaders, channel)
+				}
+			}
+		}
+		results = nil
+		if err := g.statisticShardPolicy(ctx, g.shardMgr, getStatisticsShard, shard2Leaders); err != nil {
 			log.Warn("failed to get statistics", zap.Int64("msgID", g.ID()), zap.Error(err), zap.String("Shards", fmt.Sprintf("%v", shard2Leaders)))
 			return err
 		}
@@ -273,47 +450,47 @@ func (g *getStatisticsTask) getStatisticsFromQueryNode(ctx context.Context) erro
 		err = executeGetStatistics(WithoutCache)
 	}
 	if err != nil {
-		return fmt.Errorf("fail to get statistics on all shard leaders, err=%w", err)
+		return nil, fmt.Errorf("fail to get statistics on all shard leaders, err=%w", err)
 	}
 
-	return nil
+	return results, nil
 }
 
-func (g *getStatisticsTask) getStatisticsShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
+func (g *getStatisticsTask) getStatisticsShard(ctx context.Context, statisticsReq *internalpb.GetStatisticsRequest,
+	nodeID int64, qn types.QueryNode, channelIDs []string) (*internalpb.GetStatisticsResponse, error) {
 	req := &querypb.GetStatisticsRequest{
-		Req:         g.GetStatisticsRequest,
+		Req:         statisticsReq,
 		DmlChannels: channelIDs,
+		SegmentIDs:  g.loadedSegmentIDs,
 		Scope:       querypb.DataScope_All,
 	}
 	result, err := qn.GetStatistics(ctx, req)
 	if err != nil {
 		log.Warn("QueryNode statistic return error", zap.Int64("msgID", g.ID()),
 			zap.Int64("nodeID", nodeID), zap.Strings("channels", channelIDs), zap.Error(err))
-		return err
+		return nil, err
 	}
 	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
 		log.Warn("QueryNode is not shardLeader", zap.Int64("msgID", g.ID()),
 			zap.Int64("nodeID", nodeID), zap.Strings("channels", channelIDs))
-		return errInvalidShardLeaders
+		return nil, errInvalidShardLeaders
 	}
 	if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		log.Warn("QueryNode statistic result error", zap.Int64("msgID", g.ID()),
 			zap.Int64("nodeID", nodeID), zap.String("reason", result.GetStatus().GetReason()))
-		return fmt.Errorf("fail to get statistic, QueryNode ID=%d, reason=%s", nodeID, result.GetStatus().GetReason())
+		return nil, fmt.Errorf("fail to get statistic, QueryNode ID=%d, reason=%s", nodeID, result.GetStatus().GetReason())
 	}
-	g.resultBuf <- result
-
-	return nil
+	return result, nil
 }
 
 // checkFullLoaded check if collection / partition was fully loaded into QueryNode
 // return loaded partitions, unloaded partitions and error
-func checkFullLoaded(ctx context.Context, qc types.QueryCoord, collectionName string, searchPartitionIDs []UniqueID) ([]UniqueID, []UniqueID, error) {
+func checkFullLoaded(ctx context.Context, qc types.QueryCoord, database, collectionName string, searchPartitionIDs []UniqueID) ([]UniqueID, []UniqueID, error) {
 	var loadedPartitionIDs []UniqueID
 	var unloadPartitionIDs []UniqueID
 
 	// TODO: Consider to check if partition loaded from cache to save rpc.
-	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+	info, err := globalMetaCache.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return nil, nil, fmt.Errorf("GetCollectionInfo failed, collection = %s, err = %s", collectionName, err)
 	}
@@ -336,10 +513,13 @@ func checkFullLoaded(ctx context.Context, qc types.QueryCoord, collectionName st
 		}
 
 		for i, percentage := range resp.GetInMemoryPercentages() {
+			// a partition is either loaded or not, so that the statistics of its sealed segments, which are both
+			// flushed and loaded, are not counted twice
 			if percentage >= 100 {
 				loadedPartitionIDs = append(loadedPartitionIDs, resp.GetPartitionIDs()[i])
+			} else {
+				unloadPartitionIDs = append(unloadPartitionIDs, resp.GetPartitionIDs()[i])
 			}
-			unloadPartitionIDs = append(unloadPartitionIDs, resp.GetPartitionIDs()[i])
 		}
 		return loadedPartitionIDs, unloadPartitionIDs, nil
 	}
@@ -389,15 +569,15 @@ func decodeGetStatisticsResults(results []*internalpb.GetStatisticsResponse) ([]
 
 func reduceStatisticResponse(results []map[string]string) ([]*commonpb.KeyValuePair, error) {
 	mergedResults := map[string]interface{}{
-		"row_count": int64(0),
+		rowCountKey: int64(0),
 	}
 	fieldMethod := map[string]func(string) error{
-		"row_count": func(str string) error {
+		rowCountKey: func(str string) error {
 			count, err := strconv.ParseInt(str, 10, 64)
 			if err != nil {
 				return err
 			}
-			mergedResults["row_count"] = mergedResults["row_count"].(int64) + count
+			mergedResults[rowCountKey] = mergedResults[rowCountKey].(int64) + count
 			return nil
 		},
 	}
@@ -412,6 +592,39 @@ func reduceStatisticResponse(results []map[string]string) ([]*commonpb.KeyValueP
 	return funcutil.Map2KeyValuePair(stringMap), err
 }
 
+// mergeStatisticResults reduces the statistics from QueryNode and DataCoord, the row count of each is also returned
+// as in_memory_row_count and flushed_row_count. They never cover the same segments, since a partition is got from
+// QueryNode only if it's loaded.
+func mergeStatisticResults(queryNodeResults, dataCoordResults []*internalpb.GetStatisticsResponse) ([]*commonpb.KeyValuePair, error) {
+	reduce := func(results []*internalpb.GetStatisticsResponse) ([]*commonpb.KeyValuePair, error) {
+		validResults, err := decodeGetStatisticsResults(results)
+		if err != nil {
+			return nil, err
+		}
+		return reduceStatisticResponse(validResults)
+	}
+
+	inMemory, err := reduce(queryNodeResults)
+	if err != nil {
+		return nil, err
+	}
+	flushed, err := reduce(dataCoordResults)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*internalpb.GetStatisticsResponse, 0, len(queryNodeResults)+len(dataCoordResults))
+	results = append(results, queryNodeResults...)
+	results = append(results, dataCoordResults...)
+	merged, err := reduce(results)
+	if err != nil {
+		return nil, err
+	}
+	return append(merged,
+		&commonpb.KeyValuePair{Key: inMemoryRowCountKey, Value: funcutil.KeyValuePair2Map(inMemory)[rowCountKey]},
+		&commonpb.KeyValuePair{Key: flushedRowCountKey, Value: funcutil.KeyValuePair2Map(flushed)[rowCountKey]},
+	), nil
+}
+
 // implement Task
 // try to compatible with old API (getCollectionStatistics & getPartitionStatistics)
 
@@ -634,7 +847,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -724,12 +937,12 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.GetDbName(), g.CollectionName)
 	if err != nil {
 		return err
 	}
 	g.collectionID = collID
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.GetDbName(), g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,354 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestValidateStatisticsSegments(t *testing.T) {
+	infos := []*datapb.SegmentInfo{
+		{ID: 100, CollectionID: 1, PartitionID: 10},
+		{ID: 101, CollectionID: 1, PartitionID: 11},
+	}
+	assert.NoError(t, validateStatisticsSegments("coll", 1, nil, infos))
+	assert.NoError(t, validateStatisticsSegments("coll", 1, []UniqueID{10, 11}, infos))
+
+	err := validateStatisticsSegments("coll", 2, nil, infos)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+	err = validateStatisticsSegments("coll", 1, []UniqueID{10}, infos)
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestGetStatisticsTask_Segments(t *testing.T) {
+	ctx := context.Background()
+	cache := &mockCache{}
+	cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		return &collectionInfo{collID: 1}, nil
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	// partition 10 is loaded, partition 11 is not
+	qc := NewQueryCoordMock()
+	qc.SetShowPartitionsFunc(func(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+		resp := &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		for _, partID := range req.GetPartitionIDs() {
+			resp.PartitionIDs = append(resp.PartitionIDs, partID)
+			if partID == 10 {
+				resp.InMemoryPercentages = append(resp.InMemoryPercentages, 100)
+			} else {
+				resp.InMemoryPercentages = append(resp.InMemoryPercentages, 0)
+			}
+		}
+		return resp, nil
+	})
+	segments := map[UniqueID]*datapb.SegmentInfo{
+		100: {ID: 100, CollectionID: 1, PartitionID: 10, InsertChannel: "dml-0", NumOfRows: 1},
+		101: {ID: 101, CollectionID: 1, PartitionID: 11, InsertChannel: "dml-1", NumOfRows: 5},
+		102: {ID: 102, CollectionID: 1, PartitionID: 11, InsertChannel: "dml-0", NumOfRows: 3},
+		200: {ID: 200, CollectionID: 2, PartitionID: 20, InsertChannel: "dml-2", NumOfRows: 7},
+	}
+	dc := &DataCoordMock{
+		getSegmentInfoFunc: func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+			resp := &datapb.GetSegmentInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+			for _, id := range req.GetSegmentIDs() {
+				info, ok := segments[id]
+				if !ok {
+					return &datapb.GetSegmentInfoResponse{
+						Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "segment not found"},
+					}, nil
+				}
+				resp.Infos = append(resp.Infos, info)
+			}
+			return resp, nil
+		},
+	}
+
+	newTask := func(segmentIDs ...UniqueID) *getStatisticsTask {
+		task := &getStatisticsTask{
+			request: &milvuspb.GetStatisticsRequest{
+				CollectionName: "coll",
+				SegmentIDs:     segmentIDs,
+			},
+			collectionName: "coll",
+			ctx:            ctx,
+			dc:             dc,
+			qc:             qc,
+		}
+		require.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	t.Run("valid segments", func(t *testing.T) {
+		task := newTask(100, 101, 102)
+		require.NoError(t, task.prepareSegmentStatistics(ctx, 1, nil))
+		assert.True(t, task.fromQueryNode)
+		assert.False(t, task.fromDataCoord)
+		assert.Equal(t, []UniqueID{100}, task.loadedSegmentIDs)
+		assert.Equal(t, map[string]struct{}{"dml-0": {}}, task.loadedSegmentChannels)
+		assert.Len(t, task.unloadedSegments, 2)
+	})
+
+	t.Run("unloaded segments", func(t *testing.T) {
+		task := newTask(101, 102)
+		require.NoError(t, task.prepareSegmentStatistics(ctx, 1, nil))
+		assert.False(t, task.fromQueryNode)
+		assert.Empty(t, task.loadedSegmentIDs)
+
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: "row_count", Value: "8"},
+			{Key: "in_memory_row_count", Value: "0"},
+			{Key: "flushed_row_count", Value: "8"},
+		}, task.result.GetStats())
+	})
+
+	t.Run("segment of another collection", func(t *testing.T) {
+		task := newTask(100, 200)
+		err := task.prepareSegmentStatistics(ctx, 1, nil)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("segment of another partition", func(t *testing.T) {
+		task := newTask(100, 101)
+		err := task.prepareSegmentStatistics(ctx, 1, []UniqueID{10})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("segment not found", func(t *testing.T) {
+		task := newTask(100, 300)
+		assert.Error(t, task.prepareSegmentStatistics(ctx, 1, nil))
+	})
+}
+
+// partitionRowsQueryNode returns the row count of the partitions asked, all the partitions if none is asked.
+type partitionRowsQueryNode struct {
+	*QueryNodeMock
+	rows map[UniqueID]int64
+}
+
+func (qn *partitionRowsQueryNode) GetStatistics(ctx context.Context, req *querypb.GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error) {
+	var rowCount int64
+	for partitionID, rows := range qn.rows {
+		if len(req.GetReq().GetPartitionIDs()) == 0 || funcutil.SliceContain(req.GetReq().GetPartitionIDs(), partitionID) {
+			rowCount += rows
+		}
+	}
+	return &internalpb.GetStatisticsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Stats:  []*commonpb.KeyValuePair{{Key: "row_count", Value: strconv.FormatInt(rowCount, 10)}},
+	}, nil
+}
+
+func TestGetStatisticsTask_LoadedAndUnloaded(t *testing.T) {
+	Params.InitOnce()
+	ctx := context.Background()
+	partitions := map[string]UniqueID{"p1": 10, "p2": 11}
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (UniqueID, error) {
+		return 1, nil
+	})
+	cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		return &collectionInfo{collID: 1, partInfo: map[string]*partitionInfo{
+			"p1": {partitionID: 10},
+			"p2": {partitionID: 11},
+		}}, nil
+	})
+	cache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return partitions, nil
+	})
+	cache.setGetShardsFunc(func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+		return map[string][]nodeInfo{"dml-0": {{nodeID: 1}}}, nil
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	// partition 10 is loaded, partition 11 is not
+	qc := NewQueryCoordMock()
+	qc.SetShowPartitionsFunc(func(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+		resp := &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		partIDs := req.GetPartitionIDs()
+		if len(partIDs) == 0 {
+			partIDs = []UniqueID{10, 11}
+		}
+		for _, partID := range partIDs {
+			resp.PartitionIDs = append(resp.PartitionIDs, partID)
+			if partID == 10 {
+				resp.InMemoryPercentages = append(resp.InMemoryPercentages, 100)
+			} else {
+				resp.InMemoryPercentages = append(resp.InMemoryPercentages, 0)
+			}
+		}
+		return resp, nil
+	})
+	// the 4 flushed rows of partition 10 are loaded, the query node also has 2 growing rows of it
+	flushedRows := map[UniqueID]int64{10: 4, 11: 5}
+	qn := &partitionRowsQueryNode{QueryNodeMock: &QueryNodeMock{}, rows: map[UniqueID]int64{10: 6}}
+	var dcPartitionIDs [][]UniqueID
+	dc := &DataCoordMock{
+		getPartitionStatisticsFunc: func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+			dcPartitionIDs = append(dcPartitionIDs, req.GetPartitionIDs())
+			var rowCount int64
+			for partitionID, rows := range flushedRows {
+				if len(req.GetPartitionIDs()) == 0 || funcutil.SliceContain(req.GetPartitionIDs(), partitionID) {
+					rowCount += rows
+				}
+			}
+			return &datapb.GetPartitionStatisticsResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Stats:  []*commonpb.KeyValuePair{{Key: "row_count", Value: strconv.FormatInt(rowCount, 10)}},
+			}, nil
+		},
+	}
+	policy := func(ctx context.Context, mgr *shardClientMgr, query func(context.Context, UniqueID, types.QueryNode, []string) error, leaders map[string][]nodeInfo) error {
+		for channel, nodes := range leaders {
+			if err := query(ctx, nodes[0].nodeID, qn, []string{channel}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	run := func(t *testing.T, request *milvuspb.GetStatisticsRequest) *milvuspb.GetStatisticsResponse {
+		dcPartitionIDs = nil
+		task := &getStatisticsTask{
+			request:              request,
+			Condition:            NewTaskCondition(ctx),
+			ctx:                  ctx,
+			dc:                   dc,
+			qc:                   qc,
+			statisticShardPolicy: policy,
+		}
+		require.NoError(t, task.OnEnqueue())
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+		return task.result
+	}
+
+	t.Run("collection", func(t *testing.T) {
+		result := run(t, &milvuspb.GetStatisticsRequest{CollectionName: "coll"})
+		stats := funcutil.KeyValuePair2Map(result.GetStats())
+		assert.Equal(t, "11", stats["row_count"])
+		assert.Equal(t, "6", stats["in_memory_row_count"])
+		assert.Equal(t, "5", stats["flushed_row_count"])
+		assert.Equal(t, [][]UniqueID{{11}}, dcPartitionIDs)
+		assert.Empty(t, result.GetPartitionStats())
+	})
+
+	t.Run("partitions", func(t *testing.T) {
+		// the loaded partition is not got from DataCoord, otherwise its flushed rows are counted twice
+		result := run(t, &milvuspb.GetStatisticsRequest{CollectionName: "coll", PartitionNames: []string{"p1", "p2"}})
+		stats := funcutil.KeyValuePair2Map(result.GetStats())
+		assert.Equal(t, "11", stats["row_count"])
+		assert.Equal(t, "6", stats["in_memory_row_count"])
+		assert.Equal(t, "5", stats["flushed_row_count"])
+		assert.Equal(t, [][]UniqueID{{11}}, dcPartitionIDs)
+	})
+
+	t.Run("loaded partition", func(t *testing.T) {
+		result := run(t, &milvuspb.GetStatisticsRequest{CollectionName: "coll", PartitionNames: []string{"p1"}})
+		stats := funcutil.KeyValuePair2Map(result.GetStats())
+		assert.Equal(t, "6", stats["row_count"])
+		assert.Equal(t, "6", stats["in_memory_row_count"])
+		assert.Equal(t, "0", stats["flushed_row_count"])
+		assert.Empty(t, dcPartitionIDs)
+	})
+
+	t.Run("with partition stats", func(t *testing.T) {
+		result := run(t, &milvuspb.GetStatisticsRequest{CollectionName: "coll", WithPartitionStats: true})
+		stats := funcutil.KeyValuePair2Map(result.GetStats())
+		assert.Equal(t, "11", stats["row_count"])
+		require.Len(t, result.GetPartitionStats(), 2)
+
+		p1 := result.GetPartitionStats()[0]
+		assert.Equal(t, "p1", p1.GetPartitionName())
+		assert.Equal(t, UniqueID(10), p1.GetPartitionID())
+		assert.Equal(t, map[string]string{"row_count": "6", "in_memory_row_count": "6", "flushed_row_count": "0"},
+			funcutil.KeyValuePair2Map(p1.GetStats()))
+
+		p2 := result.GetPartitionStats()[1]
+		assert.Equal(t, "p2", p2.GetPartitionName())
+		assert.Equal(t, UniqueID(11), p2.GetPartitionID())
+		assert.Equal(t, map[string]string{"row_count": "5", "in_memory_row_count": "0", "flushed_row_count": "5"},
+			funcutil.KeyValuePair2Map(p2.GetStats()))
+	})
+
+	t.Run("partition stats with segments", func(t *testing.T) {
+		task := &getStatisticsTask{
+			request: &milvuspb.GetStatisticsRequest{
+				CollectionName:     "coll",
+				SegmentIDs:         []UniqueID{100},
+				WithPartitionStats: true,
+			},
+			ctx: ctx,
+			dc:  dc,
+			qc:  qc,
+		}
+		require.NoError(t, task.OnEnqueue())
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+}
+
+func TestCheckFullLoaded_Partitions(t *testing.T) {
+	ctx := context.Background()
+	cache := newMockCache()
+	cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		return &collectionInfo{collID: 1}, nil
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	qc := NewQueryCoordMock()
+	qc.SetShowPartitionsFunc(func(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+		return &querypb.ShowPartitionsResponse{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			PartitionIDs:        []UniqueID{10, 11, 12},
+			InMemoryPercentages: []int64{100, 50, 0},
+		}, nil
+	})
+
+	loaded, unloaded, err := checkFullLoaded(ctx, qc, "", "coll", []UniqueID{10, 11, 12})
+	require.NoError(t, err)
+	assert.Equal(t, []UniqueID{10}, loaded)
+	assert.Equal(t, []UniqueID{11, 12}, unloaded)
+}
@@ -22,8 +22,10 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,12 +36,16 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -368,100 +374,100 @@ func TestTranslateOutputFields(t *testing.T) {
 		},
 	}
 
-	outputFields, err = translateOutputFields([]string{}, schema, false)
+	outputFields, err = translateOutputFields([]string{}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{idFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName, floatVectorFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName, floatVectorFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*"}, schema, false)
+	outputFields, err = translateOutputFields([]string{"*"}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{" * "}, schema, false)
+	outputFields, err = translateOutputFields([]string{" * "}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%"}, schema, false)
+	outputFields, err = translateOutputFields([]string{"%"}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{" % "}, schema, false)
+	outputFields, err = translateOutputFields([]string{" % "}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", "%"}, schema, false)
+	outputFields, err = translateOutputFields([]string{"*", "%"}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", tsFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{"*", tsFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", floatVectorFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{"*", floatVectorFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%", floatVectorFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{"%", floatVectorFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%", idFieldName}, schema, false)
+	outputFields, err = translateOutputFields([]string{"%", idFieldName}, schema, false, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
 	//=========================================================================
-	outputFields, err = translateOutputFields([]string{}, schema, true)
+	outputFields, err = translateOutputFields([]string{}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{idFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName, floatVectorFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{idFieldName, tsFieldName, floatVectorFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*"}, schema, true)
+	outputFields, err = translateOutputFields([]string{"*"}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%"}, schema, true)
+	outputFields, err = translateOutputFields([]string{"%"}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", "%"}, schema, true)
+	outputFields, err = translateOutputFields([]string{"*", "%"}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", tsFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{"*", tsFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"*", floatVectorFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{"*", floatVectorFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, tsFieldName, floatVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%", floatVectorFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{"%", floatVectorFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 
-	outputFields, err = translateOutputFields([]string{"%", idFieldName}, schema, true)
+	outputFields, err = translateOutputFields([]string{"%", idFieldName}, schema, true, false)
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 }
@@ -566,6 +572,16 @@ func TestCreateCollectionTask(t *testing.T) {
 		task.ShardsNum = Params.ProxyCfg.MaxShardNum + 1
 		err = task.PreExecute(ctx)
 		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		task.ShardsNum = -1
+		err = task.PreExecute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// the default number of shards
+		task.ShardsNum = 0
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, Params.ProxyCfg.DefaultShardNum, task.ShardsNum)
 		task.ShardsNum = shardsNum
 
 		reqBackup := proto.Clone(task.CreateCollectionRequest).(*milvuspb.CreateCollectionRequest)
@@ -745,6 +761,54 @@ func TestCreateCollectionTask(t *testing.T) {
 			assert.Error(t, err)
 		}
 	})
+
+	t.Run("partition key", func(t *testing.T) {
+		withPartitionKey := func(dataType schemapb.DataType) []byte {
+			schema := constructCollectionSchemaByDataType(collectionName, fieldName2Type, int64Field, false)
+			for _, field := range schema.Fields {
+				if field.DataType == dataType {
+					field.IsPartitionKey = true
+				}
+			}
+			marshaledSchema, err := proto.Marshal(schema)
+			require.NoError(t, err)
+			return marshaledSchema
+		}
+		preExecute := func(schema []byte, numPartitions int64) (*createCollectionTask, error) {
+			task := &createCollectionTask{
+				Condition: NewTaskCondition(ctx),
+				CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+					Base:           &commonpb.MsgBase{},
+					CollectionName: collectionName,
+					Schema:         schema,
+					ShardsNum:      shardsNum,
+					NumPartitions:  numPartitions,
+				},
+				ctx:       ctx,
+				rootCoord: rc,
+			}
+			return task, task.PreExecute(ctx)
+		}
+
+		// the rows aren't routed by the partition key yet
+		_, err := preExecute(withPartitionKey(schemapb.DataType_VarChar), 0)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		_, err = preExecute(withPartitionKey(schemapb.DataType_VarChar), 16)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// no partition key declared
+		task, err := preExecute(marshaledSchema, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), task.NumPartitions)
+		_, err = preExecute(marshaledSchema, 16)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// invalid partition key
+		_, err = preExecute(withPartitionKey(schemapb.DataType_Int64), 16)
+		assert.Error(t, err, "primary key as partition key")
+		_, err = preExecute(withPartitionKey(schemapb.DataType_FloatVector), 16)
+		assert.Error(t, err, "vector field as partition key")
+	})
 }
 
 func TestDropCollectionTask(t *testing.T) {
@@ -799,7 +863,21 @@ func TestDropCollectionTask(t *testing.T) {
 		return 0, errors.New("mock")
 	})
 	err = task.Execute(ctx)
-	assert.NoError(t, err)
+	assert.Error(t, err)
+
+	// the collection doesn't exist
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 0, errCollectionNotExists(collectionName)
+	})
+	for _, ifExists := range []bool{false, true} {
+		task.IfExists = ifExists
+		task.result = nil
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	}
+	task.IfExists = false
+
 	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
 		return 0, nil
 	})
@@ -820,6 +898,148 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateCollectionTask_IfNotExists(t *testing.T) {
+	Params.InitOnce()
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	ctx := context.Background()
+	collectionName := "TestCreateCollectionTask_IfNotExists" + funcutil.GenRandomStr()
+
+	newSchema := func(dim string) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{Name: "title", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+				{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: dim}}},
+			},
+		}
+	}
+	// existedSchema is the schema of the collection created by newSchema, as returned by the meta cache
+	existedSchema := func(dim string) *schemapb.CollectionSchema {
+		schema := newSchema(dim)
+		for i, field := range schema.Fields {
+			field.FieldID = common.StartOfUserFieldID + int64(i)
+		}
+		// the fields in another order
+		schema.Fields[0], schema.Fields[2] = schema.Fields[2], schema.Fields[0]
+		return schema
+	}
+
+	cache := newMockCache()
+	globalMetaCache = cache
+	rc := newMockRootCoord()
+	created := 0
+	rc.CreateCollectionFunc = func(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+		created++
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	newTask := func(ifNotExists bool) *createCollectionTask {
+		marshaledSchema, err := proto.Marshal(newSchema("8"))
+		require.NoError(t, err)
+		task := &createCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				IfNotExists:    ifNotExists,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		require.NoError(t, task.PreExecute(ctx))
+		return task
+	}
+
+	t.Run("not exists", func(t *testing.T) {
+		created = 0
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return nil, errCollectionNotExists(collectionName)
+		})
+		for _, ifNotExists := range []bool{true, false} {
+			task := newTask(ifNotExists)
+			assert.NoError(t, task.Execute(ctx))
+			assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+		}
+		assert.Equal(t, 2, created)
+	})
+
+	t.Run("exists with the same schema", func(t *testing.T) {
+		created = 0
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return existedSchema("8"), nil
+		})
+		task := newTask(true)
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+		assert.Equal(t, 0, created)
+	})
+
+	t.Run("exists with a different schema", func(t *testing.T) {
+		created = 0
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return existedSchema("16"), nil
+		})
+		task := newTask(true)
+		err := task.Execute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_SchemaMismatch, errorCodeOf(err))
+		assert.Equal(t, 0, created)
+
+		// rootCoord decides without if_not_exists
+		task = newTask(false)
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("failed to get the schema", func(t *testing.T) {
+		created = 0
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return nil, errors.New("mock")
+		})
+		task := newTask(true)
+		assert.Error(t, task.Execute(ctx))
+		assert.Equal(t, 0, created)
+	})
+}
+
+func Test_collectionSchemaHash(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "Test_collectionSchemaHash",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true, TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{Name: "vec", DataType: schemapb.DataType_BinaryVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}, {Key: "foo", Value: "bar"}}},
+		},
+	}
+	hash := collectionSchemaHash(schema)
+
+	// the order of the fields and the type params, the field ids, the states and the system fields don't matter
+	same := proto.Clone(schema).(*schemapb.CollectionSchema)
+	same.Fields[0], same.Fields[1] = same.Fields[1], same.Fields[0]
+	same.Fields[0].TypeParams[0], same.Fields[0].TypeParams[1] = same.Fields[0].TypeParams[1], same.Fields[0].TypeParams[0]
+	same.Fields[0].FieldID = 101
+	same.Fields[1].FieldID = 100
+	same.Fields[1].State = schemapb.FieldState_FieldCreated
+	same.Fields = append(same.Fields,
+		&schemapb.FieldSchema{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+		&schemapb.FieldSchema{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64})
+	assert.Equal(t, hash, collectionSchemaHash(same))
+
+	for name, modify := range map[string]func(schema *schemapb.CollectionSchema){
+		"type param": func(schema *schemapb.CollectionSchema) { schema.Fields[1].TypeParams[0].Value = "256" },
+		"data type":  func(schema *schemapb.CollectionSchema) { schema.Fields[1].DataType = schemapb.DataType_FloatVector },
+		"field name": func(schema *schemapb.CollectionSchema) { schema.Fields[1].Name = "vector" },
+		"auto id":    func(schema *schemapb.CollectionSchema) { schema.Fields[0].AutoID = true },
+		"more field": func(schema *schemapb.CollectionSchema) {
+			schema.Fields = append(schema.Fields, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64})
+		},
+	} {
+		different := proto.Clone(schema).(*schemapb.CollectionSchema)
+		modify(different)
+		assert.NotEqual(t, hash, collectionSchemaHash(different), name)
+	}
+}
+
 func TestHasCollectionTask(t *testing.T) {
 	Params.InitOnce()
 	rc := NewRootCoordMock()
@@ -885,7 +1105,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1006,7 +1226,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1069,7 +1289,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, "", collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1297,6 +1517,228 @@ func TestShowPartitionsTask(t *testing.T) {
 	assert.NotNil(t, err)
 
 }
+
+func TestShowTask_InMemoryNotLoaded(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rc, qc, mgr)
+	require.NoError(t, err)
+
+	collectionName := "TestShowTask_InMemoryNotLoaded" + funcutil.GenRandomStr()
+	partitionName := "TestShowTask_InMemoryNotLoaded" + funcutil.GenRandomStr()
+	createColl(t, collectionName, rc)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	require.NoError(t, err)
+	status, err := rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+		return &querypb.ShowPartitionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("collection %v has not been loaded into QueryNode", request.GetCollectionID()),
+			},
+		}, nil
+	})
+	defer qc.ResetShowPartitionsFunc()
+
+	t.Run("show partitions", func(t *testing.T) {
+		task := &showPartitionsTask{
+			Condition: NewTaskCondition(ctx),
+			ShowPartitionsRequest: &milvuspb.ShowPartitionsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ShowPartitions,
+				},
+				CollectionName: collectionName,
+				PartitionNames: []string{partitionName},
+				Type:           milvuspb.ShowType_InMemory,
+			},
+			ctx:        ctx,
+			rootCoord:  rc,
+			queryCoord: qc,
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+		assert.Equal(t, []string{partitionName}, task.result.GetPartitionNames())
+		assert.Equal(t, []int64{0}, task.result.GetInMemoryPercentages())
+
+		// no partition specified, nothing loaded
+		task.PartitionNames = nil
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, 0, len(task.result.GetPartitionNames()))
+
+		// partition not exist
+		task.PartitionNames = []string{"not_exist_partition"}
+		assert.Error(t, task.Execute(ctx))
+	})
+
+	t.Run("show partitions, collection loaded but failed", func(t *testing.T) {
+		qc.SetShowCollectionsFunc(func(ctx context.Context, request *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+			return &querypb.ShowCollectionsResponse{
+				Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				CollectionIDs:       []int64{collectionID},
+				InMemoryPercentages: []int64{100},
+			}, nil
+		})
+		defer qc.ResetShowCollectionsFunc()
+
+		task := &showPartitionsTask{
+			Condition: NewTaskCondition(ctx),
+			ShowPartitionsRequest: &milvuspb.ShowPartitionsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ShowPartitions,
+				},
+				CollectionName: collectionName,
+				PartitionNames: []string{partitionName},
+				Type:           milvuspb.ShowType_InMemory,
+			},
+			ctx:        ctx,
+			rootCoord:  rc,
+			queryCoord: qc,
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Error(t, task.Execute(ctx))
+	})
+
+	t.Run("show collections", func(t *testing.T) {
+		task := &showCollectionsTask{
+			Condition: NewTaskCondition(ctx),
+			ShowCollectionsRequest: &milvuspb.ShowCollectionsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ShowCollections,
+				},
+				CollectionNames: []string{collectionName},
+				Type:            milvuspb.ShowType_InMemory,
+			},
+			ctx:        ctx,
+			rootCoord:  rc,
+			queryCoord: qc,
+		}
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetStatus().GetErrorCode())
+		assert.Equal(t, []string{collectionName}, task.result.GetCollectionNames())
+		assert.Equal(t, []int64{0}, task.result.GetInMemoryPercentages())
+		assert.Equal(t, []bool{false}, task.result.GetQueryServiceAvailable())
+
+		task.CollectionNames = nil
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, 0, len(task.result.GetCollectionNames()))
+	})
+}
+
+func TestShowPartitionsTask_WithRowCounts(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	dc := NewDataCoordMock()
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rc, qc, mgr)
+	require.NoError(t, err)
+
+	collectionName := "TestShowPartitionsTask_WithRowCounts" + funcutil.GenRandomStr()
+	createColl(t, collectionName, rc)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	require.NoError(t, err)
+	// the mock rootcoord doesn't create the default partition along with the collection
+	for i := 0; i < 2; i++ {
+		status, err := rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			CollectionName: collectionName,
+			PartitionName:  "TestShowPartitionsTask_WithRowCounts" + funcutil.GenRandomStr(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	}
+
+	calls := 0
+	dc.getPartitionStatisticsFunc = func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+		calls++
+		assert.Equal(t, collectionID, req.GetCollectionID())
+		assert.True(t, req.GetPerPartition())
+		rowCounts := make(map[int64]int64)
+		for _, partitionID := range req.GetPartitionIDs() {
+			rowCounts[partitionID] = partitionID * 10
+		}
+		return &datapb.GetPartitionStatisticsResponse{
+			Status:             &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			PartitionRowCounts: rowCounts,
+		}, nil
+	}
+
+	newTask := func(withRowCounts bool) *showPartitionsTask {
+		return &showPartitionsTask{
+			Condition: NewTaskCondition(ctx),
+			ShowPartitionsRequest: &milvuspb.ShowPartitionsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ShowPartitions,
+				},
+				CollectionName: collectionName,
+				Type:           milvuspb.ShowType_All,
+				WithRowCounts:  withRowCounts,
+			},
+			ctx:        ctx,
+			rootCoord:  rc,
+			queryCoord: qc,
+			dataCoord:  dc,
+		}
+	}
+
+	t.Run("without row counts", func(t *testing.T) {
+		task := newTask(false)
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, 2, len(task.result.GetPartitionIDs()))
+		assert.Nil(t, task.result.GetNumRows())
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("with row counts", func(t *testing.T) {
+		task := newTask(true)
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		require.Equal(t, 2, len(task.result.GetPartitionIDs()))
+		require.Equal(t, len(task.result.GetPartitionIDs()), len(task.result.GetNumRows()))
+		for i, partitionID := range task.result.GetPartitionIDs() {
+			assert.Equal(t, partitionID*10, task.result.GetNumRows()[i])
+		}
+		// the row counts are fetched in one call
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("dataCoord fails", func(t *testing.T) {
+		dc.getPartitionStatisticsFunc = func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+			return &datapb.GetPartitionStatisticsResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+			}, nil
+		}
+		task := newTask(true)
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Error(t, task.Execute(ctx))
+
+		dc.getPartitionStatisticsFunc = func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+			return nil, errors.New("mock")
+		}
+		assert.Error(t, task.Execute(ctx))
+	})
+}
+
 func TestTask_Int64PrimaryKey(t *testing.T) {
 	var err error
 
@@ -1371,7 +1813,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1395,6 +1837,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 	assert.NoError(t, err)
 	_ = idAllocator.Start()
 	defer idAllocator.Close()
+	insertIDAllocator := newCachedIDAllocator(idAllocator.Alloc)
 
 	segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
 	assert.NoError(t, err)
@@ -1439,7 +1882,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 				UpsertCnt:    0,
 				Timestamp:    0,
 			},
-			idAllocator:   idAllocator,
+			idAllocator:   insertIDAllocator,
 			segIDAssigner: segAllocator,
 			chMgr:         chMgr,
 			chTicker:      ticker,
@@ -1458,6 +1901,44 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		assert.NoError(t, task.PostExecute(ctx))
 	})
 
+	t.Run("insert into a missing partition", func(t *testing.T) {
+		task := &insertTask{
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: generateHashKeys(nb),
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						SourceID: Params.ProxyCfg.GetNodeID(),
+					},
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName + "_typo",
+					NumRows:        uint64(nb),
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+			Condition:     NewTaskCondition(ctx),
+			ctx:           ctx,
+			idAllocator:   insertIDAllocator,
+			segIDAssigner: segAllocator,
+			chMgr:         chMgr,
+			chTicker:      ticker,
+		}
+		for fieldName, dataType := range fieldName2Types {
+			task.FieldsData = append(task.FieldsData, generateFieldData(dataType, fieldName, nb))
+		}
+
+		assert.NoError(t, task.OnEnqueue())
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+		assert.Contains(t, err.Error(), partitionName)
+		// rows are not prepared for the missing partition
+		assert.Empty(t, task.RowIDs)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		task := &deleteTask{
 			Condition: NewTaskCondition(ctx),
@@ -1548,41 +2029,202 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 			chTicker: ticker,
 		}
 		assert.Error(t, task2.PreExecute(ctx))
+
+		// delete by primary key list is the same as delete by expr
+		task3 := &deleteTask{
+			Condition: NewTaskCondition(ctx),
+			BaseDeleteTask: msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Delete,
+						SourceID: Params.ProxyCfg.GetNodeID(),
+					},
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			primaryKeys: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{0, 1}}},
+			},
+			ctx:      ctx,
+			chMgr:    chMgr,
+			chTicker: ticker,
+		}
+		assert.NoError(t, task3.OnEnqueue())
+		task3.SetTs(ts)
+		assert.NoError(t, task3.PreExecute(ctx))
+		assert.Equal(t, task.NumRows, task3.NumRows)
+		assert.True(t, proto.Equal(task.PrimaryKeys, task3.PrimaryKeys))
+		assert.Equal(t, task.result.GetDeleteCnt(), task3.result.GetDeleteCnt())
+		assert.NoError(t, task3.Execute(ctx))
+		assert.NoError(t, task3.PostExecute(ctx))
 	})
 }
 
-func TestTask_VarCharPrimaryKey(t *testing.T) {
-	var err error
+func Test_deleteTask_getPrimaryKeys(t *testing.T) {
+	fieldName2Types := map[string]schemapb.DataType{
+		testInt64Field:    schemapb.DataType_Int64,
+		testVarCharField:  schemapb.DataType_VarChar,
+		testFloatVecField: schemapb.DataType_FloatVector,
+	}
+	int64PkSchema := constructCollectionSchemaByDataType("test_delete", fieldName2Types, testInt64Field, false)
+	varCharPkSchema := constructCollectionSchemaByDataType("test_delete", fieldName2Types, testVarCharField, false)
+	// the plan parser requires the field ids to be unique
+	for _, schema := range []*schemapb.CollectionSchema{int64PkSchema, varCharPkSchema} {
+		for i, field := range schema.GetFields() {
+			field.FieldID = common.StartOfUserFieldID + int64(i)
+		}
+	}
 
-	Params.InitOnce()
+	int64Keys := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
+	strKeys := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}}
+
+	t.Run("same as expr", func(t *testing.T) {
+		cases := []struct {
+			schema *schemapb.CollectionSchema
+			expr   string
+			keys   *schemapb.IDs
+		}{
+			{int64PkSchema, testInt64Field + " in [1, 2, 3]", int64Keys},
+			{varCharPkSchema, testVarCharField + ` in ["a", "b"]`, strKeys},
+		}
+		for _, c := range cases {
+			exprTask := &deleteTask{deleteExpr: c.expr}
+			exprKeys, exprNumRow, err := exprTask.getPrimaryKeys(c.schema)
+			require.NoError(t, err)
 
-	rc := NewRootCoordMock()
-	rc.Start()
-	defer rc.Stop()
-	qc := NewQueryCoordMock()
-	qc.Start()
-	defer qc.Stop()
+			listTask := &deleteTask{primaryKeys: c.keys}
+			listKeys, listNumRow, err := listTask.getPrimaryKeys(c.schema)
+			require.NoError(t, err)
 
-	ctx := context.Background()
+			assert.Equal(t, exprNumRow, listNumRow)
+			assert.True(t, proto.Equal(exprKeys, listKeys))
+		}
+	})
 
-	mgr := newShardClientMgr()
-	err = InitMetaCache(ctx, rc, qc, mgr)
-	assert.NoError(t, err)
+	t.Run("varchar keys", func(t *testing.T) {
+		keys := []string{`a"b`, "c,d", `e\f`, "[g]", "中文", "é", ""}
+		strs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			strs = append(strs, strconv.Quote(key))
+		}
+		list := "[" + strings.Join(strs, ", ") + "]"
+		expected := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: keys}}}
+
+		// parsed without and with the plan parser
+		for _, expr := range []string{
+			testVarCharField + " in " + list,
+			"(" + testVarCharField + " in " + list + ")",
+		} {
+			ids, numRow, err := (&deleteTask{deleteExpr: expr}).getPrimaryKeys(varCharPkSchema)
+			require.NoError(t, err, expr)
+			assert.Equal(t, int64(len(keys)), numRow)
+			assert.True(t, proto.Equal(expected, ids), expr)
+		}
 
-	shardsNum := int32(2)
-	prefix := "TestTask_all"
-	dbName := ""
-	collectionName := prefix + funcutil.GenRandomStr()
-	partitionName := prefix + funcutil.GenRandomStr()
+		// the escaped unicode
+		ids, _, err := (&deleteTask{deleteExpr: "(" + testVarCharField + ` in ["\u4e2d\u6587"])`}).getPrimaryKeys(varCharPkSchema)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"中文"}, ids.GetStrId().GetData())
 
-	fieldName2Types := map[string]schemapb.DataType{
-		testBoolField:     schemapb.DataType_Bool,
-		testInt32Field:    schemapb.DataType_Int32,
-		testInt64Field:    schemapb.DataType_Int64,
-		testFloatField:    schemapb.DataType_Float,
-		testDoubleField:   schemapb.DataType_Double,
-		testVarCharField:  schemapb.DataType_VarChar,
-		testFloatVecField: schemapb.DataType_FloatVector}
+		// the string keys are hashed to the channels the same way as inserted
+		channels := []string{"ch0", "ch1", "ch2"}
+		for i, hash := range typeutil.HashPK2Channels(expected, channels) {
+			assert.Equal(t, typeutil.HashString2Uint32(keys[i])%uint32(len(channels)), hash)
+		}
+	})
+
+	t.Run("invalid expr", func(t *testing.T) {
+		for _, c := range []struct {
+			schema *schemapb.CollectionSchema
+			expr   string
+		}{
+			{varCharPkSchema, testVarCharField + ` in ["a", 1]`},
+			{varCharPkSchema, "(" + testVarCharField + ` in ["a", 1])`},
+			{varCharPkSchema, testVarCharField + ` in ["a\"]`},
+			{int64PkSchema, testInt64Field + ` in [1, "a"]`},
+			{int64PkSchema, testInt64Field + " in [1, 2.5]"},
+			{int64PkSchema, testInt64Field + " not in [1]"},
+			{int64PkSchema, testInt64Field + " > 1"},
+			{int64PkSchema, testVarCharField + ` in ["a"]`},
+		} {
+			_, _, err := (&deleteTask{deleteExpr: c.expr}).getPrimaryKeys(c.schema)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err), c.expr)
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, _, err := (&deleteTask{primaryKeys: strKeys}).getPrimaryKeys(int64PkSchema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		_, _, err = (&deleteTask{primaryKeys: int64Keys}).getPrimaryKeys(varCharPkSchema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("empty keys", func(t *testing.T) {
+		keys := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}}
+		_, _, err := (&deleteTask{primaryKeys: keys}).getPrimaryKeys(int64PkSchema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("both expr and keys", func(t *testing.T) {
+		task := &deleteTask{deleteExpr: testInt64Field + " in [1]", primaryKeys: int64Keys}
+		_, _, err := task.getPrimaryKeys(int64PkSchema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("expr too long", func(t *testing.T) {
+		limit := Params.ProxyCfg.MaxExpressionLength
+		defer func() { Params.ProxyCfg.MaxExpressionLength = limit }()
+
+		expr := testInt64Field + " in [1, 2, 3]"
+		Params.ProxyCfg.MaxExpressionLength = int64(len(expr)) - 1
+		_, _, err := (&deleteTask{deleteExpr: expr + " and " + testInt64Field + " in [1]"}).getPrimaryKeys(int64PkSchema)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		// the pk in [...] expression takes the fast path without the limit
+		_, _, err = (&deleteTask{deleteExpr: expr}).getPrimaryKeys(int64PkSchema)
+		assert.NoError(t, err)
+
+		// the primary key list isn't limited by the expression length
+		_, _, err = (&deleteTask{primaryKeys: int64Keys}).getPrimaryKeys(int64PkSchema)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTask_VarCharPrimaryKey(t *testing.T) {
+	var err error
+
+	Params.InitOnce()
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+
+	ctx := context.Background()
+
+	mgr := newShardClientMgr()
+	err = InitMetaCache(ctx, rc, qc, mgr)
+	assert.NoError(t, err)
+
+	shardsNum := int32(2)
+	prefix := "TestTask_all"
+	dbName := ""
+	collectionName := prefix + funcutil.GenRandomStr()
+	partitionName := prefix + funcutil.GenRandomStr()
+
+	fieldName2Types := map[string]schemapb.DataType{
+		testBoolField:     schemapb.DataType_Bool,
+		testInt32Field:    schemapb.DataType_Int32,
+		testInt64Field:    schemapb.DataType_Int64,
+		testFloatField:    schemapb.DataType_Float,
+		testDoubleField:   schemapb.DataType_Double,
+		testVarCharField:  schemapb.DataType_VarChar,
+		testFloatVecField: schemapb.DataType_FloatVector}
 	if enableMultipleVectorFields {
 		fieldName2Types[testBinaryVecField] = schemapb.DataType_BinaryVector
 	}
@@ -1626,7 +2268,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1650,6 +2292,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 	assert.NoError(t, err)
 	_ = idAllocator.Start()
 	defer idAllocator.Close()
+	insertIDAllocator := newCachedIDAllocator(idAllocator.Alloc)
 
 	segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
 	assert.NoError(t, err)
@@ -1694,7 +2337,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 				UpsertCnt:    0,
 				Timestamp:    0,
 			},
-			idAllocator:   idAllocator,
+			idAllocator:   insertIDAllocator,
 			segIDAssigner: segAllocator,
 			chMgr:         chMgr,
 			chTicker:      ticker,
@@ -2181,6 +2824,67 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 			},
 		}
 		assert.NoError(t, cit.PreExecute(context.Background()))
+		assert.Equal(t, Params.CommonCfg.DefaultIndexName, cit.GetIndexName())
+
+		cit.CreateIndexRequest.ExtraParams = []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "IVF_FLAT",
+			},
+			{
+				Key:   "nlist",
+				Value: "0",
+			},
+			{
+				Key:   "metric_type",
+				Value: "L2",
+			},
+		}
+		err := cit.PreExecute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+
+		cit.CreateIndexRequest.ExtraParams = []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "HNSW",
+			},
+			{
+				Key:   "metric_type",
+				Value: "L2",
+			},
+		}
+		err = cit.PreExecute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), "M, efConstruction")
+
+		cit.CreateIndexRequest.ExtraParams = []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "IVF_FLAT",
+			},
+			{
+				Key:   "nlist",
+				Value: "1024",
+			},
+			{
+				Key:   "metric_type",
+				Value: "L2",
+			},
+		}
+		cache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return map[string]*indexInfo{Params.CommonCfg.DefaultIndexName: {indexID: 1, fieldID: 101, fieldName: "other"}}, nil
+		})
+		err = cit.PreExecute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IndexNameDuplicated, errorCodeOf(err))
+
+		// the index with the same name on the same field is left to indexCoord.
+		cache.setGetIndexFunc(func(ctx context.Context, collectionName string, indexCoord types.IndexCoord) (map[string]*indexInfo, error) {
+			return map[string]*indexInfo{Params.CommonCfg.DefaultIndexName: {indexID: 1, fieldID: 100, fieldName: fieldName}}, nil
+		})
+		assert.NoError(t, cit.PreExecute(context.Background()))
 	})
 
 	t.Run("collection not found", func(t *testing.T) {
@@ -2191,4 +2895,620 @@ func Test_createIndexTask_PreExecute(t *testing.T) {
 		globalMetaCache = cache
 		assert.Error(t, cit.PreExecute(context.Background()))
 	})
+
+	t.Run("field not found", func(t *testing.T) {
+		cache := newMockCache()
+		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+			return 100, nil
+		})
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "other", DataType: schemapb.DataType_FloatVector},
+				},
+			}, nil
+		})
+		globalMetaCache = cache
+		err := cit.PreExecute(context.Background())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), fieldName)
+	})
+
+	t.Run("unindexable data type", func(t *testing.T) {
+		cache := newMockCache()
+		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+			return 100, nil
+		})
+		cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+			return &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: fieldName, DataType: schemapb.DataType_String},
+				},
+			}, nil
+		})
+		globalMetaCache = cache
+		err := cit.PreExecute(context.Background())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Equal(t, "cannot create index on field test, the data type String is not indexable", err.Error())
+	})
+}
+
+func TestLoadCollectionTask_WarmUpShardLeaders(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	defer func(interval time.Duration) { warmUpCheckInterval = interval }(warmUpCheckInterval)
+	warmUpCheckInterval = 10 * time.Millisecond
+
+	rootCoord := &MockRootCoordClientInterface{}
+	qc := NewQueryCoordMock()
+	qc.validShardLeaders = true
+	require.NoError(t, qc.Init())
+	require.NoError(t, qc.Start())
+	defer qc.Stop()
+	shardMgr := newShardClientMgr()
+	require.NoError(t, InitMetaCache(ctx, rootCoord, qc, shardMgr))
+
+	task := &loadCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		LoadCollectionRequest: &milvuspb.LoadCollectionRequest{
+			Base:           &commonpb.MsgBase{},
+			CollectionName: "collection1",
+		},
+		ctx:        ctx,
+		queryCoord: qc,
+	}
+	require.NoError(t, task.PreExecute(ctx))
+	require.NoError(t, task.Execute(ctx))
+	require.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	require.NoError(t, task.PostExecute(ctx))
+
+	// the shard leaders are fetched into the shard client manager once the collection is fully loaded
+	assert.Eventually(t, func() bool {
+		for _, nodeID := range []UniqueID{1, 2, 3} {
+			if _, ok := shardMgr.GetAddress(nodeID); !ok {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReleaseCollectionTask_Execute(t *testing.T) {
+	ctx := context.Background()
+	collectionName := "test_release_collection"
+	collectionID := UniqueID(100)
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return collectionID, nil
+	})
+	globalMetaCache = mockCache
+
+	qc := NewQueryCoordMock()
+	require.NoError(t, qc.Start())
+	defer qc.Stop()
+
+	newTask := func() *releaseCollectionTask {
+		return &releaseCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			ReleaseCollectionRequest: &milvuspb.ReleaseCollectionRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+			},
+			ctx:        ctx,
+			queryCoord: qc,
+		}
+	}
+	release := func() *commonpb.Status {
+		task := newTask()
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+		return task.result
+	}
+
+	t.Run("not loaded", func(t *testing.T) {
+		mockCache.removedCollections, mockCache.clearedShards = nil, nil
+		assert.Equal(t, commonpb.ErrorCode_Success, release().GetErrorCode())
+		// the cached load info is invalidated even if the collection is not loaded.
+		assert.Equal(t, []string{collectionName}, mockCache.removedCollections)
+		assert.Equal(t, []string{collectionName}, mockCache.clearedShards)
+	})
+
+	t.Run("loaded", func(t *testing.T) {
+		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{CollectionID: collectionID})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		mockCache.removedCollections, mockCache.clearedShards = nil, nil
+		assert.Equal(t, commonpb.ErrorCode_Success, release().GetErrorCode())
+		assert.Equal(t, []string{collectionName}, mockCache.removedCollections)
+		assert.Equal(t, []string{collectionName}, mockCache.clearedShards)
+		assert.NotContains(t, qc.collectionIDs, collectionID)
+	})
+
+	t.Run("released twice", func(t *testing.T) {
+		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{CollectionID: collectionID})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		assert.Equal(t, commonpb.ErrorCode_Success, release().GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_Success, release().GetErrorCode())
+	})
+
+	t.Run("query coord fails", func(t *testing.T) {
+		require.NoError(t, qc.Stop())
+		defer qc.Start()
+
+		mockCache.removedCollections, mockCache.clearedShards = nil, nil
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, release().GetErrorCode())
+		assert.Equal(t, []string{collectionName}, mockCache.removedCollections)
+	})
+}
+
+func TestFlushTask_Partitions(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	collectionName := "test_flush_partitions"
+	collectionID := UniqueID(100)
+	partitions := map[string]UniqueID{"p2023": 101, "p2024": 102}
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return collectionID, nil
+	})
+	mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		if id, ok := partitions[partitionName]; ok {
+			return id, nil
+		}
+		return 0, errPartitionNotExists(collectionName, partitionName, []string{"p2023", "p2024"})
+	})
+	globalMetaCache = mockCache
+
+	var flushReqs []*datapb.FlushRequest
+	dc := NewDataCoordMock()
+	dc.flushFunc = func(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+		flushReqs = append(flushReqs, req)
+		return &datapb.FlushResponse{
+			Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionID: req.GetCollectionID(),
+			SegmentIDs:   []int64{1},
+		}, nil
+	}
+
+	newTask := func(collectionNames []string, partitionNames []string) *flushTask {
+		task := &flushTask{
+			Condition: NewTaskCondition(ctx),
+			FlushRequest: &milvuspb.FlushRequest{
+				CollectionNames: collectionNames,
+				PartitionNames:  partitionNames,
+			},
+			ctx:       ctx,
+			dataCoord: dc,
+		}
+		require.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	t.Run("flush partition", func(t *testing.T) {
+		flushReqs = nil
+		task := newTask([]string{collectionName}, []string{"p2024"})
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.Len(t, flushReqs, 1)
+		assert.Equal(t, collectionID, flushReqs[0].GetCollectionID())
+		assert.Equal(t, []int64{102}, flushReqs[0].GetPartitionIDs())
+		assert.Equal(t, []int64{1}, task.result.GetCollSegIDs()[collectionName].GetData())
+	})
+
+	t.Run("flush collection", func(t *testing.T) {
+		flushReqs = nil
+		task := newTask([]string{collectionName}, nil)
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.Len(t, flushReqs, 1)
+		assert.Empty(t, flushReqs[0].GetPartitionIDs())
+	})
+
+	t.Run("partition not exist", func(t *testing.T) {
+		flushReqs = nil
+		task := newTask([]string{collectionName}, []string{"p2025"})
+		require.NoError(t, task.PreExecute(ctx))
+		err := task.Execute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PartitionNotExists, errorCodeOf(err))
+		assert.Empty(t, flushReqs)
+	})
+
+	t.Run("partitions of multiple collections", func(t *testing.T) {
+		task := newTask([]string{collectionName, "another"}, []string{"p2024"})
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	})
+
+	t.Run("invalid partition name", func(t *testing.T) {
+		task := newTask([]string{collectionName}, []string{"$p"})
+		assert.Error(t, task.PreExecute(ctx))
+	})
+}
+
+func TestAlterCollectionTask(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	InitMetaCache(ctx, rc, qc, mgr)
+	collectionName := "TestAlterCollectionTask" + funcutil.GenRandomStr()
+
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	require.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	disabled, err := globalMetaCache.IsCollectionDisabled(ctx, "", collectionName)
+	assert.NoError(t, err)
+	assert.False(t, disabled)
+
+	newTask := func(properties ...*commonpb.KeyValuePair) *alterCollectionTask {
+		task := &alterCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			AlterCollectionRequest: &milvuspb.AlterCollectionRequest{
+				CollectionName: collectionName,
+				Properties:     properties,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	t.Run("invalid properties", func(t *testing.T) {
+		assert.Error(t, newTask().PreExecute(ctx))
+		assert.Error(t, newTask(&commonpb.KeyValuePair{Key: common.CollectionDisabledKey, Value: "yes"}).PreExecute(ctx))
+	})
+
+	t.Run("disable collection", func(t *testing.T) {
+		task := newTask(&commonpb.KeyValuePair{Key: common.CollectionDisabledKey, Value: "true"})
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, commonpb.MsgType_AlterCollection, task.Type())
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+		// rootCoord invalidates the meta cache of the proxies after altering the collection
+		globalMetaCache.RemoveCollection(ctx, "", collectionName)
+
+		disabled, err := globalMetaCache.IsCollectionDisabled(ctx, "", collectionName)
+		assert.NoError(t, err)
+		assert.True(t, disabled)
+		err = checkCollectionEnabled(ctx, "", collectionName)
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionDisabled, errorCodeOf(err))
+
+		// describe still works for the disabled collection
+		describeTask := &describeCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			DescribeCollectionRequest: &milvuspb.DescribeCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+				CollectionName: collectionName,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.NoError(t, describeTask.PreExecute(ctx))
+		assert.NoError(t, describeTask.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, describeTask.result.GetStatus().GetErrorCode())
+		// the internal property is hidden from describe
+		assert.Empty(t, describeTask.result.GetProperties())
+	})
+
+	t.Run("enable collection", func(t *testing.T) {
+		task := newTask(&commonpb.KeyValuePair{Key: common.CollectionDisabledKey, Value: "false"})
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		globalMetaCache.RemoveCollection(ctx, "", collectionName)
+		assert.NoError(t, checkCollectionEnabled(ctx, "", collectionName))
+	})
+
+	t.Run("describe properties", func(t *testing.T) {
+		task := newTask(
+			&commonpb.KeyValuePair{Key: "collection.ttl.seconds", Value: "3600"},
+			&commonpb.KeyValuePair{Key: common.CollectionWriteBlockedUntilKey, Value: "1"},
+		)
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+
+		describeTask := &describeCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			DescribeCollectionRequest: &milvuspb.DescribeCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+				CollectionName: collectionName,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.NoError(t, describeTask.PreExecute(ctx))
+		assert.NoError(t, describeTask.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, describeTask.result.GetStatus().GetErrorCode())
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: "collection.ttl.seconds", Value: "3600"}}, describeTask.result.GetProperties())
+	})
+}
+
+func TestCollectionDisabled_RejectDML(t *testing.T) {
+	Params.InitOnce()
+	ctx := context.Background()
+	collectionName := "disabled_collection"
+
+	cache := newMockCache()
+	cache.setIsDisabledFunc(func(ctx context.Context, name string) (bool, error) {
+		return name == collectionName, nil
+	})
+	cache.setGetSchemaFunc(func(ctx context.Context, name string) (*schemapb.CollectionSchema, error) {
+		return constructCollectionSchema("int64", "fvec", 128, name), nil
+	})
+	originCache := globalMetaCache
+	globalMetaCache = cache
+	defer func() { globalMetaCache = originCache }()
+
+	assertDisabled := func(t *testing.T, err error) {
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_CollectionDisabled, errorCodeOf(err))
+	}
+
+	t.Run("insert", func(t *testing.T) {
+		task := &insertTask{
+			ctx: ctx,
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+					CollectionName: collectionName,
+					PartitionName:  Params.CommonCfg.DefaultPartitionName,
+				},
+			},
+		}
+		assertDisabled(t, task.PreExecute(ctx))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		task := &deleteTask{
+			ctx: ctx,
+			BaseDeleteTask: msgstream.DeleteMsg{
+				DeleteRequest: internalpb.DeleteRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+					CollectionName: collectionName,
+				},
+			},
+			deleteExpr: "int64 in [1]",
+		}
+		assertDisabled(t, task.PreExecute(ctx))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		task := &searchTask{
+			ctx:           ctx,
+			SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+			request:       &milvuspb.SearchRequest{CollectionName: collectionName},
+		}
+		assertDisabled(t, task.PreExecute(ctx))
+	})
+
+	t.Run("query", func(t *testing.T) {
+		task := &queryTask{
+			ctx:             ctx,
+			RetrieveRequest: &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{}},
+			request:         &milvuspb.QueryRequest{CollectionName: collectionName, Expr: "int64 in [1]"},
+		}
+		assertDisabled(t, task.PreExecute(ctx))
+	})
+
+	t.Run("other collections are not affected", func(t *testing.T) {
+		assert.NoError(t, checkCollectionEnabled(ctx, "", "another_collection"))
+	})
+}
+
+func TestLoadPartitionsTask_ReplicaNumber(t *testing.T) {
+	ctx := context.Background()
+	collectionName := "TestLoadPartitionsTask_ReplicaNumber"
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, name string) (UniqueID, error) {
+		if name == collectionName {
+			return 1, nil
+		}
+		return 0, errCollectionNotExists(name)
+	})
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	globalMetaCache = cache
+
+	qc := NewQueryCoordMock()
+	qc.updateState(internalpb.StateCode_Healthy)
+	// the partitions of the collection are loaded with the replicas, nothing is loaded if it's 0
+	loadedReplicas := 0
+	qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		if loadedReplicas == 0 {
+			return &milvuspb.GetReplicasResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_MetaFailed, Reason: "collection not loaded"},
+			}, nil
+		}
+		resp := &milvuspb.GetReplicasResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		for i := 0; i < loadedReplicas; i++ {
+			resp.Replicas = append(resp.Replicas, &milvuspb.ReplicaInfo{ReplicaID: int64(i), CollectionID: req.GetCollectionID()})
+		}
+		return resp, nil
+	})
+
+	newTask := func(name string, replicaNumber int32) *loadPartitionsTask {
+		return &loadPartitionsTask{
+			Condition: NewTaskCondition(ctx),
+			LoadPartitionsRequest: &milvuspb.LoadPartitionsRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: name,
+				PartitionNames: []string{"p1"},
+				ReplicaNumber:  replicaNumber,
+			},
+			ctx:        ctx,
+			queryCoord: qc,
+		}
+	}
+
+	t.Run("nothing loaded", func(t *testing.T) {
+		loadedReplicas = 0
+		assert.NoError(t, newTask(collectionName, 3).PreExecute(ctx))
+	})
+
+	t.Run("matching replica number", func(t *testing.T) {
+		loadedReplicas = 2
+		assert.NoError(t, newTask(collectionName, 2).PreExecute(ctx))
+		// one replica is loaded by default
+		loadedReplicas = 1
+		assert.NoError(t, newTask(collectionName, 0).PreExecute(ctx))
+		assert.NoError(t, newTask(collectionName, 1).PreExecute(ctx))
+	})
+
+	t.Run("mismatching replica number", func(t *testing.T) {
+		loadedReplicas = 2
+		for _, replicaNumber := range []int32{0, 1, 3} {
+			err := newTask(collectionName, replicaNumber).PreExecute(ctx)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+			assert.Contains(t, err.Error(), "the replica number 2 of the loaded partitions")
+		}
+	})
+
+	t.Run("failed to check", func(t *testing.T) {
+		err := newTask("not_exist", 1).PreExecute(ctx)
+		assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+
+		qc.SetGetReplicasFunc(func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+			return nil, errors.New("mock")
+		})
+		assert.Error(t, newTask(collectionName, 1).PreExecute(ctx))
+	})
+}
+
+func TestNameValidation_Matrix(t *testing.T) {
+	Params.InitOnce()
+	ctx := context.Background()
+
+	marshalSchema := func(collectionName, fieldName string) []byte {
+		schema := &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: fieldName, DataType: schemapb.DataType_Int64},
+			},
+		}
+		bs, err := proto.Marshal(schema)
+		require.NoError(t, err)
+		return bs
+	}
+	node := &Proxy{}
+	node.stateCode.Store(internalpb.StateCode_Healthy)
+
+	// every handler which accepts a name validates it by the same rule
+	handlers := map[string]func(name string) error{
+		"collection name": func(name string) error {
+			return (&createCollectionTask{CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:   &commonpb.MsgBase{},
+				Schema: marshalSchema(name, "age"),
+			}}).PreExecute(ctx)
+		},
+		"field name": func(name string) error {
+			return (&createCollectionTask{CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:   &commonpb.MsgBase{},
+				Schema: marshalSchema("coll", name),
+			}}).PreExecute(ctx)
+		},
+		"partition name": func(name string) error {
+			return (&createPartitionTask{CreatePartitionRequest: &milvuspb.CreatePartitionRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "coll",
+				PartitionName:  name,
+			}}).PreExecute(ctx)
+		},
+		"collection alias": func(name string) error {
+			return (&CreateAliasTask{CreateAliasRequest: &milvuspb.CreateAliasRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "coll",
+				Alias:          name,
+			}}).PreExecute(ctx)
+		},
+		"collection name of the alias": func(name string) error {
+			return (&CreateAliasTask{CreateAliasRequest: &milvuspb.CreateAliasRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: name,
+				Alias:          "alias",
+			}}).PreExecute(ctx)
+		},
+		"index name": func(name string) error {
+			// the empty index name is defaulted to the field name
+			if name == "" {
+				return validateIndexName(name)
+			}
+			return (&createIndexTask{CreateIndexRequest: &milvuspb.CreateIndexRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "coll",
+				FieldName:      "vec",
+				IndexName:      name,
+			}}).PreExecute(ctx)
+		},
+		"dropped index name": func(name string) error {
+			if name == "" {
+				return validateIndexName(name)
+			}
+			return (&dropIndexTask{DropIndexRequest: &milvuspb.DropIndexRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "coll",
+				FieldName:      "vec",
+				IndexName:      name,
+			}}).PreExecute(ctx)
+		},
+		"username": func(name string) error {
+			status, err := node.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{Username: name})
+			require.NoError(t, err)
+			require.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+			return errors.New(status.GetReason())
+		},
+	}
+	invalid := []struct {
+		name   string
+		reason string
+	}{
+		{"", "should not be empty"},
+		{"   ", "should not be empty"},
+		{"-ab", `The character '-' at position 1 is not allowed`},
+		{"a-b", `The character '-' at position 2 is not allowed`},
+		{"ab c", `The character ' ' at position 3 is not allowed`},
+		{"年龄", `The character '年' at position 1 is not allowed`},
+		{"ab😀", `The character '😀' at position 3 is not allowed`},
+		{strings.Repeat("a", 256), "must be less than"},
+	}
+	for kind, handler := range handlers {
+		for _, c := range invalid {
+			err := handler(c.name)
+			require.Error(t, err, kind, c.name)
+			assert.Contains(t, err.Error(), c.reason, kind, c.name)
+		}
+	}
+
+	// the rules differ only in the first character
+	assert.Contains(t, validateCollectionName("1ab").Error(), "must be an underscore or letter")
+	assert.Contains(t, ValidateUsername("1ab").Error(), "must be a letter")
+	assert.Contains(t, ValidateUsername("_ab").Error(), "must be a letter")
+	assert.NoError(t, validatePartitionTag("1ab", true))
+	assert.NoError(t, validateIndexName("_1ab"))
 }
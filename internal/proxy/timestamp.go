@@ -58,6 +58,7 @@ func (ta *timestampAllocator) alloc(count uint32) ([]Timestamp, error) {
 		Count: count,
 	}
 
+	sent := time.Now()
 	resp, err := ta.tso.AllocTimestamp(ctx, req)
 	defer func() {
 		cancel()
@@ -71,6 +72,7 @@ func (ta *timestampAllocator) alloc(count uint32) ([]Timestamp, error) {
 		return nil, fmt.Errorf("syncTimeStamp Failed:%s", resp.Status.Reason)
 	}
 	start, cnt := resp.Timestamp, resp.Count
+	globalClockSkew.observe(start, sent, time.Now())
 	var ret []Timestamp
 	for i := uint32(0); i < cnt; i++ {
 		ret = append(ret, start+uint64(i))
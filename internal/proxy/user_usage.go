@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+const dummySelectResourceRequestType = "select_resource"
+
+// userUsageBuckets is the number of buckets the window of userUsageCollector is divided into,
+// the usage expires a bucket at a time.
+const userUsageBuckets = 10
+
+// userUsageNote is carried by every usage report, the usage is never synced between proxies.
+const userUsageNote = "best-effort usage of the requests handled by this proxy only, it's lost once the proxy restarts"
+
+// usageCounters are the counters of the requests of a user to a collection.
+type usageCounters struct {
+	Requests     int64 `json:"requests"`
+	RowsInserted int64 `json:"rows_inserted"`
+	BytesRead    int64 `json:"bytes_read"`
+}
+
+func (c *usageCounters) add(o usageCounters) {
+	c.Requests += o.Requests
+	c.RowsInserted += o.RowsInserted
+	c.BytesRead += o.BytesRead
+}
+
+type usageBucket struct {
+	start time.Time
+	usageCounters
+}
+
+// collectionUsage is the usage of a collection by a user, the buckets are in the order of start.
+type collectionUsage struct {
+	buckets    []*usageBucket
+	lastAccess time.Time
+}
+
+// userUsageCollector collects the usage of the collections by each user over a sliding window, the usage of
+// the requests without a user, i.e. authorization is disabled, isn't collected.
+type userUsageCollector struct {
+	mu     sync.Mutex
+	window time.Duration
+	width  time.Duration
+	// usage is keyed by user then collection name
+	usage      map[string]map[string]*collectionUsage
+	lastExpire time.Time
+	now        func() time.Time
+}
+
+func newUserUsageCollector(window time.Duration) *userUsageCollector {
+	width := window / userUsageBuckets
+	if width <= 0 {
+		width = window
+	}
+	return &userUsageCollector{
+		window: window,
+		width:  width,
+		usage:  make(map[string]map[string]*collectionUsage),
+		now:    time.Now,
+	}
+}
+
+// record adds the counters of a request of the user in ctx to the collection.
+func (c *userUsageCollector) record(ctx context.Context, collection string, counters usageCounters) {
+	if c == nil {
+		return
+	}
+	user, err := GetCurUserFromContext(ctx)
+	if err != nil || user == "" {
+		return
+	}
+	c.add(user, collection, counters)
+}
+
+func (c *userUsageCollector) add(user string, collection string, counters usageCounters) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	collections, ok := c.usage[user]
+	if !ok {
+		collections = make(map[string]*collectionUsage)
+		c.usage[user] = collections
+	}
+	cu, ok := collections[collection]
+	if !ok {
+		cu = &collectionUsage{}
+		collections[collection] = cu
+	}
+	start := now.Truncate(c.width)
+	if n := len(cu.buckets); n == 0 || cu.buckets[n-1].start.Before(start) {
+		cu.buckets = append(cu.buckets, &usageBucket{start: start})
+	}
+	cu.buckets[len(cu.buckets)-1].add(counters)
+	cu.lastAccess = now
+	if now.Sub(c.lastExpire) > c.width {
+		c.expire(now)
+	}
+}
+
+// expire drops the buckets out of the window, and the users and collections without usage in the window.
+func (c *userUsageCollector) expire(now time.Time) {
+	deadline := now.Add(-c.window)
+	for user, collections := range c.usage {
+		for collection, cu := range collections {
+			i := 0
+			for i < len(cu.buckets) && !cu.buckets[i].start.Add(c.width).After(deadline) {
+				i++
+			}
+			cu.buckets = cu.buckets[i:]
+			if len(cu.buckets) == 0 {
+				delete(collections, collection)
+			}
+		}
+		if len(collections) == 0 {
+			delete(c.usage, user)
+		}
+	}
+	c.lastExpire = now
+}
+
+// collectionUsageReport is the usage of a collection by a user in the window.
+type collectionUsageReport struct {
+	CollectionName string `json:"collection_name"`
+	usageCounters
+	LastAccess time.Time `json:"last_access"`
+}
+
+// userUsageReport is the usage of a user in the window, the collections are ordered by the last access, latest first.
+type userUsageReport struct {
+	Username string `json:"username"`
+	usageCounters
+	Collections []*collectionUsageReport `json:"collections"`
+}
+
+// userUsageReply is the reply of the select_resource dummy request.
+type userUsageReply struct {
+	Status string             `json:"status"`
+	Window string             `json:"window"`
+	Note   string             `json:"note"`
+	Users  []*userUsageReport `json:"users"`
+}
+
+// report aggregates the usage in the window of the user, or of all the users if includeAll is set.
+func (c *userUsageCollector) report(user string, includeAll bool) *userUsageReply {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expire(c.now())
+
+	users := make([]string, 0, len(c.usage))
+	if includeAll {
+		for u := range c.usage {
+			users = append(users, u)
+		}
+		sort.Strings(users)
+	} else {
+		users = append(users, user)
+	}
+
+	reply := &userUsageReply{
+		Status: "success",
+		Window: c.window.String(),
+		Note:   userUsageNote,
+		Users:  make([]*userUsageReport, 0, len(users)),
+	}
+	for _, u := range users {
+		ur := &userUsageReport{Username: u, Collections: make([]*collectionUsageReport, 0, len(c.usage[u]))}
+		for collection, cu := range c.usage[u] {
+			cr := &collectionUsageReport{CollectionName: collection, LastAccess: cu.lastAccess}
+			for _, b := range cu.buckets {
+				cr.add(b.usageCounters)
+			}
+			ur.add(cr.usageCounters)
+			ur.Collections = append(ur.Collections, cr)
+		}
+		sort.Slice(ur.Collections, func(i, j int) bool {
+			if !ur.Collections[i].LastAccess.Equal(ur.Collections[j].LastAccess) {
+				return ur.Collections[i].LastAccess.After(ur.Collections[j].LastAccess)
+			}
+			return ur.Collections[i].CollectionName < ur.Collections[j].CollectionName
+		})
+		reply.Users = append(reply.Users, ur)
+	}
+	return reply
+}
+
+// checkSelectResourcePrivilege requires the admin role to select the usage of the users other than the one of the
+// request if authorization is enabled.
+func checkSelectResourcePrivilege(ctx context.Context, user string, includeAll bool) error {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return nil
+	}
+	curUser, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if curUser == util.UserRoot || (!includeAll && user == curUser) {
+		return nil
+	}
+	roles, err := GetRole(curUser)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role == util.RoleAdmin {
+			return nil
+		}
+	}
+	return errors.New("selecting the usage of other users requires the admin role")
+}
+
+// dummySelectResource replies the usage of the collections by the user of the request, by the user specified in
+// the request, or by all the users if include_all is set. Only the admin can select the usage of other users.
+func (node *Proxy) dummySelectResource(ctx context.Context, str string) *milvuspb.DummyResponse {
+	failed := func(err error) *milvuspb.DummyResponse {
+		bs, _ := json.Marshal(map[string]string{"status": "fail", "reason": err.Error()})
+		return &milvuspb.DummyResponse{Response: string(bs)}
+	}
+
+	if node.userUsage == nil {
+		return failed(errors.New("user usage isn't collected"))
+	}
+	req, err := parseDummySelectResourceRequest(str)
+	if err != nil {
+		return failed(err)
+	}
+	user := req.Username
+	if user == "" && !req.IncludeAll {
+		if user, err = GetCurUserFromContext(ctx); err != nil {
+			return failed(errors.New("username is required if the request carries no user"))
+		}
+	}
+	if err := checkSelectResourcePrivilege(ctx, user, req.IncludeAll); err != nil {
+		return failed(err)
+	}
+	bs, err := json.Marshal(node.userUsage.report(user, req.IncludeAll))
+	if err != nil {
+		return failed(err)
+	}
+	return &milvuspb.DummyResponse{Response: string(bs)}
+}
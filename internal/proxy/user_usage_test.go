@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestUserUsageCollector(t *testing.T) {
+	now := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+	c := newUserUsageCollector(10 * time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.add("alice", "coll1", usageCounters{Requests: 1, RowsInserted: 100})
+	now = now.Add(time.Minute)
+	c.add("alice", "coll2", usageCounters{Requests: 1, BytesRead: 1024})
+	c.add("alice", "coll1", usageCounters{Requests: 1, BytesRead: 512})
+	c.add("bob", "coll2", usageCounters{Requests: 2, BytesRead: 2048})
+	now = now.Add(5 * time.Minute)
+	c.add("alice", "coll2", usageCounters{Requests: 1, RowsInserted: 10})
+
+	t.Run("user", func(t *testing.T) {
+		reply := c.report("alice", false)
+		assert.Equal(t, "success", reply.Status)
+		assert.Equal(t, "10m0s", reply.Window)
+		assert.Equal(t, userUsageNote, reply.Note)
+		require.Equal(t, 1, len(reply.Users))
+		alice := reply.Users[0]
+		assert.Equal(t, "alice", alice.Username)
+		assert.Equal(t, usageCounters{Requests: 4, RowsInserted: 110, BytesRead: 1536}, alice.usageCounters)
+		// latest first
+		require.Equal(t, 2, len(alice.Collections))
+		assert.Equal(t, "coll2", alice.Collections[0].CollectionName)
+		assert.Equal(t, usageCounters{Requests: 2, RowsInserted: 10, BytesRead: 1024}, alice.Collections[0].usageCounters)
+		assert.Equal(t, now, alice.Collections[0].LastAccess)
+		assert.Equal(t, "coll1", alice.Collections[1].CollectionName)
+		assert.Equal(t, usageCounters{Requests: 2, RowsInserted: 100, BytesRead: 512}, alice.Collections[1].usageCounters)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		reply := c.report("carol", false)
+		require.Equal(t, 1, len(reply.Users))
+		assert.Equal(t, "carol", reply.Users[0].Username)
+		assert.Equal(t, usageCounters{}, reply.Users[0].usageCounters)
+		assert.Empty(t, reply.Users[0].Collections)
+	})
+
+	t.Run("include all", func(t *testing.T) {
+		reply := c.report("", true)
+		require.Equal(t, 2, len(reply.Users))
+		assert.Equal(t, "alice", reply.Users[0].Username)
+		assert.Equal(t, "bob", reply.Users[1].Username)
+		assert.Equal(t, usageCounters{Requests: 2, BytesRead: 2048}, reply.Users[1].usageCounters)
+	})
+
+	t.Run("sliding window", func(t *testing.T) {
+		// the usage of the first minute is out of the window
+		now = now.Add(5 * time.Minute)
+		reply := c.report("", true)
+		require.Equal(t, 2, len(reply.Users))
+		alice := reply.Users[0]
+		assert.Equal(t, usageCounters{Requests: 3, RowsInserted: 10, BytesRead: 1536}, alice.usageCounters)
+
+		// nothing is left once the window passes
+		now = now.Add(10 * time.Minute)
+		reply = c.report("", true)
+		assert.Empty(t, reply.Users)
+		assert.Empty(t, c.usage)
+	})
+}
+
+func TestUserUsageCollector_record(t *testing.T) {
+	c := newUserUsageCollector(time.Minute)
+	// the requests without a user aren't collected
+	c.record(context.Background(), "coll", usageCounters{Requests: 1})
+	assert.Empty(t, c.usage)
+
+	c.record(GetContext(context.Background(), "alice:123456"), "coll", usageCounters{Requests: 1, RowsInserted: 10})
+	reply := c.report("alice", false)
+	require.Equal(t, 1, len(reply.Users[0].Collections))
+	assert.Equal(t, usageCounters{Requests: 1, RowsInserted: 10}, reply.Users[0].Collections[0].usageCounters)
+
+	var nilCollector *userUsageCollector
+	assert.NotPanics(t, func() {
+		nilCollector.record(context.Background(), "coll", usageCounters{Requests: 1})
+	})
+}
+
+func TestProxy_DummySelectResource(t *testing.T) {
+	node := &Proxy{userUsage: newUserUsageCollector(time.Minute)}
+	node.userUsage.add("alice", "coll1", usageCounters{Requests: 3, BytesRead: 100})
+	node.userUsage.add("bob", "coll2", usageCounters{Requests: 1, RowsInserted: 10})
+
+	dummy := func(ctx context.Context, req string) map[string]interface{} {
+		resp, err := node.Dummy(ctx, &milvuspb.DummyRequest{RequestType: req})
+		require.NoError(t, err)
+		reply := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &reply))
+		return reply
+	}
+	usernames := func(reply map[string]interface{}) []string {
+		var names []string
+		for _, u := range reply["users"].([]interface{}) {
+			names = append(names, u.(map[string]interface{})["username"].(string))
+		}
+		return names
+	}
+
+	// the user of the request
+	reply := dummy(GetContext(context.Background(), "alice:123456"), `{"request_type": "select_resource"}`)
+	assert.Equal(t, "success", reply["status"])
+	assert.Equal(t, userUsageNote, reply["note"])
+	assert.Equal(t, []string{"alice"}, usernames(reply))
+	alice := reply["users"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(3), alice["requests"])
+	assert.Equal(t, float64(100), alice["bytes_read"])
+	assert.Equal(t, "coll1", alice["collections"].([]interface{})[0].(map[string]interface{})["collection_name"])
+
+	// the specified user
+	reply = dummy(context.Background(), `{"request_type": "select_resource", "username": "bob"}`)
+	assert.Equal(t, []string{"bob"}, usernames(reply))
+
+	reply = dummy(context.Background(), `{"request_type": "select_resource", "include_all": true}`)
+	assert.Equal(t, []string{"alice", "bob"}, usernames(reply))
+
+	reply = dummy(context.Background(), `{"request_type": "select_resource"}`)
+	assert.Equal(t, "fail", reply["status"])
+	assert.Contains(t, reply["reason"], "username is required")
+
+	node.userUsage = nil
+	reply = dummy(context.Background(), `{"request_type": "select_resource", "include_all": true}`)
+	assert.Equal(t, "fail", reply["status"])
+}
+
+func TestProxy_DummySelectResource_Privilege(t *testing.T) {
+	node := &Proxy{userUsage: newUserUsageCollector(time.Minute)}
+	node.userUsage.add("alice", "coll1", usageCounters{Requests: 3})
+	node.userUsage.add("bob", "coll2", usageCounters{Requests: 1})
+
+	defer func(enabled bool) { Params.CommonCfg.AuthorizationEnabled = enabled }(Params.CommonCfg.AuthorizationEnabled)
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func(cache Cache) { globalMetaCache = cache }(globalMetaCache)
+	cache := newMockCache()
+	cache.getUserRoleFunc = func(username string) []string {
+		if username == "carol" {
+			return []string{util.RoleAdmin}
+		}
+		return []string{util.RolePublic}
+	}
+	globalMetaCache = cache
+
+	status := func(ctx context.Context, req string) string {
+		resp, err := node.Dummy(ctx, &milvuspb.DummyRequest{RequestType: req})
+		require.NoError(t, err)
+		reply := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &reply))
+		return reply["status"].(string)
+	}
+	alice := GetContext(context.Background(), "alice:123456")
+	assert.Equal(t, "success", status(alice, `{"request_type": "select_resource"}`))
+	assert.Equal(t, "success", status(alice, `{"request_type": "select_resource", "username": "alice"}`))
+	assert.Equal(t, "fail", status(alice, `{"request_type": "select_resource", "username": "bob"}`))
+	assert.Equal(t, "fail", status(alice, `{"request_type": "select_resource", "include_all": true}`))
+	assert.Equal(t, "fail", status(context.Background(), `{"request_type": "select_resource", "username": "bob"}`))
+
+	carol := GetContext(context.Background(), "carol:123456")
+	assert.Equal(t, "success", status(carol, `{"request_type": "select_resource", "username": "bob"}`))
+	assert.Equal(t, "success", status(carol, `{"request_type": "select_resource", "include_all": true}`))
+
+	root := GetContext(context.Background(), util.UserRoot+":123456")
+	assert.Equal(t, "success", status(root, `{"request_type": "select_resource", "include_all": true}`))
+}
@@ -23,11 +23,13 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -38,8 +40,9 @@ import (
 )
 
 const (
-	strongTS  = 0
-	boundedTS = 2
+	strongTS     = 0
+	eventuallyTS = 1
+	boundedTS    = 2
 
 	// enableMultipleVectorFields indicates whether to enable multiple vector fields.
 	enableMultipleVectorFields = false
@@ -82,36 +85,55 @@ func validateTopK(topK int64) error {
 	return nil
 }
 
-func validateCollectionNameOrAlias(entity, entityType string) error {
-	entity = strings.TrimSpace(entity)
-
-	if entity == "" {
-		return fmt.Errorf("collection %s should not be empty", entityType)
-	}
-
-	invalidMsg := fmt.Sprintf("Invalid collection %s: %s. ", entityType, entity)
-	if int64(len(entity)) > Params.ProxyCfg.MaxNameLength {
-		msg := invalidMsg + fmt.Sprintf("The length of a collection %s must be less than ", entityType) +
-			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
-		return errors.New(msg)
-	}
-
-	firstChar := entity[0]
-	if firstChar != '_' && !isAlpha(firstChar) {
-		msg := invalidMsg + fmt.Sprintf("The first character of a collection %s must be an underscore or letter.", entityType)
-		return errors.New(msg)
-	}
-
-	for i := 1; i < len(entity); i++ {
-		c := entity[i]
-		if c != '_' && !isAlpha(c) && !isNumber(c) {
-			msg := invalidMsg + fmt.Sprintf("Collection %s can only contain numbers, letters and underscores.", entityType)
-			return errors.New(msg)
+// nameRule is the length and charset rule of a kind of names. The names of the collections, partitions, aliases,
+// indexes, fields and users are all validated by it, since they end up in the channel names and the meta keys.
+type nameRule struct {
+	kind        string // e.g. "collection name", it's used in the error messages
+	maxLength   int64
+	digitFirst  bool // whether the name can start with a number
+	letterFirst bool // whether the name must start with a letter, not even an underscore
+}
+
+// validate returns an IllegalArgument error if the name is empty, too long, or contains any character other than
+// numbers, letters and underscores, the error names the offending character and its position starting from 1.
+func (r nameRule) validate(name string) error {
+	name = strings.TrimSpace(name)
+	invalidMsg := fmt.Sprintf("Invalid %s: %s. ", r.kind, name)
+	if name == "" {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%s%s%s should not be empty.", invalidMsg, strings.ToUpper(r.kind[:1]), r.kind[1:])
+	}
+	if length := utf8.RuneCountInString(name); int64(length) > r.maxLength {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%sThe length of a %s must be less than %d characters, got %d.", invalidMsg, r.kind, r.maxLength, length)
+	}
+	for i, c := range []rune(name) {
+		isLetter := c < utf8.RuneSelf && (c == '_' || isAlpha(uint8(c)))
+		isDigit := c < utf8.RuneSelf && isNumber(uint8(c))
+		if i == 0 && r.letterFirst && (c == '_' || !isLetter) {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"%sThe character %q at position 1 is not allowed, the first character of a %s must be a letter.",
+				invalidMsg, c, r.kind)
 		}
+		if isLetter || (isDigit && (i > 0 || r.digitFirst)) {
+			continue
+		}
+		if i == 0 && !r.digitFirst {
+			return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+				"%sThe character %q at position 1 is not allowed, the first character of a %s must be an underscore or letter.",
+				invalidMsg, c, r.kind)
+		}
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"%sThe character %q at position %d is not allowed, a %s can only contain numbers, letters and underscores.",
+			invalidMsg, c, i+1, r.kind)
 	}
 	return nil
 }
 
+func validateCollectionNameOrAlias(entity, entityType string) error {
+	return nameRule{kind: "collection " + entityType, maxLength: Params.ProxyCfg.MaxNameLength}.validate(entity)
+}
+
 // ValidateCollectionAlias returns true if collAlias is a valid alias name for collection, otherwise returns false.
 func ValidateCollectionAlias(collAlias string) error {
 	return validateCollectionNameOrAlias(collAlias, "alias")
@@ -121,70 +143,33 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
-func validatePartitionTag(partitionTag string, strictCheck bool) error {
-	partitionTag = strings.TrimSpace(partitionTag)
-
-	invalidMsg := "Invalid partition name: " + partitionTag + ". "
-	if partitionTag == "" {
-		msg := invalidMsg + "Partition name should not be empty."
-		return errors.New(msg)
-	}
-
-	if int64(len(partitionTag)) > Params.ProxyCfg.MaxNameLength {
-		msg := invalidMsg + "The length of a partition name must be less than " +
-			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
-		return errors.New(msg)
-	}
+// validateDatabaseName validates the name of the database to create or drop, the empty name stands for the default
+// database in the other requests.
+func validateDatabaseName(dbName string) error {
+	return nameRule{kind: "database name", maxLength: Params.ProxyCfg.MaxNameLength}.validate(dbName)
+}
 
+// validatePartitionTag validates the partition name, only the length is checked if strictCheck is false, since the
+// partition names of search and query are regular expressions.
+func validatePartitionTag(partitionTag string, strictCheck bool) error {
+	rule := nameRule{kind: "partition name", maxLength: Params.ProxyCfg.MaxNameLength, digitFirst: true}
 	if strictCheck {
-		firstChar := partitionTag[0]
-		if firstChar != '_' && !isAlpha(firstChar) && !isNumber(firstChar) {
-			msg := invalidMsg + "The first character of a partition name must be an underscore or letter."
-			return errors.New(msg)
-		}
-
-		tagSize := len(partitionTag)
-		for i := 1; i < tagSize; i++ {
-			c := partitionTag[i]
-			if c != '_' && !isAlpha(c) && !isNumber(c) {
-				msg := invalidMsg + "Partition name can only contain numbers, letters and underscores."
-				return errors.New(msg)
-			}
-		}
+		return rule.validate(partitionTag)
 	}
 
+	partitionTag = strings.TrimSpace(partitionTag)
+	if partitionTag == "" || int64(utf8.RuneCountInString(partitionTag)) > rule.maxLength {
+		return rule.validate(partitionTag)
+	}
 	return nil
 }
 
 func validateFieldName(fieldName string) error {
-	fieldName = strings.TrimSpace(fieldName)
-
-	if fieldName == "" {
-		return errors.New("field name should not be empty")
-	}
-
-	invalidMsg := "Invalid field name: " + fieldName + ". "
-	if int64(len(fieldName)) > Params.ProxyCfg.MaxNameLength {
-		msg := invalidMsg + "The length of a field name must be less than " +
-			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
-		return errors.New(msg)
-	}
-
-	firstChar := fieldName[0]
-	if firstChar != '_' && !isAlpha(firstChar) {
-		msg := invalidMsg + "The first character of a field name must be an underscore or letter."
-		return errors.New(msg)
-	}
+	return nameRule{kind: "field name", maxLength: Params.ProxyCfg.MaxNameLength}.validate(fieldName)
+}
 
-	fieldNameSize := len(fieldName)
-	for i := 1; i < fieldNameSize; i++ {
-		c := fieldName[i]
-		if c != '_' && !isAlpha(c) && !isNumber(c) {
-			msg := invalidMsg + "Field name cannot only contain numbers, letters, and underscores."
-			return errors.New(msg)
-		}
-	}
-	return nil
+func validateIndexName(indexName string) error {
+	return nameRule{kind: "index name", maxLength: Params.ProxyCfg.MaxNameLength}.validate(indexName)
 }
 
 func validateDimension(field *schemapb.FieldSchema) error {
@@ -239,7 +224,7 @@ func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema)
 }
 
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
-	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
+	if !typeutil.IsVectorType(field.DataType) {
 		return nil
 	}
 	for _, params := range field.IndexParams {
@@ -321,6 +306,56 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validatePartitionKey validates the partition key definition, the partition key field is returned if declared.
+func validatePartitionKey(coll *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	var partitionKey *schemapb.FieldSchema
+	for _, field := range coll.Fields {
+		if !field.GetIsPartitionKey() {
+			continue
+		}
+		if partitionKey != nil {
+			return nil, fmt.Errorf("there are more than one partition key, field name = %s, %s", partitionKey.Name, field.Name)
+		}
+		if field.IsPrimaryKey {
+			return nil, fmt.Errorf("the primary key field %s can't be the partition key", field.Name)
+		}
+		// the rows are hashed into the partitions by the partition key the same way as into the shards by the primary key
+		if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_VarChar {
+			return nil, errors.New("the data type of partition key should be Int64 or VarChar")
+		}
+		partitionKey = field
+	}
+	return partitionKey, nil
+}
+
+// validateNumPartitions validates the number of partitions the rows are hashed into by the partition key, 0 means
+// the default number. Neither rootcoord creates the partitions nor insert and search route the rows by the partition
+// key yet, so the partition key and num_partitions are rejected rather than silently storing all the rows in the
+// default partition.
+func validateNumPartitions(partitionKey *schemapb.FieldSchema, numPartitions int64) error {
+	if numPartitions < 0 || numPartitions > Params.ProxyCfg.MaxNumPartitions {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"num_partitions should be within [1, %d], got %d", Params.ProxyCfg.MaxNumPartitions, numPartitions)
+	}
+	if partitionKey != nil {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"partition key field %s not supported yet", partitionKey.GetName())
+	}
+	if numPartitions != 0 {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument, "num_partitions not supported yet")
+	}
+	return nil
+}
+
+// validateShardsNum validates the number of shards of the collection to create, 0 means the default number.
+func validateShardsNum(shardsNum int32) error {
+	if shardsNum != 0 && (shardsNum < Params.ProxyCfg.MinShardNum || shardsNum > Params.ProxyCfg.MaxShardNum) {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"shards_num should be within [%d, %d], got %d", Params.ProxyCfg.MinShardNum, Params.ProxyCfg.MaxShardNum, shardsNum)
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -459,7 +494,7 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector
+		isVec := typeutil.IsVectorType(dType)
 		if isVec && vecExist && !enableMultipleVectorFields {
 			return fmt.Errorf(
 				"multiple vector fields is not supported, fields name: %s, %s",
@@ -550,34 +585,7 @@ func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.Collect
 }
 
 func ValidateUsername(username string) error {
-	username = strings.TrimSpace(username)
-
-	if username == "" {
-		return errors.New("username should not be empty")
-	}
-
-	invalidMsg := "Invalid username: " + username + ". "
-	if int64(len(username)) > Params.ProxyCfg.MaxUsernameLength {
-		msg := invalidMsg + "The length of username must be less than " +
-			strconv.FormatInt(Params.ProxyCfg.MaxUsernameLength, 10) + " characters."
-		return errors.New(msg)
-	}
-
-	firstChar := username[0]
-	if !isAlpha(firstChar) {
-		msg := invalidMsg + "The first character of username must be a letter."
-		return errors.New(msg)
-	}
-
-	usernameSize := len(username)
-	for i := 1; i < usernameSize; i++ {
-		c := username[i]
-		if c != '_' && !isAlpha(c) && !isNumber(c) {
-			msg := invalidMsg + "Username should only contain numbers, letters, and underscores."
-			return errors.New(msg)
-		}
-	}
-	return nil
+	return nameRule{kind: "username", maxLength: Params.ProxyCfg.MaxUsernameLength, letterFirst: true}.validate(username)
 }
 
 func ValidatePassword(password string) error {
@@ -598,6 +606,17 @@ func validateTravelTimestamp(travelTs, tMax typeutil.Timestamp) error {
 	return nil
 }
 
+// validateExprLength checks the length of expression doesn't exceed the limit, too long expressions may fail the parser.
+func validateExprLength(expr string) error {
+	limit := Params.ProxyCfg.MaxExpressionLength
+	if limit > 0 && int64(len(expr)) > limit {
+		return newErrWithCode(commonpb.ErrorCode_IllegalArgument,
+			"length of expression %d exceeds the limit %d, to delete entities by a large number of primary keys, "+
+				"use the primary_keys of delete request instead of expression", len(expr), limit)
+	}
+	return nil
+}
+
 func ReplaceID2Name(oldStr string, id int64, name string) string {
 	return strings.ReplaceAll(oldStr, strconv.FormatInt(id, 10), name)
 }
@@ -739,3 +758,73 @@ func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCach
 	globalMetaCache.UpdateCredential(credInfo)
 	return true
 }
+
+// collectionWriteBlockedUntil returns the deadline of the write block set by the collection properties, the zero
+// time if the writes aren't blocked.
+func collectionWriteBlockedUntil(properties []*commonpb.KeyValuePair) time.Time {
+	for _, pair := range properties {
+		if pair.GetKey() == common.CollectionWriteBlockedUntilKey {
+			deadline, err := strconv.ParseInt(pair.GetValue(), 10, 64)
+			if err != nil || deadline <= 0 {
+				return time.Time{}
+			}
+			return time.UnixMilli(deadline)
+		}
+	}
+	return time.Time{}
+}
+
+// isCollectionDisabled returns whether the collection properties disable the collection.
+func isCollectionDisabled(properties []*commonpb.KeyValuePair) bool {
+	for _, pair := range properties {
+		if pair.GetKey() == common.CollectionDisabledKey {
+			disabled, _ := strconv.ParseBool(pair.GetValue())
+			return disabled
+		}
+	}
+	return false
+}
+
+// internalCollectionProperties are the collection properties milvus sets for itself, they are hidden from the clients.
+var internalCollectionProperties = map[string]struct{}{
+	common.CollectionDisabledKey:          {},
+	common.CollectionWriteBlockedUntilKey: {},
+}
+
+// publicCollectionProperties returns the collection properties without the internal ones.
+func publicCollectionProperties(properties []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties))
+	for _, pair := range properties {
+		if _, ok := internalCollectionProperties[pair.GetKey()]; !ok {
+			ret = append(ret, pair)
+		}
+	}
+	return ret
+}
+
+// checkCollectionEnabled returns an error with code CollectionDisabled if the collection is disabled.
+// It's checked by the search, query, insert and delete requests, the DDL requests of a disabled collection are still served.
+func checkCollectionEnabled(ctx context.Context, database, collectionName string) error {
+	disabled, err := globalMetaCache.IsCollectionDisabled(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return newErrWithCode(commonpb.ErrorCode_CollectionDisabled, "collection %s is disabled", collectionName)
+	}
+	return nil
+}
+
+// checkCollectionWritable returns an error with code CollectionWriteBlocked if the writes of the collection are blocked
+// by an index build, it's checked by the insert and delete requests.
+func checkCollectionWritable(ctx context.Context, database, collectionName string) error {
+	blocked, err := globalMetaCache.IsCollectionWriteBlocked(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return newErrWithCode(commonpb.ErrorCode_CollectionWriteBlocked,
+			"the writes of collection %s are blocked until the index is built", collectionName)
+	}
+	return nil
+}
@@ -281,6 +281,79 @@ func TestValidatePrimaryKey(t *testing.T) {
 	}))
 }
 
+func TestValidatePartitionKey(t *testing.T) {
+	newSchema := func(fields ...*schemapb.FieldSchema) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{
+			Fields: append([]*schemapb.FieldSchema{
+				{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			}, fields...),
+		}
+	}
+
+	partitionKey, err := validatePartitionKey(newSchema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}))
+	assert.NoError(t, err)
+	assert.Nil(t, partitionKey)
+
+	for _, dataType := range []schemapb.DataType{schemapb.DataType_Int64, schemapb.DataType_VarChar} {
+		partitionKey, err = validatePartitionKey(newSchema(&schemapb.FieldSchema{Name: "key", DataType: dataType, IsPartitionKey: true}))
+		assert.NoError(t, err)
+		assert.Equal(t, "key", partitionKey.GetName())
+	}
+
+	_, err = validatePartitionKey(newSchema(&schemapb.FieldSchema{Name: "key", DataType: schemapb.DataType_Float, IsPartitionKey: true}))
+	assert.Error(t, err)
+
+	_, err = validatePartitionKey(newSchema(
+		&schemapb.FieldSchema{Name: "key1", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+		&schemapb.FieldSchema{Name: "key2", DataType: schemapb.DataType_VarChar, IsPartitionKey: true},
+	))
+	assert.Error(t, err)
+
+	schema := newSchema()
+	schema.Fields[0].IsPartitionKey = true
+	_, err = validatePartitionKey(schema)
+	assert.Error(t, err)
+}
+
+func TestValidateNumPartitions(t *testing.T) {
+	Params.InitOnce()
+
+	assert.NoError(t, validateNumPartitions(nil, 0))
+	assert.Error(t, validateNumPartitions(nil, 1))
+
+	// out of the bounds
+	err := validateNumPartitions(nil, -1)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	err = validateNumPartitions(nil, Params.ProxyCfg.MaxNumPartitions+1)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), fmt.Sprintf("[1, %d]", Params.ProxyCfg.MaxNumPartitions))
+
+	// the partition key is rejected until the rows are routed by it
+	partitionKey := &schemapb.FieldSchema{Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true}
+	err = validateNumPartitions(partitionKey, 0)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	err = validateNumPartitions(partitionKey, 16)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+}
+
+func TestValidateShardsNum(t *testing.T) {
+	Params.InitOnce()
+
+	assert.NoError(t, validateShardsNum(0))
+	assert.NoError(t, validateShardsNum(Params.ProxyCfg.MinShardNum))
+	assert.NoError(t, validateShardsNum(Params.ProxyCfg.MaxShardNum))
+	for _, shardsNum := range []int32{-1, Params.ProxyCfg.MaxShardNum + 1} {
+		err := validateShardsNum(shardsNum)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+		assert.Contains(t, err.Error(), fmt.Sprintf("[%d, %d]", Params.ProxyCfg.MinShardNum, Params.ProxyCfg.MaxShardNum))
+	}
+
+	minNum := Params.ProxyCfg.MinShardNum
+	defer func() { Params.ProxyCfg.MinShardNum = minNum }()
+	Params.ProxyCfg.MinShardNum = 4
+	assert.Error(t, validateShardsNum(2))
+}
+
 func TestValidateFieldType(t *testing.T) {
 	type testCase struct {
 		dt       schemapb.DataType
@@ -597,6 +670,11 @@ func TestValidateUsername(t *testing.T) {
 	// starts with non-alphabet
 	res = ValidateUsername("1abc")
 	assert.Error(t, res)
+	res = ValidateUsername("_abc")
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(res))
+	assert.Contains(t, res.Error(), "must be a letter")
+	res = ValidateUsername("^abc")
+	assert.Contains(t, res.Error(), "must be a letter")
 	// length gt 32
 	res = ValidateUsername("aaaaaaaaaabbbbbbbbbbccccccccccddddd")
 	assert.Error(t, res)
@@ -773,3 +851,24 @@ func TestPasswordVerify(t *testing.T) {
 	assert.True(t, passwordVerify(context.TODO(), username, password, metaCache))
 	assert.Equal(t, 1, invokedCount)
 }
+
+func TestValidateExprLength(t *testing.T) {
+	Params.InitOnce()
+	limit := Params.ProxyCfg.MaxExpressionLength
+	defer func() { Params.ProxyCfg.MaxExpressionLength = limit }()
+	Params.ProxyCfg.MaxExpressionLength = 16
+
+	assert.NoError(t, validateExprLength(""))
+	assert.NoError(t, validateExprLength(strings.Repeat("a", 16)))
+
+	err := validateExprLength(strings.Repeat("a", 17))
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, errorCodeOf(err))
+	assert.Contains(t, err.Error(), "17")
+	assert.Contains(t, err.Error(), "16")
+	assert.Contains(t, err.Error(), "primary_keys")
+
+	// no limit
+	Params.ProxyCfg.MaxExpressionLength = 0
+	assert.NoError(t, validateExprLength(strings.Repeat("a", 1024)))
+}
@@ -0,0 +1,41 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+// The features reported by GetVersion, so that clients can detect the capabilities of the cluster rather than guess
+// them from the errors.
+const (
+	featureAuth               = "auth"
+	featureSearchIterator     = "search_iterator"
+	featureSearchWithoutIndex = "search_without_index"
+)
+
+// builtinFeatures are supported by every proxy of this version regardless of the config. Only the features working
+// end to end are listed, e.g. range search isn't since segcore doesn't support it yet.
+var builtinFeatures = []string{featureSearchIterator}
+
+// supportedFeatures returns the built-in features followed by the ones enabled by the config.
+func supportedFeatures() []string {
+	features := append([]string{}, builtinFeatures...)
+	if Params.CommonCfg.AuthorizationEnabled {
+		features = append(features, featureAuth)
+	}
+	if Params.ProxyCfg.SearchWithoutIndex {
+		features = append(features, featureSearchWithoutIndex)
+	}
+	return features
+}
@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestProxy_GetVersion(t *testing.T) {
+	Params.InitOnce()
+	defer func(enabled bool) { Params.CommonCfg.AuthorizationEnabled = enabled }(Params.CommonCfg.AuthorizationEnabled)
+	defer func(allowed bool) { Params.ProxyCfg.SearchWithoutIndex = allowed }(Params.ProxyCfg.SearchWithoutIndex)
+
+	// it works without any coordinator even if the proxy isn't healthy
+	node := &Proxy{}
+	node.stateCode.Store(internalpb.StateCode_Abnormal)
+
+	Params.CommonCfg.AuthorizationEnabled = false
+	Params.ProxyCfg.SearchWithoutIndex = false
+	resp, err := node.GetVersion(context.Background(), &milvuspb.GetVersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, common.Version.String(), resp.GetVersion())
+	assert.Equal(t, []string{featureSearchIterator}, resp.GetFeatures())
+
+	Params.CommonCfg.AuthorizationEnabled = true
+	Params.ProxyCfg.SearchWithoutIndex = true
+	resp, err = node.GetVersion(context.Background(), &milvuspb.GetVersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{featureSearchIterator, featureAuth, featureSearchWithoutIndex}, resp.GetFeatures())
+}
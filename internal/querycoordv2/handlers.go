@@ -3,6 +3,7 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
@@ -55,9 +57,9 @@ func (s *Server) getCollectionSegmentInfo(collection int64) []*querypb.SegmentIn
 	return lo.Values(infos)
 }
 
-// parseBalanceRequest parses the load balance request,
-// returns the collection, replica, and segments
-func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRequest, replica *meta.Replica) error {
+// balanceSegments moves the sealed segments of the collection on the source node to the destination nodes,
+// it returns the planned movements. Nothing is moved if it's a dry run.
+func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRequest, replica *meta.Replica) ([]balance.SegmentAssignPlan, error) {
 	const (
 		manualBalanceTimeout = 10 * time.Second
 	)
@@ -69,21 +71,31 @@ func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRe
 	}
 	dstNodeSet.Remove(srcNode)
 
-	sealedSegmentSet := typeutil.NewUniqueSet(req.GetSealedSegmentIDs()...)
 	toBalance := typeutil.NewSet[*meta.Segment]()
-	segments := s.dist.SegmentDistManager.GetByNode(srcNode)
+	segments := s.dist.SegmentDistManager.GetByCollectionAndNode(req.GetCollectionID(), srcNode)
 	if len(req.GetSealedSegmentIDs()) == 0 {
 		toBalance.Insert(segments...)
 	} else {
+		segmentsOnSrc := make(map[int64]*meta.Segment, len(segments))
 		for _, segment := range segments {
-			if !sealedSegmentSet.Contain(segment.GetID()) {
-				return fmt.Errorf("segment %d not found in source node %d", segment.GetID(), srcNode)
+			segmentsOnSrc[segment.GetID()] = segment
+		}
+		for _, segmentID := range req.GetSealedSegmentIDs() {
+			segment, ok := segmentsOnSrc[segmentID]
+			if !ok {
+				return nil, fmt.Errorf("segment %d not found in source node %d", segmentID, srcNode)
 			}
 			toBalance.Insert(segment)
 		}
 	}
 
 	plans := s.balancer.AssignSegment(toBalance.Collect(), dstNodeSet.Collect())
+	for i := range plans {
+		plans[i].From = srcNode
+	}
+	if req.GetDryRun() {
+		return plans, nil
+	}
 	tasks := make([]task.Task, 0, len(plans))
 	for _, plan := range plans {
 		task := task.NewSegmentTask(ctx,
@@ -96,11 +108,20 @@ func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRe
 		)
 		err := s.taskScheduler.Add(task)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		tasks = append(tasks, task)
 	}
-	return task.Wait(ctx, manualBalanceTimeout, tasks...)
+	return plans, task.Wait(ctx, manualBalanceTimeout, tasks...)
+}
+
+// formatSegmentMovements returns the planned movements of a dry run of load balance.
+func formatSegmentMovements(plans []balance.SegmentAssignPlan) string {
+	movements := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		movements = append(movements, fmt.Sprintf("segment %d: node %d -> node %d", plan.Segment.GetID(), plan.From, plan.To))
+	}
+	return fmt.Sprintf("dry run, planned movements: [%s]", strings.Join(movements, ", "))
 }
 
 // TODO(dragondriver): add more detail metrics
@@ -435,7 +435,8 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	log.Info("load balance request received",
 		zap.Int64s("source", req.GetSourceNodeIDs()),
 		zap.Int64s("dest", req.GetDstNodeIDs()),
-		zap.Int64s("segments", req.GetSealedSegmentIDs()))
+		zap.Int64s("segments", req.GetSealedSegmentIDs()),
+		zap.Bool("dryRun", req.GetDryRun()))
 
 	if s.status.Load() != internalpb.StateCode_Healthy {
 		msg := "failed to load balance"
@@ -469,12 +470,18 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 		}
 	}
 
-	err := s.balanceSegments(ctx, req, replica)
+	plans, err := s.balanceSegments(ctx, req, replica)
 	if err != nil {
 		msg := "failed to balance segments"
 		log.Warn(msg, zap.Error(err))
 		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, err), nil
 	}
+	if req.GetDryRun() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    formatSegmentMovements(plans),
+		}, nil
+	}
 	return successStatus, nil
 }
 
@@ -3,6 +3,7 @@ package querycoordv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/kv"
@@ -569,6 +570,34 @@ func (suite *ServiceSuite) TestLoadBalance() {
 	suite.Contains(resp.Reason, ErrNotHealthy.Error())
 }
 
+func (suite *ServiceSuite) TestLoadBalanceDryRun() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test dry run plans the movements without adding any task
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		srcNode := replicas[0].GetNodes()[0]
+		dstNode := replicas[0].GetNodes()[1]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateSegmentDist(collection, srcNode)
+		segments := suite.getAllSegments(collection)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: segments[:1],
+			DryRun:           true,
+		}
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.Equal(fmt.Sprintf("dry run, planned movements: [segment %d: node %d -> node %d]",
+			segments[0], srcNode, dstNode), resp.Reason)
+	}
+}
+
 func (suite *ServiceSuite) TestLoadBalanceFailed() {
 	suite.loadAll()
 	ctx := context.Background()
@@ -633,6 +662,25 @@ func (suite *ServiceSuite) TestLoadBalanceFailed() {
 		suite.Contains(resp.Reason, "destination nodes have to be in the same replica of source node")
 	}
 
+	// Test load balance with segment not in source node
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		srcNode := replicas[0].GetNodes()[0]
+		dstNode := replicas[0].GetNodes()[1]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateSegmentDist(collection, srcNode)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: []int64{-1},
+		}
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		suite.Contains(resp.Reason, fmt.Sprintf("segment -1 not found in source node %d", srcNode))
+	}
+
 	// Test balance task failed
 	for _, collection := range suite.collections {
 		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
@@ -677,6 +677,12 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	if !req.FromShardLeader {
+		ret.ChannelServiceableTs = make(map[string]uint64)
+		for _, result := range toReduceResults {
+			for channel, ts := range result.GetChannelServiceableTs() {
+				ret.ChannelServiceableTs[channel] = ts
+			}
+		}
 		rateCol.Add(metricsinfo.NQPerSecond, float64(req.GetReq().GetNq()))
 		rateCol.Add(metricsinfo.SearchThroughput, float64(proto.Size(req)))
 		metrics.QueryNodeExecuteCounter.WithLabelValues(strconv.FormatInt(Params.QueryNodeCfg.GetNodeID(), 10), metrics.SearchLabel).Add(float64(proto.Size(req)))
@@ -781,6 +787,7 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 
 	var results []*internalpb.SearchResults
 	var streamingResult *internalpb.SearchResults
+	var serviceTime Timestamp
 	var errCluster error
 
 	withStreaming := func(ctx context.Context) error {
@@ -803,6 +810,7 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 		metrics.QueryNodeReduceLatency.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID()),
 			metrics.SearchLabel).Observe(float64(streamingTask.reduceDur.Milliseconds()))
 		streamingResult = streamingTask.Ret
+		serviceTime = streamingTask.serviceTime
 		return nil
 	}
 
@@ -823,6 +831,7 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
 	}
+	ret.ChannelServiceableTs = map[string]uint64{dmlChannel: serviceTime}
 
 	tr.CtxElapse(ctx, fmt.Sprintf("do search done, msgID = %d, fromSharedLeader = %t, vChannel = %s, segmentIDs = %v",
 		msgID, req.GetFromShardLeader(), dmlChannel, req.GetSegmentIDs()))
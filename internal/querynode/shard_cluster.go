@@ -573,6 +573,17 @@ func (sc *ShardCluster) segmentAllocations(partitionIDs []int64) (map[int64][]in
 	return sc.currentVersion.GetAllocation(partitionIDs), sc.currentVersion.versionID
 }
 
+// filterSegmentAllocations returns the allocation of the segments in segmentIDs, the nodes without any of them are removed.
+func filterSegmentAllocations(segAllocs map[int64][]int64, segmentIDs []int64) map[int64][]int64 {
+	ret := make(map[int64][]int64)
+	for nodeID, segments := range segAllocs {
+		if filtered := filterSegmentIDs(segments, segmentIDs); len(filtered) > 0 {
+			ret[nodeID] = filtered
+		}
+	}
+	return ret
+}
+
 // finishUsage decreases the inUse count of provided segments
 func (sc *ShardCluster) finishUsage(versionID int64) {
 	defer func() {
@@ -915,6 +926,10 @@ func (sc *ShardCluster) GetStatistics(ctx context.Context, req *querypb.GetStati
 	// get node allocation and maintains the inUse reference count
 	segAllocs, versionID := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
 	defer sc.finishUsage(versionID)
+	if len(req.GetSegmentIDs()) > 0 {
+		// only the segments asked by the request
+		segAllocs = filterSegmentAllocations(segAllocs, req.GetSegmentIDs())
+	}
 
 	log.Debug("cluster segment distribution", zap.Int("len", len(segAllocs)))
 	for nodeID, segmentIDs := range segAllocs {
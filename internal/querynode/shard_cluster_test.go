@@ -1704,6 +1704,58 @@ func TestShardCluster_GetStatistics(t *testing.T) {
 		assert.Equal(t, len(nodeEvents), len(result))
 	})
 
+	t.Run("get statistics on segments", func(t *testing.T) {
+		nodeEvents := []nodeEvent{
+			{
+				nodeID:   1,
+				nodeAddr: "addr_1",
+			},
+			{
+				nodeID:   2,
+				nodeAddr: "addr_2",
+			},
+		}
+
+		segmentEvents := []segmentEvent{
+			{
+				segmentID: 1,
+				nodeIDs:   []int64{1},
+				state:     segmentStateLoaded,
+			},
+			{
+				segmentID: 2,
+				nodeIDs:   []int64{2},
+				state:     segmentStateLoaded,
+			},
+			{
+				segmentID: 3,
+				nodeIDs:   []int64{2},
+				state:     segmentStateLoaded,
+			},
+		}
+
+		sc := NewShardCluster(collectionID, replicaID, vchannelName,
+			&mockNodeDetector{
+				initNodes: nodeEvents,
+			}, &mockSegmentDetector{
+				initSegments: segmentEvents,
+			}, buildMockQueryNode)
+
+		defer sc.Close()
+		// setup first version
+		sc.SyncSegments(nil, segmentStateLoaded)
+
+		require.EqualValues(t, available, sc.state.Load())
+
+		// only the node holding segment 3 is visited
+		result, err := sc.GetStatistics(ctx, &querypb.GetStatisticsRequest{
+			DmlChannels: []string{vchannelName},
+			SegmentIDs:  []int64{3},
+		}, streamingDoNothing)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+
 	t.Run("with streaming fail", func(t *testing.T) {
 		nodeEvents := []nodeEvent{
 			{
@@ -94,11 +94,34 @@ func TestStreaming_statistics(t *testing.T) {
 		res, _, _, err := statisticStreaming(context.TODO(), streaming,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
-			defaultDMLChannel)
+			defaultDMLChannel,
+			nil)
 		assert.NoError(t, err)
 		assert.Len(t, res, 1)
 	})
 
+	t.Run("test statistics on segments", func(t *testing.T) {
+		streaming, err := genSimpleReplicaWithGrowingSegment()
+		assert.NoError(t, err)
+
+		res, _, segIDs, err := statisticStreaming(context.TODO(), streaming,
+			defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			defaultDMLChannel,
+			[]UniqueID{defaultSegmentID})
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, []UniqueID{defaultSegmentID}, segIDs)
+
+		res, _, _, err = statisticStreaming(context.TODO(), streaming,
+			defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			defaultDMLChannel,
+			[]UniqueID{defaultSegmentID + 1})
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+	})
+
 	t.Run("test run empty partition", func(t *testing.T) {
 		streaming, err := genSimpleReplicaWithGrowingSegment()
 		assert.NoError(t, err)
@@ -106,7 +129,8 @@ func TestStreaming_statistics(t *testing.T) {
 		res, _, _, err := statisticStreaming(context.TODO(), streaming,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
-			defaultDMLChannel)
+			defaultDMLChannel,
+			nil)
 		assert.NoError(t, err)
 		assert.Len(t, res, 1)
 	})
@@ -125,7 +149,8 @@ func TestStreaming_statistics(t *testing.T) {
 		res, _, _, err := statisticStreaming(context.TODO(), streaming,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
-			defaultDMLChannel)
+			defaultDMLChannel,
+			nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, 0, len(res))
@@ -145,7 +170,8 @@ func TestStreaming_statistics(t *testing.T) {
 		_, _, _, err = statisticStreaming(context.TODO(), streaming,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
-			defaultDMLChannel)
+			defaultDMLChannel,
+			nil)
 		assert.Error(t, err)
 	})
 
@@ -159,7 +185,8 @@ func TestStreaming_statistics(t *testing.T) {
 		res, _, _, err := statisticStreaming(context.TODO(), streaming,
 			defaultCollectionID,
 			[]UniqueID{},
-			defaultDMLChannel)
+			defaultDMLChannel,
+			nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(res))
 	})
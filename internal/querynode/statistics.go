@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // statisticOnSegments performs statistic on listed segments
@@ -67,11 +68,27 @@ func statisticHistorical(ctx context.Context, replica ReplicaInterface, collID U
 
 // statisticStreaming will do statistics all the target segments in streaming
 // if partIDs is empty, it means all the partitions of the loaded collection or all the partitions loaded.
-func statisticStreaming(ctx context.Context, replica ReplicaInterface, collID UniqueID, partIDs []UniqueID, vChannel Channel) ([]map[string]interface{}, []UniqueID, []UniqueID, error) {
+// if segIDs is specified, it will only do statistics on the growing segments specified by the segIDs.
+func statisticStreaming(ctx context.Context, replica ReplicaInterface, collID UniqueID, partIDs []UniqueID, vChannel Channel, segIDs []UniqueID) ([]map[string]interface{}, []UniqueID, []UniqueID, error) {
 	searchPartIDs, searchSegmentIDs, err := validateOnStreamReplica(ctx, replica, collID, partIDs, vChannel)
 	if err != nil {
 		return nil, searchSegmentIDs, searchPartIDs, err
 	}
+	if len(segIDs) > 0 {
+		searchSegmentIDs = filterSegmentIDs(searchSegmentIDs, segIDs)
+	}
 	searchResults, err := statisticOnSegments(replica, segmentTypeGrowing, searchSegmentIDs)
 	return searchResults, searchPartIDs, searchSegmentIDs, err
 }
+
+// filterSegmentIDs returns the segments in segIDs which are in targets as well.
+func filterSegmentIDs(segIDs []UniqueID, targets []UniqueID) []UniqueID {
+	targetSet := typeutil.NewUniqueSet(targets...)
+	ret := make([]UniqueID, 0, len(segIDs))
+	for _, segID := range segIDs {
+		if targetSet.Contain(segID) {
+			ret = append(ret, segID)
+		}
+	}
+	return ret
+}
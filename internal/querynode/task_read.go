@@ -67,6 +67,8 @@ type baseReadTask struct {
 	waitTsDur          time.Duration
 	waitTSafeTr        *timerecord.TimeRecorder
 	tr                 *timerecord.TimeRecorder
+	// serviceTime is the tsafe of the channel when the task turns ready
+	serviceTime Timestamp
 }
 
 func (b *baseReadTask) SetStep(step TaskStep) {
@@ -171,6 +173,7 @@ func (b *baseReadTask) Ready() (bool, error) {
 		zap.Any("delta milliseconds", gt.Sub(st).Milliseconds()),
 		zap.Any("channel", channel),
 		zap.Any("msgID", b.ID()))
+	b.serviceTime = serviceTime
 	b.waitTsDur = b.waitTSafeTr.ElapseSpan()
 	return true, nil
 }
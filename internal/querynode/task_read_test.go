@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestBaseReadTask_Ready(t *testing.T) {
+	qs, err := genSimpleQueryShard(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, updateQueryShardTSafe(qs, 1000))
+
+	task := &baseReadTask{
+		baseTask:           baseTask{ctx: context.Background()},
+		QS:                 qs,
+		DataScope:          querypb.DataScope_Streaming,
+		GuaranteeTimestamp: 2000,
+		tr:                 timerecord.NewTimeRecorder("readTask"),
+	}
+	ready, err := task.Ready()
+	assert.NoError(t, err)
+	assert.False(t, ready)
+	assert.Zero(t, task.serviceTime)
+
+	// the tsafe when the task turns ready is recorded
+	require.NoError(t, updateQueryShardTSafe(qs, 3000))
+	ready, err = task.Ready()
+	assert.NoError(t, err)
+	assert.True(t, ready)
+	assert.Equal(t, Timestamp(3000), task.serviceTime)
+}
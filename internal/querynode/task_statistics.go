@@ -54,7 +54,7 @@ func (s *statistics) statisticOnStreaming() error {
 	}
 
 	results, _, _, err := statisticStreaming(ctx, s.qs.metaReplica, s.iReq.GetCollectionID(),
-		s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
+		s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0], s.req.GetSegmentIDs())
 	if err != nil {
 		log.Ctx(ctx).Warn("failed to statistic on streaming data", zap.Int64("msgID", s.id),
 			zap.Int64("collectionID", s.iReq.GetCollectionID()), zap.Error(err))
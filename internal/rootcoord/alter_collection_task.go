@@ -0,0 +1,62 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type alterCollectionTask struct {
+	baseTaskV2
+	Req *milvuspb.AlterCollectionRequest
+}
+
+func (a *alterCollectionTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(a.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if len(a.Req.GetProperties()) == 0 {
+		return errors.New("the properties to alter are empty")
+	}
+	return nil
+}
+
+func (a *alterCollectionTask) Execute(ctx context.Context) error {
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetDbName(), a.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	newColl := oldColl.Clone()
+	newColl.Properties = updateProperties(oldColl.Properties, a.Req.GetProperties())
+
+	if err := a.core.meta.AlterCollection(ctx, oldColl, newColl, a.GetTs()); err != nil {
+		return err
+	}
+	// the proxies fetch the new properties once the cached meta of the collection and its aliases is expired.
+	return a.core.ExpireMetaCache(ctx, nil, oldColl.CollectionID, a.GetTs())
+}
+
+// updateProperties sets the updates to the properties, the properties with other keys are kept in order.
+func updateProperties(properties []*commonpb.KeyValuePair, updates []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties)+len(updates))
+	index := make(map[string]int, len(properties)+len(updates))
+	set := func(pair *commonpb.KeyValuePair) {
+		clone := &commonpb.KeyValuePair{Key: pair.GetKey(), Value: pair.GetValue()}
+		if i, ok := index[pair.GetKey()]; ok {
+			ret[i] = clone
+			return
+		}
+		index[pair.GetKey()] = len(ret)
+		ret = append(ret, clone)
+	}
+	for _, pair := range properties {
+		set(pair)
+	}
+	for _, pair := range updates {
+		set(pair)
+	}
+	return ret
+}
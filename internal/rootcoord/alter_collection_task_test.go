@@ -0,0 +1,135 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func Test_alterCollectionTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &alterCollectionTask{Req: &milvuspb.AlterCollectionRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection}}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty properties", func(t *testing.T) {
+		task := &alterCollectionTask{Req: &milvuspb.AlterCollectionRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection}}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				Properties: []*commonpb.KeyValuePair{{Key: common.CollectionDisabledKey, Value: "true"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_alterCollectionTask_Execute(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{{Key: common.CollectionDisabledKey, Value: "true"}}
+
+	t.Run("collection not exist", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &alterCollectionTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "test",
+				Properties:     properties,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter collection", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1, Name: collectionName}, nil
+		}
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			return errors.New("error mock AlterCollection")
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &alterCollectionTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "test",
+				Properties:     properties,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1, Name: collectionName}, nil
+		}
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			return nil
+		}
+		core := newTestCore(withInvalidProxyManager(), withMeta(meta))
+		task := &alterCollectionTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "test",
+				Properties:     properties,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{
+				CollectionID: 1,
+				Name:         collectionName,
+				Properties:   []*commonpb.KeyValuePair{{Key: "k", Value: "v"}, {Key: common.CollectionDisabledKey, Value: "false"}},
+			}, nil
+		}
+		var altered *model.Collection
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			altered = newColl
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &alterCollectionTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "test",
+				Properties:     properties,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: "k", Value: "v"}, {Key: common.CollectionDisabledKey, Value: "true"}}, altered.Properties)
+	})
+}
+
+func Test_updateProperties(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}
+	updated := updateProperties(properties, []*commonpb.KeyValuePair{{Key: "c", Value: "3"}, {Key: "a", Value: "4"}})
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: "a", Value: "4"}, {Key: "b", Value: "2"}, {Key: "c", Value: "3"}}, updated)
+	// the original properties are not modified
+	assert.Equal(t, "1", properties[0].GetValue())
+}
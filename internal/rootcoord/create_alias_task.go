@@ -22,5 +22,5 @@ func (t *createAliasTask) Prepare(ctx context.Context) error {
 
 func (t *createAliasTask) Execute(ctx context.Context) error {
 	// create alias is atomic enough.
-	return t.core.meta.CreateAlias(ctx, t.Req.GetAlias(), t.Req.GetCollectionName(), t.GetTs())
+	return t.core.meta.CreateAlias(ctx, t.Req.GetDbName(), t.Req.GetAlias(), t.Req.GetCollectionName(), t.GetTs())
 }
@@ -151,6 +151,10 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 		return err
 	}
 
+	if !t.core.meta.HasDatabase(t.Req.GetDbName()) {
+		return errDatabaseNotExist(t.Req.GetDbName())
+	}
+
 	t.assignShardsNum()
 
 	if err := t.assignCollectionID(); err != nil {
@@ -217,6 +221,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	collInfo := model.Collection{
+		DBName:               t.Req.GetDbName(),
 		CollectionID:         collID,
 		Name:                 t.schema.Name,
 		Description:          t.schema.Description,
@@ -246,7 +251,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 	clone := collInfo.Clone()
 	clone.Partitions = []*model.Partition{{PartitionName: Params.CommonCfg.DefaultPartitionName}}
 	// need double check in meta table if we can't promise the sequence execution.
-	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
 	if err == nil {
 		equal := existedCollInfo.Equal(*clone)
 		if !equal {
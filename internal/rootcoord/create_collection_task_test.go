@@ -200,18 +200,51 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		marshaledSchema, err := proto.Marshal(schema)
 		assert.NoError(t, err)
 
-		core := newTestCore(withInvalidIDAllocator())
+		meta := newMockMetaTable()
+		meta.HasDatabaseFunc = func(dbName string) bool {
+			return true
+		}
+		core := newTestCore(withInvalidIDAllocator(), withMeta(meta))
+
+		task := createCollectionTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+			},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("database not exist", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		schema := &schemapb.CollectionSchema{
+			Name:   collectionName,
+			Fields: []*schemapb.FieldSchema{{Name: funcutil.GenRandomStr()}},
+		}
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		meta := newMockMetaTable()
+		meta.HasDatabaseFunc = func(dbName string) bool {
+			return dbName == ""
+		}
+		core := newTestCore(withValidIDAllocator(), withMeta(meta))
 
 		task := createCollectionTask{
 			baseTaskV2: baseTaskV2{core: core},
 			Req: &milvuspb.CreateCollectionRequest{
 				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         "db",
 				CollectionName: collectionName,
 				Schema:         marshaledSchema,
 			},
 		}
 		err = task.Prepare(context.Background())
 		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, errorCodeOf(err))
 	})
 
 	t.Run("failed to assign channels", func(t *testing.T) {
@@ -226,7 +259,11 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 
 		ticker := newRocksMqTtSynchronizer()
 
-		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker))
+		meta := newMockMetaTable()
+		meta.HasDatabaseFunc = func(dbName string) bool {
+			return true
+		}
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
 
 		schema := &schemapb.CollectionSchema{
 			Name:        collectionName,
@@ -262,7 +299,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		coll := &model.Collection{Name: collectionName}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -307,7 +344,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -355,7 +392,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
@@ -424,7 +461,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
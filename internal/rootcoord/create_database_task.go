@@ -0,0 +1,29 @@
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+type createDatabaseTask struct {
+	baseTaskV2
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+func (t *createDatabaseTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_CreateDatabase); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *createDatabaseTask) Execute(ctx context.Context) error {
+	// create database is atomic enough.
+	return t.core.meta.CreateDatabase(ctx, &model.Database{
+		Name:        t.Req.GetDbName(),
+		CreatedTime: t.GetTs(),
+	})
+}
@@ -0,0 +1,65 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func Test_createDatabaseTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &createDatabaseTask{
+			Req: &milvuspb.CreateDatabaseRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase}},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &createDatabaseTask{
+			Req: &milvuspb.CreateDatabaseRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase}},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_createDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to create database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &createDatabaseTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.CreateDatabaseRequest{
+				Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase},
+				DbName: "db",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		var created *model.Database
+		meta := newMockMetaTable()
+		meta.CreateDatabaseFunc = func(ctx context.Context, db *model.Database) error {
+			created = db
+			return nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &createDatabaseTask{
+			baseTaskV2: baseTaskV2{core: core, ts: 100},
+			Req: &milvuspb.CreateDatabaseRequest{
+				Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase},
+				DbName: "db",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Database{Name: "db", CreatedTime: 100}, created)
+	})
+}
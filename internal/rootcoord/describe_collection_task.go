@@ -35,7 +35,7 @@ func (t *describeCollectionTask) Execute(ctx context.Context) (err error) {
 	}
 
 	if t.Req.GetCollectionName() != "" {
-		collInfo, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), t.Req.GetTimeStamp())
+		collInfo, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.Req.GetTimeStamp())
 		if err != nil {
 			t.Rsp.Status = failStatus(commonpb.ErrorCode_CollectionNotExists, err.Error())
 			return err
@@ -69,5 +69,6 @@ func (t *describeCollectionTask) Execute(ctx context.Context) (err error) {
 	t.Rsp.Aliases = t.core.meta.ListAliasesByID(collInfo.CollectionID)
 	t.Rsp.StartPositions = collInfo.StartPositions
 	t.Rsp.CollectionName = t.Rsp.Schema.Name
+	t.Rsp.Properties = collInfo.Properties
 	return nil
 }
@@ -0,0 +1,25 @@
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+type dropDatabaseTask struct {
+	baseTaskV2
+	Req *milvuspb.DropDatabaseRequest
+}
+
+func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropDatabase); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *dropDatabaseTask) Execute(ctx context.Context) error {
+	// only the empty database can be dropped, so no collection cache of the proxies needs to be expired.
+	return t.core.meta.DropDatabase(ctx, t.Req.GetDbName())
+}
@@ -0,0 +1,64 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func Test_dropDatabaseTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &dropDatabaseTask{
+			Req: &milvuspb.DropDatabaseRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase}},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &dropDatabaseTask{
+			Req: &milvuspb.DropDatabaseRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase}},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_dropDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to drop database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &dropDatabaseTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.DropDatabaseRequest{
+				Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase},
+				DbName: "db",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		var dropped string
+		meta := newMockMetaTable()
+		meta.DropDatabaseFunc = func(ctx context.Context, dbName string) error {
+			dropped = dbName
+			return nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &dropDatabaseTask{
+			baseTaskV2: baseTaskV2{core: core},
+			Req: &milvuspb.DropDatabaseRequest{
+				Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase},
+				DbName: "db",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "db", dropped)
+	})
+}
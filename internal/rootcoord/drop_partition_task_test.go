@@ -57,7 +57,7 @@ func Test_dropPartitionTask_Prepare(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		coll := &model.Collection{Name: collectionName}
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll.Clone(), nil
 		}
 		core := newTestCore(withMeta(meta))
@@ -0,0 +1,43 @@
+package rootcoord
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+type expireCacheConfig struct {
+	withDropFlag bool
+	dbName       string
+}
+
+func (c expireCacheConfig) apply(req *proxypb.InvalidateCollMetaCacheRequest) {
+	req.DbName = c.dbName
+	if !c.withDropFlag {
+		return
+	}
+	if req.GetBase() == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_DropCollection
+}
+
+func defaultExpireCacheConfig() expireCacheConfig {
+	return expireCacheConfig{withDropFlag: false}
+}
+
+type expireCacheOpt func(c *expireCacheConfig)
+
+// expireCacheWithDropFlag tells the proxies the collection is dropped, so that they release the resources of it,
+// e.g. the collection level metrics.
+func expireCacheWithDropFlag() expireCacheOpt {
+	return func(c *expireCacheConfig) {
+		c.withDropFlag = true
+	}
+}
+
+// expireCacheWithDBName tells the proxies the database of the collection names to expire.
+func expireCacheWithDBName(dbName string) expireCacheOpt {
+	return func(c *expireCacheConfig) {
+		c.dbName = dbName
+	}
+}
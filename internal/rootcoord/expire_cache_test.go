@@ -0,0 +1,27 @@
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func Test_expireCacheConfig_apply(t *testing.T) {
+	c := defaultExpireCacheConfig()
+	req := &proxypb.InvalidateCollMetaCacheRequest{}
+	c.apply(req)
+	assert.Nil(t, req.GetBase())
+
+	opt := expireCacheWithDropFlag()
+	opt(&c)
+	c.apply(req)
+	assert.Equal(t, commonpb.MsgType_DropCollection, req.GetBase().GetMsgType())
+	assert.Equal(t, "", req.GetDbName())
+
+	expireCacheWithDBName("db")(&c)
+	c.apply(req)
+	assert.Equal(t, "db", req.GetDbName())
+}
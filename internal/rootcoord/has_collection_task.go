@@ -29,7 +29,7 @@ func (t *hasCollectionTask) Execute(ctx context.Context) error {
 	if t.Req.GetTimeStamp() == 0 {
 		t.Req.TimeStamp = typeutil.MaxTimestamp
 	}
-	_, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), t.Req.GetTimeStamp())
+	_, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.Req.GetTimeStamp())
 	t.Rsp.Value = err == nil
 	return nil
 }
@@ -28,7 +28,7 @@ func (t *hasPartitionTask) Execute(ctx context.Context) error {
 	t.Rsp.Status = succStatus()
 	t.Rsp.Value = false
 	// TODO: why HasPartitionRequest doesn't contain Timestamp but other requests do.
-	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.CollectionName, typeutil.MaxTimestamp)
+	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
 	if err != nil {
 		t.Rsp.Status = failStatus(commonpb.ErrorCode_CollectionNotExists, err.Error())
 		return err
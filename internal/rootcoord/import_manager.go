@@ -643,13 +643,17 @@ func rearrangeTasks(tasks []*milvuspb.GetImportStateResponse) {
 	})
 }
 
-func (m *importManager) listAllTasks() []*milvuspb.GetImportStateResponse {
+// listAllTasks returns the tasks of the collection colID, or all the tasks if colID is 0.
+func (m *importManager) listAllTasks(colID int64) []*milvuspb.GetImportStateResponse {
 	tasks := make([]*milvuspb.GetImportStateResponse, 0)
 
 	func() {
 		m.pendingLock.Lock()
 		defer m.pendingLock.Unlock()
 		for _, t := range m.pendingTasks {
+			if colID != 0 && t.GetCollectionId() != colID {
+				continue
+			}
 			resp := &milvuspb.GetImportStateResponse{
 				Status: &commonpb.Status{
 					ErrorCode: commonpb.ErrorCode_Success,
@@ -671,6 +675,9 @@ func (m *importManager) listAllTasks() []*milvuspb.GetImportStateResponse {
 		m.workingLock.Lock()
 		defer m.workingLock.Unlock()
 		for _, v := range m.workingTasks {
+			if colID != 0 && v.GetCollectionId() != colID {
+				continue
+			}
 			resp := &milvuspb.GetImportStateResponse{
 				Status: &commonpb.Status{
 					ErrorCode: commonpb.ErrorCode_Success,
@@ -489,7 +489,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
 
-	tasks := mgr.listAllTasks()
+	tasks := mgr.listAllTasks(0)
 	assert.Equal(t, len(rowReq.Files), len(tasks))
 
 	resp := mgr.getTaskState(1)
@@ -507,7 +507,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
-	tasks = mgr.listAllTasks()
+	tasks = mgr.listAllTasks(0)
 	assert.Equal(t, len(rowReq.Files)*2, len(tasks))
 
 	// the id of tasks must be 1,2,3,4,5,6(sequence not guaranteed)
@@ -519,6 +519,19 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 		delete(ids, tasks[i].Id)
 	}
 	assert.Equal(t, 0, len(ids))
+
+	// list the tasks of a collection, both working and pending
+	mgr.importJob(context.TODO(), rowReq, colID+1, 0)
+	mgr.callImportService = fn
+	mgr.importJob(context.TODO(), rowReq, colID+1, 0)
+	assert.Equal(t, len(rowReq.Files)*4, len(mgr.listAllTasks(0)))
+	tasks = mgr.listAllTasks(colID + 1)
+	assert.Equal(t, len(rowReq.Files)*2, len(tasks))
+	for _, task := range tasks {
+		assert.Greater(t, task.GetId(), int64(len(rowReq.Files)*2))
+	}
+	assert.Equal(t, len(rowReq.Files)*2, len(mgr.listAllTasks(colID)))
+	assert.Empty(t, mgr.listAllTasks(colID+2))
 }
 
 func TestImportManager_getCollectionPartitionName(t *testing.T) {
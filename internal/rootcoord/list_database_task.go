@@ -0,0 +1,38 @@
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// listDatabaseTask list databases request task
+type listDatabaseTask struct {
+	baseTaskV2
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+func (t *listDatabaseTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_ListDatabases); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Execute task execution
+func (t *listDatabaseTask) Execute(ctx context.Context) error {
+	t.Rsp.Status = succStatus()
+	for _, db := range t.core.meta.ListDatabases(ctx) {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		var createdUtcTimestamp uint64
+		if db.CreatedTime > 0 {
+			physical, _ := tsoutil.ParseHybridTs(db.CreatedTime)
+			createdUtcTimestamp = uint64(physical)
+		}
+		t.Rsp.CreatedUtcTimestamps = append(t.Rsp.CreatedUtcTimestamps, createdUtcTimestamp)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func Test_listDatabaseTask_Prepare(t *testing.T) {
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := &listDatabaseTask{
+			Req: &milvuspb.ListDatabasesRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowCollections}},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &listDatabaseTask{
+			Req: &milvuspb.ListDatabasesRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ListDatabases}},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_listDatabaseTask_Execute(t *testing.T) {
+	createdTime := tsoutil.ComposeTS(1000, 0)
+	meta := newMockMetaTable()
+	meta.ListDatabasesFunc = func(ctx context.Context) []*model.Database {
+		return []*model.Database{{Name: util.DefaultDBName}, {Name: "db", CreatedTime: createdTime}}
+	}
+	core := newTestCore(withMeta(meta))
+	task := &listDatabaseTask{
+		baseTaskV2: baseTaskV2{core: core},
+		Req:        &milvuspb.ListDatabasesRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ListDatabases}},
+		Rsp:        &milvuspb.ListDatabasesResponse{},
+	}
+	err := task.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+	assert.Equal(t, []string{util.DefaultDBName, "db"}, task.Rsp.GetDbNames())
+	assert.Equal(t, []uint64{0, 1000}, task.Rsp.GetCreatedUtcTimestamps())
+}
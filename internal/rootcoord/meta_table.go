@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/contextutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -71,8 +73,9 @@ const (
 type IMetaTable interface {
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
+	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
-	GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
+	GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
 	ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
 	ListAbnormalCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
@@ -80,21 +83,26 @@ type IMetaTable interface {
 	AddPartition(ctx context.Context, partition *model.Partition) error
 	ChangePartitionState(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
-	CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	DropAlias(ctx context.Context, alias string, ts Timestamp) error
-	AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
+	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
+	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
-	IsAlias(name string) bool
+	IsAlias(dbName string, name string) bool
 	ListAliasesByID(collID UniqueID) []string
 
 	// TODO: better to accept ctx.
 	// TODO: should GetCollectionNameByID & GetCollectionIDByName also accept ts?
 	GetCollectionNameByID(collID UniqueID) (string, error)                                    // serve for bulk load.
 	GetPartitionNameByID(collID UniqueID, partitionID UniqueID, ts Timestamp) (string, error) // serve for bulk load.
-	GetCollectionIDByName(name string) (UniqueID, error)                                      // serve for bulk load.
+	GetCollectionIDByName(dbName string, name string) (UniqueID, error)                       // serve for bulk load.
 	GetPartitionByName(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) // serve for bulk load.
 
+	CreateDatabase(ctx context.Context, db *model.Database) error
+	DropDatabase(ctx context.Context, dbName string) error
+	ListDatabases(ctx context.Context) []*model.Database
+	HasDatabase(dbName string) bool
+
 	// TODO: better to accept ctx.
 	AddCredential(credInfo *internalpb.CredentialInfo) error
 	GetCredential(username string) (*internalpb.CredentialInfo, error)
@@ -119,9 +127,10 @@ type MetaTable struct {
 	ctx     context.Context
 	catalog metastore.RootCoordCatalog
 
+	dbName2Meta  map[string]*model.Database              // database name -> database meta, the default one excluded
 	collID2Meta  map[typeutil.UniqueID]*model.Collection // collection id -> collection meta
-	collName2ID  map[string]typeutil.UniqueID            // collection name to collection id
-	collAlias2ID map[string]typeutil.UniqueID            // collection alias to collection id
+	collName2ID  map[string]map[string]typeutil.UniqueID // database name -> collection name to collection id
+	collAlias2ID map[string]map[string]typeutil.UniqueID // database name -> collection alias to collection id
 
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
@@ -142,18 +151,27 @@ func (mt *MetaTable) reload() error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	mt.dbName2Meta = make(map[string]*model.Database)
 	mt.collID2Meta = make(map[UniqueID]*model.Collection)
-	mt.collName2ID = make(map[string]UniqueID)
-	mt.collAlias2ID = make(map[string]UniqueID)
+	mt.collName2ID = make(map[string]map[string]UniqueID)
+	mt.collAlias2ID = make(map[string]map[string]UniqueID)
+
+	dbs, err := mt.catalog.ListDatabases(mt.ctx)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		mt.dbName2Meta[db.Name] = db
+	}
 
 	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
 	collections, err := mt.catalog.ListCollections(mt.ctx, typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
-	for name, collection := range collections {
+	for _, collection := range collections {
 		mt.collID2Meta[collection.CollectionID] = collection
-		mt.collName2ID[name] = collection.CollectionID
+		mt.setCollectionIDInternal(collection.DBName, collection.Name, collection.CollectionID)
 	}
 
 	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
@@ -162,12 +180,56 @@ func (mt *MetaTable) reload() error {
 		return err
 	}
 	for _, alias := range aliases {
-		mt.collAlias2ID[alias.Name] = alias.CollectionID
+		mt.setAliasInternal(alias.DBName, alias.Name, alias.CollectionID)
 	}
 
 	return nil
 }
 
+// normalizeDBName maps the empty database name, used by the requests and the meta created before databases are
+// supported, to the default database.
+func normalizeDBName(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
+
+func (mt *MetaTable) hasDatabaseInternal(dbName string) bool {
+	dbName = normalizeDBName(dbName)
+	if dbName == util.DefaultDBName {
+		return true
+	}
+	_, ok := mt.dbName2Meta[dbName]
+	return ok
+}
+
+func (mt *MetaTable) getCollectionIDInternal(dbName string, collectionName string) (UniqueID, bool) {
+	id, ok := mt.collName2ID[normalizeDBName(dbName)][collectionName]
+	return id, ok
+}
+
+func (mt *MetaTable) setCollectionIDInternal(dbName string, collectionName string, collectionID UniqueID) {
+	dbName = normalizeDBName(dbName)
+	if _, ok := mt.collName2ID[dbName]; !ok {
+		mt.collName2ID[dbName] = make(map[string]UniqueID)
+	}
+	mt.collName2ID[dbName][collectionName] = collectionID
+}
+
+func (mt *MetaTable) getAliasInternal(dbName string, alias string) (UniqueID, bool) {
+	id, ok := mt.collAlias2ID[normalizeDBName(dbName)][alias]
+	return id, ok
+}
+
+func (mt *MetaTable) setAliasInternal(dbName string, alias string, collectionID UniqueID) {
+	dbName = normalizeDBName(dbName)
+	if _, ok := mt.collAlias2ID[dbName]; !ok {
+		mt.collAlias2ID[dbName] = make(map[string]UniqueID)
+	}
+	mt.collAlias2ID[dbName][alias] = collectionID
+}
+
 func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -179,13 +241,16 @@ func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection)
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("collection state should be creating, collection name: %s, collection id: %d, state: %s", coll.Name, coll.CollectionID, coll.State)
 	}
+	if !mt.hasDatabaseInternal(coll.DBName) {
+		return errDatabaseNotExist(coll.DBName)
+	}
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
 	if err := mt.catalog.CreateCollection(ctx1, coll, coll.CreateTime); err != nil {
 		return err
 	}
-	mt.collName2ID[coll.Name] = coll.CollectionID
+	mt.setCollectionIDInternal(coll.DBName, coll.Name, coll.CollectionID)
 	mt.collID2Meta[coll.CollectionID] = coll.Clone()
-	log.Info("add collection to meta table", zap.String("collection", coll.Name),
+	log.Info("add collection to meta table", zap.String("database", normalizeDBName(coll.DBName)), zap.String("collection", coll.Name),
 		zap.Int64("id", coll.CollectionID), zap.Uint64("ts", coll.CreateTime))
 	return nil
 }
@@ -211,6 +276,22 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 	return nil
 }
 
+func (mt *MetaTable) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.collID2Meta[oldColl.CollectionID]; !ok {
+		return fmt.Errorf("collection not exist: %d", oldColl.CollectionID)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	if err := mt.catalog.AlterCollection(ctx1, oldColl, newColl, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[oldColl.CollectionID] = newColl.Clone()
+	log.Info("alter collection finished", zap.Int64("collection", oldColl.CollectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -218,25 +299,29 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	// Note: we cannot handle case that dropping collection with `ts1` but a collection exists in catalog with newer ts
 	// which is bigger than `ts1`. So we assume that ts should always be the latest.
 
+	var dbName, name string
+	coll, ok := mt.collID2Meta[collectionID]
+	if ok && coll != nil {
+		dbName, name = coll.DBName, coll.Name
+	}
+
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
 	aliases := mt.listAliasesByID(collectionID)
-	if err := mt.catalog.DropCollection(ctx1, &model.Collection{CollectionID: collectionID, Aliases: aliases}, ts); err != nil {
+	if err := mt.catalog.DropCollection(ctx1, &model.Collection{DBName: dbName, CollectionID: collectionID, Aliases: aliases}, ts); err != nil {
 		return err
 	}
 	delete(mt.collID2Meta, collectionID)
 
-	var name string
-	coll, ok := mt.collID2Meta[collectionID]
 	if ok && coll != nil {
-		name = coll.Name
-		delete(mt.collName2ID, name)
+		delete(mt.collName2ID[normalizeDBName(dbName)], name)
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
+		delete(mt.collAlias2ID[normalizeDBName(dbName)], alias)
 	}
 
-	log.Info("remove collection", zap.String("name", name), zap.Int64("id", collectionID), zap.Strings("aliases", aliases))
+	log.Info("remove collection", zap.String("database", normalizeDBName(dbName)), zap.String("name", name),
+		zap.Int64("id", collectionID), zap.Strings("aliases", aliases))
 	return nil
 }
 
@@ -256,8 +341,8 @@ func (mt *MetaTable) getCollectionByIDInternal(ctx context.Context, collectionID
 	}
 
 	if !coll.Available() {
-		// use coll.Name to match error message of regression. TODO: remove this after error code is ready.
-		return nil, fmt.Errorf("can't find collection: %s", coll.Name)
+		// use coll.Name to match error message of regression.
+		return nil, errCollectionNotExist(coll.Name)
 	}
 
 	clone := coll.Clone()
@@ -271,30 +356,37 @@ func (mt *MetaTable) getCollectionByIDInternal(ctx context.Context, collectionID
 	return clone, nil
 }
 
-func (mt *MetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+func (mt *MetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	if !mt.hasDatabaseInternal(dbName) {
+		return nil, errDatabaseNotExist(dbName)
+	}
+
 	var collectionID UniqueID
 
-	collectionID, ok := mt.collAlias2ID[collectionName]
+	collectionID, ok := mt.getAliasInternal(dbName, collectionName)
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
 
-	collectionID, ok = mt.collName2ID[collectionName]
+	collectionID, ok = mt.getCollectionIDInternal(dbName, collectionName)
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
 
 	// travel meta information from catalog. No need to check time travel logic again, since catalog already did.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
-	coll, err := mt.catalog.GetCollectionByName(ctx1, collectionName, ts)
+	coll, err := mt.catalog.GetCollectionByName(ctx1, dbName, collectionName, ts)
+	if common.IsKeyNotExistError(err) {
+		return nil, errCollectionNotExist(collectionName)
+	}
 	if err != nil {
 		return nil, err
 	}
 	if !coll.Available() {
-		return nil, fmt.Errorf("can't find collection: %s", collectionName)
+		return nil, errCollectionNotExist(collectionName)
 	}
 	return coll, nil
 }
@@ -431,25 +523,29 @@ func (mt *MetaTable) RemovePartition(ctx context.Context, collectionID UniqueID,
 	return nil
 }
 
-func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	if !mt.hasDatabaseInternal(dbName) {
+		return errDatabaseNotExist(dbName)
+	}
+
+	if _, ok := mt.getCollectionIDInternal(dbName, alias); ok {
 		return fmt.Errorf("cannot create alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.getCollectionIDInternal(dbName, collectionName)
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
 	}
 
 	// check if alias exists.
-	aliasedCollectionID, ok := mt.collAlias2ID[alias]
+	aliasedCollectionID, ok := mt.getAliasInternal(dbName, alias)
 	if ok && aliasedCollectionID == collectionID {
 		log.Warn("add duplicate alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 		return nil
@@ -468,6 +564,7 @@ func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionNa
 
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
 	if err := mt.catalog.CreateAlias(ctx1, &model.Alias{
+		DBName:       dbName,
 		Name:         alias,
 		CollectionID: collectionID,
 		CreatedTime:  ts,
@@ -475,36 +572,44 @@ func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionNa
 	}, ts); err != nil {
 		return err
 	}
-	mt.collAlias2ID[alias] = collectionID
+	mt.setAliasInternal(dbName, alias, collectionID)
 	log.Info("create alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) DropAlias(ctx context.Context, alias string, ts Timestamp) error {
+func (mt *MetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	if !mt.hasDatabaseInternal(dbName) {
+		return errDatabaseNotExist(dbName)
+	}
+
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
-	if err := mt.catalog.DropAlias(ctx1, alias, ts); err != nil {
+	if err := mt.catalog.DropAlias(ctx1, dbName, alias, ts); err != nil {
 		return err
 	}
-	delete(mt.collAlias2ID, alias)
+	delete(mt.collAlias2ID[normalizeDBName(dbName)], alias)
 	log.Info("drop alias", zap.String("alias", alias), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	if !mt.hasDatabaseInternal(dbName) {
+		return errDatabaseNotExist(dbName)
+	}
+
+	if _, ok := mt.getCollectionIDInternal(dbName, alias); ok {
 		return fmt.Errorf("cannot alter alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.getCollectionIDInternal(dbName, collectionName)
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
@@ -517,7 +622,7 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	}
 
 	// check if alias exists.
-	_, ok = mt.collAlias2ID[alias]
+	_, ok = mt.getAliasInternal(dbName, alias)
 	if !ok {
 		//
 		return fmt.Errorf("failed to alter alias, alias does not exist: %s", alias)
@@ -525,6 +630,7 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
 	if err := mt.catalog.AlterAlias(ctx1, &model.Alias{
+		DBName:       dbName,
 		Name:         alias,
 		CollectionID: collectionID,
 		CreatedTime:  ts,
@@ -534,22 +640,27 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	}
 
 	// alias switch to another collection anyway.
-	mt.collAlias2ID[alias] = collectionID
+	mt.setAliasInternal(dbName, alias, collectionID)
 	log.Info("alter alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) IsAlias(name string) bool {
+func (mt *MetaTable) IsAlias(dbName string, name string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	_, ok := mt.collAlias2ID[name]
+	_, ok := mt.getAliasInternal(dbName, name)
 	return ok
 }
 
+// listAliasesByID lists the aliases of the collection, they always belong to the database of the collection.
 func (mt *MetaTable) listAliasesByID(collID UniqueID) []string {
 	ret := make([]string, 0)
-	for alias, id := range mt.collAlias2ID {
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return ret
+	}
+	for alias, id := range mt.collAlias2ID[normalizeDBName(coll.DBName)] {
 		if id == collID {
 			ret = append(ret, alias)
 		}
@@ -610,11 +721,14 @@ func (mt *MetaTable) GetPartitionNameByID(collID UniqueID, partitionID UniqueID,
 }
 
 // GetCollectionIDByName serve for bulk load. TODO: why this didn't accept ts?
-func (mt *MetaTable) GetCollectionIDByName(name string) (UniqueID, error) {
+func (mt *MetaTable) GetCollectionIDByName(dbName string, name string) (UniqueID, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	id, ok := mt.collName2ID[name]
+	if !mt.hasDatabaseInternal(dbName) {
+		return InvalidCollectionID, errDatabaseNotExist(dbName)
+	}
+	id, ok := mt.getCollectionIDInternal(dbName, name)
 	if !ok {
 		return InvalidCollectionID, fmt.Errorf("collection not exists: %s", name)
 	}
@@ -654,6 +768,71 @@ func (mt *MetaTable) GetPartitionByName(collID UniqueID, partitionName string, t
 	return common.InvalidPartitionID, fmt.Errorf("partition ")
 }
 
+// CreateDatabase creates the database, it fails if the database already exists.
+func (mt *MetaTable) CreateDatabase(ctx context.Context, db *model.Database) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if mt.hasDatabaseInternal(db.Name) {
+		return fmt.Errorf("database already exists: %s", db.Name)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	if err := mt.catalog.CreateDatabase(ctx1, db); err != nil {
+		return err
+	}
+	mt.dbName2Meta[db.Name] = db.Clone()
+	log.Info("create database", zap.String("database", db.Name), zap.Uint64("ts", db.CreatedTime))
+	return nil
+}
+
+// DropDatabase drops the database, the default database can't be dropped and the database should have no collection.
+func (mt *MetaTable) DropDatabase(ctx context.Context, dbName string) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if normalizeDBName(dbName) == util.DefaultDBName {
+		return fmt.Errorf("the default database can't be dropped")
+	}
+	if !mt.hasDatabaseInternal(dbName) {
+		return errDatabaseNotExist(dbName)
+	}
+	if len(mt.collName2ID[dbName]) > 0 {
+		return fmt.Errorf("database %s isn't empty, drop the collections of it first", dbName)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName)
+	if err := mt.catalog.DropDatabase(ctx1, dbName); err != nil {
+		return err
+	}
+	delete(mt.dbName2Meta, dbName)
+	delete(mt.collName2ID, dbName)
+	delete(mt.collAlias2ID, dbName)
+	log.Info("drop database", zap.String("database", dbName))
+	return nil
+}
+
+// ListDatabases lists the databases, the default one comes first and the others are sorted by name.
+func (mt *MetaTable) ListDatabases(ctx context.Context) []*model.Database {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*model.Database, 0, len(mt.dbName2Meta))
+	for _, db := range mt.dbName2Meta {
+		dbs = append(dbs, db.Clone())
+	}
+	sort.Slice(dbs, func(i, j int) bool {
+		return dbs[i].Name < dbs[j].Name
+	})
+	return append([]*model.Database{{Name: util.DefaultDBName}}, dbs...)
+}
+
+// HasDatabase returns whether the database exists, the default database always exists.
+func (mt *MetaTable) HasDatabase(dbName string) bool {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	return mt.hasDatabaseInternal(dbName)
+}
+
 // AddCredential add credential
 func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	if credInfo.Username == "" {
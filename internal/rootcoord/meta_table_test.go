@@ -17,6 +17,7 @@
 package rootcoord
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,7 +31,9 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util"
@@ -43,6 +46,7 @@ type mockTestKV struct {
 	kv.SnapShotKV
 
 	loadWithPrefix               func(key string, ts typeutil.Timestamp) ([]string, []string, error)
+	load                         func(key string, ts typeutil.Timestamp) (string, error)
 	save                         func(key, value string, ts typeutil.Timestamp) error
 	multiSave                    func(kvs map[string]string, ts typeutil.Timestamp) error
 	multiSaveAndRemoveWithPrefix func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
@@ -52,6 +56,9 @@ func (m *mockTestKV) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string
 	return m.loadWithPrefix(key, ts)
 }
 func (m *mockTestKV) Load(key string, ts typeutil.Timestamp) (string, error) {
+	if m.load != nil {
+		return m.load(key, ts)
+	}
 	return "", nil
 }
 
@@ -676,3 +683,115 @@ func TestRbacListUserRole(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 4, len(userRoles))
 }
+
+func TestMetaTable_Database(t *testing.T) {
+	ctx := context.Background()
+	mt, snapshotKV, _, _ := generateMetaTable(t)
+	// the collections are saved, so that the ones unavailable in memory can be loaded from the catalog
+	saved := make(map[string]string)
+	snapshotKV.load = func(key string, ts typeutil.Timestamp) (string, error) {
+		value, ok := saved[key]
+		if !ok {
+			return "", common.NewKeyNotExistError(key)
+		}
+		return value, nil
+	}
+	snapshotKV.save = func(key, value string, ts typeutil.Timestamp) error {
+		saved[key] = value
+		return nil
+	}
+	snapshotKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp) error {
+		for key, value := range kvs {
+			saved[key] = value
+		}
+		return nil
+	}
+	snapshotKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		for key, value := range saves {
+			saved[key] = value
+		}
+		for _, prefix := range removals {
+			for key := range saved {
+				if strings.HasPrefix(key, prefix) {
+					delete(saved, key)
+				}
+			}
+		}
+		return nil
+	}
+	mt.ctx = ctx
+	assert.NoError(t, mt.reload())
+
+	addCollection := func(dbName string, collectionID UniqueID) {
+		coll := &model.Collection{
+			DBName:       dbName,
+			CollectionID: collectionID,
+			Name:         "coll",
+			State:        pb.CollectionState_CollectionCreating,
+		}
+		assert.NoError(t, mt.AddCollection(ctx, coll))
+		assert.NoError(t, mt.ChangeCollectionState(ctx, collectionID, pb.CollectionState_CollectionCreated, 0))
+	}
+
+	assert.True(t, mt.HasDatabase(""))
+	assert.True(t, mt.HasDatabase(util.DefaultDBName))
+	assert.False(t, mt.HasDatabase("db"))
+
+	err := mt.AddCollection(ctx, &model.Collection{DBName: "db", Name: "coll", State: pb.CollectionState_CollectionCreating})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, errorCodeOf(err))
+
+	assert.NoError(t, mt.CreateDatabase(ctx, &model.Database{Name: "db"}))
+	assert.Error(t, mt.CreateDatabase(ctx, &model.Database{Name: "db"}))
+	assert.Error(t, mt.CreateDatabase(ctx, &model.Database{Name: util.DefaultDBName}))
+	assert.True(t, mt.HasDatabase("db"))
+	assert.Equal(t, []*model.Database{{Name: util.DefaultDBName}, {Name: "db"}}, mt.ListDatabases(ctx))
+
+	// collections of the same name are isolated by the databases.
+	addCollection("", 1)
+	addCollection("db", 2)
+	coll, err := mt.GetCollectionByName(ctx, "", "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), coll.CollectionID)
+	coll, err = mt.GetCollectionByName(ctx, util.DefaultDBName, "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), coll.CollectionID)
+	coll, err = mt.GetCollectionByName(ctx, "db", "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), coll.CollectionID)
+	_, err = mt.GetCollectionByName(ctx, "db2", "coll", typeutil.MaxTimestamp)
+	assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, errorCodeOf(err))
+
+	assert.NoError(t, mt.CreateAlias(ctx, "db", "alias", "coll", 0))
+	assert.True(t, mt.IsAlias("db", "alias"))
+	assert.False(t, mt.IsAlias("", "alias"))
+	assert.Equal(t, []string{"alias"}, mt.ListAliasesByID(2))
+	assert.Empty(t, mt.ListAliasesByID(1))
+	coll, err = mt.GetCollectionByName(ctx, "db", "alias", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(2), coll.CollectionID)
+
+	assert.Error(t, mt.DropDatabase(ctx, "db"))
+	assert.Error(t, mt.DropDatabase(ctx, ""))
+	assert.Error(t, mt.DropDatabase(ctx, util.DefaultDBName))
+
+	assert.NoError(t, mt.RemoveCollection(ctx, 2, 0))
+	assert.False(t, mt.IsAlias("db", "alias"))
+	_, err = mt.GetCollectionIDByName("db", "coll")
+	assert.Error(t, err)
+	id, err := mt.GetCollectionIDByName("", "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(1), id)
+
+	assert.NoError(t, mt.DropDatabase(ctx, "db"))
+	assert.False(t, mt.HasDatabase("db"))
+	assert.Equal(t, []*model.Database{{Name: util.DefaultDBName}}, mt.ListDatabases(ctx))
+	err = mt.DropDatabase(ctx, "db")
+	assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, errorCodeOf(err))
+
+	// the collection being dropped doesn't exist any more
+	assert.NoError(t, mt.ChangeCollectionState(ctx, 1, pb.CollectionState_CollectionDropping, 0))
+	_, err = mt.GetCollectionByName(ctx, "", "coll", typeutil.MaxTimestamp)
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, errorCodeOf(err))
+	assert.Equal(t, "can't find collection: coll", err.Error())
+}
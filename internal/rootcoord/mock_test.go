@@ -40,18 +40,23 @@ type mockMetaTable struct {
 	IMetaTable
 	ListCollectionsFunc       func(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
 	AddCollectionFunc         func(ctx context.Context, coll *model.Collection) error
-	GetCollectionByNameFunc   func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
+	GetCollectionByNameFunc   func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByIDFunc     func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
 	ChangeCollectionStateFunc func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
+	AlterCollectionFunc       func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RemoveCollectionFunc      func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 	AddPartitionFunc          func(ctx context.Context, partition *model.Partition) error
 	ChangePartitionStateFunc  func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartitionFunc       func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
-	CreateAliasFunc           func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	AlterAliasFunc            func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	DropAliasFunc             func(ctx context.Context, alias string, ts Timestamp) error
-	IsAliasFunc               func(name string) bool
+	CreateAliasFunc           func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliasFunc            func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	DropAliasFunc             func(ctx context.Context, dbName string, alias string, ts Timestamp) error
+	IsAliasFunc               func(dbName string, name string) bool
 	ListAliasesByIDFunc       func(collID UniqueID) []string
+	CreateDatabaseFunc        func(ctx context.Context, db *model.Database) error
+	DropDatabaseFunc          func(ctx context.Context, dbName string) error
+	ListDatabasesFunc         func(ctx context.Context) []*model.Database
+	HasDatabaseFunc           func(dbName string) bool
 }
 
 func (m mockMetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
@@ -62,8 +67,8 @@ func (m mockMetaTable) AddCollection(ctx context.Context, coll *model.Collection
 	return m.AddCollectionFunc(ctx, coll)
 }
 
-func (m mockMetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
-	return m.GetCollectionByNameFunc(ctx, collectionName, ts)
+func (m mockMetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+	return m.GetCollectionByNameFunc(ctx, dbName, collectionName, ts)
 }
 
 func (m mockMetaTable) GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
@@ -74,6 +79,10 @@ func (m mockMetaTable) ChangeCollectionState(ctx context.Context, collectionID U
 	return m.ChangeCollectionStateFunc(ctx, collectionID, state, ts)
 }
 
+func (m mockMetaTable) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+	return m.AlterCollectionFunc(ctx, oldColl, newColl, ts)
+}
+
 func (m mockMetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
 	return m.RemoveCollectionFunc(ctx, collectionID, ts)
 }
@@ -90,26 +99,42 @@ func (m mockMetaTable) RemovePartition(ctx context.Context, collectionID UniqueI
 	return m.RemovePartitionFunc(ctx, collectionID, partitionID, ts)
 }
 
-func (m mockMetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
-	return m.CreateAliasFunc(ctx, alias, collectionName, ts)
+func (m mockMetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
+	return m.CreateAliasFunc(ctx, dbName, alias, collectionName, ts)
 }
 
-func (m mockMetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
-	return m.AlterAliasFunc(ctx, alias, collectionName, ts)
+func (m mockMetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
+	return m.AlterAliasFunc(ctx, dbName, alias, collectionName, ts)
 }
 
-func (m mockMetaTable) DropAlias(ctx context.Context, alias string, ts Timestamp) error {
-	return m.DropAliasFunc(ctx, alias, ts)
+func (m mockMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error {
+	return m.DropAliasFunc(ctx, dbName, alias, ts)
 }
 
-func (m mockMetaTable) IsAlias(name string) bool {
-	return m.IsAliasFunc(name)
+func (m mockMetaTable) IsAlias(dbName string, name string) bool {
+	return m.IsAliasFunc(dbName, name)
 }
 
 func (m mockMetaTable) ListAliasesByID(collID UniqueID) []string {
 	return m.ListAliasesByIDFunc(collID)
 }
 
+func (m mockMetaTable) CreateDatabase(ctx context.Context, db *model.Database) error {
+	return m.CreateDatabaseFunc(ctx, db)
+}
+
+func (m mockMetaTable) DropDatabase(ctx context.Context, dbName string) error {
+	return m.DropDatabaseFunc(ctx, dbName)
+}
+
+func (m mockMetaTable) ListDatabases(ctx context.Context) []*model.Database {
+	return m.ListDatabasesFunc(ctx)
+}
+
+func (m mockMetaTable) HasDatabase(dbName string) bool {
+	return m.HasDatabaseFunc(dbName)
+}
+
 func newMockMetaTable() *mockMetaTable {
 	return &mockMetaTable{}
 }
@@ -301,7 +326,7 @@ func withInvalidMeta() Opt {
 	meta.ListCollectionsFunc = func(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
 		return nil, errors.New("error mock ListCollections")
 	}
-	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 		return nil, errors.New("error mock GetCollectionByName")
 	}
 	meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID typeutil.UniqueID, ts Timestamp) (*model.Collection, error) {
@@ -313,15 +338,27 @@ func withInvalidMeta() Opt {
 	meta.ChangePartitionStateFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error {
 		return errors.New("error mock ChangePartitionState")
 	}
-	meta.CreateAliasFunc = func(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+	meta.CreateAliasFunc = func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 		return errors.New("error mock CreateAlias")
 	}
-	meta.AlterAliasFunc = func(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+	meta.AlterAliasFunc = func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 		return errors.New("error mock AlterAlias")
 	}
-	meta.DropAliasFunc = func(ctx context.Context, alias string, ts Timestamp) error {
+	meta.DropAliasFunc = func(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 		return errors.New("error mock DropAlias")
 	}
+	meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+		return errors.New("error mock AlterCollection")
+	}
+	meta.HasDatabaseFunc = func(dbName string) bool {
+		return true
+	}
+	meta.CreateDatabaseFunc = func(ctx context.Context, db *model.Database) error {
+		return errors.New("error mock CreateDatabase")
+	}
+	meta.DropDatabaseFunc = func(ctx context.Context, dbName string) error {
+		return errors.New("error mock DropDatabase")
+	}
 	return withMeta(meta)
 }
 
@@ -256,7 +256,12 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 }
 
 // ExpireMetaCache will call invalidate collection meta cache
-func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, collectionID UniqueID, ts typeutil.Timestamp) error {
+func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, collectionID UniqueID, ts typeutil.Timestamp, opts ...expireCacheOpt) error {
+	expireCacheConfig := defaultExpireCacheConfig()
+	for _, opt := range opts {
+		opt(&expireCacheConfig)
+	}
+
 	// if collectionID is specified, invalidate all the collection meta cache with the specified collectionID and return
 	if collectionID != InvalidCollectionID {
 		req := proxypb.InvalidateCollMetaCacheRequest{
@@ -266,6 +271,7 @@ func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, collecti
 			},
 			CollectionID: collectionID,
 		}
+		expireCacheConfig.apply(&req)
 		return c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 	}
 
@@ -280,6 +286,7 @@ func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, collecti
 			},
 			CollectionName: collName,
 		}
+		expireCacheConfig.apply(&req)
 		err := c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 		if err != nil {
 			// TODO: try to expire all or directly return err?
@@ -723,7 +730,7 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 			zap.String("name", in.GetCollectionName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -733,7 +740,7 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.SuccessLabel).Inc()
@@ -773,7 +780,7 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 			zap.String("name", in.GetCollectionName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -783,7 +790,7 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.SuccessLabel).Inc()
@@ -828,7 +835,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("HasCollection", metrics.FailLabel).Inc()
 		return &milvuspb.BoolResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "HasCollection failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "HasCollection failed: "+err.Error()),
 			Value:  false,
 		}, nil
 	}
@@ -841,7 +848,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("HasCollection", metrics.FailLabel).Inc()
 		return &milvuspb.BoolResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "HasCollection failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "HasCollection failed: "+err.Error()),
 			Value:  false,
 		}, nil
 	}
@@ -890,7 +897,7 @@ func (c *Core) DescribeCollection(ctx context.Context, in *milvuspb.DescribeColl
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DescribeCollection", metrics.FailLabel).Inc()
 		return &milvuspb.DescribeCollectionResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "DescribeCollection failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "DescribeCollection failed: "+err.Error()),
 		}, nil
 	}
 
@@ -902,8 +909,13 @@ func (c *Core) DescribeCollection(ctx context.Context, in *milvuspb.DescribeColl
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DescribeCollection", metrics.FailLabel).Inc()
+		// the task tells a missing collection from the other failures
+		status := t.Rsp.GetStatus()
+		if status.GetErrorCode() == commonpb.ErrorCode_Success {
+			status = failStatus(errorCodeOf(err), "DescribeCollection failed: "+err.Error())
+		}
 		return &milvuspb.DescribeCollectionResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "DescribeCollection failed: "+err.Error()),
+			Status: status,
 		}, nil
 	}
 
@@ -947,7 +959,7 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("ShowCollections", metrics.FailLabel).Inc()
 		return &milvuspb.ShowCollectionsResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ShowCollections failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "ShowCollections failed: "+err.Error()),
 		}, nil
 	}
 
@@ -959,7 +971,7 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("ShowCollections", metrics.FailLabel).Inc()
 		return &milvuspb.ShowCollectionsResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ShowCollections failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "ShowCollections failed: "+err.Error()),
 		}, nil
 	}
 
@@ -1002,7 +1014,7 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -1012,7 +1024,7 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.SuccessLabel).Inc()
@@ -1053,7 +1065,7 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 	if err := t.WaitToFinish(); err != nil {
 		log.Error("failed to drop partition", zap.String("role", typeutil.RootCoordRole),
@@ -1062,7 +1074,7 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.SuccessLabel).Inc()
@@ -1108,7 +1120,7 @@ func (c *Core) HasPartition(ctx context.Context, in *milvuspb.HasPartitionReques
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("HasPartition", metrics.FailLabel).Inc()
 		return &milvuspb.BoolResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "HasPartition failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "HasPartition failed: "+err.Error()),
 			Value:  false,
 		}, nil
 	}
@@ -1121,7 +1133,7 @@ func (c *Core) HasPartition(ctx context.Context, in *milvuspb.HasPartitionReques
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("HasPartition", metrics.FailLabel).Inc()
 		return &milvuspb.BoolResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "HasPartition failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "HasPartition failed: "+err.Error()),
 			Value:  false,
 		}, nil
 	}
@@ -1167,7 +1179,7 @@ func (c *Core) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRe
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("ShowPartitions", metrics.FailLabel).Inc()
 		return &milvuspb.ShowPartitionsResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ShowPartitions failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "ShowPartitions failed: "+err.Error()),
 		}, nil
 	}
 
@@ -1179,7 +1191,7 @@ func (c *Core) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRe
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("ShowPartitions", metrics.FailLabel).Inc()
 		return &milvuspb.ShowPartitionsResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ShowPartitions failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "ShowPartitions failed: "+err.Error()),
 		}, nil
 	}
 	metrics.RootCoordDDLReqCounter.WithLabelValues("ShowPartitions", metrics.SuccessLabel).Inc()
@@ -1214,7 +1226,7 @@ func (c *Core) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestam
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		return &rootcoordpb.AllocTimestampResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "AllocTimestamp failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "AllocTimestamp failed: "+err.Error()),
 		}, nil
 	}
 
@@ -1242,7 +1254,7 @@ func (c *Core) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest) (*ro
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		return &rootcoordpb.AllocIDResponse{
-			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "AllocID failed: "+err.Error()),
+			Status: failStatus(errorCodeOf(err), "AllocID failed: "+err.Error()),
 			Count:  in.Count,
 		}, nil
 	}
@@ -1270,7 +1282,7 @@ func (c *Core) UpdateChannelTimeTick(ctx context.Context, in *internalpb.Channel
 	if err != nil {
 		log.Warn("failed to updateTimeTick", zap.String("role", typeutil.RootCoordRole),
 			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
-		return failStatus(commonpb.ErrorCode_UnexpectedError, "UpdateTimeTick failed: "+err.Error()), nil
+		return failStatus(errorCodeOf(err), "UpdateTimeTick failed: "+err.Error()), nil
 	}
 	return succStatus(), nil
 }
@@ -1282,7 +1294,7 @@ func (c *Core) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.In
 	}
 	err := c.proxyClientManager.InvalidateCollectionMetaCache(ctx, in)
 	if err != nil {
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 	return succStatus(), nil
 }
@@ -1313,7 +1325,7 @@ func (c *Core) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (
 		log.Warn("ParseMetricType failed", zap.String("role", typeutil.RootCoordRole),
 			zap.Int64("node_id", c.session.ServerID), zap.String("req", in.Request), zap.Error(err))
 		return &milvuspb.GetMetricsResponse{
-			Status:   failStatus(commonpb.ErrorCode_UnexpectedError, "ParseMetricType failed: "+err.Error()),
+			Status:   failStatus(errorCodeOf(err), "ParseMetricType failed: "+err.Error()),
 			Response: "",
 		}, nil
 	}
@@ -1382,7 +1394,7 @@ func (c *Core) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest)
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -1392,7 +1404,7 @@ func (c *Core) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest)
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateAlias", metrics.SuccessLabel).Inc()
@@ -1431,7 +1443,7 @@ func (c *Core) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*c
 			zap.String("alias", in.GetAlias()), zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -1441,7 +1453,7 @@ func (c *Core) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*c
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.SuccessLabel).Inc()
@@ -1482,7 +1494,7 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 			zap.Int64("msgID", in.GetBase().GetMsgID()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
@@ -1492,7 +1504,7 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
 
 		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAlias", metrics.FailLabel).Inc()
-		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+		return failStatus(errorCodeOf(err), err.Error()), nil
 	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterAlias", metrics.SuccessLabel).Inc()
@@ -1504,6 +1516,210 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return succStatus(), nil
 }
 
+// AlterCollection sets the properties of a collection
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AlterCollection")
+
+	log.Info("received request to alter collection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection", in.GetCollectionName()), zap.Any("properties", in.GetProperties()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	t := &alterCollectionTask{
+		baseTaskV2: baseTaskV2{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to alter collection", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("collection", in.GetCollectionName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to alter collection", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("collection", in.GetCollectionName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to alter collection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection", in.GetCollectionName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// CreateDatabase create database
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CreateDatabase")
+
+	log.Info("received request to create database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	t := &createDatabaseTask{
+		baseTaskV2: baseTaskV2{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to create database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbname", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to create database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbname", in.GetDbName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to create database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.GetDbName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// DropDatabase drop database
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropDatabase")
+
+	log.Info("received request to drop database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	t := &dropDatabaseTask{
+		baseTaskV2: baseTaskV2{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to drop database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbname", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to drop database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbname", in.GetDbName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(errorCodeOf(err), err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to drop database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.GetDbName()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// ListDatabases list all database names
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("ListDatabases")
+
+	log.Info("received request to list databases", zap.String("role", typeutil.RootCoordRole),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	t := &listDatabaseTask{
+		baseTaskV2: baseTaskV2{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Error("failed to enqueue request to list databases", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(errorCodeOf(err), "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Error("failed to list databases", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(errorCodeOf(err), "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("ListDatabases").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to list databases", zap.String("role", typeutil.RootCoordRole),
+		zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()),
+		zap.Int("num of databases", len(t.Rsp.GetDbNames())))
+	return t.Rsp, nil
+}
+
 // Import imports large files (json, numpy, etc.) on MinIO/S3 storage into Milvus storage.
 func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -1515,7 +1731,7 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 	// Get collection/partition ID from collection/partition name.
 	var cID UniqueID
 	var err error
-	if cID, err = c.meta.GetCollectionIDByName(req.GetCollectionName()); err != nil {
+	if cID, err = c.meta.GetCollectionIDByName(req.GetDbName(), req.GetCollectionName()); err != nil {
 		log.Error("failed to find collection ID from its name",
 			zap.String("collection name", req.GetCollectionName()),
 			zap.Error(err))
@@ -1550,7 +1766,7 @@ func (c *Core) GetImportState(ctx context.Context, req *milvuspb.GetImportStateR
 	return c.importManager.getTaskState(req.GetTask()), nil
 }
 
-// ListImportTasks returns id array of all import tasks.
+// ListImportTasks returns id array of all import tasks, or of the tasks of the collection if req.CollectionID is set.
 func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
 		return &milvuspb.ListImportTasksResponse{
@@ -1562,7 +1778,7 @@ func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTask
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 		},
-		Tasks: c.importManager.listAllTasks(),
+		Tasks: c.importManager.listAllTasks(req.GetCollectionID()),
 	}
 	return resp, nil
 }
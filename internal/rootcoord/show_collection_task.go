@@ -27,6 +27,11 @@ func (t *showCollectionTask) Prepare(ctx context.Context) error {
 // Execute task execution
 func (t *showCollectionTask) Execute(ctx context.Context) error {
 	t.Rsp.Status = succStatus()
+	if !t.core.meta.HasDatabase(t.Req.GetDbName()) {
+		err := errDatabaseNotExist(t.Req.GetDbName())
+		t.Rsp.Status = failStatus(errorCodeOf(err), err.Error())
+		return err
+	}
 	ts := t.Req.GetTimeStamp()
 	if ts == 0 {
 		ts = typeutil.MaxTimestamp
@@ -36,7 +41,11 @@ func (t *showCollectionTask) Execute(ctx context.Context) error {
 		t.Rsp.Status = failStatus(commonpb.ErrorCode_UnexpectedError, err.Error())
 		return err
 	}
+	dbName := normalizeDBName(t.Req.GetDbName())
 	for _, meta := range colls {
+		if normalizeDBName(meta.DBName) != dbName {
+			continue
+		}
 		t.Rsp.CollectionNames = append(t.Rsp.CollectionNames, meta.Name)
 		t.Rsp.CollectionIds = append(t.Rsp.CollectionIds, meta.CollectionID)
 		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, meta.CreateTime)
@@ -65,8 +65,15 @@ func Test_showCollectionTask_Execute(t *testing.T) {
 				{
 					Name: "test coll2",
 				},
+				{
+					DBName: "db",
+					Name:   "test coll3",
+				},
 			}, nil
 		}
+		meta.HasDatabaseFunc = func(dbName string) bool {
+			return true
+		}
 		core := newTestCore(withMeta(meta))
 		task := &showCollectionTask{
 			baseTaskV2: baseTaskV2{
@@ -84,5 +91,35 @@ func Test_showCollectionTask_Execute(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
 		assert.Equal(t, 2, len(task.Rsp.GetCollectionNames()))
+
+		task.Req.DbName = "db"
+		task.Rsp = &milvuspb.ShowCollectionsResponse{}
+		err = task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test coll3"}, task.Rsp.GetCollectionNames())
+	})
+
+	t.Run("database not exist", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.HasDatabaseFunc = func(dbName string) bool {
+			return false
+		}
+		core := newTestCore(withMeta(meta))
+		task := &showCollectionTask{
+			baseTaskV2: baseTaskV2{
+				core: core,
+				done: make(chan error, 1),
+			},
+			Req: &milvuspb.ShowCollectionsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ShowCollections,
+				},
+				DbName: "db",
+			},
+			Rsp: &milvuspb.ShowCollectionsResponse{},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DatabaseNotExist, task.Rsp.GetStatus().GetErrorCode())
 	})
 }
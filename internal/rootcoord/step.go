@@ -80,10 +80,11 @@ type ExpireCacheStep struct {
 	collectionNames []string
 	collectionID    UniqueID
 	ts              Timestamp
+	opts            []expireCacheOpt
 }
 
 func (s *ExpireCacheStep) Execute(ctx context.Context) error {
-	return s.core.ExpireMetaCache(ctx, s.collectionNames, s.collectionID, s.ts)
+	return s.core.ExpireMetaCache(ctx, s.collectionNames, s.collectionID, s.ts, s.opts...)
 }
 
 type DeleteCollectionDataStep struct {
@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -121,3 +122,37 @@ func succStatus() *commonpb.Status {
 		Reason:    "",
 	}
 }
+
+// errWithCode carries the error code to return to the caller.
+type errWithCode struct {
+	code commonpb.ErrorCode
+	msg  string
+}
+
+func (e *errWithCode) Error() string {
+	return e.msg
+}
+
+func newErrWithCode(code commonpb.ErrorCode, format string, a ...interface{}) error {
+	return &errWithCode{
+		code: code,
+		msg:  fmt.Sprintf(format, a...),
+	}
+}
+
+// errorCodeOf returns the error code carried by err, UnexpectedError is returned if err doesn't carry any.
+func errorCodeOf(err error) commonpb.ErrorCode {
+	var e *errWithCode
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
+
+func errDatabaseNotExist(dbName string) error {
+	return newErrWithCode(commonpb.ErrorCode_DatabaseNotExist, "database not exist: %s", dbName)
+}
+
+func errCollectionNotExist(collectionName string) error {
+	return newErrWithCode(commonpb.ErrorCode_CollectionNotExists, "can't find collection: %s", collectionName)
+}
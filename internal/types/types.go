@@ -592,6 +592,16 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// AlterCollection notifies RootCoord to set the properties of the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and the properties to set
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -732,6 +742,13 @@ type RootCoord interface {
 	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+
+	// CreateDatabase creates a database, the collections are isolated by the databases they belong to
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	// DropDatabase drops an empty database, the default database can't be dropped
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	// ListDatabases lists all the databases including the default one
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -846,6 +863,10 @@ type ProxyComponent interface {
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode internalpb.StateCode)
 
+	// SetStateReason records why Proxy is abnormal or degraded, it's returned in the extra info of GetComponentStates.
+	//  `reason` is cleared if it's empty.
+	SetStateReason(reason string)
+
 	// CreateCollection notifies Proxy to create a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -948,6 +969,17 @@ type ProxyComponent interface {
 	// error is always nil
 	DropPartition(ctx context.Context, request *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
 
+	// DropPartitionData notifies Proxy to delete all entities of a partition while keeping the partition
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name,
+	// and the confirm and force flags to guard against wiping a partition by accident
+	//
+	// The `Status` in response struct `DropPartitionDataResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Timestamp` in `DropPartitionDataResponse` is the timestamp after which the deleted entities are invisible.
+	// error is always nil
+	DropPartitionData(ctx context.Context, request *milvuspb.DropPartitionDataRequest) (*milvuspb.DropPartitionDataResponse, error)
+
 	// HasPartition notifies Proxy to check a partition's existence
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1191,6 +1223,16 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// AlterCollection notifies Proxy to set the properties of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// request contains the request params, including database name(reserved), collection name, properties
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
@@ -1229,6 +1271,37 @@ type ProxyComponent interface {
 
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
+	// AllocTimestamp allocates a timestamp from the TSO
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `AllocTimestampResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Timestamp` in `AllocTimestampResponse` return the allocated timestamp.
+	// error is always nil
+	AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error)
+
+	// CheckHealth checks if the proxy is healthy to serve the requests
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `CheckHealthResponse` indicates if this operation is processed successfully or fail cause;
+	// the `IsHealthy` in `CheckHealthResponse` return if the proxy is healthy, and the `Reasons` return why it's not.
+	// error is always nil
+	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// GetVersion returns the build info of the proxy and the features supported by the cluster, it works even if
+	// the proxy isn't healthy
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `GetVersionResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Features` in `GetVersionResponse` return the features supported, e.g. auth, search_iterator and search_without_index.
+	// error is always nil
+	GetVersion(ctx context.Context, req *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -1245,6 +1318,13 @@ type ProxyComponent interface {
 	SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
 	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+
+	// CreateDatabase creates a database, the collections are isolated by the databases they belong to
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	// DropDatabase drops an empty database, the default database can't be dropped
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	// ListDatabases lists all the databases including the default one
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
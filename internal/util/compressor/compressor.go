@@ -10,6 +10,7 @@ type CompressType string
 
 const (
 	CompressTypeZstd CompressType = "zstd"
+	CompressTypeGzip CompressType = "gzip"
 
 	DefaultCompressAlgorithm CompressType = CompressTypeZstd
 )
@@ -0,0 +1,164 @@
+package compressor
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+const (
+	// GrpcZstd is the name of the grpc compressor using zstd.
+	GrpcZstd = "milvus-zstd"
+	// GrpcGzip is the name of the grpc compressor using gzip.
+	GrpcGzip = "milvus-gzip"
+
+	// DefaultGrpcCompressionMinSize is the default size in bytes under which grpc messages are sent uncompressed.
+	DefaultGrpcCompressionMinSize = 1024
+
+	// every grpc message passed through the compressors starts with one of the flags
+	rawFrame        byte = 0
+	compressedFrame byte = 1
+)
+
+var grpcCompressionMinSize = atomic.NewInt64(DefaultGrpcCompressionMinSize)
+
+// SetGrpcCompressionMinSize sets the size in bytes under which grpc messages are sent uncompressed,
+// compressing small messages costs more CPU than the bandwidth it saves.
+func SetGrpcCompressionMinSize(size int) {
+	grpcCompressionMinSize.Store(int64(size))
+}
+
+// GrpcCompressorName returns the name of the grpc compressor of the compress type, an empty type means no compression.
+func GrpcCompressorName(compressType CompressType) (string, error) {
+	switch compressType {
+	case "":
+		return "", nil
+	case CompressTypeZstd:
+		return GrpcZstd, nil
+	case CompressTypeGzip:
+		return GrpcGzip, nil
+	default:
+		return "", fmt.Errorf("unsupported grpc compress type: %s", compressType)
+	}
+}
+
+func init() {
+	encoding.RegisterCompressor(&grpcCompressor{
+		name: GrpcZstd,
+		compress: func(dst io.Writer, src []byte) error {
+			_, err := dst.Write(ZstdCompressBytes(src, nil))
+			return err
+		},
+		decompress: func(src io.Reader) (io.Reader, error) {
+			compressed, err := ioutil.ReadAll(src)
+			if err != nil {
+				return nil, err
+			}
+			data, err := ZstdDecompressBytes(compressed, nil)
+			if err != nil {
+				return nil, err
+			}
+			return bytes.NewReader(data), nil
+		},
+	})
+	encoding.RegisterCompressor(&grpcCompressor{
+		name: GrpcGzip,
+		compress: func(dst io.Writer, src []byte) error {
+			w := gzip.NewWriter(dst)
+			if _, err := w.Write(src); err != nil {
+				return err
+			}
+			return w.Close()
+		},
+		decompress: func(src io.Reader) (io.Reader, error) {
+			r, err := gzip.NewReader(src)
+			if err != nil {
+				return nil, err
+			}
+			return r, nil
+		},
+	})
+}
+
+// grpcCompressor is a grpc encoding.Compressor which leaves the messages smaller than the min size uncompressed,
+// each message is prefixed with a flag telling whether it's compressed.
+type grpcCompressor struct {
+	name       string
+	compress   func(dst io.Writer, src []byte) error
+	decompress func(src io.Reader) (io.Reader, error)
+}
+
+var _ encoding.Compressor = (*grpcCompressor)(nil)
+
+func (c *grpcCompressor) Name() string {
+	return c.name
+}
+
+func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &grpcCompressWriter{compressor: c, w: w}, nil
+}
+
+func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	flag := make([]byte, 1)
+	if _, err := io.ReadFull(r, flag); err != nil {
+		return nil, err
+	}
+	switch flag[0] {
+	case rawFrame:
+		return r, nil
+	case compressedFrame:
+		return c.decompress(r)
+	default:
+		return nil, fmt.Errorf("invalid frame flag of %s: %d", c.name, flag[0])
+	}
+}
+
+// grpcCompressWriter buffers the whole message, as the size must be known to decide whether to compress it.
+type grpcCompressWriter struct {
+	compressor *grpcCompressor
+	w          io.Writer
+	buf        bytes.Buffer
+}
+
+func (w *grpcCompressWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *grpcCompressWriter) Close() error {
+	data := w.buf.Bytes()
+	out := &countingWriter{w: w.w}
+	var err error
+	if int64(len(data)) < grpcCompressionMinSize.Load() {
+		if _, err = out.Write([]byte{rawFrame}); err == nil {
+			_, err = out.Write(data)
+		}
+	} else {
+		if _, err = out.Write([]byte{compressedFrame}); err == nil {
+			err = w.compressor.compress(out, data)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	metrics.GrpcCompressionBytes.WithLabelValues(w.compressor.name, metrics.UncompressedBytesLabel).Add(float64(len(data)))
+	metrics.GrpcCompressionBytes.WithLabelValues(w.compressor.name, metrics.CompressedBytesLabel).Add(float64(out.n))
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += n
+	return n, err
+}
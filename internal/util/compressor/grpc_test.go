@@ -0,0 +1,87 @@
+package compressor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestGrpcCompressor(t *testing.T) {
+	small := []byte("hello milvus")
+	large := bytes.Repeat([]byte("hello milvus"), 1024)
+
+	for _, name := range []string{GrpcZstd, GrpcGzip} {
+		c := encoding.GetCompressor(name)
+		require.NotNil(t, c, name)
+		assert.Equal(t, name, c.Name())
+
+		compress := func(data []byte) []byte {
+			buf := &bytes.Buffer{}
+			w, err := c.Compress(buf)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			return buf.Bytes()
+		}
+		decompress := func(data []byte) []byte {
+			r, err := c.Decompress(bytes.NewReader(data))
+			require.NoError(t, err)
+			ret, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			return ret
+		}
+
+		// messages smaller than the min size are left uncompressed
+		compressed := compress(small)
+		assert.Equal(t, rawFrame, compressed[0], name)
+		assert.Equal(t, small, compressed[1:], name)
+		assert.Equal(t, small, decompress(compressed), name)
+
+		compressed = compress(large)
+		assert.Equal(t, compressedFrame, compressed[0], name)
+		assert.Less(t, len(compressed), len(large)/10, name)
+		assert.Equal(t, large, decompress(compressed), name)
+
+		_, err := c.Decompress(bytes.NewReader([]byte{2, 1, 2, 3}))
+		assert.Error(t, err, name)
+		_, err = c.Decompress(bytes.NewReader(nil))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSetGrpcCompressionMinSize(t *testing.T) {
+	defer SetGrpcCompressionMinSize(DefaultGrpcCompressionMinSize)
+
+	c := encoding.GetCompressor(GrpcZstd)
+	data := []byte("hello milvus")
+	SetGrpcCompressionMinSize(0)
+	buf := &bytes.Buffer{}
+	w, err := c.Compress(buf)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.Equal(t, compressedFrame, buf.Bytes()[0])
+}
+
+func TestGrpcCompressorName(t *testing.T) {
+	name, err := GrpcCompressorName("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", name)
+
+	name, err = GrpcCompressorName(CompressTypeZstd)
+	assert.NoError(t, err)
+	assert.Equal(t, GrpcZstd, name)
+
+	name, err = GrpcCompressorName(CompressTypeGzip)
+	assert.NoError(t, err)
+	assert.Equal(t, GrpcGzip, name)
+
+	_, err = GrpcCompressorName("lz4")
+	assert.Error(t, err)
+}
@@ -50,6 +50,9 @@ const (
 
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
+
+	// DefaultDBName is the database of the requests without a database name, it always exists and can't be dropped
+	DefaultDBName = "default"
 )
 
 var (
@@ -84,6 +87,10 @@ var (
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDropOwnership.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSelectOwnership.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeManageOwnership.String()),
+
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeCreateDatabase.String()),
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDropDatabase.String()),
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeListDatabases.String()),
 		},
 		commonpb.ObjectType_User.String(): {
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeUpdateUser.String()),
@@ -63,32 +63,35 @@ func GetLocalIP() string {
 
 // WaitForComponentStates wait for component's state to be one of the specific states
 func WaitForComponentStates(ctx context.Context, service types.Component, serviceName string, states []internalpb.StateCode, attempts uint, sleep time.Duration) error {
-	checkFunc := func() error {
-		resp, err := service.GetComponentStates(ctx)
-		if err != nil {
-			return err
-		}
+	return waitForComponentStates(ctx, service, serviceName, states, retry.Attempts(attempts), retry.Sleep(sleep))
+}
 
-		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-			return errors.New(resp.Status.Reason)
-		}
+func waitForComponentStates(ctx context.Context, service types.Component, serviceName string, states []internalpb.StateCode, opts ...retry.Option) error {
+	return retry.Do(ctx, func() error {
+		return checkComponentStates(ctx, service, serviceName, states)
+	}, opts...)
+}
 
-		meet := false
-		for _, state := range states {
-			if resp.State.StateCode == state {
-				meet = true
-				break
-			}
-		}
-		if !meet {
-			return fmt.Errorf(
-				"WaitForComponentStates, not meet, %s current state: %s",
-				serviceName,
-				resp.State.StateCode.String())
+// checkComponentStates returns an error if component's state isn't one of the specific states
+func checkComponentStates(ctx context.Context, service types.Component, serviceName string, states []internalpb.StateCode) error {
+	resp, err := service.GetComponentStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+
+	for _, state := range states {
+		if resp.State.StateCode == state {
+			return nil
 		}
-		return nil
 	}
-	return retry.Do(ctx, checkFunc, retry.Attempts(attempts), retry.Sleep(sleep))
+	return fmt.Errorf(
+		"WaitForComponentStates, not meet, %s current state: %s",
+		serviceName,
+		resp.State.StateCode.String())
 }
 
 // WaitForComponentInitOrHealthy wait for component's state to be initializing or healthy
@@ -106,6 +109,32 @@ func WaitForComponentHealthy(ctx context.Context, service types.Component, servi
 	return WaitForComponentStates(ctx, service, serviceName, []internalpb.StateCode{internalpb.StateCode_Healthy}, attempts, sleep)
 }
 
+// WaitForComponentHealthyWithBackoff waits for component's state to be healthy until ctx is done,
+// the interval between the checks starts from sleep and doubles up to maxSleep.
+// Only the error of the last check is kept, so waiting long doesn't pile up the errors.
+func WaitForComponentHealthyWithBackoff(ctx context.Context, service types.Component, serviceName string, sleep, maxSleep time.Duration) error {
+	states := []internalpb.StateCode{internalpb.StateCode_Healthy}
+	for {
+		err := checkComponentStates(ctx, service, serviceName, states)
+		if err == nil {
+			return nil
+		}
+
+		timer := time.NewTimer(sleep)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w, last error: %v", ctx.Err(), err)
+		}
+
+		sleep *= 2
+		if sleep > maxSleep {
+			sleep = maxSleep
+		}
+	}
+}
+
 // ParseIndexParamsMap parse the jsonic index parameters to map
 func ParseIndexParamsMap(mStr string) (map[string]string, error) {
 	buffer := make(map[string]interface{})
@@ -175,6 +175,52 @@ func Test_WaitForComponentHealthy(t *testing.T) {
 	}
 }
 
+// delayedHealthyComponent becomes healthy after it's checked for healthyAfter times.
+type delayedHealthyComponent struct {
+	*MockComponent
+	healthyAfter int
+	checked      int
+}
+
+func (c *delayedHealthyComponent) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
+	c.checked++
+	if c.checked <= c.healthyAfter {
+		return nil, errors.New("mock unavailable")
+	}
+	return c.MockComponent.GetComponentStates(ctx)
+}
+
+func Test_WaitForComponentHealthyWithBackoff(t *testing.T) {
+	t.Run("healthy after attempts", func(t *testing.T) {
+		c := &delayedHealthyComponent{MockComponent: buildMockComponent(internalpb.StateCode_Healthy), healthyAfter: 3}
+		err := WaitForComponentHealthyWithBackoff(context.TODO(), c, "mockService", time.Millisecond, 4*time.Millisecond)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, c.checked)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		c := &delayedHealthyComponent{MockComponent: buildMockComponent(internalpb.StateCode_Abnormal)}
+		ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		err := WaitForComponentHealthyWithBackoff(ctx, c, "mockService", time.Millisecond, 4*time.Millisecond)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "current state: Abnormal")
+		assert.Less(t, time.Since(start), time.Second)
+		// the checks are 1ms, 2ms and then 4ms apart
+		assert.Greater(t, c.checked, 5)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		c := &delayedHealthyComponent{MockComponent: buildMockComponent(internalpb.StateCode_Abnormal)}
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		err := WaitForComponentHealthyWithBackoff(ctx, c, "mockService", time.Hour, time.Hour)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, c.checked)
+	})
+}
+
 func Test_ParseIndexParamsMap(t *testing.T) {
 	num := 10
 	keys := make([]string, 0)
@@ -19,10 +19,14 @@ package grpcclient
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"go.uber.org/atomic"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus/internal/util"
 
@@ -43,6 +47,7 @@ type GrpcClient interface {
 	GetRole() string
 	SetGetAddrFunc(func() (string, error))
 	SetNewGrpcClientFunc(func(cc *grpc.ClientConn) interface{})
+	SetCompression(string)
 	GetGrpcClient(ctx context.Context) (interface{}, error)
 	ReCall(ctx context.Context, caller func(client interface{}) (interface{}, error)) (interface{}, error)
 	Call(ctx context.Context, caller func(client interface{}) (interface{}, error)) (interface{}, error)
@@ -70,6 +75,11 @@ type ClientBase struct {
 	InitialBackoff    float32
 	MaxBackoff        float32
 	BackoffMultiplier float32
+
+	// compression is the name of the grpc compressor of requests, the server replies with the same one
+	compression string
+	// compressionUnsupported is set once the server rejects the compressor, it's reset on reconnection
+	compressionUnsupported atomic.Bool
 }
 
 // SetRole sets role of client
@@ -92,6 +102,12 @@ func (c *ClientBase) SetNewGrpcClientFunc(f func(cc *grpc.ClientConn) interface{
 	c.newGrpcClient = f
 }
 
+// SetCompression sets the name of the registered grpc compressor used to compress requests and replies,
+// an empty name disables compression. It takes effect on the next connection.
+func (c *ClientBase) SetCompression(name string) {
+	c.compression = name
+}
+
 // GetGrpcClient returns grpc client
 func (c *ClientBase) GetGrpcClient(ctx context.Context) (interface{}, error) {
 	c.grpcClientMtx.RLock()
@@ -157,9 +173,7 @@ func (c *ClientBase) connect(ctx context.Context) error {
 		  }
 		}]}`, c.RetryServiceNameConfig, c.MaxAttempts, c.InitialBackoff, c.MaxBackoff, c.BackoffMultiplier)
 
-	conn, err := grpc.DialContext(
-		dialContext,
-		addr,
+	dialOpts := []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
@@ -184,7 +198,12 @@ func (c *ClientBase) connect(ctx context.Context) error {
 			MinConnectTimeout: c.DialTimeout,
 		}),
 		grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID)}),
-	)
+	}
+	if c.compression != "" {
+		c.compressionUnsupported.Store(false)
+		dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(c.compressionInterceptor))
+	}
+	conn, err := grpc.DialContext(dialContext, addr, dialOpts...)
 	cancel()
 	if err != nil {
 		return wrapErrConnect(addr, err)
@@ -198,6 +217,30 @@ func (c *ClientBase) connect(ctx context.Context) error {
 	return nil
 }
 
+// compressionInterceptor compresses the requests with the compressor of the client, the server replies with the same
+// compressor. A server which doesn't support the compressor rejects the request, then compression is disabled
+// until reconnection and the request is sent again uncompressed.
+func (c *ClientBase) compressionInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if c.compressionUnsupported.Load() {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.UseCompressor(c.compression))...)
+	if isCompressorNotSupported(err) {
+		log.Warn("server doesn't support the compressor, fall back to uncompressed",
+			zap.String("role", c.GetRole()), zap.String("compressor", c.compression), zap.Error(err))
+		c.compressionUnsupported.Store(true)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return err
+}
+
+// isCompressorNotSupported checks whether the error is returned by a server without the compressor of the request.
+func isCompressorNotSupported(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unimplemented && strings.Contains(s.Message(), "grpc-encoding")
+}
+
 func (c *ClientBase) callOnce(ctx context.Context, caller func(client interface{}) (interface{}, error)) (interface{}, error) {
 	client, err := c.GetGrpcClient(ctx)
 	if err != nil {
@@ -17,6 +17,7 @@
 package grpcclient
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -30,11 +31,19 @@ import (
 	"google.golang.org/grpc/examples/helloworld/helloworld"
 	"google.golang.org/grpc/keepalive"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -335,3 +344,131 @@ func TestClientBase_RetryPolicy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, res.(*helloworld.HelloReply).Message, strings.ToUpper(name))
 }
+
+type compressionQueryNode struct {
+	querypb.UnimplementedQueryNodeServer
+	result *internalpb.SearchResults
+
+	mu            sync.Mutex
+	recvCompress  []string
+	searchRequest *querypb.SearchRequest
+}
+
+func (qn *compressionQueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	qn.mu.Lock()
+	defer qn.mu.Unlock()
+	if s, ok := grpc.ServerTransportStreamFromContext(ctx).(interface{ RecvCompress() string }); ok {
+		qn.recvCompress = append(qn.recvCompress, s.RecvCompress())
+	}
+	qn.searchRequest = req
+	return qn.result, nil
+}
+
+func TestClientBase_Compression(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	qn := &compressionQueryNode{
+		result: &internalpb.SearchResults{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			NumQueries: 10,
+			TopK:       100,
+			SlicedBlob: bytes.Repeat([]byte("search result"), 10000),
+		},
+	}
+	s := grpc.NewServer()
+	querypb.RegisterQueryNodeServer(s, qn)
+	go s.Serve(lis)
+	defer s.Stop()
+
+	clientBase := ClientBase{
+		ClientMaxRecvSize:      10 * 1024 * 1024,
+		ClientMaxSendSize:      10 * 1024 * 1024,
+		DialTimeout:            10 * time.Second,
+		KeepAliveTime:          60 * time.Second,
+		KeepAliveTimeout:       60 * time.Second,
+		RetryServiceNameConfig: "milvus.proto.query.QueryNode",
+		MaxAttempts:            1,
+		InitialBackoff:         1.0,
+		MaxBackoff:             1.0,
+		BackoffMultiplier:      1.0,
+	}
+	clientBase.SetRole(typeutil.QueryNodeRole)
+	clientBase.SetGetAddrFunc(func() (string, error) {
+		return lis.Addr().String(), nil
+	})
+	clientBase.SetNewGrpcClientFunc(func(cc *grpc.ClientConn) interface{} {
+		return querypb.NewQueryNodeClient(cc)
+	})
+	clientBase.SetCompression(compressor.GrpcZstd)
+	defer clientBase.Close()
+
+	compressedBytes := func() float64 {
+		return testutil.ToFloat64(metrics.GrpcCompressionBytes.WithLabelValues(compressor.GrpcZstd, metrics.CompressedBytesLabel))
+	}
+	uncompressedBytes := func() float64 {
+		return testutil.ToFloat64(metrics.GrpcCompressionBytes.WithLabelValues(compressor.GrpcZstd, metrics.UncompressedBytesLabel))
+	}
+	compressedBefore, uncompressedBefore := compressedBytes(), uncompressedBytes()
+
+	ctx := context.Background()
+	req := &querypb.SearchRequest{
+		Req:         &internalpb.SearchRequest{CollectionID: 1, Nq: 10, PlaceholderGroup: []byte("placeholder")},
+		DmlChannels: []string{"dml-channel"},
+	}
+	ret, err := clientBase.Call(ctx, func(client interface{}) (interface{}, error) {
+		return client.(querypb.QueryNodeClient).Search(ctx, req)
+	})
+	require.NoError(t, err)
+
+	// the results compressed by the query node are decoded
+	assert.True(t, proto.Equal(qn.result, ret.(*internalpb.SearchResults)))
+	assert.True(t, proto.Equal(req, qn.searchRequest))
+	assert.Equal(t, []string{compressor.GrpcZstd}, qn.recvCompress)
+
+	// both directions pass through the compressor, the large result is compressed
+	uncompressed := uncompressedBytes() - uncompressedBefore
+	compressed := compressedBytes() - compressedBefore
+	assert.GreaterOrEqual(t, uncompressed, float64(proto.Size(qn.result)+proto.Size(req)))
+	assert.Less(t, compressed, uncompressed/10)
+}
+
+func TestClientBase_compressionInterceptor(t *testing.T) {
+	clientBase := ClientBase{}
+	clientBase.SetCompression(compressor.GrpcGzip)
+
+	var compressors []string
+	unsupported := true
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		name := ""
+		for _, opt := range opts {
+			if c, ok := opt.(grpc.CompressorCallOption); ok {
+				name = c.CompressorType
+			}
+		}
+		compressors = append(compressors, name)
+		if name != "" && unsupported {
+			return status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", name)
+		}
+		return nil
+	}
+
+	ctx := context.Background()
+	unsupported = false
+	assert.NoError(t, clientBase.compressionInterceptor(ctx, "method", nil, nil, nil, invoker))
+	assert.Equal(t, []string{compressor.GrpcGzip}, compressors)
+
+	// the server doesn't support the compressor, fall back to uncompressed
+	compressors = nil
+	unsupported = true
+	assert.NoError(t, clientBase.compressionInterceptor(ctx, "method", nil, nil, nil, invoker))
+	assert.Equal(t, []string{compressor.GrpcGzip, ""}, compressors)
+
+	compressors = nil
+	assert.NoError(t, clientBase.compressionInterceptor(ctx, "method", nil, nil, nil, invoker))
+	assert.Equal(t, []string{""}, compressors)
+
+	assert.False(t, isCompressorNotSupported(nil))
+	assert.False(t, isCompressorNotSupported(status.Error(codes.Unimplemented, "unknown method")))
+	assert.False(t, isCompressorNotSupported(errors.New("grpc-encoding")))
+}
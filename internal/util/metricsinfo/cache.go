@@ -35,6 +35,11 @@ type MetricsCacheManager struct {
 	systemInfoMetricsLastUpdatedTime time.Time
 	systemInfoMetricsMtx             sync.RWMutex
 
+	collectionMetrics                *milvuspb.GetMetricsResponse
+	collectionMetricsInvalid         bool
+	collectionMetricsLastUpdatedTime time.Time
+	collectionMetricsMtx             sync.RWMutex
+
 	retention    time.Duration
 	retentionMtx sync.RWMutex // necessary?
 }
@@ -46,6 +51,10 @@ func NewMetricsCacheManager() *MetricsCacheManager {
 		systemInfoMetricsInvalid:         false,
 		systemInfoMetricsLastUpdatedTime: time.Now(),
 		systemInfoMetricsMtx:             sync.RWMutex{},
+		collectionMetrics:                nil,
+		collectionMetricsInvalid:         false,
+		collectionMetricsLastUpdatedTime: time.Now(),
+		collectionMetricsMtx:             sync.RWMutex{},
 		retention:                        DefaultMetricsRetention,
 	}
 
@@ -122,3 +131,38 @@ func (manager *MetricsCacheManager) UpdateSystemInfoMetrics(infos *milvuspb.GetM
 	manager.systemInfoMetricsInvalid = false
 	manager.systemInfoMetricsLastUpdatedTime = time.Now()
 }
+
+// InvalidateCollectionMetrics invalidates the collection stats metrics.
+func (manager *MetricsCacheManager) InvalidateCollectionMetrics() {
+	manager.collectionMetricsMtx.Lock()
+	defer manager.collectionMetricsMtx.Unlock()
+
+	manager.collectionMetricsInvalid = true
+}
+
+// GetCollectionMetrics returns the cached collection stats metrics.
+func (manager *MetricsCacheManager) GetCollectionMetrics() (*milvuspb.GetMetricsResponse, error) {
+	retention := manager.GetRetention()
+
+	manager.collectionMetricsMtx.RLock()
+	defer manager.collectionMetricsMtx.RUnlock()
+
+	if manager.collectionMetricsInvalid ||
+		manager.collectionMetrics == nil ||
+		time.Since(manager.collectionMetricsLastUpdatedTime) >= retention {
+
+		return nil, errInvalidCollectionMetricCache
+	}
+
+	return manager.collectionMetrics, nil
+}
+
+// UpdateCollectionMetrics updates collectionMetrics by given info
+func (manager *MetricsCacheManager) UpdateCollectionMetrics(infos *milvuspb.GetMetricsResponse) {
+	manager.collectionMetricsMtx.Lock()
+	defer manager.collectionMetricsMtx.Unlock()
+
+	manager.collectionMetrics = infos
+	manager.collectionMetricsInvalid = false
+	manager.collectionMetricsLastUpdatedTime = time.Now()
+}
@@ -162,3 +162,35 @@ func TestMetricsCacheManager_GetSystemInfoMetrics(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestMetricsCacheManager_GetCollectionMetrics(t *testing.T) {
+	manager := NewMetricsCacheManager()
+	assert.NotNil(t, manager)
+
+	resp, err := manager.GetCollectionMetrics()
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+
+	manager.SetRetention(time.Hour * 24)
+	manager.UpdateCollectionMetrics(&milvuspb.GetMetricsResponse{})
+	resp, err = manager.GetCollectionMetrics()
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// system info metrics are cached separately.
+	_, err = manager.GetSystemInfoMetrics()
+	assert.NotNil(t, err)
+
+	manager.InvalidateCollectionMetrics()
+	resp, err = manager.GetCollectionMetrics()
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+
+	smallRetention := time.Millisecond
+	manager.SetRetention(smallRetention)
+	manager.UpdateCollectionMetrics(&milvuspb.GetMetricsResponse{})
+	time.Sleep(smallRetention)
+	resp, err = manager.GetCollectionMetrics()
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+}
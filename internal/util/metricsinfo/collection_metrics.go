@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsinfo
+
+import (
+	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// IndexMetrics records the state and build progress of an index.
+type IndexMetrics struct {
+	IndexName   string `json:"index_name"`
+	FieldName   string `json:"field_name"`
+	State       string `json:"state"`
+	FailReason  string `json:"fail_reason"`
+	IndexedRows int64  `json:"indexed_rows"`
+	TotalRows   int64  `json:"total_rows"`
+}
+
+// CollectionMetrics records the stats of a collection aggregated from coordinators.
+type CollectionMetrics struct {
+	HasError           bool              `json:"has_error"`
+	ErrorReason        string            `json:"error_reason"`
+	CollectionID       typeutil.UniqueID `json:"collection_id"`
+	CollectionName     string            `json:"collection_name"`
+	RowCount           int64             `json:"row_count"`
+	Loaded             bool              `json:"loaded"`
+	InMemoryPercentage int64             `json:"in_memory_percentage"`
+	Indexes            []IndexMetrics    `json:"indexes"`
+}
+
+// CollectionsMetrics is the response of CollectionStatsMetrics.
+type CollectionsMetrics struct {
+	Collections []CollectionMetrics `json:"collections"`
+}
+
+// MarshalCollectionsMetrics returns the json string of CollectionsMetrics.
+func MarshalCollectionsMetrics(metrics *CollectionsMetrics) (string, error) {
+	binary, err := json.Marshal(metrics)
+	return string(binary), err
+}
+
+// UnmarshalCollectionsMetrics constructs a CollectionsMetrics using the json string.
+func UnmarshalCollectionsMetrics(s string, metrics *CollectionsMetrics) error {
+	return json.Unmarshal([]byte(s), metrics)
+}
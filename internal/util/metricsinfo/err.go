@@ -17,6 +17,10 @@ const (
 	// MsgUnimplementedMetric represents that user requests an unimplemented metric type
 	MsgUnimplementedMetric           = "sorry, but this metric type is not implemented"
 	msgInvalidSystemInfosMetricCache = "system infos metric is invalid"
+	msgInvalidCollectionMetricCache  = "collection stats metric is invalid"
 )
 
-var errInvalidSystemInfosMetricCache = errors.New(msgInvalidSystemInfosMetricCache)
+var (
+	errInvalidSystemInfosMetricCache = errors.New(msgInvalidSystemInfosMetricCache)
+	errInvalidCollectionMetricCache  = errors.New(msgInvalidCollectionMetricCache)
+)
@@ -25,6 +25,9 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// CollectionStatsMetrics means users request for the stats of all collections.
+	CollectionStatsMetrics = "collection_stats"
 )
 
 // ParseMetricType returns the metric type of req
@@ -57,6 +57,9 @@ func (c *GRPCClientBase) SetNewGrpcClientFunc(f func(cc *grpc.ClientConn) interf
 	c.newGrpcClient = f
 }
 
+func (c *GRPCClientBase) SetCompression(name string) {
+}
+
 func (c *GRPCClientBase) GetGrpcClient(ctx context.Context) (interface{}, error) {
 	c.grpcClientMtx.RLock()
 	defer c.grpcClientMtx.RUnlock()
@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"fmt"
 	"math"
 	"runtime"
 	"strconv"
@@ -439,16 +440,164 @@ type proxyConfig struct {
 	MaxPasswordLength        int64
 	MaxFieldNum              int64
 	MaxShardNum              int32
+	MinShardNum              int32
+	DefaultShardNum          int32
+	MaxNumPartitions         int64
 	MaxDimension             int64
+	MaxExpressionLength      int64
 	GinLogging               bool
 	MaxUserNum               int
 	MaxRoleNum               int
 
+	// MaxExpressionTermSize is the max number of elements in an IN list of an expression, no limit if it's 0
+	MaxExpressionTermSize int64
+	// MaxExpressionDepth is the max nesting depth of the parentheses and brackets of an expression, no limit if it's 0
+	MaxExpressionDepth int64
+
 	// required from QueryCoord
 	SearchResultChannelNames   []string
 	RetrieveResultChannelNames []string
 
 	MaxTaskNum int64
+	// DDLWorkerNum is the number of workers executing the ddl tasks
+	DDLWorkerNum int64
+	// DQLWorkerNum is the number of workers executing the search and query tasks
+	DQLWorkerNum int64
+	// DDLConcurrencyLimits is the max number of concurrent ddl tasks of each kind, keyed by lower case task name
+	DDLConcurrencyLimits map[string]int
+	// DDLPriorities is the priority of each kind of ddl tasks, keyed by lower case task name, the queued tasks
+	// of higher priority are scheduled first, 0 if not set
+	DDLPriorities map[string]int
+
+	QueryResultCacheEnabled bool
+	QueryResultCacheSize    int
+	QueryResultCacheTTL     time.Duration
+
+	// ShardPolicy is the default policy to pick shard leaders for search and query
+	ShardPolicy string
+	// MaxReduceParallelism is the max number of workers to reduce search results, capped by GOMAXPROCS
+	MaxReduceParallelism int
+	// MaxOutputFieldNum is the max number of output fields of a search, no limit if it's 0
+	MaxOutputFieldNum int
+	// ServerMaxSendSize is the max size in bytes of a response proxy sends to the clients, it's the
+	// grpc.serverMaxSendSize of the proxy grpc server
+	ServerMaxSendSize int
+	// MaxSearchNq is the max number of vectors of a search, no limit if it's 0
+	MaxSearchNq int64
+	// SearchVectorNaNCheck rejects the searches by float vectors containing NaN or Inf, it scans every element
+	SearchVectorNaNCheck bool
+	// GrpcCompression is the compress type of the traffic between proxy and query nodes, no compression if it's empty
+	GrpcCompression string
+	// InsertDuplicatePKCheck rejects inserting primary keys which already exist, it costs a query per insert
+	InsertDuplicatePKCheck bool
+	// CalcDistanceMaxVectorNum is the max number of vectors of each side of CalcDistance, no limit if it's 0
+	CalcDistanceMaxVectorNum int64
+	// CalcDistanceMaxPairNum is the max number of distances computed by CalcDistance, no limit if it's 0
+	CalcDistanceMaxPairNum int64
+	// AllocTimestampMaxRatePerClient is the max number of AllocTimestamp requests per second of each client, no limit if it's 0
+	AllocTimestampMaxRatePerClient float64
+	// SearchDeleteCheck filters the deleted entities out of the search results, it costs a query per search
+	SearchDeleteCheck bool
+	// SearchParamsStrict rejects the search params unknown to proxy rather than passing them to query nodes
+	SearchParamsStrict bool
+	// SearchWithoutIndex allows searching the vector field without index by brute force, otherwise the search is
+	// rejected with IndexNotExist
+	SearchWithoutIndex bool
+	// CoordRetryMaxAttempts is the max number of attempts of the coord calls in the pass-through handlers, 1 means no retry
+	CoordRetryMaxAttempts uint
+	// CoordRetryInitialBackoff is the backoff before the first retry of a coord call, doubled for each later retry
+	CoordRetryInitialBackoff time.Duration
+	// CoordRetryMaxBackoff is the max backoff between the retries of a coord call
+	CoordRetryMaxBackoff time.Duration
+	// CoordBreakerFailureThreshold is the number of consecutive failed calls of a coord to fast fail its calls,
+	// the circuit breaker is disabled if it's 0
+	CoordBreakerFailureThreshold int
+	// CoordBreakerCooldown is how long the calls of a coord fast fail before a call is let through to probe it
+	CoordBreakerCooldown time.Duration
+	// InsertDedupCacheSize is the max number of remembered insert dedup tokens, insert dedup is disabled if it's 0
+	InsertDedupCacheSize int
+	// InsertDedupTTL is how long the result of an insert is remembered for its dedup token
+	InsertDedupTTL time.Duration
+	// QueryResultDedup keeps only one row for each primary key in the merged query results
+	QueryResultDedup bool
+	// LoadSheddingEnabled rejects the low priority requests when the pressure of proxy exceeds LoadSheddingWatermark
+	LoadSheddingEnabled bool
+	// LoadSheddingWatermark is the pressure in range (0, 1] to shed the low priority requests,
+	// the pressure is the larger of the cpu usage and the usage of the dql queue
+	LoadSheddingWatermark float64
+	// LoadSheddingLowPriority is the lower case name of the request shed first, search or query
+	LoadSheddingLowPriority string
+	// LoadSheddingRetryAfter is the hint in the reason of the shed requests of when to retry
+	LoadSheddingRetryAfter time.Duration
+	// DefaultConsistencyLevel is the consistency level of the searches and queries which use the default consistency
+	// while the collection is of Customized level, one of Strong, Session, Bounded and Eventually
+	DefaultConsistencyLevel string
+	// AuditLogEnabled writes the audit entries of the ddl and credential operations
+	AuditLogEnabled bool
+	// AuditLogFilename is the file of the audit log, it's written to stdout if it's empty
+	AuditLogFilename string
+	// AuditLogMaxSize is the max size in MB of the audit log file before it's rotated
+	AuditLogMaxSize int
+	// DependencyWaitTimeout is how long proxy waits for each coord to be healthy at startup before giving up
+	DependencyWaitTimeout time.Duration
+	// DependencyWaitInitialBackoff is the interval before the second check of a coord, doubled for each later check
+	DependencyWaitInitialBackoff time.Duration
+	// DependencyWaitMaxBackoff is the max interval between the checks of a coord
+	DependencyWaitMaxBackoff time.Duration
+	// DegradedStart starts serving even if DataCoord, IndexCoord or QueryCoord is not healthy in DependencyWaitTimeout,
+	// proxy keeps waiting for them in the background, RootCoord is always required
+	DegradedStart bool
+	// DmlBackpressureEnabled rejects the inserts to a collection if producing to any of its dml channels is slow
+	DmlBackpressureEnabled bool
+	// DmlBackpressureLatencyThreshold is the produce latency of a dml channel above which the inserts are rejected
+	DmlBackpressureLatencyThreshold time.Duration
+	// InsertBatchingEnabled coalesces the small inserts produced to the same dml channel within a short window
+	InsertBatchingEnabled bool
+	// InsertBatchingWindow is how long an insert waits for the others to be produced together at most
+	InsertBatchingWindow time.Duration
+	// InsertBatchingMaxSize is the size in bytes of the batch above which the batch is produced without waiting
+	InsertBatchingMaxSize int
+	// ClockSkewWarnThreshold is the skew between the local clock and the TSO above which a warning is logged
+	ClockSkewWarnThreshold time.Duration
+	// ClockSkewUnhealthyThreshold is the skew between the local clock and the TSO above which the proxy is
+	// reported unhealthy by CheckHealth, if the skew persists for ClockSkewUnhealthyDuration
+	ClockSkewUnhealthyThreshold time.Duration
+	ClockSkewUnhealthyDuration  time.Duration
+	// IDAllocBatchSize is the number of ids fetched from rootcoord at a time for the rows of the inserts
+	IDAllocBatchSize uint32
+	// IDAllocPrefetchThreshold is the number of cached ids below which the next batch is fetched in background
+	IDAllocPrefetchThreshold uint32
+	// IDAllocWaitTimeout is how long an insert waits for the ids at most if the cached ids run out
+	IDAllocWaitTimeout time.Duration
+	// DummyProbeEnabled allows the "probe" dummy requests, which go through the write and read path with a temporary collection
+	DummyProbeEnabled bool
+	// DummyProbeMinInterval is the min interval between two probes, no limit if it's 0
+	DummyProbeMinInterval time.Duration
+	// DummyProbeTimeout is how long a probe takes at most
+	DummyProbeTimeout time.Duration
+	// UserUsageWindow is the sliding window of the usage of each user replied by the "select_resource" dummy requests
+	UserUsageWindow time.Duration
+	// StartupWarmUpEnabled fetches the meta and shard leaders of the loaded collections before proxy turns healthy
+	StartupWarmUpEnabled bool
+	// StartupWarmUpConcurrency is the max number of the collections warmed up at the same time
+	StartupWarmUpConcurrency int
+	// StartupWarmUpBudget is how long the warm up delays proxy turning healthy at most
+	StartupWarmUpBudget time.Duration
+	// MaxInFlightResultBytes is the ceiling of the shard results held by the running search and query tasks, the new
+	// search and query requests are rejected once it's exceeded, 0 means unlimited
+	MaxInFlightResultBytes int64
+	// MaxTaskResultBytes is the max size of the shard results of a search or query task, the task aborts once it's
+	// exceeded, 0 means unlimited
+	MaxTaskResultBytes int64
+	// IndexBuildWriteBlockTimeout is how long the writes are blocked at most by creating an index with block_writes
+	IndexBuildWriteBlockTimeout time.Duration
+	// IndexBuildWriteBlockCheckInterval is the interval to check if the index blocking the writes is built
+	IndexBuildWriteBlockCheckInterval time.Duration
+	// SlowDQLThreshold is the latency above which a search or query request is logged as slow, 0 disables the log
+	SlowDQLThreshold time.Duration
+	// RequestLogSampleRate logs the lifecycle of one of every RequestLogSampleRate successful requests of each method,
+	// the failures are always logged
+	RequestLogSampleRate int64
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -466,12 +615,58 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.initMaxPasswordLength()
 	p.initMaxFieldNum()
 	p.initMaxShardNum()
+	p.initMinShardNum()
+	p.initDefaultShardNum()
+	p.initMaxNumPartitions()
 	p.initMaxDimension()
+	p.initMaxExpressionLength()
+	p.initMaxExpressionTermSize()
+	p.initMaxExpressionDepth()
 
 	p.initMaxTaskNum()
+	p.initDDLWorkerNum()
+	p.initDQLWorkerNum()
+	p.initDDLConcurrencyLimits()
+	p.initDDLPriorities()
 	p.initGinLogging()
 	p.initMaxUserNum()
 	p.initMaxRoleNum()
+
+	p.initQueryResultCacheEnabled()
+	p.initQueryResultCacheSize()
+	p.initQueryResultCacheTTL()
+
+	p.initShardPolicy()
+	p.initMaxReduceParallelism()
+	p.initMaxOutputFieldNum()
+	p.initServerMaxSendSize()
+	p.initSearchVectorLimits()
+	p.initGrpcCompression()
+	p.initInsertDuplicatePKCheck()
+	p.initCalcDistanceLimits()
+	p.initAllocTimestampMaxRatePerClient()
+	p.initSearchDeleteCheck()
+	p.initSearchParamsStrict()
+	p.initSearchWithoutIndex()
+	p.initCoordRetry()
+	p.initCoordBreaker()
+	p.initInsertDedup()
+	p.initQueryResultDedup()
+	p.initLoadShedding()
+	p.initDefaultConsistencyLevel()
+	p.initAuditLog()
+	p.initDependencyWait()
+	p.initDmlBackpressure()
+	p.initInsertBatching()
+	p.initClockSkew()
+	p.initIDAlloc()
+	p.initDummyProbe()
+	p.initUserUsage()
+	p.initStartupWarmUp()
+	p.initResultMemory()
+	p.initIndexBuildWriteBlock()
+	p.initSlowDQLThreshold()
+	p.initRequestLogSampleRate()
 }
 
 // InitAlias initialize Alias member.
@@ -533,6 +728,31 @@ func (p *proxyConfig) initMaxShardNum() {
 	p.MaxShardNum = int32(maxShardNum)
 }
 
+func (p *proxyConfig) initMinShardNum() {
+	minShardNum := p.Base.ParseInt64WithDefault("proxy.minShardNum", 1)
+	if minShardNum < 1 || minShardNum > int64(p.MaxShardNum) {
+		panic(fmt.Sprintf("invalid proxy.minShardNum: %d, should be within [1, %d]", minShardNum, p.MaxShardNum))
+	}
+	p.MinShardNum = int32(minShardNum)
+}
+
+// initDefaultShardNum should be called after the min and max shard number are initialized.
+func (p *proxyConfig) initDefaultShardNum() {
+	defaultShardNum := p.Base.ParseInt64WithDefault("proxy.defaultShardNum", 2)
+	if defaultShardNum < int64(p.MinShardNum) || defaultShardNum > int64(p.MaxShardNum) {
+		panic(fmt.Sprintf("invalid proxy.defaultShardNum: %d, should be within [%d, %d]", defaultShardNum, p.MinShardNum, p.MaxShardNum))
+	}
+	p.DefaultShardNum = int32(defaultShardNum)
+}
+
+func (p *proxyConfig) initMaxNumPartitions() {
+	maxNum := p.Base.ParseInt64WithDefault("proxy.maxNumPartitions", 4096)
+	if maxNum < 1 {
+		panic(fmt.Sprintf("invalid proxy.maxNumPartitions: %d", maxNum))
+	}
+	p.MaxNumPartitions = maxNum
+}
+
 func (p *proxyConfig) initMaxFieldNum() {
 	str := p.Base.LoadWithDefault("proxy.maxFieldNum", "64")
 	maxFieldNum, err := strconv.ParseInt(str, 10, 64)
@@ -551,10 +771,68 @@ func (p *proxyConfig) initMaxDimension() {
 	p.MaxDimension = maxDimension
 }
 
+func (p *proxyConfig) initMaxExpressionLength() {
+	p.MaxExpressionLength = p.Base.ParseInt64WithDefault("proxy.maxExpressionLength", 65536)
+}
+
+func (p *proxyConfig) initMaxExpressionTermSize() {
+	p.MaxExpressionTermSize = p.Base.ParseInt64WithDefault("proxy.maxExpressionTermSize", 16384)
+}
+
+func (p *proxyConfig) initMaxExpressionDepth() {
+	p.MaxExpressionDepth = p.Base.ParseInt64WithDefault("proxy.maxExpressionDepth", 64)
+}
+
 func (p *proxyConfig) initMaxTaskNum() {
 	p.MaxTaskNum = p.Base.ParseInt64WithDefault("proxy.maxTaskNum", 1024)
 }
 
+func (p *proxyConfig) initDDLWorkerNum() {
+	workerNum := p.Base.ParseInt64WithDefault("proxy.ddlWorkerNum", 16)
+	if workerNum < 1 {
+		panic(fmt.Sprintf("invalid proxy.ddlWorkerNum: %d", workerNum))
+	}
+	p.DDLWorkerNum = workerNum
+}
+
+func (p *proxyConfig) initDQLWorkerNum() {
+	workerNum := p.Base.ParseInt64WithDefault("proxy.dqlWorkerNum", 256)
+	if workerNum < 1 {
+		panic(fmt.Sprintf("invalid proxy.dqlWorkerNum: %d", workerNum))
+	}
+	p.DQLWorkerNum = workerNum
+}
+
+func (p *proxyConfig) initDDLConcurrencyLimits() {
+	p.DDLConcurrencyLimits = p.parseTaskValues("proxy.ddlConcurrencyLimits")
+}
+
+// parseTaskValues parses the value of each kind of tasks from the comma separated list of `TaskName:value`, e.g.
+// "CreateIndexTask:16,LoadCollectionTask:16". The task names are lower cased.
+func (p *proxyConfig) parseTaskValues(key string) map[string]int {
+	values := make(map[string]int)
+	str := strings.TrimSpace(p.Base.LoadWithDefault(key, ""))
+	if str == "" {
+		return values
+	}
+	for _, item := range strings.Split(str, ",") {
+		kv := strings.Split(item, ":")
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			panic(fmt.Sprintf("invalid %s: %s", key, str))
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil {
+			panic(fmt.Sprintf("invalid %s: %s", key, str))
+		}
+		values[strings.ToLower(strings.TrimSpace(kv[0]))] = value
+	}
+	return values
+}
+
+func (p *proxyConfig) initDDLPriorities() {
+	p.DDLPriorities = p.parseTaskValues("proxy.ddlPriorities")
+}
+
 func (p *proxyConfig) initGinLogging() {
 	// Gin logging is on by default.
 	p.GinLogging = p.Base.ParseBool("proxy.ginLogging", true)
@@ -590,6 +868,373 @@ func (p *proxyConfig) initMaxRoleNum() {
 	p.MaxRoleNum = int(maxRoleNum)
 }
 
+func (p *proxyConfig) initQueryResultCacheEnabled() {
+	// query result cache is off by default.
+	p.QueryResultCacheEnabled = p.Base.ParseBool("proxy.queryResultCache.enabled", false)
+}
+
+func (p *proxyConfig) initQueryResultCacheSize() {
+	p.QueryResultCacheSize = p.Base.ParseIntWithDefault("proxy.queryResultCache.size", 1024)
+}
+
+func (p *proxyConfig) initQueryResultCacheTTL() {
+	ttl := p.Base.ParseInt64WithDefault("proxy.queryResultCache.ttl", 60)
+	p.QueryResultCacheTTL = time.Duration(ttl) * time.Second
+}
+
+func (p *proxyConfig) initShardPolicy() {
+	policy := strings.ToLower(p.Base.LoadWithDefault("proxy.shardPolicy", "round_robin"))
+	switch policy {
+	case "round_robin", "random", "leader_affinity":
+		p.ShardPolicy = policy
+	default:
+		panic(fmt.Sprintf("invalid proxy.shardPolicy: %s", policy))
+	}
+}
+
+func (p *proxyConfig) initMaxReduceParallelism() {
+	parallelism := p.Base.ParseIntWithDefault("proxy.maxReduceParallelism", 16)
+	if parallelism <= 0 {
+		panic(fmt.Sprintf("invalid proxy.maxReduceParallelism: %d", parallelism))
+	}
+	p.MaxReduceParallelism = parallelism
+}
+
+func (p *proxyConfig) initMaxOutputFieldNum() {
+	maxNum := p.Base.ParseIntWithDefault("proxy.maxOutputFieldNum", 0)
+	if maxNum < 0 {
+		panic(fmt.Sprintf("invalid proxy.maxOutputFieldNum: %d", maxNum))
+	}
+	p.MaxOutputFieldNum = maxNum
+}
+
+// initServerMaxSendSize loads the max send size the same way as the proxy grpc server does.
+func (p *proxyConfig) initServerMaxSendSize() {
+	maxSize := p.Base.ParseIntWithDefault("proxy.grpc.serverMaxSendSize", DefaultServerMaxSendSize)
+	p.ServerMaxSendSize = p.Base.ParseIntWithDefault("grpc.serverMaxSendSize", maxSize)
+	if p.ServerMaxSendSize <= 0 {
+		panic(fmt.Sprintf("invalid grpc.serverMaxSendSize: %d", p.ServerMaxSendSize))
+	}
+}
+
+func (p *proxyConfig) initSearchVectorLimits() {
+	maxNq := p.Base.ParseInt64WithDefault("proxy.maxSearchNq", 16384)
+	if maxNq < 0 {
+		panic(fmt.Sprintf("invalid proxy.maxSearchNq: %d", maxNq))
+	}
+	p.MaxSearchNq = maxNq
+	p.SearchVectorNaNCheck = p.Base.ParseBool("proxy.searchVectorNaNCheck", false)
+}
+
+func (p *proxyConfig) initGrpcCompression() {
+	compression := strings.ToLower(p.Base.LoadWithDefault("proxy.grpcCompression", ""))
+	switch compression {
+	case "", "zstd", "gzip":
+		p.GrpcCompression = compression
+	default:
+		panic(fmt.Sprintf("invalid proxy.grpcCompression: %s", compression))
+	}
+}
+
+func (p *proxyConfig) initInsertDuplicatePKCheck() {
+	p.InsertDuplicatePKCheck = p.Base.ParseBool("proxy.insertDuplicatePKCheck", false)
+}
+
+func (p *proxyConfig) initCalcDistanceLimits() {
+	maxVectorNum := p.Base.ParseInt64WithDefault("proxy.calcDistance.maxVectorNum", 16384)
+	if maxVectorNum < 0 {
+		panic(fmt.Sprintf("invalid proxy.calcDistance.maxVectorNum: %d", maxVectorNum))
+	}
+	p.CalcDistanceMaxVectorNum = maxVectorNum
+
+	maxPairNum := p.Base.ParseInt64WithDefault("proxy.calcDistance.maxPairNum", 10000000)
+	if maxPairNum < 0 {
+		panic(fmt.Sprintf("invalid proxy.calcDistance.maxPairNum: %d", maxPairNum))
+	}
+	p.CalcDistanceMaxPairNum = maxPairNum
+}
+
+func (p *proxyConfig) initAllocTimestampMaxRatePerClient() {
+	rate := p.Base.ParseFloatWithDefault("proxy.allocTimestamp.maxRatePerClient", 100)
+	if rate < 0 {
+		panic(fmt.Sprintf("invalid proxy.allocTimestamp.maxRatePerClient: %v", rate))
+	}
+	p.AllocTimestampMaxRatePerClient = rate
+}
+
+func (p *proxyConfig) initSearchDeleteCheck() {
+	p.SearchDeleteCheck = p.Base.ParseBool("proxy.searchDeleteCheck", false)
+}
+
+func (p *proxyConfig) initSearchParamsStrict() {
+	p.SearchParamsStrict = p.Base.ParseBool("proxy.searchParamsStrict", false)
+}
+
+func (p *proxyConfig) initSearchWithoutIndex() {
+	p.SearchWithoutIndex = p.Base.ParseBool("proxy.searchWithoutIndex", true)
+}
+
+func (p *proxyConfig) initCoordRetry() {
+	maxAttempts := p.Base.ParseIntWithDefault("proxy.coordRetry.maxAttempts", 3)
+	if maxAttempts < 1 {
+		panic(fmt.Sprintf("invalid proxy.coordRetry.maxAttempts: %v", maxAttempts))
+	}
+	p.CoordRetryMaxAttempts = uint(maxAttempts)
+
+	initialBackoff := p.Base.ParseInt64WithDefault("proxy.coordRetry.initialBackoff", 100)
+	if initialBackoff < 0 {
+		panic(fmt.Sprintf("invalid proxy.coordRetry.initialBackoff: %v", initialBackoff))
+	}
+	p.CoordRetryInitialBackoff = time.Duration(initialBackoff) * time.Millisecond
+
+	maxBackoff := p.Base.ParseInt64WithDefault("proxy.coordRetry.maxBackoff", 1000)
+	if maxBackoff < initialBackoff {
+		panic(fmt.Sprintf("invalid proxy.coordRetry.maxBackoff: %v, less than initialBackoff %v", maxBackoff, initialBackoff))
+	}
+	p.CoordRetryMaxBackoff = time.Duration(maxBackoff) * time.Millisecond
+}
+
+func (p *proxyConfig) initCoordBreaker() {
+	threshold := p.Base.ParseIntWithDefault("proxy.coordBreaker.failureThreshold", 5)
+	if threshold < 0 {
+		panic(fmt.Sprintf("invalid proxy.coordBreaker.failureThreshold: %v", threshold))
+	}
+	p.CoordBreakerFailureThreshold = threshold
+
+	cooldown := p.Base.ParseInt64WithDefault("proxy.coordBreaker.cooldown", 10000)
+	if cooldown <= 0 {
+		panic(fmt.Sprintf("invalid proxy.coordBreaker.cooldown: %v", cooldown))
+	}
+	p.CoordBreakerCooldown = time.Duration(cooldown) * time.Millisecond
+}
+
+func (p *proxyConfig) initInsertDedup() {
+	size := p.Base.ParseIntWithDefault("proxy.insertDedup.cacheSize", 4096)
+	if size < 0 {
+		panic(fmt.Sprintf("invalid proxy.insertDedup.cacheSize: %v", size))
+	}
+	p.InsertDedupCacheSize = size
+
+	ttl := p.Base.ParseInt64WithDefault("proxy.insertDedup.ttl", 300)
+	if ttl <= 0 {
+		panic(fmt.Sprintf("invalid proxy.insertDedup.ttl: %v", ttl))
+	}
+	p.InsertDedupTTL = time.Duration(ttl) * time.Second
+}
+
+func (p *proxyConfig) initQueryResultDedup() {
+	p.QueryResultDedup = p.Base.ParseBool("proxy.queryResultDedup", true)
+}
+
+func (p *proxyConfig) initLoadShedding() {
+	p.LoadSheddingEnabled = p.Base.ParseBool("proxy.loadShedding.enabled", false)
+
+	watermark := p.Base.ParseFloatWithDefault("proxy.loadShedding.watermark", 0.8)
+	if watermark <= 0 || watermark > 1 {
+		panic(fmt.Sprintf("invalid proxy.loadShedding.watermark: %v", watermark))
+	}
+	p.LoadSheddingWatermark = watermark
+
+	lowPriority := strings.ToLower(p.Base.LoadWithDefault("proxy.loadShedding.lowPriority", "query"))
+	switch lowPriority {
+	case "search", "query":
+		p.LoadSheddingLowPriority = lowPriority
+	default:
+		panic(fmt.Sprintf("invalid proxy.loadShedding.lowPriority: %s", lowPriority))
+	}
+
+	retryAfter := p.Base.ParseInt64WithDefault("proxy.loadShedding.retryAfter", 1000)
+	if retryAfter <= 0 {
+		panic(fmt.Sprintf("invalid proxy.loadShedding.retryAfter: %v", retryAfter))
+	}
+	p.LoadSheddingRetryAfter = time.Duration(retryAfter) * time.Millisecond
+}
+
+func (p *proxyConfig) initDefaultConsistencyLevel() {
+	level := p.Base.LoadWithDefault("proxy.defaultConsistencyLevel", "Strong")
+	switch strings.ToLower(level) {
+	case "strong":
+		p.DefaultConsistencyLevel = "Strong"
+	case "session":
+		p.DefaultConsistencyLevel = "Session"
+	case "bounded":
+		p.DefaultConsistencyLevel = "Bounded"
+	case "eventually":
+		p.DefaultConsistencyLevel = "Eventually"
+	default:
+		panic(fmt.Sprintf("invalid proxy.defaultConsistencyLevel: %s", level))
+	}
+}
+
+func (p *proxyConfig) initAuditLog() {
+	p.AuditLogEnabled = p.Base.ParseBool("proxy.audit.enabled", false)
+	p.AuditLogFilename = p.Base.LoadWithDefault("proxy.audit.filename", "")
+	maxSize := p.Base.ParseIntWithDefault("proxy.audit.maxSize", 300)
+	if maxSize <= 0 {
+		panic(fmt.Sprintf("invalid proxy.audit.maxSize: %v", maxSize))
+	}
+	p.AuditLogMaxSize = maxSize
+}
+
+func (p *proxyConfig) initDependencyWait() {
+	timeout := p.Base.ParseInt64WithDefault("proxy.dependencyWait.timeout", 600)
+	if timeout <= 0 {
+		panic(fmt.Sprintf("invalid proxy.dependencyWait.timeout: %v", timeout))
+	}
+	p.DependencyWaitTimeout = time.Duration(timeout) * time.Second
+
+	initialBackoff := p.Base.ParseInt64WithDefault("proxy.dependencyWait.initialBackoff", 200)
+	if initialBackoff <= 0 {
+		panic(fmt.Sprintf("invalid proxy.dependencyWait.initialBackoff: %v", initialBackoff))
+	}
+	p.DependencyWaitInitialBackoff = time.Duration(initialBackoff) * time.Millisecond
+
+	maxBackoff := p.Base.ParseInt64WithDefault("proxy.dependencyWait.maxBackoff", 3000)
+	if maxBackoff < initialBackoff {
+		panic(fmt.Sprintf("invalid proxy.dependencyWait.maxBackoff: %v, less than initialBackoff %v", maxBackoff, initialBackoff))
+	}
+	p.DependencyWaitMaxBackoff = time.Duration(maxBackoff) * time.Millisecond
+
+	p.DegradedStart = p.Base.ParseBool("proxy.dependencyWait.degradedStart", false)
+}
+
+func (p *proxyConfig) initDmlBackpressure() {
+	p.DmlBackpressureEnabled = p.Base.ParseBool("proxy.dmlBackpressure.enabled", false)
+	threshold := p.Base.ParseInt64WithDefault("proxy.dmlBackpressure.latencyThreshold", 5000)
+	if threshold <= 0 {
+		panic(fmt.Sprintf("invalid proxy.dmlBackpressure.latencyThreshold: %v", threshold))
+	}
+	p.DmlBackpressureLatencyThreshold = time.Duration(threshold) * time.Millisecond
+}
+
+func (p *proxyConfig) initInsertBatching() {
+	p.InsertBatchingEnabled = p.Base.ParseBool("proxy.insertBatching.enabled", false)
+	window := p.Base.ParseInt64WithDefault("proxy.insertBatching.window", 5)
+	if window <= 0 || window > 1000 {
+		panic(fmt.Sprintf("invalid proxy.insertBatching.window: %v, should be in (0, 1000]", window))
+	}
+	p.InsertBatchingWindow = time.Duration(window) * time.Millisecond
+	maxSize := p.Base.ParseIntWithDefault("proxy.insertBatching.maxSize", 1048576)
+	if maxSize <= 0 {
+		panic(fmt.Sprintf("invalid proxy.insertBatching.maxSize: %v", maxSize))
+	}
+	p.InsertBatchingMaxSize = maxSize
+}
+
+func (p *proxyConfig) initClockSkew() {
+	warnThreshold := p.Base.ParseInt64WithDefault("proxy.clockSkew.warnThreshold", 1000)
+	if warnThreshold <= 0 {
+		panic(fmt.Sprintf("invalid proxy.clockSkew.warnThreshold: %v", warnThreshold))
+	}
+	p.ClockSkewWarnThreshold = time.Duration(warnThreshold) * time.Millisecond
+	unhealthyThreshold := p.Base.ParseInt64WithDefault("proxy.clockSkew.unhealthyThreshold", 10000)
+	if unhealthyThreshold < warnThreshold {
+		panic(fmt.Sprintf("invalid proxy.clockSkew.unhealthyThreshold: %v, less than warnThreshold %v", unhealthyThreshold, warnThreshold))
+	}
+	p.ClockSkewUnhealthyThreshold = time.Duration(unhealthyThreshold) * time.Millisecond
+	unhealthyDuration := p.Base.ParseInt64WithDefault("proxy.clockSkew.unhealthyDuration", 60)
+	if unhealthyDuration < 0 {
+		panic(fmt.Sprintf("invalid proxy.clockSkew.unhealthyDuration: %v", unhealthyDuration))
+	}
+	p.ClockSkewUnhealthyDuration = time.Duration(unhealthyDuration) * time.Second
+}
+
+func (p *proxyConfig) initIDAlloc() {
+	batchSize := p.Base.ParseInt64WithDefault("proxy.idAllocator.batchSize", 200000)
+	if batchSize <= 0 || batchSize > math.MaxUint32 {
+		panic(fmt.Sprintf("invalid proxy.idAllocator.batchSize: %v", batchSize))
+	}
+	p.IDAllocBatchSize = uint32(batchSize)
+	threshold := p.Base.ParseInt64WithDefault("proxy.idAllocator.prefetchThreshold", 50000)
+	if threshold < 0 || threshold >= batchSize {
+		panic(fmt.Sprintf("invalid proxy.idAllocator.prefetchThreshold: %v, should be in [0, batchSize %v)", threshold, batchSize))
+	}
+	p.IDAllocPrefetchThreshold = uint32(threshold)
+	waitTimeout := p.Base.ParseInt64WithDefault("proxy.idAllocator.waitTimeout", 10000)
+	if waitTimeout <= 0 {
+		panic(fmt.Sprintf("invalid proxy.idAllocator.waitTimeout: %v", waitTimeout))
+	}
+	p.IDAllocWaitTimeout = time.Duration(waitTimeout) * time.Millisecond
+}
+
+func (p *proxyConfig) initDummyProbe() {
+	p.DummyProbeEnabled = p.Base.ParseBool("proxy.dummyProbe.enabled", false)
+	minInterval := p.Base.ParseInt64WithDefault("proxy.dummyProbe.minInterval", 60)
+	if minInterval < 0 {
+		panic(fmt.Sprintf("invalid proxy.dummyProbe.minInterval: %v", minInterval))
+	}
+	p.DummyProbeMinInterval = time.Duration(minInterval) * time.Second
+	timeout := p.Base.ParseInt64WithDefault("proxy.dummyProbe.timeout", 60)
+	if timeout <= 0 {
+		panic(fmt.Sprintf("invalid proxy.dummyProbe.timeout: %v", timeout))
+	}
+	p.DummyProbeTimeout = time.Duration(timeout) * time.Second
+}
+
+func (p *proxyConfig) initUserUsage() {
+	window := p.Base.ParseInt64WithDefault("proxy.userUsage.window", 600)
+	if window <= 0 {
+		panic(fmt.Sprintf("invalid proxy.userUsage.window: %v", window))
+	}
+	p.UserUsageWindow = time.Duration(window) * time.Second
+}
+
+func (p *proxyConfig) initStartupWarmUp() {
+	p.StartupWarmUpEnabled = p.Base.ParseBool("proxy.startupWarmUp.enabled", false)
+	concurrency := p.Base.ParseIntWithDefault("proxy.startupWarmUp.concurrency", 8)
+	if concurrency < 1 {
+		panic(fmt.Sprintf("invalid proxy.startupWarmUp.concurrency: %v", concurrency))
+	}
+	p.StartupWarmUpConcurrency = concurrency
+	budget := p.Base.ParseInt64WithDefault("proxy.startupWarmUp.budget", 30)
+	if budget <= 0 {
+		panic(fmt.Sprintf("invalid proxy.startupWarmUp.budget: %v", budget))
+	}
+	p.StartupWarmUpBudget = time.Duration(budget) * time.Second
+}
+
+func (p *proxyConfig) initResultMemory() {
+	maxInFlight := p.Base.ParseInt64WithDefault("proxy.resultMemory.maxInFlightMB", 0)
+	if maxInFlight < 0 {
+		panic(fmt.Sprintf("invalid proxy.resultMemory.maxInFlightMB: %v", maxInFlight))
+	}
+	p.MaxInFlightResultBytes = maxInFlight * 1024 * 1024
+	maxTask := p.Base.ParseInt64WithDefault("proxy.resultMemory.maxTaskMB", 0)
+	if maxTask < 0 {
+		panic(fmt.Sprintf("invalid proxy.resultMemory.maxTaskMB: %v", maxTask))
+	}
+	p.MaxTaskResultBytes = maxTask * 1024 * 1024
+}
+
+func (p *proxyConfig) initIndexBuildWriteBlock() {
+	timeout := p.Base.ParseInt64WithDefault("proxy.indexBuildWriteBlock.timeout", 3600)
+	if timeout <= 0 {
+		panic(fmt.Sprintf("invalid proxy.indexBuildWriteBlock.timeout: %v", timeout))
+	}
+	p.IndexBuildWriteBlockTimeout = time.Duration(timeout) * time.Second
+	interval := p.Base.ParseInt64WithDefault("proxy.indexBuildWriteBlock.checkInterval", 1000)
+	if interval <= 0 {
+		panic(fmt.Sprintf("invalid proxy.indexBuildWriteBlock.checkInterval: %v", interval))
+	}
+	p.IndexBuildWriteBlockCheckInterval = time.Duration(interval) * time.Millisecond
+}
+
+func (p *proxyConfig) initSlowDQLThreshold() {
+	threshold := p.Base.ParseInt64WithDefault("proxy.slowDQLThreshold", 5000)
+	if threshold < 0 {
+		panic(fmt.Sprintf("invalid proxy.slowDQLThreshold: %v", threshold))
+	}
+	p.SlowDQLThreshold = time.Duration(threshold) * time.Millisecond
+}
+
+func (p *proxyConfig) initRequestLogSampleRate() {
+	rate := p.Base.ParseInt64WithDefault("proxy.requestLogSampleRate", 1)
+	if rate < 1 {
+		panic(fmt.Sprintf("invalid proxy.requestLogSampleRate: %v", rate))
+	}
+	p.RequestLogSampleRate = rate
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- querycoord ---
 type queryCoordConfig struct {
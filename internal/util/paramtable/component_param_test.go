@@ -150,10 +150,145 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum)
 
 		t.Logf("MaxShardNum: %d", Params.MaxShardNum)
+		assert.Equal(t, int32(1), Params.MinShardNum)
+		assert.Equal(t, int32(2), Params.DefaultShardNum)
+		assert.Equal(t, int64(4096), Params.MaxNumPartitions)
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
+		assert.Equal(t, int64(16), Params.DDLWorkerNum)
+		assert.Equal(t, int64(256), Params.DQLWorkerNum)
+
+		assert.Equal(t, int64(65536), Params.MaxExpressionLength)
+		assert.Equal(t, int64(16384), Params.MaxExpressionTermSize)
+		assert.Equal(t, int64(64), Params.MaxExpressionDepth)
+
+		assert.False(t, Params.QueryResultCacheEnabled)
+		assert.Equal(t, 1024, Params.QueryResultCacheSize)
+		assert.Equal(t, 60*time.Second, Params.QueryResultCacheTTL)
+
+		assert.Equal(t, "round_robin", Params.ShardPolicy)
+		Params.Base.Save("proxy.shardPolicy", "Leader_Affinity")
+		Params.initShardPolicy()
+		assert.Equal(t, "leader_affinity", Params.ShardPolicy)
+		Params.Base.Save("proxy.shardPolicy", "round_robin")
+		Params.initShardPolicy()
+
+		assert.Equal(t, 16, Params.MaxReduceParallelism)
+		assert.Equal(t, 0, Params.MaxOutputFieldNum)
+		assert.Equal(t, DefaultServerMaxSendSize, Params.ServerMaxSendSize)
+		Params.Base.Save("grpc.serverMaxSendSize", "1024")
+		Params.initServerMaxSendSize()
+		assert.Equal(t, 1024, Params.ServerMaxSendSize)
+		Params.Base.Save("grpc.serverMaxSendSize", "2147483647")
+		Params.initServerMaxSendSize()
+		assert.Equal(t, int64(16384), Params.MaxSearchNq)
+		assert.False(t, Params.SearchVectorNaNCheck)
+		assert.Equal(t, "", Params.GrpcCompression)
+		Params.Base.Save("proxy.grpcCompression", "ZSTD")
+		Params.initGrpcCompression()
+		assert.Equal(t, "zstd", Params.GrpcCompression)
+		Params.Base.Save("proxy.grpcCompression", "")
+		Params.initGrpcCompression()
+		assert.False(t, Params.InsertDuplicatePKCheck)
+		assert.Equal(t, int64(16384), Params.CalcDistanceMaxVectorNum)
+		assert.Equal(t, int64(10000000), Params.CalcDistanceMaxPairNum)
+		assert.Equal(t, float64(100), Params.AllocTimestampMaxRatePerClient)
+		assert.False(t, Params.SearchDeleteCheck)
+		assert.False(t, Params.SearchParamsStrict)
+		assert.True(t, Params.SearchWithoutIndex)
+		assert.Equal(t, uint(3), Params.CoordRetryMaxAttempts)
+		assert.Equal(t, 100*time.Millisecond, Params.CoordRetryInitialBackoff)
+		assert.Equal(t, time.Second, Params.CoordRetryMaxBackoff)
+		assert.Equal(t, 5, Params.CoordBreakerFailureThreshold)
+		assert.Equal(t, 10*time.Second, Params.CoordBreakerCooldown)
+		assert.Equal(t, 4096, Params.InsertDedupCacheSize)
+		assert.Equal(t, 300*time.Second, Params.InsertDedupTTL)
+		assert.True(t, Params.QueryResultDedup)
+		assert.False(t, Params.LoadSheddingEnabled)
+		assert.Equal(t, 0.8, Params.LoadSheddingWatermark)
+		assert.Equal(t, "query", Params.LoadSheddingLowPriority)
+		assert.Equal(t, time.Second, Params.LoadSheddingRetryAfter)
+		assert.Equal(t, "Strong", Params.DefaultConsistencyLevel)
+		assert.False(t, Params.AuditLogEnabled)
+		assert.Equal(t, "", Params.AuditLogFilename)
+		assert.Equal(t, 300, Params.AuditLogMaxSize)
+		assert.Equal(t, 600*time.Second, Params.DependencyWaitTimeout)
+		assert.Equal(t, 200*time.Millisecond, Params.DependencyWaitInitialBackoff)
+		assert.Equal(t, 3*time.Second, Params.DependencyWaitMaxBackoff)
+		assert.False(t, Params.DegradedStart)
+		assert.False(t, Params.DmlBackpressureEnabled)
+		assert.Equal(t, 5*time.Second, Params.DmlBackpressureLatencyThreshold)
+		assert.False(t, Params.InsertBatchingEnabled)
+		assert.Equal(t, 5*time.Millisecond, Params.InsertBatchingWindow)
+		assert.Equal(t, 1048576, Params.InsertBatchingMaxSize)
+		assert.Equal(t, time.Second, Params.ClockSkewWarnThreshold)
+		assert.Equal(t, 10*time.Second, Params.ClockSkewUnhealthyThreshold)
+		assert.Equal(t, time.Minute, Params.ClockSkewUnhealthyDuration)
+		assert.Equal(t, uint32(200000), Params.IDAllocBatchSize)
+		assert.Equal(t, uint32(50000), Params.IDAllocPrefetchThreshold)
+		assert.Equal(t, 10*time.Second, Params.IDAllocWaitTimeout)
+		assert.False(t, Params.DummyProbeEnabled)
+		assert.Equal(t, time.Minute, Params.DummyProbeMinInterval)
+		assert.Equal(t, time.Minute, Params.DummyProbeTimeout)
+		assert.Equal(t, 10*time.Minute, Params.UserUsageWindow)
+		assert.False(t, Params.StartupWarmUpEnabled)
+		assert.Equal(t, 8, Params.StartupWarmUpConcurrency)
+		assert.Equal(t, 30*time.Second, Params.StartupWarmUpBudget)
+		assert.Equal(t, int64(0), Params.MaxInFlightResultBytes)
+		assert.Equal(t, int64(0), Params.MaxTaskResultBytes)
+		Params.Base.Save("proxy.resultMemory.maxInFlightMB", "1024")
+		Params.Base.Save("proxy.resultMemory.maxTaskMB", "256")
+		Params.initResultMemory()
+		assert.Equal(t, int64(1024*1024*1024), Params.MaxInFlightResultBytes)
+		assert.Equal(t, int64(256*1024*1024), Params.MaxTaskResultBytes)
+		Params.Base.Save("proxy.resultMemory.maxInFlightMB", "0")
+		Params.Base.Save("proxy.resultMemory.maxTaskMB", "0")
+		Params.initResultMemory()
+		assert.Equal(t, time.Hour, Params.IndexBuildWriteBlockTimeout)
+		assert.Equal(t, time.Second, Params.IndexBuildWriteBlockCheckInterval)
+		Params.Base.Save("proxy.indexBuildWriteBlock.timeout", "600")
+		Params.Base.Save("proxy.indexBuildWriteBlock.checkInterval", "200")
+		Params.initIndexBuildWriteBlock()
+		assert.Equal(t, 10*time.Minute, Params.IndexBuildWriteBlockTimeout)
+		assert.Equal(t, 200*time.Millisecond, Params.IndexBuildWriteBlockCheckInterval)
+		Params.Base.Remove("proxy.indexBuildWriteBlock.timeout")
+		Params.Base.Remove("proxy.indexBuildWriteBlock.checkInterval")
+		Params.initIndexBuildWriteBlock()
+		assert.Equal(t, 5*time.Second, Params.SlowDQLThreshold)
+		Params.Base.Save("proxy.slowDQLThreshold", "0")
+		Params.initSlowDQLThreshold()
+		assert.Equal(t, time.Duration(0), Params.SlowDQLThreshold)
+		Params.Base.Remove("proxy.slowDQLThreshold")
+		Params.initSlowDQLThreshold()
+		assert.Equal(t, int64(1), Params.RequestLogSampleRate)
+		Params.Base.Save("proxy.requestLogSampleRate", "100")
+		Params.initRequestLogSampleRate()
+		assert.Equal(t, int64(100), Params.RequestLogSampleRate)
+		Params.Base.Save("proxy.requestLogSampleRate", "0")
+		assert.Panics(t, Params.initRequestLogSampleRate)
+		Params.Base.Remove("proxy.requestLogSampleRate")
+		Params.initRequestLogSampleRate()
+		Params.Base.Save("proxy.defaultConsistencyLevel", "bounded")
+		Params.initDefaultConsistencyLevel()
+		assert.Equal(t, "Bounded", Params.DefaultConsistencyLevel)
+		Params.Base.Save("proxy.defaultConsistencyLevel", "Strong")
+		Params.initDefaultConsistencyLevel()
+
+		assert.Empty(t, Params.DDLConcurrencyLimits)
+		Params.Base.Save("proxy.ddlConcurrencyLimits", "CreateIndexTask:16, LoadCollectionTask: 8")
+		Params.initDDLConcurrencyLimits()
+		assert.Equal(t, map[string]int{"createindextask": 16, "loadcollectiontask": 8}, Params.DDLConcurrencyLimits)
+		Params.Base.Remove("proxy.ddlConcurrencyLimits")
+		Params.initDDLConcurrencyLimits()
+
+		assert.Empty(t, Params.DDLPriorities)
+		Params.Base.Save("proxy.ddlPriorities", "DropCollectionTask:1,ReleaseCollectionTask:-1")
+		Params.initDDLPriorities()
+		assert.Equal(t, map[string]int{"dropcollectiontask": 1, "releasecollectiontask": -1}, Params.DDLPriorities)
+		Params.Base.Remove("proxy.ddlPriorities")
+		Params.initDDLPriorities()
 	})
 
 	t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -199,16 +334,290 @@ func TestComponentParam(t *testing.T) {
 			Params.initMaxShardNum()
 		})
 
+		shouldPanic(t, "proxy.minShardNum", func() {
+			Params.Base.Save("proxy.minShardNum", "0")
+			Params.initMinShardNum()
+		})
+
+		shouldPanic(t, "proxy.defaultShardNum", func() {
+			Params.Base.Save("proxy.defaultShardNum", "1000")
+			Params.initDefaultShardNum()
+		})
+
+		shouldPanic(t, "proxy.maxNumPartitions", func() {
+			Params.Base.Save("proxy.maxNumPartitions", "0")
+			Params.initMaxNumPartitions()
+		})
+
 		shouldPanic(t, "proxy.maxDimension", func() {
 			Params.Base.Save("proxy.maxDimension", "-asdf")
 			Params.initMaxDimension()
 		})
 
+		shouldPanic(t, "proxy.maxExpressionLength", func() {
+			Params.Base.Save("proxy.maxExpressionLength", "abc")
+			Params.initMaxExpressionLength()
+		})
+
+		shouldPanic(t, "proxy.maxExpressionTermSize", func() {
+			Params.Base.Save("proxy.maxExpressionTermSize", "abc")
+			Params.initMaxExpressionTermSize()
+		})
+
+		shouldPanic(t, "proxy.maxExpressionDepth", func() {
+			Params.Base.Save("proxy.maxExpressionDepth", "abc")
+			Params.initMaxExpressionDepth()
+		})
+
 		shouldPanic(t, "proxy.maxTaskNum", func() {
 			Params.Base.Save("proxy.maxTaskNum", "-asdf")
 			Params.initMaxTaskNum()
 		})
 
+		shouldPanic(t, "proxy.ddlWorkerNum", func() {
+			Params.Base.Save("proxy.ddlWorkerNum", "0")
+			Params.initDDLWorkerNum()
+		})
+
+		shouldPanic(t, "proxy.dqlWorkerNum", func() {
+			Params.Base.Save("proxy.dqlWorkerNum", "0")
+			Params.initDQLWorkerNum()
+		})
+
+		shouldPanic(t, "proxy.shardPolicy", func() {
+			Params.Base.Save("proxy.shardPolicy", "unknown")
+			defer Params.Base.Save("proxy.shardPolicy", "round_robin")
+			Params.initShardPolicy()
+		})
+
+		shouldPanic(t, "proxy.maxReduceParallelism", func() {
+			Params.Base.Save("proxy.maxReduceParallelism", "0")
+			defer Params.Base.Save("proxy.maxReduceParallelism", "16")
+			Params.initMaxReduceParallelism()
+		})
+
+		shouldPanic(t, "proxy.maxOutputFieldNum", func() {
+			Params.Base.Save("proxy.maxOutputFieldNum", "-1")
+			defer Params.Base.Save("proxy.maxOutputFieldNum", "0")
+			Params.initMaxOutputFieldNum()
+		})
+
+		shouldPanic(t, "proxy.maxSearchNq", func() {
+			Params.Base.Save("proxy.maxSearchNq", "-1")
+			defer Params.Base.Save("proxy.maxSearchNq", "16384")
+			Params.initSearchVectorLimits()
+		})
+
+		shouldPanic(t, "proxy.calcDistance.maxVectorNum", func() {
+			Params.Base.Save("proxy.calcDistance.maxVectorNum", "-1")
+			defer Params.Base.Save("proxy.calcDistance.maxVectorNum", "16384")
+			Params.initCalcDistanceLimits()
+		})
+
+		shouldPanic(t, "proxy.calcDistance.maxPairNum", func() {
+			Params.Base.Save("proxy.calcDistance.maxPairNum", "-1")
+			defer Params.Base.Save("proxy.calcDistance.maxPairNum", "10000000")
+			Params.initCalcDistanceLimits()
+		})
+
+		shouldPanic(t, "proxy.allocTimestamp.maxRatePerClient", func() {
+			Params.Base.Save("proxy.allocTimestamp.maxRatePerClient", "-1")
+			defer Params.Base.Save("proxy.allocTimestamp.maxRatePerClient", "100")
+			Params.initAllocTimestampMaxRatePerClient()
+		})
+
+		shouldPanic(t, "proxy.coordRetry.maxAttempts", func() {
+			Params.Base.Save("proxy.coordRetry.maxAttempts", "0")
+			defer Params.Base.Save("proxy.coordRetry.maxAttempts", "3")
+			Params.initCoordRetry()
+		})
+
+		shouldPanic(t, "proxy.coordRetry.maxBackoff", func() {
+			Params.Base.Save("proxy.coordRetry.maxBackoff", "10")
+			defer Params.Base.Save("proxy.coordRetry.maxBackoff", "1000")
+			Params.initCoordRetry()
+		})
+
+		shouldPanic(t, "proxy.coordBreaker.failureThreshold", func() {
+			Params.Base.Save("proxy.coordBreaker.failureThreshold", "-1")
+			defer Params.Base.Save("proxy.coordBreaker.failureThreshold", "5")
+			Params.initCoordBreaker()
+		})
+
+		shouldPanic(t, "proxy.coordBreaker.cooldown", func() {
+			Params.Base.Save("proxy.coordBreaker.cooldown", "0")
+			defer Params.Base.Save("proxy.coordBreaker.cooldown", "10000")
+			Params.initCoordBreaker()
+		})
+
+		shouldPanic(t, "proxy.insertDedup.cacheSize", func() {
+			Params.Base.Save("proxy.insertDedup.cacheSize", "-1")
+			defer Params.Base.Save("proxy.insertDedup.cacheSize", "4096")
+			Params.initInsertDedup()
+		})
+
+		shouldPanic(t, "proxy.insertDedup.ttl", func() {
+			Params.Base.Save("proxy.insertDedup.ttl", "0")
+			defer Params.Base.Save("proxy.insertDedup.ttl", "300")
+			Params.initInsertDedup()
+		})
+
+		shouldPanic(t, "proxy.loadShedding.watermark", func() {
+			Params.Base.Save("proxy.loadShedding.watermark", "1.5")
+			defer Params.Base.Save("proxy.loadShedding.watermark", "0.8")
+			Params.initLoadShedding()
+		})
+
+		shouldPanic(t, "proxy.loadShedding.lowPriority", func() {
+			Params.Base.Save("proxy.loadShedding.lowPriority", "insert")
+			defer Params.Base.Save("proxy.loadShedding.lowPriority", "query")
+			Params.initLoadShedding()
+		})
+
+		shouldPanic(t, "proxy.loadShedding.retryAfter", func() {
+			Params.Base.Save("proxy.loadShedding.retryAfter", "0")
+			defer Params.Base.Save("proxy.loadShedding.retryAfter", "1000")
+			Params.initLoadShedding()
+		})
+
+		shouldPanic(t, "proxy.defaultConsistencyLevel", func() {
+			Params.Base.Save("proxy.defaultConsistencyLevel", "Customized")
+			defer Params.Base.Save("proxy.defaultConsistencyLevel", "Strong")
+			Params.initDefaultConsistencyLevel()
+		})
+
+		shouldPanic(t, "proxy.audit.maxSize", func() {
+			Params.Base.Save("proxy.audit.maxSize", "0")
+			defer Params.Base.Save("proxy.audit.maxSize", "300")
+			Params.initAuditLog()
+		})
+
+		shouldPanic(t, "proxy.dependencyWait.timeout", func() {
+			Params.Base.Save("proxy.dependencyWait.timeout", "0")
+			defer Params.Base.Save("proxy.dependencyWait.timeout", "600")
+			Params.initDependencyWait()
+		})
+
+		shouldPanic(t, "proxy.dependencyWait.maxBackoff", func() {
+			Params.Base.Save("proxy.dependencyWait.maxBackoff", "100")
+			defer Params.Base.Save("proxy.dependencyWait.maxBackoff", "3000")
+			Params.initDependencyWait()
+		})
+
+		shouldPanic(t, "proxy.dmlBackpressure.latencyThreshold", func() {
+			Params.Base.Save("proxy.dmlBackpressure.latencyThreshold", "0")
+			defer Params.Base.Save("proxy.dmlBackpressure.latencyThreshold", "5000")
+			Params.initDmlBackpressure()
+		})
+
+		shouldPanic(t, "proxy.insertBatching.window", func() {
+			Params.Base.Save("proxy.insertBatching.window", "0")
+			defer Params.Base.Save("proxy.insertBatching.window", "5")
+			Params.initInsertBatching()
+		})
+
+		shouldPanic(t, "proxy.insertBatching.maxSize", func() {
+			Params.Base.Save("proxy.insertBatching.maxSize", "-1")
+			defer Params.Base.Save("proxy.insertBatching.maxSize", "1048576")
+			Params.initInsertBatching()
+		})
+
+		shouldPanic(t, "proxy.clockSkew.warnThreshold", func() {
+			Params.Base.Save("proxy.clockSkew.warnThreshold", "0")
+			defer Params.Base.Save("proxy.clockSkew.warnThreshold", "1000")
+			Params.initClockSkew()
+		})
+
+		shouldPanic(t, "proxy.clockSkew.unhealthyThreshold", func() {
+			Params.Base.Save("proxy.clockSkew.unhealthyThreshold", "500")
+			defer Params.Base.Save("proxy.clockSkew.unhealthyThreshold", "10000")
+			Params.initClockSkew()
+		})
+
+		shouldPanic(t, "proxy.idAllocator.batchSize", func() {
+			Params.Base.Save("proxy.idAllocator.batchSize", "0")
+			defer Params.Base.Save("proxy.idAllocator.batchSize", "200000")
+			Params.initIDAlloc()
+		})
+
+		shouldPanic(t, "proxy.idAllocator.prefetchThreshold", func() {
+			Params.Base.Save("proxy.idAllocator.prefetchThreshold", "200000")
+			defer Params.Base.Save("proxy.idAllocator.prefetchThreshold", "50000")
+			Params.initIDAlloc()
+		})
+
+		shouldPanic(t, "proxy.idAllocator.waitTimeout", func() {
+			Params.Base.Save("proxy.idAllocator.waitTimeout", "0")
+			defer Params.Base.Save("proxy.idAllocator.waitTimeout", "10000")
+			Params.initIDAlloc()
+		})
+
+		shouldPanic(t, "proxy.dummyProbe.minInterval", func() {
+			Params.Base.Save("proxy.dummyProbe.minInterval", "-1")
+			defer Params.Base.Save("proxy.dummyProbe.minInterval", "60")
+			Params.initDummyProbe()
+		})
+
+		shouldPanic(t, "proxy.dummyProbe.timeout", func() {
+			Params.Base.Save("proxy.dummyProbe.timeout", "0")
+			defer Params.Base.Save("proxy.dummyProbe.timeout", "60")
+			Params.initDummyProbe()
+		})
+
+		shouldPanic(t, "proxy.userUsage.window", func() {
+			Params.Base.Save("proxy.userUsage.window", "0")
+			defer Params.Base.Save("proxy.userUsage.window", "600")
+			Params.initUserUsage()
+		})
+
+		shouldPanic(t, "proxy.startupWarmUp.concurrency", func() {
+			Params.Base.Save("proxy.startupWarmUp.concurrency", "0")
+			defer Params.Base.Save("proxy.startupWarmUp.concurrency", "8")
+			Params.initStartupWarmUp()
+		})
+
+		shouldPanic(t, "proxy.startupWarmUp.budget", func() {
+			Params.Base.Save("proxy.startupWarmUp.budget", "0")
+			defer Params.Base.Save("proxy.startupWarmUp.budget", "30")
+			Params.initStartupWarmUp()
+		})
+
+		shouldPanic(t, "proxy.resultMemory.maxInFlightMB", func() {
+			Params.Base.Save("proxy.resultMemory.maxInFlightMB", "-1")
+			defer Params.Base.Save("proxy.resultMemory.maxInFlightMB", "0")
+			Params.initResultMemory()
+		})
+
+		shouldPanic(t, "proxy.resultMemory.maxTaskMB", func() {
+			Params.Base.Save("proxy.resultMemory.maxTaskMB", "-1")
+			defer Params.Base.Save("proxy.resultMemory.maxTaskMB", "0")
+			Params.initResultMemory()
+		})
+
+		shouldPanic(t, "proxy.grpcCompression", func() {
+			Params.Base.Save("proxy.grpcCompression", "lz4")
+			defer Params.Base.Save("proxy.grpcCompression", "")
+			Params.initGrpcCompression()
+		})
+
+		shouldPanic(t, "proxy.ddlConcurrencyLimits", func() {
+			Params.Base.Save("proxy.ddlConcurrencyLimits", "CreateIndexTask:abc")
+			defer Params.Base.Remove("proxy.ddlConcurrencyLimits")
+			Params.initDDLConcurrencyLimits()
+		})
+
+		shouldPanic(t, "proxy.ddlConcurrencyLimits", func() {
+			Params.Base.Save("proxy.ddlConcurrencyLimits", "CreateIndexTask")
+			defer Params.Base.Remove("proxy.ddlConcurrencyLimits")
+			Params.initDDLConcurrencyLimits()
+		})
+
+		shouldPanic(t, "proxy.ddlPriorities", func() {
+			Params.Base.Save("proxy.ddlPriorities", "DropCollectionTask:abc")
+			defer Params.Base.Remove("proxy.ddlPriorities")
+			Params.initDDLPriorities()
+		})
+
 		shouldPanic(t, "proxy.maxUserNum", func() {
 			Params.Base.Save("proxy.maxUserNum", "abc")
 			Params.initMaxUserNum()
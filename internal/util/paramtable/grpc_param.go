@@ -36,6 +36,9 @@ const (
 	// DefaultClientMaxRecvSize defines the maximum size of data per grpc request can receive by client side.
 	DefaultClientMaxRecvSize = 100 * 1024 * 1024
 
+	// DefaultCompressionMinSize defines the size in bytes under which messages are sent uncompressed.
+	DefaultCompressionMinSize = 1024
+
 	// DefaultLogLevel defines the log level of grpc
 	DefaultLogLevel = "WARNING"
 
@@ -117,6 +120,8 @@ type GrpcServerConfig struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	CompressionMinSize int
 }
 
 // InitOnce initialize grpc server config once
@@ -131,6 +136,7 @@ func (p *GrpcServerConfig) init(domain string) {
 
 	p.initServerMaxSendSize()
 	p.initServerMaxRecvSize()
+	p.initCompressionMinSize()
 }
 
 func (p *GrpcServerConfig) initServerMaxSendSize() {
@@ -182,6 +188,29 @@ func (p *GrpcServerConfig) initServerMaxRecvSize() {
 		zap.String("role", p.Domain), zap.Int("grpc.serverMaxRecvSize", p.ServerMaxRecvSize))
 }
 
+func (p *GrpcServerConfig) initCompressionMinSize() {
+	valueStr, err := p.Load("grpc.compressionMinSize")
+	if err != nil {
+		valueStr, err = p.Load(p.Domain + ".grpc.compressionMinSize")
+	}
+	if err != nil {
+		p.CompressionMinSize = DefaultCompressionMinSize
+	} else {
+		value, err := strconv.Atoi(valueStr)
+		if err != nil || value < 0 {
+			log.Warn("Failed to parse grpc.compressionMinSize, set to default",
+				zap.String("role", p.Domain), zap.String("grpc.compressionMinSize", valueStr),
+				zap.Error(err))
+			p.CompressionMinSize = DefaultCompressionMinSize
+		} else {
+			p.CompressionMinSize = value
+		}
+	}
+
+	log.Debug("initCompressionMinSize",
+		zap.String("role", p.Domain), zap.Int("grpc.compressionMinSize", p.CompressionMinSize))
+}
+
 // GrpcClientConfig is configuration for grpc client.
 type GrpcClientConfig struct {
 	grpcConfig
@@ -48,6 +48,14 @@ func TestGrpcServerParams(t *testing.T) {
 	Params.Remove(role + ".grpc.serverMaxSendSize")
 	Params.initServerMaxSendSize()
 	assert.Equal(t, Params.ServerMaxSendSize, DefaultServerMaxSendSize)
+
+	assert.Equal(t, DefaultCompressionMinSize, Params.CompressionMinSize)
+	Params.Save("grpc.compressionMinSize", "-1")
+	Params.initCompressionMinSize()
+	assert.Equal(t, DefaultCompressionMinSize, Params.CompressionMinSize)
+	Params.Save("grpc.compressionMinSize", "4096")
+	Params.initCompressionMinSize()
+	assert.Equal(t, 4096, Params.CompressionMinSize)
 }
 
 func TestGrpcClientParams(t *testing.T) {
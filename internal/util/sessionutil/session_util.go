@@ -188,6 +188,25 @@ func (s *Session) Register() {
 	s.UpdateRegistered(true)
 }
 
+// Reregister registers the session to etcd again with a new lease after the lease is lost, e.g. expired during a
+// network partition. The ServerID is kept, so the node is found with the same ID after the re-registration.
+func (s *Session) Reregister() error {
+	s.UpdateRegistered(false)
+	if s.keepAliveCancel != nil {
+		s.keepAliveCancel()
+	}
+	// revoke the old lease if it's still alive, otherwise the key of the session can't be put again
+	s.Revoke(time.Second)
+
+	ch, err := s.registerService()
+	if err != nil {
+		return err
+	}
+	s.liveCh = s.processKeepAliveResponse(ch)
+	s.UpdateRegistered(true)
+	return nil
+}
+
 func (s *Session) getServerID() (int64, error) {
 	return s.getServerIDWithKey(DefaultIDKey)
 }
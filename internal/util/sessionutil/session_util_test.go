@@ -395,6 +395,62 @@ func TestSessionRevoke(t *testing.T) {
 	})
 }
 
+func TestSessionReregister(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints := Params.LoadWithDefault("etcd.endpoints", paramtable.DefaultEtcdEndpoints)
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
+	require.NoError(t, err)
+	defer etcdCli.Close()
+	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
+	err = etcdKV.RemoveWithPrefix("")
+	assert.NoError(t, err)
+
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s := NewSession(ctx, metaRoot, etcdCli)
+	s.Init("reregistertest", "testAddr", false, false)
+	s.Register()
+	serverID := s.ServerID
+	key := "reregistertest-" + strconv.FormatInt(serverID, 10)
+
+	// lose the lease
+	lost := make(chan struct{})
+	go s.LivenessCheck(ctx, func() {
+		close(lost)
+	})
+	_, err = etcdCli.Revoke(ctx, *s.leaseID)
+	assert.NoError(t, err)
+	select {
+	case <-lost:
+	case <-time.After(10 * time.Second):
+		t.Fatal("lease loss is not detected")
+	}
+	sessions, _, err := s.GetSessions("reregistertest")
+	assert.NoError(t, err)
+	assert.NotContains(t, sessions, key)
+
+	err = s.Reregister()
+	assert.NoError(t, err)
+	assert.True(t, s.Registered())
+	assert.Equal(t, serverID, s.ServerID)
+	sessions, _, err = s.GetSessions("reregistertest")
+	assert.NoError(t, err)
+	assert.Contains(t, sessions, key)
+
+	// re-registering a live session replaces its lease
+	err = s.Reregister()
+	assert.NoError(t, err)
+	sessions, _, err = s.GetSessions("reregistertest")
+	assert.NoError(t, err)
+	assert.Contains(t, sessions, key)
+}
+
 func TestSession_Registered(t *testing.T) {
 	session := &Session{}
 	session.UpdateRegistered(false)
@@ -394,6 +394,109 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 	}
 }
 
+// GatherFieldData builds the fields data of the rows at offsets in src, it's equivalent to calling AppendFieldData
+// for each offset but allocates every column only once. If the offsets are contiguous, the columns share the
+// underlying arrays of src instead of copying, so the result must not be modified as long as src is in use.
+func GatherFieldData(src []*schemapb.FieldData, offsets []int) []*schemapb.FieldData {
+	dst := make([]*schemapb.FieldData, len(src))
+	if len(offsets) == 0 {
+		return dst
+	}
+	contiguous := isContiguous(offsets)
+	for i, fieldData := range src {
+		switch fieldType := fieldData.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			dstScalar := &schemapb.ScalarField{}
+			dst[i] = &schemapb.FieldData{
+				Type:      fieldData.Type,
+				FieldName: fieldData.FieldName,
+				FieldId:   fieldData.FieldId,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: dstScalar,
+				},
+			}
+			switch srcScalar := fieldType.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				dstScalar.Data = &schemapb.ScalarField_BoolData{
+					BoolData: &schemapb.BoolArray{Data: gatherRows(srcScalar.BoolData.Data, offsets, 1, contiguous)},
+				}
+			case *schemapb.ScalarField_IntData:
+				dstScalar.Data = &schemapb.ScalarField_IntData{
+					IntData: &schemapb.IntArray{Data: gatherRows(srcScalar.IntData.Data, offsets, 1, contiguous)},
+				}
+			case *schemapb.ScalarField_LongData:
+				dstScalar.Data = &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{Data: gatherRows(srcScalar.LongData.Data, offsets, 1, contiguous)},
+				}
+			case *schemapb.ScalarField_FloatData:
+				dstScalar.Data = &schemapb.ScalarField_FloatData{
+					FloatData: &schemapb.FloatArray{Data: gatherRows(srcScalar.FloatData.Data, offsets, 1, contiguous)},
+				}
+			case *schemapb.ScalarField_DoubleData:
+				dstScalar.Data = &schemapb.ScalarField_DoubleData{
+					DoubleData: &schemapb.DoubleArray{Data: gatherRows(srcScalar.DoubleData.Data, offsets, 1, contiguous)},
+				}
+			case *schemapb.ScalarField_StringData:
+				dstScalar.Data = &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: gatherRows(srcScalar.StringData.Data, offsets, 1, contiguous)},
+				}
+			default:
+				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
+			}
+		case *schemapb.FieldData_Vectors:
+			dim := fieldType.Vectors.Dim
+			dstVector := &schemapb.VectorField{
+				Dim: dim,
+			}
+			dst[i] = &schemapb.FieldData{
+				Type:      fieldData.Type,
+				FieldName: fieldData.FieldName,
+				FieldId:   fieldData.FieldId,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: dstVector,
+				},
+			}
+			switch srcVector := fieldType.Vectors.Data.(type) {
+			case *schemapb.VectorField_BinaryVector:
+				dstVector.Data = &schemapb.VectorField_BinaryVector{
+					BinaryVector: gatherRows(srcVector.BinaryVector, offsets, int(dim/8), contiguous),
+				}
+			case *schemapb.VectorField_FloatVector:
+				dstVector.Data = &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{Data: gatherRows(srcVector.FloatVector.Data, offsets, int(dim), contiguous)},
+				}
+			default:
+				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
+			}
+		}
+	}
+	return dst
+}
+
+// gatherRows returns the rows at offsets of data, each row has rowLen elements.
+// Contiguous rows are returned as a sub slice of data without copying.
+func gatherRows[T any](data []T, offsets []int, rowLen int, contiguous bool) []T {
+	if contiguous {
+		begin, end := offsets[0]*rowLen, (offsets[len(offsets)-1]+1)*rowLen
+		// limit the capacity so that appending to the result never overwrites data.
+		return data[begin:end:end]
+	}
+	ret := make([]T, len(offsets)*rowLen)
+	for i, offset := range offsets {
+		copy(ret[i*rowLen:(i+1)*rowLen], data[offset*rowLen:(offset+1)*rowLen])
+	}
+	return ret
+}
+
+func isContiguous(offsets []int) bool {
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] != offsets[i-1]+1 {
+			return false
+		}
+	}
+	return true
+}
+
 // MergeFieldData appends fields data to dst
 func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 	fieldID2Data := make(map[int64]*schemapb.FieldData)
@@ -18,8 +18,10 @@ package typeutil
 
 import (
 	"reflect"
+	"strconv"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -697,3 +699,139 @@ func TestComparePk(t *testing.T) {
 	less = ComparePK(strPks, 2, 1)
 	assert.False(t, less)
 }
+
+func genGatherTestFieldsData(numRows int, dim int) []*schemapb.FieldData {
+	bools := make([]bool, numRows)
+	int32s := make([]int32, numRows)
+	int64s := make([]int64, numRows)
+	floats := make([]float32, numRows)
+	doubles := make([]float64, numRows)
+	strs := make([]string, numRows)
+	binaryVectors := make([]byte, numRows*dim/8)
+	floatVectors := make([]float32, numRows*dim)
+	for i := 0; i < numRows; i++ {
+		bools[i] = i%2 == 0
+		int32s[i] = int32(i)
+		int64s[i] = int64(i)
+		floats[i] = float32(i)
+		doubles[i] = float64(i)
+		strs[i] = strconv.Itoa(i)
+	}
+	for i := range binaryVectors {
+		binaryVectors[i] = byte(i)
+	}
+	for i := range floatVectors {
+		floatVectors[i] = float32(i)
+	}
+	return []*schemapb.FieldData{
+		genFieldData("bool", 100, schemapb.DataType_Bool, bools, 1),
+		genFieldData("int32", 101, schemapb.DataType_Int32, int32s, 1),
+		genFieldData("int64", 102, schemapb.DataType_Int64, int64s, 1),
+		genFieldData("float", 103, schemapb.DataType_Float, floats, 1),
+		genFieldData("double", 104, schemapb.DataType_Double, doubles, 1),
+		{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: "varchar",
+			FieldId:   105,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: strs},
+					},
+				},
+			},
+		},
+		genFieldData("binary_vector", 106, schemapb.DataType_BinaryVector, binaryVectors, int64(dim)),
+		genFieldData("float_vector", 107, schemapb.DataType_FloatVector, floatVectors, int64(dim)),
+	}
+}
+
+func appendFieldDataByOffsets(src []*schemapb.FieldData, offsets []int) []*schemapb.FieldData {
+	dst := make([]*schemapb.FieldData, len(src))
+	for _, offset := range offsets {
+		AppendFieldData(dst, src, int64(offset))
+	}
+	return dst
+}
+
+func TestGatherFieldData(t *testing.T) {
+	src := genGatherTestFieldsData(10, 16)
+	cases := []struct {
+		name    string
+		offsets []int
+	}{
+		{"empty", []int{}},
+		{"single", []int{3}},
+		{"contiguous", []int{2, 3, 4, 5}},
+		{"all", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"scattered", []int{9, 0, 4, 7}},
+		{"duplicated", []int{1, 1, 2}},
+	}
+	for _, c := range cases {
+		expected := appendFieldDataByOffsets(src, c.offsets)
+		actual := GatherFieldData(src, c.offsets)
+		// the messages built by both must be identical on the wire.
+		expectedBytes, err := proto.Marshal(&schemapb.SearchResultData{FieldsData: expected})
+		require.NoError(t, err, c.name)
+		actualBytes, err := proto.Marshal(&schemapb.SearchResultData{FieldsData: actual})
+		require.NoError(t, err, c.name)
+		assert.Equal(t, expectedBytes, actualBytes, c.name)
+	}
+
+	t.Run("contiguous rows share the source", func(t *testing.T) {
+		actual := GatherFieldData(src, []int{2, 3})
+		vectors := actual[7].GetVectors().GetFloatVector().GetData()
+		assert.Equal(t, &src[7].GetVectors().GetFloatVector().GetData()[2*16], &vectors[0])
+
+		// appending to the result doesn't overwrite the source.
+		_ = append(vectors, 0)
+		assert.Equal(t, float32(4*16), src[7].GetVectors().GetFloatVector().GetData()[4*16])
+	})
+}
+
+func TestGatherFieldData_Allocs(t *testing.T) {
+	const numRows, dim = 10000, 128
+	src := []*schemapb.FieldData{genGatherTestFieldsData(numRows, dim)[7]}
+	offsets := make([]int, 0, numRows/2)
+	for i := 0; i < numRows; i += 2 {
+		offsets = append(offsets, i)
+	}
+
+	appendAllocs := testing.AllocsPerRun(5, func() {
+		appendFieldDataByOffsets(src, offsets)
+	})
+	gatherAllocs := testing.AllocsPerRun(5, func() {
+		GatherFieldData(src, offsets)
+	})
+	assert.Less(t, gatherAllocs*4, appendAllocs)
+}
+
+func BenchmarkAppendFieldData(b *testing.B) {
+	const numRows, dim = 100000, 128
+	src := []*schemapb.FieldData{genGatherTestFieldsData(numRows, dim)[7]}
+	offsets := make([]int, 0, numRows/2)
+	for i := 0; i < numRows; i += 2 {
+		offsets = append(offsets, i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		appendFieldDataByOffsets(src, offsets)
+	}
+}
+
+func BenchmarkGatherFieldData(b *testing.B) {
+	const numRows, dim = 100000, 128
+	src := []*schemapb.FieldData{genGatherTestFieldsData(numRows, dim)[7]}
+	offsets := make([]int, 0, numRows/2)
+	for i := 0; i < numRows; i += 2 {
+		offsets = append(offsets, i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GatherFieldData(src, offsets)
+	}
+}